@@ -0,0 +1,61 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package engine
+
+import (
+	"reflect"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4/dependency"
+)
+
+// Get returns the named dependency from the getter as a value of type T. It
+// saves each StartFunc from declaring an out variable and annotating the
+// error itself.
+//
+// If the dependency is not available, dependency.ErrMissing is returned
+// unchanged so that the engine can retry the StartFunc when its inputs change.
+// Any other error, such as the dependency not being able to output a T, is
+// annotated with the name of the dependency and the requested type.
+func Get[T any](getter dependency.Getter, name string) (T, error) {
+	var out T
+	err := getter.Get(name, &out)
+	if errors.Is(err, dependency.ErrMissing) {
+		return out, err
+	} else if err != nil {
+		return out, errors.Annotatef(err, "getting %q as %s", name, typeName[T]())
+	}
+	return out, nil
+}
+
+// OutputValue sets value into the out pointer, and is intended for use by
+// OutputFuncs which expose a single value. The value is set if out is a *T,
+// or a pointer to any type which T is assignable to, such as an interface
+// that T implements. Otherwise an error naming both the value's type and the
+// requested type is returned.
+func OutputValue[T any](value T, out interface{}) error {
+	if outPtr, ok := out.(*T); ok && outPtr != nil {
+		*outPtr = value
+		return nil
+	}
+
+	outV := reflect.ValueOf(out)
+	if outV.Kind() != reflect.Ptr {
+		return errors.Errorf("cannot output %s into %T: not a pointer", typeName[T](), out)
+	} else if outV.IsNil() {
+		return errors.Errorf("cannot output %s into nil %T", typeName[T](), out)
+	}
+	inV := reflect.ValueOf(&value).Elem()
+	outValV := outV.Elem()
+	if !inV.Type().AssignableTo(outValV.Type()) {
+		return errors.Errorf("cannot output %s into %T", typeName[T](), out)
+	}
+	outValV.Set(inV)
+	return nil
+}
+
+// typeName returns the name of T, which is also correct for interface types.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package engine_test
+
+import (
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/dependency"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent/engine"
+)
+
+type OutputSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&OutputSuite{})
+
+// outputGetter is a dependency.Getter which passes the out pointer to
+// OutputValue for the named value, as an OutputFunc would.
+type outputGetter map[string]interface{}
+
+func (g outputGetter) Get(name string, out interface{}) error {
+	value, ok := g[name]
+	if !ok {
+		return dependency.ErrMissing
+	}
+	switch v := value.(type) {
+	case *testType:
+		return engine.OutputValue(v, out)
+	case string:
+		return engine.OutputValue(v, out)
+	}
+	return errors.Errorf("unexpected value %#v", value)
+}
+
+func (s *OutputSuite) TestGet(c *gc.C) {
+	value := &testType{}
+	getter := outputGetter{"thing": value}
+
+	out, err := engine.Get[*testType](getter, "thing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(out, gc.Equals, value)
+}
+
+func (s *OutputSuite) TestGetInterface(c *gc.C) {
+	value := &testType{}
+	getter := outputGetter{"thing": value}
+
+	out, err := engine.Get[testInterface](getter, "thing")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(out, gc.Equals, value)
+}
+
+func (s *OutputSuite) TestGetMissing(c *gc.C) {
+	_, err := engine.Get[*testType](outputGetter{}, "thing")
+	c.Check(err, gc.Equals, dependency.ErrMissing)
+}
+
+func (s *OutputSuite) TestGetWrongType(c *gc.C) {
+	getter := outputGetter{"thing": "cheese"}
+
+	out, err := engine.Get[testInterface](getter, "thing")
+	c.Check(err, gc.ErrorMatches,
+		`getting "thing" as engine_test.testInterface: cannot output string into \*engine_test.testInterface`)
+	c.Check(out, gc.IsNil)
+}
+
+func (s *OutputSuite) TestOutputValueNotPointer(c *gc.C) {
+	var out string
+	err := engine.OutputValue("cheese", out)
+	c.Check(err, gc.ErrorMatches, `cannot output string into string: not a pointer`)
+}
+
+func (s *OutputSuite) TestOutputValueNilPointer(c *gc.C) {
+	err := engine.OutputValue[string]("cheese", (*int)(nil))
+	c.Check(err, gc.ErrorMatches, `cannot output string into nil \*int`)
+}
+
+func (s *OutputSuite) TestOutputValueNilPointerSameType(c *gc.C) {
+	err := engine.OutputValue[string]("cheese", (*string)(nil))
+	c.Check(err, gc.ErrorMatches, `cannot output string into nil \*string`)
+}
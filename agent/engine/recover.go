@@ -0,0 +1,85 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package engine
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/core/logger"
+)
+
+// PanicError is returned by a manifold's StartFunc, in place of a worker,
+// when the StartFunc panics. The engine then handles it like any other start
+// error, restarting the manifold according to its normal policy and showing
+// the panic in its report.
+type PanicError struct {
+	// Manifold is the name of the manifold which panicked.
+	Manifold string
+
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the goroutine at the point of the panic.
+	Stack []byte
+}
+
+// Error is part of the error interface.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("manifold %q panicked: %v", e.Manifold, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, so that the cause of the
+// panic can be inspected with errors.Is and errors.As.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// RecoverPanics returns a copy of the manifolds in which a panic in any
+// StartFunc is recovered and returned as a *PanicError. The stack of each
+// recovered panic is logged once at error level, so that the underlying bug
+// remains visible.
+//
+// Manifolds named in fatal are left untouched, so a panic in them still
+// takes down the process.
+//
+// Only panics on the goroutine running the StartFunc can be recovered; a
+// panic in a goroutine started by a worker, such as its loop, still crashes
+// the process and must be recovered by the worker itself.
+func RecoverPanics(manifolds dependency.Manifolds, logger logger.Logger, fatal ...string) dependency.Manifolds {
+	fatalNames := set.NewStrings(fatal...)
+	result := make(dependency.Manifolds, len(manifolds))
+	for name, manifold := range manifolds {
+		if !fatalNames.Contains(name) && manifold.Start != nil {
+			manifold.Start = recoverStart(manifold.Start, name, logger)
+		}
+		result[name] = manifold
+	}
+	return result
+}
+
+func recoverStart(inner dependency.StartFunc, name string, logger logger.Logger) dependency.StartFunc {
+	return func(ctx context.Context, getter dependency.Getter) (w worker.Worker, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				panicErr := &PanicError{
+					Manifold: name,
+					Value:    r,
+					Stack:    debug.Stack(),
+				}
+				logger.Errorf("%v\n%s", panicErr, panicErr.Stack)
+				w, err = nil, panicErr
+			}
+		}()
+		return inner(ctx, getter)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package engine_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/agent/engine"
+	"github.com/juju/juju/core/logger"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type RecoverSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&RecoverSuite{})
+
+// errorLogger records the messages logged at error level.
+type errorLogger struct {
+	logger.Logger
+	errors []string
+}
+
+func (l *errorLogger) Errorf(msg string, args ...any) {
+	l.errors = append(l.errors, fmt.Sprintf(msg, args...))
+}
+
+func (s *RecoverSuite) TestRecoverPanics(c *gc.C) {
+	log := &errorLogger{Logger: loggertesting.WrapCheckLog(c)}
+	manifolds := engine.RecoverPanics(dependency.Manifolds{
+		"panics": dependency.Manifold{
+			Inputs: []string{"x"},
+			Start:  panicStart,
+		},
+	}, log)
+
+	manifold := manifolds["panics"]
+	c.Check(manifold.Inputs, jc.DeepEquals, []string{"x"})
+
+	w, err := manifold.Start(context.Background(), nil)
+	c.Check(w, gc.IsNil)
+	c.Check(err, gc.ErrorMatches, `manifold "panics" panicked: panicStart`)
+
+	var panicErr *engine.PanicError
+	c.Assert(errors.As(err, &panicErr), jc.IsTrue)
+	c.Check(panicErr.Manifold, gc.Equals, "panics")
+	c.Check(panicErr.Value, gc.Equals, "panicStart")
+	c.Check(string(panicErr.Stack), jc.Contains, "panicStart")
+
+	c.Assert(log.errors, gc.HasLen, 1)
+	c.Check(log.errors[0], jc.HasPrefix, `manifold "panics" panicked: panicStart`)
+	c.Check(log.errors[0], jc.Contains, string(panicErr.Stack))
+}
+
+func (s *RecoverSuite) TestRecoverPanicsWithError(c *gc.C) {
+	cause := errors.New("boom")
+	manifolds := engine.RecoverPanics(dependency.Manifolds{
+		"panics": dependency.Manifold{
+			Start: func(context.Context, dependency.Getter) (worker.Worker, error) {
+				panic(cause)
+			},
+		},
+	}, loggertesting.WrapCheckLog(c))
+
+	_, err := manifolds["panics"].Start(context.Background(), nil)
+	c.Check(err, jc.ErrorIs, cause)
+}
+
+func (s *RecoverSuite) TestRecoverPanicsNoPanic(c *gc.C) {
+	expectWorker := &dummyWorker{}
+	expectErr := errors.New("not started")
+	log := &errorLogger{Logger: loggertesting.WrapCheckLog(c)}
+	manifolds := engine.RecoverPanics(dependency.Manifolds{
+		"starts": dependency.Manifold{
+			Start: func(context.Context, dependency.Getter) (worker.Worker, error) {
+				return expectWorker, nil
+			},
+		},
+		"fails": dependency.Manifold{
+			Start: func(context.Context, dependency.Getter) (worker.Worker, error) {
+				return nil, expectErr
+			},
+		},
+	}, log)
+
+	w, err := manifolds["starts"].Start(context.Background(), nil)
+	c.Check(err, jc.ErrorIsNil)
+	c.Check(w, gc.Equals, expectWorker)
+
+	_, err = manifolds["fails"].Start(context.Background(), nil)
+	c.Check(err, gc.Equals, expectErr)
+
+	c.Check(log.errors, gc.HasLen, 0)
+}
+
+func (s *RecoverSuite) TestRecoverPanicsFatal(c *gc.C) {
+	manifolds := engine.RecoverPanics(dependency.Manifolds{
+		"fatal": dependency.Manifold{
+			Start: panicStart,
+		},
+		"empty": dependency.Manifold{},
+	}, loggertesting.WrapCheckLog(c), "fatal")
+
+	c.Check(func() {
+		manifolds["fatal"].Start(context.Background(), nil)
+	}, gc.PanicMatches, "panicStart")
+	c.Check(manifolds["empty"].Start, gc.IsNil)
+}
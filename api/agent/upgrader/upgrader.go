@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/juju/api/base"
 	apiwatcher "github.com/juju/juju/api/watcher"
+	"github.com/juju/juju/core/upgrade"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/rpc/params"
@@ -52,6 +53,27 @@ func (st *Client) SetVersion(ctx context.Context, tag string, v version.Binary)
 	return results.OneError()
 }
 
+// SetAgentUpgradeState records the progress of the entity with the given tag
+// through an upgrade of its agent binary from the current version to the
+// target version. The tag must be that of the entity that the upgrader is
+// running on behalf of.
+func (st *Client) SetAgentUpgradeState(ctx context.Context, tag string, current, target version.Number, state upgrade.AgentState) error {
+	var results params.ErrorResults
+	args := params.SetAgentUpgradeStates{
+		States: []params.AgentUpgradeState{{
+			Tag:            tag,
+			CurrentVersion: current,
+			TargetVersion:  target,
+			State:          state.String(),
+		}},
+	}
+	err := st.facade.FacadeCall(ctx, "SetAgentUpgradeStates", args, &results)
+	if err != nil {
+		return err
+	}
+	return results.OneError()
+}
+
 func (st *Client) DesiredVersion(ctx context.Context, tag string) (version.Number, error) {
 	var results params.VersionResults
 	args := params.Entities{
@@ -8,10 +8,12 @@ import (
 
 	jujutesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
+	semversion "github.com/juju/version/v2"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/api/agent/upgrader"
 	"github.com/juju/juju/api/base/testing"
+	"github.com/juju/juju/core/upgrade"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/tools"
 	"github.com/juju/juju/rpc/params"
@@ -47,6 +49,34 @@ func (s *machineUpgraderSuite) TestSetVersion(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "FAIL")
 }
 
+func (s *machineUpgraderSuite) TestSetAgentUpgradeState(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "Upgrader")
+		c.Check(version, gc.Equals, 0)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "SetAgentUpgradeStates")
+		c.Check(arg, jc.DeepEquals, params.SetAgentUpgradeStates{
+			States: []params.AgentUpgradeState{{
+				Tag:            "machine-666",
+				CurrentVersion: semversion.MustParse("4.0.0"),
+				TargetVersion:  semversion.MustParse("4.0.1"),
+				State:          "downloading",
+			}},
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ErrorResults{})
+		*(result.(*params.ErrorResults)) = params.ErrorResults{
+			Results: []params.ErrorResult{{Error: &params.Error{Message: "FAIL"}}},
+		}
+		return nil
+	})
+	client := upgrader.NewClient(apiCaller)
+	err := client.SetAgentUpgradeState(
+		context.Background(), "machine-666",
+		semversion.MustParse("4.0.0"), semversion.MustParse("4.0.1"), upgrade.AgentDownloading,
+	)
+	c.Assert(err, gc.ErrorMatches, "FAIL")
+}
+
 func (s *machineUpgraderSuite) TestTools(c *gc.C) {
 	toolsResult := tools.List{{URL: "https://tools"}}
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
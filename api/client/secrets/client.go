@@ -88,33 +88,8 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 	}
 	result := make([]SecretDetails, len(response.Results))
 	for i, r := range response.Results {
-		details := SecretDetails{
-			Metadata: secrets.SecretMetadata{
-				Version:                r.Version,
-				RotatePolicy:           secrets.RotatePolicy(r.RotatePolicy),
-				NextRotateTime:         r.NextRotateTime,
-				LatestRevision:         r.LatestRevision,
-				LatestRevisionChecksum: r.LatestRevisionChecksum,
-				LatestExpireTime:       r.LatestExpireTime,
-				Description:            r.Description,
-				Label:                  r.Label,
-				CreateTime:             r.CreateTime,
-				UpdateTime:             r.UpdateTime,
-			},
-			Access: toGrantInfo(r.Access),
-		}
-		uri, err := secrets.ParseURI(r.URI)
-		if err == nil {
-			details.Metadata.URI = uri
-		} else {
-			details.Error = err.Error()
-		}
-		owner, err := common.SecretOwnerFromTag(r.OwnerTag)
-		if err == nil {
-			details.Metadata.Owner = owner
-		} else {
-			details.Error = err.Error()
-		}
+		details := secretDetailsFromResult(r)
+		details.Access = toGrantInfo(r.Access)
 		details.Revisions = make([]secrets.SecretRevisionMetadata, len(r.Revisions))
 		for i, r := range r.Revisions {
 			details.Revisions[i] = secrets.SecretRevisionMetadata{
@@ -139,6 +114,60 @@ func (api *Client) ListSecrets(ctx context.Context, reveal bool, filter secrets.
 	return result, err
 }
 
+// SearchSecrets returns the secrets whose label or description contains
+// the query, ignoring case. The scope is one of "all", "owned" or
+// "accessible"; if empty, all secrets are searched.
+func (c *Client) SearchSecrets(ctx context.Context, query, scope string) ([]SecretDetails, error) {
+	if c.BestAPIVersion() < 3 {
+		return nil, errors.NotSupportedf("searching secrets")
+	}
+	arg := params.SearchSecretsArgs{
+		Query: query,
+		Scope: scope,
+	}
+	var response params.ListSecretResults
+	if err := c.facade.FacadeCall(ctx, "SearchSecrets", arg, &response); err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]SecretDetails, len(response.Results))
+	for i, r := range response.Results {
+		result[i] = secretDetailsFromResult(r)
+	}
+	return result, nil
+}
+
+// secretDetailsFromResult returns the secret details holding
+// the metadata in the specified result.
+func secretDetailsFromResult(r params.ListSecretResult) SecretDetails {
+	details := SecretDetails{
+		Metadata: secrets.SecretMetadata{
+			Version:                r.Version,
+			RotatePolicy:           secrets.RotatePolicy(r.RotatePolicy),
+			NextRotateTime:         r.NextRotateTime,
+			LatestRevision:         r.LatestRevision,
+			LatestRevisionChecksum: r.LatestRevisionChecksum,
+			LatestExpireTime:       r.LatestExpireTime,
+			Description:            r.Description,
+			Label:                  r.Label,
+			CreateTime:             r.CreateTime,
+			UpdateTime:             r.UpdateTime,
+		},
+	}
+	uri, err := secrets.ParseURI(r.URI)
+	if err == nil {
+		details.Metadata.URI = uri
+	} else {
+		details.Error = err.Error()
+	}
+	owner, err := common.SecretOwnerFromTag(r.OwnerTag)
+	if err == nil {
+		details.Metadata.Owner = owner
+	} else {
+		details.Error = err.Error()
+	}
+	return details
+}
+
 func (c *Client) CreateSecret(ctx context.Context, name, description string, data map[string]string) (string, error) {
 	if c.BestAPIVersion() < 2 {
 		return "", errors.NotSupportedf("user secrets")
@@ -153,6 +153,56 @@ func (s *SecretsSuite) TestListSecretsError(c *gc.C) {
 	c.Assert(result[0].Error, gc.Equals, "boom")
 }
 
+func (s *SecretsSuite) TestSearchSecrets(c *gc.C) {
+	uri := secrets.NewURI()
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		c.Check(objType, gc.Equals, "Secrets")
+		c.Check(version, gc.Equals, 3)
+		c.Check(id, gc.Equals, "")
+		c.Check(request, gc.Equals, "SearchSecrets")
+		c.Check(arg, jc.DeepEquals, params.SearchSecretsArgs{
+			Query: "foo",
+			Scope: "owned",
+		})
+		c.Assert(result, gc.FitsTypeOf, &params.ListSecretResults{})
+		*(result.(*params.ListSecretResults)) = params.ListSecretResults{
+			Results: []params.ListSecretResult{{
+				URI:            uri.String(),
+				Version:        1,
+				OwnerTag:       coretesting.ModelTag.String(),
+				Description:    "shhh",
+				Label:          "foobar",
+				LatestRevision: 2,
+			}},
+		}
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 3}
+	client := apisecrets.NewClient(caller)
+	result, err := client.SearchSecrets(context.Background(), "foo", "owned")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, []apisecrets.SecretDetails{{
+		Metadata: secrets.SecretMetadata{
+			URI:            uri,
+			Version:        1,
+			Owner:          secrets.Owner{Kind: secrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+			Description:    "shhh",
+			Label:          "foobar",
+			LatestRevision: 2,
+		},
+	}})
+}
+
+func (s *SecretsSuite) TestSearchSecretsNotSupported(c *gc.C) {
+	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
+		return nil
+	})
+	caller := testing.BestVersionCaller{APICallerFunc: apiCaller, BestVersion: 2}
+	client := apisecrets.NewClient(caller)
+	_, err := client.SearchSecrets(context.Background(), "foo", "")
+	c.Assert(err, gc.ErrorMatches, "searching secrets not supported")
+}
+
 func (s *SecretsSuite) TestCreateSecretError(c *gc.C) {
 	apiCaller := testing.APICallerFunc(func(objType string, version int, id, request string, arg, result interface{}) error {
 		return nil
@@ -226,13 +226,34 @@ func (c *Client) Export(ctx context.Context) (migration.SerializedModel, error)
 	}
 
 	return migration.SerializedModel{
-		Bytes:     serialized.Bytes,
-		Charms:    serialized.Charms,
-		Tools:     tools,
-		Resources: resources,
+		Bytes:          serialized.Bytes,
+		Charms:         serialized.Charms,
+		Tools:          tools,
+		Resources:      resources,
+		SecretBackends: convertSecretBackends(serialized.SecretBackends),
 	}, nil
 }
 
+func convertSecretBackends(in *params.SerializedModelSecretBackends) *migration.SerializedModelSecretBackends {
+	if in == nil {
+		return nil
+	}
+	out := &migration.SerializedModelSecretBackends{
+		ModelBackendName: in.ModelBackendName,
+	}
+	for _, b := range in.Backends {
+		out.Backends = append(out.Backends, migration.SerializedModelSecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			NextRotateTime:      b.NextRotateTime,
+			Config:              b.Config,
+		})
+	}
+	return out
+}
+
 // ProcessRelations runs a series of processes to ensure that the relations
 // of a given model are correct after a migrated model.
 func (c *Client) ProcessRelations(ctx context.Context, controllerAlias string) error {
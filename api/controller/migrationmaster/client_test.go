@@ -366,6 +366,15 @@ func (s *ClientSuite) TestExport(c *gc.C) {
 					},
 				},
 			}},
+			SecretBackends: &params.SerializedModelSecretBackends{
+				ModelBackendName: "myvault",
+				Backends: []params.SerializedModelSecretBackend{{
+					ID:          "backend-id",
+					Name:        "myvault",
+					BackendType: "vault",
+					Config:      map[string]interface{}{"endpoint": "http://vault"},
+				}},
+			},
 		}
 		return nil
 	})
@@ -434,6 +443,15 @@ func (s *ClientSuite) TestExport(c *gc.C) {
 				},
 			},
 		}},
+		SecretBackends: &migration.SerializedModelSecretBackends{
+			ModelBackendName: "myvault",
+			Backends: []migration.SerializedModelSecretBackend{{
+				ID:          "backend-id",
+				Name:        "myvault",
+				BackendType: "vault",
+				Config:      map[string]interface{}{"endpoint": "http://vault"},
+			}},
+		},
 	})
 }
 
@@ -89,12 +89,35 @@ func (c *Client) Prechecks(ctx context.Context, model coremigration.ModelInfo) e
 }
 
 // Import takes a serialized model and imports it into the target
-// controller.
-func (c *Client) Import(ctx context.Context, bytes []byte) error {
-	serialized := params.SerializedModel{Bytes: bytes}
+// controller, along with the secret backends the model depends on.
+func (c *Client) Import(ctx context.Context, bytes []byte, secretBackends *coremigration.SerializedModelSecretBackends) error {
+	serialized := params.SerializedModel{
+		Bytes:          bytes,
+		SecretBackends: convertSecretBackends(secretBackends),
+	}
 	return errors.Trace(c.caller.FacadeCall(ctx, "Import", serialized, nil))
 }
 
+func convertSecretBackends(in *coremigration.SerializedModelSecretBackends) *params.SerializedModelSecretBackends {
+	if in == nil {
+		return nil
+	}
+	out := &params.SerializedModelSecretBackends{
+		ModelBackendName: in.ModelBackendName,
+	}
+	for _, b := range in.Backends {
+		out.Backends = append(out.Backends, params.SerializedModelSecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			NextRotateTime:      b.NextRotateTime,
+			Config:              b.Config,
+		})
+	}
+	return out
+}
+
 // Abort removes all data relating to a previously imported model.
 func (c *Client) Abort(ctx context.Context, modelUUID string) error {
 	args := params.ModelArgs{ModelTag: names.NewModelTag(modelUUID).String()}
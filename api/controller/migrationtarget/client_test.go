@@ -93,9 +93,28 @@ func (s *ClientSuite) TestPrechecks(c *gc.C) {
 func (s *ClientSuite) TestImport(c *gc.C) {
 	client, stub := s.getClientAndStub()
 
-	err := client.Import(context.Background(), []byte("foo"))
+	err := client.Import(context.Background(), []byte("foo"), &coremigration.SerializedModelSecretBackends{
+		ModelBackendName: "myvault",
+		Backends: []coremigration.SerializedModelSecretBackend{{
+			ID:          "backend-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      map[string]interface{}{"endpoint": "http://vault"},
+		}},
+	})
 
-	expectedArg := params.SerializedModel{Bytes: []byte("foo")}
+	expectedArg := params.SerializedModel{
+		Bytes: []byte("foo"),
+		SecretBackends: &params.SerializedModelSecretBackends{
+			ModelBackendName: "myvault",
+			Backends: []params.SerializedModelSecretBackend{{
+				ID:          "backend-id",
+				Name:        "myvault",
+				BackendType: "vault",
+				Config:      map[string]interface{}{"endpoint": "http://vault"},
+			}},
+		},
+	}
 	stub.CheckCalls(c, []jujutesting.StubCall{
 		{FuncName: "MigrationTarget.Import", Args: []interface{}{"", expectedArg}},
 	})
@@ -102,7 +102,7 @@ var facadeVersions = facades.FacadeVersions{
 	"SecretBackendsManager":        {1},
 	"SecretBackendsRotateWatcher":  {1},
 	"SecretsRevisionWatcher":       {1},
-	"Secrets":                      {1, 2},
+	"Secrets":                      {1, 2, 3},
 	"SecretsManager":               {2},
 	"SecretsDrain":                 {1},
 	"UserSecretsDrain":             {1},
@@ -113,7 +113,7 @@ var facadeVersions = facades.FacadeVersions{
 	"Storage":                      {6},
 	"StorageProvisioner":           {4},
 	"StringsWatcher":               {1},
-	"Subnets":                      {5},
+	"Subnets":                      {5, 6},
 	"Undertaker":                   {1},
 	"UnitAssigner":                 {1},
 	"Uniter":                       {19, 20, 21},
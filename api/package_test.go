@@ -49,6 +49,7 @@ func (*ImportSuite) TestImports(c *gc.C) {
 		"core/secrets",
 		"core/status",
 		"core/trace",
+		"core/upgrade",
 		"core/user",
 		"core/version",
 		"core/watcher",
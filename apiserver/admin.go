@@ -309,6 +309,8 @@ func (a *admin) authenticate(ctx context.Context, req params.LoginRequest) (*aut
 
 			authenticated = true
 			a.root.authInfo = authInfo
+			// Permissions cached for a previous login no longer apply.
+			a.root.permissions.invalidate()
 			result.controllerMachineLogin = authInfo.Controller
 			break
 		}
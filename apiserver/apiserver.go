@@ -90,6 +90,7 @@ type Server struct {
 	lastConnectionID uint64
 	newObserver      observer.ObserverFactory
 	allowModelAccess bool
+	requestTimeouts  rpc.RequestTimeouts
 	// TODO(debug-log) - move into logSink
 	logSinkWriter          io.WriteCloser
 	logsinkRateLimitConfig logsink.RateLimitConfig
@@ -178,6 +179,10 @@ type ServerConfig struct {
 	// they don't have access to the controller.
 	AllowModelAccess bool
 
+	// RequestTimeouts holds the maximum time allowed for each API request
+	// before the server gives up on it. The zero value imposes no limits.
+	RequestTimeouts rpc.RequestTimeouts
+
 	// NewObserver is a function which will return an observer. This
 	// is used per-connection to instantiate a new observer to be
 	// notified of key events during API requests.
@@ -324,6 +329,10 @@ func NewServer(ctx context.Context, cfg ServerConfig) (*Server, error) {
 
 const readyTimeout = time.Second * 30
 
+// apiHeartbeatInterval is how often the API server records that it is up
+// against its controller node.
+const apiHeartbeatInterval = time.Minute
+
 func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 	controllerDomainServices := cfg.DomainServicesGetter.ServicesForModel(cfg.ControllerModelUUID)
 	controllerConfigService := controllerDomainServices.ControllerConfig()
@@ -379,6 +388,7 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		httpAuthenticators:            httpAuthenticators,
 		loginAuthenticators:           loginAuthenticators,
 		allowModelAccess:              cfg.AllowModelAccess,
+		requestTimeouts:               cfg.RequestTimeouts,
 		publicDNSName_:                cfg.PublicDNSName,
 		registerIntrospectionHandlers: cfg.RegisterIntrospectionHandlers,
 		logsinkRateLimitConfig: logsink.RateLimitConfig{
@@ -462,6 +472,9 @@ func newServer(ctx context.Context, cfg ServerConfig) (_ *Server, err error) {
 		defer unsubscribeControllerConfig()
 		return srv.loop(ready)
 	})
+	srv.tomb.Go(func() error {
+		return srv.heartbeatLoop(controllerDomainServices.ControllerNode())
+	})
 
 	// Don't return until all handlers have been registered.
 	select {
@@ -657,6 +670,29 @@ func (srv *Server) loop(ready chan struct{}) error {
 	return tomb.ErrDying
 }
 
+// heartbeatRecorder records that the API server of a controller node is up.
+type heartbeatRecorder interface {
+	RecordAPIServerHeartbeat(ctx context.Context, controllerID string, port int) error
+}
+
+// heartbeatLoop records a heartbeat for the API server against its controller
+// node until the server is stopped. The heartbeats tell operators which API
+// endpoints of an HA controller are still up.
+func (srv *Server) heartbeatLoop(nodeService heartbeatRecorder) error {
+	ctx := srv.tomb.Context(context.Background())
+	controllerID := srv.tag.Id()
+	for {
+		if err := nodeService.RecordAPIServerHeartbeat(ctx, controllerID, srv.shared.apiPort()); err != nil {
+			logger.Warningf("cannot record API server heartbeat: %v", err)
+		}
+		select {
+		case <-srv.tomb.Dying():
+			return tomb.ErrDying
+		case <-srv.clock.After(apiHeartbeatInterval):
+		}
+	}
+}
+
 const (
 	modelRoutePrefix         = "/model/:modeluuid"
 	charmsObjectsRoutePrefix = "/model-:modeluuid/charms/:object"
@@ -1094,6 +1130,7 @@ func (srv *Server) serveConn(
 	codec := jsoncodec.NewWebsocket(wsConn.Conn)
 	recorderFactory := observer.NewRecorderFactory(apiObserver, nil, observer.NoCaptureArgs)
 	conn := rpc.NewConn(codec, recorderFactory)
+	conn.SetRequestTimeouts(srv.requestTimeouts)
 
 	tracer, err := srv.shared.tracerGetter.GetTracer(
 		ctx,
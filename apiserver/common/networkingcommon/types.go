@@ -42,13 +42,14 @@ func BackingSubnetToParamsSubnetV2(subnet network.SubnetInfo) params.SubnetV2 {
 
 func BackingSubnetToParamsSubnet(subnet network.SubnetInfo) params.Subnet {
 	return params.Subnet{
-		CIDR:              subnet.CIDR,
-		VLANTag:           subnet.VLANTag,
-		ProviderId:        subnet.ProviderId.String(),
-		ProviderNetworkId: subnet.ProviderNetworkId.String(),
-		Zones:             subnet.AvailabilityZones,
-		SpaceTag:          names.NewSpaceTag(subnet.SpaceName).String(),
-		Life:              subnet.Life,
+		CIDR:               subnet.CIDR,
+		VLANTag:            subnet.VLANTag,
+		ProviderId:         subnet.ProviderId.String(),
+		ProviderNetworkId:  subnet.ProviderNetworkId.String(),
+		Zones:              subnet.AvailabilityZones,
+		SpaceTag:           names.NewSpaceTag(subnet.SpaceName).String(),
+		Life:               subnet.Life,
+		ProviderAttributes: subnet.ProviderAttributes,
 	}
 }
 
@@ -41,6 +41,7 @@ func APIHandlerWithEntity(entity state.Entity) *apiHandler {
 		authInfo: authentication.AuthInfo{
 			Entity: entity,
 		},
+		permissions: newPermissionCache(clock.WallClock, func() uint64 { return 0 }),
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4/workertest"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/apiserver/facade"
@@ -142,6 +143,11 @@ func (s *rebootSuite) SetUpTest(c *gc.C) {
 		),
 		domain.NewWatcherFactory(factory, loggertesting.WrapCheckLog(c)),
 		nil,
+		nil,
+		nil,
+		&singleflight.Group{},
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
 	)
 }
 
@@ -35,6 +35,7 @@ type statusSetter interface {
 
 type machineService interface {
 	CreateMachine(context.Context, machine.Name) (string, error)
+	CreateMachineWithParent(context.Context, machine.Name, machine.Name) (string, error)
 }
 
 // NetworkService is the interface that is used to interact with the
@@ -140,19 +141,23 @@ func (a *API) AssignUnits(ctx context.Context, args params.Entities) (params.Err
 
 func (a *API) saveMachineInfo(ctx context.Context, machineName string) error {
 	// This is temporary - just insert the machine id and all the parent ones.
-	for machineName != "" {
-		_, err := a.machineService.CreateMachine(ctx, machine.Name(machineName))
-		// The machine might already exist e.g. if we are adding a subordinate
-		// unit to an already existing machine. In this case, just continue
-		// without error.
-		if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
-			return errors.Annotatef(err, "saving info for machine %q", machineName)
+	// Parents are inserted first, so that containers are recorded against
+	// their host machine.
+	var err error
+	parent := names.NewMachineTag(machineName).Parent()
+	if parent == nil {
+		_, err = a.machineService.CreateMachine(ctx, machine.Name(machineName))
+	} else {
+		if err := a.saveMachineInfo(ctx, parent.Id()); err != nil {
+			return errors.Trace(err)
 		}
-		parent := names.NewMachineTag(machineName).Parent()
-		if parent == nil {
-			break
-		}
-		machineName = parent.Id()
+		_, err = a.machineService.CreateMachineWithParent(ctx, machine.Name(machineName), machine.Name(parent.Id()))
+	}
+	// The machine might already exist e.g. if we are adding a subordinate
+	// unit to an already existing machine. In this case, just continue
+	// without error.
+	if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
+		return errors.Annotatef(err, "saving info for machine %q", machineName)
 	}
 	return nil
 }
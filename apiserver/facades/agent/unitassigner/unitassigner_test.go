@@ -81,6 +81,11 @@ func (f *fakeMachineService) CreateMachine(_ context.Context, machineName machin
 	return "", nil
 }
 
+func (f *fakeMachineService) CreateMachineWithParent(_ context.Context, machineName, _ machine.Name) (string, error) {
+	f.machineNames = append(f.machineNames, machineName)
+	return "", nil
+}
+
 type fakeNetworkService struct {
 }
 
@@ -277,7 +277,7 @@ func (n *NetworkInfoBase) resolveHostAddress(hostName string) string {
 	// This check is probably no longer necessary, but is preserved here
 	// conservatively.
 	for _, addr := range resolved {
-		if ip := net.ParseIP(addr); ip != nil && !ip.IsLoopback() {
+		if net.ParseIP(addr) != nil && network.ClassifyAddress(addr, nil) != network.ScopeMachineLocal {
 			return addr
 		}
 	}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/agent/upgrader (interfaces: ControllerConfigGetter,ModelAgentService)
+// Source: github.com/juju/juju/apiserver/facades/agent/upgrader (interfaces: ControllerConfigGetter,ModelAgentService,UpgradeService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package upgrader_test -destination domain_mock_test.go github.com/juju/juju/apiserver/facades/agent/upgrader ControllerConfigGetter,ModelAgentService
+//	mockgen -typed -package upgrader_test -destination domain_mock_test.go github.com/juju/juju/apiserver/facades/agent/upgrader ControllerConfigGetter,ModelAgentService,UpgradeService
 //
 
 // Package upgrader_test is a generated GoMock package.
@@ -15,7 +15,9 @@ import (
 
 	controller "github.com/juju/juju/controller"
 	machine "github.com/juju/juju/core/machine"
+	model "github.com/juju/juju/core/model"
 	watcher "github.com/juju/juju/core/watcher"
+	upgrade "github.com/juju/juju/domain/upgrade"
 	version "github.com/juju/version/v2"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -338,3 +340,64 @@ func (c *MockModelAgentServiceWatchUnitTargetAgentVersionCall) DoAndReturn(f fun
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockUpgradeService is a mock of UpgradeService interface.
+type MockUpgradeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpgradeServiceMockRecorder
+}
+
+// MockUpgradeServiceMockRecorder is the mock recorder for MockUpgradeService.
+type MockUpgradeServiceMockRecorder struct {
+	mock *MockUpgradeService
+}
+
+// NewMockUpgradeService creates a new mock instance.
+func NewMockUpgradeService(ctrl *gomock.Controller) *MockUpgradeService {
+	mock := &MockUpgradeService{ctrl: ctrl}
+	mock.recorder = &MockUpgradeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpgradeService) EXPECT() *MockUpgradeServiceMockRecorder {
+	return m.recorder
+}
+
+// SetAgentUpgradeState mocks base method.
+func (m *MockUpgradeService) SetAgentUpgradeState(arg0 context.Context, arg1 model.UUID, arg2 upgrade.AgentUpgradeState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgentUpgradeState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAgentUpgradeState indicates an expected call of SetAgentUpgradeState.
+func (mr *MockUpgradeServiceMockRecorder) SetAgentUpgradeState(arg0, arg1, arg2 any) *MockUpgradeServiceSetAgentUpgradeStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgentUpgradeState", reflect.TypeOf((*MockUpgradeService)(nil).SetAgentUpgradeState), arg0, arg1, arg2)
+	return &MockUpgradeServiceSetAgentUpgradeStateCall{Call: call}
+}
+
+// MockUpgradeServiceSetAgentUpgradeStateCall wrap *gomock.Call
+type MockUpgradeServiceSetAgentUpgradeStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUpgradeServiceSetAgentUpgradeStateCall) Return(arg0 error) *MockUpgradeServiceSetAgentUpgradeStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUpgradeServiceSetAgentUpgradeStateCall) Do(f func(context.Context, model.UUID, upgrade.AgentUpgradeState) error) *MockUpgradeServiceSetAgentUpgradeStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUpgradeServiceSetAgentUpgradeStateCall) DoAndReturn(f func(context.Context, model.UUID, upgrade.AgentUpgradeState) error) *MockUpgradeServiceSetAgentUpgradeStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
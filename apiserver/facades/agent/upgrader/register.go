@@ -57,6 +57,7 @@ func newUpgraderFacade(ctx facade.ModelContext) (Upgrader, error) {
 	credentialService := domainServices.Credential()
 	modelAgentService := domainServices.Agent()
 	modelConfigService := domainServices.Config()
+	upgradeService := domainServices.Upgrade()
 	switch tag.(type) {
 	case names.MachineTag, names.ControllerAgentTag, names.ApplicationTag, names.ModelTag:
 		return NewUpgraderAPI(
@@ -69,6 +70,7 @@ func newUpgraderFacade(ctx facade.ModelContext) (Upgrader, error) {
 			credentialService,
 			modelConfigService,
 			modelAgentService,
+			upgradeService,
 			ctx.ControllerObjectStore(),
 			ctx.WatcherRegistry(),
 		)
@@ -84,11 +86,12 @@ func newUpgraderFacade(ctx facade.ModelContext) (Upgrader, error) {
 				credentialService,
 				modelConfigService,
 				modelAgentService,
+				upgradeService,
 				ctx.ControllerObjectStore(),
 				ctx.WatcherRegistry(),
 			)
 		}
-		return NewUnitUpgraderAPI(ctx, modelAgentService, ctx.WatcherRegistry())
+		return NewUnitUpgraderAPI(ctx, modelAgentService, upgradeService, ctx.WatcherRegistry())
 	}
 	// Not a machine or unit.
 	return nil, apiservererrors.ErrPerm
@@ -9,7 +9,9 @@ import (
 	"github.com/juju/version/v2"
 
 	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/upgrade"
 )
 
 // ModelAgentService provides access to the Juju agent version for the model.
@@ -55,3 +57,11 @@ type ModelAgentService interface {
 	// - [modelerrors.NotFound] - When the model of the unit no longer exists.
 	WatchUnitTargetAgentVersion(ctx context.Context, unitName string) (watcher.NotifyWatcher, error)
 }
+
+// UpgradeService provides access to the progress of agents through an agent
+// binary upgrade.
+type UpgradeService interface {
+	// SetAgentUpgradeState records the latest progress of an agent in the
+	// model through an agent binary upgrade.
+	SetAgentUpgradeState(ctx context.Context, modelUUID model.UUID, agentState upgrade.AgentUpgradeState) error
+}
@@ -24,6 +24,7 @@ import (
 // UnitUpgraderAPI provides access to the UnitUpgrader API facade.
 type UnitUpgraderAPI struct {
 	*common.ToolsSetter
+	*AgentUpgradeStateSetter
 
 	st                *state.State
 	authorizer        facade.Authorizer
@@ -35,6 +36,7 @@ type UnitUpgraderAPI struct {
 func NewUnitUpgraderAPI(
 	ctx facade.ModelContext,
 	modelAgentService ModelAgentService,
+	upgradeService UpgradeService,
 	watcherRegistry facade.WatcherRegistry,
 ) (*UnitUpgraderAPI, error) {
 	authorizer := ctx.Auth()
@@ -53,6 +55,10 @@ func NewUnitUpgraderAPI(
 		authorizer:        authorizer,
 		modelAgentService: modelAgentService,
 		watcherRegistry:   watcherRegistry,
+
+		AgentUpgradeStateSetter: NewAgentUpgradeStateSetter(
+			ctx.ModelUUID(), authorizer, upgradeService,
+		),
 	}, nil
 }
 
@@ -41,6 +41,7 @@ type unitUpgraderSuite struct {
 	authorizer apiservertesting.FakeAuthorizer
 
 	agentService    *MockModelAgentService
+	upgradeService  *MockUpgradeService
 	watcherRegistry *facademocks.MockWatcherRegistry
 }
 
@@ -88,7 +89,7 @@ func (s *unitUpgraderSuite) SetUpTest(c *gc.C) {
 	s.upgrader, err = upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: st,
 		Auth_:  s.authorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -136,7 +137,7 @@ func (s *unitUpgraderSuite) TestUpgraderAPIRefusesNonUnitAgent(c *gc.C) {
 	anUpgrader, err := upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: s.ControllerModel(c).State(),
 		Auth_:  anAuthorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Check(err, gc.NotNil)
 	c.Check(anUpgrader, gc.IsNil)
 	c.Assert(err, gc.ErrorMatches, "permission denied")
@@ -150,7 +151,7 @@ func (s *unitUpgraderSuite) TestWatchAPIVersionRefusesWrongAgent(c *gc.C) {
 	anUpgrader, err := upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: s.ControllerModel(c).State(),
 		Auth_:  anAuthorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Check(err, jc.ErrorIsNil)
 	args := params.Entities{
 		Entities: []params.Entity{{Tag: s.rawUnit.Tag().String()}},
@@ -176,7 +177,7 @@ func (s *unitUpgraderSuite) TestToolsRefusesWrongAgent(c *gc.C) {
 	anUpgrader, err := upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: s.ControllerModel(c).State(),
 		Auth_:  anAuthorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Check(err, jc.ErrorIsNil)
 	args := params.Entities{
 		Entities: []params.Entity{{Tag: s.rawUnit.Tag().String()}},
@@ -225,7 +226,7 @@ func (s *unitUpgraderSuite) TestSetToolsRefusesWrongAgent(c *gc.C) {
 	anUpgrader, err := upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: s.ControllerModel(c).State(),
 		Auth_:  anAuthorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Check(err, jc.ErrorIsNil)
 	args := params.EntitiesVersion{
 		AgentTools: []params.EntityVersion{{
@@ -286,7 +287,7 @@ func (s *unitUpgraderSuite) TestDesiredVersionRefusesWrongAgent(c *gc.C) {
 	anUpgrader, err := upgrader.NewUnitUpgraderAPI(facadetest.ModelContext{
 		State_: s.ControllerModel(c).State(),
 		Auth_:  anAuthorizer,
-	}, s.agentService, s.watcherRegistry)
+	}, s.agentService, s.upgradeService, s.watcherRegistry)
 	c.Check(err, jc.ErrorIsNil)
 	args := params.Entities{
 		Entities: []params.Entity{{Tag: s.rawUnit.Tag().String()}},
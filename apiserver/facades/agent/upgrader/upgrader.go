@@ -17,6 +17,7 @@ import (
 	"github.com/juju/juju/controller"
 	corelogger "github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/core/watcher"
@@ -38,12 +39,14 @@ type Upgrader interface {
 	DesiredVersion(ctx context.Context, args params.Entities) (params.VersionResults, error)
 	Tools(ctx context.Context, args params.Entities) (params.ToolsResults, error)
 	SetTools(ctx context.Context, args params.EntitiesVersion) (params.ErrorResults, error)
+	SetAgentUpgradeStates(ctx context.Context, args params.SetAgentUpgradeStates) (params.ErrorResults, error)
 }
 
 // UpgraderAPI provides access to the Upgrader API facade.
 type UpgraderAPI struct {
 	*common.ToolsGetter
 	*common.ToolsSetter
+	*AgentUpgradeStateSetter
 
 	st                *state.State
 	m                 *state.Model
@@ -64,6 +67,7 @@ func NewUpgraderAPI(
 	credentialService common.CredentialService,
 	modelConfigService common.ModelConfigService,
 	modelAgentService ModelAgentService,
+	upgradeService UpgradeService,
 	controllerStore objectstore.ObjectStore,
 	watcherRegistry facade.WatcherRegistry,
 ) (*UpgraderAPI, error) {
@@ -91,6 +95,10 @@ func NewUpgraderAPI(
 		logger:            logger,
 		modelAgentService: modelAgentService,
 		watcherRegistry:   watcherRegistry,
+
+		AgentUpgradeStateSetter: NewAgentUpgradeStateSetter(
+			coremodel.UUID(model.UUID()), authorizer, upgradeService,
+		),
 	}, nil
 }
 
@@ -45,6 +45,7 @@ type upgraderSuite struct {
 
 	controllerConfigGetter *MockControllerConfigGetter
 	agentService           *MockModelAgentService
+	upgradeService         *MockUpgradeService
 	isUpgrader             *MockUpgrader
 	watcherRegistry        *facademocks.MockWatcherRegistry
 }
@@ -94,6 +95,7 @@ func (s *upgraderSuite) SetUpTest(c *gc.C) {
 		domainServices.Credential(),
 		domainServices.Config(),
 		domainServices.Agent(),
+		domainServices.Upgrade(),
 		s.store,
 		s.watcherRegistry,
 	)
@@ -132,6 +134,7 @@ func (s *upgraderSuite) TestToolsRefusesWrongAgent(c *gc.C) {
 		domainServices.Credential(),
 		domainServices.Config(),
 		domainServices.Agent(),
+		domainServices.Upgrade(),
 		s.store,
 		s.watcherRegistry,
 	)
@@ -221,6 +224,7 @@ func (s *upgraderSuite) TestSetToolsRefusesWrongAgent(c *gc.C) {
 		domainServices.Credential(),
 		domainServices.Config(),
 		domainServices.Agent(),
+		domainServices.Upgrade(),
 		s.store,
 		s.watcherRegistry,
 	)
@@ -294,6 +298,7 @@ func (s *upgraderSuite) TestDesiredVersionRefusesWrongAgent(c *gc.C) {
 		domainServices.Credential(),
 		domainServices.Config(),
 		domainServices.Agent(),
+		domainServices.Upgrade(),
 		s.store,
 		s.watcherRegistry,
 	)
@@ -364,7 +369,7 @@ func (s *upgraderSuite) TestDesiredVersionUnrestrictedForAPIAgents(c *gc.C) {
 		domainServices.Cloud(),
 		domainServices.Credential(),
 		domainServices.Config(),
-		s.agentService, s.store, s.watcherRegistry,
+		s.agentService, s.upgradeService, s.store, s.watcherRegistry,
 	)
 	c.Assert(err, jc.ErrorIsNil)
 	args := params.Entities{Entities: []params.Entity{{Tag: s.apiMachine.Tag().String()}}}
@@ -395,6 +400,7 @@ func (s *upgraderSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	s.controllerConfigGetter = NewMockControllerConfigGetter(ctrl)
 	s.agentService = NewMockModelAgentService(ctrl)
+	s.upgradeService = NewMockUpgradeService(ctrl)
 	s.isUpgrader = NewMockUpgrader(ctrl)
 	s.isUpgrader.EXPECT().IsUpgrading().Return(false, nil).AnyTimes()
 	s.watcherRegistry = facademocks.NewMockWatcherRegistry(ctrl)
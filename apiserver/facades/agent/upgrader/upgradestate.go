@@ -0,0 +1,71 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package upgrader
+
+import (
+	"context"
+
+	"github.com/juju/names/v5"
+
+	apiservererrors "github.com/juju/juju/apiserver/errors"
+	"github.com/juju/juju/apiserver/facade"
+	"github.com/juju/juju/core/model"
+	coreupgrade "github.com/juju/juju/core/upgrade"
+	"github.com/juju/juju/domain/upgrade"
+	"github.com/juju/juju/rpc/params"
+)
+
+// AgentUpgradeStateSetter implements a common SetAgentUpgradeStates method
+// for use by the upgrader facades.
+type AgentUpgradeStateSetter struct {
+	modelUUID      model.UUID
+	authorizer     facade.Authorizer
+	upgradeService UpgradeService
+}
+
+// NewAgentUpgradeStateSetter returns a new AgentUpgradeStateSetter for the
+// agents of the model with the given UUID.
+func NewAgentUpgradeStateSetter(
+	modelUUID model.UUID,
+	authorizer facade.Authorizer,
+	upgradeService UpgradeService,
+) *AgentUpgradeStateSetter {
+	return &AgentUpgradeStateSetter{
+		modelUUID:      modelUUID,
+		authorizer:     authorizer,
+		upgradeService: upgradeService,
+	}
+}
+
+// SetAgentUpgradeStates records the progress of each given agent through an
+// agent binary upgrade. Agents may only record their own progress.
+func (s *AgentUpgradeStateSetter) SetAgentUpgradeStates(ctx context.Context, args params.SetAgentUpgradeStates) (params.ErrorResults, error) {
+	results := params.ErrorResults{
+		Results: make([]params.ErrorResult, len(args.States)),
+	}
+	for i, arg := range args.States {
+		tag, err := names.ParseTag(arg.Tag)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		if !s.authorizer.AuthOwner(tag) {
+			results.Results[i].Error = apiservererrors.ServerError(apiservererrors.ErrPerm)
+			continue
+		}
+		state, err := coreupgrade.ParseAgentState(arg.State)
+		if err != nil {
+			results.Results[i].Error = apiservererrors.ServerError(err)
+			continue
+		}
+		err = s.upgradeService.SetAgentUpgradeState(ctx, s.modelUUID, upgrade.AgentUpgradeState{
+			AgentName:      tag.String(),
+			CurrentVersion: arg.CurrentVersion,
+			TargetVersion:  arg.TargetVersion,
+			State:          state,
+		})
+		results.Results[i].Error = apiservererrors.ServerError(err)
+	}
+	return results, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package upgrader_test
+
+import (
+	"context"
+
+	"github.com/juju/names/v5"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/version/v2"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/apiserver/facades/agent/upgrader"
+	apiservertesting "github.com/juju/juju/apiserver/testing"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	coreupgrade "github.com/juju/juju/core/upgrade"
+	domainupgrade "github.com/juju/juju/domain/upgrade"
+	"github.com/juju/juju/rpc/params"
+)
+
+type agentUpgradeStateSuite struct {
+	upgradeService *MockUpgradeService
+}
+
+var _ = gc.Suite(&agentUpgradeStateSuite{})
+
+func (s *agentUpgradeStateSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.upgradeService = NewMockUpgradeService(ctrl)
+	return ctrl
+}
+
+func (s *agentUpgradeStateSuite) TestSetAgentUpgradeStates(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	authorizer := apiservertesting.FakeAuthorizer{
+		Tag: names.NewMachineTag("0"),
+	}
+	s.upgradeService.EXPECT().SetAgentUpgradeState(gomock.Any(), modelUUID, domainupgrade.AgentUpgradeState{
+		AgentName:      "machine-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          coreupgrade.AgentStaging,
+	}).Return(nil)
+
+	setter := upgrader.NewAgentUpgradeStateSetter(modelUUID, authorizer, s.upgradeService)
+	results, err := setter.SetAgentUpgradeStates(context.Background(), params.SetAgentUpgradeStates{
+		States: []params.AgentUpgradeState{{
+			Tag:            "machine-0",
+			CurrentVersion: version.MustParse("4.0.0"),
+			TargetVersion:  version.MustParse("4.0.1"),
+			State:          "staging",
+		}, {
+			Tag:            "machine-1",
+			CurrentVersion: version.MustParse("4.0.0"),
+			TargetVersion:  version.MustParse("4.0.1"),
+			State:          "staging",
+		}, {
+			Tag:            "machine-0",
+			CurrentVersion: version.MustParse("4.0.0"),
+			TargetVersion:  version.MustParse("4.0.1"),
+			State:          "bogus",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 3)
+	c.Check(results.Results[0].Error, gc.IsNil)
+	c.Check(results.Results[1].Error, jc.DeepEquals, apiservertesting.ErrUnauthorized)
+	c.Check(results.Results[2].Error, gc.ErrorMatches, `unknown agent upgrade state "bogus"`)
+}
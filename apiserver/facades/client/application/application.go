@@ -1657,6 +1657,7 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 	if err := api.check.ChangeAllowed(ctx); err != nil {
 		return params.ScaleApplicationResults{}, errors.Trace(err)
 	}
+	actor := api.authorizer.GetAuthTag().String()
 	scaleApplication := func(arg params.ScaleApplicationParams) (*params.ScaleApplicationInfo, error) {
 		if arg.Scale < 0 && arg.ScaleChange == 0 {
 			return nil, errors.NotValidf("scale < 0")
@@ -1672,13 +1673,13 @@ func (api *APIBase) ScaleApplications(ctx context.Context, args params.ScaleAppl
 
 		var info params.ScaleApplicationInfo
 		if arg.ScaleChange != 0 {
-			newScale, err := api.applicationService.ChangeApplicationScale(ctx, name, arg.ScaleChange)
+			newScale, err := api.applicationService.ChangeApplicationScale(ctx, name, arg.ScaleChange, actor)
 			if err != nil {
 				return nil, errors.Trace(err)
 			}
 			info.Scale = newScale
 		} else {
-			if err := api.applicationService.SetApplicationScale(ctx, name, arg.Scale); err != nil {
+			if err := api.applicationService.SetApplicationScale(ctx, name, arg.Scale, actor); err != nil {
 				return nil, errors.Trace(err)
 			}
 			info.Scale = arg.Scale
@@ -271,19 +271,23 @@ func (api *APIBase) addUnits(
 
 func saveMachineInfo(ctx context.Context, machineService MachineService, machineName string) error {
 	// This is temporary - just insert the machine id and all the parent ones.
-	for machineName != "" {
-		_, err := machineService.CreateMachine(ctx, machine.Name(machineName))
-		// The machine might already exist e.g. if we are adding a subordinate
-		// unit to an already existing machine. In this case, just continue
-		// without error.
-		if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
-			return errors.Annotatef(err, "saving info for machine %q", machineName)
+	// Parents are inserted first, so that containers are recorded against
+	// their host machine.
+	var err error
+	parent := names.NewMachineTag(machineName).Parent()
+	if parent == nil {
+		_, err = machineService.CreateMachine(ctx, machine.Name(machineName))
+	} else {
+		if err := saveMachineInfo(ctx, machineService, parent.Id()); err != nil {
+			return errors.Trace(err)
 		}
-		parent := names.NewMachineTag(machineName).Parent()
-		if parent == nil {
-			break
-		}
-		machineName = parent.Id()
+		_, err = machineService.CreateMachineWithParent(ctx, machine.Name(machineName), machine.Name(parent.Id()))
+	}
+	// The machine might already exist e.g. if we are adding a subordinate
+	// unit to an already existing machine. In this case, just continue
+	// without error.
+	if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
+		return errors.Annotatef(err, "saving info for machine %q", machineName)
 	}
 	return nil
 }
@@ -105,6 +105,9 @@ type NetworkService interface {
 type MachineService interface {
 	// CreateMachine creates the specified machine.
 	CreateMachine(context.Context, machine.Name) (string, error)
+	// CreateMachineWithParent creates the specified container machine on
+	// its parent machine.
+	CreateMachineWithParent(context.Context, machine.Name, machine.Name) (string, error)
 	// GetMachineUUID returns the UUID of a machine identified by its name.
 	GetMachineUUID(ctx context.Context, name machine.Name) (string, error)
 	// HardwareCharacteristics returns the hardware characteristics of the
@@ -123,10 +126,10 @@ type ApplicationService interface {
 	UpdateApplicationCharm(ctx context.Context, name string, params applicationservice.UpdateCharmParams) error
 	// SetApplicationScale sets the application's desired scale value.
 	// This is used on CAAS models.
-	SetApplicationScale(ctx context.Context, name string, scale int) error
+	SetApplicationScale(ctx context.Context, name string, scale int, actor string) error
 	// ChangeApplicationScale alters the existing scale by the provided change amount, returning the new amount.
 	// This is used on CAAS models.
-	ChangeApplicationScale(ctx context.Context, name string, scaleChange int) (int, error)
+	ChangeApplicationScale(ctx context.Context, name string, scaleChange int, actor string) (int, error)
 
 	// DestroyApplication prepares an application for removal from the model.
 	DestroyApplication(ctx context.Context, name string) error
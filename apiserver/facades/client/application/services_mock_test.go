@@ -698,6 +698,45 @@ func (c *MockMachineServiceCreateMachineCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// CreateMachineWithParent mocks base method.
+func (m *MockMachineService) CreateMachineWithParent(arg0 context.Context, arg1, arg2 machine.Name) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMachineWithParent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMachineWithParent indicates an expected call of CreateMachineWithParent.
+func (mr *MockMachineServiceMockRecorder) CreateMachineWithParent(arg0, arg1, arg2 any) *MockMachineServiceCreateMachineWithParentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachineWithParent", reflect.TypeOf((*MockMachineService)(nil).CreateMachineWithParent), arg0, arg1, arg2)
+	return &MockMachineServiceCreateMachineWithParentCall{Call: call}
+}
+
+// MockMachineServiceCreateMachineWithParentCall wrap *gomock.Call
+type MockMachineServiceCreateMachineWithParentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceCreateMachineWithParentCall) Return(arg0 string, arg1 error) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceCreateMachineWithParentCall) Do(f func(context.Context, machine.Name, machine.Name) (string, error)) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceCreateMachineWithParentCall) DoAndReturn(f func(context.Context, machine.Name, machine.Name) (string, error)) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetMachineUUID mocks base method.
 func (m *MockMachineService) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (string, error) {
 	m.ctrl.T.Helper()
@@ -843,18 +882,18 @@ func (c *MockApplicationServiceAddUnitsCall) DoAndReturn(f func(context.Context,
 }
 
 // ChangeApplicationScale mocks base method.
-func (m *MockApplicationService) ChangeApplicationScale(arg0 context.Context, arg1 string, arg2 int) (int, error) {
+func (m *MockApplicationService) ChangeApplicationScale(arg0 context.Context, arg1 string, arg2 int, arg3 string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeApplicationScale", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "ChangeApplicationScale", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeApplicationScale indicates an expected call of ChangeApplicationScale.
-func (mr *MockApplicationServiceMockRecorder) ChangeApplicationScale(arg0, arg1, arg2 any) *MockApplicationServiceChangeApplicationScaleCall {
+func (mr *MockApplicationServiceMockRecorder) ChangeApplicationScale(arg0, arg1, arg2, arg3 any) *MockApplicationServiceChangeApplicationScaleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).ChangeApplicationScale), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).ChangeApplicationScale), arg0, arg1, arg2, arg3)
 	return &MockApplicationServiceChangeApplicationScaleCall{Call: call}
 }
 
@@ -870,13 +909,13 @@ func (c *MockApplicationServiceChangeApplicationScaleCall) Return(arg0 int, arg1
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationServiceChangeApplicationScaleCall) Do(f func(context.Context, string, int) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
+func (c *MockApplicationServiceChangeApplicationScaleCall) Do(f func(context.Context, string, int, string) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationServiceChangeApplicationScaleCall) DoAndReturn(f func(context.Context, string, int) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
+func (c *MockApplicationServiceChangeApplicationScaleCall) DoAndReturn(f func(context.Context, string, int, string) (int, error)) *MockApplicationServiceChangeApplicationScaleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1510,17 +1549,17 @@ func (c *MockApplicationServiceIsCharmAvailableCall) DoAndReturn(f func(context.
 }
 
 // SetApplicationScale mocks base method.
-func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int) error {
+func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetApplicationScale", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetApplicationScale", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetApplicationScale indicates an expected call of SetApplicationScale.
-func (mr *MockApplicationServiceMockRecorder) SetApplicationScale(arg0, arg1, arg2 any) *MockApplicationServiceSetApplicationScaleCall {
+func (mr *MockApplicationServiceMockRecorder) SetApplicationScale(arg0, arg1, arg2, arg3 any) *MockApplicationServiceSetApplicationScaleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationScale), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationScale), arg0, arg1, arg2, arg3)
 	return &MockApplicationServiceSetApplicationScaleCall{Call: call}
 }
 
@@ -1536,13 +1575,13 @@ func (c *MockApplicationServiceSetApplicationScaleCall) Return(arg0 error) *Mock
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationServiceSetApplicationScaleCall) Do(f func(context.Context, string, int) error) *MockApplicationServiceSetApplicationScaleCall {
+func (c *MockApplicationServiceSetApplicationScaleCall) Do(f func(context.Context, string, int, string) error) *MockApplicationServiceSetApplicationScaleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationServiceSetApplicationScaleCall) DoAndReturn(f func(context.Context, string, int) error) *MockApplicationServiceSetApplicationScaleCall {
+func (c *MockApplicationServiceSetApplicationScaleCall) DoAndReturn(f func(context.Context, string, int, string) error) *MockApplicationServiceSetApplicationScaleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
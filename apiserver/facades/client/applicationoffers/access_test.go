@@ -6,6 +6,7 @@ package applicationoffers_test
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/go-macaroon-bakery/macaroon-bakery/v3/bakery"
 	"github.com/juju/clock"
@@ -25,6 +26,7 @@ import (
 	access "github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	pscontroller "github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/uuid"
 	"github.com/juju/juju/rpc/params"
@@ -63,7 +65,7 @@ func (s *offerAccessSuite) setupAPI(c *gc.C) {
 		s.mockState, s.mockStatePool,
 		s.mockAccessService,
 		s.mockModelDomainServicesGetter,
-		s.authorizer, s.authContext,
+		s.authorizer, s.authContext, s.hub,
 		c.MkDir(), loggertesting.WrapCheckLog(c),
 		testing.ControllerTag.Id(), model.UUID(testing.ModelTag.Id()),
 	)
@@ -160,6 +162,31 @@ func (s *offerAccessSuite) TestRevokePermission(c *gc.C) {
 	c.Assert(err, gc.IsNil)
 }
 
+func (s *offerAccessSuite) TestRevokePermissionPublishesEvent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	s.setupAPI(c)
+
+	done := make(chan string, 1)
+	s.hub.Subscribe(pscontroller.PermissionsChanged, func(topic string, data pscontroller.PermissionsChangedMessage, err error) {
+		c.Check(err, jc.ErrorIsNil)
+		done <- data.UserName
+	})
+
+	s.setupOffer("uuid", "test", "admin", "someoffer")
+	user := names.NewUserTag("foobar")
+	s.mockAccessService.EXPECT().UpdatePermission(gomock.Any(), gomock.Any()).Return(nil)
+
+	err := s.revoke(c, user, params.OfferReadAccess, "test.someoffer")
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case name := <-done:
+		c.Check(name, gc.Equals, "foobar")
+	case <-time.After(testing.LongWait):
+		c.Fatal("no event sent")
+	}
+}
+
 func (s *offerAccessSuite) TestGrantPermission(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.setupAPI(c)
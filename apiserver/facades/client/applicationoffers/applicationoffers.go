@@ -22,6 +22,7 @@ import (
 	access "github.com/juju/juju/domain/access"
 	accesserrors "github.com/juju/juju/domain/access/errors"
 	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/rpc/params"
 )
 
@@ -31,6 +32,7 @@ type OffersAPIv5 struct {
 	BaseAPI
 	dataDir     string
 	authContext *commoncrossmodel.AuthContext
+	hub         facade.Hub
 }
 
 // createAPI returns a new application offers OffersAPI facade.
@@ -43,6 +45,7 @@ func createOffersAPI(
 	modelDomainServicesGetter ModelDomainServicesGetter,
 	authorizer facade.Authorizer,
 	authContext *commoncrossmodel.AuthContext,
+	hub facade.Hub,
 	dataDir string,
 	logger corelogger.Logger,
 	controllerUUID string,
@@ -55,6 +58,7 @@ func createOffersAPI(
 	api := &OffersAPIv5{
 		dataDir:     dataDir,
 		authContext: authContext,
+		hub:         hub,
 		BaseAPI: BaseAPI{
 			Authorizer:                authorizer,
 			GetApplicationOffers:      getApplicationOffers,
@@ -343,7 +347,22 @@ func (api *OffersAPIv5) changeOfferAccess(
 		Change:  change,
 		Subject: targetUserName,
 	})
-	return errors.Annotatef(err, "could not %s offer access for %q", change, targetUserName)
+	if err != nil {
+		return errors.Annotatef(err, "could not %s offer access for %q", change, targetUserName)
+	}
+	api.publishPermissionsChanged(targetUserTag)
+	return nil
+}
+
+// publishPermissionsChanged announces that the user's access has changed, so
+// that API connections stop using the permissions they have cached for them.
+func (api *OffersAPIv5) publishPermissionsChanged(userTag names.UserTag) {
+	_, err := api.hub.Publish(
+		controller.PermissionsChanged,
+		controller.PermissionsChangedMessage{UserName: userTag.Id()})
+	if err != nil {
+		api.logger.Warningf("announcing access change for %q: %v", userTag.Id(), err)
+	}
 }
 
 // ApplicationOffers gets details about remote applications that match given URLs.
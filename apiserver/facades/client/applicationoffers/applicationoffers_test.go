@@ -72,7 +72,7 @@ func (s *applicationOffersSuite) setupAPI(c *gc.C) {
 		getApplicationOffers, getFakeControllerInfo,
 		s.mockState, s.mockStatePool, s.mockAccessService,
 		s.mockModelDomainServicesGetter,
-		s.authorizer, s.authContext,
+		s.authorizer, s.authContext, s.hub,
 		c.MkDir(), loggertesting.WrapCheckLog(c),
 		testing.ControllerTag.Id(), model.UUID(testing.ModelTag.Id()))
 	c.Assert(err, jc.ErrorIsNil)
@@ -1317,7 +1317,7 @@ func (s *consumeSuite) setupAPI(c *gc.C) {
 		getApplicationOffers, getFakeControllerInfo,
 		s.mockState, s.mockStatePool, s.mockAccessService,
 		s.mockModelDomainServicesGetter,
-		s.authorizer, s.authContext,
+		s.authorizer, s.authContext, s.hub,
 		c.MkDir(),
 		loggertesting.WrapCheckLog(c),
 		testing.ControllerTag.Id(), model.UUID(testing.ModelTag.Id()),
@@ -5,6 +5,7 @@ package applicationoffers_test
 
 import (
 	"github.com/juju/names/v5"
+	"github.com/juju/pubsub/v2"
 	jtesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
@@ -40,6 +41,7 @@ type baseSuite struct {
 	mockModelDomainServicesGetter *MockModelDomainServicesGetter
 	mockModelDomainServices       *MockModelDomainServices
 	mockApplicationService        *MockApplicationService
+	hub                           *pubsub.StructuredHub
 }
 
 func (s *baseSuite) SetUpTest(c *gc.C) {
@@ -56,6 +58,7 @@ func (s *baseSuite) SetUpTest(c *gc.C) {
 		relationNetworks:  &mockRelationNetworks{},
 	}
 	s.mockStatePool = &mockStatePool{map[string]applicationoffers.Backend{s.mockState.modelUUID: s.mockState}}
+	s.hub = pubsub.NewStructuredHub(nil)
 }
 
 func (s *baseSuite) setupMocks(c *gc.C) *gomock.Controller {
@@ -46,6 +46,7 @@ func makeOffersAPI(ctx context.Context, facadeContext facade.MultiModelContext)
 		newModelDomainServicesGetter(facadeContext),
 		facadeContext.Auth(),
 		authContext.(*commoncrossmodel.AuthContext),
+		facadeContext.Hub(),
 		facadeContext.DataDir(),
 		facadeContext.Logger().Child("applicationoffers"),
 		facadeContext.ControllerUUID(),
@@ -5,6 +5,7 @@ package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/juju/core/blockdevice"
 	"github.com/juju/juju/core/instance"
@@ -12,6 +13,8 @@ import (
 	"github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/unit"
+	"github.com/juju/juju/domain/application"
+	applicationservice "github.com/juju/juju/domain/application/service"
 	domainmodel "github.com/juju/juju/domain/model"
 	"github.com/juju/juju/domain/port"
 )
@@ -50,9 +53,10 @@ type MachineService interface {
 	// InstanceIDAndName returns the cloud specific instance ID and display name for
 	// this machine.
 	InstanceIDAndName(ctx context.Context, machineUUID string) (instance.Id, string, error)
-	// HardwareCharacteristics returns the hardware characteristics of the
-	// specified machine.
-	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
+	// GetMachineHardwareCharacteristics returns the hardware characteristics
+	// of the specified machine's instance, cached to spare calls to the
+	// provider.
+	GetMachineHardwareCharacteristics(ctx context.Context, machineUUID string) (instance.HardwareCharacteristics, error)
 	// AppliedLXDProfiles returns the names of the LXD profiles on the machine.
 	AppliedLXDProfileNames(ctx context.Context, machineUUID string) ([]string, error)
 }
@@ -62,6 +66,20 @@ type MachineService interface {
 type ApplicationService interface {
 	// GetUnitUUID returns the UUID for the named unit
 	GetUnitUUID(context.Context, unit.Name) (unit.UUID, error)
+
+	// GetLatestApplicationScaleChanges returns the time of the most recent
+	// change to the desired scale of each application, keyed by application
+	// name.
+	GetLatestApplicationScaleChanges(ctx context.Context) (map[string]time.Time, error)
+
+	// ListApplicationsWithPendingCharmUpgrades returns the applications for
+	// which a newer revision of their charm is available. If channel is not
+	// empty, only applications tracking that channel are returned.
+	ListApplicationsWithPendingCharmUpgrades(ctx context.Context, channel string) ([]application.ApplicationPendingUpgrade, error)
+
+	// GetApplicationStatus returns the status of the named application
+	// derived from the statuses of its units.
+	GetApplicationStatus(ctx context.Context, appName string) (applicationservice.ApplicationStatusInfo, error)
 }
 
 // PortService defines the methods that the facade assumes from the Port service.
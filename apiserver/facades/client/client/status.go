@@ -30,6 +30,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	domainmodelerrors "github.com/juju/juju/domain/model/errors"
 	"github.com/juju/juju/domain/port"
@@ -255,6 +256,15 @@ func (c *Client) FullStatus(ctx context.Context, args params.StatusParams) (para
 	if err = context.fetchAllOpenPortRanges(ctx, c.portService); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch open port ranges")
 	}
+	if err = context.fetchScaleChanges(ctx, c.applicationService); err != nil {
+		return noStatus, errors.Annotate(err, "could not fetch application scale changes")
+	}
+	if err = context.fetchPendingCharmUpgrades(ctx, c.applicationService); err != nil {
+		return noStatus, errors.Annotate(err, "could not fetch pending charm upgrades")
+	}
+	if err = context.fetchUnitDerivedStatuses(ctx, c.applicationService); err != nil {
+		return noStatus, errors.Annotate(err, "could not fetch application statuses")
+	}
 	if context.controllerNodes, err = fetchControllerNodes(c.stateAccessor); err != nil {
 		return noStatus, errors.Annotate(err, "could not fetch controller nodes")
 	}
@@ -652,6 +662,17 @@ type statusContext struct {
 	// allOpenPortRanges: all open port ranges in the model, grouped by unit name.
 	allOpenPortRanges port.UnitGroupedPortRanges
 
+	// scaleChanged: application name -> time of the last scale change
+	scaleChanged map[string]time.Time
+
+	// latestCharmRevisions: application name -> latest charm revision
+	// available, for applications with a pending charm upgrade
+	latestCharmRevisions map[string]int
+
+	// unitDerivedStatuses: application name -> status derived from the
+	// statuses of its units, in an IAAS model
+	unitDerivedStatuses map[string]status.StatusInfo
+
 	// offers: offer name -> offer
 	offers map[string]offerStatus
 
@@ -717,6 +738,60 @@ func (context *statusContext) fetchAllOpenPortRanges(ctx context.Context, portSe
 	return err
 }
 
+// fetchScaleChanges records the time of the last scale change of each
+// application in a CAAS model.
+func (context *statusContext) fetchScaleChanges(ctx context.Context, applicationService ApplicationService) error {
+	if context.model.Type() != state.ModelTypeCAAS {
+		return nil
+	}
+	changes, err := applicationService.GetLatestApplicationScaleChanges(ctx)
+	if err != nil {
+		return err
+	}
+	context.scaleChanged = changes
+	return nil
+}
+
+// fetchPendingCharmUpgrades records the latest charm revision of each
+// application for which a newer revision of its charm is available, as last
+// recorded by the charm revision updater.
+func (context *statusContext) fetchPendingCharmUpgrades(ctx context.Context, applicationService ApplicationService) error {
+	pending, err := applicationService.ListApplicationsWithPendingCharmUpgrades(ctx, "")
+	if err != nil {
+		return err
+	}
+	context.latestCharmRevisions = make(map[string]int, len(pending))
+	for _, p := range pending {
+		context.latestCharmRevisions[p.Name] = p.LatestRevision
+	}
+	return nil
+}
+
+// fetchUnitDerivedStatuses records the status of each application in an IAAS
+// model as derived from the statuses of its units. Each is computed by the
+// application service in a single query, rather than by fetching the status of
+// every unit. CAAS applications fold in the status of each unit's container,
+// so are left to derive their status from their units.
+func (context *statusContext) fetchUnitDerivedStatuses(ctx context.Context, applicationService ApplicationService) error {
+	if context.model.Type() == state.ModelTypeCAAS {
+		return nil
+	}
+	context.unitDerivedStatuses = make(map[string]status.StatusInfo)
+	for name := range context.allAppsUnitsCharmBindings.applications {
+		info, err := applicationService.GetApplicationStatus(ctx, name)
+		if errors.Is(err, applicationerrors.ApplicationNotFound) {
+			continue
+		} else if err != nil {
+			return errors.Annotatef(err, "getting status of application %q", name)
+		}
+		context.unitDerivedStatuses[name] = status.StatusInfo{
+			Status:  info.Status,
+			Message: info.Message,
+		}
+	}
+	return nil
+}
+
 // fetchControllerNodes returns a map from node id to controller node.
 func fetchControllerNodes(st Backend) (map[string]state.ControllerNode, error) {
 	v := make(map[string]state.ControllerNode)
@@ -1158,13 +1233,13 @@ func (c *statusContext) makeMachineStatus(
 	constraints := c.allConstraints.Machine(machineID)
 	status.Constraints = constraints.String()
 
-	hc, err := machineService.HardwareCharacteristics(ctx, machineUUID)
+	hc, err := machineService.GetMachineHardwareCharacteristics(ctx, machineUUID)
 	if errors.Is(err, machineerrors.NotProvisioned) {
 		logger.Debugf("can't retrieve hardware characteristics of machine %q: not provisioned", machineUUID)
 	}
 	if err != nil {
 		logger.Debugf("error fetching hardware characteristics: %v", err)
-	} else if hc != nil {
+	} else {
 		status.Hardware = hc.String()
 	}
 	status.Containers = make(map[string]params.MachineStatus)
@@ -1314,7 +1389,9 @@ func (context *statusContext) processApplication(ctx context.Context, applicatio
 	if err != nil {
 		return params.ApplicationStatus{Err: apiservererrors.ServerError(err)}
 	}
-	if latestCharm, ok := context.allAppsUnitsCharmBindings.latestCharms[*curl.WithRevision(-1)]; ok && latestCharm != nil {
+	if latestRevision, ok := context.latestCharmRevisions[application.Name()]; ok && latestRevision > curl.Revision {
+		processedStatus.CanUpgradeTo = curl.WithRevision(latestRevision).String()
+	} else if latestCharm, ok := context.allAppsUnitsCharmBindings.latestCharms[*curl.WithRevision(-1)]; ok && latestCharm != nil {
 		if latestCharm.Revision() > curl.Revision {
 			processedStatus.CanUpgradeTo = latestCharm.URL()
 		}
@@ -1331,11 +1408,7 @@ func (context *statusContext) processApplication(ctx context.Context, applicatio
 	}
 
 	applicationStatus := status.StatusInfo{Status: status.Unknown}
-	var appUnits []*state.Unit
-	for _, u := range units {
-		appUnits = append(appUnits, u)
-	}
-	displayStatus, err := common.ApplicationDisplayStatus(context.model, application, appUnits)
+	displayStatus, err := context.applicationDisplayStatus(application, units)
 	if err == nil {
 		applicationStatus = displayStatus
 	}
@@ -1370,11 +1443,39 @@ func (context *statusContext) processApplication(ctx context.Context, applicatio
 			logger.Debugf("no service details for %v: %v", application.Name(), err)
 		}
 		processedStatus.Scale = application.GetScale()
+		if changed, ok := context.scaleChanged[application.Name()]; ok {
+			processedStatus.ScaleChanged = &changed
+		}
 	}
 	processedStatus.EndpointBindings = context.allAppsUnitsCharmBindings.endpointBindings[application.Name()]
 	return processedStatus
 }
 
+// applicationDisplayStatus returns the status of the application. If the
+// application hasn't set its own status, the status derived from its units by
+// the application service is used, falling back to fetching the status of each
+// unit if there is none.
+func (context *statusContext) applicationDisplayStatus(application *state.Application, units map[string]*state.Unit) (status.StatusInfo, error) {
+	derived, ok := context.unitDerivedStatuses[application.Name()]
+	if !ok {
+		var appUnits []*state.Unit
+		for _, u := range units {
+			appUnits = append(appUnits, u)
+		}
+		return common.ApplicationDisplayStatus(context.model, application, appUnits)
+	}
+
+	info, err := application.Status()
+	if err != nil {
+		return status.StatusInfo{}, errors.Trace(err)
+	}
+	if info.Status != status.Unset {
+		return info, nil
+	}
+	derived.Since = info.Since
+	return derived, nil
+}
+
 func (context *statusContext) mapExposedEndpointsFromState(exposedEndpoints map[string]state.ExposedEndpoint) (map[string]params.ExposedEndpoint,
 	error) {
 	if len(exposedEndpoints) == 0 {
@@ -812,11 +812,27 @@ func (c *ControllerAPI) ModifyControllerAccess(ctx context.Context, args params.
 			},
 		}
 		err = c.accessService.UpdatePermission(ctx, updateArgs)
+		if err == nil {
+			c.publishPermissionsChanged(targetUserTag)
+		}
 		result.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return result, nil
 }
 
+// publishPermissionsChanged announces that the user's access has changed, so
+// that API connections stop using the permissions they have cached for them.
+// The change has already been made, so failing to announce it is only logged;
+// cached permissions expire regardless.
+func (c *ControllerAPI) publishPermissionsChanged(userTag names.UserTag) {
+	_, err := c.hub.Publish(
+		controller.PermissionsChanged,
+		controller.PermissionsChangedMessage{UserName: userTag.Id()})
+	if err != nil {
+		c.logger.Warningf("announcing access change for %q: %v", userTag.Id(), err)
+	}
+}
+
 // ConfigSet changes the value of specified controller configuration
 // settings. Only some settings can be changed after bootstrap.
 // Settings that aren't specified in the params are left unchanged.
@@ -929,6 +929,7 @@ type accessSuite struct {
 
 	resources  *common.Resources
 	authorizer apiservertesting.FakeAuthorizer
+	hub        *pubsub.StructuredHub
 
 	accessService *mocks.MockControllerAccessService
 }
@@ -956,7 +957,7 @@ func (s *accessSuite) SetUpTest(c *gc.C) {
 		Tag:      s.Owner,
 		AdminTag: s.Owner,
 	}
-
+	s.hub = pubsub.NewStructuredHub(nil)
 }
 
 func (s *accessSuite) setupMocks(c *gc.C) *gomock.Controller {
@@ -977,7 +978,7 @@ func (s *accessSuite) controllerAPI(c *gc.C) *controller.ControllerAPI {
 		s.authorizer,
 		s.resources,
 		nil,
-		nil,
+		s.hub,
 		loggertesting.WrapCheckLog(c),
 		nil,
 		nil,
@@ -1031,6 +1032,36 @@ func (s *accessSuite) TestModifyControllerAccess(c *gc.C) {
 	c.Assert(result.Results, gc.HasLen, 1)
 }
 
+func (s *accessSuite) TestModifyControllerAccessPublishesEvent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	userName := usertesting.GenNewName(c, "test-user")
+
+	done := make(chan string, 1)
+	s.hub.Subscribe(pscontroller.PermissionsChanged, func(topic string, data pscontroller.PermissionsChangedMessage, err error) {
+		c.Check(err, jc.ErrorIsNil)
+		done <- data.UserName
+	})
+	s.accessService.EXPECT().UpdatePermission(gomock.Any(), gomock.Any()).Return(nil)
+
+	args := params.ModifyControllerAccessRequest{Changes: []params.ModifyControllerAccess{{
+		UserTag: names.NewUserTag(userName.Name()).String(),
+		Action:  params.RevokeControllerAccess,
+		Access:  string(permission.SuperuserAccess),
+	}}}
+
+	result, err := s.controllerAPI(c).ModifyControllerAccess(stdcontext.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result.Results, gc.HasLen, 1)
+	c.Assert(result.Results[0].Error, gc.IsNil)
+
+	select {
+	case name := <-done:
+		c.Check(name, gc.Equals, userName.Name())
+	case <-time.After(testing.LongWait):
+		c.Fatal("no event sent")
+	}
+}
+
 func (s *accessSuite) TestGetControllerAccessPermissions(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
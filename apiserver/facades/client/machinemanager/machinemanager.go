@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/domain/blockcommand"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/environs/config"
@@ -90,6 +91,9 @@ type Authorizer interface {
 type MachineService interface {
 	// CreateMachine creates a machine with the given name.
 	CreateMachine(context.Context, coremachine.Name) (string, error)
+	// CreateMachineWithParent creates a container machine with the given
+	// name on the given parent machine.
+	CreateMachineWithParent(context.Context, coremachine.Name, coremachine.Name) (string, error)
 	// DeleteMachine deletes a machine with the given name.
 	DeleteMachine(context.Context, coremachine.Name) error
 	// GetBootstrapEnviron returns the bootstrap environ.
@@ -107,6 +111,9 @@ type MachineService interface {
 	SetKeepInstance(ctx context.Context, machineName coremachine.Name, keep bool) error
 	// GetMachineUUID returns the UUID of a machine identified by its name.
 	GetMachineUUID(ctx context.Context, name coremachine.Name) (string, error)
+	// GetMachineContainerCount returns the number of containers hosted on
+	// the machine with the given UUID.
+	GetMachineContainerCount(ctx context.Context, hostMachineUUID string) (domainmachine.ContainerCount, error)
 	// HardwareCharacteristics returns the hardware characteristics of the
 	// specified machine.
 	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
@@ -335,36 +342,62 @@ func (mm *MachineManagerAPI) addOneMachine(ctx context.Context, p params.AddMach
 		return mm.st.AddOneMachine(template)
 	}
 	if p.ParentId != "" {
+		if err := mm.checkContainerCapacity(ctx, p.ParentId); err != nil {
+			return nil, errors.Trace(err)
+		}
 		return mm.st.AddMachineInsideMachine(template, p.ParentId, p.ContainerType)
 	}
 	return mm.st.AddMachineInsideNewMachine(template, template, p.ContainerType)
 }
 
-func (mm *MachineManagerAPI) saveMachineInfo(ctx context.Context, machineName string) error {
-	// This is temporary - just insert the machine id all al the parent ones.
-	var errs []error
-	for machineName != "" {
-		_, err := mm.machineService.CreateMachine(ctx, coremachine.Name(machineName))
-		// The machine might already exist e.g. if we are adding a subordinate
-		// unit to an already existing machine. In this case, just continue
-		// without error.
-		if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
-			errs = append(errs, errors.Annotatef(err, "saving info for machine %q", machineName))
-		}
-		parent := names.NewMachineTag(machineName).Parent()
-		if parent == nil {
-			break
-		}
-		machineName = parent.Id()
-	}
-	if len(errs) == 0 {
+// checkContainerCapacity returns a QuotaLimitExceeded error if the parent
+// machine already hosts the maximum number of containers allowed by the
+// model's max-containers-per-machine config.
+func (mm *MachineManagerAPI) checkContainerCapacity(ctx context.Context, parentId string) error {
+	conf, err := mm.modelConfigService.ModelConfig(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	maxContainers := conf.MaxContainersPerMachine()
+	if maxContainers == 0 {
 		return nil
 	}
-	var errStr string
-	for _, e := range errs {
-		errStr += e.Error() + "\n"
+	parentUUID, err := mm.machineService.GetMachineUUID(ctx, coremachine.Name(parentId))
+	if err != nil {
+		return errors.Annotatef(err, "getting machine %q", parentId)
+	}
+	count, err := mm.machineService.GetMachineContainerCount(ctx, parentUUID)
+	if err != nil {
+		return errors.Annotatef(err, "counting containers on machine %q", parentId)
+	}
+	if count.TotalContainers >= maxContainers {
+		return errors.QuotaLimitExceededf("machine %q has reached the %s limit of %d",
+			parentId, config.MaxContainersPerMachineKey, maxContainers)
+	}
+	return nil
+}
+
+func (mm *MachineManagerAPI) saveMachineInfo(ctx context.Context, machineName string) error {
+	// This is temporary - just insert the machine id and all the parent ones.
+	// Parents are inserted first, so that containers are recorded against
+	// their host machine.
+	var err error
+	parent := names.NewMachineTag(machineName).Parent()
+	if parent == nil {
+		_, err = mm.machineService.CreateMachine(ctx, coremachine.Name(machineName))
+	} else {
+		if err := mm.saveMachineInfo(ctx, parent.Id()); err != nil {
+			return errors.Trace(err)
+		}
+		_, err = mm.machineService.CreateMachineWithParent(ctx, coremachine.Name(machineName), coremachine.Name(parent.Id()))
+	}
+	// The machine might already exist e.g. if we are adding a subordinate
+	// unit to an already existing machine. In this case, just continue
+	// without error.
+	if err != nil && !errors.Is(err, machineerrors.MachineAlreadyExists) {
+		return errors.Annotatef(err, "saving info for machine %q", machineName)
 	}
-	return errors.New(errStr)
+	return nil
 }
 
 // ProvisioningScript returns a shell script that, when run,
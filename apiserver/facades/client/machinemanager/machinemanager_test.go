@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
 	blockcommanderrors "github.com/juju/juju/domain/blockcommand/errors"
+	domainmachine "github.com/juju/juju/domain/machine"
 	"github.com/juju/juju/environs/config"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/storage"
@@ -52,6 +53,7 @@ type AddMachineManagerSuite struct {
 	machineService          *MockMachineService
 	networkService          *MockNetworkService
 	keyUpdaterService       *MockKeyUpdaterService
+	modelConfigService      *MockModelConfigService
 	blockCommandService     *MockBlockCommandService
 }
 
@@ -79,6 +81,7 @@ func (s *AddMachineManagerSuite) setup(c *gc.C) *gomock.Controller {
 	s.store = NewMockObjectStore(ctrl)
 	s.networkService = NewMockNetworkService(ctrl)
 	s.keyUpdaterService = NewMockKeyUpdaterService(ctrl)
+	s.modelConfigService = NewMockModelConfigService(ctrl)
 
 	s.blockCommandService = NewMockBlockCommandService(ctrl)
 	s.blockCommandService.EXPECT().GetBlockSwitchedOn(gomock.Any(), gomock.Any()).Return("", blockcommanderrors.NotFound).AnyTimes()
@@ -103,7 +106,7 @@ func (s *AddMachineManagerSuite) setup(c *gc.C) *gomock.Controller {
 		loggertesting.WrapCheckLog(c),
 		s.networkService,
 		s.keyUpdaterService,
-		nil,
+		s.modelConfigService,
 		s.blockCommandService,
 	)
 
@@ -148,8 +151,8 @@ func (s *AddMachineManagerSuite) TestAddMachines(c *gc.C) {
 		},
 	}).Return(m1, nil)
 	s.machineService.EXPECT().CreateMachine(gomock.Any(), coremachine.Name("666"))
-	s.machineService.EXPECT().CreateMachine(gomock.Any(), coremachine.Name("667/lxd/1"))
 	s.machineService.EXPECT().CreateMachine(gomock.Any(), coremachine.Name("667"))
+	s.machineService.EXPECT().CreateMachineWithParent(gomock.Any(), coremachine.Name("667/lxd/1"), coremachine.Name("667"))
 	s.st.EXPECT().AddOneMachine(state.MachineTemplate{
 		Base: state.UbuntuBase("22.04"),
 		Jobs: []state.MachineJob{state.JobHostUnits},
@@ -190,6 +193,68 @@ func (s *AddMachineManagerSuite) TestAddMachinesStateError(c *gc.C) {
 	})
 }
 
+func (s *AddMachineManagerSuite) TestAddMachinesContainerLimitReached(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	cfg, err := config.New(config.NoDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.MaxContainersPerMachineKey: 2,
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any())
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), coremachine.Name("0")).Return("deadbeef", nil)
+	s.machineService.EXPECT().GetMachineContainerCount(gomock.Any(), "deadbeef").Return(domainmachine.ContainerCount{
+		TotalContainers: 2,
+		LXDContainers:   2,
+	}, nil)
+
+	results, err := s.api.AddMachines(context.Background(), params.AddMachines{
+		MachineParams: []params.AddMachineParams{{
+			Base:          &params.Base{Name: "ubuntu", Channel: "22.04"},
+			ContainerType: instance.LXD,
+			ParentId:      "0",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Machines, gc.HasLen, 1)
+	c.Check(results.Machines[0].Error, gc.ErrorMatches, `machine "0" has reached the max-containers-per-machine limit of 2`)
+	c.Check(results.Machines[0].Error, jc.Satisfies, params.IsCodeQuotaLimitExceeded)
+}
+
+func (s *AddMachineManagerSuite) TestAddMachinesContainerUnderLimit(c *gc.C) {
+	ctrl := s.setup(c)
+	defer ctrl.Finish()
+
+	cfg, err := config.New(config.NoDefaults, coretesting.FakeConfig().Merge(coretesting.Attrs{
+		config.MaxContainersPerMachineKey: 2,
+	}))
+	c.Assert(err, jc.ErrorIsNil)
+	s.modelConfigService.EXPECT().ModelConfig(gomock.Any()).Return(cfg, nil)
+	s.networkService.EXPECT().GetAllSpaces(gomock.Any())
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), coremachine.Name("0")).Return("deadbeef", nil)
+	s.machineService.EXPECT().GetMachineContainerCount(gomock.Any(), "deadbeef").Return(domainmachine.ContainerCount{
+		TotalContainers: 1,
+		LXDContainers:   1,
+	}, nil)
+	m := NewMockMachine(ctrl)
+	m.EXPECT().Id().Return("0/lxd/1").AnyTimes()
+	s.st.EXPECT().AddMachineInsideMachine(gomock.Any(), "0", instance.LXD).Return(m, nil)
+	s.machineService.EXPECT().CreateMachine(gomock.Any(), coremachine.Name("0"))
+	s.machineService.EXPECT().CreateMachineWithParent(gomock.Any(), coremachine.Name("0/lxd/1"), coremachine.Name("0"))
+
+	results, err := s.api.AddMachines(context.Background(), params.AddMachines{
+		MachineParams: []params.AddMachineParams{{
+			Base:          &params.Base{Name: "ubuntu", Channel: "22.04"},
+			ContainerType: instance.LXD,
+			ParentId:      "0",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, gc.DeepEquals, params.AddMachinesResults{
+		Machines: []params.AddMachinesResult{{Machine: "0/lxd/1"}},
+	})
+}
+
 type DestroyMachineManagerSuite struct {
 	testing.CleanupSuite
 	authorizer    *apiservertesting.FakeAuthorizer
@@ -22,6 +22,7 @@ import (
 	objectstore "github.com/juju/juju/core/objectstore"
 	status "github.com/juju/juju/core/status"
 	blockcommand "github.com/juju/juju/domain/blockcommand"
+	machine0 "github.com/juju/juju/domain/machine"
 	environs "github.com/juju/juju/environs"
 	config "github.com/juju/juju/environs/config"
 	charmhub "github.com/juju/juju/internal/charmhub"
@@ -1991,6 +1992,45 @@ func (c *MockMachineServiceCreateMachineCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// CreateMachineWithParent mocks base method.
+func (m *MockMachineService) CreateMachineWithParent(arg0 context.Context, arg1, arg2 machine.Name) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMachineWithParent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMachineWithParent indicates an expected call of CreateMachineWithParent.
+func (mr *MockMachineServiceMockRecorder) CreateMachineWithParent(arg0, arg1, arg2 any) *MockMachineServiceCreateMachineWithParentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachineWithParent", reflect.TypeOf((*MockMachineService)(nil).CreateMachineWithParent), arg0, arg1, arg2)
+	return &MockMachineServiceCreateMachineWithParentCall{Call: call}
+}
+
+// MockMachineServiceCreateMachineWithParentCall wrap *gomock.Call
+type MockMachineServiceCreateMachineWithParentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceCreateMachineWithParentCall) Return(arg0 string, arg1 error) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceCreateMachineWithParentCall) Do(f func(context.Context, machine.Name, machine.Name) (string, error)) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceCreateMachineWithParentCall) DoAndReturn(f func(context.Context, machine.Name, machine.Name) (string, error)) *MockMachineServiceCreateMachineWithParentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteMachine mocks base method.
 func (m *MockMachineService) DeleteMachine(arg0 context.Context, arg1 machine.Name) error {
 	m.ctrl.T.Helper()
@@ -2107,6 +2147,45 @@ func (c *MockMachineServiceGetInstanceTypesFetcherCall) DoAndReturn(f func(conte
 	return c
 }
 
+// GetMachineContainerCount mocks base method.
+func (m *MockMachineService) GetMachineContainerCount(arg0 context.Context, arg1 string) (machine0.ContainerCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineContainerCount", arg0, arg1)
+	ret0, _ := ret[0].(machine0.ContainerCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineContainerCount indicates an expected call of GetMachineContainerCount.
+func (mr *MockMachineServiceMockRecorder) GetMachineContainerCount(arg0, arg1 any) *MockMachineServiceGetMachineContainerCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineContainerCount", reflect.TypeOf((*MockMachineService)(nil).GetMachineContainerCount), arg0, arg1)
+	return &MockMachineServiceGetMachineContainerCountCall{Call: call}
+}
+
+// MockMachineServiceGetMachineContainerCountCall wrap *gomock.Call
+type MockMachineServiceGetMachineContainerCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceGetMachineContainerCountCall) Return(arg0 machine0.ContainerCount, arg1 error) *MockMachineServiceGetMachineContainerCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceGetMachineContainerCountCall) Do(f func(context.Context, string) (machine0.ContainerCount, error)) *MockMachineServiceGetMachineContainerCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceGetMachineContainerCountCall) DoAndReturn(f func(context.Context, string) (machine0.ContainerCount, error)) *MockMachineServiceGetMachineContainerCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetMachineUUID mocks base method.
 func (m *MockMachineService) GetMachineUUID(arg0 context.Context, arg1 machine.Name) (string, error) {
 	m.ctrl.T.Helper()
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService)
+// Source: github.com/juju/juju/apiserver/facades/client/modelmanager (interfaces: ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,AnnotationService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/service_mock.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService
+//	mockgen -typed -package mocks -destination mocks/service_mock.go github.com/juju/juju/apiserver/facades/client/modelmanager ApplicationService,AccessService,SecretBackendService,ModelService,DomainServicesGetter,ModelDefaultsService,ModelInfoService,ModelConfigService,NetworkService,ModelDomainServices,MachineService,ModelAgentService,AnnotationService
 //
 
 // Package mocks is a generated GoMock package.
@@ -15,6 +15,7 @@ import (
 	time "time"
 
 	modelmanager "github.com/juju/juju/apiserver/facades/client/modelmanager"
+	annotations "github.com/juju/juju/core/annotations"
 	assumes "github.com/juju/juju/core/assumes"
 	credential "github.com/juju/juju/core/credential"
 	instance "github.com/juju/juju/core/instance"
@@ -1427,6 +1428,44 @@ func (c *MockModelDomainServicesAgentCall) DoAndReturn(f func() modelmanager.Mod
 	return c
 }
 
+// Annotation mocks base method.
+func (m *MockModelDomainServices) Annotation() modelmanager.AnnotationService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Annotation")
+	ret0, _ := ret[0].(modelmanager.AnnotationService)
+	return ret0
+}
+
+// Annotation indicates an expected call of Annotation.
+func (mr *MockModelDomainServicesMockRecorder) Annotation() *MockModelDomainServicesAnnotationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Annotation", reflect.TypeOf((*MockModelDomainServices)(nil).Annotation))
+	return &MockModelDomainServicesAnnotationCall{Call: call}
+}
+
+// MockModelDomainServicesAnnotationCall wrap *gomock.Call
+type MockModelDomainServicesAnnotationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockModelDomainServicesAnnotationCall) Return(arg0 modelmanager.AnnotationService) *MockModelDomainServicesAnnotationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockModelDomainServicesAnnotationCall) Do(f func() modelmanager.AnnotationService) *MockModelDomainServicesAnnotationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockModelDomainServicesAnnotationCall) DoAndReturn(f func() modelmanager.AnnotationService) *MockModelDomainServicesAnnotationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // BlockCommand mocks base method.
 func (m *MockModelDomainServices) BlockCommand() modelmanager.BlockCommandService {
 	m.ctrl.T.Helper()
@@ -1896,3 +1935,65 @@ func (c *MockModelAgentServiceGetModelTargetAgentVersionCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockAnnotationService is a mock of AnnotationService interface.
+type MockAnnotationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnotationServiceMockRecorder
+}
+
+// MockAnnotationServiceMockRecorder is the mock recorder for MockAnnotationService.
+type MockAnnotationServiceMockRecorder struct {
+	mock *MockAnnotationService
+}
+
+// NewMockAnnotationService creates a new mock instance.
+func NewMockAnnotationService(ctrl *gomock.Controller) *MockAnnotationService {
+	mock := &MockAnnotationService{ctrl: ctrl}
+	mock.recorder = &MockAnnotationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnotationService) EXPECT() *MockAnnotationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAnnotations mocks base method.
+func (m *MockAnnotationService) GetAnnotations(arg0 context.Context, arg1 annotations.ID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotations", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotations indicates an expected call of GetAnnotations.
+func (mr *MockAnnotationServiceMockRecorder) GetAnnotations(arg0, arg1 any) *MockAnnotationServiceGetAnnotationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotations", reflect.TypeOf((*MockAnnotationService)(nil).GetAnnotations), arg0, arg1)
+	return &MockAnnotationServiceGetAnnotationsCall{Call: call}
+}
+
+// MockAnnotationServiceGetAnnotationsCall wrap *gomock.Call
+type MockAnnotationServiceGetAnnotationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAnnotationServiceGetAnnotationsCall) Return(arg0 map[string]string, arg1 error) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAnnotationServiceGetAnnotationsCall) Do(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAnnotationServiceGetAnnotationsCall) DoAndReturn(f func(context.Context, annotations.ID) (map[string]string, error)) *MockAnnotationServiceGetAnnotationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
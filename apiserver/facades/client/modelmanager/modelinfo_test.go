@@ -203,6 +203,7 @@ func (s *modelInfoSuite) getAPI(c *gc.C) (*modelmanager.ModelManagerAPI, *gomock
 	api, ctrl := s.getAPIWithoutModelInfo(c)
 
 	mockModelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, mockModelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(mockModelDomainServices).AnyTimes()
 
 	modelAgentService := mocks.NewMockModelAgentService(ctrl)
@@ -276,6 +277,7 @@ func (s *modelInfoSuite) getAPIWithUser(c *gc.C, user names.UserTag) (*modelmana
 	s.mockModelService = mocks.NewMockModelService(ctrl)
 	s.mockApplicationService = mocks.NewMockApplicationService(ctrl)
 	s.mockModelDomainServices = mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, s.mockModelDomainServices)
 	s.mockDomainServicesGetter = mocks.NewMockDomainServicesGetter(ctrl)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(s.mockModelDomainServices).AnyTimes()
 	s.mockBlockCommandService = mocks.NewMockBlockCommandService(ctrl)
@@ -678,6 +680,7 @@ func (s *modelInfoSuite) TestAliveModelWithGetModelInfoFailure(c *gc.C) {
 	api, ctrl := s.getAPIWithoutModelInfo(c)
 	defer ctrl.Finish()
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -691,6 +694,7 @@ func (s *modelInfoSuite) TestAliveModelWithGetModelTargetAgentVersionFailure(c *
 	api, ctrl := s.getAPIWithoutModelInfo(c)
 	defer ctrl.Finish()
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -747,6 +751,7 @@ func (s *modelInfoSuite) TestDeadModelWithGetModelInfoFailure(c *gc.C) {
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -774,6 +779,7 @@ func (s *modelInfoSuite) TestDeadModelWithGetModelTargetAgentVersionFailure(c *g
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -838,6 +844,7 @@ func (s *modelInfoSuite) TestDyingModelWithGetModelInfoFailure(c *gc.C) {
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -865,6 +872,7 @@ func (s *modelInfoSuite) TestDyingModelWithGetModelTargetAgentVersionFailure(c *
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -945,6 +953,7 @@ func (s *modelInfoSuite) TestImportingModelWithGetModelInfoFailure(c *gc.C) {
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -973,6 +982,7 @@ func (s *modelInfoSuite) TestImportingModelWithGetModelTargetAgentVersionFailure
 	s.mockMachineService.EXPECT().HardwareCharacteristics(gomock.Any(), "deadbeef1").Return(&instance.HardwareCharacteristics{}, nil)
 
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.mockDomainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 	modelInfoService := mocks.NewMockModelInfoService(ctrl)
 	modelDomainServices.EXPECT().ModelInfo().Return(modelInfoService)
@@ -1449,3 +1459,9 @@ func (s mockCredentialShim) InvalidateModelCredential(reason string) error {
 type mockObjectStore struct {
 	objectstore.ObjectStore
 }
+
+func expectNoModelAnnotations(ctrl *gomock.Controller, domainServices *mocks.MockModelDomainServices) {
+	annotationService := mocks.NewMockAnnotationService(ctrl)
+	annotationService.EXPECT().GetAnnotations(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	domainServices.EXPECT().Annotation().Return(annotationService).AnyTimes()
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/apiserver/facade"
 	"github.com/juju/juju/caas"
 	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/annotations"
 	"github.com/juju/juju/core/credential"
 	"github.com/juju/juju/core/life"
 	coremodel "github.com/juju/juju/core/model"
@@ -35,6 +36,7 @@ import (
 	"github.com/juju/juju/environs/config"
 	environsContext "github.com/juju/juju/environs/envcontext"
 	internallogger "github.com/juju/juju/internal/logger"
+	"github.com/juju/juju/internal/pubsub/controller"
 	"github.com/juju/juju/internal/secrets/provider/kubernetes"
 	"github.com/juju/juju/internal/storage/provider"
 	"github.com/juju/juju/internal/uuid"
@@ -83,6 +85,7 @@ type ModelManagerAPI struct {
 
 	modelExporter func(coremodel.UUID, facade.LegacyStateExporter) ModelExporter
 	store         objectstore.ObjectStore
+	hub           facade.Hub
 
 	// ToolsFinder is used to find tools for a given version.
 	toolsFinder common.ToolsFinder
@@ -136,6 +139,7 @@ func NewModelManagerAPI(
 		networkService:       services.NetworkService,
 		applicationService:   services.ApplicationService,
 		store:                services.ObjectStore,
+		hub:                  services.Hub,
 		getBroker:            getBroker,
 		check:                blockChecker,
 		authorizer:           authorizer,
@@ -1199,6 +1203,17 @@ func (m *ModelManagerAPI) getModelInfo(ctx context.Context, tag names.ModelTag,
 		}
 	}
 
+	modelAnnotations, err := modelDomainServices.Annotation().GetAnnotations(ctx, annotations.ID{
+		Kind: annotations.KindModel,
+		Name: modelUUID,
+	})
+	if shouldErr(err) {
+		return params.ModelInfo{}, errors.Annotate(err, "getting model annotations")
+	}
+	if len(modelAnnotations) > 0 {
+		info.Annotations = modelAnnotations
+	}
+
 	modelAdmin := m.isModelAdmin(ctx, tag)
 	info.Users, err = common.ModelUserInfo(ctx, m.modelService, tag, user.NameFromTag(m.apiUser), modelAdmin)
 	if shouldErr(err) {
@@ -1331,12 +1346,26 @@ func (m *ModelManagerAPI) ModifyModelAccess(ctx context.Context, args params.Mod
 			Change:  permission.AccessChange(arg.Action),
 			Subject: user.NameFromTag(targetUserTag),
 		})
+		if err == nil {
+			m.publishPermissionsChanged(targetUserTag)
+		}
 
 		result.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return result, nil
 }
 
+// publishPermissionsChanged announces that the user's access has changed, so
+// that API connections stop using the permissions they have cached for them.
+func (m *ModelManagerAPI) publishPermissionsChanged(userTag names.UserTag) {
+	_, err := m.hub.Publish(
+		controller.PermissionsChanged,
+		controller.PermissionsChangedMessage{UserName: userTag.Id()})
+	if err != nil {
+		logger.Warningf("announcing access change for %q: %v", userTag.Id(), err)
+	}
+}
+
 // ModelDefaultsForClouds returns the default config values for the specified
 // clouds.
 func (m *ModelManagerAPI) ModelDefaultsForClouds(ctx context.Context, args params.Entities) (params.ModelDefaultsResults, error) {
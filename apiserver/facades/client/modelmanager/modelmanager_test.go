@@ -11,6 +11,7 @@ import (
 	"github.com/juju/errors"
 	"github.com/juju/loggo/v2"
 	"github.com/juju/names/v5"
+	"github.com/juju/pubsub/v2"
 	jtesting "github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/version/v2"
@@ -48,6 +49,7 @@ import (
 	_ "github.com/juju/juju/internal/provider/ec2"
 	_ "github.com/juju/juju/internal/provider/maas"
 	_ "github.com/juju/juju/internal/provider/openstack"
+	pscontroller "github.com/juju/juju/internal/pubsub/controller"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/testing/factory"
 	"github.com/juju/juju/internal/uuid"
@@ -91,6 +93,7 @@ type modelManagerSuite struct {
 	controllerUUID       uuid.UUID
 	modelConfigService   *mocks.MockModelConfigService
 	machineService       *mocks.MockMachineService
+	hub                  *pubsub.StructuredHub
 }
 
 var _ = gc.Suite(&modelManagerSuite{})
@@ -107,6 +110,7 @@ func (s *modelManagerSuite) setUpMocks(c *gc.C) *gomock.Controller {
 	s.blockCommandService = mocks.NewMockBlockCommandService(ctrl)
 	s.machineService = mocks.NewMockMachineService(ctrl)
 	s.domainServices = mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, s.domainServices)
 
 	return ctrl
 }
@@ -230,6 +234,7 @@ func (s *modelManagerSuite) setUpAPI(c *gc.C) *gomock.Controller {
 			"dummy": dummyCloud,
 		},
 	}
+	s.hub = pubsub.NewStructuredHub(nil)
 	cred := cloud.NewEmptyCredential()
 	api, err := modelmanager.NewModelManagerAPI(
 		context.Background(),
@@ -244,6 +249,7 @@ func (s *modelManagerSuite) setUpAPI(c *gc.C) *gomock.Controller {
 			ApplicationService:   s.applicationService,
 			AccessService:        s.accessService,
 			ObjectStore:          &mockObjectStore{},
+			Hub:                  s.hub,
 		},
 		nil, newBroker, common.NewBlockChecker(s.blockCommandService),
 		s.authoriser, s.st.model,
@@ -302,6 +308,7 @@ func (s *modelManagerSuite) setAPIUser(c *gc.C, user names.UserTag) {
 			AccessService:        s.accessService,
 			ApplicationService:   s.applicationService,
 			ObjectStore:          &mockObjectStore{},
+			Hub:                  s.hub,
 		},
 		nil, newBroker, common.NewBlockChecker(s.blockCommandService),
 		s.authoriser, s.st.model,
@@ -378,6 +385,7 @@ func (s *modelManagerSuite) expectCreateModelOnModelDB(
 ) {
 	// Expect call to get the model domain services.
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 
 	// Expect calls to get various model services.
@@ -985,6 +993,38 @@ func (s *modelManagerSuite) TestUpdatedModel(c *gc.C) {
 	c.Check(results.OneError(), jc.ErrorIsNil)
 }
 
+func (s *modelManagerSuite) TestModifyModelAccessPublishesEvent(c *gc.C) {
+	defer s.setUpAPI(c).Finish()
+
+	done := make(chan string, 1)
+	s.hub.Subscribe(pscontroller.PermissionsChanged, func(topic string, data pscontroller.PermissionsChangedMessage, err error) {
+		c.Check(err, jc.ErrorIsNil)
+		done <- data.UserName
+	})
+	s.accessService.EXPECT().UpdatePermission(gomock.Any(), gomock.Any()).Return(nil)
+
+	s.setAPIUser(c, jujutesting.AdminUser)
+
+	args := params.ModifyModelAccessRequest{
+		Changes: []params.ModifyModelAccess{{
+			UserTag:  names.NewUserTag("foobar").String(),
+			Action:   params.RevokeModelAccess,
+			Access:   params.ModelReadAccess,
+			ModelTag: names.NewModelTag(modeltesting.GenModelUUID(c).String()).String(),
+		}}}
+
+	results, err := s.api.ModifyModelAccess(stdcontext.Background(), args)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results.OneError(), jc.ErrorIsNil)
+
+	select {
+	case name := <-done:
+		c.Check(name, gc.Equals, "foobar")
+	case <-time.After(coretesting.LongWait):
+		c.Fatal("no event sent")
+	}
+}
+
 // modelManagerStateSuite contains end-to-end tests.
 // Prefer adding tests to modelManagerSuite above.
 type modelManagerStateSuite struct {
@@ -1137,6 +1177,7 @@ func (s *modelManagerStateSuite) expectCreateModelStateSuite(
 
 	// Expect call to get the model domain services
 	modelDomainServices := mocks.NewMockModelDomainServices(ctrl)
+	expectNoModelAnnotations(ctrl, modelDomainServices)
 	s.domainServicesGetter.EXPECT().DomainServicesForModel(gomock.Any()).Return(modelDomainServices).AnyTimes()
 
 	// Expect calls to get various model services.
@@ -102,6 +102,7 @@ func newFacadeV10(stdCtx context.Context, ctx facade.MultiModelContext) (*ModelM
 			NetworkService:       domainServices.Network(),
 			MachineService:       domainServices.Machine(),
 			ApplicationService:   domainServices.Application(),
+			Hub:                  ctx.Hub(),
 		},
 		toolsFinder,
 		caas.New,
@@ -13,6 +13,7 @@ import (
 	"github.com/juju/juju/apiserver/common"
 	"github.com/juju/juju/apiserver/facade"
 	jujucloud "github.com/juju/juju/cloud"
+	"github.com/juju/juju/core/annotations"
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/credential"
 	"github.com/juju/juju/core/instance"
@@ -54,6 +55,9 @@ type ModelDomainServices interface {
 
 	// Machine returns the machine service.
 	Machine() MachineService
+
+	// Annotation returns the annotation service.
+	Annotation() AnnotationService
 }
 
 // DomainServicesGetter is a factory for creating model services.
@@ -223,6 +227,13 @@ type ModelAgentService interface {
 	GetModelTargetAgentVersion(ctx context.Context) (version.Number, error)
 }
 
+// AnnotationService is the interface that is used to read the annotations
+// of a model.
+type AnnotationService interface {
+	// GetAnnotations returns the annotations set on the given entity.
+	GetAnnotations(ctx context.Context, id annotations.ID) (map[string]string, error)
+}
+
 // NetworkService is the interface that is used to interact with the
 // network spaces/subnets.
 type NetworkService interface {
@@ -291,6 +302,8 @@ type Services struct {
 	// ApplicationService is an interface for interacting with the application
 	// service.
 	ApplicationService ApplicationService
+	// Hub is used to announce changes to user permissions.
+	Hub facade.Hub
 }
 
 // BlockCommandService defines methods for interacting with block commands.
@@ -336,3 +349,7 @@ func (s domainServices) Machine() MachineService {
 func (s domainServices) BlockCommand() BlockCommandService {
 	return s.domainServices.BlockCommand()
 }
+
+func (s domainServices) Annotation() AnnotationService {
+	return s.domainServices.Annotation()
+}
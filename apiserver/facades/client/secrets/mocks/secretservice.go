@@ -120,119 +120,119 @@ func (c *MockSecretServiceDeleteSecretCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
-// GetSecretContentFromBackend mocks base method.
-func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
+// GetSecretByLabel mocks base method.
+func (m *MockSecretService) GetSecretByLabel(arg0 context.Context, arg1, arg2, arg3 string) (*secrets.URI, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2)
-	ret0, _ := ret[0].(secrets.SecretValue)
+	ret := m.ctrl.Call(m, "GetSecretByLabel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*secrets.URI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
-func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2 any) *MockSecretServiceGetSecretContentFromBackendCall {
+// GetSecretByLabel indicates an expected call of GetSecretByLabel.
+func (mr *MockSecretServiceMockRecorder) GetSecretByLabel(arg0, arg1, arg2, arg3 any) *MockSecretServiceGetSecretByLabelCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2)
-	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretByLabel", reflect.TypeOf((*MockSecretService)(nil).GetSecretByLabel), arg0, arg1, arg2, arg3)
+	return &MockSecretServiceGetSecretByLabelCall{Call: call}
 }
 
-// MockSecretServiceGetSecretContentFromBackendCall wrap *gomock.Call
-type MockSecretServiceGetSecretContentFromBackendCall struct {
+// MockSecretServiceGetSecretByLabelCall wrap *gomock.Call
+type MockSecretServiceGetSecretByLabelCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.SecretValue, arg1 error) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretByLabelCall) Return(arg0 *secrets.URI, arg1 error) *MockSecretServiceGetSecretByLabelCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretByLabelCall) Do(f func(context.Context, string, string, string) (*secrets.URI, error)) *MockSecretServiceGetSecretByLabelCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
+func (c *MockSecretServiceGetSecretByLabelCall) DoAndReturn(f func(context.Context, string, string, string) (*secrets.URI, error)) *MockSecretServiceGetSecretByLabelCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetSecretGrants mocks base method.
-func (m *MockSecretService) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]service.SecretAccess, error) {
+// GetSecretContentFromBackend mocks base method.
+func (m *MockSecretService) GetSecretContentFromBackend(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecretGrants", arg0, arg1, arg2)
-	ret0, _ := ret[0].([]service.SecretAccess)
+	ret := m.ctrl.Call(m, "GetSecretContentFromBackend", arg0, arg1, arg2)
+	ret0, _ := ret[0].(secrets.SecretValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSecretGrants indicates an expected call of GetSecretGrants.
-func (mr *MockSecretServiceMockRecorder) GetSecretGrants(arg0, arg1, arg2 any) *MockSecretServiceGetSecretGrantsCall {
+// GetSecretContentFromBackend indicates an expected call of GetSecretContentFromBackend.
+func (mr *MockSecretServiceMockRecorder) GetSecretContentFromBackend(arg0, arg1, arg2 any) *MockSecretServiceGetSecretContentFromBackendCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretGrants", reflect.TypeOf((*MockSecretService)(nil).GetSecretGrants), arg0, arg1, arg2)
-	return &MockSecretServiceGetSecretGrantsCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentFromBackend", reflect.TypeOf((*MockSecretService)(nil).GetSecretContentFromBackend), arg0, arg1, arg2)
+	return &MockSecretServiceGetSecretContentFromBackendCall{Call: call}
 }
 
-// MockSecretServiceGetSecretGrantsCall wrap *gomock.Call
-type MockSecretServiceGetSecretGrantsCall struct {
+// MockSecretServiceGetSecretContentFromBackendCall wrap *gomock.Call
+type MockSecretServiceGetSecretContentFromBackendCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretServiceGetSecretGrantsCall) Return(arg0 []service.SecretAccess, arg1 error) *MockSecretServiceGetSecretGrantsCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Return(arg0 secrets.SecretValue, arg1 error) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetSecretGrantsCall) Do(f func(context.Context, *secrets.URI, secrets.SecretRole) ([]service.SecretAccess, error)) *MockSecretServiceGetSecretGrantsCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) Do(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetSecretGrantsCall) DoAndReturn(f func(context.Context, *secrets.URI, secrets.SecretRole) ([]service.SecretAccess, error)) *MockSecretServiceGetSecretGrantsCall {
+func (c *MockSecretServiceGetSecretContentFromBackendCall) DoAndReturn(f func(context.Context, *secrets.URI, int) (secrets.SecretValue, error)) *MockSecretServiceGetSecretContentFromBackendCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
 
-// GetUserSecretURIByLabel mocks base method.
-func (m *MockSecretService) GetUserSecretURIByLabel(arg0 context.Context, arg1 string) (*secrets.URI, error) {
+// GetSecretGrants mocks base method.
+func (m *MockSecretService) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]service.SecretAccess, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserSecretURIByLabel", arg0, arg1)
-	ret0, _ := ret[0].(*secrets.URI)
+	ret := m.ctrl.Call(m, "GetSecretGrants", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]service.SecretAccess)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserSecretURIByLabel indicates an expected call of GetUserSecretURIByLabel.
-func (mr *MockSecretServiceMockRecorder) GetUserSecretURIByLabel(arg0, arg1 any) *MockSecretServiceGetUserSecretURIByLabelCall {
+// GetSecretGrants indicates an expected call of GetSecretGrants.
+func (mr *MockSecretServiceMockRecorder) GetSecretGrants(arg0, arg1, arg2 any) *MockSecretServiceGetSecretGrantsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSecretURIByLabel", reflect.TypeOf((*MockSecretService)(nil).GetUserSecretURIByLabel), arg0, arg1)
-	return &MockSecretServiceGetUserSecretURIByLabelCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretGrants", reflect.TypeOf((*MockSecretService)(nil).GetSecretGrants), arg0, arg1, arg2)
+	return &MockSecretServiceGetSecretGrantsCall{Call: call}
 }
 
-// MockSecretServiceGetUserSecretURIByLabelCall wrap *gomock.Call
-type MockSecretServiceGetUserSecretURIByLabelCall struct {
+// MockSecretServiceGetSecretGrantsCall wrap *gomock.Call
+type MockSecretServiceGetSecretGrantsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockSecretServiceGetUserSecretURIByLabelCall) Return(arg0 *secrets.URI, arg1 error) *MockSecretServiceGetUserSecretURIByLabelCall {
+func (c *MockSecretServiceGetSecretGrantsCall) Return(arg0 []service.SecretAccess, arg1 error) *MockSecretServiceGetSecretGrantsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockSecretServiceGetUserSecretURIByLabelCall) Do(f func(context.Context, string) (*secrets.URI, error)) *MockSecretServiceGetUserSecretURIByLabelCall {
+func (c *MockSecretServiceGetSecretGrantsCall) Do(f func(context.Context, *secrets.URI, secrets.SecretRole) ([]service.SecretAccess, error)) *MockSecretServiceGetSecretGrantsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockSecretServiceGetUserSecretURIByLabelCall) DoAndReturn(f func(context.Context, string) (*secrets.URI, error)) *MockSecretServiceGetUserSecretURIByLabelCall {
+func (c *MockSecretServiceGetSecretGrantsCall) DoAndReturn(f func(context.Context, *secrets.URI, secrets.SecretRole) ([]service.SecretAccess, error)) *MockSecretServiceGetSecretGrantsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -398,6 +398,45 @@ func (c *MockSecretServiceRevokeSecretAccessCall) DoAndReturn(f func(context.Con
 	return c
 }
 
+// SearchSecrets mocks base method.
+func (m *MockSecretService) SearchSecrets(arg0 context.Context, arg1 string, arg2 secret.SecretSearchScope) ([]*secrets.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*secrets.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSecrets indicates an expected call of SearchSecrets.
+func (mr *MockSecretServiceMockRecorder) SearchSecrets(arg0, arg1, arg2 any) *MockSecretServiceSearchSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSecrets", reflect.TypeOf((*MockSecretService)(nil).SearchSecrets), arg0, arg1, arg2)
+	return &MockSecretServiceSearchSecretsCall{Call: call}
+}
+
+// MockSecretServiceSearchSecretsCall wrap *gomock.Call
+type MockSecretServiceSearchSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceSearchSecretsCall) Return(arg0 []*secrets.SecretMetadata, arg1 error) *MockSecretServiceSearchSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceSearchSecretsCall) Do(f func(context.Context, string, secret.SecretSearchScope) ([]*secrets.SecretMetadata, error)) *MockSecretServiceSearchSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceSearchSecretsCall) DoAndReturn(f func(context.Context, string, secret.SecretSearchScope) ([]*secrets.SecretMetadata, error)) *MockSecretServiceSearchSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateUserSecret mocks base method.
 func (m *MockSecretService) UpdateUserSecret(arg0 context.Context, arg1 *secrets.URI, arg2 service.UpdateUserSecretParams) error {
 	m.ctrl.T.Helper()
@@ -21,6 +21,9 @@ func Register(registry facade.FacadeRegistry) {
 		return newSecretsAPIV1(stdCtx, ctx)
 	}, reflect.TypeOf((*SecretsAPI)(nil)))
 	registry.MustRegister("Secrets", 2, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newSecretsAPIV2(stdCtx, ctx)
+	}, reflect.TypeOf((*SecretsAPIV2)(nil)))
+	registry.MustRegister("Secrets", 3, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
 		return newSecretsAPI(stdCtx, ctx)
 	}, reflect.TypeOf((*SecretsAPI)(nil)))
 }
@@ -33,6 +36,14 @@ func newSecretsAPIV1(stdCtx context.Context, context facade.ModelContext) (*Secr
 	return &SecretsAPIV1{SecretsAPI: api}, nil
 }
 
+func newSecretsAPIV2(stdCtx context.Context, context facade.ModelContext) (*SecretsAPIV2, error) {
+	api, err := newSecretsAPI(stdCtx, context)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &SecretsAPIV2{SecretsAPI: api}, nil
+}
+
 // newSecretsAPI creates a SecretsAPI.
 func newSecretsAPI(stdCtx context.Context, ctx facade.ModelContext) (*SecretsAPI, error) {
 	if !ctx.Auth().AuthClient() {
@@ -36,6 +36,11 @@ type SecretsAPI struct {
 	secretService        SecretService
 }
 
+// SecretsAPIV2 is the backend for the Secrets facade v2.
+type SecretsAPIV2 struct {
+	*SecretsAPI
+}
+
 // SecretsAPIV1 is the backend for the Secrets facade v1.
 type SecretsAPIV1 struct {
 	*SecretsAPI
@@ -199,6 +204,53 @@ func (s *SecretsAPI) ListSecrets(ctx context.Context, arg params.ListSecretsArgs
 	return result, nil
 }
 
+// SearchSecrets isn't on the v1 API.
+func (s *SecretsAPIV1) SearchSecrets(_ context.Context, _ struct{}) {}
+
+// SearchSecrets isn't on the v2 API.
+func (s *SecretsAPIV2) SearchSecrets(_ context.Context, _ struct{}) {}
+
+// SearchSecrets returns the metadata of the secrets whose label or
+// description contains the query, ignoring case. If no scope is
+// specified, all secrets in the model are searched.
+func (s *SecretsAPI) SearchSecrets(ctx context.Context, arg params.SearchSecretsArgs) (params.ListSecretResults, error) {
+	result := params.ListSecretResults{}
+	if err := s.checkCanRead(ctx); err != nil {
+		return result, errors.Trace(err)
+	}
+	scope := domainsecret.SearchAll
+	if arg.Scope != "" {
+		scope = domainsecret.SecretSearchScope(arg.Scope)
+	}
+	metadata, err := s.secretService.SearchSecrets(ctx, arg.Query, scope)
+	if err != nil {
+		return result, errors.Trace(err)
+	}
+	result.Results = make([]params.ListSecretResult, len(metadata))
+	for i, m := range metadata {
+		ownerTag, err := commonsecrets.OwnerTagFromOwner(m.Owner)
+		if err != nil {
+			// This should never happen.
+			return params.ListSecretResults{}, errors.Trace(err)
+		}
+		result.Results[i] = params.ListSecretResult{
+			URI:                    m.URI.String(),
+			Version:                m.Version,
+			OwnerTag:               ownerTag.String(),
+			Description:            m.Description,
+			Label:                  m.Label,
+			RotatePolicy:           string(m.RotatePolicy),
+			NextRotateTime:         m.NextRotateTime,
+			LatestRevision:         m.LatestRevision,
+			LatestRevisionChecksum: m.LatestRevisionChecksum,
+			LatestExpireTime:       m.LatestExpireTime,
+			CreateTime:             m.CreateTime,
+			UpdateTime:             m.UpdateTime,
+		}
+	}
+	return result, nil
+}
+
 func tagFromSubject(access secretservice.SecretAccessor) (names.Tag, error) {
 	switch kind := access.Kind; kind {
 	case secretservice.UnitAccessor:
@@ -347,7 +399,7 @@ func (s *SecretsAPI) secretURI(ctx context.Context, uriStr, label string) (*core
 	if uriStr != "" {
 		return coresecrets.ParseURI(uriStr)
 	}
-	uri, err := s.secretService.GetUserSecretURIByLabel(ctx, label)
+	uri, err := s.secretService.GetSecretByLabel(ctx, label, string(coresecrets.ModelOwner), s.modelUUID)
 	if err != nil {
 		return nil, errors.Annotatef(err, "getting user secret for label %q", label)
 	}
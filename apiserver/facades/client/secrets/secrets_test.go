@@ -208,6 +208,79 @@ func (s *SecretsSuite) TestListSecretsPermissionDeniedShow(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "permission denied")
 }
 
+func (s *SecretsSuite) TestSearchSecrets(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+
+	now := time.Now()
+	uri := coresecrets.NewURI()
+	s.secretService.EXPECT().SearchSecrets(gomock.Any(), "foo", secret.SearchOwned).Return([]*coresecrets.SecretMetadata{{
+		URI:            uri,
+		Version:        1,
+		Owner:          coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()},
+		RotatePolicy:   coresecrets.RotateNever,
+		LatestRevision: 2,
+		Description:    "shhh",
+		Label:          "foobar",
+		CreateTime:     now,
+		UpdateTime:     now.Add(time.Second),
+	}}, nil)
+
+	results, err := facade.SearchSecrets(context.Background(), params.SearchSecretsArgs{
+		Query: "foo",
+		Scope: "owned",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, jc.DeepEquals, params.ListSecretResults{
+		Results: []params.ListSecretResult{{
+			URI:            uri.String(),
+			Version:        1,
+			OwnerTag:       coretesting.ModelTag.String(),
+			RotatePolicy:   string(coresecrets.RotateNever),
+			LatestRevision: 2,
+			Description:    "shhh",
+			Label:          "foobar",
+			CreateTime:     now,
+			UpdateTime:     now.Add(time.Second),
+		}},
+	})
+}
+
+func (s *SecretsSuite) TestSearchSecretsDefaultScope(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(nil)
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.secretService.EXPECT().SearchSecrets(gomock.Any(), "foo", secret.SearchAll).Return(nil, nil)
+
+	results, err := facade.SearchSecrets(context.Background(), params.SearchSecretsArgs{Query: "foo"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results.Results, gc.HasLen, 0)
+}
+
+func (s *SecretsSuite) TestSearchSecretsPermissionDenied(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	s.expectAuthClient()
+	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.ReadAccess, coretesting.ModelTag).Return(
+		errors.WithType(apiservererrors.ErrPerm, authentication.ErrorEntityMissingPermission))
+
+	facade, err := apisecrets.NewTestAPI(s.authTag, s.authorizer, s.secretService, s.secretBackendService)
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = facade.SearchSecrets(context.Background(), params.SearchSecretsArgs{Query: "foo"})
+	c.Assert(err, gc.ErrorMatches, "permission denied")
+}
+
 func (s *SecretsSuite) TestCreateSecretsPermissionDenied(c *gc.C) {
 	defer s.setup(c).Finish()
 
@@ -297,7 +370,7 @@ func (s *SecretsSuite) assertUpdateSecrets(c *gc.C, uri *coresecrets.URI) {
 	if uri == nil {
 		existingLabel = "my-secret"
 		uri = coresecrets.NewURI()
-		s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "my-secret").Return(uri, nil)
+		s.secretService.EXPECT().GetSecretByLabel(gomock.Any(), "my-secret", "model", coretesting.ModelTag.Id()).Return(uri, nil)
 	} else {
 		uriString = uri.String()
 	}
@@ -486,7 +559,7 @@ func (s *SecretsSuite) TestGrantSecretByName(c *gc.C) {
 	s.authorizer.EXPECT().HasPermission(gomock.Any(), permission.WriteAccess, coretesting.ModelTag).Return(nil)
 
 	uri := coresecrets.NewURI()
-	s.secretService.EXPECT().GetUserSecretURIByLabel(gomock.Any(), "my-secret").Return(uri, nil)
+	s.secretService.EXPECT().GetSecretByLabel(gomock.Any(), "my-secret", "model", coretesting.ModelTag.Id()).Return(uri, nil)
 	s.secretService.EXPECT().GrantSecretAccess(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
 		func(_ context.Context, arg *coresecrets.URI, params secretservice.SecretAccessParams) error {
 			c.Assert(arg, gc.DeepEquals, uri)
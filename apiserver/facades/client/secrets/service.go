@@ -22,13 +22,14 @@ type SecretService interface {
 
 	// View and fetch secrets.
 
-	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
+	GetSecretByLabel(ctx context.Context, label string, ownerKind, ownerName string) (*secrets.URI, error)
 	GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error)
 	ListSecrets(ctx context.Context, uri *secrets.URI,
 		revision *int,
 		labels domainsecret.Labels,
 	) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
 	ListCharmSecrets(ctx context.Context, owners ...secretservice.CharmSecretOwner) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
+	SearchSecrets(ctx context.Context, query string, scope domainsecret.SecretSearchScope) ([]*secrets.SecretMetadata, error)
 
 	// Delete secrets.
 
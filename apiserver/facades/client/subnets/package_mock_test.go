@@ -458,3 +458,42 @@ func (c *MockNetworkServiceSubnetsByCIDRCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// ValidateAndAddSubnet mocks base method.
+func (m *MockNetworkService) ValidateAndAddSubnet(arg0 context.Context, arg1 network.SubnetInfo) (network.Id, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAndAddSubnet", arg0, arg1)
+	ret0, _ := ret[0].(network.Id)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAndAddSubnet indicates an expected call of ValidateAndAddSubnet.
+func (mr *MockNetworkServiceMockRecorder) ValidateAndAddSubnet(arg0, arg1 any) *MockNetworkServiceValidateAndAddSubnetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAndAddSubnet", reflect.TypeOf((*MockNetworkService)(nil).ValidateAndAddSubnet), arg0, arg1)
+	return &MockNetworkServiceValidateAndAddSubnetCall{Call: call}
+}
+
+// MockNetworkServiceValidateAndAddSubnetCall wrap *gomock.Call
+type MockNetworkServiceValidateAndAddSubnetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockNetworkServiceValidateAndAddSubnetCall) Return(arg0 network.Id, arg1 error) *MockNetworkServiceValidateAndAddSubnetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockNetworkServiceValidateAndAddSubnetCall) Do(f func(context.Context, network.SubnetInfo) (network.Id, error)) *MockNetworkServiceValidateAndAddSubnetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockNetworkServiceValidateAndAddSubnetCall) DoAndReturn(f func(context.Context, network.SubnetInfo) (network.Id, error)) *MockNetworkServiceValidateAndAddSubnetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -16,7 +16,14 @@ import (
 // Register is called to expose a package of facades onto a given registry.
 func Register(registry facade.FacadeRegistry) {
 	registry.MustRegister("Subnets", 5, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
-		return newAPI(ctx) // Removes AddSubnets.
+		api, err := newAPI(ctx) // Removes AddSubnets.
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return &APIv5{API: api}, nil
+	}, reflect.TypeOf((*APIv5)(nil)))
+	registry.MustRegister("Subnets", 6, func(stdCtx context.Context, ctx facade.ModelContext) (facade.Facade, error) {
+		return newAPI(ctx) // Adds AddSubnets with CIDR validation.
 	}, reflect.TypeOf((*API)(nil)))
 }
 
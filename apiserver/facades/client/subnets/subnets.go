@@ -55,9 +55,18 @@ type NetworkService interface {
 	GetAllSubnets(ctx context.Context) (network.SubnetInfos, error)
 	// SubnetsByCIDR returns the subnets matching the input CIDRs.
 	SubnetsByCIDR(ctx context.Context, cidrs ...string) ([]network.SubnetInfo, error)
+	// ValidateAndAddSubnet validates the CIDR of the input subnet against
+	// the constraints of the model's provider and its existing subnets, and
+	// then creates the subnet.
+	ValidateAndAddSubnet(ctx context.Context, args network.SubnetInfo) (network.Id, error)
 }
 
-// API provides the subnets API facade for version 5.
+// APIv5 provides the subnets API facade for version 5.
+type APIv5 struct {
+	*API
+}
+
+// API provides the subnets API facade for version 6.
 type API struct {
 	backing                     Backing
 	resources                   facade.Resources
@@ -71,6 +80,10 @@ func (api *API) checkCanRead(ctx context.Context) error {
 	return api.authorizer.HasPermission(ctx, permission.ReadAccess, api.backing.ModelTag())
 }
 
+func (api *API) checkCanWrite(ctx context.Context) error {
+	return api.authorizer.HasPermission(ctx, permission.AdminAccess, api.backing.ModelTag())
+}
+
 // newAPIWithBacking creates a new server-side Subnets API facade with
 // a common.NetworkBacking
 func newAPIWithBacking(
@@ -188,3 +201,48 @@ func (api *API) SubnetsByCIDR(ctx stdcontext.Context, arg params.CIDRParams) (pa
 	result.Results = results
 	return result, nil
 }
+
+// AddSubnets isn't on the v5 API.
+func (api *APIv5) AddSubnets(_ context.Context, _ struct{}) {}
+
+// AddSubnets adds the input subnets to the model. The CIDR of each subnet is
+// validated against the constraints of the model's provider and the existing
+// subnets of the model before it is added.
+func (api *API) AddSubnets(ctx stdcontext.Context, args params.AddSubnetsParams) (params.ErrorResults, error) {
+	if err := api.checkCanWrite(ctx); err != nil {
+		return params.ErrorResults{}, err
+	}
+
+	results := make([]params.ErrorResult, len(args.Subnets))
+	for i, arg := range args.Subnets {
+		if err := api.addSubnet(ctx, arg); err != nil {
+			results[i].Error = apiservererrors.ServerError(err)
+		}
+	}
+	return params.ErrorResults{Results: results}, nil
+}
+
+func (api *API) addSubnet(ctx context.Context, arg params.AddSubnetParams) error {
+	if !network.IsValidCIDR(arg.CIDR) {
+		return errors.NotValidf("CIDR %q", arg.CIDR)
+	}
+	spaceTag, err := names.ParseSpaceTag(arg.SpaceTag)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	space, err := api.networkService.SpaceByName(ctx, spaceTag.Id())
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	_, err = api.networkService.ValidateAndAddSubnet(ctx, network.SubnetInfo{
+		CIDR:              arg.CIDR,
+		ProviderId:        network.Id(arg.SubnetProviderId),
+		ProviderNetworkId: network.Id(arg.ProviderNetworkId),
+		VLANTag:           arg.VLANTag,
+		AvailabilityZones: arg.Zones,
+		SpaceID:           space.ID,
+		SpaceName:         string(space.Name),
+	})
+	return errors.Trace(err)
+}
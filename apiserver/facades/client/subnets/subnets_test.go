@@ -75,6 +75,50 @@ func (s *SubnetSuite) TestSubnetsByCIDR(c *gc.C) {
 	c.Check(results[2].Error.Message, gc.Equals, `CIDR "not-a-cidr" not valid`)
 }
 
+func (s *SubnetSuite) TestAddSubnets(c *gc.C) {
+	ctrl := s.setupSubnetsAPI(c)
+	defer ctrl.Finish()
+
+	space := &network.SpaceInfo{ID: "space-uuid", Name: "dmz"}
+	s.mockNetworkService.EXPECT().SpaceByName(gomock.Any(), "dmz").Return(space, nil).Times(2)
+	s.mockNetworkService.EXPECT().ValidateAndAddSubnet(gomock.Any(), network.SubnetInfo{
+		CIDR:              "10.0.0.0/24",
+		ProviderId:        "provider-id",
+		AvailabilityZones: []string{"zone1"},
+		SpaceID:           "space-uuid",
+		SpaceName:         "dmz",
+	}).Return("subnet-uuid", nil)
+	s.mockNetworkService.EXPECT().ValidateAndAddSubnet(gomock.Any(), network.SubnetInfo{
+		CIDR:      "10.0.0.0/30",
+		SpaceID:   "space-uuid",
+		SpaceName: "dmz",
+	}).Return("", errors.New("subnet too small"))
+
+	res, err := s.api.AddSubnets(stdcontext.Background(), params.AddSubnetsParams{
+		Subnets: []params.AddSubnetParams{{
+			CIDR:             "10.0.0.0/24",
+			SubnetProviderId: "provider-id",
+			SpaceTag:         "space-dmz",
+			Zones:            []string{"zone1"},
+		}, {
+			CIDR:     "10.0.0.0/30",
+			SpaceTag: "space-dmz",
+		}, {
+			CIDR:     "not-a-cidr",
+			SpaceTag: "space-dmz",
+		}, {
+			CIDR:     "10.0.1.0/24",
+			SpaceTag: "dmz",
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res.Results, gc.HasLen, 4)
+	c.Check(res.Results[0].Error, gc.IsNil)
+	c.Check(res.Results[1].Error, gc.ErrorMatches, "subnet too small")
+	c.Check(res.Results[2].Error, gc.ErrorMatches, `CIDR "not-a-cidr" not valid`)
+	c.Check(res.Results[3].Error, gc.ErrorMatches, `"dmz" is not a valid tag`)
+}
+
 func (s *SubnetSuite) setupSubnetsAPI(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.mockResource = facademocks.NewMockResources(ctrl)
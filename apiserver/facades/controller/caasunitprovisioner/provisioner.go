@@ -32,13 +32,14 @@ type NetworkService interface {
 // ApplicationService is used to interact with the application service.
 type ApplicationService interface {
 	GetApplicationScale(ctx context.Context, appName string) (int, error)
-	SetApplicationScale(ctx context.Context, appName string, scale int) error
+	SetApplicationScale(ctx context.Context, appName string, scale int, actor string) error
 	UpdateCloudService(ctx context.Context, appName, providerID string, sAddrs network.SpaceAddresses) error
 	WatchApplicationScale(ctx context.Context, appName string) (watcher.NotifyWatcher, error)
 }
 
 type Facade struct {
 	watcherRegistry facade.WatcherRegistry
+	authTag         names.Tag
 
 	networkService     NetworkService
 	applicationService ApplicationService
@@ -64,6 +65,7 @@ func NewFacade(
 	}
 	return &Facade{
 		watcherRegistry:    watcherRegistry,
+		authTag:            authorizer.GetAuthTag(),
 		networkService:     networkService,
 		applicationService: applicationService,
 		resources:          resources,
@@ -235,7 +237,7 @@ func (f *Facade) UpdateApplicationsService(ctx context.Context, args params.Upda
 			result.Results[i].Error = apiservererrors.ServerError(err)
 		}
 		if appUpdate.Scale != nil {
-			if err := f.applicationService.SetApplicationScale(ctx, appName, *appUpdate.Scale); err != nil {
+			if err := f.applicationService.SetApplicationScale(ctx, appName, *appUpdate.Scale, f.authTag.String()); err != nil {
 				if errors.Is(err, applicationerrors.ApplicationNotFound) {
 					err = errors.NotFoundf("application %s not found", appName)
 				}
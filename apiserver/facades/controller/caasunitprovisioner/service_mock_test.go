@@ -57,17 +57,17 @@ func (mr *MockApplicationServiceMockRecorder) GetApplicationScale(arg0, arg1 any
 }
 
 // SetApplicationScale mocks base method.
-func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int) error {
+func (m *MockApplicationService) SetApplicationScale(arg0 context.Context, arg1 string, arg2 int, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetApplicationScale", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetApplicationScale", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetApplicationScale indicates an expected call of SetApplicationScale.
-func (mr *MockApplicationServiceMockRecorder) SetApplicationScale(arg0, arg1, arg2 any) *gomock.Call {
+func (mr *MockApplicationServiceMockRecorder) SetApplicationScale(arg0, arg1, arg2, arg3 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationScale), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationScale", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationScale), arg0, arg1, arg2, arg3)
 }
 
 // UpdateCloudService mocks base method.
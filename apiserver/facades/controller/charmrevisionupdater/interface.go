@@ -55,6 +55,15 @@ type ModelConfigService interface {
 	ModelConfig(ctx context.Context) (*config.Config, error)
 }
 
+// ApplicationService is an interface that provides access to the
+// application domain.
+type ApplicationService interface {
+	// SetApplicationsLatestCharmRevision records the latest revision of the
+	// charm available in the charm repository for each of the named
+	// applications.
+	SetApplicationsLatestCharmRevision(ctx context.Context, revisions map[string]int) error
+}
+
 // StateShim takes a *state.State and implements this package's State interface.
 type StateShim struct {
 	*state.State
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater (interfaces: Application,CharmhubRefreshClient,Model,State,ModelConfigService,Resources)
+// Source: github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater (interfaces: Application,ApplicationService,CharmhubRefreshClient,Model,State,ModelConfigService,Resources)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/mocks.go github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater Application,CharmhubRefreshClient,Model,State,ModelConfigService,Resources
+//	mockgen -typed -package mocks -destination mocks/mocks.go github.com/juju/juju/apiserver/facades/controller/charmrevisionupdater Application,ApplicationService,CharmhubRefreshClient,Model,State,ModelConfigService,Resources
 //
 
 // Package mocks is a generated GoMock package.
@@ -203,6 +203,67 @@ func (c *MockApplicationUnitCountCall) DoAndReturn(f func() int) *MockApplicatio
 	return c
 }
 
+// MockApplicationService is a mock of ApplicationService interface.
+type MockApplicationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApplicationServiceMockRecorder
+}
+
+// MockApplicationServiceMockRecorder is the mock recorder for MockApplicationService.
+type MockApplicationServiceMockRecorder struct {
+	mock *MockApplicationService
+}
+
+// NewMockApplicationService creates a new mock instance.
+func NewMockApplicationService(ctrl *gomock.Controller) *MockApplicationService {
+	mock := &MockApplicationService{ctrl: ctrl}
+	mock.recorder = &MockApplicationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApplicationService) EXPECT() *MockApplicationServiceMockRecorder {
+	return m.recorder
+}
+
+// SetApplicationsLatestCharmRevision mocks base method.
+func (m *MockApplicationService) SetApplicationsLatestCharmRevision(arg0 context.Context, arg1 map[string]int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationsLatestCharmRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationsLatestCharmRevision indicates an expected call of SetApplicationsLatestCharmRevision.
+func (mr *MockApplicationServiceMockRecorder) SetApplicationsLatestCharmRevision(arg0, arg1 any) *MockApplicationServiceSetApplicationsLatestCharmRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationsLatestCharmRevision", reflect.TypeOf((*MockApplicationService)(nil).SetApplicationsLatestCharmRevision), arg0, arg1)
+	return &MockApplicationServiceSetApplicationsLatestCharmRevisionCall{Call: call}
+}
+
+// MockApplicationServiceSetApplicationsLatestCharmRevisionCall wrap *gomock.Call
+type MockApplicationServiceSetApplicationsLatestCharmRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationServiceSetApplicationsLatestCharmRevisionCall) Return(arg0 error) *MockApplicationServiceSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationServiceSetApplicationsLatestCharmRevisionCall) Do(f func(context.Context, map[string]int) error) *MockApplicationServiceSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationServiceSetApplicationsLatestCharmRevisionCall) DoAndReturn(f func(context.Context, map[string]int) error) *MockApplicationServiceSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockCharmhubRefreshClient is a mock of CharmhubRefreshClient interface.
 type MockCharmhubRefreshClient struct {
 	ctrl     *gomock.Controller
@@ -57,6 +57,7 @@ func newCharmRevisionUpdaterAPI(ctx facade.ModelContext) (*CharmRevisionUpdaterA
 		ctx.ObjectStore(),
 		clock.WallClock,
 		modelConfigService,
+		ctx.DomainServices().Application(),
 		newCharmhubClient,
 		ctx.Logger().Child("charmrevisionupdater"),
 	)
@@ -38,6 +38,7 @@ type CharmRevisionUpdaterAPI struct {
 	clock clock.Clock
 
 	modelConfigService ModelConfigService
+	applicationService ApplicationService
 	newCharmhubClient  NewCharmhubClientFunc
 	logger             corelogger.Logger
 }
@@ -53,6 +54,7 @@ func NewCharmRevisionUpdaterAPIState(
 	store objectstore.ObjectStore,
 	clock clock.Clock,
 	modelConfigService ModelConfigService,
+	applicationService ApplicationService,
 	newCharmhubClient NewCharmhubClientFunc,
 	logger corelogger.Logger,
 ) (*CharmRevisionUpdaterAPI, error) {
@@ -61,6 +63,7 @@ func NewCharmRevisionUpdaterAPIState(
 		store:              store,
 		clock:              clock,
 		modelConfigService: modelConfigService,
+		applicationService: applicationService,
 		newCharmhubClient:  newCharmhubClient,
 		logger:             logger,
 	}, nil
@@ -83,6 +86,18 @@ func (api *CharmRevisionUpdaterAPI) updateLatestRevisions(ctx context.Context) e
 		return errors.Trace(err)
 	}
 
+	// Record the latest revision of each application's charm, so that
+	// pending charm upgrades can be found without asking the charm store.
+	if len(latest) > 0 {
+		revisions := make(map[string]int, len(latest))
+		for _, info := range latest {
+			revisions[info.appID] = info.revision
+		}
+		if err := api.applicationService.SetApplicationsLatestCharmRevision(ctx, revisions); err != nil {
+			return errors.Trace(err)
+		}
+	}
+
 	// Process the resulting info for each charm.
 	resources := api.state.Resources(api.store)
 	for _, info := range latest {
@@ -38,6 +38,7 @@ type updaterSuite struct {
 	objectStore        *mocks.MockObjectStore
 	cloudService       *commonmocks.MockCloudService
 	modelConfigService *mocks.MockModelConfigService
+	applicationService *mocks.MockApplicationService
 	resources          *mocks.MockResources
 	client             *mocks.MockCharmhubRefreshClient
 
@@ -99,6 +100,10 @@ func (s *updaterSuite) TestCharmhubUpdate(c *gc.C) {
 		makeApplication(ctrl, "ch", "mysql", "charm-1", "app-1", 22),
 		makeApplication(ctrl, "ch", "postgresql", "charm-2", "app-2", 41),
 	}, nil).AnyTimes()
+	s.applicationService.EXPECT().SetApplicationsLatestCharmRevision(gomock.Any(), map[string]int{
+		"app-1": 23,
+		"app-2": 42,
+	}).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:mysql-23")).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:postgresql-42")).Return(nil)
 
@@ -171,6 +176,10 @@ func (s *updaterSuite) testCharmhubUpdateMetrics(c *gc.C, ctrl *gomock.Controlle
 		makeApplication(ctrl, "ch", "mysql", "charm-1", "app-1", 22),
 		makeApplication(ctrl, "ch", "postgresql", "charm-2", "app-2", 41),
 	}, nil).AnyTimes()
+	s.applicationService.EXPECT().SetApplicationsLatestCharmRevision(gomock.Any(), map[string]int{
+		"app-1": 23,
+		"app-2": 42,
+	}).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:mysql-23")).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:postgresql-42")).Return(nil)
 
@@ -285,6 +294,9 @@ func (s *updaterSuite) TestCharmhubUpdateWithResources(c *gc.C) {
 		makeApplication(ctrl, "ch", "resourcey", "charm-3", "app-1", 1),
 	}, nil).AnyTimes()
 
+	s.applicationService.EXPECT().SetApplicationsLatestCharmRevision(gomock.Any(), map[string]int{
+		"app-1": 1,
+	}).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:resourcey-1")).Return(nil)
 
 	result, err := s.api(c).UpdateLatestRevisions(context.Background())
@@ -311,6 +323,9 @@ func (s *updaterSuite) TestCharmhubNoUpdate(c *gc.C) {
 	s.state.EXPECT().AllApplications().Return([]charmrevisionupdater.Application{
 		makeApplication(ctrl, "ch", "postgresql", "charm-2", "app-2", 42),
 	}, nil).AnyTimes()
+	s.applicationService.EXPECT().SetApplicationsLatestCharmRevision(gomock.Any(), map[string]int{
+		"app-2": 42,
+	}).Return(nil)
 	s.state.EXPECT().AddCharmPlaceholder(charm.MustParseURL("ch:postgresql-42")).Return(nil)
 
 	result, err := s.api(c).UpdateLatestRevisions(context.Background())
@@ -357,6 +372,7 @@ func (s *updaterSuite) setupMocksNoResources(c *gc.C) *gomock.Controller {
 
 	s.objectStore = mocks.NewMockObjectStore(ctrl)
 	s.modelConfigService = mocks.NewMockModelConfigService(ctrl)
+	s.applicationService = mocks.NewMockApplicationService(ctrl)
 	s.client = mocks.NewMockCharmhubRefreshClient(ctrl)
 	return ctrl
 }
@@ -391,6 +407,7 @@ func (s *updaterSuite) api(c *gc.C) *charmrevisionupdater.CharmRevisionUpdaterAP
 		s.objectStore,
 		s.clock,
 		s.modelConfigService,
+		s.applicationService,
 		clientFunc,
 		loggertesting.WrapCheckLog(c))
 	c.Assert(err, jc.ErrorIsNil)
@@ -14,6 +14,7 @@ import (
 	apiservererrors "github.com/juju/juju/apiserver/errors"
 	"github.com/juju/juju/apiserver/facade"
 	corelogger "github.com/juju/juju/core/logger"
+	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/rpc/params"
@@ -330,12 +331,34 @@ func (a *InstancePollerAPI) SetInstanceStatus(ctx context.Context, args params.S
 					err = machine.SetStatus(s)
 				}
 			}
+			if err == nil && s.Status == status.Running {
+				a.syncInstanceTags(ctx, machine.Id())
+			}
 		}
 		result.Results[i].Error = apiservererrors.ServerError(err)
 	}
 	return result, nil
 }
 
+// syncInstanceTags records the tags of the machine's instance as reported by
+// the provider. Tags are synced whenever the instance is seen to be running,
+// so that tags added directly in the cloud are picked up without polling the
+// provider for them separately. Failures are logged rather than returned, as
+// the instance status has already been updated.
+func (a *InstancePollerAPI) syncInstanceTags(ctx context.Context, machineID string) {
+	machineUUID, err := a.machineService.GetMachineUUID(ctx, coremachine.Name(machineID))
+	if err != nil {
+		a.logger.Warningf("cannot sync instance tags for machine %q: %v", machineID, err)
+		return
+	}
+	err = a.machineService.SyncMachineInstanceTags(ctx, machineUUID)
+	if errors.Is(err, errors.NotSupported) {
+		a.logger.Tracef("provider does not report instance tags for machine %q", machineID)
+	} else if err != nil {
+		a.logger.Warningf("cannot sync instance tags for machine %q: %v", machineID, err)
+	}
+}
+
 // AreManuallyProvisioned returns whether each given entity is
 // manually provisioned or not. Only machine tags are accepted.
 func (a *InstancePollerAPI) AreManuallyProvisioned(ctx context.Context, args params.Entities) (params.BoolResults, error) {
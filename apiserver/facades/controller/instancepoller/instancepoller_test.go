@@ -551,6 +551,32 @@ func (s *InstancePollerSuite) TestSetInstanceStatusSuccess(c *gc.C) {
 	c.Assert(setStatus, gc.DeepEquals, status.StatusInfo{Status: "new status"})
 }
 
+func (s *InstancePollerSuite) TestSetInstanceStatusRunningSyncsInstanceTags(c *gc.C) {
+	ctrl := s.setUpMocks(c)
+	defer ctrl.Finish()
+	err := s.setupAPI(c)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.st.SetMachineInfo(c, machineInfo{id: "1", instanceStatus: statusInfo("pending")})
+	s.st.SetMachineInfo(c, machineInfo{id: "2", instanceStatus: statusInfo("pending")})
+
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("1")).Return("uuid-1", nil)
+	s.machineService.EXPECT().SyncMachineInstanceTags(gomock.Any(), "uuid-1").Return(nil)
+	s.machineService.EXPECT().GetMachineUUID(gomock.Any(), machine.Name("2")).Return("uuid-2", nil)
+	s.machineService.EXPECT().SyncMachineInstanceTags(gomock.Any(), "uuid-2").Return(errors.NotSupportedf("instance tags provider"))
+
+	result, err := s.api.SetInstanceStatus(context.Background(), params.SetStatus{
+		Entities: []params.EntityStatusArgs{
+			{Tag: "machine-1", Status: status.Running.String()},
+			{Tag: "machine-2", Status: status.Running.String()},
+		}},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(result, jc.DeepEquals, params.ErrorResults{
+		Results: []params.ErrorResult{{}, {}},
+	})
+}
+
 func (s *InstancePollerSuite) TestSetInstanceStatusFailure(c *gc.C) {
 	ctrl := s.setUpMocks(c)
 	defer ctrl.Finish()
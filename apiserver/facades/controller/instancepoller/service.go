@@ -43,4 +43,7 @@ type MachineService interface {
 	// HardwareCharacteristics returns the hardware characteristics of the
 	// specified machine.
 	HardwareCharacteristics(ctx context.Context, machineUUID string) (*instance.HardwareCharacteristics, error)
+	// SyncMachineInstanceTags fetches the tags of the specified machine's
+	// instance from the provider and records them.
+	SyncMachineInstanceTags(ctx context.Context, machineUUID string) error
 }
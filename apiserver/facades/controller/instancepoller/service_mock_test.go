@@ -361,3 +361,41 @@ func (c *MockMachineServiceInstanceIDAndNameCall) DoAndReturn(f func(context.Con
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// SyncMachineInstanceTags mocks base method.
+func (m *MockMachineService) SyncMachineInstanceTags(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncMachineInstanceTags", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SyncMachineInstanceTags indicates an expected call of SyncMachineInstanceTags.
+func (mr *MockMachineServiceMockRecorder) SyncMachineInstanceTags(arg0, arg1 any) *MockMachineServiceSyncMachineInstanceTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMachineInstanceTags", reflect.TypeOf((*MockMachineService)(nil).SyncMachineInstanceTags), arg0, arg1)
+	return &MockMachineServiceSyncMachineInstanceTagsCall{Call: call}
+}
+
+// MockMachineServiceSyncMachineInstanceTagsCall wrap *gomock.Call
+type MockMachineServiceSyncMachineInstanceTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMachineServiceSyncMachineInstanceTagsCall) Return(arg0 error) *MockMachineServiceSyncMachineInstanceTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMachineServiceSyncMachineInstanceTagsCall) Do(f func(context.Context, string) error) *MockMachineServiceSyncMachineInstanceTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMachineServiceSyncMachineInstanceTagsCall) DoAndReturn(f func(context.Context, string) error) *MockMachineServiceSyncMachineInstanceTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
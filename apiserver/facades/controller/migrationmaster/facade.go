@@ -21,6 +21,7 @@ import (
 	coremigration "github.com/juju/juju/core/migration"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/objectstore"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/rpc/params"
@@ -57,6 +58,7 @@ type API struct {
 	modelInfoService        ModelInfoService
 	modelService            ModelService
 	applicationService      ApplicationService
+	secretBackendService    SecretBackendService
 	store                   objectstore.ObjectStore
 }
 
@@ -82,6 +84,7 @@ func NewAPI(
 	applicationService ApplicationService,
 	upgradeService UpgradeService,
 	modelAgentService ModelAgentService,
+	secretBackendService SecretBackendService,
 ) (*API, error) {
 	if !authorizer.AuthController() {
 		return nil, apiservererrors.ErrPerm
@@ -107,6 +110,7 @@ func NewAPI(
 		applicationService:      applicationService,
 		upgradeService:          upgradeService,
 		modelAgentService:       modelAgentService,
+		secretBackendService:    secretBackendService,
 	}, nil
 }
 
@@ -321,9 +325,34 @@ func (api *API) Export(ctx context.Context) (params.SerializedModel, error) {
 	if model.Type() == string(coremodel.IAAS) {
 		serialized.Tools = getUsedTools(model)
 	}
+
+	// Secret backends hold credentials, so they are carried outside of the
+	// model description.
+	secretBackends, err := api.secretBackendService.GetSecretBackendsForExport(ctx, coremodel.UUID(model.Tag().Id()))
+	if err != nil {
+		return serialized, errors.Annotate(err, "getting secret backends for export")
+	}
+	serialized.SecretBackends = getSecretBackends(secretBackends)
 	return serialized, nil
 }
 
+func getSecretBackends(in *secretbackendservice.SecretBackendsExport) *params.SerializedModelSecretBackends {
+	out := &params.SerializedModelSecretBackends{
+		ModelBackendName: in.ModelBackendName,
+	}
+	for _, b := range in.Backends {
+		out.Backends = append(out.Backends, params.SerializedModelSecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			NextRotateTime:      b.NextRotateTime,
+			Config:              b.Config,
+		})
+	}
+	return out
+}
+
 // ProcessRelations processes any relations that need updating after an export.
 // This should help fix any remoteApplications that have been migrated.
 func (api *API) ProcessRelations(ctx context.Context, args params.ProcessRelations) error {
@@ -31,6 +31,8 @@ import (
 	"github.com/juju/juju/core/presence"
 	usertesting "github.com/juju/juju/core/user/testing"
 	jujuversion "github.com/juju/juju/core/version"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	environscloudspec "github.com/juju/juju/environs/cloudspec"
 	"github.com/juju/juju/environs/config"
 	coretesting "github.com/juju/juju/internal/testing"
@@ -56,6 +58,7 @@ type Suite struct {
 	modelInfoService        *mocks.MockModelInfoService
 	modelService            *mocks.MockModelService
 	upgradeService          *mocks.MockUpgradeService
+	secretBackendService    *mocks.MockSecretBackendService
 
 	controllerUUID string
 	modelUUID      string
@@ -410,6 +413,15 @@ func (s *Suite) assertExport(c *gc.C, modelType string) {
 	unitRev := unitRes.Revision()
 
 	s.modelExporter.EXPECT().ExportModel(gomock.Any(), map[string]string{}, s.store).Return(s.model, nil)
+	s.secretBackendService.EXPECT().GetSecretBackendsForExport(gomock.Any(), model.UUID(s.modelUUID)).Return(&secretbackendservice.SecretBackendsExport{
+		ModelBackendName: "myvault",
+		Backends: []secretbackend.SecretBackend{{
+			ID:          "backend-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      map[string]any{"endpoint": "http://vault"},
+		}},
+	}, nil)
 
 	serialized, err := s.mustMakeAPI(c).Export(context.Background())
 	c.Assert(err, jc.ErrorIsNil)
@@ -467,6 +479,15 @@ func (s *Suite) assertExport(c *gc.C, modelType string) {
 			},
 		},
 	}})
+	c.Check(serialized.SecretBackends, gc.DeepEquals, &params.SerializedModelSecretBackends{
+		ModelBackendName: "myvault",
+		Backends: []params.SerializedModelSecretBackend{{
+			ID:          "backend-id",
+			Name:        "myvault",
+			BackendType: "vault",
+			Config:      map[string]interface{}{"endpoint": "http://vault"},
+		}},
+	})
 }
 
 func (s *Suite) TestReap(c *gc.C) {
@@ -624,6 +645,7 @@ func (s *Suite) setupMocks(c *gc.C) *gomock.Controller {
 	s.precheckBackend = mocks.NewMockPrecheckBackend(ctrl)
 	s.store = mocks.NewMockObjectStore(ctrl)
 	s.upgradeService = mocks.NewMockUpgradeService(ctrl)
+	s.secretBackendService = mocks.NewMockSecretBackendService(ctrl)
 	return ctrl
 }
 
@@ -654,6 +676,7 @@ func (s *Suite) makeAPI() (*migrationmaster.API, error) {
 		s.applicationService,
 		s.upgradeService,
 		s.agentService,
+		s.secretBackendService,
 	)
 }
 
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/migrationmaster (interfaces: Backend,ControllerState,ModelExporter,UpgradeService,ControllerConfigService,ModelConfigService,ModelInfoService,ModelService,ApplicationService,ModelAgentService)
+// Source: github.com/juju/juju/apiserver/facades/controller/migrationmaster (interfaces: Backend,ControllerState,ModelExporter,UpgradeService,ControllerConfigService,ModelConfigService,ModelInfoService,ModelService,ApplicationService,ModelAgentService,SecretBackendService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/backend.go github.com/juju/juju/apiserver/facades/controller/migrationmaster Backend,ControllerState,ModelExporter,UpgradeService,ControllerConfigService,ModelConfigService,ModelInfoService,ModelService,ApplicationService,ModelAgentService
+//	mockgen -typed -package mocks -destination mocks/backend.go github.com/juju/juju/apiserver/facades/controller/migrationmaster Backend,ControllerState,ModelExporter,UpgradeService,ControllerConfigService,ModelConfigService,ModelInfoService,ModelService,ApplicationService,ModelAgentService,SecretBackendService
 //
 
 // Package mocks is a generated GoMock package.
@@ -19,6 +19,7 @@ import (
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
 	objectstore "github.com/juju/juju/core/objectstore"
+	service "github.com/juju/juju/domain/secretbackend/service"
 	config "github.com/juju/juju/environs/config"
 	state "github.com/juju/juju/state"
 	version "github.com/juju/version/v2"
@@ -837,3 +838,65 @@ func (c *MockModelAgentServiceGetModelTargetAgentVersionCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSecretBackendService is a mock of SecretBackendService interface.
+type MockSecretBackendService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretBackendServiceMockRecorder
+}
+
+// MockSecretBackendServiceMockRecorder is the mock recorder for MockSecretBackendService.
+type MockSecretBackendServiceMockRecorder struct {
+	mock *MockSecretBackendService
+}
+
+// NewMockSecretBackendService creates a new mock instance.
+func NewMockSecretBackendService(ctrl *gomock.Controller) *MockSecretBackendService {
+	mock := &MockSecretBackendService{ctrl: ctrl}
+	mock.recorder = &MockSecretBackendServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretBackendService) EXPECT() *MockSecretBackendServiceMockRecorder {
+	return m.recorder
+}
+
+// GetSecretBackendsForExport mocks base method.
+func (m *MockSecretBackendService) GetSecretBackendsForExport(arg0 context.Context, arg1 model.UUID) (*service.SecretBackendsExport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretBackendsForExport", arg0, arg1)
+	ret0, _ := ret[0].(*service.SecretBackendsExport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretBackendsForExport indicates an expected call of GetSecretBackendsForExport.
+func (mr *MockSecretBackendServiceMockRecorder) GetSecretBackendsForExport(arg0, arg1 any) *MockSecretBackendServiceGetSecretBackendsForExportCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretBackendsForExport", reflect.TypeOf((*MockSecretBackendService)(nil).GetSecretBackendsForExport), arg0, arg1)
+	return &MockSecretBackendServiceGetSecretBackendsForExportCall{Call: call}
+}
+
+// MockSecretBackendServiceGetSecretBackendsForExportCall wrap *gomock.Call
+type MockSecretBackendServiceGetSecretBackendsForExportCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceGetSecretBackendsForExportCall) Return(arg0 *service.SecretBackendsExport, arg1 error) *MockSecretBackendServiceGetSecretBackendsForExportCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceGetSecretBackendsForExportCall) Do(f func(context.Context, model.UUID) (*service.SecretBackendsExport, error)) *MockSecretBackendServiceGetSecretBackendsForExportCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceGetSecretBackendsForExportCall) DoAndReturn(f func(context.Context, model.UUID) (*service.SecretBackendsExport, error)) *MockSecretBackendServiceGetSecretBackendsForExportCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
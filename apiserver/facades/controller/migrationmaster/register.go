@@ -73,5 +73,6 @@ func newMigrationMasterFacade(ctx facade.ModelContext) (*API, error) {
 		domainServices.Application(),
 		domainServices.Upgrade(),
 		domainServices.Agent(),
+		domainServices.SecretBackend(),
 	)
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/model"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	"github.com/juju/juju/environs/config"
 )
 
@@ -58,3 +59,12 @@ type ModelAgentService interface {
 	// not exist.
 	GetModelTargetAgentVersion(context.Context) (version.Number, error)
 }
+
+// SecretBackendService provides access to the secret backends used by a
+// model.
+type SecretBackendService interface {
+	// GetSecretBackendsForExport returns the user-defined secret backends
+	// the specified model depends on, along with the name of the backend
+	// the model is configured to use.
+	GetSecretBackendsForExport(ctx context.Context, modelUUID model.UUID) (*secretbackendservice.SecretBackendsExport, error)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/apiserver/facades/controller/migrationtarget (interfaces: ControllerConfigService,ExternalControllerService,UpgradeService,ModelImporter,ModelMigrationService,ApplicationService,ModelAgentService)
+// Source: github.com/juju/juju/apiserver/facades/controller/migrationtarget (interfaces: ControllerConfigService,ExternalControllerService,UpgradeService,ModelImporter,ModelMigrationService,ApplicationService,ModelAgentService,SecretBackendService)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package migrationtarget_test -destination domain_mock_test.go github.com/juju/juju/apiserver/facades/controller/migrationtarget ControllerConfigService,ExternalControllerService,UpgradeService,ModelImporter,ModelMigrationService,ApplicationService,ModelAgentService
+//	mockgen -typed -package migrationtarget_test -destination domain_mock_test.go github.com/juju/juju/apiserver/facades/controller/migrationtarget ControllerConfigService,ExternalControllerService,UpgradeService,ModelImporter,ModelMigrationService,ApplicationService,ModelAgentService,SecretBackendService
 //
 
 // Package migrationtarget_test is a generated GoMock package.
@@ -16,7 +16,9 @@ import (
 	controller "github.com/juju/juju/controller"
 	crossmodel "github.com/juju/juju/core/crossmodel"
 	life "github.com/juju/juju/core/life"
+	model "github.com/juju/juju/core/model"
 	modelmigration "github.com/juju/juju/domain/modelmigration"
+	service "github.com/juju/juju/domain/secretbackend/service"
 	state "github.com/juju/juju/state"
 	version "github.com/juju/version/v2"
 	gomock "go.uber.org/mock/gomock"
@@ -532,3 +534,64 @@ func (c *MockModelAgentServiceGetModelTargetAgentVersionCall) DoAndReturn(f func
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockSecretBackendService is a mock of SecretBackendService interface.
+type MockSecretBackendService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretBackendServiceMockRecorder
+}
+
+// MockSecretBackendServiceMockRecorder is the mock recorder for MockSecretBackendService.
+type MockSecretBackendServiceMockRecorder struct {
+	mock *MockSecretBackendService
+}
+
+// NewMockSecretBackendService creates a new mock instance.
+func NewMockSecretBackendService(ctrl *gomock.Controller) *MockSecretBackendService {
+	mock := &MockSecretBackendService{ctrl: ctrl}
+	mock.recorder = &MockSecretBackendServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretBackendService) EXPECT() *MockSecretBackendServiceMockRecorder {
+	return m.recorder
+}
+
+// ImportSecretBackends mocks base method.
+func (m *MockSecretBackendService) ImportSecretBackends(arg0 context.Context, arg1 model.UUID, arg2 service.SecretBackendsExport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSecretBackends", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportSecretBackends indicates an expected call of ImportSecretBackends.
+func (mr *MockSecretBackendServiceMockRecorder) ImportSecretBackends(arg0, arg1, arg2 any) *MockSecretBackendServiceImportSecretBackendsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecretBackends", reflect.TypeOf((*MockSecretBackendService)(nil).ImportSecretBackends), arg0, arg1, arg2)
+	return &MockSecretBackendServiceImportSecretBackendsCall{Call: call}
+}
+
+// MockSecretBackendServiceImportSecretBackendsCall wrap *gomock.Call
+type MockSecretBackendServiceImportSecretBackendsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretBackendServiceImportSecretBackendsCall) Return(arg0 error) *MockSecretBackendServiceImportSecretBackendsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretBackendServiceImportSecretBackendsCall) Do(f func(context.Context, model.UUID, service.SecretBackendsExport) error) *MockSecretBackendServiceImportSecretBackendsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretBackendServiceImportSecretBackendsCall) DoAndReturn(f func(context.Context, model.UUID, service.SecretBackendsExport) error) *MockSecretBackendServiceImportSecretBackendsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
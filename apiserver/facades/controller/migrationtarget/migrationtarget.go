@@ -27,6 +27,8 @@ import (
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/domain/modelmigration"
+	"github.com/juju/juju/domain/secretbackend"
+	secretbackendservice "github.com/juju/juju/domain/secretbackend/service"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/migration"
 	"github.com/juju/juju/rpc/params"
@@ -93,6 +95,14 @@ type ModelAgentService interface {
 	GetModelTargetAgentVersion(context.Context) (version.Number, error)
 }
 
+// SecretBackendService provides a subset of the secret backend domain
+// service methods.
+type SecretBackendService interface {
+	// ImportSecretBackends creates the secret backends a migrated model
+	// depends on and sets the model's secret backend.
+	ImportSecretBackends(ctx context.Context, modelUUID coremodel.UUID, in secretbackendservice.SecretBackendsExport) error
+}
+
 // ModelMigrationServiceGetter describes a function that is able to return the
 // [ModelMigrationService] for a given model id.
 type ModelMigrationServiceGetter func(coremodel.UUID) ModelMigrationService
@@ -114,6 +124,8 @@ type API struct {
 	modelImporter  ModelImporter
 	upgradeService UpgradeService
 
+	secretBackendService SecretBackendService
+
 	applicationService          ApplicationService
 	controllerConfigService     ControllerConfigService
 	externalControllerService   ExternalControllerService
@@ -138,6 +150,7 @@ func NewAPI(
 	externalControllerService ExternalControllerService,
 	applicationService ApplicationService,
 	upgradeService UpgradeService,
+	secretBackendService SecretBackendService,
 	modelAgentServiceGetter ModelAgentServiceGetter,
 	modelMigrationServiceGetter ModelMigrationServiceGetter,
 	requiredMigrationFacadeVersions facades.FacadeVersions,
@@ -151,6 +164,7 @@ func NewAPI(
 		externalControllerService:       externalControllerService,
 		applicationService:              applicationService,
 		upgradeService:                  upgradeService,
+		secretBackendService:            secretBackendService,
 		modelAgentServiceGetter:         modelAgentServiceGetter,
 		modelMigrationServiceGetter:     modelMigrationServiceGetter,
 		authorizer:                      authorizer,
@@ -265,19 +279,45 @@ with an earlier version of the target controller and try again.
 }
 
 // Import takes a serialized Juju model, deserializes it, and
-// recreates it in the receiving controller.
+// recreates it in the receiving controller, along with the secret backends
+// the model depends on.
 func (api *API) Import(ctx context.Context, serialized params.SerializedModel) error {
-	_, st, err := api.modelImporter.ImportModel(ctx, serialized.Bytes)
+	model, st, err := api.modelImporter.ImportModel(ctx, serialized.Bytes)
 	if err != nil {
 		return err
 	}
 	defer st.Close()
+
+	// Source controllers which don't export secret backends leave the model
+	// with the backend it was created with.
+	if serialized.SecretBackends != nil {
+		if err := api.importSecretBackends(ctx, coremodel.UUID(model.UUID()), *serialized.SecretBackends); err != nil {
+			return errors.Errorf("importing secret backends: %w", err)
+		}
+	}
 	// TODO(mjs) - post import checks
 	// NOTE(fwereade) - checks here would be sensible, but we will
 	// also need to check after the binaries are imported too.
 	return err
 }
 
+func (api *API) importSecretBackends(ctx context.Context, modelUUID coremodel.UUID, in params.SerializedModelSecretBackends) error {
+	backends := secretbackendservice.SecretBackendsExport{
+		ModelBackendName: in.ModelBackendName,
+	}
+	for _, b := range in.Backends {
+		backends.Backends = append(backends.Backends, secretbackend.SecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			NextRotateTime:      b.NextRotateTime,
+			Config:              b.Config,
+		})
+	}
+	return api.secretBackendService.ImportSecretBackends(ctx, modelUUID, backends)
+}
+
 func (api *API) getModel(modelTag string) (*state.Model, func(), error) {
 	tag, err := names.ParseModelTag(modelTag)
 	if err != nil {
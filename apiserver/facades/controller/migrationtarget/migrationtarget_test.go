@@ -53,6 +53,7 @@ type Suite struct {
 	externalControllerService *MockExternalControllerService
 	applicationService        *MockApplicationService
 	upgradeService            *MockUpgradeService
+	secretBackendService      *MockSecretBackendService
 	modelImporter             *MockModelImporter
 	objectStoreGetter         *MockModelObjectStoreGetter
 	modelMigrationService     *MockModelMigrationService
@@ -546,6 +547,7 @@ func (s *Suite) setupMocks(c *gc.C) *gomock.Controller {
 	s.externalControllerService = NewMockExternalControllerService(ctrl)
 	s.applicationService = NewMockApplicationService(ctrl)
 	s.upgradeService = NewMockUpgradeService(ctrl)
+	s.secretBackendService = NewMockSecretBackendService(ctrl)
 
 	s.objectStoreGetter = NewMockModelObjectStoreGetter(ctrl)
 	s.modelImporter = NewMockModelImporter(ctrl)
@@ -586,6 +588,7 @@ func (s *Suite) newAPI(versions facades.FacadeVersions, logDir string) (*migrati
 		s.externalControllerService,
 		s.applicationService,
 		s.upgradeService,
+		s.secretBackendService,
 		s.agentServiceGetter,
 		s.migrationServiceGetter,
 		versions,
@@ -55,6 +55,7 @@ func makeFacade(
 		domainServices.ExternalController(),
 		domainServices.Application(),
 		domainServices.Upgrade(),
+		domainServices.SecretBackend(),
 		modelAgentServiceGetter,
 		modelMigrationServiceGetter,
 		facadeVersions,
@@ -0,0 +1,92 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+
+	"github.com/juju/juju/core/permission"
+)
+
+// permissionCacheTTL bounds how long a cached permission is used before it is
+// looked up again. It limits how stale a permission can be if the change that
+// revoked it was not announced to this server.
+const permissionCacheTTL = 30 * time.Second
+
+type permissionKey struct {
+	subject string
+	target  permission.ID
+}
+
+type cachedPermission struct {
+	access     permission.Access
+	generation uint64
+	expires    time.Time
+}
+
+// permissionCache caches the permissions looked up for the requests on a
+// single connection, so that they are not looked up again for every request.
+// Entries expire after permissionCacheTTL, and are discarded whenever the
+// generation changes, which happens each time a permission change is
+// announced to the server.
+type permissionCache struct {
+	clock      clock.Clock
+	generation func() uint64
+
+	mu      sync.Mutex
+	entries map[permissionKey]cachedPermission
+}
+
+func newPermissionCache(clock clock.Clock, generation func() uint64) *permissionCache {
+	return &permissionCache{
+		clock:      clock,
+		generation: generation,
+		entries:    make(map[permissionKey]cachedPermission),
+	}
+}
+
+// subjectPermissions returns the access the subject has to the target,
+// calling lookup if nothing current is cached. Lookup errors are not cached.
+func (c *permissionCache) subjectPermissions(
+	ctx context.Context,
+	subject string,
+	target permission.ID,
+	lookup func(context.Context, string, permission.ID) (permission.Access, error),
+) (permission.Access, error) {
+	key := permissionKey{subject: subject, target: target}
+	generation := c.generation()
+	now := c.clock.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && entry.generation == generation && now.Before(entry.expires) {
+		return entry.access, nil
+	}
+
+	access, err := lookup(ctx, subject, target)
+	if err != nil {
+		return permission.NoAccess, err
+	}
+
+	c.mu.Lock()
+	c.entries[key] = cachedPermission{
+		access:     access,
+		generation: generation,
+		expires:    now.Add(permissionCacheTTL),
+	}
+	c.mu.Unlock()
+	return access, nil
+}
+
+// invalidate discards everything cached.
+func (c *permissionCache) invalidate() {
+	c.mu.Lock()
+	c.entries = make(map[permissionKey]cachedPermission)
+	c.mu.Unlock()
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package apiserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/permission"
+)
+
+type permissionCacheSuite struct {
+	testing.IsolationSuite
+
+	clock      *testclock.Clock
+	generation uint64
+	lookups    int
+	access     permission.Access
+	err        error
+}
+
+var _ = gc.Suite(&permissionCacheSuite{})
+
+func (s *permissionCacheSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.clock = testclock.NewClock(time.Now())
+	s.generation = 0
+	s.lookups = 0
+	s.access = permission.AdminAccess
+	s.err = nil
+}
+
+func (s *permissionCacheSuite) newCache() *permissionCache {
+	return newPermissionCache(s.clock, func() uint64 { return s.generation })
+}
+
+func (s *permissionCacheSuite) lookup(context.Context, string, permission.ID) (permission.Access, error) {
+	s.lookups++
+	return s.access, s.err
+}
+
+var cacheTarget = permission.ID{ObjectType: permission.Model, Key: "model-uuid"}
+
+func (s *permissionCacheSuite) TestCachesLookup(c *gc.C) {
+	cache := s.newCache()
+
+	for i := 0; i < 3; i++ {
+		access, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(access, gc.Equals, permission.AdminAccess)
+	}
+	c.Check(s.lookups, gc.Equals, 1)
+
+	// A different subject is looked up separately.
+	_, err := cache.subjectPermissions(context.Background(), "alice", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.lookups, gc.Equals, 2)
+}
+
+func (s *permissionCacheSuite) TestExpires(c *gc.C) {
+	cache := s.newCache()
+
+	_, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.access = permission.ReadAccess
+	s.clock.Advance(permissionCacheTTL)
+
+	access, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.ReadAccess)
+	c.Check(s.lookups, gc.Equals, 2)
+}
+
+func (s *permissionCacheSuite) TestGenerationChange(c *gc.C) {
+	cache := s.newCache()
+
+	_, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.access = permission.NoAccess
+	s.generation++
+
+	access, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.NoAccess)
+	c.Check(s.lookups, gc.Equals, 2)
+}
+
+func (s *permissionCacheSuite) TestInvalidate(c *gc.C) {
+	cache := s.newCache()
+
+	_, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cache.invalidate()
+
+	_, err = cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.lookups, gc.Equals, 2)
+}
+
+func (s *permissionCacheSuite) TestErrorsNotCached(c *gc.C) {
+	cache := s.newCache()
+	s.err = errors.New("boom")
+
+	_, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, gc.ErrorMatches, "boom")
+
+	s.err = nil
+	access, err := cache.subjectPermissions(context.Background(), "bob", cacheTarget, s.lookup)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(access, gc.Equals, permission.AdminAccess)
+	c.Check(s.lookups, gc.Equals, 2)
+}
@@ -97,6 +97,10 @@ type apiHandler struct {
 	// connection.
 	authInfo authentication.AuthInfo
 
+	// permissions caches the permissions looked up for the entity
+	// authenticated on this connection.
+	permissions *permissionCache
+
 	// modelUUID is the UUID of the model that the client is connected to.
 	// All facades for a given context will be scoped to the model UUID.
 	// Facade methods should only scoped to the model UUID they are operating
@@ -185,6 +189,9 @@ func newAPIHandler(
 		controllerOnlyLogin:   controllerOnlyLogin,
 		connectionID:          connectionID,
 		serverHost:            serverHost,
+		permissions: newPermissionCache(srv.clock, func() uint64 {
+			return srv.shared.permissionsGeneration.Load()
+		}),
 	}
 
 	// Facades involved with managing application offers need the auth context
@@ -380,7 +387,7 @@ func (r *apiHandler) EntityHasPermission(
 		if r.authInfo.Delegator == nil {
 			return permission.NoAccess, fmt.Errorf("permissions %w for auth info", errors.NotImplemented)
 		}
-		return r.authInfo.Delegator.SubjectPermissions(ctx, userName.Name(), target)
+		return r.permissions.subjectPermissions(ctx, userName.Name(), target, r.authInfo.Delegator.SubjectPermissions)
 	}
 	has, err := common.HasPermission(ctx, userAccessFunc, entity, operation, target)
 	if err != nil {
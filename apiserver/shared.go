@@ -5,6 +5,7 @@ package apiserver
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/juju/collections/set"
@@ -82,6 +83,10 @@ type sharedServerContext struct {
 	dataDir    string
 	logDir     string
 
+	// permissionsGeneration is incremented each time a permission change is
+	// announced, invalidating the permissions cached by every connection.
+	permissionsGeneration atomic.Uint64
+
 	unsubscribe func()
 }
 
@@ -174,12 +179,21 @@ func newSharedServerContext(config sharedServerConfig) (*sharedServerContext, er
 	// because the changes are only ever published in response to an API call, and
 	// this function is called in the newServer call to create the API server,
 	// and we know that we can't make any API calls until the server has started.
-	unsubscribe, err := ctx.centralHub.Subscribe(controller.ConfigChanged, ctx.onConfigChanged)
+	unsubscribeConfig, err := ctx.centralHub.Subscribe(controller.ConfigChanged, ctx.onConfigChanged)
+	if err != nil {
+		ctx.logger.Criticalf("programming error in subscribe function: %v", err)
+		return nil, errors.Trace(err)
+	}
+	unsubscribePermissions, err := ctx.centralHub.Subscribe(controller.PermissionsChanged, ctx.onPermissionsChanged)
 	if err != nil {
+		unsubscribeConfig()
 		ctx.logger.Criticalf("programming error in subscribe function: %v", err)
 		return nil, errors.Trace(err)
 	}
-	ctx.unsubscribe = unsubscribe
+	ctx.unsubscribe = func() {
+		unsubscribeConfig()
+		unsubscribePermissions()
+	}
 	return ctx, nil
 }
 
@@ -187,6 +201,15 @@ func (c *sharedServerContext) Close() {
 	c.unsubscribe()
 }
 
+func (c *sharedServerContext) onPermissionsChanged(topic string, data controller.PermissionsChangedMessage, err error) {
+	if err != nil {
+		c.logger.Criticalf("programming error in %s message data: %v", topic, err)
+		return
+	}
+	c.logger.Debugf("permissions changed for %q, invalidating cached permissions", data.UserName)
+	c.permissionsGeneration.Add(1)
+}
+
 func (c *sharedServerContext) onConfigChanged(topic string, data controller.ConfigChangedMessage, err error) {
 	if err != nil {
 		c.logger.Criticalf("programming error in %s message data: %v", topic, err)
@@ -208,6 +231,12 @@ func (c *sharedServerContext) onConfigChanged(topic string, data controller.Conf
 	}
 }
 
+func (c *sharedServerContext) apiPort() int {
+	c.configMutex.RLock()
+	defer c.configMutex.RUnlock()
+	return c.controllerConfig.APIPort()
+}
+
 func (c *sharedServerContext) maxDebugLogDuration() time.Duration {
 	c.configMutex.RLock()
 	defer c.configMutex.RUnlock()
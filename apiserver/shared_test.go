@@ -153,3 +153,21 @@ func (s *sharedServerContextSuite) TestControllerConfigChanged(c *gc.C) {
 	c.Check(ctx.featureEnabled("baz"), jc.IsFalse)
 	c.Check(stub.published, gc.HasLen, 0)
 }
+
+func (s *sharedServerContextSuite) TestPermissionsChanged(c *gc.C) {
+	ctx := s.newContext(c)
+	c.Assert(ctx.permissionsGeneration.Load(), gc.Equals, uint64(0))
+
+	done, err := s.hub.Publish(controller.PermissionsChanged, controller.PermissionsChangedMessage{
+		UserName: "bob",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	select {
+	case <-pubsub.Wait(done):
+	case <-time.After(testing.LongWait):
+		c.Fatalf("handler didn't")
+	}
+
+	c.Check(ctx.permissionsGeneration.Load(), gc.Equals, uint64(1))
+}
@@ -33,17 +33,37 @@ func IsUserAbortedError(err error) bool {
 	return ok
 }
 
-// UserConfirmYes returns an error if we do not read a "y" or "yes" from user
-// input.
-func UserConfirmYes(ctx *cmd.Context) error {
-	fmt.Fprint(ctx.Stderr, yesNoMsg)
+// ErrNonInteractive is returned when a command needs the user to confirm
+// an operation but cannot ask, because stdin is not a terminal. Failing
+// closed avoids blocking forever on input which will never arrive.
+const ErrNonInteractive = errors.ConstError("cannot prompt for confirmation as stdin is not a terminal; use -y to skip confirmation")
+
+// readConfirmation writes the prompt to stderr and returns the line of
+// user input read in response. It returns an error satisfying
+// [ErrNonInteractive] if stdin is not a terminal.
+func readConfirmation(ctx *cmd.Context, prompt string) (string, error) {
+	if IsPiped(ctx) {
+		return "", errors.Trace(ErrNonInteractive)
+	}
+	fmt.Fprint(ctx.Stderr, prompt)
 	scanner := bufio.NewScanner(ctx.Stdin)
 	scanner.Scan()
 	err := scanner.Err()
 	if err != nil && err != io.EOF {
+		return "", errors.Trace(err)
+	}
+	return scanner.Text(), nil
+}
+
+// UserConfirmYes returns an error if we do not read a "y" or "yes" from user
+// input. It returns an error satisfying [ErrNonInteractive] if stdin is not a
+// terminal.
+func UserConfirmYes(ctx *cmd.Context) error {
+	answer, err := readConfirmation(ctx, yesNoMsg)
+	if err != nil {
 		return errors.Trace(err)
 	}
-	answer := strings.ToLower(scanner.Text())
+	answer = strings.ToLower(answer)
 	if answer != "y" && answer != "yes" {
 		return errors.Trace(userAbortedError("aborted"))
 	}
@@ -51,16 +71,14 @@ func UserConfirmYes(ctx *cmd.Context) error {
 }
 
 // UserConfirmName returns an error if we do not read a "name" of the model/controller/etc from user
-// input.
+// input. It returns an error satisfying [ErrNonInteractive] if stdin is not a
+// terminal.
 func UserConfirmName(verificationName string, objectType string, ctx *cmd.Context) error {
-	fmt.Fprintf(ctx.Stderr, nameVerificationMsg, objectType)
-	scanner := bufio.NewScanner(ctx.Stdin)
-	scanner.Scan()
-	err := scanner.Err()
-	if err != nil && err != io.EOF {
+	answer, err := readConfirmation(ctx, fmt.Sprintf(nameVerificationMsg, objectType))
+	if err != nil {
 		return errors.Trace(err)
 	}
-	answer := strings.ToLower(scanner.Text())
+	answer = strings.ToLower(answer)
 	if answer != verificationName {
 		return errors.Trace(userAbortedError("aborted"))
 	}
@@ -0,0 +1,72 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package cmd_test
+
+import (
+	"os"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type ConfirmSuite struct{}
+
+var _ = gc.Suite(&ConfirmSuite{})
+
+func (s *ConfirmSuite) context(c *gc.C, input string) *cmd.Context {
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = strings.NewReader(input)
+	return ctx
+}
+
+func (s *ConfirmSuite) TestUserConfirmYes(c *gc.C) {
+	for _, answer := range []string{"y\n", "Y\n", "yes\n", "YES"} {
+		ctx := s.context(c, answer)
+		c.Check(jujucmd.UserConfirmYes(ctx), jc.ErrorIsNil)
+		c.Check(cmdtesting.Stderr(ctx), gc.Equals, "\nContinue [y/N]? ")
+	}
+}
+
+func (s *ConfirmSuite) TestUserConfirmYesAborted(c *gc.C) {
+	for _, answer := range []string{"n\n", "\n", "", "yeah\n"} {
+		err := jujucmd.UserConfirmYes(s.context(c, answer))
+		c.Check(err, gc.ErrorMatches, "aborted")
+		c.Check(jujucmd.IsUserAbortedError(err), jc.IsTrue)
+	}
+}
+
+func (s *ConfirmSuite) TestUserConfirmName(c *gc.C) {
+	ctx := s.context(c, "mymodel\n")
+	c.Check(jujucmd.UserConfirmName("mymodel", "model", ctx), jc.ErrorIsNil)
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "\nTo continue, enter the name of the model to be unregistered: ")
+
+	err := jujucmd.UserConfirmName("mymodel", "model", s.context(c, "othermodel\n"))
+	c.Check(jujucmd.IsUserAbortedError(err), jc.IsTrue)
+}
+
+func (s *ConfirmSuite) TestConfirmNonInteractive(c *gc.C) {
+	r, w, err := os.Pipe()
+	c.Assert(err, jc.ErrorIsNil)
+	defer func() { _ = r.Close() }()
+	defer func() { _ = w.Close() }()
+
+	ctx := cmdtesting.Context(c)
+	ctx.Stdin = r
+
+	// Nothing is ever written to the pipe, so any attempt to read from it
+	// would block forever.
+	err = jujucmd.UserConfirmYes(ctx)
+	c.Check(err, jc.ErrorIs, jujucmd.ErrNonInteractive)
+	c.Check(jujucmd.IsUserAbortedError(err), jc.IsFalse)
+
+	err = jujucmd.UserConfirmName("mymodel", "model", ctx)
+	c.Check(err, jc.ErrorIs, jujucmd.ErrNonInteractive)
+
+	c.Check(cmdtesting.Stderr(ctx), gc.Equals, "")
+}
@@ -254,7 +254,10 @@ func applicationConfigValue(key string, valueMap interface{}) (interface{}, erro
 // combined bundle data. Returns a slice of errors encountered while
 // processing the bundle. They are for informational purposes and do
 // not require failing the bundle deployment.
-func ComposeAndVerifyBundle(ctx *cmd.Context, base BundleDataSource, pathToOverlays []string) (*charm.BundleData, []error, error) {
+// Application constraints are merged key by key, with overlay values
+// preferred. If strictOverlay is true, an overlay setting a constraint to
+// a different value is an error instead.
+func ComposeAndVerifyBundle(ctx *cmd.Context, base BundleDataSource, pathToOverlays []string, strictOverlay bool) (*charm.BundleData, []error, error) {
 	verifyConstraints := func(s string) error {
 		_, err := constraints.Parse(s)
 		return err
@@ -279,10 +282,14 @@ func ComposeAndVerifyBundle(ctx *cmd.Context, base BundleDataSource, pathToOverl
 		unMarshallErrors = append(unMarshallErrors, gatherErrors(ds)...)
 	}
 
+	constraintsByPart := applicationConstraintsByPart(dsList)
 	bundleData, err := charm.ReadAndMergeBundleData(dsList...)
 	if err != nil {
 		return nil, nil, errors.Trace(err)
 	}
+	if err := mergeApplicationConstraints(bundleData, constraintsByPart, strictOverlay); err != nil {
+		return nil, nil, errors.Trace(err)
+	}
 
 	// verify composed (base + overlay bundles)
 	if err = verifyBundle(bundleData, dsList, base.BasePath(), verifyConstraints); err != nil {
@@ -294,6 +301,82 @@ func ComposeAndVerifyBundle(ctx *cmd.Context, base BundleDataSource, pathToOverl
 	return bundleData, unMarshallErrors, nil
 }
 
+// applicationConstraintsByPart returns the constraints set for each
+// application by each of the bundle parts, in order. An application removed
+// by a part maps to nil. This must be called before the parts are merged,
+// as merging overwrites the base part.
+func applicationConstraintsByPart(dsList []charm.BundleDataSource) []map[string]*string {
+	var result []map[string]*string
+	for _, ds := range dsList {
+		for _, part := range ds.Parts() {
+			if part.Data == nil {
+				continue
+			}
+			partConstraints := make(map[string]*string)
+			for appName, app := range part.Data.Applications {
+				if app == nil {
+					partConstraints[appName] = nil
+				} else if app.Constraints != "" {
+					consStr := app.Constraints
+					partConstraints[appName] = &consStr
+				}
+			}
+			result = append(result, partConstraints)
+		}
+	}
+	return result
+}
+
+// mergeApplicationConstraints sets the constraints of each application in
+// the composed bundle to those of the bundle parts merged in order, rather
+// than those of the last part to set them.
+func mergeApplicationConstraints(data *charm.BundleData, byPart []map[string]*string, strict bool) error {
+	for appName, app := range data.Applications {
+		if app == nil {
+			continue
+		}
+		var (
+			merged constraints.Value
+			parts  int
+		)
+		for _, partConstraints := range byPart {
+			consStr, ok := partConstraints[appName]
+			if !ok {
+				continue
+			}
+			if consStr == nil {
+				// The application was removed by this part, so any
+				// constraints before it no longer apply.
+				merged, parts = constraints.Value{}, 0
+				continue
+			}
+			cons, err := constraints.Parse(*consStr)
+			if err != nil {
+				return errors.Annotatef(err, "invalid constraints for application %q", appName)
+			}
+			parts++
+			var conflicts []constraints.ConstraintConflict
+			merged, conflicts, err = constraints.MergeApplicationConstraints(merged, cons)
+			if err != nil {
+				return errors.Annotatef(err, "application %q", appName)
+			}
+			if strict && len(conflicts) > 0 {
+				details := make([]string, len(conflicts))
+				for i, conflict := range conflicts {
+					details[i] = conflict.String()
+				}
+				return errors.Errorf("overlay constraints for application %q conflict with the bundle: %s",
+					appName, strings.Join(details, ", "))
+			}
+		}
+		// Constraints set by a single part are left as written.
+		if parts > 1 {
+			app.Constraints = merged.String()
+		}
+	}
+	return nil
+}
+
 func gatherErrors(ds BundleDataSource) []error {
 	returnErrors := make([]error, 0)
 	for _, p := range ds.Parts() {
@@ -218,7 +218,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleEmpty(c *gc.C) {
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, jc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, false)
 	c.Assert(err, gc.ErrorMatches, ".*bundle is empty not valid")
 	c.Assert(obtained, gc.IsNil)
 }
@@ -233,7 +233,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleUnsupportedConstrai
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, jc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, false)
 	c.Assert(err, gc.ErrorMatches, "*'image-id' constraint in a base bundle not supported")
 	c.Assert(obtained, gc.IsNil)
 }
@@ -248,7 +248,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleNoOverlay(c *gc.C)
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, jc.ErrorIsNil)
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, false)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(obtained, gc.DeepEquals, bundleData)
 }
@@ -269,7 +269,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlay(c *gc.C) {
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, false)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(obtained, gc.DeepEquals, &expected)
 }
@@ -290,7 +290,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayUnsupportedC
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, false)
 	c.Assert(err, gc.ErrorMatches, "*'image-id' constraint in a base bundle not supported")
 	c.Assert(obtained, gc.IsNil)
 }
@@ -315,7 +315,7 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayUnmarshallEr
 		"blog-title": "magic bundle config",
 	}
 
-	obtained, unmarshallErrors, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile})
+	obtained, unmarshallErrors, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, false)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(obtained, gc.DeepEquals, &expected)
 	c.Assert(unmarshallErrors, gc.HasLen, 1)
@@ -332,10 +332,56 @@ func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleWithSeriesStillPass
 	ctx, err := cmd.DefaultContext()
 	c.Assert(err, jc.ErrorIsNil)
 
-	_, _, err = ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil)
+	_, _, err = ComposeAndVerifyBundle(ctx, s.bundleDataSource, nil, false)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayMergesConstraints(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	bundleData, err := charm.ReadBundleData(strings.NewReader(constraintsBundle))
+	c.Assert(err, jc.ErrorIsNil)
+	s.expectBundleBytes([]byte(constraintsBundle))
+	s.expectParts(&charm.BundleDataPart{Data: bundleData})
+	s.expectBasePath()
+	s.setupConstraintsOverlayFile(c)
+	ctx, err := cmd.DefaultContext()
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained.Applications["wordpress"].Constraints, gc.Equals, "arch=amd64 mem=8192M spaces=alpha")
+	c.Check(obtained.Applications["mysql"].Constraints, gc.Equals, "cores=2")
+}
+
+func (s *composeAndVerifyRepSuite) TestComposeAndVerifyBundleOverlayStrictConstraintsConflict(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	bundleData, err := charm.ReadBundleData(strings.NewReader(constraintsBundle))
+	c.Assert(err, jc.ErrorIsNil)
+	s.expectBundleBytes([]byte(constraintsBundle))
+	s.expectParts(&charm.BundleDataPart{Data: bundleData})
+	s.expectBasePath()
+	s.setupConstraintsOverlayFile(c)
+	ctx, err := cmd.DefaultContext()
+	c.Assert(err, jc.ErrorIsNil)
+
+	obtained, _, err := ComposeAndVerifyBundle(ctx, s.bundleDataSource, []string{s.overlayFile}, true)
+	c.Assert(err, gc.ErrorMatches, `overlay constraints for application "wordpress" conflict with the bundle: mem: "4096M" != "8192M"`)
+	c.Assert(obtained, gc.IsNil)
+}
+
+func (s *composeAndVerifyRepSuite) setupConstraintsOverlayFile(c *gc.C) {
+	s.overlayDir = c.MkDir()
+	s.overlayFile = filepath.Join(s.overlayDir, "config.yaml")
+	c.Assert(
+		os.WriteFile(
+			s.overlayFile, []byte(`
+applications:
+  wordpress:
+    constraints: mem=8G spaces=alpha
+`), 0644),
+		jc.ErrorIsNil)
+}
+
 func (s *composeAndVerifyRepSuite) setupOverlayFile(c *gc.C) {
 	s.overlayDir = c.MkDir()
 	s.overlayFile = filepath.Join(s.overlayDir, "config.yaml")
@@ -516,6 +562,22 @@ relations:
   - mysql:db
 `
 
+const constraintsBundle = `
+default-base: ubuntu@22.04
+applications:
+  mysql:
+    charm: ch:mysql
+    num_units: 1
+    constraints: cores=2
+  wordpress:
+    charm: ch:wordpress
+    num_units: 1
+    constraints: arch=amd64 mem=4G
+relations:
+- - wordpress:db
+  - mysql:db
+`
+
 const typoBundle = `
 sries: jammy
 applications:
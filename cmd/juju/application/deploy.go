@@ -243,6 +243,10 @@ type DeployCommand struct {
 	// configuration to be merged with the main bundle.
 	BundleOverlayFile []string
 
+	// OverlayStrict, if true, fails a bundle deployment when an overlay
+	// sets an application constraint to a different value than the bundle.
+	OverlayStrict bool
+
 	// Channel holds the channel to use when obtaining
 	// the charm to be deployed.
 	Channel charm.Channel
@@ -634,6 +638,7 @@ func (c *DeployCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.Trust, "trust", false, "Allows charm to run hooks that require access credentials")
 
 	f.Var(cmd.NewAppendStringsValue(&c.BundleOverlayFile), "overlay", "Bundles to overlay on the primary bundle, applied in order")
+	f.BoolVar(&c.OverlayStrict, "overlay-strict", false, "Fail if an overlay changes an application constraint set by the bundle, rather than preferring the overlay value")
 	f.Var(&c.ConstraintsStr, "constraints", "Set application constraints")
 	f.StringVar(&c.Base, "base", "", "The base on which to deploy")
 	f.IntVar(&c.Revision, "revision", -1, "The revision to deploy")
@@ -873,6 +878,7 @@ func (c *DeployCommand) getDeployerFactory(base corebase.Base, defaultCharmSchem
 		BundleDevices:      c.BundleDevices,
 		BundleMachines:     c.BundleMachines,
 		BundleOverlayFile:  c.BundleOverlayFile,
+		OverlayStrict:      c.OverlayStrict,
 		BundleStorage:      c.BundleStorage,
 		Channel:            c.Channel,
 		CharmOrBundle:      c.CharmOrBundle,
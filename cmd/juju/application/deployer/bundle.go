@@ -31,6 +31,7 @@ type deployBundle struct {
 	bundleDir         string
 	bundleURL         *charm.URL
 	bundleOverlayFile []string
+	overlayStrict     bool
 	origin            commoncharm.Origin
 	modelConstraints  constraints.Value
 
@@ -85,7 +86,7 @@ func (d *deployBundle) deploy(
 	d.accountUser = accountDetails.User
 
 	// Compose bundle to be deployed and check its validity.
-	bundleData, unmarshalErrors, err := bundle.ComposeAndVerifyBundle(ctx, d.bundleDataSource, d.bundleOverlayFile)
+	bundleData, unmarshalErrors, err := bundle.ComposeAndVerifyBundle(ctx, d.bundleDataSource, d.bundleOverlayFile, d.overlayStrict)
 	if err != nil {
 		return errors.Annotatef(err, "cannot deploy bundle")
 	}
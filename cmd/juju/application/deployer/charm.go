@@ -188,7 +188,7 @@ or use remove-application to remove the existing one and try again.`,
 var (
 	// BundleOnlyFlags represents what flags are used for bundles only.
 	BundleOnlyFlags = []string{
-		"overlay", "map-machines",
+		"overlay", "overlay-strict", "map-machines",
 	}
 )
 
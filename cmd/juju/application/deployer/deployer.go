@@ -360,6 +360,7 @@ func (d *factory) setConfig(cfg DeployerConfig) {
 	d.charmOrBundle = cfg.CharmOrBundle
 	d.defaultCharmSchema = cfg.DefaultCharmSchema
 	d.bundleOverlayFile = cfg.BundleOverlayFile
+	d.overlayStrict = cfg.OverlayStrict
 	d.channel = cfg.Channel
 	d.base = cfg.Base
 	d.force = cfg.Force
@@ -404,6 +405,7 @@ type DeployerConfig struct {
 	BundleDevices        map[string]map[string]devices.Constraints
 	BundleMachines       map[string]string
 	BundleOverlayFile    []string
+	OverlayStrict        bool
 	BundleStorage        map[string]map[string]storage.Directive
 	Channel              charm.Channel
 	CharmOrBundle        string
@@ -445,6 +447,7 @@ type factory struct {
 	attachStorage      []string
 	charmOrBundle      string
 	bundleOverlayFile  []string
+	overlayStrict      bool
 	channel            charm.Channel
 	revision           int
 	base               corebase.Base
@@ -536,6 +539,7 @@ func (d *factory) newDeployBundle(_ charm.Schema, ds charm.BundleDataSource) dep
 		bundleStorage:        d.bundleStorage,
 		bundleDevices:        d.bundleDevices,
 		bundleOverlayFile:    d.bundleOverlayFile,
+		overlayStrict:        d.overlayStrict,
 		bundleDir:            d.charmOrBundle,
 		modelConstraints:     d.modelConstraints,
 		charmReader:          d.charmReader,
@@ -542,6 +542,7 @@ func registerCommands(r commandRegistry) {
 	// Secrets.
 	r.Register(secrets.NewListSecretsCommand())
 	r.Register(secrets.NewShowSecretsCommand())
+	r.Register(secrets.NewFindSecretCommand())
 	r.Register(secrets.NewAddSecretCommand())
 	r.Register(secrets.NewUpdateSecretCommand())
 	r.Register(secrets.NewRemoveSecretCommand())
@@ -363,6 +363,7 @@ var commandNames = []string{
 	"export-bundle",
 	"expose",
 	"find-offers",
+	"find-secret",
 	"find",
 	"firewall-rules",
 	"grant-cloud",
@@ -42,6 +42,8 @@ type ModelInfo struct {
 	Credential     *ModelCredential             `json:"credential,omitempty" yaml:"credential,omitempty"`
 
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty" yaml:"supported-features,omitempty"`
+
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
 }
 
 // SupportedFeature describes a feature that is supported by a particular model.
@@ -170,6 +172,9 @@ func ModelInfoFromParams(info params.ModelInfo, now time.Time) (ModelInfo, error
 	if len(info.SecretBackends) != 0 {
 		modelInfo.SecretBackends = ModelSecretBackendInfoFromParams(info.SecretBackends)
 	}
+	if len(info.Annotations) != 0 {
+		modelInfo.Annotations = info.Annotations
+	}
 
 	if info.CloudCredentialTag != "" {
 		credTag, err := names.ParseCloudCredentialTag(info.CloudCredentialTag)
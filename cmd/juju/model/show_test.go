@@ -238,6 +238,16 @@ func (s *ShowCommandSuite) TestShowWithSecretBackendFormatJson(c *gc.C) {
 	c.Assert(cmdtesting.Stdout(ctx), jc.JSONEquals, s.expectedOutput)
 }
 
+func (s *ShowCommandSuite) TestShowWithAnnotationsFormatJson(c *gc.C) {
+	s.fake.info.Annotations = map[string]string{"cost-center": "ops"}
+	modelOutput := s.expectedOutput["mymodel"].(attrs)
+	modelOutput["annotations"] = attrs{"cost-center": "ops"}
+
+	ctx, err := cmdtesting.RunCommand(c, s.newShowCommand(), "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(cmdtesting.Stdout(ctx), jc.JSONEquals, s.expectedOutput)
+}
+
 func (s *ShowCommandSuite) TestShowBasicIncompleteModelsYaml(c *gc.C) {
 	s.fake.infos = []params.ModelInfoResult{
 		{Result: createBasicModelInfo()},
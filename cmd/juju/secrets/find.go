@@ -0,0 +1,161 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+	"github.com/juju/gnuflag"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	jujucmd "github.com/juju/juju/cmd"
+	"github.com/juju/juju/cmd/modelcmd"
+	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/cmd"
+)
+
+var findSecretScopes = set.NewStrings("all", "owned", "accessible")
+
+type findSecretCommand struct {
+	modelcmd.ModelCommandBase
+	out cmd.Output
+
+	secretsAPIFunc func(ctx context.Context) (FindSecretsAPI, error)
+
+	query string
+	scope string
+}
+
+// FindSecretsAPI is the secrets client API.
+type FindSecretsAPI interface {
+	SearchSecrets(ctx context.Context, query, scope string) ([]apisecrets.SecretDetails, error)
+	Close() error
+}
+
+// NewFindSecretCommand returns a command to search for secrets.
+func NewFindSecretCommand() cmd.Command {
+	c := &findSecretCommand{}
+	c.secretsAPIFunc = c.secretsAPI
+	return modelcmd.Wrap(c)
+}
+
+func (c *findSecretCommand) secretsAPI(ctx context.Context) (FindSecretsAPI, error) {
+	root, err := c.NewAPIRoot(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return apisecrets.NewClient(root), nil
+}
+
+const (
+	findSecretDoc = `
+Searches the secrets in the model for those whose name, label or description
+contains the specified text. The match is case-insensitive.
+
+By default all secrets in the model are searched. Use --scope to restrict the
+search to the secrets owned by the model ("owned"), or to the secrets the model
+has been granted access to ("accessible").
+`
+	findSecretExamples = `
+    juju find-secret password
+    juju find-secret db --scope owned
+    juju find-secret "api key" --format yaml
+`
+)
+
+// Info implements cmd.Command.
+func (c *findSecretCommand) Info() *cmd.Info {
+	return jujucmd.Info(&cmd.Info{
+		Name:     "find-secret",
+		Args:     "<query>",
+		Purpose:  "Find secrets by name, label or description.",
+		Doc:      findSecretDoc,
+		Examples: findSecretExamples,
+		SeeAlso: []string{
+			"secrets",
+			"show-secret",
+		},
+	})
+}
+
+// SetFlags implements cmd.Command.
+func (c *findSecretCommand) SetFlags(f *gnuflag.FlagSet) {
+	f.StringVar(&c.scope, "scope", "all", "The secrets to search: all, owned or accessible")
+	c.out.AddFlags(f, "tabular", map[string]cmd.Formatter{
+		"yaml": cmd.FormatYaml,
+		"json": cmd.FormatJson,
+		"tabular": func(writer io.Writer, value interface{}) error {
+			return formatFoundSecretsTabular(writer, value)
+		},
+	})
+}
+
+// Init implements cmd.Command.
+func (c *findSecretCommand) Init(args []string) error {
+	if len(args) < 1 {
+		return errors.New("missing search query")
+	}
+	c.query = args[0]
+	if c.query == "" {
+		return errors.New("empty search query")
+	}
+	if !findSecretScopes.Contains(c.scope) {
+		return errors.NotValidf("scope %q", c.scope)
+	}
+	return cmd.CheckEmpty(args[1:])
+}
+
+// Run implements cmd.Command.
+func (c *findSecretCommand) Run(ctx *cmd.Context) error {
+	secretsAPI, err := c.secretsAPIFunc(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer secretsAPI.Close()
+
+	result, err := secretsAPI.SearchSecrets(ctx, c.query, c.scope)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	details := gatherSecretInfo(result, false, false, false)
+	return c.out.Write(ctx, details)
+}
+
+// formatFoundSecretsTabular writes a tabular summary of the found secrets.
+func formatFoundSecretsTabular(writer io.Writer, value interface{}) error {
+	result, ok := value.(map[string]secretDisplayDetails)
+	if !ok {
+		return errors.Errorf("expected value of type %T, got %T", result, value)
+	}
+
+	var secrets []secretDisplayDetails
+	for _, s := range result {
+		secrets = append(secrets, s)
+	}
+	sort.Slice(secrets, func(i, j int) bool {
+		if secrets[i].Owner != secrets[j].Owner {
+			return secrets[i].Owner < secrets[j].Owner
+		}
+		return secrets[i].URI.ID < secrets[j].URI.ID
+	})
+
+	tw := output.TabWriter(writer)
+	w := output.Wrapper{TabWriter: tw}
+
+	w.Println("ID", "Owner", "Name", "Label", "Description")
+	orDash := func(s string) string {
+		if s == "" {
+			return "-"
+		}
+		return s
+	}
+	for _, s := range secrets {
+		w.Println(s.URI.ID, s.Owner, orDash(s.Name), orDash(s.Label), orDash(s.Description))
+	}
+	return tw.Flush()
+}
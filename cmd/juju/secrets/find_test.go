@@ -0,0 +1,124 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secrets_test
+
+import (
+	"fmt"
+
+	jujutesting "github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	apisecrets "github.com/juju/juju/api/client/secrets"
+	"github.com/juju/juju/cmd/juju/secrets"
+	"github.com/juju/juju/cmd/juju/secrets/mocks"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/jujuclient"
+)
+
+type FindSuite struct {
+	jujutesting.IsolationSuite
+	store      *jujuclient.MemStore
+	secretsAPI *mocks.MockFindSecretsAPI
+}
+
+var _ = gc.Suite(&FindSuite{})
+
+func (s *FindSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	store := jujuclient.NewMemStore()
+	store.Controllers["mycontroller"] = jujuclient.ControllerDetails{}
+	store.CurrentControllerName = "mycontroller"
+	s.store = store
+}
+
+func (s *FindSuite) setup(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.secretsAPI = mocks.NewMockFindSecretsAPI(ctrl)
+
+	return ctrl
+}
+
+func (s *FindSuite) TestInit(c *gc.C) {
+	for _, t := range []struct {
+		args []string
+		err  string
+	}{{
+		err: "missing search query",
+	}, {
+		args: []string{""},
+		err:  "empty search query",
+	}, {
+		args: []string{"foo", "bar"},
+		err:  `unrecognized args: \["bar"\]`,
+	}, {
+		args: []string{"foo", "--scope", "mine"},
+		err:  `scope "mine" not valid`,
+	}} {
+		_, err := cmdtesting.RunCommand(c, secrets.NewFindCommandForTest(s.store, s.secretsAPI), t.args...)
+		c.Check(err, gc.ErrorMatches, t.err)
+	}
+}
+
+func (s *FindSuite) TestFindTabular(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	uri2 := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().SearchSecrets(gomock.Any(), "db", "all").Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI:         uri,
+				Label:       "db-password",
+				Description: "the db password",
+				Owner:       coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: coretesting.ModelTag.Id()}},
+		}, {
+			Metadata: coresecrets.SecretMetadata{
+				URI:   uri2,
+				Label: "db-creds",
+				Owner: coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"}},
+		}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewFindCommandForTest(s.store, s.secretsAPI), "db")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, gc.Equals, fmt.Sprintf(`
+ID                    Owner    Name         Label     Description
+%s  <model>  db-password  -         the db password
+%s  mysql    -            db-creds  -
+`[1:], uri.ID, uri2.ID))
+}
+
+func (s *FindSuite) TestFindYAML(c *gc.C) {
+	defer s.setup(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.secretsAPI.EXPECT().SearchSecrets(gomock.Any(), "creds", "accessible").Return(
+		[]apisecrets.SecretDetails{{
+			Metadata: coresecrets.SecretMetadata{
+				URI:            uri,
+				Label:          "db-creds",
+				LatestRevision: 2,
+				Owner:          coresecrets.Owner{Kind: coresecrets.ApplicationOwner, ID: "mysql"}},
+		}}, nil)
+	s.secretsAPI.EXPECT().Close().Return(nil)
+
+	ctx, err := cmdtesting.RunCommand(c, secrets.NewFindCommandForTest(s.store, s.secretsAPI),
+		"creds", "--scope", "accessible", "--format", "yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Assert(out, gc.Equals, fmt.Sprintf(`
+%s:
+  revision: 2
+  owner: mysql
+  label: db-creds
+  created: 0001-01-01T00:00:00Z
+  updated: 0001-01-01T00:00:00Z
+`[1:], uri.ID))
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
+// Source: github.com/juju/juju/cmd/juju/secrets (interfaces: ListSecretsAPI,FindSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//	mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,FindSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
 //
 
 // Package mocks is a generated GoMock package.
@@ -118,6 +118,106 @@ func (c *MockListSecretsAPIListSecretsCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// MockFindSecretsAPI is a mock of FindSecretsAPI interface.
+type MockFindSecretsAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockFindSecretsAPIMockRecorder
+}
+
+// MockFindSecretsAPIMockRecorder is the mock recorder for MockFindSecretsAPI.
+type MockFindSecretsAPIMockRecorder struct {
+	mock *MockFindSecretsAPI
+}
+
+// NewMockFindSecretsAPI creates a new mock instance.
+func NewMockFindSecretsAPI(ctrl *gomock.Controller) *MockFindSecretsAPI {
+	mock := &MockFindSecretsAPI{ctrl: ctrl}
+	mock.recorder = &MockFindSecretsAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFindSecretsAPI) EXPECT() *MockFindSecretsAPIMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockFindSecretsAPI) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockFindSecretsAPIMockRecorder) Close() *MockFindSecretsAPICloseCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockFindSecretsAPI)(nil).Close))
+	return &MockFindSecretsAPICloseCall{Call: call}
+}
+
+// MockFindSecretsAPICloseCall wrap *gomock.Call
+type MockFindSecretsAPICloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFindSecretsAPICloseCall) Return(arg0 error) *MockFindSecretsAPICloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFindSecretsAPICloseCall) Do(f func() error) *MockFindSecretsAPICloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFindSecretsAPICloseCall) DoAndReturn(f func() error) *MockFindSecretsAPICloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SearchSecrets mocks base method.
+func (m *MockFindSecretsAPI) SearchSecrets(arg0 context.Context, arg1, arg2 string) ([]secrets.SecretDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]secrets.SecretDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSecrets indicates an expected call of SearchSecrets.
+func (mr *MockFindSecretsAPIMockRecorder) SearchSecrets(arg0, arg1, arg2 any) *MockFindSecretsAPISearchSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSecrets", reflect.TypeOf((*MockFindSecretsAPI)(nil).SearchSecrets), arg0, arg1, arg2)
+	return &MockFindSecretsAPISearchSecretsCall{Call: call}
+}
+
+// MockFindSecretsAPISearchSecretsCall wrap *gomock.Call
+type MockFindSecretsAPISearchSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockFindSecretsAPISearchSecretsCall) Return(arg0 []secrets.SecretDetails, arg1 error) *MockFindSecretsAPISearchSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockFindSecretsAPISearchSecretsCall) Do(f func(context.Context, string, string) ([]secrets.SecretDetails, error)) *MockFindSecretsAPISearchSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockFindSecretsAPISearchSecretsCall) DoAndReturn(f func(context.Context, string, string) ([]secrets.SecretDetails, error)) *MockFindSecretsAPISearchSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockAddSecretsAPI is a mock of AddSecretsAPI interface.
 type MockAddSecretsAPI struct {
 	ctrl     *gomock.Controller
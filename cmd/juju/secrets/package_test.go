@@ -12,7 +12,7 @@ import (
 	"github.com/juju/juju/jujuclient"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/secretsapi.go github.com/juju/juju/cmd/juju/secrets ListSecretsAPI,FindSecretsAPI,AddSecretsAPI,GrantRevokeSecretsAPI,UpdateSecretsAPI,RemoveSecretsAPI
 
 func TestPackage(t *stdtesting.T) {
 	gc.TestingT(t)
@@ -80,3 +80,12 @@ func NewShowCommandForTest(store jujuclient.ClientStore, listSecretsAPI ListSecr
 	c.SetClientStore(store)
 	return c
 }
+
+// NewFindCommandForTest returns a find-secret command for testing.
+func NewFindCommandForTest(store jujuclient.ClientStore, api FindSecretsAPI) *findSecretCommand {
+	c := &findSecretCommand{
+		secretsAPIFunc: func(ctx context.Context) (FindSecretsAPI, error) { return api, nil },
+	}
+	c.SetClientStore(store)
+	return c
+}
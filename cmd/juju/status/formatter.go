@@ -231,6 +231,10 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 	if err == nil {
 		base = &formattedBase{Name: application.Base.Name, Channel: channel.DisplayString()}
 	}
+	var scaleChanged string
+	if application.ScaleChanged != nil {
+		scaleChanged = common.FormatTime(application.ScaleChanged, sf.isoTime)
+	}
 	out := applicationStatus{
 		Err:              typedNilCheck(application.Err),
 		Charm:            charmAlias,
@@ -244,6 +248,7 @@ func (sf *statusFormatter) formatApplication(name string, application params.App
 		Exposed:          application.Exposed,
 		Life:             string(application.Life),
 		Scale:            application.Scale,
+		ScaleChanged:     scaleChanged,
 		ProviderId:       application.ProviderId,
 		Address:          application.PublicAddress,
 		Relations:        sf.processApplicationRelations(name, application.Relations),
@@ -23,6 +23,7 @@ import (
 	"github.com/juju/juju/cmd/juju/storage"
 	"github.com/juju/juju/cmd/modelcmd"
 	"github.com/juju/juju/core/output"
+	"github.com/juju/juju/internal/charm"
 	"github.com/juju/juju/internal/cmd"
 	internallogger "github.com/juju/juju/internal/logger"
 	"github.com/juju/juju/juju/osenv"
@@ -73,6 +74,16 @@ type statusCommand struct {
 	// storage indicates if 'storage' section is displayed
 	storage bool
 
+	// availableUpgrades indicates if only applications with a charm upgrade
+	// available are displayed
+	availableUpgrades bool
+
+	// charm, if set, limits the displayed applications to those using the
+	// named charm, optionally at a given revision, as <name>[@<revision>]
+	charm         string
+	charmName     string
+	charmRevision *int
+
 	// watch indicates the time to wait between consecutive status queries
 	watch time.Duration
 }
@@ -157,6 +168,16 @@ Show only applications/units in active status:
 Show only applications/units in error status:
 
     juju status error
+
+Show only applications with a newer charm revision available:
+
+    juju status --available-upgrades
+
+Show only applications using the mysql charm, at any revision or at
+revision 42:
+
+    juju status --charm mysql
+    juju status --charm mysql@42
 `
 
 func (c *statusCommand) Info() *cmd.Info {
@@ -184,6 +205,8 @@ func (c *statusCommand) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.integrations, "integrations", false, "Show 'integrations' section in tabular output")
 	f.BoolVar(&c.relations, "relations", false, "The same as '--integrations'")
 	f.BoolVar(&c.storage, "storage", false, "Show 'storage' section in tabular output")
+	f.BoolVar(&c.availableUpgrades, "available-upgrades", false, "Show only applications with a charm upgrade available")
+	f.StringVar(&c.charm, "charm", "", "Show only applications using the charm, specified as <name>[@<revision>]")
 
 	f.IntVar(&c.retryCount, "retry-count", 3, "Number of times to retry API failures")
 	f.DurationVar(&c.retryDelay, "retry-delay", 100*time.Millisecond, "Time to wait between retry attempts")
@@ -240,6 +263,21 @@ func (c *statusCommand) Init(args []string) error {
 		return errors.Errorf("cannot mix --no-color and --color")
 	}
 
+	if c.charm != "" {
+		name, rev, hasRev := strings.Cut(c.charm, "@")
+		if name == "" {
+			return errors.NotValidf("charm %q", c.charm)
+		}
+		c.charmName = name
+		if hasRev {
+			revision, err := strconv.Atoi(rev)
+			if err != nil || revision < 0 {
+				return errors.NotValidf("charm revision %q", rev)
+			}
+			c.charmRevision = &revision
+		}
+	}
+
 	return nil
 }
 
@@ -318,6 +356,13 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 		return errors.Errorf("unable to obtain the current status")
 	}
 
+	if c.availableUpgrades {
+		filterAvailableUpgrades(status)
+	}
+	if c.charmName != "" {
+		filterCharm(status, c.charmName, c.charmRevision)
+	}
+
 	controllerName, err := c.ControllerName()
 	if err != nil {
 		return errors.Trace(err)
@@ -379,6 +424,28 @@ func (c *statusCommand) runStatus(ctx *cmd.Context) error {
 	return nil
 }
 
+// filterAvailableUpgrades removes all applications from the status which
+// don't have a charm upgrade available.
+func filterAvailableUpgrades(status *params.FullStatus) {
+	for name, app := range status.Applications {
+		if app.CanUpgradeTo == "" {
+			delete(status.Applications, name)
+		}
+	}
+}
+
+// filterCharm removes all applications from the status which don't use the
+// named charm. If revision is not nil, applications using a different
+// revision of the charm are also removed.
+func filterCharm(status *params.FullStatus, name string, revision *int) {
+	for appName, app := range status.Applications {
+		curl, err := charm.ParseURL(app.Charm)
+		if err != nil || curl.Name != name || (revision != nil && curl.Revision != *revision) {
+			delete(status.Applications, appName)
+		}
+	}
+}
+
 // statusCommandForViddy returns the full juju command including all args
 // except the '--watch' flag.
 func (c *statusCommand) statusCommandForViddy(args []string) []string {
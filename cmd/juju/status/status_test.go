@@ -149,6 +149,54 @@ func (s *MinimalStatusSuite) TestRetryCountOfZero(c *gc.C) {
 	c.Assert(s.clock.waits, gc.HasLen, 0)
 }
 
+func (s *MinimalStatusSuite) TestAvailableUpgrades(c *gc.C) {
+	s.statusapi.result.Applications = map[string]params.ApplicationStatus{
+		"foo": {
+			Charm:        "ch:foo-1",
+			CanUpgradeTo: "ch:foo-2",
+		},
+		"bar": {
+			Charm: "ch:bar-3",
+		},
+	}
+
+	s.statusapi.expectIncludeStorage = true
+	ctx, err := s.runStatus(c, "--available-upgrades", "--format=yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Check(out, jc.Contains, "foo:")
+	c.Check(out, jc.Contains, "can-upgrade-to: ch:foo-2")
+	c.Check(out, gc.Not(jc.Contains), "bar:")
+}
+
+func (s *MinimalStatusSuite) TestFilterCharm(c *gc.C) {
+	s.statusapi.result.Applications = map[string]params.ApplicationStatus{
+		"db":      {Charm: "ch:amd64/mysql-42"},
+		"db-next": {Charm: "ch:amd64/mysql-43"},
+		"web":     {Charm: "ch:amd64/wordpress-7"},
+	}
+
+	s.statusapi.expectIncludeStorage = true
+	ctx, err := s.runStatus(c, "--charm", "mysql", "--format=yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	out := cmdtesting.Stdout(ctx)
+	c.Check(out, jc.Contains, "db:")
+	c.Check(out, jc.Contains, "db-next:")
+	c.Check(out, gc.Not(jc.Contains), "web:")
+
+	ctx, err = s.runStatus(c, "--charm", "mysql@43", "--format=yaml")
+	c.Assert(err, jc.ErrorIsNil)
+	out = cmdtesting.Stdout(ctx)
+	c.Check(out, jc.Contains, "db-next:")
+	c.Check(out, gc.Not(jc.Contains), "db:")
+	c.Check(out, gc.Not(jc.Contains), "web:")
+}
+
+func (s *MinimalStatusSuite) TestFilterCharmInvalidRevision(c *gc.C) {
+	_, err := s.runStatus(c, "--charm", "mysql@latest")
+	c.Assert(err, gc.ErrorMatches, `charm revision "latest" not valid`)
+}
+
 type fakeStatusAPI struct {
 	expectIncludeStorage bool
 	result               *params.FullStatus
@@ -135,6 +135,8 @@ func (c *ListCommand) Run(ctx *cmd.Context) error {
 				ProviderId:        sub.ProviderId,
 				ProviderNetworkId: sub.ProviderNetworkId,
 				Zones:             sub.Zones,
+
+				ProviderAttributes: sub.ProviderAttributes,
 			}
 
 			// Use the CIDR to determine the subnet type.
@@ -188,4 +190,6 @@ type formattedSubnet struct {
 	Status            string   `json:"status,omitempty" yaml:"status,omitempty"`
 	Space             string   `json:"space" yaml:"space"`
 	Zones             []string `json:"zones" yaml:"zones"`
+
+	ProviderAttributes map[string]string `json:"provider-attributes,omitempty" yaml:"provider-attributes,omitempty"`
 }
@@ -114,6 +114,8 @@ subnets:
     zones:
     - zone1
     - zone2
+    provider-attributes:
+      secondary-ranges: pods=10.4.0.0/14
   2001:db8::/32:
     type: ipv6
     provider-id: subnet-bar
@@ -134,7 +136,8 @@ subnets:
 		`"provider-id":"subnet-foo",` +
 		`"status":"in-use",` +
 		`"space":"public",` +
-		`"zones":["zone1","zone2"]},` +
+		`"zones":["zone1","zone2"],` +
+		`"provider-attributes":{"secondary-ranges":"pods=10.4.0.0/14"}},` +
 		`"2001:db8::/32":{` +
 		`"type":"ipv6",` +
 		`"provider-id":"subnet-bar",` +
@@ -253,6 +256,8 @@ subnets:
     zones:
     - zone1
     - zone2
+    provider-attributes:
+      secondary-ranges: pods=10.4.0.0/14
 `[1:]
 
 	// Filter by space name first.
@@ -339,6 +344,8 @@ subnets:
     zones:
     - zone1
     - zone2
+    provider-attributes:
+      secondary-ranges: pods=10.4.0.0/14
 `[1:]
 	s.AssertRunSucceeds(c, "", expectedYAML)
 	s.api.CheckCallNames(c, "ListSubnets", "Close")
@@ -135,6 +135,9 @@ func NewStubAPI() *StubAPI {
 		Life:       life.Alive,
 		SpaceTag:   "space-public",
 		Zones:      []string{"zone1", "zone2"},
+		ProviderAttributes: map[string]string{
+			"secondary-ranges": "pods=10.4.0.0/14",
+		},
 	}, {
 		// IPv6 subnet.
 		CIDR:              "2001:db8::/32",
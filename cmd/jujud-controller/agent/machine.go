@@ -52,6 +52,7 @@ import (
 	cmdutil "github.com/juju/juju/cmd/jujud-controller/util"
 	"github.com/juju/juju/cmd/jujud/reboot"
 	"github.com/juju/juju/controller"
+	corechangestream "github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/life"
 	corelogger "github.com/juju/juju/core/logger"
@@ -61,6 +62,7 @@ import (
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/core/status"
 	jujuversion "github.com/juju/juju/core/version"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	"github.com/juju/juju/environs"
 	"github.com/juju/juju/internal/cmd"
 	"github.com/juju/juju/internal/container/broker"
@@ -642,6 +644,8 @@ func (a *MachineAgent) makeEngineCreator(
 			RegisterIntrospectionHTTPHandlers: registerIntrospectionHandlers,
 			NewModelWorker:                    a.startModelWorkers,
 			MuxShutdownWait:                   1 * time.Minute,
+			ChangeStreamMaxTermSize:           corechangestream.DefaultMaxTermSize,
+			SecretBackendFailureTTL:           secretservice.DefaultBackendFailureTTL,
 			NewBrokerFunc:                     newBroker,
 			IsCaasConfig:                      a.isCaasAgent,
 			UnitEngineConfig: func() dependency.EngineConfig {
@@ -659,6 +663,7 @@ func (a *MachineAgent) makeEngineCreator(
 		if a.isCaasAgent {
 			manifolds = caasMachineManifolds(manifoldsCfg)
 		}
+		manifolds = agentengine.RecoverPanics(manifolds, internallogger.GetLogger("juju.worker.dependency"))
 		if err := dependency.Install(eng, manifolds); err != nil {
 			if err := worker.Stop(eng); err != nil {
 				logger.Errorf("while stopping engine with bad manifolds: %v", err)
@@ -264,6 +264,14 @@ type ManifoldsConfig struct {
 	// exits regardless.
 	MuxShutdownWait time.Duration
 
+	// ChangeStreamMaxTermSize is the maximum number of changes the
+	// change stream will deliver within a single term.
+	ChangeStreamMaxTermSize int
+
+	// SecretBackendFailureTTL is how long a failure to connect to a secret
+	// backend is remembered by the secret services.
+	SecretBackendFailureTTL time.Duration
+
 	// NewBrokerFunc is a function opens a instance broker (LXD/KVM)
 	NewBrokerFunc containerbroker.NewBrokerFunc
 
@@ -713,6 +721,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewDomainServicesGetter:     workerdomainservices.NewDomainServicesGetter,
 			NewControllerDomainServices: workerdomainservices.NewControllerDomainServices,
 			NewModelDomainServices:      workerdomainservices.NewProviderTrackerModelDomainServices,
+			SecretBackendFailureTTL:     config.SecretBackendFailureTTL,
 		}),
 
 		providerDomainServicesName: providerservicefactory.Manifold(providerservicefactory.ManifoldConfig{
@@ -745,6 +754,7 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			PrometheusRegisterer: config.PrometheusRegisterer,
 			NewWatchableDB:       changestream.NewWatchableDB,
 			NewMetricsCollector:  changestream.NewMetricsCollector,
+			MaxTermSize:          config.ChangeStreamMaxTermSize,
 		}),
 
 		changeStreamPrunerName: ifPrimaryController(changestreampruner.Manifold(changestreampruner.ManifoldConfig{
@@ -51,6 +51,7 @@ import (
 	"github.com/juju/juju/internal/worker/modelworkermanager"
 	"github.com/juju/juju/internal/worker/providertracker"
 	"github.com/juju/juju/internal/worker/remoterelations"
+	"github.com/juju/juju/internal/worker/secretcontentpruner"
 	"github.com/juju/juju/internal/worker/secretsdrainworker"
 	"github.com/juju/juju/internal/worker/secretspruner"
 	"github.com/juju/juju/internal/worker/singular"
@@ -345,6 +346,11 @@ func commonManifolds(config ManifoldsConfig) dependency.Manifolds {
 			NewUserSecretsFacade: secretspruner.NewUserSecretsFacade,
 			NewWorker:            secretspruner.NewWorker,
 		})),
+		secretContentPrunerName: ifNotMigrating(secretcontentpruner.Manifold(secretcontentpruner.ManifoldConfig{
+			DomainServicesName: domainServicesName,
+			Logger:             config.LoggingContext.GetLogger("juju.worker.secretcontentpruner"),
+			NewWorker:          secretcontentpruner.NewWorker,
+		})),
 		// The userSecretsDrainWorker is the worker that drains the user secrets from the inactive backend to the current active backend.
 		userSecretsDrainWorker: ifNotMigrating(secretsdrainworker.Manifold(secretsdrainworker.ManifoldConfig{
 			APICallerName:         apiCallerName,
@@ -662,8 +668,9 @@ const (
 	caasApplicationProvisionerName = "caas-application-provisioner"
 	caasStorageProvisionerName     = "caas-storage-provisioner"
 
-	secretsPrunerName      = "secrets-pruner"
-	userSecretsDrainWorker = "user-secrets-drain-worker"
+	secretContentPrunerName = "secret-content-pruner"
+	secretsPrunerName       = "secrets-pruner"
+	userSecretsDrainWorker  = "user-secrets-drain-worker"
 
 	validCredentialFlagName = "valid-credential-flag"
 )
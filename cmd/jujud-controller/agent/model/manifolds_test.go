@@ -60,6 +60,7 @@ func (s *ManifoldsSuite) TestIAASNames(c *gc.C) {
 		"provider-service-factories",
 		"provider-tracker",
 		"remote-relations",
+		"secret-content-pruner",
 		"secrets-pruner",
 		"state-cleaner",
 		"storage-provisioner",
@@ -105,6 +106,7 @@ func (s *ManifoldsSuite) TestCAASNames(c *gc.C) {
 		"provider-service-factories",
 		"provider-tracker",
 		"remote-relations",
+		"secret-content-pruner",
 		"secrets-pruner",
 		"state-cleaner",
 		"undertaker",
@@ -202,6 +204,16 @@ func (s *ManifoldsSuite) TestCAASManifold(c *gc.C) {
 
 var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-content-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
@@ -383,6 +395,16 @@ var expectedCAASModelManifoldsWithDependencies = map[string][]string{
 
 var expectedIAASModelManifoldsWithDependencies = map[string][]string{
 
+	"secret-content-pruner": {
+		"agent",
+		"api-caller",
+		"domain-services",
+		"is-responsible-flag",
+		"migration-fortress",
+		"migration-inactive-flag",
+		"not-dead-flag",
+	},
+
 	"secrets-pruner": {
 		"agent",
 		"api-caller",
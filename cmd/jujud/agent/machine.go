@@ -579,6 +579,7 @@ func (a *MachineAgent) makeEngineCreator(
 		if a.isCaasAgent {
 			manifolds = caasMachineManifolds(manifoldsCfg)
 		}
+		manifolds = agentengine.RecoverPanics(manifolds, internallogger.GetLogger("juju.worker.dependency"))
 		if err := dependency.Install(engine, manifolds); err != nil {
 			if err := worker.Stop(engine); err != nil {
 				logger.Errorf("while stopping engine with bad manifolds: %v", err)
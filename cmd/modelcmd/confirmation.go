@@ -17,8 +17,10 @@ type DestroyConfirmationCommandBase struct {
 	assumeNoPrompt bool
 }
 
+// SetFlags implements Command.SetFlags.
 func (c *DestroyConfirmationCommandBase) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.assumeNoPrompt, "no-prompt", false, "Do not ask for confirmation")
+	f.BoolVar(&c.assumeNoPrompt, "y", false, "")
 }
 
 // NeedsConfirmation returns indicates whether confirmation is required or not.
@@ -39,6 +41,7 @@ type RemoveConfirmationCommandBase struct {
 // SetFlags implements Command.SetFlags.
 func (c *RemoveConfirmationCommandBase) SetFlags(f *gnuflag.FlagSet) {
 	f.BoolVar(&c.assumeNoPrompt, "no-prompt", false, "Do not ask for confirmation. Overrides `mode` model config setting")
+	f.BoolVar(&c.assumeNoPrompt, "y", false, "")
 }
 
 // NeedsConfirmation returns indicates whether confirmation is required or not.
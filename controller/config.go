@@ -166,6 +166,16 @@ const (
 	// which should be more than enough time for a debugging session.
 	MaxDebugLogDuration = "max-debug-log-duration"
 
+	// APIRequestTimeout is the maximum time the API server allows a request
+	// to run before cancelling it. Requests on watcher facades are exempt.
+	// A value of zero means no limit.
+	APIRequestTimeout = "api-request-timeout"
+
+	// APIWatcherRequestTimeout is the maximum time the API server allows a
+	// request on a watcher facade to run before cancelling it. A value of
+	// zero means no limit.
+	APIWatcherRequestTimeout = "api-watcher-request-timeout"
+
 	// AgentLogfileMaxSize is the maximum file size of each agent log file,
 	// in MB.
 	AgentLogfileMaxSize = "agent-logfile-max-size"
@@ -310,6 +320,11 @@ const (
 	// Can be set to "legacy", "snapstore", "local" or "local-dangerous".
 	// Cannot be changed.
 	JujudControllerSnapSource = "jujud-controller-snap-source"
+
+	// StrictSchemaChecking (true/false) determines whether the controller
+	// refuses to start when the database schema has been altered outside
+	// of the schema migrations.
+	StrictSchemaChecking = "strict-schema-checking"
 )
 
 // Attribute Defaults
@@ -463,6 +478,10 @@ const (
 	// TODO(jujud-controller-snap): change this to "snapstore" once it is implemented.
 	DefaultJujudControllerSnapSource = "legacy"
 
+	// DefaultStrictSchemaChecking is the default value for if the controller
+	// refuses to start when the database schema integrity check fails.
+	DefaultStrictSchemaChecking = false
+
 	// DefaultObjectStoreType is the default type of object store to use for
 	// storing blobs.
 	DefaultObjectStoreType = objectstore.FileBackend
@@ -491,6 +510,8 @@ var (
 		MongoMemoryProfile,
 		JujuDBSnapChannel,
 		MaxDebugLogDuration,
+		APIRequestTimeout,
+		APIWatcherRequestTimeout,
 		MaxTxnLogSize,
 		MaxPruneTxnBatchSize,
 		MaxPruneTxnPasses,
@@ -531,6 +552,7 @@ var (
 		ObjectStoreS3StaticSession,
 		SystemSSHKeys,
 		JujudControllerSnapSource,
+		StrictSchemaChecking,
 	}
 
 	// For backwards compatibility, we must include "anything", "juju-apiserver"
@@ -590,6 +612,7 @@ var (
 		ObjectStoreS3StaticKey,
 		ObjectStoreS3StaticSecret,
 		ObjectStoreS3StaticSession,
+		StrictSchemaChecking,
 	)
 
 	methodNameRE = regexp.MustCompile(`[[:alpha:]][[:alnum:]]*\.[[:alpha:]][[:alnum:]]*`)
@@ -979,6 +1002,18 @@ func (c Config) MaxDebugLogDuration() time.Duration {
 	return c.durationOrDefault(MaxDebugLogDuration, DefaultMaxDebugLogDuration)
 }
 
+// APIRequestTimeout is the maximum time the API server allows a request to
+// run. A value of zero indicates no limit.
+func (c Config) APIRequestTimeout() time.Duration {
+	return c.durationOrDefault(APIRequestTimeout, 0)
+}
+
+// APIWatcherRequestTimeout is the maximum time the API server allows a
+// request on a watcher facade to run. A value of zero indicates no limit.
+func (c Config) APIWatcherRequestTimeout() time.Duration {
+	return c.durationOrDefault(APIWatcherRequestTimeout, 0)
+}
+
 // MaxTxnLogSizeMB is the maximum size in MiB of the txn log collection.
 func (c Config) MaxTxnLogSizeMB() int {
 	return c.sizeMBOrDefault(MaxTxnLogSize, DefaultMaxTxnLogCollectionMB)
@@ -1065,6 +1100,12 @@ func (c Config) QueryTracingEnabled() bool {
 	return c.boolOrDefault(QueryTracingEnabled, DefaultQueryTracingEnabled)
 }
 
+// StrictSchemaChecking returns whether the controller should refuse to start
+// when the database schema integrity check fails.
+func (c Config) StrictSchemaChecking() bool {
+	return c.boolOrDefault(StrictSchemaChecking, DefaultStrictSchemaChecking)
+}
+
 // QueryTracingThreshold returns the threshold for query tracing. The
 // lower the threshold, the more queries will be output. A value of 0
 // means all queries will be output.
@@ -1228,6 +1269,14 @@ func Validate(c Config) error {
 		}
 	}
 
+	for _, name := range []string{APIRequestTimeout, APIWatcherRequestTimeout} {
+		if v, err := parseDuration(c, name); err != nil && !errors.Is(err, errors.NotFound) {
+			return errors.Trace(err)
+		} else if err == nil && v < 0 {
+			return errors.NotValidf("negative %s", name)
+		}
+	}
+
 	if v, ok := c[AgentLogfileMaxBackups].(int); ok {
 		if v < 0 {
 			return errors.NotValidf("negative %s", AgentLogfileMaxBackups)
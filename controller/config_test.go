@@ -205,6 +205,12 @@ var newConfigTests = []struct {
 		controller.MaxDebugLogDuration: time.Duration(0),
 	},
 	expectError: `max-debug-log-duration cannot be zero`,
+}, {
+	about: "api-request-timeout not valid",
+	config: controller.Config{
+		controller.APIRequestTimeout: -time.Second,
+	},
+	expectError: `negative api-request-timeout not valid`,
 }, {
 	about: "agent-logfile-max-backups not valid",
 	config: controller.Config{
@@ -34,6 +34,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	MongoMemoryProfile:                 schema.String(),
 	JujuDBSnapChannel:                  schema.String(),
 	MaxDebugLogDuration:                schema.TimeDurationString(),
+	APIRequestTimeout:                  schema.TimeDurationString(),
+	APIWatcherRequestTimeout:           schema.TimeDurationString(),
 	MaxTxnLogSize:                      schema.String(),
 	MaxPruneTxnBatchSize:               schema.ForceInt(),
 	MaxPruneTxnPasses:                  schema.ForceInt(),
@@ -69,6 +71,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	ObjectStoreS3StaticSession:         schema.String(),
 	SystemSSHKeys:                      schema.String(),
 	JujudControllerSnapSource:          schema.String(),
+	StrictSchemaChecking:               schema.Bool(),
 }, schema.Defaults{
 	AgentRateLimitMax:                  schema.Omit,
 	AgentRateLimitRate:                 schema.Omit,
@@ -92,6 +95,8 @@ var configChecker = schema.FieldMap(schema.Fields{
 	MongoMemoryProfile:                 DefaultMongoMemoryProfile,
 	JujuDBSnapChannel:                  DefaultJujuDBSnapChannel,
 	MaxDebugLogDuration:                DefaultMaxDebugLogDuration,
+	APIRequestTimeout:                  schema.Omit,
+	APIWatcherRequestTimeout:           schema.Omit,
 	MaxTxnLogSize:                      fmt.Sprintf("%vM", DefaultMaxTxnLogCollectionMB),
 	MaxPruneTxnBatchSize:               DefaultMaxPruneTxnBatchSize,
 	MaxPruneTxnPasses:                  DefaultMaxPruneTxnPasses,
@@ -127,6 +132,7 @@ var configChecker = schema.FieldMap(schema.Fields{
 	ObjectStoreS3StaticSession:         schema.Omit,
 	SystemSSHKeys:                      schema.Omit,
 	JujudControllerSnapSource:          DefaultJujudControllerSnapSource,
+	StrictSchemaChecking:               DefaultStrictSchemaChecking,
 })
 
 // ConfigSchema holds information on all the fields defined by
@@ -237,6 +243,14 @@ they don't have any access rights to the controller itself`,
 		Type:        environschema.Tstring,
 		Description: `The maximum duration that a debug-log session is allowed to run`,
 	},
+	APIRequestTimeout: {
+		Type:        environschema.Tstring,
+		Description: `The maximum duration that an API request is allowed to run, excluding requests on watchers`,
+	},
+	APIWatcherRequestTimeout: {
+		Type:        environschema.Tstring,
+		Description: `The maximum duration that an API request on a watcher is allowed to run`,
+	},
 	MaxTxnLogSize: {
 		Type:        environschema.Tstring,
 		Description: `The maximum size the of capped txn log collection`,
@@ -372,4 +386,9 @@ will be output if tracing is enabled.`,
 		Type:        environschema.Tstring,
 		Description: `The source for the jujud-controller snap.`,
 	},
+	StrictSchemaChecking: {
+		Type: environschema.Tbool,
+		Description: `Determines if the controller refuses to start when the
+database schema has been modified outside of the schema migrations`,
+	},
 }
@@ -7,4 +7,9 @@ var (
 	// DefaultNumTermWatermarks is the default number of terms (watermarks) to
 	// keep before removing the oldest one.
 	DefaultNumTermWatermarks = 10
+
+	// DefaultMaxTermSize is the default maximum number of changes within a
+	// single term. A poll which returns more changes than this is split into
+	// multiple terms, to bound the memory of each term.
+	DefaultMaxTermSize = 1000
 )
@@ -0,0 +1,74 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package constraints
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+)
+
+// ConstraintConflict describes a constraint attribute which is set to
+// different values in the base and overlay constraints being merged.
+type ConstraintConflict struct {
+	// Attribute is the name of the conflicting constraint, e.g. "mem".
+	Attribute string
+	// Base is the value of the attribute in the base constraints.
+	Base string
+	// Overlay is the value of the attribute in the overlay constraints.
+	Overlay string
+}
+
+// String implements fmt.Stringer.
+func (c ConstraintConflict) String() string {
+	return fmt.Sprintf("%s: %q != %q", c.Attribute, c.Base, c.Overlay)
+}
+
+// MergeApplicationConstraints merges the overlay constraints onto the base
+// constraints. Attributes set in only one of them are kept as they are, and
+// attributes set in both take the overlay value. Any attribute set in both
+// to different values is reported as a conflict, ordered by attribute name,
+// so that callers can choose to reject the merge instead.
+// An error is returned if the merged constraints are not valid.
+func MergeApplicationConstraints(base, overlay Value) (Value, []ConstraintConflict, error) {
+	baseAttrs := base.attributesWithValues()
+	overlayAttrs := overlay.attributesWithValues()
+
+	var conflicts []ConstraintConflict
+	merged := make(map[string]interface{}, len(baseAttrs)+len(overlayAttrs))
+	for attr, value := range baseAttrs {
+		merged[attr] = value
+	}
+	for attr, value := range overlayAttrs {
+		if baseValue, ok := baseAttrs[attr]; ok && !reflect.DeepEqual(baseValue, value) {
+			conflicts = append(conflicts, ConstraintConflict{
+				Attribute: attr,
+				Base:      attributeString(attr, baseValue),
+				Overlay:   attributeString(attr, value),
+			})
+		}
+		merged[attr] = value
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Attribute < conflicts[j].Attribute
+	})
+
+	// Round trip the merged constraints through the parser so that
+	// combinations which can't be expressed are rejected.
+	result, err := Parse(fromAttributes(merged).String())
+	if err != nil {
+		return Value{}, nil, errors.Annotate(err, "merging constraints")
+	}
+	return result, conflicts, nil
+}
+
+// attributeString returns the value of the attribute in the form it is
+// written in a constraints string.
+func attributeString(attr string, value interface{}) string {
+	str := fromAttributes(map[string]interface{}{attr: value}).String()
+	return strings.TrimPrefix(str, attr+"=")
+}
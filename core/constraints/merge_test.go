@@ -0,0 +1,110 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package constraints_test
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/constraints"
+)
+
+type mergeApplicationSuite struct{}
+
+var _ = gc.Suite(&mergeApplicationSuite{})
+
+var mergeApplicationTests = []struct {
+	desc      string
+	base      string
+	overlay   string
+	expected  string
+	conflicts []constraints.ConstraintConflict
+}{
+	{
+		desc:     "both empty",
+		expected: "",
+	}, {
+		desc:     "disjoint",
+		base:     "arch=amd64",
+		overlay:  "mem=4G",
+		expected: "arch=amd64 mem=4G",
+	}, {
+		desc:     "arch same",
+		base:     "arch=amd64",
+		overlay:  "arch=amd64",
+		expected: "arch=amd64",
+	}, {
+		desc:     "arch conflict",
+		base:     "arch=amd64",
+		overlay:  "arch=arm64",
+		expected: "arch=arm64",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "arch", Base: "amd64", Overlay: "arm64",
+		}},
+	}, {
+		desc:     "instance-type conflict",
+		base:     "instance-type=m5.large",
+		overlay:  "instance-type=m5.xlarge",
+		expected: "instance-type=m5.xlarge",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "instance-type", Base: "m5.large", Overlay: "m5.xlarge",
+		}},
+	}, {
+		desc:     "cores conflict",
+		base:     "cores=2",
+		overlay:  "cpu-cores=4",
+		expected: "cores=4",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "cores", Base: "2", Overlay: "4",
+		}},
+	}, {
+		desc:     "mem same in different units",
+		base:     "mem=1G",
+		overlay:  "mem=1024M",
+		expected: "mem=1G",
+	}, {
+		desc:     "mem conflict",
+		base:     "mem=2G",
+		overlay:  "mem=4G",
+		expected: "mem=4G",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "mem", Base: "2048M", Overlay: "4096M",
+		}},
+	}, {
+		desc:     "spaces conflict",
+		base:     "spaces=alpha,^beta",
+		overlay:  "spaces=gamma",
+		expected: "spaces=gamma",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "spaces", Base: "alpha,^beta", Overlay: "gamma",
+		}},
+	}, {
+		desc:     "several conflicts are ordered",
+		base:     "mem=2G cores=2 arch=amd64",
+		overlay:  "mem=4G cores=4 spaces=alpha",
+		expected: "arch=amd64 cores=4 mem=4G spaces=alpha",
+		conflicts: []constraints.ConstraintConflict{{
+			Attribute: "cores", Base: "2", Overlay: "4",
+		}, {
+			Attribute: "mem", Base: "2048M", Overlay: "4096M",
+		}},
+	},
+}
+
+func (s *mergeApplicationSuite) TestMergeApplicationConstraints(c *gc.C) {
+	for i, t := range mergeApplicationTests {
+		c.Logf("test %d: %s", i, t.desc)
+		merged, conflicts, err := constraints.MergeApplicationConstraints(
+			constraints.MustParse(t.base), constraints.MustParse(t.overlay),
+		)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(merged, jc.DeepEquals, constraints.MustParse(t.expected))
+		c.Check(conflicts, jc.DeepEquals, t.conflicts)
+	}
+}
+
+func (s *mergeApplicationSuite) TestConstraintConflictString(c *gc.C) {
+	conflict := constraints.ConstraintConflict{Attribute: "mem", Base: "2048M", Overlay: "4096M"}
+	c.Check(conflict.String(), gc.Equals, `mem: "2048M" != "4096M"`)
+}
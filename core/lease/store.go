@@ -49,11 +49,18 @@ type Store interface {
 	// the recipient of the pin behaviour.
 	// The input entity denotes the party responsible for the
 	// pinning operation.
+	// Pins are stored durably, so they survive a controller restart. A
+	// pinned lease is held indefinitely, even once its expiry time has
+	// passed and even if the holder stops extending it, until every entity
+	// has unpinned it.
 	PinLease(ctx context.Context, lease Key, entity string) error
 
 	// UnpinLease reverses a Pin operation for the same key and entity.
 	// Normal expiry behaviour is restored when no entities remain with
-	// pins for the application.
+	// pins for the application. The lease keeps its recorded expiry time,
+	// so the "at least duration" guarantee of the last claim or extension
+	// still holds; if that time has already passed, the lease is expired
+	// at the next opportunity.
 	UnpinLease(ctx context.Context, lease Key, entity string) error
 
 	// Pinned returns a snapshot of pinned leases.
@@ -38,4 +38,8 @@ const (
 
 	// SSHIMPORTER defines a common tag for delaing with ssh key importer.
 	SSHIMPORTER Tag = "ssh-importer"
+
+	// SECURITY defines a common tag for security relevant events, such as
+	// operator actions which change what a model exposes.
+	SECURITY Tag = "security"
 )
@@ -52,6 +52,32 @@ type SerializedModel struct {
 
 	// Resources represents all the resources in use in the model.
 	Resources []SerializedModelResource
+
+	// SecretBackends holds the secret backends the model depends on, or
+	// nil if the source controller does not export them.
+	SecretBackends *SerializedModelSecretBackends
+}
+
+// SerializedModelSecretBackends holds the secret backends a model depends
+// on. They are carried outside the model description since they hold
+// backend credentials.
+type SerializedModelSecretBackends struct {
+	// ModelBackendName is the name of the backend the model is configured
+	// to use, or "auto" for the built-in backend.
+	ModelBackendName string
+
+	// Backends are the user-defined secret backends used by the model.
+	Backends []SerializedModelSecretBackend
+}
+
+// SerializedModelSecretBackend defines a secret backend used by a model.
+type SerializedModelSecretBackend struct {
+	ID                  string
+	Name                string
+	BackendType         string
+	TokenRotateInterval *time.Duration
+	NextRotateTime      *time.Time
+	Config              map[string]interface{}
 }
 
 // SerializedModelResource defines the resource revisions for a
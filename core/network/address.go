@@ -370,21 +370,44 @@ func deriveScope(addr MachineAddress) Scope {
 	if addr.Type == HostName {
 		return addr.Scope
 	}
-	ip := net.ParseIP(addr.Value)
+	if scope := ClassifyAddress(addr.Value, nil); scope != ScopeUnknown {
+		return scope
+	}
+	return addr.Scope
+}
+
+// ClassifyAddress returns the scope of the input IP address. Addresses in
+// any of the known fan overlay subnets are fan-local, as are addresses in the
+// IPv4 class E range that fan overlays use by default. IPv4 RFC1918 and IPv6
+// unique local addresses are cloud-local, loopback addresses are
+// machine-local, and link-local and interface-local addresses are link-local.
+// Any other global unicast address is public. ScopeUnknown is returned for
+// host names and any address that fits none of these.
+func ClassifyAddress(addr string, knownSubnets []net.IPNet) Scope {
+	ip := net.ParseIP(addr)
 	if ip == nil {
-		return addr.Scope
+		return ScopeUnknown
+	}
+	addrType := IPv6Address
+	if ip.To4() != nil {
+		addrType = IPv4Address
 	}
+
 	if ip.IsLoopback() {
 		return ScopeMachineLocal
 	}
-	if isIPv4PrivateNetworkAddress(addr.Type, ip) ||
-		isIPv6UniqueLocalAddress(addr.Type, ip) {
+	for _, subnet := range knownSubnets {
+		if subnet.Contains(ip) {
+			return ScopeFanLocal
+		}
+	}
+	if isIPv4PrivateNetworkAddress(addrType, ip) ||
+		isIPv6UniqueLocalAddress(addrType, ip) {
 		return ScopeCloudLocal
 	}
-	if isIPv4ReservedEAddress(addr.Type, ip) {
+	if isIPv4ReservedEAddress(addrType, ip) {
 		return ScopeFanLocal
 	}
-
 	if ip.IsLinkLocalMulticast() ||
 		ip.IsLinkLocalUnicast() ||
 		ip.IsInterfaceLocalMulticast() {
@@ -393,7 +416,7 @@ func deriveScope(addr MachineAddress) Scope {
 	if ip.IsGlobalUnicast() {
 		return ScopePublic
 	}
-	return addr.Scope
+	return ScopeUnknown
 }
 
 func isIPv4PrivateNetworkAddress(addrType AddressType, ip net.IP) bool {
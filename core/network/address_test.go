@@ -279,6 +279,82 @@ func (s *AddressSuite) TestNewScopedAddressHostname(c *gc.C) {
 	c.Check(addr.Scope, gc.Equals, network.ScopeUnknown)
 }
 
+func (s *AddressSuite) TestClassifyAddress(c *gc.C) {
+	tests := []struct {
+		value string
+		scope network.Scope
+	}{
+		{"localhost", network.ScopeUnknown},
+		{"", network.ScopeUnknown},
+		{"not-an-ip", network.ScopeUnknown},
+
+		{"127.0.0.1", network.ScopeMachineLocal},
+		{"127.255.255.254", network.ScopeMachineLocal},
+		{"::1", network.ScopeMachineLocal},
+
+		{"10.0.0.1", network.ScopeCloudLocal},
+		{"10.255.255.255", network.ScopeCloudLocal},
+		{"172.16.0.1", network.ScopeCloudLocal},
+		{"172.31.255.254", network.ScopeCloudLocal},
+		{"192.168.1.1", network.ScopeCloudLocal},
+		{"::ffff:10.0.0.1", network.ScopeCloudLocal},
+		{"fc00::1", network.ScopeCloudLocal},
+		{"fd12:3456:789a::1", network.ScopeCloudLocal},
+
+		{"240.0.0.1", network.ScopeFanLocal},
+		{"252.80.0.100", network.ScopeFanLocal},
+
+		{"169.254.1.1", network.ScopeLinkLocal},
+		{"224.0.0.251", network.ScopeLinkLocal},
+		{"fe80::1", network.ScopeLinkLocal},
+		{"ff01::1", network.ScopeLinkLocal},
+		{"ff02::1", network.ScopeLinkLocal},
+
+		{"8.8.8.8", network.ScopePublic},
+		{"172.32.0.1", network.ScopePublic},
+		{"192.169.0.1", network.ScopePublic},
+		{"2001:db8::1", network.ScopePublic},
+		{"fe00::1", network.ScopePublic},
+
+		{"0.0.0.0", network.ScopeUnknown},
+		{"::", network.ScopeUnknown},
+		{"239.1.1.1", network.ScopeUnknown},
+		{"ff05::1", network.ScopeUnknown},
+	}
+	for i, t := range tests {
+		c.Logf("test %d: %q", i, t.value)
+		c.Check(network.ClassifyAddress(t.value, nil), gc.Equals, t.scope)
+	}
+}
+
+func (s *AddressSuite) TestClassifyAddressKnownSubnets(c *gc.C) {
+	_, overlay, err := net.ParseCIDR("10.100.0.0/16")
+	c.Assert(err, jc.ErrorIsNil)
+	_, overlay6, err := net.ParseCIDR("fd00:100::/32")
+	c.Assert(err, jc.ErrorIsNil)
+	subnets := []net.IPNet{*overlay, *overlay6}
+
+	tests := []struct {
+		value string
+		scope network.Scope
+	}{
+		// Overlay subnets take precedence over the private ranges they
+		// may be carved from.
+		{"10.100.3.4", network.ScopeFanLocal},
+		{"fd00:100::5", network.ScopeFanLocal},
+		{"10.101.3.4", network.ScopeCloudLocal},
+		{"fd00:101::5", network.ScopeCloudLocal},
+
+		// But never over the loopback range.
+		{"127.0.0.1", network.ScopeMachineLocal},
+		{"8.8.8.8", network.ScopePublic},
+	}
+	for i, t := range tests {
+		c.Logf("test %d: %q", i, t.value)
+		c.Check(network.ClassifyAddress(t.value, subnets), gc.Equals, t.scope)
+	}
+}
+
 type selectTest struct {
 	about         string
 	addresses     network.SpaceAddresses
@@ -145,7 +145,7 @@ func addressesToConfig(nic InterfaceInfo, nicAddrs []ConfigSourceAddr) ([]Provid
 		ip := nicAddr.IP()
 
 		// TODO (macgreagoir): Skip IPv6 link-local until we decide how to handle them.
-		if ip.To4() == nil && ip.IsLinkLocalUnicast() {
+		if ip.To4() == nil && ClassifyAddress(ip.String(), nil) == ScopeLinkLocal {
 			logger.Tracef("skipping observed IPv6 link-local address %q on %q", ip, nic.InterfaceName)
 			continue
 		}
@@ -62,6 +62,11 @@ type SubnetInfo struct {
 
 	// Life represents the current life-cycle status of the subnets.
 	Life life.Value
+
+	// ProviderAttributes holds provider-specific metadata about the subnet,
+	// such as the service endpoints of an Azure subnet. It can be empty if
+	// the provider does not report any.
+	ProviderAttributes map[string]string
 }
 
 // Validate validates the subnet, checking the CIDR, and VLANTag, if present.
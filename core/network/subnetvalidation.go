@@ -0,0 +1,182 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/juju/errors"
+)
+
+// SubnetValidationCode identifies the reason a subnet CIDR failed
+// validation.
+type SubnetValidationCode string
+
+const (
+	// SubnetTooSmall indicates that the subnet is smaller than the provider
+	// allows.
+	SubnetTooSmall SubnetValidationCode = "too_small"
+
+	// SubnetOverlapsExisting indicates that the subnet overlaps a subnet
+	// already known to the model.
+	SubnetOverlapsExisting SubnetValidationCode = "overlaps_existing"
+
+	// SubnetOverlapsHost indicates that the subnet overlaps a network of the
+	// host, which the provider does not allow.
+	SubnetOverlapsHost SubnetValidationCode = "overlaps_host"
+
+	// SubnetReservedRange indicates that the subnet overlaps an address range
+	// reserved for special use, such as loopback or multicast.
+	SubnetReservedRange SubnetValidationCode = "reserved_range"
+)
+
+// SubnetValidationError describes a constraint that a subnet CIDR does not
+// satisfy.
+type SubnetValidationError struct {
+	// Code identifies the constraint that is not satisfied.
+	Code SubnetValidationCode
+
+	// Message is a human-readable description of the problem.
+	Message string
+}
+
+// Error implements error.
+func (e SubnetValidationError) Error() string {
+	return e.Message
+}
+
+// minIPv4SubnetPrefix holds, for providers which restrict the size of a
+// subnet, the longest IPv4 prefix length (and so smallest subnet) allowed.
+var minIPv4SubnetPrefix = map[string]int{
+	"ec2":   28,
+	"azure": 29,
+}
+
+// hostOverlapProviders are the providers whose subnets must not overlap the
+// networks of the host.
+var hostOverlapProviders = map[string]bool{
+	"lxd": true,
+}
+
+// reservedRanges are the address ranges reserved for special use, which
+// cannot be used for a subnet.
+var reservedRanges = mustParseCIDRs(
+	"0.0.0.0/8",
+	"127.0.0.0/8",
+	"169.254.0.0/16",
+	"224.0.0.0/4",
+	"255.255.255.255/32",
+	"::/128",
+	"::1/128",
+	"fe80::/10",
+	"ff00::/8",
+)
+
+func mustParseCIDRs(cidrs ...string) []*net.IPNet {
+	result := make([]*net.IPNet, len(cidrs))
+	for i, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		result[i] = ipNet
+	}
+	return result
+}
+
+// ValidateSubnetCIDR checks that the input CIDR can be used for a subnet on
+// the provider with the input type. The CIDR is checked against the size
+// limits of the provider, the reserved address ranges, the existing subnet
+// CIDRs of the model, and for providers which require it, the networks of the
+// host. Existing CIDRs identical to the input are not considered overlapping,
+// since they describe the same subnet.
+//
+// An error is returned if the CIDR cannot be parsed. Otherwise, each
+// constraint that the CIDR does not satisfy is returned as a
+// SubnetValidationError. An empty result means the CIDR is valid.
+func ValidateSubnetCIDR(cidr string, providerType string, existing []string) ([]SubnetValidationError, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.NotValidf("CIDR %q", cidr)
+	}
+
+	var result []SubnetValidationError
+
+	ones, bits := ipNet.Mask.Size()
+	if minPrefix, ok := minIPv4SubnetPrefix[providerType]; ok && bits == 32 && ones > minPrefix {
+		result = append(result, SubnetValidationError{
+			Code:    SubnetTooSmall,
+			Message: fmt.Sprintf("subnet %q is smaller than the /%d minimum for %s", cidr, minPrefix, providerType),
+		})
+	}
+
+	for _, reserved := range reservedRanges {
+		if cidrsOverlap(ipNet, reserved) {
+			result = append(result, SubnetValidationError{
+				Code:    SubnetReservedRange,
+				Message: fmt.Sprintf("subnet %q overlaps reserved range %q", cidr, reserved.String()),
+			})
+		}
+	}
+
+	for _, other := range existing {
+		_, otherNet, err := net.ParseCIDR(other)
+		if err != nil || otherNet.String() == ipNet.String() {
+			continue
+		}
+		if cidrsOverlap(ipNet, otherNet) {
+			result = append(result, SubnetValidationError{
+				Code:    SubnetOverlapsExisting,
+				Message: fmt.Sprintf("subnet %q overlaps existing subnet %q", cidr, other),
+			})
+		}
+	}
+
+	if hostOverlapProviders[providerType] {
+		hostNets, err := hostNetworks()
+		if err != nil {
+			return nil, errors.Annotate(err, "getting host networks")
+		}
+		for _, hostNet := range hostNets {
+			if cidrsOverlap(ipNet, hostNet) {
+				result = append(result, SubnetValidationError{
+					Code:    SubnetOverlapsHost,
+					Message: fmt.Sprintf("subnet %q overlaps host network %q", cidr, hostNet.String()),
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// hostNetworks returns the networks of the host's interface addresses,
+// excluding loopback and link-local networks.
+func hostNetworks() ([]*net.IPNet, error) {
+	addrs, err := InterfaceAddrs()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	var result []*net.IPNet
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if scope := ClassifyAddress(ipNet.IP.String(), nil); scope == ScopeMachineLocal || scope == ScopeLinkLocal {
+			continue
+		}
+		result = append(result, &net.IPNet{
+			IP:   ipNet.IP.Mask(ipNet.Mask),
+			Mask: ipNet.Mask,
+		})
+	}
+	return result, nil
+}
+
+// cidrsOverlap returns true if either network contains the other.
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package network_test
+
+import (
+	"net"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+)
+
+type subnetValidationSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&subnetValidationSuite{})
+
+func (s *subnetValidationSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+	s.PatchValue(&network.InterfaceAddrs, func() ([]net.Addr, error) {
+		return []net.Addr{
+			&net.IPNet{IP: net.ParseIP("127.0.0.1"), Mask: net.CIDRMask(8, 32)},
+			&net.IPNet{IP: net.ParseIP("192.168.1.10"), Mask: net.CIDRMask(24, 32)},
+		}, nil
+	})
+}
+
+func codes(errs []network.SubnetValidationError) []network.SubnetValidationCode {
+	var result []network.SubnetValidationCode
+	for _, e := range errs {
+		result = append(result, e.Code)
+	}
+	return result
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDRValid(c *gc.C) {
+	errs, err := network.ValidateSubnetCIDR("10.0.0.0/24", "ec2", []string{"10.0.1.0/24", "10.0.0.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(errs, gc.HasLen, 0)
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDRNotValid(c *gc.C) {
+	_, err := network.ValidateSubnetCIDR("10.0.0.0", "ec2", nil)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDRTooSmall(c *gc.C) {
+	errs, err := network.ValidateSubnetCIDR("10.0.0.0/29", "ec2", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(codes(errs), jc.DeepEquals, []network.SubnetValidationCode{network.SubnetTooSmall})
+	c.Check(errs[0].Message, gc.Equals, `subnet "10.0.0.0/29" is smaller than the /28 minimum for ec2`)
+
+	errs, err = network.ValidateSubnetCIDR("10.0.0.0/29", "azure", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(errs, gc.HasLen, 0)
+
+	errs, err = network.ValidateSubnetCIDR("10.0.0.0/30", "azure", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(codes(errs), jc.DeepEquals, []network.SubnetValidationCode{network.SubnetTooSmall})
+
+	// Providers without a size limit accept small subnets.
+	errs, err = network.ValidateSubnetCIDR("10.0.0.0/30", "maas", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(errs, gc.HasLen, 0)
+
+	// The limit only applies to IPv4 subnets.
+	errs, err = network.ValidateSubnetCIDR("2001:db8::/64", "ec2", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(errs, gc.HasLen, 0)
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDROverlapsExisting(c *gc.C) {
+	errs, err := network.ValidateSubnetCIDR("10.0.0.0/16", "maas", []string{"10.0.3.0/24", "10.1.0.0/24"})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(codes(errs), jc.DeepEquals, []network.SubnetValidationCode{network.SubnetOverlapsExisting})
+	c.Check(errs[0].Message, gc.Equals, `subnet "10.0.0.0/16" overlaps existing subnet "10.0.3.0/24"`)
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDROverlapsHost(c *gc.C) {
+	errs, err := network.ValidateSubnetCIDR("192.168.0.0/16", "lxd", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(codes(errs), jc.DeepEquals, []network.SubnetValidationCode{network.SubnetOverlapsHost})
+	c.Check(errs[0].Message, gc.Equals, `subnet "192.168.0.0/16" overlaps host network "192.168.1.0/24"`)
+
+	// Only LXD checks the host networks.
+	errs, err = network.ValidateSubnetCIDR("192.168.0.0/16", "maas", nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(errs, gc.HasLen, 0)
+}
+
+func (s *subnetValidationSuite) TestValidateSubnetCIDRReservedRange(c *gc.C) {
+	for _, cidr := range []string{"127.0.0.0/24", "169.254.0.0/16", "224.0.0.0/24", "fe80::/64"} {
+		errs, err := network.ValidateSubnetCIDR(cidr, "maas", nil)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(codes(errs), jc.DeepEquals, []network.SubnetValidationCode{network.SubnetReservedRange}, gc.Commentf("cidr %q", cidr))
+	}
+}
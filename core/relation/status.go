@@ -17,6 +17,10 @@ const (
 	// Broken is the status for when a relation life goes to Dead.
 	Broken Status = "broken"
 
+	// Suspending is used to signify that a relation will be temporarily
+	// broken pending action to resume it.
+	Suspending Status = "suspending"
+
 	// Suspended is used to signify that a relation is temporarily broken pending
 	// action to resume it.
 	Suspended Status = "suspended"
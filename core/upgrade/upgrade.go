@@ -100,3 +100,47 @@ type Info struct {
 	// State holds the current state of the upgrade.
 	State State
 }
+
+// AgentState represents the progress of an agent through an agent binary
+// upgrade.
+type AgentState int
+
+const (
+	// AgentDownloading is the state of an agent while it downloads the
+	// target agent binary.
+	AgentDownloading AgentState = iota
+	// AgentStaging is the state of an agent while it unpacks and installs
+	// the downloaded agent binary.
+	AgentStaging
+	// AgentRestarting is the state of an agent while it restarts into the
+	// new agent binary.
+	AgentRestarting
+	// AgentUpgraded is the state of an agent once it is running the target
+	// agent binary.
+	AgentUpgraded
+)
+
+// AgentStates holds all the possible agent upgrade states.
+var AgentStates = map[AgentState]string{
+	AgentDownloading: "downloading",
+	AgentStaging:     "staging",
+	AgentRestarting:  "restarting",
+	AgentUpgraded:    "upgraded",
+}
+
+// ParseAgentState returns the agent upgrade state from a string.
+func ParseAgentState(str string) (AgentState, error) {
+	for state, s := range AgentStates {
+		if s == str {
+			return state, nil
+		}
+	}
+	return 0, errors.Errorf("unknown agent upgrade state %q", str)
+}
+
+func (s AgentState) String() string {
+	if str, ok := AgentStates[s]; ok {
+		return str
+	}
+	return "unknown"
+}
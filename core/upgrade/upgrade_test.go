@@ -143,3 +143,38 @@ func (s *upgradeSuite) TestTransitionToError(c *gc.C) {
 		c.Check(err, gc.IsNil)
 	}
 }
+
+func (s *upgradeSuite) TestParseAgentState(c *gc.C) {
+	tests := []struct {
+		str string
+		st  AgentState
+		err string
+	}{{
+		str: "",
+		err: `unknown agent upgrade state ""`,
+	}, {
+		str: "downloading",
+		st:  AgentDownloading,
+	}, {
+		str: "staging",
+		st:  AgentStaging,
+	}, {
+		str: "restarting",
+		st:  AgentRestarting,
+	}, {
+		str: "upgraded",
+		st:  AgentUpgraded,
+	}}
+	for i, test := range tests {
+		c.Logf("test %d: %q", i, test.str)
+
+		st, err := ParseAgentState(test.str)
+		if test.err != "" {
+			c.Check(err, gc.ErrorMatches, test.err)
+			continue
+		}
+		c.Check(err, jc.ErrorIsNil)
+		c.Check(st, gc.Equals, test.st)
+		c.Check(st.String(), gc.Equals, test.str)
+	}
+}
@@ -72,3 +72,26 @@ type SecretRevisionChannel = <-chan []SecretRevisionChange
 // SecretsRevisionWatcher represents a watcher that reports the latest
 // revision of a secret.
 type SecretsRevisionWatcher = Watcher[[]SecretRevisionChange]
+
+// SecretRevisionPendingDeletion describes an obsolete secret revision whose
+// content is stored in a secret backend and has not yet been deleted from it.
+type SecretRevisionPendingDeletion struct {
+	RevisionUUID string
+	URI          *secrets.URI
+	Revision     int
+	ValueRef     secrets.ValueRef
+}
+
+// String returns the UUID of the revision, which identifies the change.
+func (s SecretRevisionPendingDeletion) String() string {
+	return s.RevisionUUID
+}
+
+// GoString returns a Go-syntax representation of the change.
+func (s SecretRevisionPendingDeletion) GoString() string {
+	return fmt.Sprintf("%s/%d in backend %s", s.URI.ID, s.Revision, s.ValueRef.BackendID)
+}
+
+// SecretRevisionWatcher represents a watcher that reports obsolete secret
+// revisions whose backend content is pending deletion.
+type SecretRevisionWatcher = Watcher[[]SecretRevisionPendingDeletion]
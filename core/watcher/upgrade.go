@@ -0,0 +1,23 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package watcher
+
+import (
+	"github.com/juju/version/v2"
+
+	"github.com/juju/juju/core/upgrade"
+)
+
+// ModelUpgradeStateEvent describes the latest upgrade progress of an agent
+// in a model.
+type ModelUpgradeStateEvent struct {
+	AgentName      string
+	CurrentVersion version.Number
+	TargetVersion  version.Number
+	State          upgrade.AgentState
+}
+
+// ModelUpgradeStateWatcher represents a watcher that reports the agents in
+// a model whose upgrade progress has changed.
+type ModelUpgradeStateWatcher = Watcher[[]ModelUpgradeStateEvent]
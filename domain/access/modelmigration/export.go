@@ -66,6 +66,7 @@ func (e *exportOperation) Name() string {
 func (e *exportOperation) Setup(scope modelmigration.Scope) error {
 	e.service = service.NewService(
 		state.NewState(scope.ControllerDB(), e.logger),
+		e.logger,
 	)
 	return nil
 }
@@ -67,7 +67,7 @@ func (i *importOperation) Name() string {
 // Setup implements Operation.
 func (i *importOperation) Setup(scope modelmigration.Scope) error {
 	i.service = service.NewService(
-		state.NewState(scope.ControllerDB(), i.logger))
+		state.NewState(scope.ControllerDB(), i.logger), i.logger)
 	return nil
 }
 
@@ -5,10 +5,12 @@ package service
 
 import (
 	"context"
+	"sort"
 
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/credential"
+	"github.com/juju/juju/core/logger"
 	corepermission "github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain/access"
@@ -19,13 +21,17 @@ import (
 // PermissionService provides the API for working with permissions.
 type PermissionService struct {
 	st PermissionState
+
+	// securityLogger records changes to the access users have.
+	securityLogger logger.Logger
 }
 
 // NewPermissionService returns a new PermissionService for interacting with the underlying
 // permission state.
-func NewPermissionService(st PermissionState) *PermissionService {
+func NewPermissionService(st PermissionState, log logger.Logger) *PermissionService {
 	return &PermissionService{
-		st: st,
+		st:             st,
+		securityLogger: log.Child("security", logger.SECURITY),
 	}
 }
 
@@ -160,6 +166,69 @@ func (s *PermissionService) UpdatePermission(ctx context.Context, args access.Up
 	return errors.Trace(s.st.UpdatePermission(ctx, args))
 }
 
+// BulkGrantModelAccess grants each of the given model access grants, for
+// managing access of many users at once. All grants are made in a single
+// transaction, but a grant that cannot be applied does not prevent the others
+// from being made. The grants which were not applied are returned, in the
+// order given, with the reason:
+//   - an error satisfying [errors.NotValid] if the grant has no subject, an
+//     invalid model UUID, or an access level which is not valid for models.
+//   - [accesserrors.UserNotFound] if a local user does not exist.
+//   - [accesserrors.PermissionTargetInvalid] if the model does not exist.
+//   - [accesserrors.PermissionAccessGreater] if the user already has the same
+//     or greater access to the model.
+//
+// Each grant made is recorded in the security log.
+func (s *PermissionService) BulkGrantModelAccess(ctx context.Context, grants []access.ModelAccessGrant) ([]access.ModelAccessError, error) {
+	var (
+		failed []access.ModelAccessError
+		valid  []access.ModelAccessGrant
+		// indexes maps the position of a valid grant to its position in
+		// grants.
+		indexes []int
+	)
+	for i, grant := range grants {
+		if err := grant.Validate(); err != nil {
+			failed = append(failed, access.ModelAccessError{
+				Index: i,
+				Grant: grant,
+				Error: errors.Trace(err),
+			})
+			continue
+		}
+		valid = append(valid, grant)
+		indexes = append(indexes, i)
+	}
+	if len(valid) == 0 {
+		return failed, nil
+	}
+
+	stateFailed, err := s.st.BulkGrantModelAccess(ctx, valid)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	granted := make([]bool, len(valid))
+	for i := range granted {
+		granted[i] = true
+	}
+	for _, f := range stateFailed {
+		granted[f.Index] = false
+		f.Index = indexes[f.Index]
+		failed = append(failed, f)
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].Index < failed[j].Index
+	})
+
+	for i, grant := range valid {
+		if granted[i] {
+			s.securityLogger.Infof("granted %q access on model %q to %q", grant.Access, grant.ModelUUID, grant.Subject)
+		}
+	}
+	return failed, nil
+}
+
 // AllModelAccessForCloudCredential for a given (cloud) credential key, return all
 // model name and model access level combinations.
 func (s *PermissionService) AllModelAccessForCloudCredential(ctx context.Context, key credential.Key) ([]access.CredentialOwnerModelAccess, error) {
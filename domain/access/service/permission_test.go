@@ -13,9 +13,12 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/credential"
+	modeltesting "github.com/juju/juju/core/model/testing"
 	corepermission "github.com/juju/juju/core/permission"
 	usertesting "github.com/juju/juju/core/user/testing"
 	"github.com/juju/juju/domain/access"
+	accesserrors "github.com/juju/juju/domain/access/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -47,7 +50,7 @@ func (s *serviceSuite) TestCreatePermission(c *gc.C) {
 			Access: corepermission.AddModelAccess,
 		},
 	}
-	_, err := NewService(s.state).CreatePermission(context.Background(), spec)
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).CreatePermission(context.Background(), spec)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -64,14 +67,14 @@ func (s *serviceSuite) TestCreatePermissionError(c *gc.C) {
 			Access: corepermission.ReadAccess,
 		},
 	}
-	_, err := NewService(s.state).CreatePermission(context.Background(), spec)
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).CreatePermission(context.Background(), spec)
 	c.Assert(err, jc.ErrorIs, errors.NotValid)
 }
 
 func (s *serviceSuite) TestDeletePermission(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().DeletePermission(gomock.Any(), usertesting.GenNewName(c, "testme"), gomock.AssignableToTypeOf(corepermission.ID{})).Return(nil)
-	err := NewService(s.state).DeletePermission(context.Background(), usertesting.GenNewName(c, "testme"), corepermission.ID{
+	err := NewService(s.state, loggertesting.WrapCheckLog(c)).DeletePermission(context.Background(), usertesting.GenNewName(c, "testme"), corepermission.ID{
 		ObjectType: corepermission.Cloud,
 		Key:        "aws",
 	})
@@ -81,7 +84,7 @@ func (s *serviceSuite) TestDeletePermission(c *gc.C) {
 func (s *serviceSuite) TestDeletePermissionError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	err := NewService(s.state).DeletePermission(context.Background(), usertesting.GenNewName(c, "testme"), corepermission.ID{
+	err := NewService(s.state, loggertesting.WrapCheckLog(c)).DeletePermission(context.Background(), usertesting.GenNewName(c, "testme"), corepermission.ID{
 		ObjectType: "faileme",
 		Key:        "aws",
 	})
@@ -92,7 +95,7 @@ func (s *serviceSuite) TestUpsertPermission(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().UpdatePermission(gomock.Any(), gomock.AssignableToTypeOf(access.UpdatePermissionArgs{})).Return(nil)
 
-	err := NewService(s.state).UpdatePermission(
+	err := NewService(s.state, loggertesting.WrapCheckLog(c)).UpdatePermission(
 		context.Background(),
 		access.UpdatePermissionArgs{
 			AccessSpec: corepermission.AccessSpec{
@@ -109,11 +112,57 @@ func (s *serviceSuite) TestUpsertPermission(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestBulkGrantModelAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	grants := []access.ModelAccessGrant{
+		{Subject: usertesting.GenNewName(c, "bob"), ModelUUID: modelUUID, Access: corepermission.ReadAccess},
+		{Subject: usertesting.GenNewName(c, "sue"), ModelUUID: modelUUID, Access: corepermission.SuperuserAccess},
+		{Subject: usertesting.GenNewName(c, "ghost"), ModelUUID: modelUUID, Access: corepermission.WriteAccess},
+		{Subject: usertesting.GenNewName(c, "tom"), ModelUUID: "", Access: corepermission.WriteAccess},
+		{Subject: usertesting.GenNewName(c, "jim"), ModelUUID: modelUUID, Access: corepermission.AdminAccess},
+	}
+
+	// Only the valid grants are passed to state, which reports failures by
+	// their position in what it was given.
+	s.state.EXPECT().BulkGrantModelAccess(gomock.Any(), []access.ModelAccessGrant{
+		grants[0], grants[2], grants[4],
+	}).Return([]access.ModelAccessError{{
+		Index: 1,
+		Grant: grants[2],
+		Error: accesserrors.UserNotFound,
+	}}, nil)
+
+	failed, err := NewService(s.state, loggertesting.WrapCheckLog(c)).BulkGrantModelAccess(context.Background(), grants)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(failed, gc.HasLen, 3)
+	c.Check(failed[0].Index, gc.Equals, 1)
+	c.Check(failed[0].Error, jc.ErrorIs, errors.NotValid)
+	c.Check(failed[1].Index, gc.Equals, 2)
+	c.Check(failed[1].Grant, jc.DeepEquals, grants[2])
+	c.Check(failed[1].Error, jc.ErrorIs, accesserrors.UserNotFound)
+	c.Check(failed[2].Index, gc.Equals, 3)
+	c.Check(failed[2].Error, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestBulkGrantModelAccessAllNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	failed, err := NewService(s.state, loggertesting.WrapCheckLog(c)).BulkGrantModelAccess(context.Background(), []access.ModelAccessGrant{{
+		ModelUUID: modeltesting.GenModelUUID(c),
+		Access:    corepermission.ReadAccess,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(failed, gc.HasLen, 1)
+	c.Check(failed[0].Error, jc.ErrorIs, errors.NotValid)
+}
+
 func (s *serviceSuite) TestReadUserAccessForTarget(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadUserAccessForTarget(gomock.Any(), usertesting.GenNewName(c, "testme"), gomock.AssignableToTypeOf(corepermission.ID{})).Return(corepermission.UserAccess{}, nil)
 
-	_, err := NewService(s.state).ReadUserAccessForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadUserAccessForTarget(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		corepermission.ID{
@@ -126,7 +175,7 @@ func (s *serviceSuite) TestReadUserAccessForTarget(c *gc.C) {
 func (s *serviceSuite) TestReadUserAccessForTargetError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	_, err := NewService(s.state).ReadUserAccessForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadUserAccessForTarget(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		corepermission.ID{
@@ -140,7 +189,7 @@ func (s *serviceSuite) TestReadUserAccessLevelForTarget(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadUserAccessLevelForTarget(gomock.Any(), usertesting.GenNewName(c, "testme"), gomock.AssignableToTypeOf(corepermission.ID{})).Return(corepermission.NoAccess, nil)
 
-	_, err := NewService(s.state).ReadUserAccessLevelForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadUserAccessLevelForTarget(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		corepermission.ID{
@@ -153,7 +202,7 @@ func (s *serviceSuite) TestReadUserAccessLevelForTarget(c *gc.C) {
 func (s *serviceSuite) TestReadUserAccessLevelForTargetError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	_, err := NewService(s.state).ReadUserAccessForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadUserAccessForTarget(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		corepermission.ID{
@@ -167,7 +216,7 @@ func (s *serviceSuite) TestReadAllUserAccessForTarget(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadAllUserAccessForTarget(gomock.Any(), gomock.AssignableToTypeOf(corepermission.ID{})).Return(nil, nil)
 
-	_, err := NewService(s.state).ReadAllUserAccessForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadAllUserAccessForTarget(
 		context.Background(),
 		corepermission.ID{
 			ObjectType: corepermission.Cloud,
@@ -179,7 +228,7 @@ func (s *serviceSuite) TestReadAllUserAccessForTarget(c *gc.C) {
 func (s *serviceSuite) TestReadAllUserAccessForTargetError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	_, err := NewService(s.state).ReadAllUserAccessForTarget(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadAllUserAccessForTarget(
 		context.Background(),
 		corepermission.ID{
 			ObjectType: "faileme",
@@ -192,7 +241,7 @@ func (s *serviceSuite) TestReadAllUserAccessForUser(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadAllUserAccessForUser(gomock.Any(), usertesting.GenNewName(c, "testme")).Return(nil, nil)
 
-	_, err := NewService(s.state).ReadAllUserAccessForUser(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadAllUserAccessForUser(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"))
 	c.Assert(err, jc.ErrorIsNil)
@@ -202,7 +251,7 @@ func (s *serviceSuite) TestReadAllAccessForUserAndObjectType(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().ReadAllAccessForUserAndObjectType(gomock.Any(), usertesting.GenNewName(c, "testme"), corepermission.Cloud).Return(nil, nil)
 
-	_, err := NewService(s.state).ReadAllAccessForUserAndObjectType(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadAllAccessForUserAndObjectType(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		corepermission.Cloud)
@@ -212,7 +261,7 @@ func (s *serviceSuite) TestReadAllAccessForUserAndObjectType(c *gc.C) {
 func (s *serviceSuite) TestReadAllAccessForUserAndObjectTypeError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	_, err := NewService(s.state).ReadAllAccessForUserAndObjectType(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).ReadAllAccessForUserAndObjectType(
 		context.Background(),
 		usertesting.GenNewName(c, "testme"),
 		"failme")
@@ -223,7 +272,7 @@ func (s *serviceSuite) TestAllModelAccessForCloudCredential(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	s.state.EXPECT().AllModelAccessForCloudCredential(gomock.Any(), gomock.AssignableToTypeOf(credential.Key{})).Return(nil, nil)
 
-	_, err := NewService(s.state).AllModelAccessForCloudCredential(
+	_, err := NewService(s.state, loggertesting.WrapCheckLog(c)).AllModelAccessForCloudCredential(
 		context.Background(),
 		credential.Key{})
 	c.Assert(err, jc.ErrorIsNil)
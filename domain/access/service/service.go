@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/juju/juju/core/credential"
+	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
@@ -155,6 +156,10 @@ type PermissionState interface {
 	// subject and api user. Access can be granted or revoked.
 	UpdatePermission(ctx context.Context, args access.UpdatePermissionArgs) error
 
+	// BulkGrantModelAccess grants each of the given model access grants in a
+	// single transaction, returning the grants which could not be applied.
+	BulkGrantModelAccess(ctx context.Context, grants []access.ModelAccessGrant) ([]access.ModelAccessError, error)
+
 	// ReadUserAccessForTarget returns the subject's (user) access for the
 	// given user on the given target.
 	ReadUserAccessForTarget(ctx context.Context, subject user.Name, target permission.ID) (permission.UserAccess, error)
@@ -197,9 +202,9 @@ type Service struct {
 
 // NewService returns a new Service for interacting with the underlying access
 // state.
-func NewService(st State) *Service {
+func NewService(st State, logger logger.Logger) *Service {
 	return &Service{
 		UserService:       NewUserService(st),
-		PermissionService: NewPermissionService(st),
+		PermissionService: NewPermissionService(st, logger),
 	}
 }
@@ -200,6 +200,45 @@ func (c *MockStateAllModelAccessForCloudCredentialCall) DoAndReturn(f func(conte
 	return c
 }
 
+// BulkGrantModelAccess mocks base method.
+func (m *MockState) BulkGrantModelAccess(arg0 context.Context, arg1 []access.ModelAccessGrant) ([]access.ModelAccessError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkGrantModelAccess", arg0, arg1)
+	ret0, _ := ret[0].([]access.ModelAccessError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkGrantModelAccess indicates an expected call of BulkGrantModelAccess.
+func (mr *MockStateMockRecorder) BulkGrantModelAccess(arg0, arg1 any) *MockStateBulkGrantModelAccessCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkGrantModelAccess", reflect.TypeOf((*MockState)(nil).BulkGrantModelAccess), arg0, arg1)
+	return &MockStateBulkGrantModelAccessCall{Call: call}
+}
+
+// MockStateBulkGrantModelAccessCall wrap *gomock.Call
+type MockStateBulkGrantModelAccessCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateBulkGrantModelAccessCall) Return(arg0 []access.ModelAccessError, arg1 error) *MockStateBulkGrantModelAccessCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateBulkGrantModelAccessCall) Do(f func(context.Context, []access.ModelAccessGrant) ([]access.ModelAccessError, error)) *MockStateBulkGrantModelAccessCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateBulkGrantModelAccessCall) DoAndReturn(f func(context.Context, []access.ModelAccessGrant) ([]access.ModelAccessError, error)) *MockStateBulkGrantModelAccessCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // CreatePermission mocks base method.
 func (m *MockState) CreatePermission(arg0 context.Context, arg1 uuid.UUID, arg2 permission.UserAccessSpec) (permission.UserAccess, error) {
 	m.ctrl.T.Helper()
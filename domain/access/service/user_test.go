@@ -25,6 +25,7 @@ import (
 	usererrors "github.com/juju/juju/domain/access/errors"
 	usertesting "github.com/juju/juju/domain/access/testing"
 	"github.com/juju/juju/internal/auth"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 	jujutesting "github.com/juju/juju/internal/testing"
 )
 
@@ -40,22 +41,22 @@ func (s *userServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	return ctrl
 }
 
-func (s *userServiceSuite) service() *Service {
-	return NewService(s.state)
+func (s *userServiceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, loggertesting.WrapCheckLog(c))
 }
 
 // TestAddUserNameNotValid is testing that if we try and add a user with a
 // username that is not valid we get an error that satisfies
 // usererrors.UserNameNotValid back.
 func (s *userServiceSuite) TestAddUserNameNotValid(c *gc.C) {
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{Name: user.Name{}})
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{Name: user.Name{}})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
 // TestAddUserExternalUser is testing that if we try and add an external user we
 // get an error.
 func (s *userServiceSuite) TestAddUserExternalUser(c *gc.C) {
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{Name: coreusertesting.GenNewName(c, "alastair@external")})
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{Name: coreusertesting.GenNewName(c, "alastair@external")})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -70,7 +71,7 @@ func (s *userServiceSuite) TestAddUserAlreadyExists(c *gc.C) {
 	a := gomock.Any()
 	s.state.EXPECT().AddUserWithActivationKey(a, stringerNotEmpty{}, a, a, a, a, a).Return(usererrors.UserAlreadyExists)
 
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{
 		Name:        coreusertesting.GenNewName(c, "valid"),
 		CreatorUUID: newUUID(c),
 		Permission: permission.AccessSpec{
@@ -92,7 +93,7 @@ func (s *userServiceSuite) TestAddUserCreatorUUIDNotFound(c *gc.C) {
 	a := gomock.Any()
 	s.state.EXPECT().AddUserWithActivationKey(a, stringerNotEmpty{}, a, a, a, a, a).Return(usererrors.UserCreatorUUIDNotFound)
 
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{
 		Name:        coreusertesting.GenNewName(c, "valid"),
 		CreatorUUID: newUUID(c),
 		Permission: permission.AccessSpec{
@@ -127,7 +128,7 @@ func (s *userServiceSuite) TestAddUserWithPassword(c *gc.C) {
 
 	pass := auth.NewPassword("password")
 
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{
 		UUID:        userUUID,
 		Name:        name,
 		DisplayName: "display",
@@ -150,7 +151,7 @@ func (s *userServiceSuite) TestAddUserWithPasswordNotValid(c *gc.C) {
 	userUUID := newUUID(c)
 	creatorUUID := newUUID(c)
 
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{
 		UUID:        userUUID,
 		Name:        coreusertesting.GenNewName(c, "valid"),
 		DisplayName: "display",
@@ -176,7 +177,7 @@ func (s *userServiceSuite) TestAddUserWithPermissionInvalid(c *gc.C) {
 
 	pass := auth.NewPassword("password")
 
-	_, _, err := s.service().AddUser(context.Background(), AddUserArg{
+	_, _, err := s.service(c).AddUser(context.Background(), AddUserArg{
 		UUID:        userUUID,
 		Name:        coreusertesting.GenNewName(c, "valid"),
 		DisplayName: "display",
@@ -199,7 +200,7 @@ func (s *userServiceSuite) TestAddExternalUser(c *gc.C) {
 		creatorUUID,
 	)
 
-	err := s.service().AddExternalUser(
+	err := s.service(c).AddExternalUser(
 		context.Background(),
 		name,
 		name.Name(),
@@ -211,7 +212,7 @@ func (s *userServiceSuite) TestAddExternalUser(c *gc.C) {
 func (s *userServiceSuite) TestAddExternalUserLocal(c *gc.C) {
 	creatorUUID := newUUID(c)
 	name := coreusertesting.GenNewName(c, "fred")
-	err := s.service().AddExternalUser(context.Background(), name, name.Name(), creatorUUID)
+	err := s.service(c).AddExternalUser(context.Background(), name, name.Name(), creatorUUID)
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -221,14 +222,14 @@ func (s *userServiceSuite) TestRemoveUser(c *gc.C) {
 
 	s.state.EXPECT().RemoveUser(gomock.Any(), coreusertesting.GenNewName(c, "user")).Return(nil)
 
-	err := s.service().RemoveUser(context.Background(), coreusertesting.GenNewName(c, "user"))
+	err := s.service(c).RemoveUser(context.Background(), coreusertesting.GenNewName(c, "user"))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
 // TestRemoveUserInvalidUsername is testing that if we supply RemoveUser with
 // invalid usernames we get back an error.
 func (s *userServiceSuite) TestRemoveUserInvalidUsername(c *gc.C) {
-	err := s.service().RemoveUser(context.Background(), user.Name{})
+	err := s.service(c).RemoveUser(context.Background(), user.Name{})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -240,7 +241,7 @@ func (s *userServiceSuite) TestRemoveUserNotFound(c *gc.C) {
 
 	s.state.EXPECT().RemoveUser(gomock.Any(), coreusertesting.GenNewName(c, "missing")).Return(usererrors.UserNotFound)
 
-	err := s.service().RemoveUser(context.Background(), coreusertesting.GenNewName(c, "missing"))
+	err := s.service(c).RemoveUser(context.Background(), coreusertesting.GenNewName(c, "missing"))
 	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
 }
 
@@ -251,14 +252,14 @@ func (s *userServiceSuite) TestSetPassword(c *gc.C) {
 	a := gomock.Any()
 	s.state.EXPECT().SetPasswordHash(a, a, a, a).Return(nil)
 
-	err := s.service().SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
+	err := s.service(c).SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
 // TestSetPasswordInvalidUsername is testing that if we supply SetPassword with
 // invalid usernames we get back an error.
 func (s *userServiceSuite) TestSetPasswordInvalidUsername(c *gc.C) {
-	err := s.service().SetPassword(context.Background(), user.Name{}, auth.NewPassword("password"))
+	err := s.service(c).SetPassword(context.Background(), user.Name{}, auth.NewPassword("password"))
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -270,14 +271,14 @@ func (s *userServiceSuite) TestSetPasswordUserNotFound(c *gc.C) {
 	a := gomock.Any()
 	s.state.EXPECT().SetPasswordHash(a, a, a, a).Return(usererrors.UserNotFound)
 
-	err := s.service().SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
+	err := s.service(c).SetPassword(context.Background(), coreusertesting.GenNewName(c, "user"), auth.NewPassword("password"))
 	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
 }
 
 // TestSetPasswordInvalid is asserting that if pass invalid passwords to
 // SetPassword the correct errors are returned.
 func (s *userServiceSuite) TestSetPasswordInvalid(c *gc.C) {
-	err := s.service().SetPassword(context.Background(), coreusertesting.GenNewName(c, "username"), auth.NewPassword(""))
+	err := s.service(c).SetPassword(context.Background(), coreusertesting.GenNewName(c, "username"), auth.NewPassword(""))
 	c.Assert(err, jc.ErrorIs, auth.ErrPasswordNotValid)
 }
 
@@ -287,7 +288,7 @@ func (s *userServiceSuite) TestResetPassword(c *gc.C) {
 
 	s.state.EXPECT().SetActivationKey(gomock.Any(), coreusertesting.GenNewName(c, "name"), gomock.Any()).Return(nil)
 
-	key, err := s.service().ResetPassword(context.Background(), coreusertesting.GenNewName(c, "name"))
+	key, err := s.service(c).ResetPassword(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(key, gc.Not(gc.Equals), "")
 }
@@ -295,7 +296,7 @@ func (s *userServiceSuite) TestResetPassword(c *gc.C) {
 // TestResetPasswordInvalidUsername is testing that if we supply ResetPassword
 // with invalid usernames we get back an error.
 func (s *userServiceSuite) TestResetPasswordInvalidUsername(c *gc.C) {
-	_, err := s.service().ResetPassword(context.Background(), user.Name{})
+	_, err := s.service(c).ResetPassword(context.Background(), user.Name{})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -306,7 +307,7 @@ func (s *userServiceSuite) TestResetPasswordUserNotFound(c *gc.C) {
 
 	s.state.EXPECT().SetActivationKey(gomock.Any(), coreusertesting.GenNewName(c, "name"), gomock.Any()).Return(usererrors.UserNotFound)
 
-	_, err := s.service().ResetPassword(context.Background(), coreusertesting.GenNewName(c, "name"))
+	_, err := s.service(c).ResetPassword(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
 }
 
@@ -319,7 +320,7 @@ func (s *userServiceSuite) TestGetUserNotFound(c *gc.C) {
 	uuid := newUUID(c)
 	s.state.EXPECT().GetUser(gomock.Any(), uuid).Return(user.User{}, usererrors.UserNotFound)
 
-	_, err := s.service().GetUser(context.Background(), uuid)
+	_, err := s.service(c).GetUser(context.Background(), uuid)
 	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
 }
 
@@ -335,7 +336,7 @@ func (s *userServiceSuite) TestGetUser(c *gc.C) {
 		Name: coreusertesting.GenNewName(c, "user"),
 	}, nil)
 
-	u, err := s.service().GetUser(context.Background(), uuid)
+	u, err := s.service(c).GetUser(context.Background(), uuid)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(u.Name, gc.Equals, coreusertesting.GenNewName(c, "user"))
 }
@@ -350,7 +351,7 @@ func (s *userServiceSuite) TestGetUserByName(c *gc.C) {
 		Name: coreusertesting.GenNewName(c, "user"),
 	}, nil)
 
-	u, err := s.service().GetUserByName(context.Background(), coreusertesting.GenNewName(c, "name"))
+	u, err := s.service(c).GetUserByName(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(u.UUID, gc.Equals, uuid)
 }
@@ -362,7 +363,7 @@ func (s *userServiceSuite) TestGetUserByNameNotFound(c *gc.C) {
 
 	s.state.EXPECT().GetUserByName(gomock.Any(), coreusertesting.GenNewName(c, "user")).Return(user.User{}, usererrors.UserNotFound)
 
-	_, err := s.service().GetUserByName(context.Background(), coreusertesting.GenNewName(c, "user"))
+	_, err := s.service(c).GetUserByName(context.Background(), coreusertesting.GenNewName(c, "user"))
 	c.Assert(err, jc.ErrorIs, usererrors.UserNotFound)
 }
 
@@ -381,7 +382,7 @@ func (s *userServiceSuite) TestGetAllUsers(c *gc.C) {
 		},
 	}, nil)
 
-	users, err := s.service().GetAllUsers(context.Background(), true)
+	users, err := s.service(c).GetAllUsers(context.Background(), true)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(users, gc.HasLen, 2)
 	c.Check(users[0].Name, gc.Equals, coreusertesting.GenNewName(c, "user0"))
@@ -393,7 +394,7 @@ func (s *userServiceSuite) TestGetAllUsers(c *gc.C) {
 // here that the service doesn't let invalid usernames flow through to the state
 // layer.
 func (s *userServiceSuite) TestGetUserByNameInvalidUsername(c *gc.C) {
-	_, err := s.service().GetUserByName(context.Background(), user.Name{})
+	_, err := s.service(c).GetUserByName(context.Background(), user.Name{})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -407,7 +408,7 @@ func (s *userServiceSuite) TestGetUserByAuth(c *gc.C) {
 		Name: coreusertesting.GenNewName(c, "user"),
 	}, nil)
 
-	u, err := s.service().GetUserByAuth(context.Background(), coreusertesting.GenNewName(c, "name"), auth.NewPassword("pass"))
+	u, err := s.service(c).GetUserByAuth(context.Background(), coreusertesting.GenNewName(c, "name"), auth.NewPassword("pass"))
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(u.UUID, gc.Equals, uuid)
 }
@@ -418,7 +419,7 @@ func (s *userServiceSuite) TestEnableUserAuthentication(c *gc.C) {
 
 	s.state.EXPECT().EnableUserAuthentication(gomock.Any(), coreusertesting.GenNewName(c, "name"))
 
-	err := s.service().EnableUserAuthentication(context.Background(), coreusertesting.GenNewName(c, "name"))
+	err := s.service(c).EnableUserAuthentication(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -428,7 +429,7 @@ func (s *userServiceSuite) TestDisableUserAuthentication(c *gc.C) {
 
 	s.state.EXPECT().DisableUserAuthentication(gomock.Any(), coreusertesting.GenNewName(c, "name"))
 
-	err := s.service().DisableUserAuthentication(context.Background(), coreusertesting.GenNewName(c, "name"))
+	err := s.service(c).DisableUserAuthentication(context.Background(), coreusertesting.GenNewName(c, "name"))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -463,7 +464,7 @@ func (s *userServiceSuite) TestSetPasswordWithActivationKey(c *gc.C) {
 
 	box := s.sealBox(key, nonce, payloadBytes)
 
-	_, err = s.service().SetPasswordWithActivationKey(context.Background(), coreusertesting.GenNewName(c, "name"), nonce, box)
+	_, err = s.service(c).SetPasswordWithActivationKey(context.Background(), coreusertesting.GenNewName(c, "name"), nonce, box)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -501,7 +502,7 @@ func (s *userServiceSuite) TestSetPasswordWithActivationKeyWithInvalidKey(c *gc.
 	_, err = rand.Read(nonce)
 	c.Assert(err, jc.ErrorIsNil)
 
-	_, err = s.service().SetPasswordWithActivationKey(context.Background(), coreusertesting.GenNewName(c, "name"), nonce, box)
+	_, err = s.service(c).SetPasswordWithActivationKey(context.Background(), coreusertesting.GenNewName(c, "name"), nonce, box)
 	c.Assert(err, jc.ErrorIs, usererrors.ActivationKeyNotValid)
 }
 
@@ -540,7 +541,7 @@ func FuzzGetUser(f *testing.F) {
 			nil,
 		).AnyTimes()
 
-		usr, err := NewService(state).GetUserByName(context.Background(), name)
+		usr, err := NewService(state, loggertesting.WrapCheckLog(t)).GetUserByName(context.Background(), name)
 		if err != nil {
 			t.Errorf("unexpected error %v when fuzzing GetUser with %q",
 				err, username,
@@ -559,7 +560,7 @@ func (s *userServiceSuite) TestUpdateLastModelLogin(c *gc.C) {
 	modelUUID := modeltesting.GenModelUUID(c)
 	s.state.EXPECT().UpdateLastModelLogin(gomock.Any(), coreusertesting.GenNewName(c, "name"), modelUUID, gomock.Any())
 
-	err := s.service().UpdateLastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID)
+	err := s.service(c).UpdateLastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -567,7 +568,7 @@ func (s *userServiceSuite) TestUpdateLastModelLogin(c *gc.C) {
 func (s *userServiceSuite) TestUpdateLastModelLoginBadUsername(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	modelUUID := modeltesting.GenModelUUID(c)
-	err := s.service().UpdateLastModelLogin(context.Background(), user.Name{}, modelUUID)
+	err := s.service(c).UpdateLastModelLogin(context.Background(), user.Name{}, modelUUID)
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -578,7 +579,7 @@ func (s *userServiceSuite) TestSetLastModelLogin(c *gc.C) {
 	lastLogin := time.Now()
 	s.state.EXPECT().UpdateLastModelLogin(gomock.Any(), coreusertesting.GenNewName(c, "name"), modelUUID, lastLogin)
 
-	err := s.service().SetLastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID, lastLogin)
+	err := s.service(c).SetLastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID, lastLogin)
 	c.Assert(err, jc.ErrorIsNil)
 }
 
@@ -586,7 +587,7 @@ func (s *userServiceSuite) TestSetLastModelLogin(c *gc.C) {
 func (s *userServiceSuite) TestSetLastModelLoginBadUsername(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 	modelUUID := modeltesting.GenModelUUID(c)
-	err := s.service().SetLastModelLogin(context.Background(), user.Name{}, modelUUID, time.Time{})
+	err := s.service(c).SetLastModelLogin(context.Background(), user.Name{}, modelUUID, time.Time{})
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
@@ -597,7 +598,7 @@ func (s *userServiceSuite) TestLastModelLogin(c *gc.C) {
 	t := time.Now()
 	s.state.EXPECT().LastModelLogin(gomock.Any(), coreusertesting.GenNewName(c, "name"), modelUUID).Return(t, nil)
 
-	lastConnection, err := s.service().LastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID)
+	lastConnection, err := s.service(c).LastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), modelUUID)
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(lastConnection, gc.Equals, t)
 }
@@ -605,14 +606,14 @@ func (s *userServiceSuite) TestLastModelLogin(c *gc.C) {
 // TestLastModelLoginBadUUID tests a bad UUID given to LastModelLogin.
 func (s *userServiceSuite) TestLastModelLoginBadUUID(c *gc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := s.service().LastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), "bad-uuid")
+	_, err := s.service(c).LastModelLogin(context.Background(), coreusertesting.GenNewName(c, "name"), "bad-uuid")
 	c.Assert(err, jc.ErrorIs, jujuerrors.NotValid)
 }
 
 // TestLastModelLoginBadUsername tests a bad username for LastModelLogin.
 func (s *userServiceSuite) TestLastModelLoginBadUsername(c *gc.C) {
 	defer s.setupMocks(c).Finish()
-	_, err := s.service().LastModelLogin(context.Background(), user.Name{}, "")
+	_, err := s.service(c).LastModelLogin(context.Background(), user.Name{}, "")
 	c.Assert(err, jc.ErrorIs, usererrors.UserNameNotValid)
 }
 
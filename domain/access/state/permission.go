@@ -177,6 +177,171 @@ func (st *PermissionState) UpdatePermission(ctx context.Context, args access.Upd
 	return nil
 }
 
+// BulkGrantModelAccess grants each of the given model access grants in a
+// single transaction. Grants which cannot be applied are returned, so that
+// the remaining grants are still made. New permissions are added with a
+// single insert. A grant is not applied if:
+//   - the user is local and does not exist, or is disabled
+//     ([accesserrors.UserNotFound]).
+//   - the model does not exist ([accesserrors.PermissionTargetInvalid]).
+//   - the user already has the same or greater access to the model, including
+//     from an earlier grant in the same call
+//     ([accesserrors.PermissionAccessGreater]).
+func (st *PermissionState) BulkGrantModelAccess(ctx context.Context, grants []access.ModelAccessGrant) ([]access.ModelAccessError, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	accessTypesStmt, err := st.Prepare(`
+SELECT &dbPermissionType.*
+FROM   permission_access_type
+`, dbPermissionType{})
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing select access types statement")
+	}
+
+	modelType := dbPermissionType{Type: string(corepermission.Model)}
+	objectTypeStmt, err := st.Prepare(`
+SELECT &dbPermissionType.id
+FROM   permission_object_type
+WHERE  type = $dbPermissionType.type
+`, modelType)
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing select object type statement")
+	}
+
+	currentStmt, err := st.Prepare(`
+SELECT &dbPermission.access_type
+FROM   v_permission
+WHERE  grant_to = $dbPermission.grant_to
+AND    grant_on = $dbPermission.grant_on
+`, dbPermission{})
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing select current access statement")
+	}
+
+	insertStmt, err := st.Prepare(`
+INSERT INTO permission (*) VALUES ($dbPermissionRow.*)
+`, dbPermissionRow{})
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing insert permissions statement")
+	}
+
+	var failed []access.ModelAccessError
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		failed = nil
+
+		var accessTypes []dbPermissionType
+		if err := tx.Query(ctx, accessTypesStmt).GetAll(&accessTypes); err != nil {
+			return errors.Annotate(err, "getting access types")
+		}
+		accessTypeIDs := make(map[corepermission.Access]int, len(accessTypes))
+		accessTypeNames := make(map[int]corepermission.Access, len(accessTypes))
+		for _, t := range accessTypes {
+			accessTypeIDs[corepermission.Access(t.Type)] = t.ID
+			accessTypeNames[t.ID] = corepermission.Access(t.Type)
+		}
+
+		objectType := modelType
+		if err := tx.Query(ctx, objectTypeStmt, modelType).Get(&objectType); err != nil {
+			return errors.Annotate(err, "getting model object type")
+		}
+
+		// New permissions are keyed on the user and model, so that a user
+		// granted access to the same model twice gets the greater access.
+		var (
+			rows    []dbPermissionRow
+			pending = make(map[[2]string]int)
+		)
+		for i, grant := range grants {
+			fail := func(err error) {
+				failed = append(failed, access.ModelAccessError{
+					Index: i,
+					Grant: grant,
+					Error: err,
+				})
+			}
+
+			subjectUUID, err := st.userUUID(ctx, tx, grant.Subject)
+			if errors.Is(err, accesserrors.UserNotFound) && !grant.Subject.IsLocal() {
+				subjectUUID, err = st.addExternalUser(ctx, tx, grant.Subject)
+			}
+			if errors.Is(err, accesserrors.UserNotFound) {
+				fail(err)
+				continue
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+
+			target := corepermission.ID{
+				ObjectType: corepermission.Model,
+				Key:        grant.ModelUUID.String(),
+			}
+			err = targetExists(ctx, tx, target)
+			if errors.Is(err, accesserrors.PermissionTargetInvalid) {
+				fail(err)
+				continue
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+
+			key := [2]string{subjectUUID.String(), target.Key}
+			if idx, ok := pending[key]; ok {
+				if accessTypeNames[rows[idx].AccessTypeID].EqualOrGreaterModelAccessThan(grant.Access) {
+					fail(fmt.Errorf("user %q already has %q %w", grant.Subject, grant.Access, accesserrors.PermissionAccessGreater))
+					continue
+				}
+				rows[idx].AccessTypeID = accessTypeIDs[grant.Access]
+				continue
+			}
+
+			current := dbPermission{
+				GrantTo: subjectUUID.String(),
+				GrantOn: target.Key,
+			}
+			err = tx.Query(ctx, currentStmt, current).Get(&current)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				newUUID, err := uuid.NewUUID()
+				if err != nil {
+					return errors.Annotate(err, "generating new UUID")
+				}
+				pending[key] = len(rows)
+				rows = append(rows, dbPermissionRow{
+					UUID:         newUUID.String(),
+					AccessTypeID: accessTypeIDs[grant.Access],
+					ObjectTypeID: objectType.ID,
+					GrantOn:      target.Key,
+					GrantTo:      subjectUUID.String(),
+				})
+				continue
+			} else if err != nil {
+				return errors.Annotatef(err, "getting current access of %q on %q", grant.Subject, target.Key)
+			}
+
+			if corepermission.Access(current.AccessType).EqualOrGreaterModelAccessThan(grant.Access) {
+				fail(fmt.Errorf("user %q already has %q %w", grant.Subject, grant.Access, accesserrors.PermissionAccessGreater))
+				continue
+			}
+			if err := st.updatePermission(ctx, tx, grant.Subject.Name(), target.Key, grant.Access.String()); err != nil {
+				return errors.Trace(err)
+			}
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := tx.Query(ctx, insertStmt, rows).Run(); err != nil {
+			return errors.Annotate(err, "inserting model permissions")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return failed, nil
+}
+
 // ReadUserAccessForTarget returns the subject's (user) access for the
 // given user on the given target.
 // accesserrors.PermissionNotFound is returned the users permission cannot be
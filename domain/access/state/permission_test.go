@@ -1134,6 +1134,79 @@ func (s *permissionStateSuite) TestUpdatePermissionRevoke(c *gc.C) {
 	c.Check(obtainedUserAccess.Access, gc.Equals, corepermission.AddModelAccess)
 }
 
+func (s *permissionStateSuite) TestBulkGrantModelAccess(c *gc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	// Bob starts with Admin access on "test-model" and Write access on
+	// "default-model". Sue has no model access.
+	s.setupForRead(c, st)
+
+	bobName := usertesting.GenNewName(c, "bob")
+	sueName := usertesting.GenNewName(c, "sue")
+	tomName := usertesting.GenNewName(c, "tom@external")
+	grants := []access.ModelAccessGrant{
+		{Subject: sueName, ModelUUID: s.modelUUID, Access: corepermission.ReadAccess},
+		{Subject: sueName, ModelUUID: s.defaultModelUUID, Access: corepermission.AdminAccess},
+		{Subject: bobName, ModelUUID: s.modelUUID, Access: corepermission.ReadAccess},
+		{Subject: bobName, ModelUUID: s.defaultModelUUID, Access: corepermission.AdminAccess},
+		{Subject: usertesting.GenNewName(c, "ghost"), ModelUUID: s.modelUUID, Access: corepermission.ReadAccess},
+		{Subject: sueName, ModelUUID: coremodel.UUID(uuid.MustNewUUID().String()), Access: corepermission.WriteAccess},
+		// A later grant in the same batch can raise an earlier one, but
+		// not lower it.
+		{Subject: sueName, ModelUUID: s.modelUUID, Access: corepermission.WriteAccess},
+		{Subject: sueName, ModelUUID: s.defaultModelUUID, Access: corepermission.ReadAccess},
+		{Subject: tomName, ModelUUID: s.modelUUID, Access: corepermission.ReadAccess},
+	}
+	failed, err := st.BulkGrantModelAccess(context.Background(), grants)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(failed, gc.HasLen, 4)
+	for i, expected := range []struct {
+		index int
+		err   error
+	}{
+		{index: 2, err: accesserrors.PermissionAccessGreater},
+		{index: 4, err: accesserrors.UserNotFound},
+		{index: 5, err: accesserrors.PermissionTargetInvalid},
+		{index: 7, err: accesserrors.PermissionAccessGreater},
+	} {
+		c.Check(failed[i].Index, gc.Equals, expected.index)
+		c.Check(failed[i].Grant, jc.DeepEquals, grants[expected.index])
+		c.Check(failed[i].Error, jc.ErrorIs, expected.err)
+	}
+
+	for _, expected := range []struct {
+		name   user.Name
+		model  string
+		access corepermission.Access
+	}{
+		{name: sueName, model: s.modelUUID.String(), access: corepermission.WriteAccess},
+		{name: sueName, model: s.defaultModelUUID.String(), access: corepermission.AdminAccess},
+		{name: bobName, model: s.modelUUID.String(), access: corepermission.AdminAccess},
+		{name: bobName, model: s.defaultModelUUID.String(), access: corepermission.AdminAccess},
+		{name: tomName, model: s.modelUUID.String(), access: corepermission.ReadAccess},
+	} {
+		obtained, err := st.ReadUserAccessLevelForTarget(context.Background(), expected.name, corepermission.ID{
+			ObjectType: corepermission.Model,
+			Key:        expected.model,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(obtained, gc.Equals, expected.access, gc.Commentf("%s on %s", expected.name, expected.model))
+	}
+}
+
+func (s *permissionStateSuite) TestBulkGrantModelAccessNoneApplied(c *gc.C) {
+	st := NewPermissionState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	failed, err := st.BulkGrantModelAccess(context.Background(), []access.ModelAccessGrant{{
+		Subject:   usertesting.GenNewName(c, "ghost"),
+		ModelUUID: s.modelUUID,
+		Access:    corepermission.ReadAccess,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(failed, gc.HasLen, 1)
+	c.Check(failed[0].Error, jc.ErrorIs, accesserrors.UserNotFound)
+}
+
 func (s *permissionStateSuite) TestModelAccessForCloudCredential(c *gc.C) {
 	st := NewPermissionState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
 	ctx := context.Background()
@@ -189,6 +189,23 @@ type permInOut struct {
 	Access  string `db:"access_type"`
 }
 
+// dbPermissionRow is a row of the permission table, used when inserting
+// several permissions at once.
+type dbPermissionRow struct {
+	UUID         string `db:"uuid"`
+	AccessTypeID int    `db:"access_type_id"`
+	ObjectTypeID int    `db:"object_type_id"`
+	GrantOn      string `db:"grant_on"`
+	GrantTo      string `db:"grant_to"`
+}
+
+// dbPermissionType is a row of either the permission_access_type or the
+// permission_object_type lookup tables.
+type dbPermissionType struct {
+	ID   int    `db:"id"`
+	Type string `db:"type"`
+}
+
 // dbModelLastLogin is a struct used to record a users logging in to a particular
 // model.
 type dbModelLastLogin struct {
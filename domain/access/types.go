@@ -6,6 +6,7 @@ package access
 import (
 	"github.com/juju/errors"
 
+	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
 )
@@ -42,3 +43,36 @@ type CredentialOwnerModelAccess struct {
 	ModelName   string            `db:"model_name"`
 	OwnerAccess permission.Access `db:"access_type"`
 }
+
+// ModelAccessGrant describes a single grant of model access to a user, as
+// part of a bulk grant.
+type ModelAccessGrant struct {
+	// Subject is the user being granted access.
+	Subject user.Name
+	// ModelUUID is the model on which access is granted.
+	ModelUUID coremodel.UUID
+	// Access is the level of model access to grant.
+	Access permission.Access
+}
+
+// Validate returns an error satisfying NotValid if the grant is missing a
+// subject or model, or the access is not a valid model access level.
+func (g ModelAccessGrant) Validate() error {
+	if g.Subject.IsZero() {
+		return errors.Trace(errors.NotValidf("empty subject"))
+	}
+	if err := g.ModelUUID.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(permission.ValidateModelAccess(g.Access))
+}
+
+// ModelAccessError describes a grant from a bulk grant that was not applied.
+type ModelAccessError struct {
+	// Index is the position of the grant in the bulk grant.
+	Index int
+	// Grant is the grant that was not applied.
+	Grant ModelAccessGrant
+	// Error is the reason the grant was not applied.
+	Error error
+}
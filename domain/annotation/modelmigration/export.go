@@ -0,0 +1,67 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/annotations"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/modelmigration"
+	"github.com/juju/juju/domain/annotation/service"
+	"github.com/juju/juju/domain/annotation/state"
+)
+
+// RegisterExport registers the export operations with the given coordinator.
+func RegisterExport(coordinator Coordinator, logger logger.Logger) {
+	coordinator.Add(&exportOperation{
+		logger: logger,
+	})
+}
+
+// ExportService provides a subset of the annotation domain
+// service methods needed for annotation export.
+type ExportService interface {
+	GetAnnotations(ctx context.Context, id annotations.ID) (map[string]string, error)
+}
+
+// exportOperation describes a way to execute a migration for
+// exporting annotations.
+type exportOperation struct {
+	modelmigration.BaseOperation
+
+	logger  logger.Logger
+	service ExportService
+}
+
+// Name returns the name of this operation.
+func (e *exportOperation) Name() string {
+	return "export annotations"
+}
+
+// Setup implements Operation.
+func (e *exportOperation) Setup(scope modelmigration.Scope) error {
+	e.service = service.NewService(state.NewState(scope.ModelDB()))
+	return nil
+}
+
+// Execute the export, adding the annotations of the model to the
+// description.
+func (e *exportOperation) Execute(ctx context.Context, model description.Model) error {
+	id := annotations.ID{
+		Kind: annotations.KindModel,
+		Name: model.Tag().Id(),
+	}
+	modelAnnotations, err := e.service.GetAnnotations(ctx, id)
+	if err != nil {
+		return errors.Annotate(err, "exporting model annotations")
+	}
+	if len(modelAnnotations) > 0 {
+		model.SetAnnotations(modelAnnotations)
+	}
+	return nil
+}
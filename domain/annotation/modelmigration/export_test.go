@@ -0,0 +1,76 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/annotations"
+)
+
+type exportSuite struct {
+	coordinator *MockCoordinator
+	service     *MockExportService
+}
+
+var _ = gc.Suite(&exportSuite{})
+
+func (s *exportSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.coordinator = NewMockCoordinator(ctrl)
+	s.service = NewMockExportService(ctrl)
+
+	return ctrl
+}
+
+func (s *exportSuite) newExportOperation() *exportOperation {
+	return &exportOperation{
+		service: s.service,
+	}
+}
+
+func (s *exportSuite) TestExport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dst := description.NewModel(description.ModelArgs{
+		Config: map[string]any{"uuid": "deadbeef"},
+	})
+
+	s.service.EXPECT().GetAnnotations(gomock.Any(), annotations.ID{
+		Kind: annotations.KindModel,
+		Name: "deadbeef",
+	}).Return(map[string]string{
+		"team": "platform",
+	}, nil)
+
+	op := s.newExportOperation()
+	err := op.Execute(context.Background(), dst)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(dst.Annotations(), jc.DeepEquals, map[string]string{
+		"team": "platform",
+	})
+}
+
+func (s *exportSuite) TestExportNoAnnotations(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	dst := description.NewModel(description.ModelArgs{
+		Config: map[string]any{"uuid": "deadbeef"},
+	})
+
+	s.service.EXPECT().GetAnnotations(gomock.Any(), gomock.Any()).Return(map[string]string{}, nil)
+
+	op := s.newExportOperation()
+	err := op.Execute(context.Background(), dst)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Check(dst.Annotations(), gc.HasLen, 0)
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/annotations"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/modelmigration"
+	"github.com/juju/juju/domain/annotation/service"
+	"github.com/juju/juju/domain/annotation/state"
+)
+
+// Coordinator is the interface that is used to add operations to a migration.
+type Coordinator interface {
+	// Add adds the given operation to the migration.
+	Add(modelmigration.Operation)
+}
+
+// RegisterImport registers the import operations with the given coordinator.
+func RegisterImport(coordinator Coordinator, logger logger.Logger) {
+	coordinator.Add(&importOperation{
+		logger: logger,
+	})
+}
+
+// ImportService provides a subset of the annotation domain
+// service methods needed for annotation import.
+type ImportService interface {
+	SetAnnotations(ctx context.Context, id annotations.ID, annotations map[string]string) error
+}
+
+type importOperation struct {
+	modelmigration.BaseOperation
+
+	logger  logger.Logger
+	service ImportService
+}
+
+// Name returns the name of this operation.
+func (i *importOperation) Name() string {
+	return "import annotations"
+}
+
+// Setup implements Operation.
+func (i *importOperation) Setup(scope modelmigration.Scope) error {
+	i.service = service.NewService(state.NewState(scope.ModelDB()))
+	return nil
+}
+
+// Execute the import on the annotations of the model.
+func (i *importOperation) Execute(ctx context.Context, model description.Model) error {
+	modelAnnotations := model.Annotations()
+	if len(modelAnnotations) == 0 {
+		return nil
+	}
+
+	id := annotations.ID{
+		Kind: annotations.KindModel,
+		Name: model.Tag().Id(),
+	}
+	if err := i.service.SetAnnotations(ctx, id, modelAnnotations); err != nil {
+		return errors.Annotate(err, "importing model annotations")
+	}
+	return nil
+}
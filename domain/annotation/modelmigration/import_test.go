@@ -0,0 +1,82 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/annotations"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type importSuite struct {
+	coordinator *MockCoordinator
+	service     *MockImportService
+}
+
+var _ = gc.Suite(&importSuite{})
+
+func (s *importSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.coordinator = NewMockCoordinator(ctrl)
+	s.service = NewMockImportService(ctrl)
+
+	return ctrl
+}
+
+func (s *importSuite) newImportOperation() *importOperation {
+	return &importOperation{
+		service: s.service,
+	}
+}
+
+func (s *importSuite) TestRegisterImport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.coordinator.EXPECT().Add(gomock.Any())
+
+	RegisterImport(s.coordinator, loggertesting.WrapCheckLog(c))
+}
+
+func (s *importSuite) TestImport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.service.EXPECT().SetAnnotations(gomock.Any(), annotations.ID{
+		Kind: annotations.KindModel,
+		Name: "deadbeef",
+	}, map[string]string{
+		"team":        "platform",
+		"cost-center": "42",
+	}).Return(nil)
+
+	model := description.NewModel(description.ModelArgs{
+		Config: map[string]any{"uuid": "deadbeef"},
+	})
+	model.SetAnnotations(map[string]string{
+		"team":        "platform",
+		"cost-center": "42",
+	})
+
+	op := s.newImportOperation()
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *importSuite) TestImportNoAnnotations(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{
+		Config: map[string]any{"uuid": "deadbeef"},
+	})
+
+	op := s.newImportOperation()
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIsNil)
+}
@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/annotation/modelmigration (interfaces: Coordinator,ImportService,ExportService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package modelmigration -destination migrations_mock_test.go github.com/juju/juju/domain/annotation/modelmigration Coordinator,ImportService,ExportService
+//
+
+// Package modelmigration is a generated GoMock package.
+package modelmigration
+
+import (
+	context "context"
+	reflect "reflect"
+
+	annotations "github.com/juju/juju/core/annotations"
+	modelmigration "github.com/juju/juju/core/modelmigration"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCoordinator is a mock of Coordinator interface.
+type MockCoordinator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoordinatorMockRecorder
+}
+
+// MockCoordinatorMockRecorder is the mock recorder for MockCoordinator.
+type MockCoordinatorMockRecorder struct {
+	mock *MockCoordinator
+}
+
+// NewMockCoordinator creates a new mock instance.
+func NewMockCoordinator(ctrl *gomock.Controller) *MockCoordinator {
+	mock := &MockCoordinator{ctrl: ctrl}
+	mock.recorder = &MockCoordinatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoordinator) EXPECT() *MockCoordinatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCoordinator) Add(arg0 modelmigration.Operation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", arg0)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCoordinatorMockRecorder) Add(arg0 any) *MockCoordinatorAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCoordinator)(nil).Add), arg0)
+	return &MockCoordinatorAddCall{Call: call}
+}
+
+// MockCoordinatorAddCall wrap *gomock.Call
+type MockCoordinatorAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCoordinatorAddCall) Return() *MockCoordinatorAddCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCoordinatorAddCall) Do(f func(modelmigration.Operation)) *MockCoordinatorAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCoordinatorAddCall) DoAndReturn(f func(modelmigration.Operation)) *MockCoordinatorAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// SetAnnotations mocks base method.
+func (m *MockImportService) SetAnnotations(arg0 context.Context, arg1 annotations.ID, arg2 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAnnotations", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAnnotations indicates an expected call of SetAnnotations.
+func (mr *MockImportServiceMockRecorder) SetAnnotations(arg0, arg1, arg2 any) *MockImportServiceSetAnnotationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnnotations", reflect.TypeOf((*MockImportService)(nil).SetAnnotations), arg0, arg1, arg2)
+	return &MockImportServiceSetAnnotationsCall{Call: call}
+}
+
+// MockImportServiceSetAnnotationsCall wrap *gomock.Call
+type MockImportServiceSetAnnotationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImportServiceSetAnnotationsCall) Return(arg0 error) *MockImportServiceSetAnnotationsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImportServiceSetAnnotationsCall) Do(f func(context.Context, annotations.ID, map[string]string) error) *MockImportServiceSetAnnotationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImportServiceSetAnnotationsCall) DoAndReturn(f func(context.Context, annotations.ID, map[string]string) error) *MockImportServiceSetAnnotationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockExportService is a mock of ExportService interface.
+type MockExportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExportServiceMockRecorder
+}
+
+// MockExportServiceMockRecorder is the mock recorder for MockExportService.
+type MockExportServiceMockRecorder struct {
+	mock *MockExportService
+}
+
+// NewMockExportService creates a new mock instance.
+func NewMockExportService(ctrl *gomock.Controller) *MockExportService {
+	mock := &MockExportService{ctrl: ctrl}
+	mock.recorder = &MockExportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExportService) EXPECT() *MockExportServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAnnotations mocks base method.
+func (m *MockExportService) GetAnnotations(arg0 context.Context, arg1 annotations.ID) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnotations", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnotations indicates an expected call of GetAnnotations.
+func (mr *MockExportServiceMockRecorder) GetAnnotations(arg0, arg1 any) *MockExportServiceGetAnnotationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotations", reflect.TypeOf((*MockExportService)(nil).GetAnnotations), arg0, arg1)
+	return &MockExportServiceGetAnnotationsCall{Call: call}
+}
+
+// MockExportServiceGetAnnotationsCall wrap *gomock.Call
+type MockExportServiceGetAnnotationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockExportServiceGetAnnotationsCall) Return(arg0 map[string]string, arg1 error) *MockExportServiceGetAnnotationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockExportServiceGetAnnotationsCall) Do(f func(context.Context, annotations.ID) (map[string]string, error)) *MockExportServiceGetAnnotationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockExportServiceGetAnnotationsCall) DoAndReturn(f func(context.Context, annotations.ID) (map[string]string, error)) *MockExportServiceGetAnnotationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
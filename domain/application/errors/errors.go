@@ -48,6 +48,14 @@ const (
 	// on does not exist.
 	UnitNotFound = errors.ConstError("unit not found")
 
+	// UnitNotInErrorState describes an error that occurs when trying to
+	// resolve a unit whose agent is not in an error state.
+	UnitNotInErrorState = errors.ConstError("unit not in error state")
+
+	// ResolveModeNotValid describes an error that occurs when a unit is
+	// resolved with an unknown resolve mode.
+	ResolveModeNotValid = errors.ConstError("resolve mode not valid")
+
 	// UnitAlreadyExists describes an error that occurs when the
 	// unit being created already exists.
 	UnitAlreadyExists = errors.ConstError("unit already exists")
@@ -99,6 +107,10 @@ const (
 	// exists for the given natural key.
 	CharmAlreadyExists = errors.ConstError("charm already exists")
 
+	// CharmChannelNotValid describes an error that occurs when the charm
+	// channel is not valid.
+	CharmChannelNotValid = errors.ConstError("charm channel not valid")
+
 	// CharmRevisionNotValid describes an error that occurs when attempting to
 	// get a charm using an invalid revision.
 	CharmRevisionNotValid = errors.ConstError("charm revision not valid")
@@ -16,6 +16,7 @@ import (
 	"github.com/juju/juju/caas"
 	coreapplication "github.com/juju/juju/core/application"
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/leadership"
 	corelife "github.com/juju/juju/core/life"
 	"github.com/juju/juju/core/logger"
@@ -40,6 +41,10 @@ import (
 	"github.com/juju/juju/internal/storage"
 )
 
+// ScaleHistoryLimit is the number of scale history entries returned for an
+// application.
+const ScaleHistoryLimit = 50
+
 // AtomicApplicationState describes retrieval and persistence methods for
 // applications that require atomic transactions.
 type AtomicApplicationState interface {
@@ -86,6 +91,12 @@ type AtomicApplicationState interface {
 	// [applicationerrors.UnitNotFound] if the unit doesn't exist.
 	SetUnitAgentStatus(domain.AtomicContext, coreunit.UUID, application.UnitAgentStatusInfo) error
 
+	// SetUnitsResolveMode marks the specified units as resolved with the
+	// given mode. It returns an error satisfying
+	// [applicationerrors.UnitNotInErrorState] if the agent of any of the
+	// units is not in an error state.
+	SetUnitsResolveMode(domain.AtomicContext, []coreunit.UUID, application.ResolveMode) error
+
 	// SetUnitWorkloadStatus saves the given unit workload status, overwriting
 	// any current status data. If returns an error satisfying
 	// [applicationerrors.UnitNotFound] if the unit doesn't exist.
@@ -105,13 +116,25 @@ type AtomicApplicationState interface {
 	// [applicationerrors.ApplicationNotFound] if the application is not found.
 	GetApplicationScaleState(domain.AtomicContext, coreapplication.ID) (application.ScaleState, error)
 
+	// GetApplicationConstraints returns the constraints of the specified
+	// application which can be checked against the model. It returns an
+	// error satisfying [applicationerrors.ApplicationNotFound] if the
+	// application is not found.
+	GetApplicationConstraints(domain.AtomicContext, coreapplication.ID) (constraints.Value, error)
+
+	// GetSpaceSubnetCounts returns the number of subnets in each of the
+	// specified spaces, keyed by space name. Spaces which do not exist are not
+	// included in the result.
+	GetSpaceSubnetCounts(domain.AtomicContext, []string) (map[string]int, error)
+
 	// SetApplicationScalingState sets the scaling details for the given caas
 	// application Scale is optional and is only set if not nil.
 	SetApplicationScalingState(ctx domain.AtomicContext, appID coreapplication.ID, scale *int, targetScale int, scaling bool) error
 
 	// SetDesiredApplicationScale updates the desired scale of the specified
-	// application.
-	SetDesiredApplicationScale(domain.AtomicContext, coreapplication.ID, int) error
+	// application, recording the change and the actor which requested it in
+	// the application's scale history.
+	SetDesiredApplicationScale(ctx domain.AtomicContext, appID coreapplication.ID, scale int, actor string) error
 
 	// GetUnitLife looks up the life of the specified unit, returning an error
 	// satisfying [applicationerrors.UnitNotFound] if the unit is not found.
@@ -120,6 +143,17 @@ type AtomicApplicationState interface {
 	// SetUnitLife sets the life of the specified unit.
 	SetUnitLife(domain.AtomicContext, coreunit.Name, life.Life) error
 
+	// GetUnitSubordinateTree returns the tree of subordinates of the
+	// specified unit, including subordinates of subordinates, returning an
+	// error satisfying [applicationerrors.UnitNotFound] if the unit is not
+	// found.
+	GetUnitSubordinateTree(domain.AtomicContext, coreunit.Name) (application.UnitTree, error)
+
+	// GetUnitPrincipalChainNames returns the names of all the principals of
+	// the specified unit, root principal first, returning an error
+	// satisfying [applicationerrors.UnitNotFound] if the unit is not found.
+	GetUnitPrincipalChainNames(domain.AtomicContext, coreunit.Name) ([]coreunit.Name, error)
+
 	// InitialWatchStatementUnitLife returns the initial namespace query for the
 	// application unit life watcher.
 	InitialWatchStatementUnitLife(appName string) (string, eventsource.NamespaceQuery)
@@ -207,6 +241,12 @@ type ApplicationState interface {
 	// the charm metadata.
 	GetCharmIDByApplicationName(context.Context, string) (corecharm.ID, error)
 
+	// GetMachineProvisioningConstraints returns what is needed to provision
+	// a machine for the named unit, with the LXD profile of its charm
+	// undecoded. Returns an error satisfying [applicationerrors.UnitNotFound]
+	// if the unit doesn't exist.
+	GetMachineProvisioningConstraints(ctx context.Context, name coreunit.Name) (application.MachineProvisioningConstraints, []byte, error)
+
 	// GetApplicationIDByUnitName returns the application ID for the named unit,
 	// returning an error satisfying [applicationerrors.UnitNotFound] if the
 	// unit doesn't exist.
@@ -231,6 +271,58 @@ type ApplicationState interface {
 	// ResolveCharmDownload resolves the charm download for the specified
 	// application, updating the charm with the specified charm information.
 	ResolveCharmDownload(ctx context.Context, charmID corecharm.ID, info application.ResolvedCharmDownload) error
+
+	// SetApplicationsLatestCharmRevision records the latest revision of the
+	// charm available in the charm repository for each of the named
+	// applications. Applications which don't exist are skipped.
+	SetApplicationsLatestCharmRevision(ctx context.Context, revisions map[string]int) error
+
+	// GetApplicationsWithPendingCharmUpgrades returns the applications for
+	// which the latest recorded charm revision is greater than the revision
+	// of the charm the application is using. If channel is not nil, only
+	// applications tracking that channel are returned.
+	GetApplicationsWithPendingCharmUpgrades(ctx context.Context, channel *application.Channel) ([]application.ApplicationPendingUpgrade, error)
+
+	// ListUnitsInAgentError returns the units whose agent is in an error
+	// state, along with the error message and the name of the failed hook.
+	ListUnitsInAgentError(context.Context) ([]application.PendingHookRetryInfo, error)
+
+	// GetApplicationScaleHistory returns the most recent changes to the
+	// desired scale of the named application, newest first, up to the given
+	// limit. Returns an error satisfying
+	// [applicationerrors.ApplicationNotFound] if the application doesn't
+	// exist.
+	GetApplicationScaleHistory(ctx context.Context, appName string, limit int) ([]application.ScaleTargetEntry, error)
+
+	// GetLatestApplicationScaleChanges returns the time of the most recent
+	// change to the desired scale of each application, keyed by application
+	// name.
+	GetLatestApplicationScaleChanges(ctx context.Context) (map[string]time.Time, error)
+
+	// GetApplicationUnitStatusCounts returns the number of units of the named
+	// application with each combination of workload and agent status, ordered
+	// by the name of the first unit with each combination. Returns an error
+	// satisfying [applicationerrors.ApplicationNotFound] if the application
+	// doesn't exist.
+	GetApplicationUnitStatusCounts(ctx context.Context, appName string) ([]application.UnitStatusCount, error)
+
+	// GetApplicationPlacementGroups returns the units of the named
+	// application grouped by the machine they are placed on, ordered by
+	// machine name. Returns an error satisfying
+	// [applicationerrors.ApplicationNotFound] if the application doesn't
+	// exist.
+	GetApplicationPlacementGroups(ctx context.Context, appName string) ([]application.PlacementGroup, error)
+
+	// GetApplicationRelationSummary returns a summary of each relation of the
+	// named application, ordered by relation ID. Returns an error satisfying
+	// [applicationerrors.ApplicationNotFound] if the application doesn't
+	// exist.
+	GetApplicationRelationSummary(ctx context.Context, appName string) ([]application.RelationSummary, error)
+
+	// ListApplicationsWithRelationErrors returns an entry for each
+	// application endpoint taking part in a relation which is in an error
+	// state, ordered by application name and relation ID.
+	ListApplicationsWithRelationErrors(ctx context.Context) ([]application.ApplicationRelationError, error)
 }
 
 // DeleteSecretState describes methods used by the secret deleter plugin.
@@ -520,6 +612,33 @@ func (s *Service) GetApplicationIDByUnitName(
 	return id, nil
 }
 
+// GetApplicationConstraintsForMachineProvisioning returns everything needed
+// to provision a machine for the named unit in a single call: the constraints
+// of its application, the storage to provision, the space each endpoint is
+// bound to, and the LXD profile required by its charm.
+//
+// If the unit doesn't exist, an error satisfying
+// [applicationerrors.UnitNotFound] is returned.
+func (s *Service) GetApplicationConstraintsForMachineProvisioning(
+	ctx context.Context,
+	unitName coreunit.Name,
+) (application.MachineProvisioningConstraints, error) {
+	if err := unitName.Validate(); err != nil {
+		return application.MachineProvisioningConstraints{}, internalerrors.Errorf("unit name: %w", err)
+	}
+
+	result, profile, err := s.st.GetMachineProvisioningConstraints(ctx, unitName)
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, internalerrors.Errorf("getting machine provisioning constraints: %w", err)
+	}
+
+	result.LXDProfile, err = decodeLXDProfile(profile)
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, internalerrors.Errorf("decoding lxd profile: %w", err)
+	}
+	return result, nil
+}
+
 // GetUnitUUIDs returns the UUIDs for the named units in bulk, returning an error
 // satisfying [applicationerrors.UnitNotFound] if any of the units don't exist.
 func (s *Service) GetUnitUUIDs(ctx context.Context, unitNames []coreunit.Name) ([]coreunit.UUID, error) {
@@ -569,7 +688,8 @@ func (s *Service) GetUnitLife(ctx context.Context, unitName coreunit.Name) (core
 // DestroyMaybeRemove, DestroyWithForce, RemoveWithForce.
 func (s *Service) DeleteUnit(ctx context.Context, unitName coreunit.Name) error {
 	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
-		return s.deleteUnit(ctx, unitName)
+		_, err := s.deleteUnit(ctx, unitName)
+		return err
 	})
 	if err != nil {
 		return errors.Annotatef(err, "deleting unit %q", unitName)
@@ -577,7 +697,75 @@ func (s *Service) DeleteUnit(ctx context.Context, unitName coreunit.Name) error
 	return nil
 }
 
-func (s *Service) deleteUnit(ctx domain.AtomicContext, unitName coreunit.Name) error {
+// GetUnitPrincipalChainNames returns the names of all the principals of the
+// specified unit, with the root principal first and the unit's immediate
+// principal last. It is empty if the unit is not a subordinate. If the unit
+// is not found, an error satisfying [applicationerrors.UnitNotFound] is
+// returned.
+func (s *Service) GetUnitPrincipalChainNames(ctx context.Context, unitName coreunit.Name) ([]coreunit.Name, error) {
+	var names []coreunit.Name
+	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		var err error
+		names, err = s.st.GetUnitPrincipalChainNames(ctx, unitName)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting principal chain of unit %q", unitName)
+	}
+	return names, nil
+}
+
+// GetUnitSubordinateTree returns the tree of subordinates of the specified
+// principal unit, including subordinates of subordinates. If the unit is not
+// found, an error satisfying [applicationerrors.UnitNotFound] is returned.
+func (s *Service) GetUnitSubordinateTree(ctx context.Context, principalUnitName coreunit.Name) (application.UnitTree, error) {
+	var tree application.UnitTree
+	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		var err error
+		tree, err = s.st.GetUnitSubordinateTree(ctx, principalUnitName)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return application.UnitTree{}, errors.Annotatef(err, "getting subordinate tree of unit %q", principalUnitName)
+	}
+	return tree, nil
+}
+
+// deleteUnit deletes the unit along with all of its transitive subordinates,
+// returning the names of the deleted subordinates. The subordinates are
+// deleted leaf first, so that no unit is stopped while a subordinate of it
+// remains. If any of the subordinates is still alive, nothing is deleted and
+// an error satisfying [applicationerrors.UnitHasSubordinates] is returned.
+func (s *Service) deleteUnit(ctx domain.AtomicContext, unitName coreunit.Name) ([]coreunit.Name, error) {
+	tree, err := s.st.GetUnitSubordinateTree(ctx, unitName)
+	if errors.Is(err, applicationerrors.UnitNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting subordinates of unit %q", unitName)
+	}
+	subordinateNames := tree.SubordinatesLeafFirst()
+	for _, subordinateName := range subordinateNames {
+		subordinateLife, err := s.st.GetUnitLife(ctx, subordinateName)
+		if errors.Is(err, applicationerrors.UnitNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Annotatef(err, "getting life of subordinate unit %q", subordinateName)
+		}
+		if subordinateLife == life.Alive {
+			return nil, fmt.Errorf("subordinate unit %q is alive: %w", subordinateName, applicationerrors.UnitHasSubordinates)
+		}
+	}
+	for _, subordinateName := range subordinateNames {
+		if err := s.deleteSingleUnit(ctx, subordinateName); err != nil {
+			return nil, errors.Annotatef(err, "deleting subordinate unit %q", subordinateName)
+		}
+	}
+	return subordinateNames, s.deleteSingleUnit(ctx, unitName)
+}
+
+func (s *Service) deleteSingleUnit(ctx domain.AtomicContext, unitName coreunit.Name) error {
 	// Get unit owned secrets.
 	uris, err := s.st.GetSecretsForUnit(ctx, unitName)
 	if err != nil {
@@ -668,9 +856,12 @@ func (s *Service) ensureUnitDead(ctx domain.AtomicContext, unitName coreunit.Nam
 // Note: the callers of this method only do so after the unit has become dead, so
 // there's strictly no need to call ensureUnitDead before removing.
 // If the unit is still alive, an error satisfying [applicationerrors.UnitIsAlive]
-// is returned. If the unit is not found, an error satisfying
-// [applicationerrors.UnitNotFound] is returned.
+// is returned. If any of its subordinates is still alive, an error satisfying
+// [applicationerrors.UnitHasSubordinates] is returned. If the unit is not
+// found, an error satisfying [applicationerrors.UnitNotFound] is returned.
+// The leadership of the unit and of each removed subordinate is revoked.
 func (s *Service) RemoveUnit(ctx context.Context, unitName coreunit.Name, leadershipRevoker leadership.Revoker) error {
+	var subordinateNames []coreunit.Name
 	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
 		unitLife, err := s.st.GetUnitLife(ctx, unitName)
 		if err != nil {
@@ -679,17 +870,26 @@ func (s *Service) RemoveUnit(ctx context.Context, unitName coreunit.Name, leader
 		if unitLife == life.Alive {
 			return fmt.Errorf("cannot remove unit %q: %w", unitName, applicationerrors.UnitIsAlive)
 		}
-		err = s.deleteUnit(ctx, unitName)
+		subordinateNames, err = s.deleteUnit(ctx, unitName)
 		return errors.Annotatef(err, "deleting unit %q", unitName)
 	})
 	if err != nil {
 		return errors.Annotatef(err, "removing unit %q", unitName)
 	}
+	for _, subordinateName := range subordinateNames {
+		s.revokeUnitLeadership(subordinateName, leadershipRevoker)
+	}
+	s.revokeUnitLeadership(unitName, leadershipRevoker)
+	return nil
+}
+
+// revokeUnitLeadership revokes the leadership of the unit's application if it
+// is held by the removed unit.
+func (s *Service) revokeUnitLeadership(unitName coreunit.Name, leadershipRevoker leadership.Revoker) {
 	appName, _ := names.UnitApplication(unitName.String())
 	if err := leadershipRevoker.RevokeLeadership(appName, unitName); err != nil && !errors.Is(err, leadership.ErrClaimNotHeld) {
 		s.logger.Warningf("cannot revoke lease for dead unit %q", unitName)
 	}
-	return nil
 }
 
 func makeCloudContainerArg(unitName coreunit.Name, cloudContainer CloudContainerParams) *application.CloudContainer {
@@ -911,6 +1111,55 @@ func (s *Service) UpdateCAASUnit(ctx context.Context, unitName coreunit.Name, pa
 	return errors.Annotatef(err, "updating caas unit %q", unitName)
 }
 
+// ListUnitsWithPendingHookRetries returns the units whose agent is in an
+// error state because a hook failed, and which are waiting to be resolved.
+func (s *Service) ListUnitsWithPendingHookRetries(ctx context.Context) ([]application.PendingHookRetryInfo, error) {
+	units, err := s.st.ListUnitsInAgentError(ctx)
+	if err != nil {
+		return nil, internalerrors.Errorf("listing units with pending hook retries: %w", err)
+	}
+	return units, nil
+}
+
+// BulkResolveUnits marks the specified units as resolved in a single
+// transaction, so that their failed hooks are either retried or skipped
+// depending on the mode.
+//
+// If any of the units don't exist, an error satisfying
+// [applicationerrors.UnitNotFound] is returned. If the agent of any of the
+// units is not in an error state, an error satisfying
+// [applicationerrors.UnitNotInErrorState] is returned. In both cases no unit
+// is resolved.
+func (s *Service) BulkResolveUnits(ctx context.Context, unitNames []coreunit.Name, mode application.ResolveMode) error {
+	if mode != application.ResolveModeRetryHooks && mode != application.ResolveModeNoHooks {
+		return internalerrors.Errorf("resolve mode %d: %w", mode, applicationerrors.ResolveModeNotValid)
+	}
+	if len(unitNames) == 0 {
+		return nil
+	}
+	for _, name := range unitNames {
+		if err := name.Validate(); err != nil {
+			return internalerrors.Errorf("unit name: %w", err)
+		}
+	}
+
+	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		uuids := make([]coreunit.UUID, len(unitNames))
+		for i, name := range unitNames {
+			unitUUID, err := s.st.GetUnitUUID(ctx, name)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			uuids[i] = unitUUID
+		}
+		return s.st.SetUnitsResolveMode(ctx, uuids, mode)
+	})
+	if err != nil {
+		return internalerrors.Errorf("resolving %d units: %w", len(unitNames), err)
+	}
+	return nil
+}
+
 // SetUnitPassword updates the password for the specified unit, returning an error
 // satisfying [applicationerrors.NotNotFound] if the unit doesn't exist.
 func (s *Service) SetUnitPassword(ctx context.Context, unitName coreunit.Name, password string) error {
@@ -1194,8 +1443,9 @@ func (s *Service) GetApplicationLife(ctx context.Context, appName string) (corel
 
 // SetApplicationScale sets the application's desired scale value, returning an error
 // satisfying [applicationerrors.ApplicationNotFound] if the application is not found.
+// The actor which requested the change is recorded in the application's scale history.
 // This is used on CAAS models.
-func (s *Service) SetApplicationScale(ctx context.Context, appName string, scale int) error {
+func (s *Service) SetApplicationScale(ctx context.Context, appName string, scale int, actor string) error {
 	if scale < 0 {
 		return fmt.Errorf("application scale %d not valid%w", scale, errors.Hide(applicationerrors.ScaleChangeInvalid))
 	}
@@ -1211,7 +1461,7 @@ func (s *Service) SetApplicationScale(ctx context.Context, appName string, scale
 		s.logger.Tracef(
 			"SetScale DesiredScale %v -> %v", appScale.Scale, scale,
 		)
-		return s.st.SetDesiredApplicationScale(ctx, appID, scale)
+		return s.st.SetDesiredApplicationScale(ctx, appID, scale, actor)
 	})
 	return errors.Annotatef(err, "setting scale for application %q", appName)
 }
@@ -1245,8 +1495,9 @@ func (s *Service) getApplicationScaleAndID(ctx context.Context, appName string)
 // ChangeApplicationScale alters the existing scale by the provided change amount, returning the new amount.
 // It returns an error satisfying [applicationerrors.ApplicationNotFoundError] if the application
 // doesn't exist.
+// The actor which requested the change is recorded in the application's scale history.
 // This is used on CAAS models.
-func (s *Service) ChangeApplicationScale(ctx context.Context, appName string, scaleChange int) (int, error) {
+func (s *Service) ChangeApplicationScale(ctx context.Context, appName string, scaleChange int, actor string) (int, error) {
 	var newScale int
 	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
 		appID, err := s.st.GetApplicationID(ctx, appName)
@@ -1265,12 +1516,112 @@ func (s *Service) ChangeApplicationScale(ctx context.Context, appName string, sc
 			return fmt.Errorf(
 				"%w: cannot remove more units than currently exist", applicationerrors.ScaleChangeInvalid)
 		}
-		err = s.st.SetDesiredApplicationScale(ctx, appID, newScale)
+		err = s.st.SetDesiredApplicationScale(ctx, appID, newScale, actor)
 		return errors.Annotatef(err, "changing scaling state for %q", appName)
 	})
 	return newScale, errors.Annotatef(err, "changing scale for %q", appName)
 }
 
+// GetApplicationScaleHistory returns the most recent changes to the desired
+// scale of the named application, newest first. At most
+// ScaleHistoryLimit entries are returned.
+//
+// If the application doesn't exist, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationScaleHistory(ctx context.Context, appName string) ([]application.ScaleTargetEntry, error) {
+	history, err := s.st.GetApplicationScaleHistory(ctx, appName, ScaleHistoryLimit)
+	return history, errors.Annotatef(err, "getting scale history for %q", appName)
+}
+
+// GetLatestApplicationScaleChanges returns the time of the most recent change
+// to the desired scale of each application, keyed by application name.
+// Applications whose scale has never changed are not included.
+func (s *Service) GetLatestApplicationScaleChanges(ctx context.Context) (map[string]time.Time, error) {
+	changes, err := s.st.GetLatestApplicationScaleChanges(ctx)
+	return changes, errors.Annotate(err, "getting latest scale changes")
+}
+
+// GetApplicationRelationSummary returns a summary of each relation of the
+// named application, ordered by relation ID. Each summary carries the related
+// application and endpoints, the relation status and the number of units in
+// scope.
+//
+// If the application doesn't exist, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationRelationSummary(ctx context.Context, appName string) ([]application.RelationSummary, error) {
+	if !isValidApplicationName(appName) {
+		return nil, applicationerrors.ApplicationNameNotValid
+	}
+
+	summary, err := s.st.GetApplicationRelationSummary(ctx, appName)
+	return summary, errors.Annotatef(err, "getting relation summary for %q", appName)
+}
+
+// ListApplicationsWithRelationErrors returns an entry for each application
+// endpoint taking part in a relation which is in an error state, ordered by
+// application name and relation ID. Both ends of a failed relation are
+// reported, so that health reporting can be done per application in bulk.
+func (s *Service) ListApplicationsWithRelationErrors(ctx context.Context) ([]application.ApplicationRelationError, error) {
+	relErrors, err := s.st.ListApplicationsWithRelationErrors(ctx)
+	return relErrors, errors.Annotate(err, "listing applications with relation errors")
+}
+
+// GetApplicationStatus returns the status of the named application derived
+// from the statuses of its units. The application takes the status of its
+// worst unit, in the order error, blocked, maintenance, waiting, active and
+// unknown, along with that unit's status message. A unit whose agent is in
+// error counts as being in error, regardless of its workload status.
+//
+// If the application doesn't exist, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationStatus(ctx context.Context, appName string) (ApplicationStatusInfo, error) {
+	counts, err := s.st.GetApplicationUnitStatusCounts(ctx, appName)
+	if err != nil {
+		return ApplicationStatusInfo{}, errors.Annotatef(err, "getting unit statuses for %q", appName)
+	}
+	return deriveApplicationStatus(counts), nil
+}
+
+// GetApplicationPlacementGroups returns the units of the named application
+// grouped by the machine they are placed on, ordered by machine name. Units
+// which are not placed on a machine, such as those of k8s applications, are
+// omitted. Any group for which [application.PlacementGroup.IsColocated]
+// reports true is a risk to the application's availability.
+//
+// If the application doesn't exist, an error satisfying
+// [applicationerrors.ApplicationNotFound] is returned.
+func (s *Service) GetApplicationPlacementGroups(ctx context.Context, appName string) ([]application.PlacementGroup, error) {
+	groups, err := s.st.GetApplicationPlacementGroups(ctx, appName)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting placement groups for %q", appName)
+	}
+	return groups, nil
+}
+
+func deriveApplicationStatus(counts []application.UnitStatusCount) ApplicationStatusInfo {
+	result := ApplicationStatusInfo{
+		UnitCounts: make(map[corestatus.Status]int),
+	}
+	statuses := make([]corestatus.StatusInfo, len(counts))
+	for i, count := range counts {
+		statuses[i] = corestatus.StatusInfo{
+			Status:  count.WorkloadStatus,
+			Message: count.WorkloadMessage,
+		}
+		if count.AgentStatus == corestatus.Error {
+			statuses[i] = corestatus.StatusInfo{
+				Status:  corestatus.Error,
+				Message: count.AgentMessage,
+			}
+		}
+		result.UnitCounts[statuses[i].Status] += count.Count
+	}
+	derived := corestatus.DeriveStatus(statuses)
+	result.Status = derived.Status
+	result.Message = derived.Message
+	return result
+}
+
 // SetApplicationScalingState updates the scale state of an application, returning an error
 // satisfying [applicationerrors.ApplicationNotFoundError] if the application doesn't exist.
 // This is used on CAAS models.
@@ -1334,6 +1685,52 @@ func (s *Service) GetApplicationsWithPendingCharmsFromUUIDs(ctx context.Context,
 	return s.st.GetApplicationsWithPendingCharmsFromUUIDs(ctx, uuids)
 }
 
+// SetApplicationsLatestCharmRevision records the latest revision of the charm
+// available in the charm repository for each of the named applications. The
+// recorded revisions are used to find applications with pending charm upgrades
+// without querying the charm repository.
+//
+// If any of the application names are not valid, an error satisfying
+// [applicationerrors.ApplicationNameNotValid] is returned. Applications which
+// are not found are skipped.
+func (s *Service) SetApplicationsLatestCharmRevision(ctx context.Context, revisions map[string]int) error {
+	for name := range revisions {
+		if !isValidApplicationName(name) {
+			return internalerrors.Errorf("application %q: %w", name, applicationerrors.ApplicationNameNotValid)
+		}
+	}
+	if err := s.st.SetApplicationsLatestCharmRevision(ctx, revisions); err != nil {
+		return internalerrors.Errorf("setting latest charm revisions: %w", err)
+	}
+	return nil
+}
+
+// ListApplicationsWithPendingCharmUpgrades returns the applications for which
+// a newer revision of their charm is available, based on the latest revisions
+// recorded by the charm revision updater. If channel is not empty, only
+// applications tracking that channel are returned.
+//
+// If the channel is not valid, an error satisfying
+// [applicationerrors.CharmChannelNotValid] is returned.
+func (s *Service) ListApplicationsWithPendingCharmUpgrades(ctx context.Context, channel string) ([]application.ApplicationPendingUpgrade, error) {
+	var ch *application.Channel
+	if channel != "" {
+		parsed, err := internalcharm.ParseChannel(channel)
+		if err != nil {
+			return nil, internalerrors.Errorf("parsing channel %q: %w", channel, applicationerrors.CharmChannelNotValid)
+		}
+		if ch, err = encodeChannel(&parsed); err != nil {
+			return nil, internalerrors.Errorf("encoding channel %q: %w", channel, applicationerrors.CharmChannelNotValid)
+		}
+	}
+
+	pending, err := s.st.GetApplicationsWithPendingCharmUpgrades(ctx, ch)
+	if err != nil {
+		return nil, internalerrors.Errorf("listing applications with pending charm upgrades: %w", err)
+	}
+	return pending, nil
+}
+
 // GetAsyncCharmDownloadInfo returns a charm download info for the specified
 // application. If the charm is already being downloaded, the method will
 // return [applicationerrors.CharmAlreadyAvailable]. The charm download
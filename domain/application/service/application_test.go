@@ -22,8 +22,11 @@ import (
 	"github.com/juju/juju/core/changestream"
 	corecharm "github.com/juju/juju/core/charm"
 	charmtesting "github.com/juju/juju/core/charm/testing"
+	"github.com/juju/juju/core/constraints"
 	modeltesting "github.com/juju/juju/core/model/testing"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
 	corestorage "github.com/juju/juju/core/storage"
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
@@ -959,6 +962,17 @@ func (s *applicationServiceSuite) TestAddUnits(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *applicationServiceSuite) TestGetUnitPrincipalChainNames(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	chain := []coreunit.Name{"foo/0", "bar/0"}
+	s.state.EXPECT().GetUnitPrincipalChainNames(domaintesting.IsAtomicContextChecker, coreunit.Name("baz/0")).Return(chain, nil)
+
+	names, err := s.service.GetUnitPrincipalChainNames(context.Background(), "baz/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, jc.DeepEquals, chain)
+}
+
 func (s *applicationServiceSuite) TestGetUnitUUIDs(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1199,6 +1213,267 @@ func (s *applicationServiceSuite) TestUpdateCAASUnitNotAlive(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotAlive)
 }
 
+func (s *applicationServiceSuite) TestListUnitsWithPendingHookRetries(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	units := []application.PendingHookRetryInfo{{
+		UnitName: "foo/0",
+		HookName: "install",
+		Message:  `hook failed: "install"`,
+	}}
+	s.state.EXPECT().ListUnitsInAgentError(gomock.Any()).Return(units, nil)
+
+	result, err := s.service.ListUnitsWithPendingHookRetries(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, units)
+}
+
+func (s *applicationServiceSuite) TestBulkResolveUnits(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnitUUID(domaintesting.IsAtomicContextChecker, coreunit.Name("foo/0")).Return("unit-uuid-0", nil)
+	s.state.EXPECT().GetUnitUUID(domaintesting.IsAtomicContextChecker, coreunit.Name("foo/1")).Return("unit-uuid-1", nil)
+	s.state.EXPECT().SetUnitsResolveMode(domaintesting.IsAtomicContextChecker, []coreunit.UUID{"unit-uuid-0", "unit-uuid-1"}, application.ResolveModeNoHooks).Return(nil)
+
+	err := s.service.BulkResolveUnits(context.Background(), []coreunit.Name{"foo/0", "foo/1"}, application.ResolveModeNoHooks)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestBulkResolveUnitsNotInError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnitUUID(domaintesting.IsAtomicContextChecker, coreunit.Name("foo/0")).Return("unit-uuid-0", nil)
+	s.state.EXPECT().SetUnitsResolveMode(domaintesting.IsAtomicContextChecker, []coreunit.UUID{"unit-uuid-0"}, application.ResolveModeRetryHooks).Return(applicationerrors.UnitNotInErrorState)
+
+	err := s.service.BulkResolveUnits(context.Background(), []coreunit.Name{"foo/0"}, application.ResolveModeRetryHooks)
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotInErrorState)
+}
+
+func (s *applicationServiceSuite) TestBulkResolveUnitsInvalidMode(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.BulkResolveUnits(context.Background(), []coreunit.Name{"foo/0"}, application.ResolveModeNone)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ResolveModeNotValid)
+}
+
+func (s *applicationServiceSuite) TestBulkResolveUnitsInvalidUnitName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.BulkResolveUnits(context.Background(), []coreunit.Name{"not-valid"}, application.ResolveModeRetryHooks)
+	c.Assert(err, jc.ErrorIs, coreunit.InvalidUnitName)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitStatusCounts(gomock.Any(), "foo").Return([]application.UnitStatusCount{{
+		WorkloadStatus:  corestatus.Active,
+		WorkloadMessage: "ready",
+		AgentStatus:     corestatus.Idle,
+		Count:           3,
+	}, {
+		WorkloadStatus:  corestatus.Waiting,
+		WorkloadMessage: "waiting for db",
+		AgentStatus:     corestatus.Idle,
+		Count:           2,
+	}, {
+		WorkloadStatus:  corestatus.Maintenance,
+		WorkloadMessage: "installing",
+		AgentStatus:     corestatus.Executing,
+		Count:           1,
+	}}, nil)
+
+	info, err := s.service.GetApplicationStatus(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(info, jc.DeepEquals, ApplicationStatusInfo{
+		Status:  corestatus.Maintenance,
+		Message: "installing",
+		UnitCounts: map[corestatus.Status]int{
+			corestatus.Active:      3,
+			corestatus.Waiting:     2,
+			corestatus.Maintenance: 1,
+		},
+	})
+}
+
+func (s *applicationServiceSuite) TestGetApplicationStatusAgentError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitStatusCounts(gomock.Any(), "foo").Return([]application.UnitStatusCount{{
+		WorkloadStatus:  corestatus.Blocked,
+		WorkloadMessage: "needs relation",
+		AgentStatus:     corestatus.Idle,
+		Count:           1,
+	}, {
+		WorkloadStatus:  corestatus.Active,
+		WorkloadMessage: "ready",
+		AgentStatus:     corestatus.Error,
+		AgentMessage:    `hook failed: "install"`,
+		Count:           2,
+	}}, nil)
+
+	info, err := s.service.GetApplicationStatus(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(info, jc.DeepEquals, ApplicationStatusInfo{
+		Status:  corestatus.Error,
+		Message: `hook failed: "install"`,
+		UnitCounts: map[corestatus.Status]int{
+			corestatus.Blocked: 1,
+			corestatus.Error:   2,
+		},
+	})
+}
+
+func (s *applicationServiceSuite) TestGetApplicationPlacementGroups(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	groups := []application.PlacementGroup{{
+		MachineUUID: "machine-uuid-0",
+		MachineName: "0",
+		UnitNames:   []coreunit.Name{"foo/0", "foo/2"},
+	}, {
+		MachineUUID: "machine-uuid-1",
+		MachineName: "1",
+		UnitNames:   []coreunit.Name{"foo/1"},
+	}}
+	s.state.EXPECT().GetApplicationPlacementGroups(gomock.Any(), "foo").Return(groups, nil)
+
+	result, err := s.service.GetApplicationPlacementGroups(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, groups)
+	c.Check(result[0].IsColocated(), jc.IsTrue)
+	c.Check(result[1].IsColocated(), jc.IsFalse)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationPlacementGroupsNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationPlacementGroups(gomock.Any(), "foo").Return(nil, applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationPlacementGroups(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationRelationSummary(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	summary := []application.RelationSummary{{
+		UUID:                   "rel-uuid",
+		ID:                     1,
+		Endpoint:               "db",
+		RelatedApplicationName: "mysql",
+		RelatedEndpoint:        "server",
+		Status:                 corerelation.Joined,
+		InScopeUnitCount:       2,
+	}}
+	s.state.EXPECT().GetApplicationRelationSummary(gomock.Any(), "foo").Return(summary, nil)
+
+	result, err := s.service.GetApplicationRelationSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, summary)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationRelationSummaryNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationRelationSummary(gomock.Any(), "foo").Return(nil, applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationRelationSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationRelationSummaryInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetApplicationRelationSummary(context.Background(), "!!!")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *applicationServiceSuite) TestListApplicationsWithRelationErrors(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	relErrors := []application.ApplicationRelationError{{
+		ApplicationName:        "foo",
+		RelationUUID:           "rel-uuid",
+		RelatedApplicationName: "mysql",
+		Endpoint:               "db",
+		Message:                "hook failed",
+	}}
+	s.state.EXPECT().ListApplicationsWithRelationErrors(gomock.Any()).Return(relErrors, nil)
+
+	result, err := s.service.ListApplicationsWithRelationErrors(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, relErrors)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationConstraintsForMachineProvisioning(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	details := application.MachineProvisioningConstraints{
+		Constraints: constraints.MustParse("arch=arm64"),
+		StorageDirectives: []application.StorageDirective{{
+			Name:  "data",
+			Pool:  "rootfs",
+			Size:  1024,
+			Count: 1,
+		}},
+		EndpointBindings: map[string]string{
+			"db": "alpha",
+		},
+	}
+	s.state.EXPECT().GetMachineProvisioningConstraints(gomock.Any(), coreunit.Name("foo/0")).
+		Return(details, []byte(`{"config": {"security.nesting": "true"}}`), nil)
+
+	result, err := s.service.GetApplicationConstraintsForMachineProvisioning(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	details.LXDProfile = charm.LXDProfile{
+		Config: map[string]string{
+			"security.nesting": "true",
+		},
+	}
+	c.Check(result, jc.DeepEquals, details)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationConstraintsForMachineProvisioningUnitNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineProvisioningConstraints(gomock.Any(), coreunit.Name("foo/0")).
+		Return(application.MachineProvisioningConstraints{}, nil, applicationerrors.UnitNotFound)
+
+	_, err := s.service.GetApplicationConstraintsForMachineProvisioning(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationConstraintsForMachineProvisioningInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetApplicationConstraintsForMachineProvisioning(context.Background(), "!!!")
+	c.Assert(err, jc.ErrorIs, coreunit.InvalidUnitName)
+}
+
+func (s *applicationServiceSuite) TestGetApplicationStatusNoUnits(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitStatusCounts(gomock.Any(), "foo").Return(nil, nil)
+
+	info, err := s.service.GetApplicationStatus(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(info, jc.DeepEquals, ApplicationStatusInfo{
+		Status:     corestatus.Unknown,
+		UnitCounts: map[corestatus.Status]int{},
+	})
+}
+
+func (s *applicationServiceSuite) TestGetApplicationStatusNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitStatusCounts(gomock.Any(), "foo").Return(nil, applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationStatus(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *applicationServiceSuite) TestSetUnitPassword(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1282,6 +1557,58 @@ func (s *applicationServiceSuite) TestGetCharmModifiedVersion(c *gc.C) {
 	c.Check(obtained, gc.DeepEquals, 42)
 }
 
+func (s *applicationServiceSuite) TestSetApplicationsLatestCharmRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	revisions := map[string]int{"foo": 43, "bar": 7}
+	s.state.EXPECT().SetApplicationsLatestCharmRevision(gomock.Any(), revisions).Return(nil)
+
+	err := s.service.SetApplicationsLatestCharmRevision(context.Background(), revisions)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationServiceSuite) TestSetApplicationsLatestCharmRevisionInvalidName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{"!!!": 43})
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNameNotValid)
+}
+
+func (s *applicationServiceSuite) TestListApplicationsWithPendingCharmUpgrades(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := []application.ApplicationPendingUpgrade{{
+		Name:            "foo",
+		CurrentRevision: 42,
+		LatestRevision:  43,
+	}}
+	s.state.EXPECT().GetApplicationsWithPendingCharmUpgrades(gomock.Any(), nil).Return(expected, nil)
+
+	obtained, err := s.service.ListApplicationsWithPendingCharmUpgrades(context.Background(), "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, jc.DeepEquals, expected)
+}
+
+func (s *applicationServiceSuite) TestListApplicationsWithPendingCharmUpgradesChannel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationsWithPendingCharmUpgrades(gomock.Any(), &application.Channel{
+		Track: "2.0",
+		Risk:  application.RiskEdge,
+	}).Return(nil, nil)
+
+	obtained, err := s.service.ListApplicationsWithPendingCharmUpgrades(context.Background(), "2.0/edge")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(obtained, gc.HasLen, 0)
+}
+
+func (s *applicationServiceSuite) TestListApplicationsWithPendingCharmUpgradesInvalidChannel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.ListApplicationsWithPendingCharmUpgrades(context.Background(), "foo/bar/baz/qux")
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmChannelNotValid)
+}
+
 func (s *applicationServiceSuite) TestGetAsyncCharmDownloadInfo(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1794,3 +2121,85 @@ func (s *providerServiceSuite) TestGetSupportedFeaturesNotSupported(c *gc.C) {
 	})
 	c.Check(features, jc.DeepEquals, fs)
 }
+
+func (s *providerServiceSuite) TestGetApplicationConstraintsSummary(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	cons := constraints.MustParse("arch=arm64 instance-type=m1.large mem=2G spaces=alpha,beta,^gamma")
+	s.state.EXPECT().GetApplicationID(domaintesting.IsAtomicContextChecker, "foo").Return(appID, nil)
+	s.state.EXPECT().GetApplicationConstraints(domaintesting.IsAtomicContextChecker, appID).Return(cons, nil)
+	s.state.EXPECT().GetSpaceSubnetCounts(domaintesting.IsAtomicContextChecker, []string{"alpha", "beta", "gamma"}).Return(map[string]int{
+		"alpha": 2,
+		"beta":  0,
+		"gamma": 1,
+	}, nil)
+
+	validator := constraints.NewValidator()
+	validator.RegisterVocabulary(constraints.Arch, []string{"amd64"})
+	validator.RegisterUnsupported([]string{constraints.InstanceType})
+	s.provider.EXPECT().ConstraintsValidator(gomock.Any()).Return(validator, nil)
+
+	result, err := s.service.GetApplicationConstraintsSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Feasible(), jc.IsFalse)
+	c.Check(result.Checks, jc.DeepEquals, []application.ConstraintCheck{{
+		Name:  "arch",
+		Value: "arm64",
+		Reason: `invalid constraint value: arch=arm64
+valid values are: amd64`,
+	}, {
+		Name:   "instance-type",
+		Value:  "m1.large",
+		Reason: "instance-type is not supported by the provider",
+	}, {
+		Name:     "mem",
+		Value:    "2048M",
+		Feasible: true,
+	}, {
+		Name:     "spaces",
+		Value:    "alpha",
+		Feasible: true,
+	}, {
+		Name:   "spaces",
+		Value:  "beta",
+		Reason: `space "beta" has no subnets`,
+	}, {
+		Name:     "spaces",
+		Value:    "^gamma",
+		Feasible: true,
+	}})
+}
+
+func (s *providerServiceSuite) TestGetApplicationConstraintsSummaryApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationID(domaintesting.IsAtomicContextChecker, "foo").Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationConstraintsSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *providerServiceSuite) TestCheckConstraintsFeasibilityNotSupported(c *gc.C) {
+	ctrl := s.setupMocksWithProvider(c, func(ctx context.Context) (Provider, error) {
+		return s.provider, jujuerrors.NotSupported
+	})
+	defer ctrl.Finish()
+
+	s.state.EXPECT().RunAtomic(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx domain.AtomicContext) error) error {
+		return fn(domaintesting.NewAtomicContext(ctx))
+	})
+	s.state.EXPECT().GetSpaceSubnetCounts(domaintesting.IsAtomicContextChecker, []string{"alpha"}).Return(map[string]int{}, nil)
+
+	result, err := s.service.CheckConstraintsFeasibility(context.Background(), constraints.MustParse("arch=arm64 spaces=alpha"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.Checks, jc.DeepEquals, []application.ConstraintCheck{{
+		Name:     "arch",
+		Value:    "arm64",
+		Feasible: true,
+	}, {
+		Name:   "spaces",
+		Value:  "alpha",
+		Reason: `space "alpha" not found`,
+	}})
+}
@@ -477,6 +477,28 @@ func (s *Service) GetCharmConfig(ctx context.Context, id corecharm.ID) (internal
 	return decoded, nil
 }
 
+// GetCharmConfigSchemaJSON returns the config for the charm using the charm
+// ID, rendered as a JSON Schema document for use by external tooling.
+//
+// If the charm does not exist, a [applicationerrors.CharmNotFound] error is
+// returned.
+func (s *Service) GetCharmConfigSchemaJSON(ctx context.Context, id corecharm.ID) ([]byte, error) {
+	if err := id.Validate(); err != nil {
+		return nil, fmt.Errorf("charm id: %w", err)
+	}
+
+	config, err := s.st.GetCharmConfig(ctx, id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	schema, err := encodeConfigJSONSchema(config)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return schema, nil
+}
+
 // GetCharmLXDProfile returns the LXD profile along with the revision of the
 // charm using the charm ID. The revision
 //
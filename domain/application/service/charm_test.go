@@ -274,6 +274,48 @@ func (s *charmServiceSuite) TestGetCharmMetadataInvalidUUID(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, errors.NotValid)
 }
 
+func (s *charmServiceSuite) TestGetCharmConfigSchemaJSON(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetCharmConfig(gomock.Any(), id).Return(charm.Config{
+		Options: map[string]charm.Option{
+			"foo": {
+				Type:        charm.OptionInt,
+				Description: "the foo",
+				Default:     42,
+			},
+		},
+	}, nil)
+
+	schema, err := s.service.GetCharmConfigSchemaJSON(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(schema), jc.JSONEquals, map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    "object",
+		"properties": map[string]any{
+			"foo": map[string]any{
+				"type":        "integer",
+				"description": "the foo",
+				"default":     42,
+			},
+		},
+		"additionalProperties": false,
+	})
+}
+
+func (s *charmServiceSuite) TestGetCharmConfigSchemaJSONCharmNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := charmtesting.GenCharmID(c)
+
+	s.state.EXPECT().GetCharmConfig(gomock.Any(), id).Return(charm.Config{}, applicationerrors.CharmNotFound)
+
+	_, err := s.service.GetCharmConfigSchemaJSON(context.Background(), id)
+	c.Assert(err, jc.ErrorIs, applicationerrors.CharmNotFound)
+}
+
 func (s *charmServiceSuite) TestGetCharmLXDProfile(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -4,6 +4,7 @@
 package service
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/juju/juju/domain/application/charm"
@@ -107,3 +108,56 @@ func encodeOptionType(t string) (charm.OptionType, error) {
 		return "", fmt.Errorf("unknown option type %q", t)
 	}
 }
+
+// configJSONSchema is a JSON Schema document describing the config of a
+// charm.
+type configJSONSchema struct {
+	Schema               string                              `json:"$schema"`
+	Type                 string                              `json:"type"`
+	Properties           map[string]configJSONSchemaProperty `json:"properties"`
+	AdditionalProperties bool                                `json:"additionalProperties"`
+}
+
+// configJSONSchemaProperty describes a single config option in a JSON Schema
+// document.
+type configJSONSchemaProperty struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Default     any    `json:"default,omitempty"`
+}
+
+// encodeConfigJSONSchema renders the config as a JSON Schema document.
+func encodeConfigJSONSchema(config charm.Config) ([]byte, error) {
+	schema := configJSONSchema{
+		Schema:     "https://json-schema.org/draft/2020-12/schema",
+		Type:       "object",
+		Properties: make(map[string]configJSONSchemaProperty, len(config.Options)),
+	}
+	for name, option := range config.Options {
+		t, err := encodeJSONSchemaType(option.Type)
+		if err != nil {
+			return nil, fmt.Errorf("encode config option %q: %w", name, err)
+		}
+		schema.Properties[name] = configJSONSchemaProperty{
+			Type:        t,
+			Description: option.Description,
+			Default:     option.Default,
+		}
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func encodeJSONSchemaType(t charm.OptionType) (string, error) {
+	switch t {
+	case charm.OptionString, charm.OptionSecret:
+		return "string", nil
+	case charm.OptionInt:
+		return "integer", nil
+	case charm.OptionFloat:
+		return "number", nil
+	case charm.OptionBool:
+		return "boolean", nil
+	default:
+		return "", fmt.Errorf("unknown option type %q", t)
+	}
+}
@@ -105,3 +105,49 @@ func (s *metadataSuite) TestConvertConfig(c *gc.C) {
 		c.Check(converted, jc.DeepEquals, tc.input)
 	}
 }
+
+func (s *configSuite) TestEncodeConfigJSONSchema(c *gc.C) {
+	schema, err := encodeConfigJSONSchema(configTestCases[1].input)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(string(schema), jc.JSONEquals, map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type":    "object",
+		"properties": map[string]any{
+			"key-string": map[string]any{
+				"type":        "string",
+				"description": "description-string",
+				"default":     "default-string",
+			},
+			"key-int": map[string]any{
+				"type":        "integer",
+				"description": "description-int",
+				"default":     "default-int",
+			},
+			"key-float": map[string]any{
+				"type":        "number",
+				"description": "description-float",
+				"default":     "default-float",
+			},
+			"key-bool": map[string]any{
+				"type":        "boolean",
+				"description": "description-bool",
+				"default":     "default-bool",
+			},
+			"key-secret": map[string]any{
+				"type":        "string",
+				"description": "description-secret",
+				"default":     "default-secret",
+			},
+		},
+		"additionalProperties": false,
+	})
+}
+
+func (s *configSuite) TestEncodeConfigJSONSchemaUnknownType(c *gc.C) {
+	_, err := encodeConfigJSONSchema(charm.Config{
+		Options: map[string]charm.Option{
+			"foo": {Type: "blob"},
+		},
+	})
+	c.Assert(err, gc.ErrorMatches, `encode config option "foo": unknown option type "blob"`)
+}
@@ -13,11 +13,13 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	application "github.com/juju/juju/core/application"
 	assumes "github.com/juju/juju/core/assumes"
 	changestream "github.com/juju/juju/core/changestream"
 	charm "github.com/juju/juju/core/charm"
+	constraints "github.com/juju/juju/core/constraints"
 	model "github.com/juju/juju/core/model"
 	network "github.com/juju/juju/core/network"
 	objectstore "github.com/juju/juju/core/objectstore"
@@ -30,6 +32,7 @@ import (
 	charm0 "github.com/juju/juju/domain/application/charm"
 	life "github.com/juju/juju/domain/life"
 	storage "github.com/juju/juju/domain/storage"
+	envcontext "github.com/juju/juju/environs/envcontext"
 	version "github.com/juju/version/v2"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -254,6 +257,45 @@ func (c *MockStateDeleteUnitCall) DoAndReturn(f func(domain.AtomicContext, unit.
 	return c
 }
 
+// GetApplicationConstraints mocks base method.
+func (m *MockState) GetApplicationConstraints(arg0 domain.AtomicContext, arg1 application.ID) (constraints.Value, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationConstraints", arg0, arg1)
+	ret0, _ := ret[0].(constraints.Value)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationConstraints indicates an expected call of GetApplicationConstraints.
+func (mr *MockStateMockRecorder) GetApplicationConstraints(arg0, arg1 any) *MockStateGetApplicationConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationConstraints", reflect.TypeOf((*MockState)(nil).GetApplicationConstraints), arg0, arg1)
+	return &MockStateGetApplicationConstraintsCall{Call: call}
+}
+
+// MockStateGetApplicationConstraintsCall wrap *gomock.Call
+type MockStateGetApplicationConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationConstraintsCall) Return(arg0 constraints.Value, arg1 error) *MockStateGetApplicationConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationConstraintsCall) Do(f func(domain.AtomicContext, application.ID) (constraints.Value, error)) *MockStateGetApplicationConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationConstraintsCall) DoAndReturn(f func(domain.AtomicContext, application.ID) (constraints.Value, error)) *MockStateGetApplicationConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationID mocks base method.
 func (m *MockState) GetApplicationID(arg0 domain.AtomicContext, arg1 string) (application.ID, error) {
 	m.ctrl.T.Helper()
@@ -372,6 +414,123 @@ func (c *MockStateGetApplicationLifeCall) DoAndReturn(f func(domain.AtomicContex
 	return c
 }
 
+// GetApplicationPlacementGroups mocks base method.
+func (m *MockState) GetApplicationPlacementGroups(arg0 context.Context, arg1 string) ([]application0.PlacementGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationPlacementGroups", arg0, arg1)
+	ret0, _ := ret[0].([]application0.PlacementGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationPlacementGroups indicates an expected call of GetApplicationPlacementGroups.
+func (mr *MockStateMockRecorder) GetApplicationPlacementGroups(arg0, arg1 any) *MockStateGetApplicationPlacementGroupsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationPlacementGroups", reflect.TypeOf((*MockState)(nil).GetApplicationPlacementGroups), arg0, arg1)
+	return &MockStateGetApplicationPlacementGroupsCall{Call: call}
+}
+
+// MockStateGetApplicationPlacementGroupsCall wrap *gomock.Call
+type MockStateGetApplicationPlacementGroupsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationPlacementGroupsCall) Return(arg0 []application0.PlacementGroup, arg1 error) *MockStateGetApplicationPlacementGroupsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationPlacementGroupsCall) Do(f func(context.Context, string) ([]application0.PlacementGroup, error)) *MockStateGetApplicationPlacementGroupsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationPlacementGroupsCall) DoAndReturn(f func(context.Context, string) ([]application0.PlacementGroup, error)) *MockStateGetApplicationPlacementGroupsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationRelationSummary mocks base method.
+func (m *MockState) GetApplicationRelationSummary(arg0 context.Context, arg1 string) ([]application0.RelationSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationRelationSummary", arg0, arg1)
+	ret0, _ := ret[0].([]application0.RelationSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationRelationSummary indicates an expected call of GetApplicationRelationSummary.
+func (mr *MockStateMockRecorder) GetApplicationRelationSummary(arg0, arg1 any) *MockStateGetApplicationRelationSummaryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationRelationSummary", reflect.TypeOf((*MockState)(nil).GetApplicationRelationSummary), arg0, arg1)
+	return &MockStateGetApplicationRelationSummaryCall{Call: call}
+}
+
+// MockStateGetApplicationRelationSummaryCall wrap *gomock.Call
+type MockStateGetApplicationRelationSummaryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationRelationSummaryCall) Return(arg0 []application0.RelationSummary, arg1 error) *MockStateGetApplicationRelationSummaryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationRelationSummaryCall) Do(f func(context.Context, string) ([]application0.RelationSummary, error)) *MockStateGetApplicationRelationSummaryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationRelationSummaryCall) DoAndReturn(f func(context.Context, string) ([]application0.RelationSummary, error)) *MockStateGetApplicationRelationSummaryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationScaleHistory mocks base method.
+func (m *MockState) GetApplicationScaleHistory(arg0 context.Context, arg1 string, arg2 int) ([]application0.ScaleTargetEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationScaleHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]application0.ScaleTargetEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationScaleHistory indicates an expected call of GetApplicationScaleHistory.
+func (mr *MockStateMockRecorder) GetApplicationScaleHistory(arg0, arg1, arg2 any) *MockStateGetApplicationScaleHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationScaleHistory", reflect.TypeOf((*MockState)(nil).GetApplicationScaleHistory), arg0, arg1, arg2)
+	return &MockStateGetApplicationScaleHistoryCall{Call: call}
+}
+
+// MockStateGetApplicationScaleHistoryCall wrap *gomock.Call
+type MockStateGetApplicationScaleHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationScaleHistoryCall) Return(arg0 []application0.ScaleTargetEntry, arg1 error) *MockStateGetApplicationScaleHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationScaleHistoryCall) Do(f func(context.Context, string, int) ([]application0.ScaleTargetEntry, error)) *MockStateGetApplicationScaleHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationScaleHistoryCall) DoAndReturn(f func(context.Context, string, int) ([]application0.ScaleTargetEntry, error)) *MockStateGetApplicationScaleHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationScaleState mocks base method.
 func (m *MockState) GetApplicationScaleState(arg0 domain.AtomicContext, arg1 application.ID) (application0.ScaleState, error) {
 	m.ctrl.T.Helper()
@@ -455,6 +614,84 @@ func (c *MockStateGetApplicationUnitLifeCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// GetApplicationUnitStatusCounts mocks base method.
+func (m *MockState) GetApplicationUnitStatusCounts(arg0 context.Context, arg1 string) ([]application0.UnitStatusCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationUnitStatusCounts", arg0, arg1)
+	ret0, _ := ret[0].([]application0.UnitStatusCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationUnitStatusCounts indicates an expected call of GetApplicationUnitStatusCounts.
+func (mr *MockStateMockRecorder) GetApplicationUnitStatusCounts(arg0, arg1 any) *MockStateGetApplicationUnitStatusCountsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationUnitStatusCounts", reflect.TypeOf((*MockState)(nil).GetApplicationUnitStatusCounts), arg0, arg1)
+	return &MockStateGetApplicationUnitStatusCountsCall{Call: call}
+}
+
+// MockStateGetApplicationUnitStatusCountsCall wrap *gomock.Call
+type MockStateGetApplicationUnitStatusCountsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationUnitStatusCountsCall) Return(arg0 []application0.UnitStatusCount, arg1 error) *MockStateGetApplicationUnitStatusCountsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationUnitStatusCountsCall) Do(f func(context.Context, string) ([]application0.UnitStatusCount, error)) *MockStateGetApplicationUnitStatusCountsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationUnitStatusCountsCall) DoAndReturn(f func(context.Context, string) ([]application0.UnitStatusCount, error)) *MockStateGetApplicationUnitStatusCountsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetApplicationsWithPendingCharmUpgrades mocks base method.
+func (m *MockState) GetApplicationsWithPendingCharmUpgrades(arg0 context.Context, arg1 *application0.Channel) ([]application0.ApplicationPendingUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationsWithPendingCharmUpgrades", arg0, arg1)
+	ret0, _ := ret[0].([]application0.ApplicationPendingUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationsWithPendingCharmUpgrades indicates an expected call of GetApplicationsWithPendingCharmUpgrades.
+func (mr *MockStateMockRecorder) GetApplicationsWithPendingCharmUpgrades(arg0, arg1 any) *MockStateGetApplicationsWithPendingCharmUpgradesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationsWithPendingCharmUpgrades", reflect.TypeOf((*MockState)(nil).GetApplicationsWithPendingCharmUpgrades), arg0, arg1)
+	return &MockStateGetApplicationsWithPendingCharmUpgradesCall{Call: call}
+}
+
+// MockStateGetApplicationsWithPendingCharmUpgradesCall wrap *gomock.Call
+type MockStateGetApplicationsWithPendingCharmUpgradesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationsWithPendingCharmUpgradesCall) Return(arg0 []application0.ApplicationPendingUpgrade, arg1 error) *MockStateGetApplicationsWithPendingCharmUpgradesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationsWithPendingCharmUpgradesCall) Do(f func(context.Context, *application0.Channel) ([]application0.ApplicationPendingUpgrade, error)) *MockStateGetApplicationsWithPendingCharmUpgradesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationsWithPendingCharmUpgradesCall) DoAndReturn(f func(context.Context, *application0.Channel) ([]application0.ApplicationPendingUpgrade, error)) *MockStateGetApplicationsWithPendingCharmUpgradesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationsWithPendingCharmsFromUUIDs mocks base method.
 func (m *MockState) GetApplicationsWithPendingCharmsFromUUIDs(arg0 context.Context, arg1 []application.ID) ([]application.ID, error) {
 	m.ctrl.T.Helper()
@@ -1238,6 +1475,85 @@ func (c *MockStateGetCharmModifiedVersionCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// GetLatestApplicationScaleChanges mocks base method.
+func (m *MockState) GetLatestApplicationScaleChanges(arg0 context.Context) (map[string]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestApplicationScaleChanges", arg0)
+	ret0, _ := ret[0].(map[string]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestApplicationScaleChanges indicates an expected call of GetLatestApplicationScaleChanges.
+func (mr *MockStateMockRecorder) GetLatestApplicationScaleChanges(arg0 any) *MockStateGetLatestApplicationScaleChangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestApplicationScaleChanges", reflect.TypeOf((*MockState)(nil).GetLatestApplicationScaleChanges), arg0)
+	return &MockStateGetLatestApplicationScaleChangesCall{Call: call}
+}
+
+// MockStateGetLatestApplicationScaleChangesCall wrap *gomock.Call
+type MockStateGetLatestApplicationScaleChangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetLatestApplicationScaleChangesCall) Return(arg0 map[string]time.Time, arg1 error) *MockStateGetLatestApplicationScaleChangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetLatestApplicationScaleChangesCall) Do(f func(context.Context) (map[string]time.Time, error)) *MockStateGetLatestApplicationScaleChangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetLatestApplicationScaleChangesCall) DoAndReturn(f func(context.Context) (map[string]time.Time, error)) *MockStateGetLatestApplicationScaleChangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineProvisioningConstraints mocks base method.
+func (m *MockState) GetMachineProvisioningConstraints(arg0 context.Context, arg1 unit.Name) (application0.MachineProvisioningConstraints, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineProvisioningConstraints", arg0, arg1)
+	ret0, _ := ret[0].(application0.MachineProvisioningConstraints)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMachineProvisioningConstraints indicates an expected call of GetMachineProvisioningConstraints.
+func (mr *MockStateMockRecorder) GetMachineProvisioningConstraints(arg0, arg1 any) *MockStateGetMachineProvisioningConstraintsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineProvisioningConstraints", reflect.TypeOf((*MockState)(nil).GetMachineProvisioningConstraints), arg0, arg1)
+	return &MockStateGetMachineProvisioningConstraintsCall{Call: call}
+}
+
+// MockStateGetMachineProvisioningConstraintsCall wrap *gomock.Call
+type MockStateGetMachineProvisioningConstraintsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineProvisioningConstraintsCall) Return(arg0 application0.MachineProvisioningConstraints, arg1 []byte, arg2 error) *MockStateGetMachineProvisioningConstraintsCall {
+	c.Call = c.Call.Return(arg0, arg1, arg2)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineProvisioningConstraintsCall) Do(f func(context.Context, unit.Name) (application0.MachineProvisioningConstraints, []byte, error)) *MockStateGetMachineProvisioningConstraintsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineProvisioningConstraintsCall) DoAndReturn(f func(context.Context, unit.Name) (application0.MachineProvisioningConstraints, []byte, error)) *MockStateGetMachineProvisioningConstraintsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetModelType mocks base method.
 func (m *MockState) GetModelType(arg0 context.Context) (model.ModelType, error) {
 	m.ctrl.T.Helper()
@@ -1355,6 +1671,45 @@ func (c *MockStateGetSecretsForUnitCall) DoAndReturn(f func(domain.AtomicContext
 	return c
 }
 
+// GetSpaceSubnetCounts mocks base method.
+func (m *MockState) GetSpaceSubnetCounts(arg0 domain.AtomicContext, arg1 []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpaceSubnetCounts", arg0, arg1)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpaceSubnetCounts indicates an expected call of GetSpaceSubnetCounts.
+func (mr *MockStateMockRecorder) GetSpaceSubnetCounts(arg0, arg1 any) *MockStateGetSpaceSubnetCountsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpaceSubnetCounts", reflect.TypeOf((*MockState)(nil).GetSpaceSubnetCounts), arg0, arg1)
+	return &MockStateGetSpaceSubnetCountsCall{Call: call}
+}
+
+// MockStateGetSpaceSubnetCountsCall wrap *gomock.Call
+type MockStateGetSpaceSubnetCountsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSpaceSubnetCountsCall) Return(arg0 map[string]int, arg1 error) *MockStateGetSpaceSubnetCountsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSpaceSubnetCountsCall) Do(f func(domain.AtomicContext, []string) (map[string]int, error)) *MockStateGetSpaceSubnetCountsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSpaceSubnetCountsCall) DoAndReturn(f func(domain.AtomicContext, []string) (map[string]int, error)) *MockStateGetSpaceSubnetCountsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetStoragePoolByName mocks base method.
 func (m *MockState) GetStoragePoolByName(arg0 context.Context, arg1 string) (storage.StoragePoolDetails, error) {
 	m.ctrl.T.Helper()
@@ -1472,6 +1827,84 @@ func (c *MockStateGetUnitNamesCall) DoAndReturn(f func(context.Context, []unit.U
 	return c
 }
 
+// GetUnitPrincipalChainNames mocks base method.
+func (m *MockState) GetUnitPrincipalChainNames(arg0 domain.AtomicContext, arg1 unit.Name) ([]unit.Name, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitPrincipalChainNames", arg0, arg1)
+	ret0, _ := ret[0].([]unit.Name)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitPrincipalChainNames indicates an expected call of GetUnitPrincipalChainNames.
+func (mr *MockStateMockRecorder) GetUnitPrincipalChainNames(arg0, arg1 any) *MockStateGetUnitPrincipalChainNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitPrincipalChainNames", reflect.TypeOf((*MockState)(nil).GetUnitPrincipalChainNames), arg0, arg1)
+	return &MockStateGetUnitPrincipalChainNamesCall{Call: call}
+}
+
+// MockStateGetUnitPrincipalChainNamesCall wrap *gomock.Call
+type MockStateGetUnitPrincipalChainNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnitPrincipalChainNamesCall) Return(arg0 []unit.Name, arg1 error) *MockStateGetUnitPrincipalChainNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnitPrincipalChainNamesCall) Do(f func(domain.AtomicContext, unit.Name) ([]unit.Name, error)) *MockStateGetUnitPrincipalChainNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnitPrincipalChainNamesCall) DoAndReturn(f func(domain.AtomicContext, unit.Name) ([]unit.Name, error)) *MockStateGetUnitPrincipalChainNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetUnitSubordinateTree mocks base method.
+func (m *MockState) GetUnitSubordinateTree(arg0 domain.AtomicContext, arg1 unit.Name) (application0.UnitTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnitSubordinateTree", arg0, arg1)
+	ret0, _ := ret[0].(application0.UnitTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnitSubordinateTree indicates an expected call of GetUnitSubordinateTree.
+func (mr *MockStateMockRecorder) GetUnitSubordinateTree(arg0, arg1 any) *MockStateGetUnitSubordinateTreeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitSubordinateTree", reflect.TypeOf((*MockState)(nil).GetUnitSubordinateTree), arg0, arg1)
+	return &MockStateGetUnitSubordinateTreeCall{Call: call}
+}
+
+// MockStateGetUnitSubordinateTreeCall wrap *gomock.Call
+type MockStateGetUnitSubordinateTreeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnitSubordinateTreeCall) Return(arg0 application0.UnitTree, arg1 error) *MockStateGetUnitSubordinateTreeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnitSubordinateTreeCall) Do(f func(domain.AtomicContext, unit.Name) (application0.UnitTree, error)) *MockStateGetUnitSubordinateTreeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnitSubordinateTreeCall) DoAndReturn(f func(domain.AtomicContext, unit.Name) (application0.UnitTree, error)) *MockStateGetUnitSubordinateTreeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetUnitUUID mocks base method.
 func (m *MockState) GetUnitUUID(arg0 domain.AtomicContext, arg1 unit.Name) (unit.UUID, error) {
 	m.ctrl.T.Helper()
@@ -1783,6 +2216,45 @@ func (c *MockStateIsSubordinateCharmCall) DoAndReturn(f func(context.Context, ch
 	return c
 }
 
+// ListApplicationsWithRelationErrors mocks base method.
+func (m *MockState) ListApplicationsWithRelationErrors(arg0 context.Context) ([]application0.ApplicationRelationError, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplicationsWithRelationErrors", arg0)
+	ret0, _ := ret[0].([]application0.ApplicationRelationError)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplicationsWithRelationErrors indicates an expected call of ListApplicationsWithRelationErrors.
+func (mr *MockStateMockRecorder) ListApplicationsWithRelationErrors(arg0 any) *MockStateListApplicationsWithRelationErrorsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplicationsWithRelationErrors", reflect.TypeOf((*MockState)(nil).ListApplicationsWithRelationErrors), arg0)
+	return &MockStateListApplicationsWithRelationErrorsCall{Call: call}
+}
+
+// MockStateListApplicationsWithRelationErrorsCall wrap *gomock.Call
+type MockStateListApplicationsWithRelationErrorsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListApplicationsWithRelationErrorsCall) Return(arg0 []application0.ApplicationRelationError, arg1 error) *MockStateListApplicationsWithRelationErrorsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListApplicationsWithRelationErrorsCall) Do(f func(context.Context) ([]application0.ApplicationRelationError, error)) *MockStateListApplicationsWithRelationErrorsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListApplicationsWithRelationErrorsCall) DoAndReturn(f func(context.Context) ([]application0.ApplicationRelationError, error)) *MockStateListApplicationsWithRelationErrorsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmLocators mocks base method.
 func (m *MockState) ListCharmLocators(arg0 context.Context) ([]charm0.CharmLocator, error) {
 	m.ctrl.T.Helper()
@@ -1861,6 +2333,45 @@ func (c *MockStateListCharmLocatorsByNamesCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// ListUnitsInAgentError mocks base method.
+func (m *MockState) ListUnitsInAgentError(arg0 context.Context) ([]application0.PendingHookRetryInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnitsInAgentError", arg0)
+	ret0, _ := ret[0].([]application0.PendingHookRetryInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnitsInAgentError indicates an expected call of ListUnitsInAgentError.
+func (mr *MockStateMockRecorder) ListUnitsInAgentError(arg0 any) *MockStateListUnitsInAgentErrorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnitsInAgentError", reflect.TypeOf((*MockState)(nil).ListUnitsInAgentError), arg0)
+	return &MockStateListUnitsInAgentErrorCall{Call: call}
+}
+
+// MockStateListUnitsInAgentErrorCall wrap *gomock.Call
+type MockStateListUnitsInAgentErrorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListUnitsInAgentErrorCall) Return(arg0 []application0.PendingHookRetryInfo, arg1 error) *MockStateListUnitsInAgentErrorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListUnitsInAgentErrorCall) Do(f func(context.Context) ([]application0.PendingHookRetryInfo, error)) *MockStateListUnitsInAgentErrorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListUnitsInAgentErrorCall) DoAndReturn(f func(context.Context) ([]application0.PendingHookRetryInfo, error)) *MockStateListUnitsInAgentErrorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ResolveCharmDownload mocks base method.
 func (m *MockState) ResolveCharmDownload(arg0 context.Context, arg1 charm.ID, arg2 application0.ResolvedCharmDownload) error {
 	m.ctrl.T.Helper()
@@ -2013,6 +2524,44 @@ func (c *MockStateSetApplicationScalingStateCall) DoAndReturn(f func(domain.Atom
 	return c
 }
 
+// SetApplicationsLatestCharmRevision mocks base method.
+func (m *MockState) SetApplicationsLatestCharmRevision(arg0 context.Context, arg1 map[string]int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationsLatestCharmRevision", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetApplicationsLatestCharmRevision indicates an expected call of SetApplicationsLatestCharmRevision.
+func (mr *MockStateMockRecorder) SetApplicationsLatestCharmRevision(arg0, arg1 any) *MockStateSetApplicationsLatestCharmRevisionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationsLatestCharmRevision", reflect.TypeOf((*MockState)(nil).SetApplicationsLatestCharmRevision), arg0, arg1)
+	return &MockStateSetApplicationsLatestCharmRevisionCall{Call: call}
+}
+
+// MockStateSetApplicationsLatestCharmRevisionCall wrap *gomock.Call
+type MockStateSetApplicationsLatestCharmRevisionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationsLatestCharmRevisionCall) Return(arg0 error) *MockStateSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationsLatestCharmRevisionCall) Do(f func(context.Context, map[string]int) error) *MockStateSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationsLatestCharmRevisionCall) DoAndReturn(f func(context.Context, map[string]int) error) *MockStateSetApplicationsLatestCharmRevisionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetCharm mocks base method.
 func (m *MockState) SetCharm(arg0 context.Context, arg1 charm0.Charm, arg2 *charm0.DownloadInfo) (charm.ID, error) {
 	m.ctrl.T.Helper()
@@ -2129,17 +2678,17 @@ func (c *MockStateSetCloudContainerStatusCall) DoAndReturn(f func(domain.AtomicC
 }
 
 // SetDesiredApplicationScale mocks base method.
-func (m *MockState) SetDesiredApplicationScale(arg0 domain.AtomicContext, arg1 application.ID, arg2 int) error {
+func (m *MockState) SetDesiredApplicationScale(arg0 domain.AtomicContext, arg1 application.ID, arg2 int, arg3 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetDesiredApplicationScale", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SetDesiredApplicationScale", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetDesiredApplicationScale indicates an expected call of SetDesiredApplicationScale.
-func (mr *MockStateMockRecorder) SetDesiredApplicationScale(arg0, arg1, arg2 any) *MockStateSetDesiredApplicationScaleCall {
+func (mr *MockStateMockRecorder) SetDesiredApplicationScale(arg0, arg1, arg2, arg3 any) *MockStateSetDesiredApplicationScaleCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDesiredApplicationScale", reflect.TypeOf((*MockState)(nil).SetDesiredApplicationScale), arg0, arg1, arg2)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDesiredApplicationScale", reflect.TypeOf((*MockState)(nil).SetDesiredApplicationScale), arg0, arg1, arg2, arg3)
 	return &MockStateSetDesiredApplicationScaleCall{Call: call}
 }
 
@@ -2155,13 +2704,13 @@ func (c *MockStateSetDesiredApplicationScaleCall) Return(arg0 error) *MockStateS
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateSetDesiredApplicationScaleCall) Do(f func(domain.AtomicContext, application.ID, int) error) *MockStateSetDesiredApplicationScaleCall {
+func (c *MockStateSetDesiredApplicationScaleCall) Do(f func(domain.AtomicContext, application.ID, int, string) error) *MockStateSetDesiredApplicationScaleCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateSetDesiredApplicationScaleCall) DoAndReturn(f func(domain.AtomicContext, application.ID, int) error) *MockStateSetDesiredApplicationScaleCall {
+func (c *MockStateSetDesiredApplicationScaleCall) DoAndReturn(f func(domain.AtomicContext, application.ID, int, string) error) *MockStateSetDesiredApplicationScaleCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -2318,6 +2867,44 @@ func (c *MockStateSetUnitWorkloadStatusCall) DoAndReturn(f func(domain.AtomicCon
 	return c
 }
 
+// SetUnitsResolveMode mocks base method.
+func (m *MockState) SetUnitsResolveMode(arg0 domain.AtomicContext, arg1 []unit.UUID, arg2 application0.ResolveMode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUnitsResolveMode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUnitsResolveMode indicates an expected call of SetUnitsResolveMode.
+func (mr *MockStateMockRecorder) SetUnitsResolveMode(arg0, arg1, arg2 any) *MockStateSetUnitsResolveModeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUnitsResolveMode", reflect.TypeOf((*MockState)(nil).SetUnitsResolveMode), arg0, arg1, arg2)
+	return &MockStateSetUnitsResolveModeCall{Call: call}
+}
+
+// MockStateSetUnitsResolveModeCall wrap *gomock.Call
+type MockStateSetUnitsResolveModeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetUnitsResolveModeCall) Return(arg0 error) *MockStateSetUnitsResolveModeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetUnitsResolveModeCall) Do(f func(domain.AtomicContext, []unit.UUID, application0.ResolveMode) error) *MockStateSetUnitsResolveModeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetUnitsResolveModeCall) DoAndReturn(f func(domain.AtomicContext, []unit.UUID, application0.ResolveMode) error) *MockStateSetUnitsResolveModeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // StorageDefaults mocks base method.
 func (m *MockState) StorageDefaults(arg0 context.Context) (storage.StorageDefaults, error) {
 	m.ctrl.T.Helper()
@@ -2797,6 +3384,45 @@ func (m *MockProvider) EXPECT() *MockProviderMockRecorder {
 	return m.recorder
 }
 
+// ConstraintsValidator mocks base method.
+func (m *MockProvider) ConstraintsValidator(arg0 envcontext.ProviderCallContext) (constraints.Validator, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConstraintsValidator", arg0)
+	ret0, _ := ret[0].(constraints.Validator)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConstraintsValidator indicates an expected call of ConstraintsValidator.
+func (mr *MockProviderMockRecorder) ConstraintsValidator(arg0 any) *MockProviderConstraintsValidatorCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstraintsValidator", reflect.TypeOf((*MockProvider)(nil).ConstraintsValidator), arg0)
+	return &MockProviderConstraintsValidatorCall{Call: call}
+}
+
+// MockProviderConstraintsValidatorCall wrap *gomock.Call
+type MockProviderConstraintsValidatorCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockProviderConstraintsValidatorCall) Return(arg0 constraints.Validator, arg1 error) *MockProviderConstraintsValidatorCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockProviderConstraintsValidatorCall) Do(f func(envcontext.ProviderCallContext) (constraints.Validator, error)) *MockProviderConstraintsValidatorCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockProviderConstraintsValidatorCall) DoAndReturn(f func(envcontext.ProviderCallContext) (constraints.Validator, error)) *MockProviderConstraintsValidatorCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SupportedFeatures mocks base method.
 func (m *MockProvider) SupportedFeatures() (assumes.FeatureSet, error) {
 	m.ctrl.T.Helper()
@@ -112,6 +112,18 @@ type StatusParams struct {
 	Since   *time.Time
 }
 
+// ApplicationStatusInfo holds the status of an application derived from the
+// statuses of its units.
+type ApplicationStatusInfo struct {
+	// Status is the status of the application's worst unit.
+	Status corestatus.Status
+	// Message is the status message of the application's worst unit.
+	Message string
+	// UnitCounts holds the number of the application's units with each
+	// status.
+	UnitCounts map[corestatus.Status]int
+}
+
 // UpdateCAASUnitParams contains parameters for updating a CAAS unit.
 type UpdateCAASUnitParams struct {
 	ProviderId           *string
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/juju/clock"
 	"github.com/juju/collections/transform"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/core/assumes"
 	"github.com/juju/juju/core/changestream"
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
@@ -35,6 +37,7 @@ import (
 	"github.com/juju/juju/domain/life"
 	domainstorage "github.com/juju/juju/domain/storage"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/envcontext"
 	internalcharm "github.com/juju/juju/internal/charm"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/storage"
@@ -98,6 +101,7 @@ type AgentVersionGetter interface {
 // provider.
 type Provider interface {
 	environs.SupportedFeatureEnumerator
+	environs.ConstraintsChecker
 }
 
 // ProviderService defines a service for interacting with the underlying
@@ -171,6 +175,133 @@ func (s *ProviderService) GetSupportedFeatures(ctx context.Context) (assumes.Fea
 	return fs, nil
 }
 
+// GetApplicationConstraintsSummary checks each constraint set on the
+// specified application against the model, reporting whether it can be
+// satisfied. It returns an error satisfying
+// [applicationerrors.ApplicationNotFound] if the application is not found.
+func (s *ProviderService) GetApplicationConstraintsSummary(ctx context.Context, appName string) (application.ConstraintsFeasibility, error) {
+	if !isValidApplicationName(appName) {
+		return application.ConstraintsFeasibility{}, applicationerrors.ApplicationNameNotValid
+	}
+
+	var cons constraints.Value
+	err := s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		appID, err := s.st.GetApplicationID(ctx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		cons, err = s.st.GetApplicationConstraints(ctx, appID)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return application.ConstraintsFeasibility{}, errors.Annotatef(err, "getting constraints of application %q", appName)
+	}
+
+	result, err := s.CheckConstraintsFeasibility(ctx, cons)
+	return result, errors.Annotatef(err, "checking constraints of application %q", appName)
+}
+
+// CheckConstraintsFeasibility checks each constraint set in cons against the
+// model, reporting whether it can be satisfied. It allows constraints to be
+// checked before an application is deployed.
+//
+// The architecture, instance type, cores and memory constraints are checked
+// against the provider's constraints validator; if the provider cannot
+// validate constraints they are assumed to be feasible. Spaces are checked to
+// exist and, unless excluded, to contain at least one subnet.
+func (s *ProviderService) CheckConstraintsFeasibility(ctx context.Context, cons constraints.Value) (application.ConstraintsFeasibility, error) {
+	var validator constraints.Validator
+	provider, err := s.provider(ctx)
+	if err != nil && !errors.Is(err, errors.NotSupported) {
+		return application.ConstraintsFeasibility{}, errors.Trace(err)
+	} else if err == nil {
+		validator, err = provider.ConstraintsValidator(envcontext.WithoutCredentialInvalidator(ctx))
+		if err != nil {
+			return application.ConstraintsFeasibility{}, errors.Annotate(err, "getting constraints validator")
+		}
+	}
+
+	var result application.ConstraintsFeasibility
+	for _, single := range []struct {
+		name  string
+		value constraints.Value
+	}{
+		{name: constraints.Arch, value: constraints.Value{Arch: cons.Arch}},
+		{name: constraints.InstanceType, value: constraints.Value{InstanceType: cons.InstanceType}},
+		{name: constraints.Cores, value: constraints.Value{CpuCores: cons.CpuCores}},
+		{name: constraints.Mem, value: constraints.Value{Mem: cons.Mem}},
+	} {
+		if single.value.String() == "" {
+			continue
+		}
+		result.Checks = append(result.Checks, checkConstraint(validator, single.name, single.value))
+	}
+
+	if !cons.HasSpaces() {
+		return result, nil
+	}
+
+	var counts map[string]int
+	err = s.st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		names := append(cons.IncludeSpaces(), cons.ExcludeSpaces()...)
+		counts, err = s.st.GetSpaceSubnetCounts(ctx, names)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return application.ConstraintsFeasibility{}, errors.Trace(err)
+	}
+	for _, space := range cons.IncludeSpaces() {
+		check := application.ConstraintCheck{
+			Name:     constraints.Spaces,
+			Value:    space,
+			Feasible: true,
+		}
+		if count, ok := counts[space]; !ok {
+			check.Feasible = false
+			check.Reason = fmt.Sprintf("space %q not found", space)
+		} else if count == 0 {
+			check.Feasible = false
+			check.Reason = fmt.Sprintf("space %q has no subnets", space)
+		}
+		result.Checks = append(result.Checks, check)
+	}
+	for _, space := range cons.ExcludeSpaces() {
+		check := application.ConstraintCheck{
+			Name:     constraints.Spaces,
+			Value:    "^" + space,
+			Feasible: true,
+		}
+		if _, ok := counts[space]; !ok {
+			check.Feasible = false
+			check.Reason = fmt.Sprintf("space %q not found", space)
+		}
+		result.Checks = append(result.Checks, check)
+	}
+	return result, nil
+}
+
+// checkConstraint checks a value holding a single constraint against the
+// validator. A nil validator accepts every constraint.
+func checkConstraint(validator constraints.Validator, name string, value constraints.Value) application.ConstraintCheck {
+	check := application.ConstraintCheck{
+		Name:     name,
+		Value:    strings.TrimPrefix(value.String(), name+"="),
+		Feasible: true,
+	}
+	if validator == nil {
+		return check
+	}
+	unsupported, err := validator.Validate(value)
+	if err != nil {
+		check.Feasible = false
+		check.Reason = err.Error()
+	} else if len(unsupported) > 0 {
+		check.Feasible = false
+		check.Reason = fmt.Sprintf("%s is not supported by the provider", name)
+	}
+	return check
+}
+
 // WatchableService provides the API for working with applications and the
 // ability to create watchers.
 type WatchableService struct {
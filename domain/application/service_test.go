@@ -330,6 +330,82 @@ func (s *serviceSuite) TestDeleteUnit(c *gc.C) {
 	c.Assert(gotSecretCount, gc.Equals, 0)
 }
 
+// TestDeleteUnitWithSubordinates asserts that deleting a principal unit
+// deletes all of its transitive subordinates with it.
+func (s *serviceSuite) TestDeleteUnitWithSubordinates(c *gc.C) {
+	s.createSubordinateChain(c)
+	s.setUnitsDead(c, "foo/0", "bar/0", "baz/0")
+
+	tree, err := s.svc.GetUnitSubordinateTree(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tree.SubordinatesLeafFirst(), jc.DeepEquals, []coreunit.Name{"baz/0", "bar/0"})
+
+	err = s.svc.DeleteUnit(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	var gotUnitCount, gotPrincipalCount int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx, "SELECT count(*) FROM unit").Scan(&gotUnitCount); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM unit_principal").Scan(&gotPrincipalCount)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(gotUnitCount, gc.Equals, 0)
+	c.Check(gotPrincipalCount, gc.Equals, 0)
+}
+
+func (s *serviceSuite) TestDeleteUnitWithAliveSubordinate(c *gc.C) {
+	s.createSubordinateChain(c)
+	s.setUnitsDead(c, "foo/0", "baz/0")
+
+	err := s.svc.DeleteUnit(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitHasSubordinates)
+
+	var gotUnitCount int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM unit").Scan(&gotUnitCount)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(gotUnitCount, gc.Equals, 3)
+}
+
+// createSubordinateChain creates the principal unit foo/0, its subordinate
+// bar/0 and the subordinate of that, baz/0.
+func (s *serviceSuite) createSubordinateChain(c *gc.C) {
+	s.createApplication(c, "foo", service.AddUnitArg{UnitName: "foo/0"})
+	s.createApplication(c, "bar", service.AddUnitArg{UnitName: "bar/0"})
+	s.createApplication(c, "baz", service.AddUnitArg{UnitName: "baz/0"})
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for unitName, principalName := range map[string]string{
+			"bar/0": "foo/0",
+			"baz/0": "bar/0",
+		} {
+			_, err := tx.ExecContext(ctx, `
+INSERT INTO unit_principal (unit_uuid, principal_uuid)
+SELECT u.uuid, p.uuid FROM unit u, unit p WHERE u.name = ? AND p.name = ?`, unitName, principalName)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) setUnitsDead(c *gc.C, unitNames ...string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, unitName := range unitNames {
+			if _, err := tx.ExecContext(ctx, "UPDATE unit SET life_id = 2 WHERE name = ?", unitName); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestDeleteUnitNotFound(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -375,6 +451,46 @@ func (s *serviceSuite) TestRemoveUnit(c *gc.C) {
 	c.Assert(gotCount, gc.Equals, 0)
 }
 
+// TestRemoveUnitWithSubordinates asserts that removing a principal unit
+// revokes the leadership of each of the removed subordinates.
+func (s *serviceSuite) TestRemoveUnitWithSubordinates(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.createSubordinateChain(c)
+	s.setUnitsDead(c, "foo/0", "bar/0", "baz/0")
+
+	revoker := application.NewMockRevoker(ctrl)
+	gomock.InOrder(
+		revoker.EXPECT().RevokeLeadership("baz", coreunit.Name("baz/0")),
+		revoker.EXPECT().RevokeLeadership("bar", coreunit.Name("bar/0")),
+		revoker.EXPECT().RevokeLeadership("foo", coreunit.Name("foo/0")),
+	)
+
+	err := s.svc.RemoveUnit(context.Background(), "foo/0", revoker)
+	c.Assert(err, jc.ErrorIsNil)
+
+	var gotUnitCount int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM unit").Scan(&gotUnitCount)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(gotUnitCount, gc.Equals, 0)
+}
+
+func (s *serviceSuite) TestRemoveUnitWithAliveSubordinate(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	s.createSubordinateChain(c)
+	s.setUnitsDead(c, "foo/0")
+
+	revoker := application.NewMockRevoker(ctrl)
+
+	err := s.svc.RemoveUnit(context.Background(), "foo/0", revoker)
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitHasSubordinates)
+}
+
 func (s *serviceSuite) TestRemoveUnitStillAlive(c *gc.C) {
 	ctrl := gomock.NewController(c)
 	defer ctrl.Finish()
@@ -681,7 +797,7 @@ func (s *serviceSuite) TestGetScalingState(c *gc.C) {
 func (s *serviceSuite) TestSetScale(c *gc.C) {
 	appID := s.createApplication(c, "foo")
 
-	err := s.svc.SetApplicationScale(context.Background(), "foo", 666)
+	err := s.svc.SetApplicationScale(context.Background(), "foo", 666, "user-admin")
 	c.Assert(err, jc.ErrorIsNil)
 
 	var gotScale int
@@ -700,7 +816,7 @@ func (s *serviceSuite) TestSetScale(c *gc.C) {
 func (s *serviceSuite) TestGetScale(c *gc.C) {
 	s.createApplication(c, "foo")
 
-	err := s.svc.SetApplicationScale(context.Background(), "foo", 666)
+	err := s.svc.SetApplicationScale(context.Background(), "foo", 666, "user-admin")
 	c.Assert(err, jc.ErrorIsNil)
 
 	got, err := s.svc.GetApplicationScale(context.Background(), "foo")
@@ -708,13 +824,35 @@ func (s *serviceSuite) TestGetScale(c *gc.C) {
 	c.Assert(got, gc.Equals, 666)
 }
 
+func (s *serviceSuite) TestGetScaleHistory(c *gc.C) {
+	u := service.AddUnitArg{
+		UnitName: "foo/1",
+	}
+	s.createApplication(c, "foo", u)
+
+	err := s.svc.SetApplicationScale(context.Background(), "foo", 3, "user-admin")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.svc.ChangeApplicationScale(context.Background(), "foo", -1, "user-bob")
+	c.Assert(err, jc.ErrorIsNil)
+
+	history, err := s.svc.GetApplicationScaleHistory(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 2)
+	c.Check(history[0].Actor, gc.Equals, "user-bob")
+	c.Check(history[0].OldScale, gc.Equals, 3)
+	c.Check(history[0].NewScale, gc.Equals, 2)
+	c.Check(history[1].Actor, gc.Equals, "user-admin")
+	c.Check(history[1].OldScale, gc.Equals, 1)
+	c.Check(history[1].NewScale, gc.Equals, 3)
+}
+
 func (s *serviceSuite) TestChangeScale(c *gc.C) {
 	u := service.AddUnitArg{
 		UnitName: "foo/1",
 	}
 	appID := s.createApplication(c, "foo", u)
 
-	newScale, err := s.svc.ChangeApplicationScale(context.Background(), "foo", 2)
+	newScale, err := s.svc.ChangeApplicationScale(context.Background(), "foo", 2, "user-admin")
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(newScale, gc.Equals, 3)
 
@@ -736,7 +874,7 @@ func (s *serviceSuite) TestChangeScaleInvalid(c *gc.C) {
 	}
 	s.createApplication(c, "foo", u)
 
-	_, err := s.svc.ChangeApplicationScale(context.Background(), "foo", -2)
+	_, err := s.svc.ChangeApplicationScale(context.Background(), "foo", -2, "user-admin")
 	c.Assert(err, jc.ErrorIs, applicationerrors.ScaleChangeInvalid)
 }
 
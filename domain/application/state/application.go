@@ -7,6 +7,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/transform"
@@ -14,10 +16,13 @@ import (
 
 	coreapplication "github.com/juju/juju/core/application"
 	corecharm "github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/database"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
 	coresecrets "github.com/juju/juju/core/secrets"
+	corestatus "github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/domain"
@@ -320,8 +325,10 @@ func (st *State) deleteSimpleApplicationReferences(ctx context.Context, tx *sqla
 
 	for _, table := range []string{
 		"application_channel",
+		"application_latest_charm_revision",
 		"application_platform",
 		"application_scale",
+		"scale_target_history",
 		"application_config",
 		"application_constraint",
 		"application_setting",
@@ -380,6 +387,24 @@ func (st *State) GetUnitUUID(ctx domain.AtomicContext, unitName coreunit.Name) (
 	return unit.UnitUUID, errors.Trace(err)
 }
 
+// getUnitUUIDByName returns the UUID for the named unit within the given
+// transaction, returning an error satisfying [applicationerrors.UnitNotFound]
+// if the unit doesn't exist.
+func (st *State) getUnitUUIDByName(ctx context.Context, tx *sqlair.TX, unitName coreunit.Name) (coreunit.UUID, error) {
+	unit := unitNameAndUUID{Name: unitName}
+	stmt, err := st.Prepare(`SELECT &unitNameAndUUID.uuid FROM unit WHERE name = $unitNameAndUUID.name`, unit)
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	err = tx.Query(ctx, stmt, unit).Get(&unit)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return "", fmt.Errorf("unit %q not found%w", unitName, errors.Hide(applicationerrors.UnitNotFound))
+	} else if err != nil {
+		return "", fmt.Errorf("querying unit %q: %w", unitName, err)
+	}
+	return unit.UnitUUID, nil
+}
+
 // GetUnitUUIDs returns the UUIDs for the named units in bulk, returning an error
 // satisfying [applicationerrors.UnitNotFound] if any of the units don't exist.
 func (st *State) GetUnitUUIDs(ctx context.Context, names []coreunit.Name) ([]coreunit.UUID, error) {
@@ -748,12 +773,23 @@ ON CONFLICT(uuid) DO UPDATE SET
     type_id = excluded.type_id,
     scope_id = excluded.scope_id,
     origin_id = excluded.origin_id,
-    config_type_id = excluded.config_type_id
+    config_type_id = excluded.config_type_id,
+    subnet_uuid = excluded.subnet_uuid
 `, ipAddr)
 	if err != nil {
 		return errors.Trace(err)
 	}
 
+	// Record the subnet the address is in, so that the address can be
+	// resolved to a space.
+	subnetUUID, err := st.lookupAddressSubnet(ctx, tx, address.Value)
+	if err != nil {
+		return errors.Annotatef(err, "looking up subnet for cloud container address %q", address.Value)
+	}
+	if subnetUUID != "" {
+		ipAddr.SubnetUUID = sql.NullString{String: subnetUUID, Valid: true}
+	}
+
 	// Container addresses are never deleted unless the container itself is deleted.
 	// First see if there's an existing address recorded.
 	err = tx.Query(ctx, selectAddressUUIDStmt, ipAddr).Get(&ipAddr)
@@ -777,6 +813,47 @@ ON CONFLICT(uuid) DO UPDATE SET
 	return nil
 }
 
+// lookupAddressSubnet returns the UUID of the most specific subnet whose
+// CIDR contains the input address, or an empty string if there is none.
+func (st *State) lookupAddressSubnet(ctx context.Context, tx *sqlair.TX, value string) (string, error) {
+	ip := net.ParseIP(value)
+	if ip == nil {
+		var err error
+		if ip, _, err = net.ParseCIDR(value); err != nil {
+			// Not an IP address, so it can't be in a subnet.
+			return "", nil
+		}
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &subnetCIDR.*
+FROM   subnet
+`, subnetCIDR{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	var subnets []subnetCIDR
+	err = tx.Query(ctx, stmt).GetAll(&subnets)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return "", errors.Annotate(err, "querying subnets")
+	}
+
+	var (
+		result    string
+		bestMatch = -1
+	)
+	for _, subnet := range subnets {
+		_, ipNet, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > bestMatch {
+			result, bestMatch = subnet.UUID, ones
+		}
+	}
+	return result, nil
+}
+
 type ports []string
 
 func (st *State) upsertCloudContainerPorts(ctx context.Context, tx *sqlair.TX, unitUUID coreunit.UUID, portValues []string) error {
@@ -1014,6 +1091,7 @@ func (st *State) deleteSimpleUnitReferences(ctx context.Context, tx *sqlair.TX,
 
 	for _, table := range []string{
 		"unit_agent",
+		"unit_principal",
 		"unit_state",
 		"unit_state_charm",
 		"unit_state_relation",
@@ -1199,6 +1277,122 @@ WHERE name = $minimalUnit.name
 	return unit.LifeID, errors.Annotatef(err, "querying unit %q life", unitName)
 }
 
+// GetUnitPrincipalChainNames returns the names of all the principals of the
+// specified unit, from the root principal to the unit's immediate principal.
+// It is empty if the unit is not a subordinate. It returns an error
+// satisfying [applicationerrors.UnitNotFound] if the unit is not found.
+func (st *State) GetUnitPrincipalChainNames(ctx domain.AtomicContext, unitName coreunit.Name) ([]coreunit.Name, error) {
+	unit := unitNameAndUUID{Name: unitName}
+
+	// The principal chain is followed up from the unit. The depth bound
+	// guards against a cycle.
+	queryPrincipals := `
+WITH RECURSIVE principal(unit_uuid, principal_uuid, depth) AS (
+    SELECT up.unit_uuid, up.principal_uuid, 1
+    FROM   unit_principal AS up
+    WHERE  up.unit_uuid = $unitNameAndUUID.uuid
+    UNION ALL
+    SELECT up.unit_uuid, up.principal_uuid, p.depth + 1
+    FROM   unit_principal AS up
+    JOIN   principal AS p ON up.unit_uuid = p.principal_uuid
+    WHERE  p.depth < 16
+)
+SELECT u.name AS &unitPrincipalAncestor.name,
+       p.depth AS &unitPrincipalAncestor.depth
+FROM   principal AS p
+JOIN   unit AS u ON u.uuid = p.principal_uuid
+ORDER BY p.depth DESC
+`
+	queryPrincipalsStmt, err := st.Prepare(queryPrincipals, unit, unitPrincipalAncestor{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var ancestors []unitPrincipalAncestor
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		unit.UnitUUID, err = st.getUnitUUIDByName(ctx, tx, unitName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = tx.Query(ctx, queryPrincipalsStmt, unit).GetAll(&ancestors)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying principals of unit %q", unitName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting principal chain of unit %q", unitName)
+	}
+
+	names := make([]coreunit.Name, len(ancestors))
+	for i, a := range ancestors {
+		names[i] = a.Name
+	}
+	return names, nil
+}
+
+// GetUnitSubordinateTree returns the tree of subordinates of the specified
+// unit, including subordinates of subordinates, returning an error
+// satisfying [applicationerrors.UnitNotFound] if the unit is not found.
+func (st *State) GetUnitSubordinateTree(ctx domain.AtomicContext, unitName coreunit.Name) (application.UnitTree, error) {
+	unit := unitNameAndUUID{Name: unitName}
+
+	// The principal chain is followed down from the unit, one level of
+	// subordinates at a time. The depth bound guards against a cycle.
+	querySubordinates := `
+WITH RECURSIVE subordinate(unit_uuid, principal_uuid, depth) AS (
+    SELECT up.unit_uuid, up.principal_uuid, 1
+    FROM   unit_principal AS up
+    WHERE  up.principal_uuid = $unitNameAndUUID.uuid
+    UNION ALL
+    SELECT up.unit_uuid, up.principal_uuid, s.depth + 1
+    FROM   unit_principal AS up
+    JOIN   subordinate AS s ON up.principal_uuid = s.unit_uuid
+    WHERE  s.depth < 16
+)
+SELECT u.name AS &unitSubordinate.name,
+       p.name AS &unitSubordinate.principal_name
+FROM   subordinate AS s
+JOIN   unit AS u ON u.uuid = s.unit_uuid
+JOIN   unit AS p ON p.uuid = s.principal_uuid
+ORDER BY u.name
+`
+	querySubordinatesStmt, err := st.Prepare(querySubordinates, unit, unitSubordinate{})
+	if err != nil {
+		return application.UnitTree{}, errors.Trace(err)
+	}
+
+	var subordinates []unitSubordinate
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		unit.UnitUUID, err = st.getUnitUUIDByName(ctx, tx, unitName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		err = tx.Query(ctx, querySubordinatesStmt, unit).GetAll(&subordinates)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying subordinates of unit %q", unitName)
+		}
+		return nil
+	})
+	if err != nil {
+		return application.UnitTree{}, errors.Annotatef(err, "getting subordinate tree of unit %q", unitName)
+	}
+
+	byPrincipal := make(map[coreunit.Name][]coreunit.Name)
+	for _, sub := range subordinates {
+		byPrincipal[sub.PrincipalName] = append(byPrincipal[sub.PrincipalName], sub.Name)
+	}
+	var build func(coreunit.Name) application.UnitNode
+	build = func(name coreunit.Name) application.UnitNode {
+		node := application.UnitNode{Name: name}
+		for _, sub := range byPrincipal[name] {
+			node.Subordinates = append(node.Subordinates, build(sub))
+		}
+		return node
+	}
+	return application.UnitTree{UnitNode: build(unitName)}, nil
+}
+
 // SetUnitLife sets the life of the specified unit, returning an error
 // satisfying [applicationerrors.UnitNotFound] if the unit is not found.
 func (st *State) SetUnitLife(ctx domain.AtomicContext, unitName coreunit.Name, l life.Life) error {
@@ -1292,6 +1486,187 @@ WHERE name = $applicationDetails.name
 	return app.UUID, app.LifeID, errors.Trace(err)
 }
 
+// GetApplicationConstraints returns the constraints of the specified
+// application which can be checked against the model. It returns an empty
+// value if the application has no constraints, and an error satisfying
+// [applicationerrors.ApplicationNotFound] if the application is not found.
+func (st *State) GetApplicationConstraints(ctx domain.AtomicContext, appUUID coreapplication.ID) (constraints.Value, error) {
+	appID := applicationID{ID: appUUID}
+	queryApp := `
+SELECT &applicationID.uuid
+FROM application
+WHERE uuid = $applicationID.uuid
+`
+	queryAppStmt, err := st.Prepare(queryApp, appID)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	var result constraints.Value
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryAppStmt, appID).Get(&appID)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("%w: %s", applicationerrors.ApplicationNotFound, appUUID)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		result, err = st.getApplicationConstraints(ctx, tx, appUUID)
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return constraints.Value{}, errors.Annotatef(err, "querying application %q constraints", appUUID)
+	}
+	return result, nil
+}
+
+func (st *State) getApplicationConstraints(ctx context.Context, tx *sqlair.TX, appUUID coreapplication.ID) (constraints.Value, error) {
+	appID := applicationID{ID: appUUID}
+	queryCons := `
+SELECT c.arch AS &applicationConstraint.arch,
+       c.cpu_cores AS &applicationConstraint.cpu_cores,
+       c.cpu_power AS &applicationConstraint.cpu_power,
+       c.mem AS &applicationConstraint.mem,
+       c.root_disk AS &applicationConstraint.root_disk,
+       c.root_disk_source AS &applicationConstraint.root_disk_source,
+       c.instance_role AS &applicationConstraint.instance_role,
+       c.instance_type AS &applicationConstraint.instance_type,
+       ct.value AS &applicationConstraint.container_type,
+       c.virt_type AS &applicationConstraint.virt_type,
+       c.allocate_public_ip AS &applicationConstraint.allocate_public_ip,
+       c.image_id AS &applicationConstraint.image_id
+FROM application_constraint AS ac
+JOIN "constraint" AS c ON c.uuid = ac.constraint_uuid
+LEFT JOIN container_type AS ct ON ct.id = c.container_type_id
+WHERE ac.application_uuid = $applicationID.uuid
+`
+	queryConsStmt, err := st.Prepare(queryCons, applicationConstraint{}, appID)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	querySpaces := `
+SELECT cs.space AS &constraintSpace.space,
+       cs."exclude" AS &constraintSpace.exclude
+FROM application_constraint AS ac
+JOIN constraint_space AS cs ON cs.constraint_uuid = ac.constraint_uuid
+WHERE ac.application_uuid = $applicationID.uuid
+ORDER BY cs.space
+`
+	querySpacesStmt, err := st.Prepare(querySpaces, constraintSpace{}, appID)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	queryTags := `
+SELECT ctag.tag AS &constraintTag.tag
+FROM application_constraint AS ac
+JOIN constraint_tag AS ctag ON ctag.constraint_uuid = ac.constraint_uuid
+WHERE ac.application_uuid = $applicationID.uuid
+ORDER BY ctag.tag
+`
+	queryTagsStmt, err := st.Prepare(queryTags, constraintTag{}, appID)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	queryZones := `
+SELECT cz.zone AS &constraintZone.zone
+FROM application_constraint AS ac
+JOIN constraint_zone AS cz ON cz.constraint_uuid = ac.constraint_uuid
+WHERE ac.application_uuid = $applicationID.uuid
+ORDER BY cz.zone
+`
+	queryZonesStmt, err := st.Prepare(queryZones, constraintZone{}, appID)
+	if err != nil {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	var (
+		cons   []applicationConstraint
+		spaces []constraintSpace
+		tags   []constraintTag
+		zones  []constraintZone
+	)
+	err = tx.Query(ctx, queryConsStmt, appID).GetAll(&cons)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	err = tx.Query(ctx, querySpacesStmt, appID).GetAll(&spaces)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	err = tx.Query(ctx, queryTagsStmt, appID).GetAll(&tags)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return constraints.Value{}, errors.Trace(err)
+	}
+	err = tx.Query(ctx, queryZonesStmt, appID).GetAll(&zones)
+	if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+		return constraints.Value{}, errors.Trace(err)
+	}
+
+	var result constraints.Value
+	if len(cons) > 0 {
+		result = cons[0].toValue()
+	}
+	if len(tags) > 0 {
+		values := transform.Slice(tags, func(t constraintTag) string { return t.Tag })
+		result.Tags = &values
+	}
+	if len(zones) > 0 {
+		values := transform.Slice(zones, func(z constraintZone) string { return z.Zone })
+		result.Zones = &values
+	}
+	if len(spaces) > 0 {
+		names := make([]string, len(spaces))
+		for i, s := range spaces {
+			if s.Exclude.Valid && s.Exclude.Bool {
+				names[i] = "^" + s.Space
+			} else {
+				names[i] = s.Space
+			}
+		}
+		result.Spaces = &names
+	}
+	return result, nil
+}
+
+// GetSpaceSubnetCounts returns the number of subnets in each of the specified
+// spaces, keyed by space name. Spaces which do not exist are not included in
+// the result.
+func (st *State) GetSpaceSubnetCounts(ctx domain.AtomicContext, spaces []string) (map[string]int, error) {
+	names := spaceNames(spaces)
+	query := `
+SELECT s.name AS &spaceSubnetCount.name,
+       COUNT(sn.uuid) AS &spaceSubnetCount.count
+FROM space AS s
+LEFT JOIN subnet AS sn ON sn.space_uuid = s.uuid
+WHERE s.name IN ($spaceNames[:])
+GROUP BY s.name
+`
+	queryStmt, err := st.Prepare(query, spaceSubnetCount{}, names)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var counts []spaceSubnetCount
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryStmt, names).GetAll(&counts)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Trace(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "querying space subnet counts")
+	}
+
+	result := make(map[string]int, len(counts))
+	for _, c := range counts {
+		result[c.Name] = c.Count
+	}
+	return result, nil
+}
+
 // SetApplicationLife sets the life of the specified application.
 func (st *State) SetApplicationLife(ctx domain.AtomicContext, appUUID coreapplication.ID, l life.Life) error {
 	lifeQuery := `
@@ -1315,12 +1690,23 @@ AND life_id <= $applicationID.life_id
 }
 
 // SetDesiredApplicationScale updates the desired scale of the specified
-// application.
-func (st *State) SetDesiredApplicationScale(ctx domain.AtomicContext, appUUID coreapplication.ID, scale int) error {
+// application. If the scale differs from the current desired scale, the change
+// is recorded in the application's scale history along with the actor which
+// requested it.
+func (st *State) SetDesiredApplicationScale(ctx domain.AtomicContext, appUUID coreapplication.ID, scale int, actor string) error {
 	scaleDetails := applicationScale{
 		ApplicationID: appUUID,
 		Scale:         scale,
 	}
+	queryScaleStmt, err := st.Prepare(`
+SELECT &applicationScale.scale
+FROM application_scale
+WHERE application_uuid = $applicationScale.application_uuid
+`, scaleDetails)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	upsertApplicationScale := `
 UPDATE application_scale SET scale = $applicationScale.scale
 WHERE application_uuid = $applicationScale.application_uuid
@@ -1330,12 +1716,414 @@ WHERE application_uuid = $applicationScale.application_uuid
 	if err != nil {
 		return errors.Trace(err)
 	}
+
+	historyStmt, err := st.Prepare(`
+INSERT INTO scale_target_history (*) VALUES ($scaleTargetHistory.*)
+`, scaleTargetHistory{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	historyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		return tx.Query(ctx, upsertStmt, scaleDetails).Run()
+		current := applicationScale{ApplicationID: appUUID}
+		err := tx.Query(ctx, queryScaleStmt, current).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("%w: %s", applicationerrors.ApplicationNotFound, appUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "querying application %q scale", appUUID)
+		}
+
+		if err := tx.Query(ctx, upsertStmt, scaleDetails).Run(); err != nil {
+			return errors.Trace(err)
+		}
+		if current.Scale == scale {
+			return nil
+		}
+
+		history := scaleTargetHistory{
+			UUID:          historyUUID.String(),
+			ApplicationID: appUUID,
+			Actor:         actor,
+			OldScale:      current.Scale,
+			NewScale:      scale,
+			ChangedAt:     st.clock.Now().UTC(),
+		}
+		if err := tx.Query(ctx, historyStmt, history).Run(); err != nil {
+			return errors.Annotatef(err, "recording scale history for application %q", appUUID)
+		}
+		return nil
 	})
 	return errors.Trace(err)
 }
 
+// GetApplicationScaleHistory returns the most recent changes to the desired
+// scale of the named application, newest first, up to the given limit.
+//
+// Returns an error satisfying [applicationerrors.ApplicationNotFound] if the
+// application doesn't exist.
+func (st *State) GetApplicationScaleHistory(ctx context.Context, appName string, limit int) ([]application.ScaleTargetEntry, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT   &scaleTargetHistory.*
+FROM     scale_target_history
+WHERE    application_uuid = $scaleTargetHistoryArgs.application_uuid
+ORDER BY changed_at DESC
+LIMIT    $scaleTargetHistoryArgs.limit
+`, scaleTargetHistory{}, scaleTargetHistoryArgs{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var history []scaleTargetHistory
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		appUUID, err := st.lookupApplication(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		args := scaleTargetHistoryArgs{
+			ApplicationID: appUUID,
+			Limit:         limit,
+		}
+		err = tx.Query(ctx, stmt, args).GetAll(&history)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying scale history for application %q", appName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]application.ScaleTargetEntry, len(history))
+	for i, h := range history {
+		result[i] = application.ScaleTargetEntry{
+			Actor:     h.Actor,
+			OldScale:  h.OldScale,
+			NewScale:  h.NewScale,
+			ChangedAt: h.ChangedAt,
+		}
+	}
+	return result, nil
+}
+
+// GetLatestApplicationScaleChanges returns the time of the most recent
+// change to the desired scale of each application, keyed by application name.
+// Applications whose scale has never changed are not included.
+func (st *State) GetLatestApplicationScaleChanges(ctx context.Context) (map[string]time.Time, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT a.name AS &latestScaleChange.name,
+       h.changed_at AS &latestScaleChange.changed_at
+FROM   scale_target_history AS h
+JOIN   application AS a ON a.uuid = h.application_uuid
+WHERE  h.changed_at = (
+    SELECT MAX(changed_at)
+    FROM   scale_target_history
+    WHERE  application_uuid = h.application_uuid
+)
+`, latestScaleChange{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var changes []latestScaleChange
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&changes)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotate(err, "querying latest scale changes")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make(map[string]time.Time, len(changes))
+	for _, c := range changes {
+		result[c.Name] = c.ChangedAt
+	}
+	return result, nil
+}
+
+// GetApplicationRelationSummary returns a summary of each relation of the
+// named application, ordered by relation ID. The summary is computed in a
+// single query. For a peer relation, the related application and endpoint
+// are those of the application itself.
+//
+// Returns an error satisfying [applicationerrors.ApplicationNotFound] if the
+// application doesn't exist.
+func (st *State) GetApplicationRelationSummary(ctx context.Context, appName string) ([]application.RelationSummary, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT    r.uuid AS &relationSummary.uuid,
+          r.relation_id AS &relationSummary.relation_id,
+          cr.name AS &relationSummary.endpoint_name,
+          COALESCE(oa.name, a.name) AS &relationSummary.related_application_name,
+          COALESCE(ocr.name, cr.name) AS &relationSummary.related_endpoint_name,
+          rst.name AS &relationSummary.status,
+          COALESCE(ru.unit_count, 0) AS &relationSummary.in_scope_unit_count
+FROM      application AS a
+JOIN      application_endpoint AS ae ON ae.application_uuid = a.uuid
+JOIN      charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+JOIN      relation_endpoint AS re ON re.endpoint_uuid = ae.uuid
+JOIN      relation AS r ON r.uuid = re.relation_uuid
+LEFT JOIN relation_endpoint AS ore ON ore.relation_uuid = r.uuid AND ore.uuid != re.uuid
+LEFT JOIN application_endpoint AS oae ON oae.uuid = ore.endpoint_uuid
+LEFT JOIN application AS oa ON oa.uuid = oae.application_uuid
+LEFT JOIN charm_relation AS ocr ON ocr.uuid = oae.charm_relation_uuid
+LEFT JOIN relation_status AS rs ON rs.relation_uuid = r.uuid
+LEFT JOIN relation_status_type AS rst ON rst.id = rs.relation_status_type_id
+LEFT JOIN (
+    SELECT   relation_uuid, COUNT(*) AS unit_count
+    FROM     relation_unit
+    WHERE    in_scope = TRUE
+    GROUP BY relation_uuid
+) AS ru ON ru.relation_uuid = r.uuid
+WHERE     a.uuid = $applicationID.uuid
+ORDER BY  r.relation_id
+`, relationSummary{}, applicationID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var summaries []relationSummary
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		appUUID, err := st.lookupApplication(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		err = tx.Query(ctx, stmt, applicationID{ID: appUUID}).GetAll(&summaries)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying relation summary for application %q", appName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]application.RelationSummary, len(summaries))
+	for i, rs := range summaries {
+		status := corerelation.Status(rs.Status.String)
+		result[i] = application.RelationSummary{
+			UUID:                   rs.UUID,
+			ID:                     rs.RelationID,
+			Endpoint:               rs.EndpointName,
+			RelatedApplicationName: rs.RelatedApplicationName,
+			RelatedEndpoint:        rs.RelatedEndpointName,
+			Status:                 status,
+			InScopeUnitCount:       rs.InScopeUnitCount,
+			Suspended:              status == corerelation.Suspended,
+		}
+	}
+	return result, nil
+}
+
+// ListApplicationsWithRelationErrors returns an entry for each application
+// endpoint taking part in a relation which is in an error state, ordered by
+// application name and relation ID. The message is taken from the most recent
+// status history entry of the relation.
+func (st *State) ListApplicationsWithRelationErrors(ctx context.Context) ([]application.ApplicationRelationError, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// SQLite takes the bare message column from the row providing the
+	// MAX(updated_at), so the message is that of the latest history entry.
+	stmt, err := st.Prepare(`
+SELECT    a.name AS &applicationRelationError.application_name,
+          r.uuid AS &applicationRelationError.relation_uuid,
+          COALESCE(oa.name, a.name) AS &applicationRelationError.related_application_name,
+          cr.name AS &applicationRelationError.endpoint_name,
+          rsh.message AS &applicationRelationError.message
+FROM      relation_status AS rs
+JOIN      relation_status_type AS rst ON rst.id = rs.relation_status_type_id
+JOIN      relation AS r ON r.uuid = rs.relation_uuid
+JOIN      relation_endpoint AS re ON re.relation_uuid = r.uuid
+JOIN      application_endpoint AS ae ON ae.uuid = re.endpoint_uuid
+JOIN      application AS a ON a.uuid = ae.application_uuid
+JOIN      charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+LEFT JOIN relation_endpoint AS ore ON ore.relation_uuid = r.uuid AND ore.uuid != re.uuid
+LEFT JOIN application_endpoint AS oae ON oae.uuid = ore.endpoint_uuid
+LEFT JOIN application AS oa ON oa.uuid = oae.application_uuid
+LEFT JOIN (
+    SELECT   relation_uuid, message, MAX(updated_at)
+    FROM     relation_status_history
+    GROUP BY relation_uuid
+) AS rsh ON rsh.relation_uuid = r.uuid
+WHERE     rst.name = 'error'
+ORDER BY  a.name, r.relation_id
+`, applicationRelationError{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var relErrors []applicationRelationError
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&relErrors)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotate(err, "querying relations in error")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]application.ApplicationRelationError, len(relErrors))
+	for i, re := range relErrors {
+		result[i] = application.ApplicationRelationError{
+			ApplicationName:        re.ApplicationName,
+			RelationUUID:           re.RelationUUID,
+			RelatedApplicationName: re.RelatedApplicationName,
+			Endpoint:               re.EndpointName,
+			Message:                re.Message.String,
+		}
+	}
+	return result, nil
+}
+
+// GetApplicationUnitStatusCounts returns the number of units of the named
+// application with each combination of workload and agent status, ordered by
+// the name of the first unit with each combination. The counts are aggregated
+// in the database.
+//
+// Returns an error satisfying [applicationerrors.ApplicationNotFound] if the
+// application doesn't exist.
+func (st *State) GetApplicationUnitStatusCounts(ctx context.Context, appName string) ([]application.UnitStatusCount, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// SQLite takes the bare message columns from the row providing the
+	// MIN(u.name), so the messages are those of the first unit in each group.
+	stmt, err := st.Prepare(`
+SELECT    COALESCE(wsv.status, 'unset') AS &unitStatusCount.workload_status,
+          COALESCE(ws.message, '') AS &unitStatusCount.workload_message,
+          COALESCE(asv.status, '') AS &unitStatusCount.agent_status,
+          COALESCE(ast.message, '') AS &unitStatusCount.agent_message,
+          MIN(u.name) AS &unitStatusCount.first_unit_name,
+          COUNT(*) AS &unitStatusCount.count
+FROM      unit AS u
+LEFT JOIN unit_workload_status AS ws ON ws.unit_uuid = u.uuid
+LEFT JOIN unit_workload_status_value AS wsv ON wsv.id = ws.status_id
+LEFT JOIN unit_agent_status AS ast ON ast.unit_uuid = u.uuid
+LEFT JOIN unit_agent_status_value AS asv ON asv.id = ast.status_id
+WHERE     u.application_uuid = $applicationID.uuid
+GROUP BY  wsv.status, asv.status
+ORDER BY  MIN(u.name)
+`, unitStatusCount{}, applicationID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var counts []unitStatusCount
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		appUUID, err := st.lookupApplication(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		err = tx.Query(ctx, stmt, applicationID{ID: appUUID}).GetAll(&counts)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "counting unit statuses for application %q", appName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]application.UnitStatusCount, len(counts))
+	for i, c := range counts {
+		result[i] = application.UnitStatusCount{
+			WorkloadStatus:  corestatus.Status(c.WorkloadStatus),
+			WorkloadMessage: c.WorkloadMessage,
+			AgentStatus:     corestatus.Status(c.AgentStatus),
+			AgentMessage:    c.AgentMessage,
+			Count:           c.Count,
+		}
+	}
+	return result, nil
+}
+
+// GetApplicationPlacementGroups returns the units of the named application
+// grouped by the machine they are placed on, ordered by machine name. Units
+// which are not placed on a machine are omitted. Returns an error satisfying
+// [applicationerrors.ApplicationNotFound] if the application doesn't exist.
+func (st *State) GetApplicationPlacementGroups(ctx context.Context, appName string) ([]application.PlacementGroup, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	// A unit is placed on a machine by sharing its net node.
+	stmt, err := st.Prepare(`
+SELECT    m.uuid AS &unitPlacement.machine_uuid,
+          m.name AS &unitPlacement.machine_name,
+          u.name AS &unitPlacement.unit_name
+FROM      unit AS u
+JOIN      machine AS m ON m.net_node_uuid = u.net_node_uuid
+WHERE     u.application_uuid = $applicationID.uuid
+ORDER BY  m.name, u.name
+`, unitPlacement{}, applicationID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var placements []unitPlacement
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		appUUID, err := st.lookupApplication(ctx, tx, appName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		err = tx.Query(ctx, stmt, applicationID{ID: appUUID}).GetAll(&placements)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying unit placements for application %q", appName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var result []application.PlacementGroup
+	for _, p := range placements {
+		if n := len(result); n > 0 && result[n-1].MachineUUID == p.MachineUUID {
+			result[n-1].UnitNames = append(result[n-1].UnitNames, p.UnitName)
+			continue
+		}
+		result = append(result, application.PlacementGroup{
+			MachineUUID: p.MachineUUID,
+			MachineName: p.MachineName,
+			UnitNames:   []coreunit.Name{p.UnitName},
+		})
+	}
+	return result, nil
+}
+
 // SetApplicationScalingState sets the scaling details for the given caas
 // application Scale is optional and is only set if not nil.
 func (st *State) SetApplicationScalingState(ctx domain.AtomicContext, appUUID coreapplication.ID, scale *int, targetScale int, scaling bool) error {
@@ -1904,6 +2692,141 @@ WHERE name = $unitNameAndUUID.name
 	return app.ID, nil
 }
 
+// GetMachineProvisioningConstraints returns what is needed to provision a
+// machine for the named unit, read in a single transaction: the constraints
+// of its application, its storage directives, the space each endpoint of its
+// application is bound to, and the LXD profile of its charm. The LXD profile
+// is returned undecoded.
+//
+// The unit's storage directives are used if it has any, otherwise those of
+// its application are returned.
+//
+// Returns an error satisfying [applicationerrors.UnitNotFound] if the unit
+// doesn't exist.
+func (st *State) GetMachineProvisioningConstraints(
+	ctx context.Context,
+	name coreunit.Name,
+) (application.MachineProvisioningConstraints, []byte, error) {
+	db, err := st.DB()
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Capture(err)
+	}
+
+	unit := unitNameAndUUID{Name: name}
+	unitStmt, err := st.Prepare(`
+SELECT u.uuid AS &unitProvisioningDetails.uuid,
+       u.application_uuid AS &unitProvisioningDetails.application_uuid,
+       COALESCE(u.charm_uuid, a.charm_uuid) AS &unitProvisioningDetails.charm_uuid
+FROM   unit AS u
+JOIN   application AS a ON a.uuid = u.application_uuid
+WHERE  u.name = $unitNameAndUUID.name
+`, unitProvisioningDetails{}, unit)
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Errorf("preparing unit query: %w", err)
+	}
+
+	unitStorageStmt, err := st.Prepare(`
+SELECT storage_key AS &storageDirective.storage_key,
+       storage_pool AS &storageDirective.storage_pool,
+       size AS &storageDirective.size,
+       count AS &storageDirective.count
+FROM   unit_storage_directive
+WHERE  unit_uuid = $unitProvisioningDetails.uuid
+ORDER BY storage_key
+`, storageDirective{}, unitProvisioningDetails{})
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Errorf("preparing unit storage query: %w", err)
+	}
+
+	appStorageStmt, err := st.Prepare(`
+SELECT storage_key AS &storageDirective.storage_key,
+       storage_pool AS &storageDirective.storage_pool,
+       size AS &storageDirective.size,
+       count AS &storageDirective.count
+FROM   application_storage_directive
+WHERE  application_uuid = $unitProvisioningDetails.application_uuid
+AND    charm_uuid = $unitProvisioningDetails.charm_uuid
+ORDER BY storage_key
+`, storageDirective{}, unitProvisioningDetails{})
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Errorf("preparing application storage query: %w", err)
+	}
+
+	bindingsStmt, err := st.Prepare(`
+SELECT cr.name AS &endpointSpace.endpoint_name,
+       s.name AS &endpointSpace.space_name
+FROM   application_endpoint AS ae
+JOIN   charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+JOIN   space AS s ON s.uuid = ae.space_uuid
+WHERE  ae.application_uuid = $unitProvisioningDetails.application_uuid
+`, endpointSpace{}, unitProvisioningDetails{})
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Errorf("preparing endpoint bindings query: %w", err)
+	}
+
+	var (
+		result   application.MachineProvisioningConstraints
+		profile  []byte
+		storage  []storageDirective
+		bindings []endpointSpace
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var details unitProvisioningDetails
+		err := tx.Query(ctx, unitStmt, unit).Get(&details)
+		if internalerrors.Is(err, sqlair.ErrNoRows) {
+			return applicationerrors.UnitNotFound
+		} else if err != nil {
+			return internalerrors.Errorf("getting unit: %w", err)
+		}
+
+		result.Constraints, err = st.getApplicationConstraints(ctx, tx, details.ApplicationID)
+		if err != nil {
+			return internalerrors.Errorf("getting application constraints: %w", err)
+		}
+
+		err = tx.Query(ctx, unitStorageStmt, details).GetAll(&storage)
+		if err != nil && !internalerrors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting unit storage directives: %w", err)
+		}
+		if len(storage) == 0 {
+			err = tx.Query(ctx, appStorageStmt, details).GetAll(&storage)
+			if err != nil && !internalerrors.Is(err, sqlair.ErrNoRows) {
+				return internalerrors.Errorf("getting application storage directives: %w", err)
+			}
+		}
+
+		err = tx.Query(ctx, bindingsStmt, details).GetAll(&bindings)
+		if err != nil && !internalerrors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting endpoint bindings: %w", err)
+		}
+
+		profile, _, err = st.getCharmLXDProfile(ctx, tx, charmID{UUID: details.CharmUUID})
+		if internalerrors.Is(err, applicationerrors.LXDProfileNotFound) {
+			return nil
+		}
+		return internalerrors.Capture(err)
+	})
+	if err != nil {
+		return application.MachineProvisioningConstraints{}, nil, internalerrors.Errorf("querying machine provisioning constraints for unit %q: %w", name, err)
+	}
+
+	for _, sd := range storage {
+		result.StorageDirectives = append(result.StorageDirectives, application.StorageDirective{
+			Name:  sd.StorageKey,
+			Pool:  sd.StoragePool,
+			Size:  sd.Size,
+			Count: sd.Count,
+		})
+	}
+	if len(bindings) > 0 {
+		result.EndpointBindings = make(map[string]string, len(bindings))
+		for _, b := range bindings {
+			result.EndpointBindings[b.EndpointName] = b.SpaceName
+		}
+	}
+	return result, profile, nil
+}
+
 // GetCharmModifiedVersion looks up the charm modified version of the given
 // application.
 //
@@ -1944,6 +2867,127 @@ WHERE uuid = $applicationID.uuid
 	return version.CharmModifiedVersion, err
 }
 
+// SetApplicationsLatestCharmRevision records the latest revision of the charm
+// available in the charm repository for each of the named applications.
+//
+// Applications which are not found, e.g. because they were removed after the
+// charm repository was queried, are skipped.
+func (st *State) SetApplicationsLatestCharmRevision(ctx context.Context, revisions map[string]int) error {
+	db, err := st.DB()
+	if err != nil {
+		return internalerrors.Capture(err)
+	}
+
+	upsertQuery := `
+INSERT INTO application_latest_charm_revision (*)
+VALUES ($applicationLatestCharmRevision.*)
+ON CONFLICT (application_uuid) DO UPDATE SET
+    revision = excluded.revision,
+    updated_at = excluded.updated_at
+`
+	upsertStmt, err := st.Prepare(upsertQuery, applicationLatestCharmRevision{})
+	if err != nil {
+		return internalerrors.Errorf("preparing query: %w", err)
+	}
+
+	now := st.clock.Now().UTC()
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for name, revision := range revisions {
+			appID, err := st.lookupApplication(ctx, tx, name)
+			if errors.Is(err, applicationerrors.ApplicationNotFound) {
+				st.logger.Debugf("not recording latest charm revision for missing application %q", name)
+				continue
+			} else if err != nil {
+				return internalerrors.Capture(err)
+			}
+			latest := applicationLatestCharmRevision{
+				ApplicationID: appID,
+				Revision:      revision,
+				UpdatedAt:     now,
+			}
+			if err := tx.Query(ctx, upsertStmt, latest).Run(); err != nil {
+				return internalerrors.Errorf("setting latest charm revision for %q: %w", name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return internalerrors.Errorf("setting latest charm revisions: %w", err)
+	}
+	return nil
+}
+
+// GetApplicationsWithPendingCharmUpgrades returns the applications for which
+// the latest recorded charm revision is greater than the revision of the
+// charm the application is using. If channel is not nil, only applications
+// tracking that channel are returned. Applications without a recorded latest
+// revision are never returned.
+func (st *State) GetApplicationsWithPendingCharmUpgrades(
+	ctx context.Context, channel *application.Channel,
+) ([]application.ApplicationPendingUpgrade, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	query := `
+SELECT a.name AS &applicationPendingUpgrade.name,
+       c.revision AS &applicationPendingUpgrade.current_revision,
+       r.revision AS &applicationPendingUpgrade.latest_revision
+FROM application AS a
+JOIN charm AS c ON c.uuid = a.charm_uuid
+JOIN application_latest_charm_revision AS r ON r.application_uuid = a.uuid
+`
+	args := []any{}
+	if channel != nil {
+		// The track and branch of a channel are optional, and may be
+		// recorded either as NULL or as an empty string.
+		query += `
+JOIN application_channel AS ac ON ac.application_uuid = a.uuid
+WHERE r.revision > c.revision
+AND NULLIF(ac.track, '') IS NULLIF($applicationChannel.track, '')
+AND ac.risk = $applicationChannel.risk
+AND NULLIF(ac.branch, '') IS NULLIF($applicationChannel.branch, '')
+`
+		args = append(args, applicationChannel{
+			Track:  channel.Track,
+			Risk:   string(channel.Risk),
+			Branch: channel.Branch,
+		})
+	} else {
+		query += `WHERE r.revision > c.revision
+`
+	}
+	query += `ORDER BY a.name`
+
+	stmt, err := st.Prepare(query, append([]any{applicationPendingUpgrade{}}, args...)...)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing query: %w", err)
+	}
+
+	var pending []applicationPendingUpgrade
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, args...).GetAll(&pending)
+		if internalerrors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, internalerrors.Errorf("querying applications with pending charm upgrades: %w", err)
+	}
+
+	result := make([]application.ApplicationPendingUpgrade, len(pending))
+	for i, p := range pending {
+		result[i] = application.ApplicationPendingUpgrade{
+			Name:            p.Name,
+			CurrentRevision: p.CurrentRevision,
+			LatestRevision:  p.LatestRevision,
+		}
+	}
+	return result, nil
+}
+
 // GetAsyncCharmDownloadInfo gets the charm download for the specified
 // application, returning an error satisfying
 // [applicationerrors.CharmAlreadyAvailable] if the application is already
@@ -2088,3 +3132,98 @@ WHERE uuid = $charmID.uuid;`
 	}
 	return nil
 }
+
+// ListUnitsInAgentError returns the units whose agent is in an error state,
+// along with the error message and the name of the failed hook, ordered by
+// unit name.
+func (st *State) ListUnitsInAgentError(ctx context.Context) ([]application.PendingHookRetryInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, internalerrors.Capture(err)
+	}
+
+	statusID := unitStatusInfo{StatusID: int(application.UnitAgentStatusError)}
+	stmt, err := st.Prepare(`
+SELECT u.name AS &pendingHookRetry.name,
+       s.message AS &pendingHookRetry.message,
+       d.data AS &pendingHookRetry.hook_name
+FROM   unit AS u
+JOIN   unit_agent_status AS s ON s.unit_uuid = u.uuid
+LEFT JOIN unit_agent_status_data AS d ON d.unit_uuid = u.uuid AND d."key" = 'hook'
+WHERE  s.status_id = $unitStatusInfo.status_id
+ORDER BY u.name
+`, pendingHookRetry{}, statusID)
+	if err != nil {
+		return nil, internalerrors.Errorf("preparing units in error query: %w", err)
+	}
+
+	var rows []pendingHookRetry
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, statusID).GetAll(&rows)
+		if internalerrors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return nil, internalerrors.Errorf("listing units in error: %w", err)
+	}
+
+	return transform.Slice(rows, func(r pendingHookRetry) application.PendingHookRetryInfo {
+		return application.PendingHookRetryInfo{
+			UnitName: r.Name,
+			HookName: r.HookName.String,
+			Message:  r.Message.String,
+		}
+	}), nil
+}
+
+// SetUnitsResolveMode marks the specified units as resolved with the given
+// mode. Every unit must have its agent in an error state, otherwise an error
+// satisfying [applicationerrors.UnitNotInErrorState] is returned and no unit
+// is updated.
+func (st *State) SetUnitsResolveMode(ctx domain.AtomicContext, uuids []coreunit.UUID, mode application.ResolveMode) error {
+	if len(uuids) == 0 {
+		return nil
+	}
+	ids := unitUUIDs(uuids)
+	statusStmt, err := st.Prepare(`
+SELECT u.name AS &unitAgentStatusID.name,
+       s.status_id AS &unitAgentStatusID.status_id
+FROM   unit AS u
+LEFT JOIN unit_agent_status AS s ON s.unit_uuid = u.uuid
+WHERE  u.uuid IN ($unitUUIDs[:])
+ORDER BY u.name
+`, unitAgentStatusID{}, ids)
+	if err != nil {
+		return internalerrors.Errorf("preparing unit agent status query: %w", err)
+	}
+
+	kind := unitResolveKind{ResolveKindID: int(mode)}
+	updateStmt, err := st.Prepare(`
+UPDATE unit
+SET    resolve_kind_id = $unitResolveKind.resolve_kind_id
+WHERE  uuid IN ($unitUUIDs[:])
+`, kind, ids)
+	if err != nil {
+		return internalerrors.Errorf("preparing unit resolve query: %w", err)
+	}
+
+	return domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var statuses []unitAgentStatusID
+		err := tx.Query(ctx, statusStmt, ids).GetAll(&statuses)
+		if err != nil && !internalerrors.Is(err, sqlair.ErrNoRows) {
+			return internalerrors.Errorf("getting unit agent statuses: %w", err)
+		}
+		for _, status := range statuses {
+			if !status.StatusID.Valid || status.StatusID.Int64 != int64(application.UnitAgentStatusError) {
+				return internalerrors.Errorf("unit %q: %w", status.Name, applicationerrors.UnitNotInErrorState)
+			}
+		}
+
+		if err := tx.Query(ctx, updateStmt, kind, ids).Run(); err != nil {
+			return internalerrors.Errorf("resolving units: %w", err)
+		}
+		return nil
+	})
+}
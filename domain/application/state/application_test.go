@@ -19,11 +19,14 @@ import (
 
 	coreapplication "github.com/juju/juju/core/application"
 	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/core/constraints"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/objectstore"
 	objectstoretesting "github.com/juju/juju/core/objectstore/testing"
+	corerelation "github.com/juju/juju/core/relation"
 	"github.com/juju/juju/core/secrets"
+	corestatus "github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	unittesting "github.com/juju/juju/core/unit/testing"
 	jujuversion "github.com/juju/juju/core/version"
@@ -576,6 +579,54 @@ func (s *applicationStateSuite) TestInsertUnitCloudContainer(c *gc.C) {
 
 }
 
+func (s *applicationStateSuite) TestInsertUnitCloudContainerAddressSubnet(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO subnet (uuid, cidr) VALUES
+    ('subnet-wide', '10.0.0.0/8'),
+    ('subnet-narrow', '10.6.6.0/24'),
+    ('subnet-other', '192.168.0.0/16')`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	u := application.InsertUnitArg{
+		UnitName: "foo/666",
+		CloudContainer: &application.CloudContainer{
+			ProviderId: "some-id",
+			Address: ptr(application.ContainerAddress{
+				Device: application.ContainerDevice{
+					Name:              "placeholder",
+					DeviceTypeID:      linklayerdevice.DeviceTypeUnknown,
+					VirtualPortTypeID: linklayerdevice.NonVirtualPortType,
+				},
+				Value:       "10.6.6.6",
+				AddressType: ipaddress.AddressTypeIPv4,
+				ConfigType:  ipaddress.ConfigTypeDHCP,
+				Scope:       ipaddress.ScopeMachineLocal,
+				Origin:      ipaddress.OriginHost,
+			}),
+		},
+	}
+	appID := s.createApplication(c, "foo", life.Alive)
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return s.state.InsertUnit(ctx, appID, u)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var subnetUUID sql.NullString
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT a.subnet_uuid
+FROM unit u
+JOIN link_layer_device lld ON lld.net_node_uuid = u.net_node_uuid
+JOIN ip_address a ON a.device_uuid = lld.uuid
+WHERE u.name=?`, "foo/666").Scan(&subnetUUID)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(subnetUUID, gc.Equals, sql.NullString{String: "subnet-narrow", Valid: true})
+}
+
 func (s *applicationStateSuite) assertContainerAddressValues(
 
 	c *gc.C,
@@ -1147,6 +1198,115 @@ func (s *applicationStateSuite) TestGetSecretsNone(c *gc.C) {
 	c.Assert(gotURIs, gc.HasLen, 0)
 }
 
+func (s *applicationStateSuite) TestGetUnitSubordinateTree(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+	s.createApplication(c, "bar", life.Alive,
+		application.InsertUnitArg{UnitName: "bar/0"},
+		application.InsertUnitArg{UnitName: "bar/1"},
+	)
+	s.createApplication(c, "baz", life.Alive,
+		application.InsertUnitArg{UnitName: "baz/0"},
+		application.InsertUnitArg{UnitName: "baz/1"},
+	)
+	s.setUnitPrincipal(c, "bar/0", "foo/0")
+	s.setUnitPrincipal(c, "baz/0", "bar/0")
+	s.setUnitPrincipal(c, "baz/1", "foo/0")
+
+	var tree application.UnitTree
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		tree, err = s.state.GetUnitSubordinateTree(ctx, "foo/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tree, jc.DeepEquals, application.UnitTree{
+		UnitNode: application.UnitNode{
+			Name: "foo/0",
+			Subordinates: []application.UnitNode{{
+				Name: "bar/0",
+				Subordinates: []application.UnitNode{{
+					Name: "baz/0",
+				}},
+			}, {
+				Name: "baz/1",
+			}},
+		},
+	})
+	c.Check(tree.SubordinatesLeafFirst(), jc.DeepEquals, []coreunit.Name{"baz/0", "bar/0", "baz/1"})
+}
+
+func (s *applicationStateSuite) TestGetUnitSubordinateTreeNoSubordinates(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+
+	var tree application.UnitTree
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		tree, err = s.state.GetUnitSubordinateTree(ctx, "foo/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tree, jc.DeepEquals, application.UnitTree{
+		UnitNode: application.UnitNode{Name: "foo/0"},
+	})
+	c.Check(tree.SubordinatesLeafFirst(), gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetUnitSubordinateTreeNotFound(c *gc.C) {
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		_, err := s.state.GetUnitSubordinateTree(ctx, "foo/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationStateSuite) TestGetUnitPrincipalChainNames(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+	s.createApplication(c, "bar", life.Alive, application.InsertUnitArg{UnitName: "bar/0"})
+	s.createApplication(c, "baz", life.Alive, application.InsertUnitArg{UnitName: "baz/0"})
+	s.setUnitPrincipal(c, "bar/0", "foo/0")
+	s.setUnitPrincipal(c, "baz/0", "bar/0")
+
+	var names []coreunit.Name
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		names, err = s.state.GetUnitPrincipalChainNames(ctx, "baz/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, jc.DeepEquals, []coreunit.Name{"foo/0", "bar/0"})
+}
+
+func (s *applicationStateSuite) TestGetUnitPrincipalChainNamesNotSubordinate(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+
+	var names []coreunit.Name
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		names, err = s.state.GetUnitPrincipalChainNames(ctx, "foo/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(names, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetUnitPrincipalChainNamesNotFound(c *gc.C) {
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		_, err := s.state.GetUnitPrincipalChainNames(ctx, "foo/0")
+		return err
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
+func (s *applicationStateSuite) setUnitPrincipal(c *gc.C, unitName, principalName coreunit.Name) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO unit_principal (unit_uuid, principal_uuid)
+SELECT u.uuid, p.uuid FROM unit u, unit p WHERE u.name = ? AND p.name = ?`, unitName, principalName)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *applicationStateSuite) TestDeleteUnitLastUnit(c *gc.C) {
 	u1 := application.InsertUnitArg{
 		UnitName: "foo/666",
@@ -1329,6 +1489,133 @@ func (s *applicationStateSuite) TestGetCharmModifiedVersionApplicationNotFound(c
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationStateSuite) TestGetApplicationsWithPendingCharmUpgrades(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+	s.createApplication(c, "bar", life.Alive)
+	s.createApplication(c, "baz", life.Alive)
+
+	// All the applications are using revision 42 of their charm. The baz
+	// application has no recorded latest revision.
+	err := s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo": 43,
+		"bar": 42,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	pending, err := s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, jc.DeepEquals, []application.ApplicationPendingUpgrade{{
+		Name:            "foo",
+		CurrentRevision: 42,
+		LatestRevision:  43,
+	}})
+
+	// Recording a newer revision updates the existing value.
+	err = s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo": 50,
+		"bar": 44,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	pending, err = s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, jc.DeepEquals, []application.ApplicationPendingUpgrade{{
+		Name:            "bar",
+		CurrentRevision: 42,
+		LatestRevision:  44,
+	}, {
+		Name:            "foo",
+		CurrentRevision: 42,
+		LatestRevision:  50,
+	}})
+}
+
+func (s *applicationStateSuite) TestGetApplicationsWithPendingCharmUpgradesChannel(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo": 43,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	pending, err := s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), &application.Channel{
+		Track:  "track",
+		Risk:   application.RiskStable,
+		Branch: "branch",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, gc.HasLen, 1)
+
+	pending, err = s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), &application.Channel{
+		Track: "track",
+		Risk:  application.RiskEdge,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetApplicationsWithPendingCharmUpgradesChannelNoTrack(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+UPDATE application_channel SET track = NULL, branch = NULL
+WHERE application_uuid = (SELECT uuid FROM application WHERE name = 'foo')`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo": 43,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	pending, err := s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), &application.Channel{
+		Risk: application.RiskStable,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, gc.HasLen, 1)
+
+	pending, err = s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), &application.Channel{
+		Track: "track",
+		Risk:  application.RiskStable,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestSetApplicationsLatestCharmRevisionApplicationNotFound(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo":     43,
+		"missing": 1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	pending, err := s.state.GetApplicationsWithPendingCharmUpgrades(context.Background(), nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pending, jc.DeepEquals, []application.ApplicationPendingUpgrade{{
+		Name:            "foo",
+		CurrentRevision: 42,
+		LatestRevision:  43,
+	}})
+}
+
+func (s *applicationStateSuite) TestDeleteApplicationWithLatestCharmRevision(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	err := s.state.SetApplicationsLatestCharmRevision(context.Background(), map[string]int{
+		"foo": 43,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return s.state.DeleteApplication(ctx, "foo")
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *applicationStateSuite) assertUnitStatus(
 
 	c *gc.C, statusType, unitUUID coreunit.UUID, statusID int, message string, since time.Time, data map[string]string,
@@ -1432,6 +1719,150 @@ func (s *applicationStateSuite) TestSetUnitAgentStatus(c *gc.C) {
 		c, "unit_agent", unitUUID, int(status.StatusID), status.Message, status.Since, status.Data)
 }
 
+func (s *applicationStateSuite) setUnitAgentStatuses(c *gc.C, statuses map[coreunit.UUID]application.UnitAgentStatusInfo) {
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		for unitUUID, status := range statuses {
+			if err := s.state.SetUnitAgentStatus(ctx, unitUUID, status); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *applicationStateSuite) TestListUnitsInAgentError(c *gc.C) {
+	u1 := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	u2 := application.InsertUnitArg{
+		UnitName: "foo/667",
+	}
+	u3 := application.InsertUnitArg{
+		UnitName: "foo/668",
+	}
+	s.createApplication(c, "foo", life.Alive, u1, u2, u3)
+
+	unitUUIDs, err := s.state.GetUnitUUIDs(context.Background(), []coreunit.Name{u1.UnitName, u2.UnitName, u3.UnitName})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitUUIDs, gc.HasLen, 3)
+
+	now := time.Now()
+	s.setUnitAgentStatuses(c, map[coreunit.UUID]application.UnitAgentStatusInfo{
+		unitUUIDs[0]: {
+			StatusID: application.UnitAgentStatusError,
+			StatusInfo: application.StatusInfo{
+				Message: `hook failed: "install"`,
+				Data:    map[string]string{"hook": "install"},
+				Since:   now,
+			},
+		},
+		unitUUIDs[1]: {
+			StatusID: application.UnitAgentStatusIdle,
+			StatusInfo: application.StatusInfo{
+				Since: now,
+			},
+		},
+		unitUUIDs[2]: {
+			StatusID: application.UnitAgentStatusError,
+			StatusInfo: application.StatusInfo{
+				Message: "something went wrong",
+				Since:   now,
+			},
+		},
+	})
+
+	units, err := s.state.ListUnitsInAgentError(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(units, jc.DeepEquals, []application.PendingHookRetryInfo{{
+		UnitName: "foo/666",
+		HookName: "install",
+		Message:  `hook failed: "install"`,
+	}, {
+		UnitName: "foo/668",
+		Message:  "something went wrong",
+	}})
+}
+
+func (s *applicationStateSuite) TestListUnitsInAgentErrorNone(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/666"})
+
+	units, err := s.state.ListUnitsInAgentError(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(units, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestSetUnitsResolveMode(c *gc.C) {
+	u1 := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	u2 := application.InsertUnitArg{
+		UnitName: "foo/667",
+	}
+	s.createApplication(c, "foo", life.Alive, u1, u2)
+
+	unitUUIDs, err := s.state.GetUnitUUIDs(context.Background(), []coreunit.Name{u1.UnitName, u2.UnitName})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitUUIDs, gc.HasLen, 2)
+
+	errorStatus := application.UnitAgentStatusInfo{
+		StatusID:   application.UnitAgentStatusError,
+		StatusInfo: application.StatusInfo{Since: time.Now()},
+	}
+	s.setUnitAgentStatuses(c, map[coreunit.UUID]application.UnitAgentStatusInfo{
+		unitUUIDs[0]: errorStatus,
+		unitUUIDs[1]: errorStatus,
+	})
+
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return s.state.SetUnitsResolveMode(ctx, unitUUIDs, application.ResolveModeNoHooks)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	rows, err := s.DB().QueryContext(context.Background(), "SELECT resolve_kind_id FROM unit ORDER BY name")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+	var kinds []int
+	for rows.Next() {
+		var kind int
+		c.Assert(rows.Scan(&kind), jc.ErrorIsNil)
+		kinds = append(kinds, kind)
+	}
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+	c.Check(kinds, jc.DeepEquals, []int{int(application.ResolveModeNoHooks), int(application.ResolveModeNoHooks)})
+}
+
+func (s *applicationStateSuite) TestSetUnitsResolveModeNotInError(c *gc.C) {
+	u1 := application.InsertUnitArg{
+		UnitName: "foo/666",
+	}
+	u2 := application.InsertUnitArg{
+		UnitName: "foo/667",
+	}
+	s.createApplication(c, "foo", life.Alive, u1, u2)
+
+	unitUUIDs, err := s.state.GetUnitUUIDs(context.Background(), []coreunit.Name{u1.UnitName, u2.UnitName})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitUUIDs, gc.HasLen, 2)
+
+	s.setUnitAgentStatuses(c, map[coreunit.UUID]application.UnitAgentStatusInfo{
+		unitUUIDs[0]: {
+			StatusID:   application.UnitAgentStatusError,
+			StatusInfo: application.StatusInfo{Since: time.Now()},
+		},
+	})
+
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return s.state.SetUnitsResolveMode(ctx, unitUUIDs, application.ResolveModeRetryHooks)
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotInErrorState)
+
+	var count int
+	err = s.DB().QueryRowContext(context.Background(), "SELECT COUNT(*) FROM unit WHERE resolve_kind_id != 0").Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
 func (s *applicationStateSuite) TestSetUnitWorkloadStatus(c *gc.C) {
 	u1 := application.InsertUnitArg{
 		UnitName: "foo/666",
@@ -1486,11 +1917,259 @@ func (s *applicationStateSuite) TestGetApplicationScaleStateNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
 }
 
+func (s *applicationStateSuite) TestGetApplicationConstraints(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO space (uuid, name) VALUES ('space-uuid', 'beta')`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO "constraint" (uuid, arch, mem, instance_type) VALUES ('cons-uuid', 'arm64', 2048, 'm1.large')
+`); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO constraint_space (constraint_uuid, space, "exclude") VALUES ('cons-uuid', 'alpha', FALSE), ('cons-uuid', 'beta', TRUE)
+`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `INSERT INTO application_constraint (application_uuid, constraint_uuid) VALUES (?, 'cons-uuid')`, appID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var cons constraints.Value
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		cons, err = s.state.GetApplicationConstraints(ctx, appID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cons, jc.DeepEquals, constraints.MustParse("arch=arm64 mem=2048M instance-type=m1.large spaces=alpha,^beta"))
+}
+
+func (s *applicationStateSuite) TestGetApplicationConstraintsNone(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	var cons constraints.Value
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		cons, err = s.state.GetApplicationConstraints(ctx, appID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cons, jc.DeepEquals, constraints.Value{})
+}
+
+func (s *applicationStateSuite) TestGetApplicationConstraintsApplicationNotFound(c *gc.C) {
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		_, err := s.state.GetApplicationConstraints(ctx, "deadbeef")
+		return err
+	})
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationStateSuite) TestGetSpaceSubnetCounts(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `INSERT INTO space (uuid, name) VALUES ('space-uuid', 'beta')`); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO subnet (uuid, cidr, space_uuid) VALUES ('subnet-1', '10.0.0.0/24', '0'), ('subnet-2', '10.0.1.0/24', '0')
+`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var counts map[string]int
+	err = s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		counts, err = s.state.GetSpaceSubnetCounts(ctx, []string{"alpha", "beta", "gamma"})
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(counts, jc.DeepEquals, map[string]int{
+		"alpha": 2,
+		"beta":  0,
+	})
+}
+
+func (s *applicationStateSuite) TestGetApplicationRelationSummary(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+	s.createApplication(c, "bar", life.Alive, application.InsertUnitArg{UnitName: "bar/0"})
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range []string{
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'foo-endpoint', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'foo' AND cr.name = 'endpoint'`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'foo-misc', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'foo' AND cr.name = 'misc'`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'bar-endpoint', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'bar' AND cr.name = 'endpoint'`,
+			`INSERT INTO relation (uuid, life_id, relation_id) VALUES ('rel-1', 0, 1), ('rel-2', 0, 2)`,
+			`INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES
+('rel-1-foo', 'rel-1', 'foo-endpoint'),
+('rel-1-bar', 'rel-1', 'bar-endpoint'),
+('rel-2-foo', 'rel-2', 'foo-misc')`,
+			`INSERT INTO relation_status (relation_uuid, relation_status_type_id, updated_at) VALUES ('rel-1', '4', datetime('now'))`,
+			`INSERT INTO relation_unit (uuid, relation_uuid, unit_uuid, in_scope)
+SELECT 'rel-1-' || name, 'rel-1', uuid, name = 'foo/0' FROM unit`,
+		} {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	summary, err := s.state.GetApplicationRelationSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(summary, jc.DeepEquals, []application.RelationSummary{{
+		UUID:                   "rel-1",
+		ID:                     1,
+		Endpoint:               "endpoint",
+		RelatedApplicationName: "bar",
+		RelatedEndpoint:        "endpoint",
+		Status:                 corerelation.Suspended,
+		InScopeUnitCount:       1,
+		Suspended:              true,
+	}, {
+		UUID:                   "rel-2",
+		ID:                     2,
+		Endpoint:               "misc",
+		RelatedApplicationName: "foo",
+		RelatedEndpoint:        "misc",
+	}})
+}
+
+func (s *applicationStateSuite) TestGetApplicationRelationSummaryNoRelations(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	summary, err := s.state.GetApplicationRelationSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(summary, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetApplicationRelationSummaryApplicationNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationRelationSummary(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationStateSuite) TestListApplicationsWithRelationErrors(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+	s.createApplication(c, "bar", life.Alive)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range []string{
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'foo-endpoint', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'foo' AND cr.name = 'endpoint'`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'foo-misc', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'foo' AND cr.name = 'misc'`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'bar-endpoint', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'bar' AND cr.name = 'endpoint'`,
+			`INSERT INTO relation (uuid, life_id, relation_id) VALUES ('rel-1', 0, 1), ('rel-2', 0, 2)`,
+			`INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES
+('rel-1-foo', 'rel-1', 'foo-endpoint'),
+('rel-1-bar', 'rel-1', 'bar-endpoint'),
+('rel-2-foo', 'rel-2', 'foo-misc')`,
+			`INSERT INTO relation_status (relation_uuid, relation_status_type_id, updated_at) VALUES
+('rel-1', '5', datetime('now')),
+('rel-2', '1', datetime('now'))`,
+			`INSERT INTO relation_status_history (uuid, relation_uuid, relation_status_type_id, message, updated_at) VALUES
+('hist-1', 'rel-1', '1', 'joined', '2026-01-01 00:00:00'),
+('hist-2', 'rel-1', '5', 'hook failed', '2026-01-01 00:01:00')`,
+		} {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	relErrors, err := s.state.ListApplicationsWithRelationErrors(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(relErrors, jc.DeepEquals, []application.ApplicationRelationError{{
+		ApplicationName:        "bar",
+		RelationUUID:           "rel-1",
+		RelatedApplicationName: "foo",
+		Endpoint:               "endpoint",
+		Message:                "hook failed",
+	}, {
+		ApplicationName:        "foo",
+		RelationUUID:           "rel-1",
+		RelatedApplicationName: "bar",
+		Endpoint:               "endpoint",
+		Message:                "hook failed",
+	}})
+}
+
+func (s *applicationStateSuite) TestListApplicationsWithRelationErrorsNone(c *gc.C) {
+	relErrors, err := s.state.ListApplicationsWithRelationErrors(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(relErrors, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetMachineProvisioningConstraints(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive, application.InsertUnitArg{UnitName: "foo/0"})
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range []string{
+			`INSERT INTO "constraint" (uuid, arch, mem, container_type_id) VALUES ('cons-uuid', 'arm64', 2048, 1)`,
+			`INSERT INTO constraint_tag (constraint_uuid, tag) VALUES ('cons-uuid', 'gpu')`,
+			`INSERT INTO constraint_zone (constraint_uuid, zone) VALUES ('cons-uuid', 'az1')`,
+			`INSERT INTO application_constraint (application_uuid, constraint_uuid) VALUES ('` + appID.String() + `', 'cons-uuid')`,
+			`INSERT INTO charm_storage (charm_uuid, "key", storage_kind_id, count_min, count_max)
+SELECT charm_uuid, 'data', 0, 1, 1 FROM application WHERE name = 'foo'`,
+			`INSERT INTO application_storage_directive (application_uuid, charm_uuid, storage_key, storage_pool, size, count)
+SELECT uuid, charm_uuid, 'data', 'rootfs', 1024, 1 FROM application WHERE name = 'foo'`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid)
+SELECT 'foo-endpoint', a.uuid, '0', cr.uuid FROM application a JOIN charm_relation cr ON cr.charm_uuid = a.charm_uuid
+WHERE a.name = 'foo' AND cr.name = 'endpoint'`,
+		} {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, profile, err := s.state.GetMachineProvisioningConstraints(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, application.MachineProvisioningConstraints{
+		Constraints: constraints.MustParse("arch=arm64 mem=2048M container=lxd tags=gpu zones=az1"),
+		StorageDirectives: []application.StorageDirective{{
+			Name:  "data",
+			Pool:  "rootfs",
+			Size:  1024,
+			Count: 1,
+		}},
+		EndpointBindings: map[string]string{
+			"endpoint": "alpha",
+		},
+	})
+	c.Check(profile, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetMachineProvisioningConstraintsUnitNotFound(c *gc.C) {
+	_, _, err := s.state.GetMachineProvisioningConstraints(context.Background(), "foo/0")
+	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
+}
+
 func (s *applicationStateSuite) TestSetDesiredApplicationScale(c *gc.C) {
 	appID := s.createApplication(c, "foo", life.Alive)
 
 	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
-		return s.state.SetDesiredApplicationScale(ctx, appID, 666)
+		return s.state.SetDesiredApplicationScale(ctx, appID, 666, "user-admin")
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -1504,6 +2183,176 @@ func (s *applicationStateSuite) TestSetDesiredApplicationScale(c *gc.C) {
 	c.Assert(gotScale, jc.DeepEquals, 666)
 }
 
+func (s *applicationStateSuite) TestGetApplicationScaleHistory(c *gc.C) {
+	appID := s.createApplication(c, "foo", life.Alive)
+
+	for i, scale := range []int{3, 3, 5, 2} {
+		err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+			return s.state.SetDesiredApplicationScale(ctx, appID, scale, fmt.Sprintf("user-bob%d", i))
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	// Setting the same scale again is not recorded.
+	history, err := s.state.GetApplicationScaleHistory(context.Background(), "foo", 50)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 3)
+	c.Check(history[0].Actor, gc.Equals, "user-bob3")
+	c.Check(history[0].OldScale, gc.Equals, 5)
+	c.Check(history[0].NewScale, gc.Equals, 2)
+	c.Check(history[1].Actor, gc.Equals, "user-bob2")
+	c.Check(history[1].OldScale, gc.Equals, 3)
+	c.Check(history[1].NewScale, gc.Equals, 5)
+	c.Check(history[2].Actor, gc.Equals, "user-bob0")
+	c.Check(history[2].OldScale, gc.Equals, 0)
+	c.Check(history[2].NewScale, gc.Equals, 3)
+	c.Check(history[0].ChangedAt.Before(history[2].ChangedAt), jc.IsFalse)
+
+	history, err = s.state.GetApplicationScaleHistory(context.Background(), "foo", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 1)
+	c.Check(history[0].NewScale, gc.Equals, 2)
+}
+
+func (s *applicationStateSuite) TestGetApplicationScaleHistoryNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationScaleHistory(context.Background(), "foo", 50)
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationStateSuite) TestGetLatestApplicationScaleChanges(c *gc.C) {
+	fooID := s.createApplication(c, "foo", life.Alive)
+	barID := s.createApplication(c, "bar", life.Alive)
+	s.createApplication(c, "baz", life.Alive)
+
+	for _, scale := range []int{3, 5} {
+		err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+			return s.state.SetDesiredApplicationScale(ctx, fooID, scale, "user-bob")
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return s.state.SetDesiredApplicationScale(ctx, barID, 1, "user-bob")
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	fooHistory, err := s.state.GetApplicationScaleHistory(context.Background(), "foo", 1)
+	c.Assert(err, jc.ErrorIsNil)
+	barHistory, err := s.state.GetApplicationScaleHistory(context.Background(), "bar", 1)
+	c.Assert(err, jc.ErrorIsNil)
+
+	changes, err := s.state.GetLatestApplicationScaleChanges(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(changes, gc.HasLen, 2)
+	c.Check(changes["foo"].Equal(fooHistory[0].ChangedAt), jc.IsTrue)
+	c.Check(changes["bar"].Equal(barHistory[0].ChangedAt), jc.IsTrue)
+}
+
+func (s *applicationStateSuite) TestGetApplicationUnitStatusCounts(c *gc.C) {
+	unitArg := func(name string, agent application.UnitAgentStatusType, workload application.UnitWorkloadStatusType, message string) application.InsertUnitArg {
+		return application.InsertUnitArg{
+			UnitName: coreunit.Name(name),
+			UnitStatusArg: application.UnitStatusArg{
+				AgentStatus: application.UnitAgentStatusInfo{
+					StatusID:   agent,
+					StatusInfo: application.StatusInfo{Message: message, Since: time.Now()},
+				},
+				WorkloadStatus: application.UnitWorkloadStatusInfo{
+					StatusID:   workload,
+					StatusInfo: application.StatusInfo{Message: message, Since: time.Now()},
+				},
+			},
+		}
+	}
+	s.createApplication(c, "foo", life.Alive,
+		unitArg("foo/2", application.UnitAgentStatusIdle, application.UnitWorkloadStatusActive, "ready 2"),
+		unitArg("foo/0", application.UnitAgentStatusIdle, application.UnitWorkloadStatusActive, "ready 0"),
+		unitArg("foo/1", application.UnitAgentStatusIdle, application.UnitWorkloadStatusBlocked, "blocked 1"),
+		unitArg("foo/3", application.UnitAgentStatusError, application.UnitWorkloadStatusActive, "hook failed"),
+	)
+	s.createApplication(c, "bar", life.Alive,
+		unitArg("bar/0", application.UnitAgentStatusIdle, application.UnitWorkloadStatusWaiting, "waiting"),
+	)
+
+	counts, err := s.state.GetApplicationUnitStatusCounts(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(counts, jc.DeepEquals, []application.UnitStatusCount{{
+		WorkloadStatus:  corestatus.Active,
+		WorkloadMessage: "ready 0",
+		AgentStatus:     corestatus.Idle,
+		AgentMessage:    "ready 0",
+		Count:           2,
+	}, {
+		WorkloadStatus:  corestatus.Blocked,
+		WorkloadMessage: "blocked 1",
+		AgentStatus:     corestatus.Idle,
+		AgentMessage:    "blocked 1",
+		Count:           1,
+	}, {
+		WorkloadStatus:  corestatus.Active,
+		WorkloadMessage: "hook failed",
+		AgentStatus:     corestatus.Error,
+		AgentMessage:    "hook failed",
+		Count:           1,
+	}})
+}
+
+func (s *applicationStateSuite) TestGetApplicationUnitStatusCountsNoUnits(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive)
+
+	counts, err := s.state.GetApplicationUnitStatusCounts(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(counts, gc.HasLen, 0)
+}
+
+func (s *applicationStateSuite) TestGetApplicationUnitStatusCountsNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationUnitStatusCounts(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+func (s *applicationStateSuite) TestGetApplicationPlacementGroups(c *gc.C) {
+	s.createApplication(c, "foo", life.Alive,
+		application.InsertUnitArg{UnitName: "foo/0"},
+		application.InsertUnitArg{UnitName: "foo/1"},
+		application.InsertUnitArg{UnitName: "foo/2"},
+		application.InsertUnitArg{UnitName: "foo/3"},
+	)
+	s.placeUnitOnMachine(c, "foo/0", "0")
+	s.placeUnitOnMachine(c, "foo/1", "1")
+	s.placeUnitOnMachine(c, "foo/2", "0")
+
+	groups, err := s.state.GetApplicationPlacementGroups(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(groups, gc.HasLen, 2)
+	c.Check(groups[0].MachineName, gc.Equals, "0")
+	c.Check(groups[0].UnitNames, jc.DeepEquals, []coreunit.Name{"foo/0", "foo/2"})
+	c.Check(groups[1].MachineName, gc.Equals, "1")
+	c.Check(groups[1].UnitNames, jc.DeepEquals, []coreunit.Name{"foo/1"})
+}
+
+func (s *applicationStateSuite) TestGetApplicationPlacementGroupsNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationPlacementGroups(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
+// placeUnitOnMachine places the unit on the named machine, creating the
+// machine if it doesn't exist.
+func (s *applicationStateSuite) placeUnitOnMachine(c *gc.C, unitName coreunit.Name, machineName string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO machine (uuid, name, net_node_uuid, life_id)
+SELECT ?, ?, net_node_uuid, 0 FROM unit WHERE name = ?
+ON CONFLICT (name) DO NOTHING`, "machine-uuid-"+machineName, machineName, unitName)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+UPDATE unit SET net_node_uuid = (SELECT net_node_uuid FROM machine WHERE name = ?)
+WHERE name = ?`, machineName, unitName)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *applicationStateSuite) TestSetApplicationScalingState(c *gc.C) {
 	u := application.InsertUnitArg{
 		UnitName: "foo/666",
@@ -1512,7 +2361,7 @@ func (s *applicationStateSuite) TestSetApplicationScalingState(c *gc.C) {
 
 	// Set up the initial scale value.
 	err := s.state.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
-		return s.state.SetDesiredApplicationScale(ctx, appID, 666)
+		return s.state.SetDesiredApplicationScale(ctx, appID, 666, "user-admin")
 	})
 	c.Assert(err, jc.ErrorIsNil)
 
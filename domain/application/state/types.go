@@ -8,6 +8,8 @@ import (
 	"time"
 
 	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/core/constraints"
+	"github.com/juju/juju/core/instance"
 	coresecrets "github.com/juju/juju/core/secrets"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application"
@@ -99,6 +101,44 @@ type unitNames []coreunit.Name
 
 type unitUUIDs []coreunit.UUID
 
+// unitResolveKind is used to set the resolve kind of a unit.
+type unitResolveKind struct {
+	ResolveKindID int `db:"resolve_kind_id"`
+}
+
+// unitAgentStatusID is the agent status of a unit.
+type unitAgentStatusID struct {
+	Name     coreunit.Name `db:"name"`
+	StatusID sql.NullInt64 `db:"status_id"`
+}
+
+// pendingHookRetry is a unit whose agent is in an error state.
+type pendingHookRetry struct {
+	Name     coreunit.Name  `db:"name"`
+	Message  sql.NullString `db:"message"`
+	HookName sql.NullString `db:"hook_name"`
+}
+
+// unitPlacement is a unit along with the machine it is placed on.
+type unitPlacement struct {
+	MachineUUID string        `db:"machine_uuid"`
+	MachineName string        `db:"machine_name"`
+	UnitName    coreunit.Name `db:"unit_name"`
+}
+
+// unitSubordinate is a subordinate unit along with the name of its principal.
+type unitSubordinate struct {
+	Name          coreunit.Name `db:"name"`
+	PrincipalName coreunit.Name `db:"principal_name"`
+}
+
+// unitPrincipalAncestor is a principal of a unit, along with how many levels
+// above the unit it is.
+type unitPrincipalAncestor struct {
+	Name  coreunit.Name `db:"name"`
+	Depth int           `db:"depth"`
+}
+
 type minimalUnit struct {
 	UUID      coreunit.UUID `db:"uuid"`
 	NetNodeID string        `db:"net_node_uuid"`
@@ -155,13 +195,20 @@ type cloudContainerPort struct {
 }
 
 type ipAddress struct {
-	AddressUUID  string `db:"uuid"`
-	Value        string `db:"address_value"`
-	ConfigTypeID int    `db:"config_type_id"`
-	TypeID       int    `db:"type_id"`
-	OriginID     int    `db:"origin_id"`
-	ScopeID      int    `db:"scope_id"`
-	DeviceID     string `db:"device_uuid"`
+	AddressUUID  string         `db:"uuid"`
+	Value        string         `db:"address_value"`
+	ConfigTypeID int            `db:"config_type_id"`
+	TypeID       int            `db:"type_id"`
+	OriginID     int            `db:"origin_id"`
+	ScopeID      int            `db:"scope_id"`
+	DeviceID     string         `db:"device_uuid"`
+	SubnetUUID   sql.NullString `db:"subnet_uuid"`
+}
+
+// subnetCIDR is the CIDR of a subnet.
+type subnetCIDR struct {
+	UUID string `db:"uuid"`
+	CIDR string `db:"cidr"`
 }
 
 type secretID struct {
@@ -567,6 +614,98 @@ type charmConfig struct {
 	Description  string  `db:"description"`
 }
 
+// applicationConstraint holds the constraints of an application.
+type applicationConstraint struct {
+	Arch             sql.NullString `db:"arch"`
+	CPUCores         sql.NullInt64  `db:"cpu_cores"`
+	CPUPower         sql.NullInt64  `db:"cpu_power"`
+	Mem              sql.NullInt64  `db:"mem"`
+	RootDisk         sql.NullInt64  `db:"root_disk"`
+	RootDiskSource   sql.NullString `db:"root_disk_source"`
+	InstanceRole     sql.NullString `db:"instance_role"`
+	InstanceType     sql.NullString `db:"instance_type"`
+	ContainerType    sql.NullString `db:"container_type"`
+	VirtType         sql.NullString `db:"virt_type"`
+	AllocatePublicIP sql.NullBool   `db:"allocate_public_ip"`
+	ImageID          sql.NullString `db:"image_id"`
+}
+
+// toValue returns the constraints as a constraints value. Null columns are
+// left unset.
+func (c applicationConstraint) toValue() constraints.Value {
+	var result constraints.Value
+	if c.Arch.Valid {
+		result.Arch = &c.Arch.String
+	}
+	if c.CPUCores.Valid {
+		cores := uint64(c.CPUCores.Int64)
+		result.CpuCores = &cores
+	}
+	if c.CPUPower.Valid {
+		power := uint64(c.CPUPower.Int64)
+		result.CpuPower = &power
+	}
+	if c.Mem.Valid {
+		mem := uint64(c.Mem.Int64)
+		result.Mem = &mem
+	}
+	if c.RootDisk.Valid {
+		disk := uint64(c.RootDisk.Int64)
+		result.RootDisk = &disk
+	}
+	if c.RootDiskSource.Valid {
+		result.RootDiskSource = &c.RootDiskSource.String
+	}
+	if c.InstanceRole.Valid {
+		result.InstanceRole = &c.InstanceRole.String
+	}
+	if c.InstanceType.Valid {
+		result.InstanceType = &c.InstanceType.String
+	}
+	if c.ContainerType.Valid {
+		container := instance.ContainerType(c.ContainerType.String)
+		if c.ContainerType.String == "bare-metal" {
+			container = instance.NONE
+		}
+		result.Container = &container
+	}
+	if c.VirtType.Valid {
+		result.VirtType = &c.VirtType.String
+	}
+	if c.AllocatePublicIP.Valid {
+		result.AllocatePublicIP = &c.AllocatePublicIP.Bool
+	}
+	if c.ImageID.Valid {
+		result.ImageID = &c.ImageID.String
+	}
+	return result
+}
+
+// constraintTag is a tag named in a constraint.
+type constraintTag struct {
+	Tag string `db:"tag"`
+}
+
+// constraintZone is an availability zone named in a constraint.
+type constraintZone struct {
+	Zone string `db:"zone"`
+}
+
+// constraintSpace is a space named in a constraint. Excluded spaces must not
+// be used.
+type constraintSpace struct {
+	Space   string       `db:"space"`
+	Exclude sql.NullBool `db:"exclude"`
+}
+
+type spaceNames []string
+
+// spaceSubnetCount is the number of subnets in a space.
+type spaceSubnetCount struct {
+	Name  string `db:"name"`
+	Count int    `db:"count"`
+}
+
 // setCharmConfig is used to set the config of a charm.
 type setCharmConfig struct {
 	CharmUUID    string  `db:"charm_uuid"`
@@ -654,3 +793,88 @@ type linkResourceApplication struct {
 	ResourceUUID    string `db:"resource_uuid"`
 	ApplicationUUID string `db:"application_uuid"`
 }
+
+type applicationLatestCharmRevision struct {
+	ApplicationID coreapplication.ID `db:"application_uuid"`
+	Revision      int                `db:"revision"`
+	UpdatedAt     time.Time          `db:"updated_at"`
+}
+
+type applicationPendingUpgrade struct {
+	Name            string `db:"name"`
+	CurrentRevision int    `db:"current_revision"`
+	LatestRevision  int    `db:"latest_revision"`
+}
+
+type scaleTargetHistory struct {
+	UUID          string             `db:"uuid"`
+	ApplicationID coreapplication.ID `db:"application_uuid"`
+	Actor         string             `db:"actor"`
+	OldScale      int                `db:"old_scale"`
+	NewScale      int                `db:"new_scale"`
+	ChangedAt     time.Time          `db:"changed_at"`
+}
+
+type scaleTargetHistoryArgs struct {
+	ApplicationID coreapplication.ID `db:"application_uuid"`
+	Limit         int                `db:"limit"`
+}
+
+// latestScaleChange is the time of the most recent scale change of an
+// application.
+type latestScaleChange struct {
+	Name      string    `db:"name"`
+	ChangedAt time.Time `db:"changed_at"`
+}
+
+type unitStatusCount struct {
+	WorkloadStatus  string `db:"workload_status"`
+	WorkloadMessage string `db:"workload_message"`
+	AgentStatus     string `db:"agent_status"`
+	AgentMessage    string `db:"agent_message"`
+	FirstUnitName   string `db:"first_unit_name"`
+	Count           int    `db:"count"`
+}
+
+// relationSummary is a summary of a relation of an application.
+type relationSummary struct {
+	UUID                   string         `db:"uuid"`
+	RelationID             int            `db:"relation_id"`
+	EndpointName           string         `db:"endpoint_name"`
+	RelatedApplicationName string         `db:"related_application_name"`
+	RelatedEndpointName    string         `db:"related_endpoint_name"`
+	Status                 sql.NullString `db:"status"`
+	InScopeUnitCount       int            `db:"in_scope_unit_count"`
+}
+
+// applicationRelationError is a relation of an application which is in an
+// error state.
+type applicationRelationError struct {
+	ApplicationName        string         `db:"application_name"`
+	RelationUUID           string         `db:"relation_uuid"`
+	RelatedApplicationName string         `db:"related_application_name"`
+	EndpointName           string         `db:"endpoint_name"`
+	Message                sql.NullString `db:"message"`
+}
+
+// unitProvisioningDetails identifies the application and charm of a unit
+// which is being provisioned.
+type unitProvisioningDetails struct {
+	UUID          coreunit.UUID      `db:"uuid"`
+	ApplicationID coreapplication.ID `db:"application_uuid"`
+	CharmUUID     string             `db:"charm_uuid"`
+}
+
+// storageDirective is how a storage defined by a charm is provisioned.
+type storageDirective struct {
+	StorageKey  string `db:"storage_key"`
+	StoragePool string `db:"storage_pool"`
+	Size        uint64 `db:"size"`
+	Count       uint64 `db:"count"`
+}
+
+// endpointSpace is the space an application endpoint is bound to.
+type endpointSpace struct {
+	EndpointName string `db:"endpoint_name"`
+	SpaceName    string `db:"space_name"`
+}
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/juju/juju/core/charm"
+	"github.com/juju/juju/core/constraints"
 	"github.com/juju/juju/core/objectstore"
+	corerelation "github.com/juju/juju/core/relation"
+	corestatus "github.com/juju/juju/core/status"
 	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/application/architecture"
 	domaincharm "github.com/juju/juju/domain/application/charm"
@@ -183,6 +186,33 @@ type UnitAgentStatusInfo struct {
 	StatusInfo
 }
 
+// ResolveMode describes how a unit whose agent is in an error state should
+// be resolved, as recorded in the unit_resolve_kind lookup table.
+type ResolveMode int
+
+const (
+	// ResolveModeNone means the unit has not been marked as resolved.
+	ResolveModeNone ResolveMode = iota
+	// ResolveModeRetryHooks means the failed hook is retried.
+	ResolveModeRetryHooks
+	// ResolveModeNoHooks means the failed hook is skipped.
+	ResolveModeNoHooks
+)
+
+// PendingHookRetryInfo describes a unit whose agent is in an error state, and
+// which is waiting to be resolved.
+type PendingHookRetryInfo struct {
+	// UnitName is the name of the unit.
+	UnitName coreunit.Name
+
+	// HookName is the name of the failed hook. It is empty if the agent did
+	// not record which hook failed.
+	HookName string
+
+	// Message is the error message recorded in the agent status.
+	Message string
+}
+
 // UnitWorkloadStatusInfo holds a unit workload status
 // and associated information.
 type UnitWorkloadStatusInfo struct {
@@ -233,3 +263,191 @@ type ResolvedControllerCharmDownload struct {
 	ArchivePath     string
 	ObjectStoreUUID objectstore.UUID
 }
+
+// ApplicationPendingUpgrade describes an application for which a newer
+// revision of its charm is available in the charm repository.
+type ApplicationPendingUpgrade struct {
+	// Name is the name of the application.
+	Name string
+	// CurrentRevision is the revision of the charm the application is using.
+	CurrentRevision int
+	// LatestRevision is the latest revision of the charm available for the
+	// channel the application is tracking.
+	LatestRevision int
+}
+
+// UnitStatusCount holds the number of units of an application which share
+// the same workload and agent status. The messages are those of the first of
+// the units, ordered by name.
+type UnitStatusCount struct {
+	// WorkloadStatus is the workload status of the units.
+	WorkloadStatus corestatus.Status
+	// WorkloadMessage is the workload status message of the first unit.
+	WorkloadMessage string
+	// AgentStatus is the agent status of the units.
+	AgentStatus corestatus.Status
+	// AgentMessage is the agent status message of the first unit.
+	AgentMessage string
+	// Count is the number of units with these statuses.
+	Count int
+}
+
+// ScaleTargetEntry is an entry in the scale history of an application,
+// recording a change to its desired scale.
+type ScaleTargetEntry struct {
+	// Actor is the entity which requested the scale change.
+	Actor string
+	// OldScale is the desired scale before the change.
+	OldScale int
+	// NewScale is the desired scale after the change.
+	NewScale int
+	// ChangedAt is the time at which the scale was changed.
+	ChangedAt time.Time
+}
+
+// PlacementGroup is a set of units of an application placed on the same
+// machine.
+type PlacementGroup struct {
+	// MachineUUID is the UUID of the machine.
+	MachineUUID string
+	// MachineName is the name of the machine.
+	MachineName string
+	// UnitNames are the names of the units on the machine, ordered by name.
+	UnitNames []coreunit.Name
+}
+
+// IsColocated reports whether more than one unit of the application is
+// placed on the machine, so that losing the machine would take them all down.
+func (g PlacementGroup) IsColocated() bool {
+	return len(g.UnitNames) > 1
+}
+
+// UnitNode is a unit within a tree of principal and subordinate units.
+type UnitNode struct {
+	// Name is the name of the unit.
+	Name coreunit.Name
+	// Subordinates are the units directly subordinate to the unit, ordered
+	// by name.
+	Subordinates []UnitNode
+}
+
+// UnitTree is the tree of subordinate units rooted at a principal unit,
+// including subordinates of subordinates.
+type UnitTree struct {
+	UnitNode
+}
+
+// SubordinatesLeafFirst returns the names of all the transitive subordinates
+// in the tree, excluding the root, ordered such that every unit comes before
+// its principal.
+func (t UnitTree) SubordinatesLeafFirst() []coreunit.Name {
+	var names []coreunit.Name
+	var walk func(UnitNode)
+	walk = func(node UnitNode) {
+		for _, sub := range node.Subordinates {
+			walk(sub)
+			names = append(names, sub.Name)
+		}
+	}
+	walk(t.UnitNode)
+	return names
+}
+
+// ConstraintCheck describes whether a single constraint can be satisfied by
+// the model.
+type ConstraintCheck struct {
+	// Name is the name of the constraint, for example "arch".
+	Name string
+	// Value is the constraint value as it would be written on the command
+	// line.
+	Value string
+	// Feasible is true if the model can satisfy the constraint.
+	Feasible bool
+	// Reason explains why the constraint cannot be satisfied. It is empty
+	// when the constraint is feasible.
+	Reason string
+}
+
+// ConstraintsFeasibility holds the result of checking each set constraint
+// against the model.
+type ConstraintsFeasibility struct {
+	Checks []ConstraintCheck
+}
+
+// Feasible returns true if every checked constraint can be satisfied.
+func (f ConstraintsFeasibility) Feasible() bool {
+	for _, check := range f.Checks {
+		if !check.Feasible {
+			return false
+		}
+	}
+	return true
+}
+
+// RelationSummary describes a relation of an application, from the point of
+// view of that application.
+type RelationSummary struct {
+	// UUID is the UUID of the relation.
+	UUID string
+	// ID is the relation ID.
+	ID int
+	// Endpoint is the name of the application's endpoint in the relation.
+	Endpoint string
+	// RelatedApplicationName is the name of the application at the other
+	// end of the relation. For a peer relation it is the application itself.
+	RelatedApplicationName string
+	// RelatedEndpoint is the name of the endpoint at the other end of the
+	// relation.
+	RelatedEndpoint string
+	// Status is the status of the relation. It is empty if no status has
+	// been recorded.
+	Status corerelation.Status
+	// InScopeUnitCount is the number of units which have entered the scope
+	// of the relation.
+	InScopeUnitCount int
+	// Suspended is true if the relation is suspended.
+	Suspended bool
+}
+
+// ApplicationRelationError describes a relation of an application which is
+// in an error state, from the point of view of that application.
+type ApplicationRelationError struct {
+	// ApplicationName is the name of the application.
+	ApplicationName string
+	// RelationUUID is the UUID of the relation.
+	RelationUUID string
+	// RelatedApplicationName is the name of the application at the other
+	// end of the relation. For a peer relation it is the application itself.
+	RelatedApplicationName string
+	// Endpoint is the name of the application's endpoint in the relation.
+	Endpoint string
+	// Message is the message recorded when the relation entered the error
+	// state.
+	Message string
+}
+
+// StorageDirective describes how a storage defined by a charm is provisioned.
+type StorageDirective struct {
+	// Name is the name of the storage, as defined by the charm.
+	Name string
+	// Pool is the storage pool, or provider type, to use.
+	Pool string
+	// Size is the size of each storage instance, in MiB.
+	Size uint64
+	// Count is the number of storage instances.
+	Count uint64
+}
+
+// MachineProvisioningConstraints holds what is needed to provision a machine
+// for a unit.
+type MachineProvisioningConstraints struct {
+	// Constraints are the constraints of the unit's application.
+	Constraints constraints.Value
+	// StorageDirectives describe the storage to provision for the unit.
+	StorageDirectives []StorageDirective
+	// EndpointBindings maps each endpoint of the unit's application to the
+	// name of the space it is bound to.
+	EndpointBindings map[string]string
+	// LXDProfile is the LXD profile required by the unit's charm.
+	LXDProfile internalcharm.LXDProfile
+}
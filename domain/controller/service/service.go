@@ -7,8 +7,16 @@ import (
 	"context"
 
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/controller"
 )
 
+// capabilities are the features supported by this version of the
+// controller. They are fixed at build time, so any new feature that clients
+// need to discover must be added here.
+var capabilities = controller.ControllerCapabilities{
+	SupportsBulkGrant: true,
+}
+
 // State defines an interface for interacting with the underlying state.
 type State interface {
 	ControllerModelUUID(ctx context.Context) (model.UUID, error)
@@ -30,3 +38,10 @@ func NewService(st State) *Service {
 func (s *Service) ControllerModelUUID(ctx context.Context) (model.UUID, error) {
 	return s.st.ControllerModelUUID(ctx)
 }
+
+// GetControllerCapabilities returns the features supported by the
+// controller, allowing clients to gate feature usage on capability presence
+// rather than on the controller version.
+func (s *Service) GetControllerCapabilities(ctx context.Context) (controller.ControllerCapabilities, error) {
+	return capabilities, nil
+}
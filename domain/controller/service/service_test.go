@@ -12,6 +12,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/controller"
 	jujutesting "github.com/juju/juju/internal/testing"
 )
 
@@ -37,3 +38,13 @@ func (s *serviceSuite) TestControllerModelUUID(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(uuid, gc.Equals, controllerModelUUID)
 }
+
+func (s *serviceSuite) TestGetControllerCapabilities(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+	st := NewService(s.state)
+	capabilities, err := st.GetControllerCapabilities(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(capabilities, gc.Equals, controller.ControllerCapabilities{
+		SupportsBulkGrant: true,
+	})
+}
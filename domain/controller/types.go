@@ -8,3 +8,20 @@ const (
 	// represent the public key owned by a controller.
 	ControllerSSHKeyComment = "juju-system-key"
 )
+
+// ControllerCapabilities describes the features supported by the controller.
+// Clients should check for the presence of a capability instead of comparing
+// controller versions.
+type ControllerCapabilities struct {
+	// SupportsSecretAuditLog indicates whether the controller records an
+	// audit log of secret access.
+	SupportsSecretAuditLog bool
+
+	// SupportsBulkGrant indicates whether the controller can grant access to
+	// a secret for many subjects in a single call.
+	SupportsBulkGrant bool
+
+	// SupportsIncrementalBackup indicates whether the controller can take
+	// incremental backups.
+	SupportsIncrementalBackup bool
+}
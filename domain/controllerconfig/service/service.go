@@ -10,6 +10,7 @@ import (
 
 	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
@@ -34,6 +35,10 @@ type WatcherFactory interface {
 	// NewNamespaceWatcher returns a new namespace watcher
 	// for events based on the input change mask.
 	NewNamespaceWatcher(string, changestream.ChangeType, eventsource.NamespaceQuery) (watcher.StringsWatcher, error)
+
+	// NewNamespaceMapperWatcher returns a new namespace watcher
+	// for events based on the input change mask and mapper.
+	NewNamespaceMapperWatcher(string, changestream.ChangeType, eventsource.NamespaceQuery, eventsource.Mapper) (watcher.StringsWatcher, error)
 }
 
 // Service defines a service for interacting with the underlying state.
@@ -259,3 +264,34 @@ var InitialNamespaceChanges = eventsource.InitialNamespaceChanges
 func (s *WatchableService) WatchControllerConfig() (watcher.StringsWatcher, error) {
 	return s.watcherFactory.NewNamespaceWatcher("controller_config", changestream.All, InitialNamespaceChanges(s.st.AllKeysQuery()))
 }
+
+// WatchControllerConfigChanges returns a watcher that emits the names of the
+// controller config keys that have changed. Unlike WatchControllerConfig, the
+// initial event is empty, so that workers applying config changes live only
+// act on keys that changed after they subscribed. Each key is emitted at most
+// once per event.
+func (s *WatchableService) WatchControllerConfigChanges(ctx context.Context) (watcher.StringsWatcher, error) {
+	return s.watcherFactory.NewNamespaceMapperWatcher(
+		"controller_config",
+		changestream.All,
+		eventsource.EmptyInitialNamespaceChanges(),
+		uniqueKeysMapper,
+	)
+}
+
+// uniqueKeysMapper drops repeated changes to the same config key from a batch
+// of change events, keeping the first.
+func uniqueKeysMapper(
+	_ context.Context, _ database.TxnRunner, events []changestream.ChangeEvent,
+) ([]changestream.ChangeEvent, error) {
+	seen := make(map[string]struct{}, len(events))
+	result := make([]changestream.ChangeEvent, 0, len(events))
+	for _, event := range events {
+		if _, ok := seen[event.Changed()]; ok {
+			continue
+		}
+		seen[event.Changed()] = struct{}{}
+		result = append(result, event)
+	}
+	return result, nil
+}
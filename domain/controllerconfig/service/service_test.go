@@ -175,6 +175,30 @@ func (s *serviceSuite) TestWatch(c *gc.C) {
 	c.Assert(w, gc.NotNil)
 }
 
+func (s *serviceSuite) TestWatchControllerConfigChanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.watcherFactory.EXPECT().NewNamespaceMapperWatcher("controller_config", changestream.All, gomock.Any(), gomock.Any()).Return(s.stringsWatcher, nil)
+
+	w, err := NewWatchableService(s.state, s.watcherFactory).WatchControllerConfigChanges(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(w, gc.NotNil)
+}
+
+func (s *serviceSuite) TestUniqueKeysMapper(c *gc.C) {
+	in := []changestream.ChangeEvent{
+		changeEvent{ctype: changestream.Update, changed: controller.APIPort},
+		changeEvent{ctype: changestream.Update, changed: controller.LoginTokenRefreshURL},
+		changeEvent{ctype: changestream.Delete, changed: controller.APIPort},
+	}
+	out, err := uniqueKeysMapper(context.Background(), nil, in)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(out, jc.DeepEquals, []changestream.ChangeEvent{
+		changeEvent{ctype: changestream.Update, changed: controller.APIPort},
+		changeEvent{ctype: changestream.Update, changed: controller.LoginTokenRefreshURL},
+	})
+}
+
 func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -226,3 +250,20 @@ func makeMinimalConfig(objectType string) (controller.Config, map[string]string)
 			controller.ObjectStoreType:     objectType,
 		}
 }
+
+type changeEvent struct {
+	ctype   changestream.ChangeType
+	changed string
+}
+
+func (c changeEvent) Type() changestream.ChangeType {
+	return c.ctype
+}
+
+func (c changeEvent) Namespace() string {
+	return "controller_config"
+}
+
+func (c changeEvent) Changed() string {
+	return c.changed
+}
@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	controllernode "github.com/juju/juju/domain/controllernode"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -77,6 +78,83 @@ func (c *MockStateCurateNodesCall) DoAndReturn(f func(context.Context, []string,
 	return c
 }
 
+// ListControllerAPIEndpoints mocks base method.
+func (m *MockState) ListControllerAPIEndpoints(arg0 context.Context) ([]controllernode.ControllerAPIEndpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListControllerAPIEndpoints", arg0)
+	ret0, _ := ret[0].([]controllernode.ControllerAPIEndpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListControllerAPIEndpoints indicates an expected call of ListControllerAPIEndpoints.
+func (mr *MockStateMockRecorder) ListControllerAPIEndpoints(arg0 any) *MockStateListControllerAPIEndpointsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListControllerAPIEndpoints", reflect.TypeOf((*MockState)(nil).ListControllerAPIEndpoints), arg0)
+	return &MockStateListControllerAPIEndpointsCall{Call: call}
+}
+
+// MockStateListControllerAPIEndpointsCall wrap *gomock.Call
+type MockStateListControllerAPIEndpointsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListControllerAPIEndpointsCall) Return(arg0 []controllernode.ControllerAPIEndpoint, arg1 error) *MockStateListControllerAPIEndpointsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListControllerAPIEndpointsCall) Do(f func(context.Context) ([]controllernode.ControllerAPIEndpoint, error)) *MockStateListControllerAPIEndpointsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListControllerAPIEndpointsCall) DoAndReturn(f func(context.Context) ([]controllernode.ControllerAPIEndpoint, error)) *MockStateListControllerAPIEndpointsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RecordAPIServerHeartbeat mocks base method.
+func (m *MockState) RecordAPIServerHeartbeat(arg0 context.Context, arg1 string, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordAPIServerHeartbeat", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordAPIServerHeartbeat indicates an expected call of RecordAPIServerHeartbeat.
+func (mr *MockStateMockRecorder) RecordAPIServerHeartbeat(arg0, arg1, arg2 any) *MockStateRecordAPIServerHeartbeatCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordAPIServerHeartbeat", reflect.TypeOf((*MockState)(nil).RecordAPIServerHeartbeat), arg0, arg1, arg2)
+	return &MockStateRecordAPIServerHeartbeatCall{Call: call}
+}
+
+// MockStateRecordAPIServerHeartbeatCall wrap *gomock.Call
+type MockStateRecordAPIServerHeartbeatCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRecordAPIServerHeartbeatCall) Return(arg0 error) *MockStateRecordAPIServerHeartbeatCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRecordAPIServerHeartbeatCall) Do(f func(context.Context, string, int) error) *MockStateRecordAPIServerHeartbeatCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRecordAPIServerHeartbeatCall) DoAndReturn(f func(context.Context, string, int) error) *MockStateRecordAPIServerHeartbeatCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SelectDatabaseNamespace mocks base method.
 func (m *MockState) SelectDatabaseNamespace(arg0 context.Context, arg1 string) (string, error) {
 	m.ctrl.T.Helper()
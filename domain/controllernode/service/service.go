@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/errors"
 
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 )
 
@@ -18,6 +19,8 @@ type State interface {
 	CurateNodes(context.Context, []string, []string) error
 	UpdateDqliteNode(context.Context, string, uint64, string) error
 	SelectDatabaseNamespace(context.Context, string) (string, error)
+	RecordAPIServerHeartbeat(context.Context, string, int) error
+	ListControllerAPIEndpoints(context.Context) ([]controllernode.ControllerAPIEndpoint, error)
 }
 
 // Service provides the API for working with controller nodes.
@@ -61,3 +64,24 @@ func (s *Service) IsKnownDatabaseNamespace(ctx context.Context, namespace string
 
 	return ns == namespace, nil
 }
+
+// RecordAPIServerHeartbeat records that the API server of the input
+// controller is up and listening on the input port.
+// If the controller is not known, an error satisfying
+// [controllernodeerrors.NotFound] is returned.
+func (s *Service) RecordAPIServerHeartbeat(ctx context.Context, controllerID string, port int) error {
+	err := s.st.RecordAPIServerHeartbeat(ctx, controllerID, port)
+	return errors.Annotatef(err, "recording API server heartbeat for %q", controllerID)
+}
+
+// ListControllerAPIEndpoints returns the API endpoint of every controller
+// node along with the time its API server last reported a heartbeat. Use
+// [controllernode.ControllerAPIEndpoint.IsStale] to find the endpoints that
+// may be down.
+func (s *Service) ListControllerAPIEndpoints(ctx context.Context) ([]controllernode.ControllerAPIEndpoint, error) {
+	endpoints, err := s.st.ListControllerAPIEndpoints(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "listing controller API endpoints")
+	}
+	return endpoints, nil
+}
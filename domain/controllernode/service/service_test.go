@@ -5,12 +5,14 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 )
 
@@ -40,6 +42,43 @@ func (s *serviceSuite) TestUpdateDqliteNode(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestRecordAPIServerHeartbeat(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().RecordAPIServerHeartbeat(gomock.Any(), "0", 17070).Return(nil)
+
+	err := NewService(s.state).RecordAPIServerHeartbeat(context.Background(), "0", 17070)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestListControllerAPIEndpoints(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	endpoints := []controllernode.ControllerAPIEndpoint{{
+		ControllerID:  "0",
+		Address:       "192.168.5.60",
+		Port:          17070,
+		LastHeartbeat: time.Now(),
+	}}
+	s.state.EXPECT().ListControllerAPIEndpoints(gomock.Any()).Return(endpoints, nil)
+
+	result, err := NewService(s.state).ListControllerAPIEndpoints(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, endpoints)
+}
+
+func (s *serviceSuite) TestControllerAPIEndpointIsStale(c *gc.C) {
+	now := time.Now()
+	endpoint := controllernode.ControllerAPIEndpoint{LastHeartbeat: now.Add(-time.Minute)}
+	c.Check(endpoint.IsStale(now), jc.IsFalse)
+
+	endpoint.LastHeartbeat = now.Add(-3 * time.Minute)
+	c.Check(endpoint.IsStale(now), jc.IsTrue)
+
+	// An endpoint that has never reported a heartbeat is stale.
+	c.Check(controllernode.ControllerAPIEndpoint{}.IsStale(now), jc.IsTrue)
+}
+
 func (s *serviceSuite) TestIsModelKnownToController(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strconv"
 
@@ -13,6 +14,7 @@ import (
 
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 )
 
@@ -111,6 +113,87 @@ AND    (dqlite_node_id != $dbControllerNode.dqlite_node_id OR bind_address != $d
 	}))
 }
 
+// RecordAPIServerHeartbeat records that the API server of the input
+// controller is listening on the input port as of now. The time is taken from
+// the database, so that heartbeats from all controllers share a clock.
+// If the controller is not known, an error satisfying
+// [controllernodeerrors.NotFound] is returned.
+func (st *State) RecordAPIServerHeartbeat(ctx context.Context, controllerID string, port int) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	endpoint := dbControllerAPIEndpoint{
+		ControllerID: controllerID,
+		APIPort:      sql.NullInt64{Int64: int64(port), Valid: true},
+	}
+
+	stmt, err := st.Prepare(`
+UPDATE controller_node
+SET    api_port = $dbControllerAPIEndpoint.api_port,
+       last_heartbeat = datetime('now')
+WHERE  controller_id = $dbControllerAPIEndpoint.controller_id`, endpoint)
+	if err != nil {
+		return errors.Annotate(err, "preparing update API server heartbeat statement")
+	}
+
+	return errors.Trace(db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, endpoint).Get(&outcome); err != nil {
+			return errors.Annotatef(err, "recording API server heartbeat for controller %q", controllerID)
+		}
+		if affected, err := outcome.Result().RowsAffected(); err != nil {
+			return errors.Annotatef(err, "recording API server heartbeat for controller %q", controllerID)
+		} else if affected == 0 {
+			return fmt.Errorf("controller %q %w", controllerID, controllernodeerrors.NotFound)
+		}
+		return nil
+	}))
+}
+
+// ListControllerAPIEndpoints returns the API endpoints of all the controller
+// nodes, ordered by controller ID.
+func (st *State) ListControllerAPIEndpoints(ctx context.Context) ([]controllernode.ControllerAPIEndpoint, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &dbControllerAPIEndpoint.*
+FROM   controller_node
+ORDER BY controller_id`, dbControllerAPIEndpoint{})
+	if err != nil {
+		return nil, errors.Annotate(err, "preparing select controller API endpoints statement")
+	}
+
+	var endpoints []dbControllerAPIEndpoint
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&endpoints)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotate(err, "selecting controller API endpoints")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]controllernode.ControllerAPIEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		result[i] = controllernode.ControllerAPIEndpoint{
+			ControllerID: endpoint.ControllerID,
+			Address:      endpoint.BindAddress.String,
+			Port:         int(endpoint.APIPort.Int64),
+		}
+		if endpoint.LastHeartbeat.Valid {
+			result[i].LastHeartbeat = endpoint.LastHeartbeat.Time.UTC()
+		}
+	}
+	return result, nil
+}
+
 // SelectDatabaseNamespace is responsible for selecting and returning the
 // database namespace specified by namespace. If no namespace is registered an
 // error satisfying [errors.NotFound] is returned.
@@ -5,11 +5,13 @@ package state
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/collections/set"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/domain/controllernode"
 	controllernodeerrors "github.com/juju/juju/domain/controllernode/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 )
@@ -72,6 +74,63 @@ func (s *stateSuite) TestUpdateDqliteNode(c *gc.C) {
 	c.Check(addr, gc.Equals, "192.168.5.60")
 }
 
+func (s *stateSuite) TestListControllerAPIEndpoints(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.CurateNodes(context.Background(), []string{"1"}, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.UpdateDqliteNode(context.Background(), "0", 1, "192.168.5.60")
+	c.Assert(err, jc.ErrorIsNil)
+
+	before := time.Now().UTC().Add(-time.Second)
+	err = st.RecordAPIServerHeartbeat(context.Background(), "0", 17070)
+	c.Assert(err, jc.ErrorIsNil)
+
+	endpoints, err := st.ListControllerAPIEndpoints(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(endpoints, gc.HasLen, 2)
+
+	c.Check(endpoints[0].ControllerID, gc.Equals, "0")
+	c.Check(endpoints[0].Address, gc.Equals, "192.168.5.60")
+	c.Check(endpoints[0].Port, gc.Equals, 17070)
+	c.Check(endpoints[0].LastHeartbeat.After(before), jc.IsTrue)
+
+	// A node that has not yet started has no endpoint details.
+	c.Check(endpoints[1], gc.DeepEquals, controllernode.ControllerAPIEndpoint{
+		ControllerID: "1",
+	})
+}
+
+func (s *stateSuite) TestRecordAPIServerHeartbeatNoChangeLog(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+
+	err := st.RecordAPIServerHeartbeat(context.Background(), "0", 17070)
+	c.Assert(err, jc.ErrorIsNil)
+
+	changes := func() int {
+		var count int
+		row := s.DB().QueryRowContext(context.Background(), `
+SELECT COUNT(*)
+FROM   change_log AS c
+JOIN   change_log_namespace AS n ON c.namespace_id = n.id
+WHERE  n.namespace = 'controller_node'`)
+		c.Assert(row.Scan(&count), jc.ErrorIsNil)
+		return count
+	}
+	before := changes()
+
+	// A heartbeat which only moves the time on must not be reported as a
+	// change to the node, as every API server records one each minute.
+	err = st.RecordAPIServerHeartbeat(context.Background(), "0", 17070)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(changes(), gc.Equals, before)
+}
+
+func (s *stateSuite) TestRecordAPIServerHeartbeatNotFound(c *gc.C) {
+	err := NewState(s.TxnRunnerFactory()).RecordAPIServerHeartbeat(context.Background(), "42", 17070)
+	c.Assert(err, jc.ErrorIs, controllernodeerrors.NotFound)
+}
+
 // TestSelectDatabaseNamespace is testing success for existing namespaces and
 // a not found error for namespaces that don't exist.
 func (s *stateSuite) TestSelectDatabaseNamespace(c *gc.C) {
@@ -3,6 +3,8 @@
 
 package state
 
+import "database/sql"
+
 // dbControllerNode is the database representation of a controller node.
 type dbControllerNode struct {
 	// ControllerID is the nodes controller ID.
@@ -16,6 +18,24 @@ type dbControllerNode struct {
 	BindAddress string `db:"bind_address"`
 }
 
+// dbControllerAPIEndpoint is the database representation of the API endpoint
+// of a controller node.
+type dbControllerAPIEndpoint struct {
+	// ControllerID is the nodes controller ID.
+	ControllerID string `db:"controller_id"`
+
+	// BindAddress is the IP address (no port) that Dqlite is bound to, which
+	// is also the address of the node's API server.
+	BindAddress sql.NullString `db:"bind_address"`
+
+	// APIPort is the port that the node's API server listens on.
+	APIPort sql.NullInt64 `db:"api_port"`
+
+	// LastHeartbeat is the last time the node's API server reported itself
+	// as up.
+	LastHeartbeat sql.NullTime `db:"last_heartbeat"`
+}
+
 type dbNamespace struct {
 	Namespace string `db:"namespace"`
 }
@@ -0,0 +1,32 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package controllernode
+
+import "time"
+
+// APIEndpointStaleAfter is how long after its last heartbeat an API endpoint
+// is considered stale. The API server reports a heartbeat every minute, so
+// this allows for a single missed beat.
+const APIEndpointStaleAfter = 2 * time.Minute
+
+// ControllerAPIEndpoint describes the API endpoint of a controller node.
+type ControllerAPIEndpoint struct {
+	// ControllerID is the ID of the controller node.
+	ControllerID string
+	// Address is the address of the controller node. It is empty if the
+	// node has not yet started.
+	Address string
+	// Port is the port that the node's API server listens on. It is zero
+	// if the API server has never reported a heartbeat.
+	Port int
+	// LastHeartbeat is the last time the node's API server reported a
+	// heartbeat. It is zero if it never has.
+	LastHeartbeat time.Time
+}
+
+// IsStale reports whether the endpoint has not reported a heartbeat within
+// [APIEndpointStaleAfter] of the input time.
+func (e ControllerAPIEndpoint) IsStale(now time.Time) bool {
+	return now.Sub(e.LastHeartbeat) > APIEndpointStaleAfter
+}
@@ -34,6 +34,22 @@ func MarshallConfigType(configType corenetwork.AddressConfigType) ConfigType {
 	return ConfigTypeUnknown
 }
 
+// UnmarshallConfigType converts a db config type id to an IP address config
+// type.
+func UnmarshallConfigType(configType ConfigType) corenetwork.AddressConfigType {
+	switch configType {
+	case ConfigTypeDHCP:
+		return corenetwork.ConfigDHCP
+	case ConfigTypeStatic:
+		return corenetwork.ConfigStatic
+	case ConfigTypeManual:
+		return corenetwork.ConfigManual
+	case ConfigTypeLoopback:
+		return corenetwork.ConfigLoopback
+	}
+	return corenetwork.ConfigUnknown
+}
+
 // Scope represents the scope of an IP address, as recorded in
 // the ip_address_scope lookup table.
 type Scope int
@@ -61,6 +77,21 @@ func MarshallScope(scope corenetwork.Scope) Scope {
 	return ScopeUnknown
 }
 
+// UnmarshallScope converts a db scope id to an address scope.
+func UnmarshallScope(scope Scope) corenetwork.Scope {
+	switch scope {
+	case ScopePublic:
+		return corenetwork.ScopePublic
+	case ScopeCloudLocal:
+		return corenetwork.ScopeCloudLocal
+	case ScopeMachineLocal:
+		return corenetwork.ScopeMachineLocal
+	case ScopeLinkLocal:
+		return corenetwork.ScopeLinkLocal
+	}
+	return corenetwork.ScopeUnknown
+}
+
 // AddressType represents the type of an IP address, as recorded in
 // the ip_address_type lookup table.
 type AddressType int
@@ -81,6 +112,14 @@ func MarshallAddressType(addressType corenetwork.AddressType) AddressType {
 	return AddressTypeIPv4
 }
 
+// UnmarshallAddressType converts a db address type id to an address type.
+func UnmarshallAddressType(addressType AddressType) corenetwork.AddressType {
+	if addressType == AddressTypeIPv6 {
+		return corenetwork.IPv6Address
+	}
+	return corenetwork.IPv4Address
+}
+
 // Origin represents the origin of an IP address, as recorded in
 // the ip_address_origin lookup table.
 type Origin int
@@ -100,3 +139,11 @@ func MarshallOrigin(origin corenetwork.Origin) Origin {
 	}
 	return OriginHost
 }
+
+// UnmarshallOrigin converts a db origin id to an address origin.
+func UnmarshallOrigin(origin Origin) corenetwork.Origin {
+	if origin == OriginProvider {
+		return corenetwork.OriginProvider
+	}
+	return corenetwork.OriginMachine
+}
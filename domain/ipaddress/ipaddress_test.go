@@ -7,6 +7,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
+	corenetwork "github.com/juju/juju/core/network"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 )
 
@@ -119,3 +120,38 @@ func (s *ipAddressSuite) TestOriginDBValues(c *gc.C) {
 		OriginProvider: "provider",
 	})
 }
+
+// TestUnmarshallRoundTrip ensures that the values which can be marshalled
+// to db ids are unmarshalled back to the same values.
+func (s *ipAddressSuite) TestUnmarshallRoundTrip(c *gc.C) {
+	for _, configType := range []corenetwork.AddressConfigType{
+		corenetwork.ConfigUnknown,
+		corenetwork.ConfigDHCP,
+		corenetwork.ConfigStatic,
+		corenetwork.ConfigManual,
+		corenetwork.ConfigLoopback,
+	} {
+		c.Check(UnmarshallConfigType(MarshallConfigType(configType)), gc.Equals, configType)
+	}
+	for _, scope := range []corenetwork.Scope{
+		corenetwork.ScopeUnknown,
+		corenetwork.ScopePublic,
+		corenetwork.ScopeCloudLocal,
+		corenetwork.ScopeMachineLocal,
+		corenetwork.ScopeLinkLocal,
+	} {
+		c.Check(UnmarshallScope(MarshallScope(scope)), gc.Equals, scope)
+	}
+	for _, addressType := range []corenetwork.AddressType{
+		corenetwork.IPv4Address,
+		corenetwork.IPv6Address,
+	} {
+		c.Check(UnmarshallAddressType(MarshallAddressType(addressType)), gc.Equals, addressType)
+	}
+	for _, origin := range []corenetwork.Origin{
+		corenetwork.OriginMachine,
+		corenetwork.OriginProvider,
+	} {
+		c.Check(UnmarshallOrigin(MarshallOrigin(origin)), gc.Equals, origin)
+	}
+}
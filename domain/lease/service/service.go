@@ -23,6 +23,7 @@ type State interface {
 	UnpinLease(context.Context, lease.Key, string) error
 	Pinned(context.Context) (map[lease.Key][]string, error)
 	ExpireLeases(context.Context) error
+	ExpireLeaseGroup(context.Context, string, string) error
 }
 
 // Service provides the API for working with external controllers.
@@ -117,3 +118,11 @@ func (s *Service) Pinned(ctx context.Context) (map[lease.Key][]string, error) {
 func (s *Service) ExpireLeases(ctx context.Context) error {
 	return s.st.ExpireLeases(ctx)
 }
+
+// ExpireLeaseGroup deletes the expired leases for the input namespace and
+// model, in a single transaction. Only leases that have genuinely expired and
+// are not pinned are deleted.
+// Leases of a model being removed are removed along with the model.
+func (s *Service) ExpireLeaseGroup(ctx context.Context, namespace, modelUUID string) error {
+	return s.st.ExpireLeaseGroup(ctx, namespace, modelUUID)
+}
@@ -193,6 +193,16 @@ func (s *serviceSuite) TestExpireLeases(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestExpireLeaseGroup(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().ExpireLeaseGroup(gomock.Any(), "application-leadership", "model-uuid").Return(nil)
+
+	service := NewService(s.state)
+	err := service.ExpireLeaseGroup(context.Background(), "application-leadership", "model-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
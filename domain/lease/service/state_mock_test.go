@@ -79,6 +79,44 @@ func (c *MockStateClaimLeaseCall) DoAndReturn(f func(context.Context, uuid.UUID,
 	return c
 }
 
+// ExpireLeaseGroup mocks base method.
+func (m *MockState) ExpireLeaseGroup(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireLeaseGroup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireLeaseGroup indicates an expected call of ExpireLeaseGroup.
+func (mr *MockStateMockRecorder) ExpireLeaseGroup(arg0, arg1, arg2 any) *MockStateExpireLeaseGroupCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireLeaseGroup", reflect.TypeOf((*MockState)(nil).ExpireLeaseGroup), arg0, arg1, arg2)
+	return &MockStateExpireLeaseGroupCall{Call: call}
+}
+
+// MockStateExpireLeaseGroupCall wrap *gomock.Call
+type MockStateExpireLeaseGroupCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateExpireLeaseGroupCall) Return(arg0 error) *MockStateExpireLeaseGroupCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateExpireLeaseGroupCall) Do(f func(context.Context, string, string) error) *MockStateExpireLeaseGroupCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateExpireLeaseGroupCall) DoAndReturn(f func(context.Context, string, string) error) *MockStateExpireLeaseGroupCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ExpireLeases mocks base method.
 func (m *MockState) ExpireLeases(arg0 context.Context) error {
 	m.ctrl.T.Helper()
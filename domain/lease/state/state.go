@@ -433,7 +433,8 @@ ORDER BY l.uuid;`, Lease{}, LeasePin{})
 }
 
 // ExpireLeases (lease.Store) deletes all leases that have expired, from the
-// store. This method is intended to be called periodically by a worker.
+// store. Leases with at least one pin are retained regardless of their expiry.
+// This method is intended to be called periodically by a worker.
 func (s *State) ExpireLeases(ctx context.Context) error {
 	db, err := s.DB()
 	if err != nil {
@@ -443,9 +444,15 @@ func (s *State) ExpireLeases(ctx context.Context) error {
 	// This is split into two queries to avoid a write transaction preventing
 	// other writers from writing to the db, even if there is no writes
 	// occurring.
+	// Pinned leases are never expired, so they are excluded from the count
+	// as well as the deletion; otherwise a pinned lease past its expiry would
+	// cause a write transaction on every call.
 	count := Count{}
 	countStmt, err := s.Prepare(`
-SELECT COUNT(*) AS &Count.num FROM lease WHERE expiry < datetime('now');
+SELECT COUNT(*) AS &Count.num
+FROM   lease l LEFT JOIN lease_pin p ON l.uuid = p.lease_uuid
+WHERE  p.uuid IS NULL
+AND    l.expiry < datetime('now');
 `, count)
 	if err != nil {
 		return errors.Annotate(err, "preparing select expired count statement")
@@ -503,3 +510,98 @@ DELETE FROM lease WHERE uuid in (
 	})
 	return errors.Trace(err)
 }
+
+// ExpireLeaseGroup deletes the expired leases in the input namespace for the
+// input model, in a single transaction. As with [State.ExpireLeases], leases
+// with at least one pin are retained regardless of their expiry, and leases
+// which have not yet expired are left in place.
+func (s *State) ExpireLeaseGroup(ctx context.Context, namespace, modelUUID string) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	lease := Lease{
+		ModelUUID: modelUUID,
+		Type:      namespace,
+	}
+
+	stmt, err := s.Prepare(`
+DELETE FROM lease WHERE uuid IN (
+    SELECT l.uuid
+    FROM   lease l
+           JOIN lease_type t ON l.lease_type_id = t.id
+           LEFT JOIN lease_pin p ON l.uuid = p.lease_uuid
+    WHERE  t.type = $Lease.type
+    AND    l.model_uuid = $Lease.model_uuid
+    AND    p.uuid IS NULL
+    AND    l.expiry < datetime('now')
+);`, lease)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete expired lease group statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, lease).Get(&outcome); err != nil {
+			return errors.Trace(err)
+		}
+
+		expired, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if expired > 0 {
+			s.logger.Infof("expired %d %q leases for model %q", expired, namespace, modelUUID)
+		}
+		return nil
+	})
+	return errors.Trace(err)
+}
+
+// RemoveLeaseGroup deletes every lease in the input namespace for the input
+// model, along with any pins on them, regardless of expiry or pins. The
+// holders lose their leases without being told.
+//
+// This is only for use when tearing down a model, from within the
+// transaction that removes the model. It is deliberately not a method on
+// [State], so that it cannot be reached through the lease store. Calling it
+// for a live model would, for instance, leave every application in the
+// model without a leader at once.
+func RemoveLeaseGroup(ctx context.Context, tx *sqlair.TX, namespace, modelUUID string) error {
+	lease := Lease{
+		ModelUUID: modelUUID,
+		Type:      namespace,
+	}
+
+	// Pins reference the lease, so they must be removed first.
+	deletePinsStmt, err := sqlair.Prepare(`
+DELETE FROM lease_pin WHERE lease_uuid IN (
+    SELECT l.uuid
+    FROM   lease l JOIN lease_type t ON l.lease_type_id = t.id
+    WHERE  t.type = $Lease.type
+    AND    l.model_uuid = $Lease.model_uuid
+);`, lease)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete lease group pins statement")
+	}
+
+	deleteLeasesStmt, err := sqlair.Prepare(`
+DELETE FROM lease WHERE uuid IN (
+    SELECT l.uuid
+    FROM   lease l JOIN lease_type t ON l.lease_type_id = t.id
+    WHERE  t.type = $Lease.type
+    AND    l.model_uuid = $Lease.model_uuid
+);`, lease)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete lease group statement")
+	}
+
+	if err := tx.Query(ctx, deletePinsStmt, lease).Run(); err != nil {
+		return errors.Annotatef(err, "removing %q lease pins for model %q", namespace, modelUUID)
+	}
+	if err := tx.Query(ctx, deleteLeasesStmt, lease).Run(); err != nil {
+		return errors.Annotatef(err, "removing %q leases for model %q", namespace, modelUUID)
+	}
+	return nil
+}
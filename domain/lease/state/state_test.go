@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/canonical/sqlair"
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
 
@@ -284,3 +285,127 @@ VALUES (?, 1, 'some-model-uuid', ?, ?, datetime('now'), datetime('now', ?))`[1:]
 
 	c.Check(name, gc.Equals, "postgresql")
 }
+
+func (s *stateSuite) TestWorkerRetainsPinnedExpiredLeases(c *gc.C) {
+	q := `
+INSERT INTO lease (uuid, lease_type_id, model_uuid, name, holder, start, expiry)
+VALUES (?, 1, 'some-model-uuid', 'redis', 'redis/0', datetime('now'), datetime('now', '-2 minutes'))`[1:]
+
+	_, err := s.DB().Exec(q, uuid.MustNewUUID().String())
+	c.Assert(err, jc.ErrorIsNil)
+
+	key := corelease.Key{
+		Namespace: "application-leadership",
+		ModelUUID: "some-model-uuid",
+		Lease:     "redis",
+	}
+	err = s.store.PinLease(context.Background(), key, "machine/6")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The pinned lease survives expiry, despite being past its expiry time.
+	err = s.store.ExpireLeases(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	leases, err := s.store.Leases(context.Background(), key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leases[key].Holder, gc.Equals, "redis/0")
+
+	// Once unpinned, it is expired as normal.
+	err = s.store.UnpinLease(context.Background(), key, "machine/6")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.store.ExpireLeases(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+
+	leases, err = s.store.Leases(context.Background(), key)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leases, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestExpireLeaseGroup(c *gc.C) {
+	q := `
+INSERT INTO lease (uuid, lease_type_id, model_uuid, name, holder, start, expiry)
+VALUES (?, ?, ?, ?, ?, datetime('now'), datetime('now', ?))`[1:]
+
+	// Lease type 0 is singular-controller, 1 is application-leadership.
+	leases := []struct {
+		typeID           int
+		model, name, ttl string
+	}{
+		{typeID: 1, model: "some-model-uuid", name: "redis", ttl: "-2 minutes"},
+		{typeID: 1, model: "some-model-uuid", name: "pinned", ttl: "-2 minutes"},
+		{typeID: 1, model: "some-model-uuid", name: "postgresql", ttl: "+2 minutes"},
+		{typeID: 1, model: "other-model-uuid", name: "redis", ttl: "-2 minutes"},
+		{typeID: 0, model: "some-model-uuid", name: "some-model-uuid", ttl: "-2 minutes"},
+	}
+	for _, l := range leases {
+		_, err := s.DB().Exec(q, uuid.MustNewUUID().String(), l.typeID, l.model, l.name, l.name+"/0", l.ttl)
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	err := s.store.PinLease(context.Background(), corelease.Key{
+		Namespace: "application-leadership",
+		ModelUUID: "some-model-uuid",
+		Lease:     "pinned",
+	}, "machine/6")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.store.ExpireLeaseGroup(context.Background(), "application-leadership", "some-model-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only the expired, unpinned lease in the group is gone.
+	rows, err := s.DB().Query("SELECT model_uuid, name FROM lease ORDER BY model_uuid, name")
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	var remaining []string
+	for rows.Next() {
+		var model, name string
+		c.Assert(rows.Scan(&model, &name), jc.ErrorIsNil)
+		remaining = append(remaining, model+":"+name)
+	}
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+	c.Check(remaining, jc.DeepEquals, []string{
+		"other-model-uuid:redis",
+		"some-model-uuid:pinned",
+		"some-model-uuid:postgresql",
+		"some-model-uuid:some-model-uuid",
+	})
+}
+
+func (s *stateSuite) TestRemoveLeaseGroup(c *gc.C) {
+	q := `
+INSERT INTO lease (uuid, lease_type_id, model_uuid, name, holder, start, expiry)
+VALUES (?, 1, ?, ?, ?, datetime('now'), datetime('now', '+2 minutes'))`[1:]
+
+	_, err := s.DB().Exec(q, uuid.MustNewUUID().String(), "some-model-uuid", "redis", "redis/0")
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(q, uuid.MustNewUUID().String(), "other-model-uuid", "redis", "redis/0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	key := corelease.Key{
+		Namespace: "application-leadership",
+		ModelUUID: "some-model-uuid",
+		Lease:     "redis",
+	}
+	err = s.store.PinLease(context.Background(), key, "machine/6")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Unexpired and pinned leases are removed all the same.
+	err = s.TxnRunner().Txn(context.Background(), func(ctx context.Context, tx *sqlair.TX) error {
+		return state.RemoveLeaseGroup(ctx, tx, "application-leadership", "some-model-uuid")
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	leases, err := s.store.LeaseGroup(context.Background(), "application-leadership", "some-model-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leases, gc.HasLen, 0)
+
+	pinned, err := s.store.Pinned(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pinned, gc.HasLen, 0)
+
+	leases, err = s.store.LeaseGroup(context.Background(), "application-leadership", "other-model-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leases, gc.HasLen, 1)
+}
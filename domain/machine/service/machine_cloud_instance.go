@@ -5,10 +5,23 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/environs/envcontext"
+)
+
+const (
+	// hardwareCharacteristicsRefreshInterval is how long cached hardware
+	// characteristics are used before they are refreshed from the provider.
+	hardwareCharacteristicsRefreshInterval = time.Hour
+
+	// hardwareCharacteristicsRefreshTimeout bounds a refresh of hardware
+	// characteristics from the provider.
+	hardwareCharacteristicsRefreshTimeout = time.Minute
 )
 
 // InstanceID returns the cloud specific instance id for this machine.
@@ -47,6 +60,93 @@ func (s *Service) HardwareCharacteristics(ctx context.Context, machineUUID strin
 	return hc, errors.Annotatef(err, "retrieving hardware characteristics for machine %q", machineUUID)
 }
 
+// GetMachineHardwareCharacteristics returns the hardware characteristics of
+// the specified machine's instance. They are read from the cache populated
+// when the machine was provisioned, and only fetched from the provider if
+// nothing is cached for the current instance. Cached characteristics older
+// than an hour are returned, and refreshed from the provider in the
+// background. Concurrent requests for the same machine share one refresh.
+// If the provider can't report hardware characteristics, those recorded when
+// the machine was provisioned are returned.
+// If the machine is not provisioned, it returns a
+// [github.com/juju/juju/domain/machine/errors.NotProvisioned]
+func (s *ProviderService) GetMachineHardwareCharacteristics(ctx context.Context, machineUUID string) (instance.HardwareCharacteristics, error) {
+	cache, err := s.st.GetHardwareCharacteristicsCache(ctx, machineUUID)
+	if err != nil {
+		return instance.HardwareCharacteristics{}, errors.Annotatef(err, "retrieving hardware characteristics for machine %q", machineUUID)
+	}
+
+	if cache.HardwareCharacteristics == nil {
+		var result singleflight.Result
+		select {
+		case result = <-s.sharedHardwareCharacteristicsRefresh(ctx, machineUUID, cache.InstanceID):
+		case <-ctx.Done():
+			return instance.HardwareCharacteristics{}, ctx.Err()
+		}
+		hc, err := result.Val.(*instance.HardwareCharacteristics), result.Err
+		if errors.Is(err, errors.NotSupported) {
+			hc, err = s.st.HardwareCharacteristics(ctx, machineUUID)
+		}
+		if err != nil {
+			return instance.HardwareCharacteristics{}, errors.Annotatef(err, "retrieving hardware characteristics for machine %q", machineUUID)
+		}
+		return *hc, nil
+	}
+
+	if s.clock.Now().Sub(cache.LastRefreshed) >= hardwareCharacteristicsRefreshInterval {
+		// The caller needn't wait on the provider for characteristics which
+		// rarely change, nor be cancelled by a refresh failing.
+		_ = s.sharedHardwareCharacteristicsRefresh(ctx, machineUUID, cache.InstanceID)
+	}
+	return *cache.HardwareCharacteristics, nil
+}
+
+// sharedHardwareCharacteristicsRefresh refreshes the hardware characteristics
+// of the machine's instance from the provider, returning a channel on which
+// the result is delivered. A refresh already in flight for the machine is
+// joined rather than another being started. The refresh outlives the context
+// of the caller which started it, but is bounded by
+// hardwareCharacteristicsRefreshTimeout.
+func (s *ProviderService) sharedHardwareCharacteristicsRefresh(
+	ctx context.Context, machineUUID string, instanceID instance.Id,
+) <-chan singleflight.Result {
+	ctx = context.WithoutCancel(ctx)
+	return s.hardwareRefreshes.DoChan(machineUUID, func() (any, error) {
+		ctx, cancel := context.WithTimeout(ctx, hardwareCharacteristicsRefreshTimeout)
+		defer cancel()
+		hc, err := s.refreshHardwareCharacteristics(ctx, machineUUID, instanceID)
+		if errors.Is(err, errors.NotSupported) {
+			s.logger.Tracef("provider can't refresh hardware characteristics for machine %q", machineUUID)
+		} else if err != nil {
+			s.logger.Warningf("refreshing hardware characteristics for machine %q: %v", machineUUID, err)
+		}
+		return hc, err
+	})
+}
+
+// refreshHardwareCharacteristics fetches the hardware characteristics of the
+// instance from the provider, and caches them for the machine. It returns an
+// error satisfying [errors.NotSupported] if the provider can't report them.
+func (s *ProviderService) refreshHardwareCharacteristics(
+	ctx context.Context, machineUUID string, instanceID instance.Id,
+) (*instance.HardwareCharacteristics, error) {
+	if s.hardwareProviderGetter == nil {
+		return nil, errors.NotSupportedf("hardware characteristics provider")
+	}
+	provider, err := s.hardwareProviderGetter(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	hc, err := provider.InstanceHardwareCharacteristics(envcontext.WithoutCredentialInvalidator(ctx), instanceID)
+	if err != nil {
+		return nil, errors.Annotatef(err, "fetching hardware characteristics of instance %q", instanceID)
+	}
+	if err := s.st.SetHardwareCharacteristicsCache(ctx, machineUUID, instanceID, *hc, s.clock.Now()); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return hc, nil
+}
+
 // SetMachineCloudInstance sets an entry in the machine cloud instance table
 // along with the instance tags and the link to a lxd profile if any.
 func (s *Service) SetMachineCloudInstance(
@@ -72,3 +172,47 @@ func (s *Service) DeleteMachineCloudInstance(ctx context.Context, machineUUID st
 	)
 
 }
+
+// GetMachineInstanceTags returns the tags of the specified machine's instance,
+// as last synced from the provider by [ProviderService.SyncMachineInstanceTags].
+// The result is empty if the tags have not been synced.
+// If the machine is not provisioned, it returns a
+// [github.com/juju/juju/domain/machine/errors.NotProvisioned]
+func (s *Service) GetMachineInstanceTags(ctx context.Context, machineUUID string) (map[string]string, error) {
+	tags, err := s.st.GetMachineInstanceTags(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Annotatef(err, "retrieving instance tags for machine %q", machineUUID)
+	}
+	return tags, nil
+}
+
+// SyncMachineInstanceTags fetches the tags of the specified machine's
+// instance from the provider, including any added directly in the cloud, and
+// records them in place of those previously synced.
+// If the provider can't report instance tags, it returns an error satisfying
+// [errors.NotSupported].
+// If the machine is not provisioned, it returns a
+// [github.com/juju/juju/domain/machine/errors.NotProvisioned]
+func (s *ProviderService) SyncMachineInstanceTags(ctx context.Context, machineUUID string) error {
+	instanceID, err := s.st.InstanceID(ctx, machineUUID)
+	if err != nil {
+		return errors.Annotatef(err, "syncing instance tags for machine %q", machineUUID)
+	}
+
+	if s.tagsProviderGetter == nil {
+		return errors.NotSupportedf("instance tags provider")
+	}
+	provider, err := s.tagsProviderGetter(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tags, err := provider.InstanceTags(envcontext.WithoutCredentialInvalidator(ctx), instance.Id(instanceID))
+	if err != nil {
+		return errors.Annotatef(err, "fetching tags of instance %q", instanceID)
+	}
+
+	if err := s.st.SetMachineInstanceTags(ctx, machineUUID, instance.Id(instanceID), tags); err != nil {
+		return errors.Annotatef(err, "syncing instance tags for machine %q", machineUUID)
+	}
+	return nil
+}
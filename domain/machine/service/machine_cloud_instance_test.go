@@ -5,13 +5,21 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/juju/clock/testclock"
 	"github.com/juju/errors"
+	"github.com/juju/testing"
 	jc "github.com/juju/testing/checkers"
 	gomock "go.uber.org/mock/gomock"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/instance"
+	domainmachine "github.com/juju/juju/domain/machine"
+	machineerrors "github.com/juju/juju/domain/machine/errors"
+	"github.com/juju/juju/environs/envcontext"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
 )
 
 func (s *serviceSuite) TestRetrieveHardwareCharacteristics(c *gc.C) {
@@ -116,3 +124,237 @@ func (s *serviceSuite) TestDeleteMachineCloudInstanceFails(c *gc.C) {
 func uintptr(u uint64) *uint64 {
 	return &u
 }
+
+func (s *serviceSuite) newProviderService(c *gc.C, clock *testclock.Clock) *ProviderService {
+	return &ProviderService{
+		Service: Service{st: s.state},
+		hardwareProviderGetter: func(context.Context) (HardwareCharacteristicsProvider, error) {
+			return s.hardwareProvider, nil
+		},
+		tagsProviderGetter: func(context.Context) (InstanceTagsProvider, error) {
+			return s.tagsProvider, nil
+		},
+		clock:             clock,
+		logger:            loggertesting.WrapCheckLog(c),
+		hardwareRefreshes: &singleflight.Group{},
+	}
+}
+
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsCached(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	clock := testclock.NewClock(time.Now())
+	hc := &instance.HardwareCharacteristics{
+		Mem:      uintptr(1024),
+		CpuCores: uintptr(4),
+	}
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{
+		InstanceID:              "i-123",
+		HardwareCharacteristics: hc,
+		LastRefreshed:           clock.Now().Add(-time.Minute),
+	}, nil)
+
+	result, err := s.newProviderService(c, clock).GetMachineHardwareCharacteristics(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, *hc)
+}
+
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsStale(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	clock := testclock.NewClock(time.Now())
+	hc := &instance.HardwareCharacteristics{
+		Mem: uintptr(1024),
+	}
+	refreshed := &instance.HardwareCharacteristics{
+		Mem: uintptr(2048),
+	}
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{
+		InstanceID:              "i-123",
+		HardwareCharacteristics: hc,
+		LastRefreshed:           clock.Now().Add(-2 * time.Hour),
+	}, nil)
+	s.hardwareProvider.EXPECT().InstanceHardwareCharacteristics(gomock.Any(), instance.Id("i-123")).Return(refreshed, nil)
+	done := make(chan struct{})
+	s.state.EXPECT().SetHardwareCharacteristicsCache(gomock.Any(), "42", instance.Id("i-123"), *refreshed, clock.Now()).
+		DoAndReturn(func(context.Context, string, instance.Id, instance.HardwareCharacteristics, time.Time) error {
+			close(done)
+			return nil
+		})
+
+	// The stale characteristics are returned, without waiting on the
+	// provider.
+	result, err := s.newProviderService(c, clock).GetMachineHardwareCharacteristics(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, *hc)
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("hardware characteristics not refreshed")
+	}
+}
+
+// TestGetMachineHardwareCharacteristicsStaleSharedRefresh asserts that
+// concurrent requests for stale characteristics share a single refresh from
+// the provider, even when made through different services sharing the same
+// refreshes.
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsStaleSharedRefresh(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	clock := testclock.NewClock(time.Now())
+	hc := &instance.HardwareCharacteristics{
+		Mem: uintptr(1024),
+	}
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{
+		InstanceID:              "i-123",
+		HardwareCharacteristics: hc,
+		LastRefreshed:           clock.Now().Add(-2 * time.Hour),
+	}, nil).Times(2)
+	release := make(chan struct{})
+	s.hardwareProvider.EXPECT().InstanceHardwareCharacteristics(gomock.Any(), instance.Id("i-123")).
+		DoAndReturn(func(envcontext.ProviderCallContext, instance.Id) (*instance.HardwareCharacteristics, error) {
+			<-release
+			return hc, nil
+		})
+	done := make(chan struct{})
+	s.state.EXPECT().SetHardwareCharacteristicsCache(gomock.Any(), "42", instance.Id("i-123"), *hc, clock.Now()).
+		DoAndReturn(func(context.Context, string, instance.Id, instance.HardwareCharacteristics, time.Time) error {
+			close(done)
+			return nil
+		})
+
+	refreshes := &singleflight.Group{}
+	for i := 0; i < 2; i++ {
+		svc := s.newProviderService(c, clock)
+		svc.hardwareRefreshes = refreshes
+		_, err := svc.GetMachineHardwareCharacteristics(context.Background(), "42")
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	close(release)
+
+	select {
+	case <-done:
+	case <-time.After(testing.LongWait):
+		c.Fatalf("hardware characteristics not refreshed")
+	}
+}
+
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsNotCached(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	clock := testclock.NewClock(time.Now())
+	hc := &instance.HardwareCharacteristics{
+		Mem: uintptr(1024),
+	}
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{
+		InstanceID: "i-123",
+	}, nil)
+	s.hardwareProvider.EXPECT().InstanceHardwareCharacteristics(gomock.Any(), instance.Id("i-123")).Return(hc, nil)
+	s.state.EXPECT().SetHardwareCharacteristicsCache(gomock.Any(), "42", instance.Id("i-123"), *hc, clock.Now()).Return(nil)
+
+	result, err := s.newProviderService(c, clock).GetMachineHardwareCharacteristics(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, *hc)
+}
+
+// TestGetMachineHardwareCharacteristicsNotSupported asserts that the hardware
+// characteristics recorded at provisioning are used if nothing is cached and
+// the provider can't report them.
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	hc := &instance.HardwareCharacteristics{
+		Mem: uintptr(1024),
+	}
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{
+		InstanceID: "i-123",
+	}, nil)
+	s.state.EXPECT().HardwareCharacteristics(gomock.Any(), "42").Return(hc, nil)
+
+	svc := s.newProviderService(c, testclock.NewClock(time.Now()))
+	svc.hardwareProviderGetter = func(context.Context) (HardwareCharacteristicsProvider, error) {
+		return nil, errors.NotSupportedf("provider")
+	}
+	result, err := svc.GetMachineHardwareCharacteristics(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, *hc)
+}
+
+func (s *serviceSuite) TestGetMachineHardwareCharacteristicsNotProvisioned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetHardwareCharacteristicsCache(gomock.Any(), "42").Return(domainmachine.HardwareCharacteristicsCache{}, machineerrors.NotProvisioned)
+
+	_, err := s.newProviderService(c, testclock.NewClock(time.Now())).GetMachineHardwareCharacteristics(context.Background(), "42")
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
+func (s *serviceSuite) TestGetMachineInstanceTags(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tags := map[string]string{"owner": "ops"}
+	s.state.EXPECT().GetMachineInstanceTags(gomock.Any(), "42").Return(tags, nil)
+
+	result, err := NewService(s.state).GetMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, tags)
+}
+
+func (s *serviceSuite) TestGetMachineInstanceTagsNotProvisioned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineInstanceTags(gomock.Any(), "42").Return(nil, machineerrors.NotProvisioned)
+
+	_, err := NewService(s.state).GetMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
+// TestSyncMachineInstanceTags asserts that the tags reported by the provider
+// for the machine's instance are recorded against it.
+func (s *serviceSuite) TestSyncMachineInstanceTags(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	tags := map[string]string{
+		"juju-model-uuid": "deadbeef",
+		"owner":           "ops",
+	}
+	s.state.EXPECT().InstanceID(gomock.Any(), "42").Return("i-123", nil)
+	s.tagsProvider.EXPECT().InstanceTags(gomock.Any(), instance.Id("i-123")).Return(tags, nil)
+	s.state.EXPECT().SetMachineInstanceTags(gomock.Any(), "42", instance.Id("i-123"), tags).Return(nil)
+
+	err := s.newProviderService(c, testclock.NewClock(time.Now())).SyncMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSyncMachineInstanceTagsProviderError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().InstanceID(gomock.Any(), "42").Return("i-123", nil)
+	s.tagsProvider.EXPECT().InstanceTags(gomock.Any(), instance.Id("i-123")).Return(nil, errors.New("boom"))
+
+	err := s.newProviderService(c, testclock.NewClock(time.Now())).SyncMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, gc.ErrorMatches, `fetching tags of instance "i-123": boom`)
+}
+
+func (s *serviceSuite) TestSyncMachineInstanceTagsNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().InstanceID(gomock.Any(), "42").Return("i-123", nil)
+
+	svc := s.newProviderService(c, testclock.NewClock(time.Now()))
+	svc.tagsProviderGetter = func(context.Context) (InstanceTagsProvider, error) {
+		return nil, errors.NotSupportedf("provider")
+	}
+	err := svc.SyncMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *serviceSuite) TestSyncMachineInstanceTagsNotProvisioned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().InstanceID(gomock.Any(), "42").Return("", machineerrors.NotProvisioned)
+
+	err := s.newProviderService(c, testclock.NewClock(time.Now())).SyncMachineInstanceTags(context.Background(), "42")
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/machine/service (interfaces: State)
+// Source: github.com/juju/juju/domain/machine/service (interfaces: State,HardwareCharacteristicsProvider,InstanceTagsProvider)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/machine/service State
+//	mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/machine/service State,HardwareCharacteristicsProvider,InstanceTagsProvider
 //
 
 // Package service is a generated GoMock package.
@@ -12,11 +12,14 @@ package service
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	instance "github.com/juju/juju/core/instance"
 	machine "github.com/juju/juju/core/machine"
 	status "github.com/juju/juju/core/status"
 	life "github.com/juju/juju/domain/life"
+	machine0 "github.com/juju/juju/domain/machine"
+	envcontext "github.com/juju/juju/environs/envcontext"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -237,17 +240,17 @@ func (c *MockStateCreateMachineCall) DoAndReturn(f func(context.Context, machine
 }
 
 // CreateMachineWithParent mocks base method.
-func (m *MockState) CreateMachineWithParent(arg0 context.Context, arg1, arg2 machine.Name, arg3, arg4 string) error {
+func (m *MockState) CreateMachineWithParent(arg0 context.Context, arg1, arg2 machine.Name, arg3, arg4 string, arg5 machine0.ContainerType) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateMachineWithParent", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "CreateMachineWithParent", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateMachineWithParent indicates an expected call of CreateMachineWithParent.
-func (mr *MockStateMockRecorder) CreateMachineWithParent(arg0, arg1, arg2, arg3, arg4 any) *MockStateCreateMachineWithParentCall {
+func (mr *MockStateMockRecorder) CreateMachineWithParent(arg0, arg1, arg2, arg3, arg4, arg5 any) *MockStateCreateMachineWithParentCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachineWithParent", reflect.TypeOf((*MockState)(nil).CreateMachineWithParent), arg0, arg1, arg2, arg3, arg4)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMachineWithParent", reflect.TypeOf((*MockState)(nil).CreateMachineWithParent), arg0, arg1, arg2, arg3, arg4, arg5)
 	return &MockStateCreateMachineWithParentCall{Call: call}
 }
 
@@ -263,13 +266,13 @@ func (c *MockStateCreateMachineWithParentCall) Return(arg0 error) *MockStateCrea
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockStateCreateMachineWithParentCall) Do(f func(context.Context, machine.Name, machine.Name, string, string) error) *MockStateCreateMachineWithParentCall {
+func (c *MockStateCreateMachineWithParentCall) Do(f func(context.Context, machine.Name, machine.Name, string, string, machine0.ContainerType) error) *MockStateCreateMachineWithParentCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockStateCreateMachineWithParentCall) DoAndReturn(f func(context.Context, machine.Name, machine.Name, string, string) error) *MockStateCreateMachineWithParentCall {
+func (c *MockStateCreateMachineWithParentCall) DoAndReturn(f func(context.Context, machine.Name, machine.Name, string, string, machine0.ContainerType) error) *MockStateCreateMachineWithParentCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -389,6 +392,45 @@ func (c *MockStateGetAllMachineRemovalsCall) DoAndReturn(f func(context.Context)
 	return c
 }
 
+// GetHardwareCharacteristicsCache mocks base method.
+func (m *MockState) GetHardwareCharacteristicsCache(arg0 context.Context, arg1 string) (machine0.HardwareCharacteristicsCache, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHardwareCharacteristicsCache", arg0, arg1)
+	ret0, _ := ret[0].(machine0.HardwareCharacteristicsCache)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHardwareCharacteristicsCache indicates an expected call of GetHardwareCharacteristicsCache.
+func (mr *MockStateMockRecorder) GetHardwareCharacteristicsCache(arg0, arg1 any) *MockStateGetHardwareCharacteristicsCacheCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHardwareCharacteristicsCache", reflect.TypeOf((*MockState)(nil).GetHardwareCharacteristicsCache), arg0, arg1)
+	return &MockStateGetHardwareCharacteristicsCacheCall{Call: call}
+}
+
+// MockStateGetHardwareCharacteristicsCacheCall wrap *gomock.Call
+type MockStateGetHardwareCharacteristicsCacheCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetHardwareCharacteristicsCacheCall) Return(arg0 machine0.HardwareCharacteristicsCache, arg1 error) *MockStateGetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetHardwareCharacteristicsCacheCall) Do(f func(context.Context, string) (machine0.HardwareCharacteristicsCache, error)) *MockStateGetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetHardwareCharacteristicsCacheCall) DoAndReturn(f func(context.Context, string) (machine0.HardwareCharacteristicsCache, error)) *MockStateGetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetInstanceStatus mocks base method.
 func (m *MockState) GetInstanceStatus(arg0 context.Context, arg1 machine.Name) (status.StatusInfo, error) {
 	m.ctrl.T.Helper()
@@ -428,6 +470,84 @@ func (c *MockStateGetInstanceStatusCall) DoAndReturn(f func(context.Context, mac
 	return c
 }
 
+// GetMachineContainerCount mocks base method.
+func (m *MockState) GetMachineContainerCount(arg0 context.Context, arg1 string) (machine0.ContainerCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineContainerCount", arg0, arg1)
+	ret0, _ := ret[0].(machine0.ContainerCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineContainerCount indicates an expected call of GetMachineContainerCount.
+func (mr *MockStateMockRecorder) GetMachineContainerCount(arg0, arg1 any) *MockStateGetMachineContainerCountCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineContainerCount", reflect.TypeOf((*MockState)(nil).GetMachineContainerCount), arg0, arg1)
+	return &MockStateGetMachineContainerCountCall{Call: call}
+}
+
+// MockStateGetMachineContainerCountCall wrap *gomock.Call
+type MockStateGetMachineContainerCountCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineContainerCountCall) Return(arg0 machine0.ContainerCount, arg1 error) *MockStateGetMachineContainerCountCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineContainerCountCall) Do(f func(context.Context, string) (machine0.ContainerCount, error)) *MockStateGetMachineContainerCountCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineContainerCountCall) DoAndReturn(f func(context.Context, string) (machine0.ContainerCount, error)) *MockStateGetMachineContainerCountCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetMachineInstanceTags mocks base method.
+func (m *MockState) GetMachineInstanceTags(arg0 context.Context, arg1 string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineInstanceTags", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineInstanceTags indicates an expected call of GetMachineInstanceTags.
+func (mr *MockStateMockRecorder) GetMachineInstanceTags(arg0, arg1 any) *MockStateGetMachineInstanceTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineInstanceTags", reflect.TypeOf((*MockState)(nil).GetMachineInstanceTags), arg0, arg1)
+	return &MockStateGetMachineInstanceTagsCall{Call: call}
+}
+
+// MockStateGetMachineInstanceTagsCall wrap *gomock.Call
+type MockStateGetMachineInstanceTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineInstanceTagsCall) Return(arg0 map[string]string, arg1 error) *MockStateGetMachineInstanceTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineInstanceTagsCall) Do(f func(context.Context, string) (map[string]string, error)) *MockStateGetMachineInstanceTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineInstanceTagsCall) DoAndReturn(f func(context.Context, string) (map[string]string, error)) *MockStateGetMachineInstanceTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetMachineLife mocks base method.
 func (m *MockState) GetMachineLife(arg0 context.Context, arg1 machine.Name) (*life.Life, error) {
 	m.ctrl.T.Helper()
@@ -858,6 +978,45 @@ func (c *MockStateIsMachineRebootRequiredCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// ListZombieContainers mocks base method.
+func (m *MockState) ListZombieContainers(arg0 context.Context) ([]machine0.ZombieContainer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZombieContainers", arg0)
+	ret0, _ := ret[0].([]machine0.ZombieContainer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZombieContainers indicates an expected call of ListZombieContainers.
+func (mr *MockStateMockRecorder) ListZombieContainers(arg0 any) *MockStateListZombieContainersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZombieContainers", reflect.TypeOf((*MockState)(nil).ListZombieContainers), arg0)
+	return &MockStateListZombieContainersCall{Call: call}
+}
+
+// MockStateListZombieContainersCall wrap *gomock.Call
+type MockStateListZombieContainersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListZombieContainersCall) Return(arg0 []machine0.ZombieContainer, arg1 error) *MockStateListZombieContainersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListZombieContainersCall) Do(f func(context.Context) ([]machine0.ZombieContainer, error)) *MockStateListZombieContainersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListZombieContainersCall) DoAndReturn(f func(context.Context) ([]machine0.ZombieContainer, error)) *MockStateListZombieContainersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MarkMachineForRemoval mocks base method.
 func (m *MockState) MarkMachineForRemoval(arg0 context.Context, arg1 machine.Name) error {
 	m.ctrl.T.Helper()
@@ -972,6 +1131,44 @@ func (c *MockStateSetAppliedLXDProfileNamesCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// SetHardwareCharacteristicsCache mocks base method.
+func (m *MockState) SetHardwareCharacteristicsCache(arg0 context.Context, arg1 string, arg2 instance.Id, arg3 instance.HardwareCharacteristics, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHardwareCharacteristicsCache", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHardwareCharacteristicsCache indicates an expected call of SetHardwareCharacteristicsCache.
+func (mr *MockStateMockRecorder) SetHardwareCharacteristicsCache(arg0, arg1, arg2, arg3, arg4 any) *MockStateSetHardwareCharacteristicsCacheCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHardwareCharacteristicsCache", reflect.TypeOf((*MockState)(nil).SetHardwareCharacteristicsCache), arg0, arg1, arg2, arg3, arg4)
+	return &MockStateSetHardwareCharacteristicsCacheCall{Call: call}
+}
+
+// MockStateSetHardwareCharacteristicsCacheCall wrap *gomock.Call
+type MockStateSetHardwareCharacteristicsCacheCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetHardwareCharacteristicsCacheCall) Return(arg0 error) *MockStateSetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetHardwareCharacteristicsCacheCall) Do(f func(context.Context, string, instance.Id, instance.HardwareCharacteristics, time.Time) error) *MockStateSetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetHardwareCharacteristicsCacheCall) DoAndReturn(f func(context.Context, string, instance.Id, instance.HardwareCharacteristics, time.Time) error) *MockStateSetHardwareCharacteristicsCacheCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetInstanceStatus mocks base method.
 func (m *MockState) SetInstanceStatus(arg0 context.Context, arg1 machine.Name, arg2 status.StatusInfo) error {
 	m.ctrl.T.Helper()
@@ -1086,6 +1283,44 @@ func (c *MockStateSetMachineCloudInstanceCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// SetMachineInstanceTags mocks base method.
+func (m *MockState) SetMachineInstanceTags(arg0 context.Context, arg1 string, arg2 instance.Id, arg3 map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMachineInstanceTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMachineInstanceTags indicates an expected call of SetMachineInstanceTags.
+func (mr *MockStateMockRecorder) SetMachineInstanceTags(arg0, arg1, arg2, arg3 any) *MockStateSetMachineInstanceTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMachineInstanceTags", reflect.TypeOf((*MockState)(nil).SetMachineInstanceTags), arg0, arg1, arg2, arg3)
+	return &MockStateSetMachineInstanceTagsCall{Call: call}
+}
+
+// MockStateSetMachineInstanceTagsCall wrap *gomock.Call
+type MockStateSetMachineInstanceTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetMachineInstanceTagsCall) Return(arg0 error) *MockStateSetMachineInstanceTagsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetMachineInstanceTagsCall) Do(f func(context.Context, string, instance.Id, map[string]string) error) *MockStateSetMachineInstanceTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetMachineInstanceTagsCall) DoAndReturn(f func(context.Context, string, instance.Id, map[string]string) error) *MockStateSetMachineInstanceTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetMachineLife mocks base method.
 func (m *MockState) SetMachineLife(arg0 context.Context, arg1 machine.Name, arg2 life.Life) error {
 	m.ctrl.T.Helper()
@@ -1239,3 +1474,127 @@ func (c *MockStateShouldRebootOrShutdownCall) DoAndReturn(f func(context.Context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockHardwareCharacteristicsProvider is a mock of HardwareCharacteristicsProvider interface.
+type MockHardwareCharacteristicsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockHardwareCharacteristicsProviderMockRecorder
+}
+
+// MockHardwareCharacteristicsProviderMockRecorder is the mock recorder for MockHardwareCharacteristicsProvider.
+type MockHardwareCharacteristicsProviderMockRecorder struct {
+	mock *MockHardwareCharacteristicsProvider
+}
+
+// NewMockHardwareCharacteristicsProvider creates a new mock instance.
+func NewMockHardwareCharacteristicsProvider(ctrl *gomock.Controller) *MockHardwareCharacteristicsProvider {
+	mock := &MockHardwareCharacteristicsProvider{ctrl: ctrl}
+	mock.recorder = &MockHardwareCharacteristicsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHardwareCharacteristicsProvider) EXPECT() *MockHardwareCharacteristicsProviderMockRecorder {
+	return m.recorder
+}
+
+// InstanceHardwareCharacteristics mocks base method.
+func (m *MockHardwareCharacteristicsProvider) InstanceHardwareCharacteristics(arg0 envcontext.ProviderCallContext, arg1 instance.Id) (*instance.HardwareCharacteristics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceHardwareCharacteristics", arg0, arg1)
+	ret0, _ := ret[0].(*instance.HardwareCharacteristics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceHardwareCharacteristics indicates an expected call of InstanceHardwareCharacteristics.
+func (mr *MockHardwareCharacteristicsProviderMockRecorder) InstanceHardwareCharacteristics(arg0, arg1 any) *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceHardwareCharacteristics", reflect.TypeOf((*MockHardwareCharacteristicsProvider)(nil).InstanceHardwareCharacteristics), arg0, arg1)
+	return &MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall{Call: call}
+}
+
+// MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall wrap *gomock.Call
+type MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall) Return(arg0 *instance.HardwareCharacteristics, arg1 error) *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall) Do(f func(envcontext.ProviderCallContext, instance.Id) (*instance.HardwareCharacteristics, error)) *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall) DoAndReturn(f func(envcontext.ProviderCallContext, instance.Id) (*instance.HardwareCharacteristics, error)) *MockHardwareCharacteristicsProviderInstanceHardwareCharacteristicsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockInstanceTagsProvider is a mock of InstanceTagsProvider interface.
+type MockInstanceTagsProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceTagsProviderMockRecorder
+}
+
+// MockInstanceTagsProviderMockRecorder is the mock recorder for MockInstanceTagsProvider.
+type MockInstanceTagsProviderMockRecorder struct {
+	mock *MockInstanceTagsProvider
+}
+
+// NewMockInstanceTagsProvider creates a new mock instance.
+func NewMockInstanceTagsProvider(ctrl *gomock.Controller) *MockInstanceTagsProvider {
+	mock := &MockInstanceTagsProvider{ctrl: ctrl}
+	mock.recorder = &MockInstanceTagsProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstanceTagsProvider) EXPECT() *MockInstanceTagsProviderMockRecorder {
+	return m.recorder
+}
+
+// InstanceTags mocks base method.
+func (m *MockInstanceTagsProvider) InstanceTags(arg0 envcontext.ProviderCallContext, arg1 instance.Id) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceTags", arg0, arg1)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceTags indicates an expected call of InstanceTags.
+func (mr *MockInstanceTagsProviderMockRecorder) InstanceTags(arg0, arg1 any) *MockInstanceTagsProviderInstanceTagsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceTags", reflect.TypeOf((*MockInstanceTagsProvider)(nil).InstanceTags), arg0, arg1)
+	return &MockInstanceTagsProviderInstanceTagsCall{Call: call}
+}
+
+// MockInstanceTagsProviderInstanceTagsCall wrap *gomock.Call
+type MockInstanceTagsProviderInstanceTagsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceTagsProviderInstanceTagsCall) Return(arg0 map[string]string, arg1 error) *MockInstanceTagsProviderInstanceTagsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceTagsProviderInstanceTagsCall) Do(f func(envcontext.ProviderCallContext, instance.Id) (map[string]string, error)) *MockInstanceTagsProviderInstanceTagsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceTagsProviderInstanceTagsCall) DoAndReturn(f func(envcontext.ProviderCallContext, instance.Id) (map[string]string, error)) *MockInstanceTagsProviderInstanceTagsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -9,7 +9,7 @@ import (
 	gc "gopkg.in/check.v1"
 )
 
-//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/machine/service State
+//go:generate go run go.uber.org/mock/mockgen -typed -package service -destination package_mock_test.go github.com/juju/juju/domain/machine/service State,HardwareCharacteristicsProvider,InstanceTagsProvider
 
 func TestPackage(t *testing.T) {
 	gc.TestingT(t)
@@ -5,17 +5,24 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"github.com/juju/clock"
 	"github.com/juju/errors"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
 	coremachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	"github.com/juju/juju/environs"
+	"github.com/juju/juju/environs/envcontext"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -32,7 +39,17 @@ type State interface {
 	// already exists.
 	// It returns a MachineNotFound error if the parent machine does not exist.
 
-	CreateMachineWithParent(context.Context, coremachine.Name, coremachine.Name, string, string) error
+	CreateMachineWithParent(context.Context, coremachine.Name, coremachine.Name, string, string, domainmachine.ContainerType) error
+
+	// ListZombieContainers returns the containers whose host machine has
+	// been removed, or is dead or marked for removal, along with the units
+	// running in them.
+	ListZombieContainers(context.Context) ([]domainmachine.ZombieContainer, error)
+
+	// GetMachineContainerCount returns the number of containers hosted on
+	// the specified machine, broken down by container type and status.
+	// It returns a MachineNotFound if the host machine does not exist.
+	GetMachineContainerCount(ctx context.Context, hostUUID string) (domainmachine.ContainerCount, error)
 
 	// DeleteMachine deletes the input machine entity.
 	DeleteMachine(context.Context, coremachine.Name) error
@@ -88,6 +105,31 @@ type State interface {
 	// data retrieved from the machine cloud instance table.
 	HardwareCharacteristics(context.Context, string) (*instance.HardwareCharacteristics, error)
 
+	// GetHardwareCharacteristicsCache returns the cached hardware
+	// characteristics of the specified machine's current instance. The
+	// hardware characteristics of the result are nil if none are cached for
+	// the current instance.
+	// It returns NotProvisioned if the machine has no instance.
+	GetHardwareCharacteristicsCache(context.Context, string) (domainmachine.HardwareCharacteristicsCache, error)
+
+	// SetHardwareCharacteristicsCache caches the hardware characteristics of
+	// the specified machine's instance, as obtained from the provider at the
+	// given time. Nothing is cached if the machine's instance is no longer
+	// the given instance.
+	// It returns NotProvisioned if the machine has no instance.
+	SetHardwareCharacteristicsCache(context.Context, string, instance.Id, instance.HardwareCharacteristics, time.Time) error
+
+	// GetMachineInstanceTags returns the provider tags of the specified
+	// machine's instance, as last synced from the provider.
+	// It returns NotProvisioned if the machine has no instance.
+	GetMachineInstanceTags(context.Context, string) (map[string]string, error)
+
+	// SetMachineInstanceTags replaces the provider tags of the specified
+	// machine's instance. Nothing is set if the machine's instance is no
+	// longer the given instance.
+	// It returns NotProvisioned if the machine has no instance.
+	SetMachineInstanceTags(context.Context, string, instance.Id, map[string]string) error
+
 	// AvailabilityZone returns the availability zone for the specified machine.
 	AvailabilityZone(context.Context, string) (string, error)
 
@@ -155,6 +197,21 @@ type Provider interface {
 	environs.InstanceTypesFetcher
 }
 
+// HardwareCharacteristicsProvider represents an underlying cloud provider
+// that can report the hardware characteristics of its instances.
+type HardwareCharacteristicsProvider interface {
+	// InstanceHardwareCharacteristics returns the hardware characteristics
+	// of the instance with the given ID.
+	InstanceHardwareCharacteristics(ctx envcontext.ProviderCallContext, id instance.Id) (*instance.HardwareCharacteristics, error)
+}
+
+// InstanceTagsProvider represents an underlying cloud provider that can
+// report the tags of its instances, including those added outside of Juju.
+type InstanceTagsProvider interface {
+	// InstanceTags returns the tags of the instance with the given ID.
+	InstanceTags(ctx envcontext.ProviderCallContext, id instance.Id) (map[string]string, error)
+}
+
 // Service provides the API for working with machines.
 type Service struct {
 	st State
@@ -185,7 +242,8 @@ func (s *Service) CreateMachine(ctx context.Context, machineName coremachine.Nam
 }
 
 // CreateMachineWirhParent creates the specified machine with the specified
-// parent.
+// parent. The container type of the machine is determined from its name,
+// defaulting to LXD.
 // It returns a MachineAlreadyExists error if a machine with the same name
 // already exists.
 // It returns a MachineNotFound error if the parent machine does not exist.
@@ -198,11 +256,54 @@ func (s *Service) CreateMachineWithParent(ctx context.Context, machineName, pare
 		return "", errors.Annotatef(err, "creating machine %q with parent %q", machineName, parentName)
 	}
 
-	err = s.st.CreateMachineWithParent(ctx, machineName, parentName, nodeUUID, machineUUID)
+	containerType := containerTypeFromName(machineName)
+	err = s.st.CreateMachineWithParent(ctx, machineName, parentName, nodeUUID, machineUUID, containerType)
 
 	return machineUUID, errors.Annotatef(err, "creating machine %q with parent %q", machineName, parentName)
 }
 
+// ListZombieContainers returns the containers whose host machine has been
+// removed, or is dead or marked for removal, along with the units running in
+// them.
+func (s *Service) ListZombieContainers(ctx context.Context) ([]domainmachine.ZombieContainer, error) {
+	containers, err := s.st.ListZombieContainers(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "listing zombie containers")
+	}
+	return containers, nil
+}
+
+// GetMachineContainerCount returns the number of containers hosted on the
+// specified machine, broken down by container type, along with how many of
+// them are running.
+// It returns a MachineNotFound if the host machine does not exist.
+func (s *Service) GetMachineContainerCount(ctx context.Context, hostMachineUUID string) (domainmachine.ContainerCount, error) {
+	count, err := s.st.GetMachineContainerCount(ctx, hostMachineUUID)
+	if err != nil {
+		return domainmachine.ContainerCount{}, errors.Annotatef(err, "counting containers on machine %q", hostMachineUUID)
+	}
+	return count, nil
+}
+
+// containerTypeFromName returns the container type of a container machine
+// from its name. Container machines are named after their parent, with the
+// container type and the container number appended, e.g. "0/lxd/1". LXD is
+// returned if the name does not include a known container type.
+func containerTypeFromName(name coremachine.Name) domainmachine.ContainerType {
+	parts := strings.Split(name.String(), "/")
+	if len(parts) < 3 {
+		return domainmachine.ContainerTypeLXD
+	}
+	switch parts[len(parts)-2] {
+	case "kvm":
+		return domainmachine.ContainerTypeKVM
+	case "docker":
+		return domainmachine.ContainerTypeDocker
+	default:
+		return domainmachine.ContainerTypeLXD
+	}
+}
+
 // createUUIDs generates a new UUID for the machine and the net-node.
 func createUUIDs() (string, string, error) {
 	nodeUUID, err := uuid.NewUUID()
@@ -414,7 +515,15 @@ func (s *Service) SetAppliedLXDProfileNames(ctx context.Context, mUUID string, p
 type ProviderService struct {
 	Service
 
-	providerGetter providertracker.ProviderGetter[Provider]
+	providerGetter         providertracker.ProviderGetter[Provider]
+	hardwareProviderGetter providertracker.ProviderGetter[HardwareCharacteristicsProvider]
+	tagsProviderGetter     providertracker.ProviderGetter[InstanceTagsProvider]
+	clock                  clock.Clock
+	logger                 logger.Logger
+
+	// hardwareRefreshes ensures that only one refresh of a machine's
+	// hardware characteristics from the provider is in flight at a time.
+	hardwareRefreshes *singleflight.Group
 }
 
 // GetBootstrapEnviron returns the bootstrap environ.
@@ -15,13 +15,17 @@ import (
 	"github.com/juju/juju/core/instance"
 	cmachine "github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/status"
+	coreunit "github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 )
 
 type serviceSuite struct {
 	testing.IsolationSuite
-	state *MockState
+	state            *MockState
+	hardwareProvider *MockHardwareCharacteristicsProvider
+	tagsProvider     *MockInstanceTagsProvider
 }
 
 var _ = gc.Suite(&serviceSuite{})
@@ -29,6 +33,8 @@ var _ = gc.Suite(&serviceSuite{})
 func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 	s.state = NewMockState(ctrl)
+	s.hardwareProvider = NewMockHardwareCharacteristicsProvider(ctrl)
+	s.tagsProvider = NewMockInstanceTagsProvider(ctrl)
 	return ctrl
 }
 
@@ -73,19 +79,30 @@ func (s *serviceSuite) TestCreateMachineAlreadyExists(c *gc.C) {
 func (s *serviceSuite) TestCreateMachineWithParentSuccess(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any()).Return(nil)
+	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any(), domainmachine.ContainerTypeLXD).Return(nil)
 
 	_, err := NewService(s.state).CreateMachineWithParent(context.Background(), cmachine.Name("666"), cmachine.Name("parent"))
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+// TestCreateMachineWithParentContainerType asserts that the container type
+// of a machine created with a parent is taken from the machine name.
+func (s *serviceSuite) TestCreateMachineWithParentContainerType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("0/kvm/1"), cmachine.Name("0"), gomock.Any(), gomock.Any(), domainmachine.ContainerTypeKVM).Return(nil)
+
+	_, err := NewService(s.state).CreateMachineWithParent(context.Background(), cmachine.Name("0/kvm/1"), cmachine.Name("0"))
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 // TestCreateMachineWithParentError asserts that an error coming from the state
 // layer is preserved, passed over to the service layer to be maintained there.
 func (s *serviceSuite) TestCreateMachineWithParentError(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	rErr := errors.New("boom")
-	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any()).Return(rErr)
+	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any(), domainmachine.ContainerTypeLXD).Return(rErr)
 
 	_, err := NewService(s.state).CreateMachineWithParent(context.Background(), cmachine.Name("666"), cmachine.Name("parent"))
 	c.Check(err, jc.ErrorIs, rErr)
@@ -99,7 +116,7 @@ func (s *serviceSuite) TestCreateMachineWithParentError(c *gc.C) {
 func (s *serviceSuite) TestCreateMachineWithParentParentNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any()).Return(errors.NotFound)
+	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any(), domainmachine.ContainerTypeLXD).Return(errors.NotFound)
 
 	_, err := NewService(s.state).CreateMachineWithParent(context.Background(), cmachine.Name("666"), cmachine.Name("parent"))
 	c.Check(err, jc.ErrorIs, errors.NotFound)
@@ -112,7 +129,7 @@ func (s *serviceSuite) TestCreateMachineWithParentParentNotFound(c *gc.C) {
 func (s *serviceSuite) TestCreateMachineWithParentMachineAlreadyExists(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
-	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any()).Return(machineerrors.MachineAlreadyExists)
+	s.state.EXPECT().CreateMachineWithParent(gomock.Any(), cmachine.Name("666"), cmachine.Name("parent"), gomock.Any(), gomock.Any(), domainmachine.ContainerTypeLXD).Return(machineerrors.MachineAlreadyExists)
 
 	_, err := NewService(s.state).CreateMachineWithParent(context.Background(), cmachine.Name("666"), cmachine.Name("parent"))
 	c.Check(err, jc.ErrorIs, machineerrors.MachineAlreadyExists)
@@ -537,6 +554,52 @@ func (s *serviceSuite) TestGetMachineParentUUIDSuccess(c *gc.C) {
 	c.Assert(parentUUID, gc.Equals, "123")
 }
 
+// TestListZombieContainers asserts the happy path of the service.
+func (s *serviceSuite) TestListZombieContainers(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	containers := []domainmachine.ZombieContainer{{
+		UUID:          "666",
+		Name:          "0/lxd/0",
+		HostUUID:      "123",
+		ContainerType: domainmachine.ContainerTypeLXD,
+		Units:         []coreunit.Name{"foo/0"},
+	}}
+	s.state.EXPECT().ListZombieContainers(gomock.Any()).Return(containers, nil)
+
+	result, err := NewService(s.state).ListZombieContainers(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, containers)
+}
+
+// TestGetMachineContainerCount asserts the happy path of the service.
+func (s *serviceSuite) TestGetMachineContainerCount(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := domainmachine.ContainerCount{
+		TotalContainers:   3,
+		LXDContainers:     2,
+		KVMContainers:     1,
+		RunningContainers: 2,
+	}
+	s.state.EXPECT().GetMachineContainerCount(gomock.Any(), "666").Return(expected, nil)
+
+	count, err := NewService(s.state).GetMachineContainerCount(context.Background(), "666")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, expected)
+}
+
+// TestGetMachineContainerCountNotFound asserts that a MachineNotFound error
+// from the state layer is preserved.
+func (s *serviceSuite) TestGetMachineContainerCountNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetMachineContainerCount(gomock.Any(), "666").Return(domainmachine.ContainerCount{}, machineerrors.MachineNotFound)
+
+	_, err := NewService(s.state).GetMachineContainerCount(context.Background(), "666")
+	c.Check(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
 // TestGetMachineParentUUIDError asserts that an error coming from the state
 // layer is preserved, passed over to the service layer to be maintained there.
 func (s *serviceSuite) TestGetMachineParentUUIDError(c *gc.C) {
@@ -9,12 +9,15 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/juju/clock"
 	"github.com/juju/collections/set"
 	"github.com/juju/collections/transform"
 	"github.com/juju/errors"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/watcher"
@@ -54,13 +57,23 @@ func NewWatchableService(
 	st State,
 	watcherFactory WatcherFactory,
 	providerGetter providertracker.ProviderGetter[Provider],
+	hardwareProviderGetter providertracker.ProviderGetter[HardwareCharacteristicsProvider],
+	tagsProviderGetter providertracker.ProviderGetter[InstanceTagsProvider],
+	hardwareRefreshes *singleflight.Group,
+	clock clock.Clock,
+	logger logger.Logger,
 ) *WatchableService {
 	return &WatchableService{
 		ProviderService: ProviderService{
 			Service: Service{
 				st: st,
 			},
-			providerGetter: providerGetter,
+			providerGetter:         providerGetter,
+			hardwareProviderGetter: hardwareProviderGetter,
+			tagsProviderGetter:     tagsProviderGetter,
+			clock:                  clock,
+			logger:                 logger,
+			hardwareRefreshes:      hardwareRefreshes,
 		},
 		watcherFactory: watcherFactory,
 	}
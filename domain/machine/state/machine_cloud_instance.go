@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/canonical/sqlair"
 	"github.com/juju/collections/transform"
@@ -15,6 +16,7 @@ import (
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/domain"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	"github.com/juju/juju/internal/database"
@@ -96,6 +98,270 @@ WHERE     v.machine_uuid = $instanceDataResult.machine_uuid`
 	return *row.AvailabilityZone, nil
 }
 
+// GetHardwareCharacteristicsCache returns the cached hardware characteristics
+// of the specified machine's current instance. The hardware characteristics
+// of the result are nil if none are cached for the current instance.
+// If the machine is not provisioned, it returns a
+// [machineerrors.NotProvisioned].
+func (st *State) GetHardwareCharacteristicsCache(
+	ctx context.Context,
+	mUUID string,
+) (domainmachine.HardwareCharacteristicsCache, error) {
+	db, err := st.DB()
+	if err != nil {
+		return domainmachine.HardwareCharacteristicsCache{}, errors.Trace(err)
+	}
+
+	mUUIDParam := machineUUID{UUID: mUUID}
+	instanceQuery := `
+SELECT &instanceID.instance_id
+FROM   machine_cloud_instance
+WHERE  machine_uuid = $machineUUID.uuid`
+	instanceStmt, err := st.Prepare(instanceQuery, mUUIDParam, instanceID{})
+	if err != nil {
+		return domainmachine.HardwareCharacteristicsCache{}, errors.Trace(err)
+	}
+
+	cacheQuery := `
+SELECT &hardwareCharacteristicsCache.*
+FROM   machine_hardware_characteristics_cache
+WHERE  machine_uuid = $machineUUID.uuid
+AND    instance_id = $instanceID.instance_id`
+	cacheStmt, err := st.Prepare(cacheQuery, mUUIDParam, instanceID{}, hardwareCharacteristicsCache{})
+	if err != nil {
+		return domainmachine.HardwareCharacteristicsCache{}, errors.Trace(err)
+	}
+
+	var result domainmachine.HardwareCharacteristicsCache
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current instanceID
+		err := tx.Query(ctx, instanceStmt, mUUIDParam).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(machineerrors.NotProvisioned, "machine: %q", mUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "querying instance for machine %q", mUUID)
+		}
+		result.InstanceID = instance.Id(current.ID)
+
+		// The cache may hold nothing for the current instance, in which
+		// case only the instance is returned.
+		var cache hardwareCharacteristicsCache
+		err = tx.Query(ctx, cacheStmt, mUUIDParam, current).Get(&cache)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Annotatef(err, "querying cached hardware characteristics for machine %q", mUUID)
+		}
+		result.HardwareCharacteristics = cache.toHardwareCharacteristics()
+		result.LastRefreshed = cache.LastRefreshed
+		return nil
+	})
+	if err != nil {
+		return domainmachine.HardwareCharacteristicsCache{}, errors.Trace(err)
+	}
+	return result, nil
+}
+
+// SetHardwareCharacteristicsCache caches the hardware characteristics of the
+// specified machine's instance, as obtained from the provider at the given
+// time. Nothing is cached if the machine's instance is no longer the given
+// instance, so that a replacement instance isn't described by its
+// predecessor.
+// If the machine is not provisioned, it returns a
+// [machineerrors.NotProvisioned].
+func (st *State) SetHardwareCharacteristicsCache(
+	ctx context.Context,
+	mUUID string,
+	instanceId instance.Id,
+	hc instance.HardwareCharacteristics,
+	refreshed time.Time,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	mUUIDParam := machineUUID{UUID: mUUID}
+	instanceQuery := `
+SELECT &instanceID.instance_id
+FROM   machine_cloud_instance
+WHERE  machine_uuid = $machineUUID.uuid`
+	instanceStmt, err := st.Prepare(instanceQuery, mUUIDParam, instanceID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	upsertQuery := `
+INSERT INTO machine_hardware_characteristics_cache (*)
+VALUES ($hardwareCharacteristicsCache.*)
+ON CONFLICT (machine_uuid) DO UPDATE SET
+    instance_id = excluded.instance_id,
+    arch = excluded.arch,
+    mem = excluded.mem,
+    root_disk = excluded.root_disk,
+    root_disk_source = excluded.root_disk_source,
+    cpu_cores = excluded.cpu_cores,
+    cpu_power = excluded.cpu_power,
+    availability_zone_name = excluded.availability_zone_name,
+    virt_type = excluded.virt_type,
+    last_refreshed = excluded.last_refreshed`
+	upsertStmt, err := st.Prepare(upsertQuery, hardwareCharacteristicsCache{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	cache := newHardwareCharacteristicsCache(mUUID, instanceId, hc)
+	cache.LastRefreshed = refreshed.UTC()
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current instanceID
+		err := tx.Query(ctx, instanceStmt, mUUIDParam).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(machineerrors.NotProvisioned, "machine: %q", mUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "querying instance for machine %q", mUUID)
+		}
+		if current.ID != cache.InstanceID {
+			st.logger.Debugf("not caching hardware characteristics of replaced instance %q of machine %q", cache.InstanceID, mUUID)
+			return nil
+		}
+
+		if err := tx.Query(ctx, upsertStmt, cache).Run(); err != nil {
+			return errors.Annotatef(err, "caching hardware characteristics for machine %q", mUUID)
+		}
+		return nil
+	})
+}
+
+// GetMachineInstanceTags returns the provider tags of the specified machine's
+// instance, as last synced from the provider. The result is empty if the tags
+// have not been synced.
+// If the machine is not provisioned, it returns a
+// [machineerrors.NotProvisioned].
+func (st *State) GetMachineInstanceTags(ctx context.Context, mUUID string) (map[string]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	mUUIDParam := machineUUID{UUID: mUUID}
+	instanceQuery := `
+SELECT &instanceID.instance_id
+FROM   machine_cloud_instance
+WHERE  machine_uuid = $machineUUID.uuid`
+	instanceStmt, err := st.Prepare(instanceQuery, mUUIDParam, instanceID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	tagsQuery := `
+SELECT &machineInstanceTag.*
+FROM   machine_instance_tag
+WHERE  machine_uuid = $machineUUID.uuid`
+	tagsStmt, err := st.Prepare(tagsQuery, mUUIDParam, machineInstanceTag{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var tags []machineInstanceTag
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current instanceID
+		err := tx.Query(ctx, instanceStmt, mUUIDParam).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(machineerrors.NotProvisioned, "machine: %q", mUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "querying instance for machine %q", mUUID)
+		}
+
+		err = tx.Query(ctx, tagsStmt, mUUIDParam).GetAll(&tags)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying instance tags for machine %q", mUUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		result[tag.Key] = tag.Value
+	}
+	return result, nil
+}
+
+// SetMachineInstanceTags replaces the provider tags of the specified
+// machine's instance with those given, as obtained from the provider.
+// Nothing is set if the machine's instance is no longer the given instance,
+// so that a replacement instance isn't described by its predecessor.
+// If the machine is not provisioned, it returns a
+// [machineerrors.NotProvisioned].
+func (st *State) SetMachineInstanceTags(
+	ctx context.Context,
+	mUUID string,
+	instanceId instance.Id,
+	tags map[string]string,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	mUUIDParam := machineUUID{UUID: mUUID}
+	instanceQuery := `
+SELECT &instanceID.instance_id
+FROM   machine_cloud_instance
+WHERE  machine_uuid = $machineUUID.uuid`
+	instanceStmt, err := st.Prepare(instanceQuery, mUUIDParam, instanceID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteStmt, err := st.Prepare(`DELETE FROM machine_instance_tag WHERE machine_uuid = $machineUUID.uuid`, mUUIDParam)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	insertStmt, err := st.Prepare(`INSERT INTO machine_instance_tag (*) VALUES ($machineInstanceTag.*)`, machineInstanceTag{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	rows := make([]machineInstanceTag, 0, len(tags))
+	for key, value := range tags {
+		rows = append(rows, machineInstanceTag{
+			MachineUUID: mUUID,
+			Key:         key,
+			Value:       value,
+		})
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var current instanceID
+		err := tx.Query(ctx, instanceStmt, mUUIDParam).Get(&current)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(machineerrors.NotProvisioned, "machine: %q", mUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "querying instance for machine %q", mUUID)
+		}
+		if current.ID != string(instanceId) {
+			st.logger.Debugf("not setting instance tags of replaced instance %q of machine %q", instanceId, mUUID)
+			return nil
+		}
+
+		if err := tx.Query(ctx, deleteStmt, mUUIDParam).Run(); err != nil {
+			return errors.Annotatef(err, "deleting instance tags for machine %q", mUUID)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		if err := tx.Query(ctx, insertStmt, rows).Run(); err != nil {
+			return errors.Annotatef(err, "setting instance tags for machine %q", mUUID)
+		}
+		return nil
+	})
+}
+
 // SetMachineCloudInstance sets an entry in the machine cloud instance table
 // along with the instance tags and the link to a lxd profile if any.
 func (st *State) SetMachineCloudInstance(
@@ -143,6 +409,31 @@ WHERE  availability_zone.name = $availabilityZoneName.name
 		return errors.Trace(err)
 	}
 
+	// The provisioner supplies the hardware characteristics of the new
+	// instance, so they are cached straight away. The refresh time takes its
+	// default.
+	setHardwareCache := `
+INSERT INTO machine_hardware_characteristics_cache (
+    machine_uuid, instance_id, arch, mem, root_disk, root_disk_source,
+    cpu_cores, cpu_power, availability_zone_name, virt_type
+)
+VALUES (
+    $hardwareCharacteristicsCache.machine_uuid,
+    $hardwareCharacteristicsCache.instance_id,
+    $hardwareCharacteristicsCache.arch,
+    $hardwareCharacteristicsCache.mem,
+    $hardwareCharacteristicsCache.root_disk,
+    $hardwareCharacteristicsCache.root_disk_source,
+    $hardwareCharacteristicsCache.cpu_cores,
+    $hardwareCharacteristicsCache.cpu_power,
+    $hardwareCharacteristicsCache.availability_zone_name,
+    $hardwareCharacteristicsCache.virt_type
+)`
+	setHardwareCacheStmt, err := st.Prepare(setHardwareCache, hardwareCharacteristicsCache{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		instanceData := instanceData{
 			MachineUUID: machineUUID,
@@ -179,6 +470,12 @@ WHERE  availability_zone.name = $availabilityZoneName.name
 				return errors.Annotatef(err, "inserting instance tags for machine %q", machineUUID)
 			}
 		}
+		if hardwareCharacteristics != nil {
+			cache := newHardwareCharacteristicsCache(machineUUID, instanceID, *hardwareCharacteristics)
+			if err := tx.Query(ctx, setHardwareCacheStmt, cache).Run(); err != nil {
+				return errors.Annotatef(err, "caching hardware characteristics for machine %q", machineUUID)
+			}
+		}
 		return nil
 	})
 }
@@ -232,7 +529,33 @@ WHERE machine_uuid=$machineUUID.uuid
 		return errors.Trace(err)
 	}
 
+	// Prepare query for deleting the cached hardware characteristics, which
+	// must not be used for any replacement instance.
+	deleteHardwareCacheQuery := `DELETE FROM machine_hardware_characteristics_cache WHERE machine_uuid=$machineUUID.uuid`
+	deleteHardwareCacheStmt, err := st.Prepare(deleteHardwareCacheQuery, machineUUIDParam)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Prepare query for deleting the tags reported by the provider for the
+	// instance.
+	deleteProviderTagsQuery := `DELETE FROM machine_instance_tag WHERE machine_uuid=$machineUUID.uuid`
+	deleteProviderTagsStmt, err := st.Prepare(deleteProviderTagsQuery, machineUUIDParam)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
 	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		// Delete the cached hardware characteristics.
+		if err := tx.Query(ctx, deleteHardwareCacheStmt, machineUUIDParam).Run(); err != nil {
+			return errors.Annotatef(domain.CoerceError(err), "deleting cached hardware characteristics for machine %q", mUUID)
+		}
+
+		// Delete the tags reported by the provider.
+		if err := tx.Query(ctx, deleteProviderTagsStmt, machineUUIDParam).Run(); err != nil {
+			return errors.Annotatef(domain.CoerceError(err), "deleting provider instance tags for machine %q", mUUID)
+		}
+
 		// Delete the machine cloud instance status data. No need to return
 		// error if no status data is set for the instance while deleting.
 		if err := tx.Query(ctx, deleteInstanceStatusDataStmt, machineUUIDParam).Run(); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
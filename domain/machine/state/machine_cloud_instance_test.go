@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"time"
 
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -215,8 +216,10 @@ func (s *stateSuite) TestDeleteInstanceData(c *gc.C) {
 	db := s.DB()
 
 	machineUUID := s.ensureInstance(c, "42")
+	err := s.state.SetMachineInstanceTags(context.Background(), machineUUID, instance.Id("123"), map[string]string{"owner": "ops"})
+	c.Assert(err, jc.ErrorIsNil)
 
-	err := s.state.DeleteMachineCloudInstance(context.Background(), machineUUID)
+	err = s.state.DeleteMachineCloudInstance(context.Background(), machineUUID)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Check that all rows've been deleted.
@@ -230,6 +233,16 @@ func (s *stateSuite) TestDeleteInstanceData(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Assert(rows.Err(), jc.ErrorIsNil)
 	c.Check(rows.Next(), jc.IsFalse)
+	rows, err = db.QueryContext(context.Background(), "SELECT * FROM machine_instance_tag WHERE machine_uuid='"+machineUUID+"'")
+	defer func() { _ = rows.Close() }()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+	c.Check(rows.Next(), jc.IsFalse)
+	rows, err = db.QueryContext(context.Background(), "SELECT * FROM machine_hardware_characteristics_cache WHERE machine_uuid='"+machineUUID+"'")
+	defer func() { _ = rows.Close() }()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+	c.Check(rows.Next(), jc.IsFalse)
 }
 
 // TestDeleteInstanceDataWithStatus asserts that DeleteMachineCloudInstance at
@@ -258,6 +271,164 @@ func (s *stateSuite) TestDeleteInstanceDataWithStatus(c *gc.C) {
 	c.Assert(statusData, gc.Equals, 0)
 }
 
+func (s *stateSuite) TestGetHardwareCharacteristicsCacheNotProvisioned(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "42", "", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetHardwareCharacteristicsCache(context.Background(), "deadbeef")
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
+// TestGetHardwareCharacteristicsCachedAtProvisioning asserts that the hardware
+// characteristics of a new instance are cached when it is set.
+func (s *stateSuite) TestGetHardwareCharacteristicsCachedAtProvisioning(c *gc.C) {
+	before := time.Now().UTC().Add(-time.Second)
+	machineUUID := s.ensureInstance(c, "42")
+
+	cache, err := s.state.GetHardwareCharacteristicsCache(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cache.InstanceID, gc.Equals, instance.Id("123"))
+	c.Assert(cache.HardwareCharacteristics, gc.NotNil)
+	c.Check(*cache.HardwareCharacteristics, jc.DeepEquals, instance.HardwareCharacteristics{
+		Arch:             strptr("arm64"),
+		Mem:              uintptr(1024),
+		RootDisk:         uintptr(256),
+		RootDiskSource:   strptr("/test"),
+		CpuCores:         uintptr(4),
+		CpuPower:         uintptr(75),
+		AvailabilityZone: strptr("az-1"),
+		VirtType:         strptr("virtual-machine"),
+	})
+	c.Check(cache.LastRefreshed.After(before), jc.IsTrue)
+}
+
+func (s *stateSuite) TestGetHardwareCharacteristicsCacheNotCached(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "42", "", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineCloudInstance(context.Background(), "deadbeef", instance.Id("123"), "", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cache, err := s.state.GetHardwareCharacteristicsCache(context.Background(), "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cache.InstanceID, gc.Equals, instance.Id("123"))
+	c.Check(cache.HardwareCharacteristics, gc.IsNil)
+}
+
+func (s *stateSuite) TestSetHardwareCharacteristicsCache(c *gc.C) {
+	machineUUID := s.ensureInstance(c, "42")
+	refreshed := time.Now().UTC().Add(time.Hour).Truncate(time.Millisecond)
+
+	err := s.state.SetHardwareCharacteristicsCache(context.Background(), machineUUID, instance.Id("123"), instance.HardwareCharacteristics{
+		Arch: strptr("amd64"),
+		Mem:  uintptr(2048),
+	}, refreshed)
+	c.Assert(err, jc.ErrorIsNil)
+
+	cache, err := s.state.GetHardwareCharacteristicsCache(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(*cache.HardwareCharacteristics, jc.DeepEquals, instance.HardwareCharacteristics{
+		Arch: strptr("amd64"),
+		Mem:  uintptr(2048),
+	})
+	c.Check(cache.LastRefreshed.Equal(refreshed), jc.IsTrue, gc.Commentf("%v != %v", cache.LastRefreshed, refreshed))
+}
+
+// TestSetHardwareCharacteristicsCacheReplacedInstance asserts that the
+// hardware characteristics of a replaced instance are not cached for its
+// replacement.
+func (s *stateSuite) TestSetHardwareCharacteristicsCacheReplacedInstance(c *gc.C) {
+	machineUUID := s.ensureInstance(c, "42")
+	err := s.state.DeleteMachineCloudInstance(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineCloudInstance(context.Background(), machineUUID, instance.Id("456"), "", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetHardwareCharacteristicsCache(context.Background(), machineUUID, instance.Id("123"), instance.HardwareCharacteristics{
+		Arch: strptr("amd64"),
+	}, time.Now())
+	c.Assert(err, jc.ErrorIsNil)
+
+	cache, err := s.state.GetHardwareCharacteristicsCache(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cache.InstanceID, gc.Equals, instance.Id("456"))
+	c.Check(cache.HardwareCharacteristics, gc.IsNil)
+}
+
+func (s *stateSuite) TestSetHardwareCharacteristicsCacheNotProvisioned(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "42", "", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetHardwareCharacteristicsCache(context.Background(), "deadbeef", instance.Id("123"), instance.HardwareCharacteristics{}, time.Now())
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
+func (s *stateSuite) TestGetMachineInstanceTagsNotProvisioned(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "42", "", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = s.state.GetMachineInstanceTags(context.Background(), "deadbeef")
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
+func (s *stateSuite) TestGetMachineInstanceTagsNotSynced(c *gc.C) {
+	machineUUID := s.ensureInstance(c, "42")
+
+	tags, err := s.state.GetMachineInstanceTags(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tags, gc.HasLen, 0)
+}
+
+// TestSetMachineInstanceTags asserts that setting the instance tags replaces
+// any previously set.
+func (s *stateSuite) TestSetMachineInstanceTags(c *gc.C) {
+	machineUUID := s.ensureInstance(c, "42")
+
+	err := s.state.SetMachineInstanceTags(context.Background(), machineUUID, instance.Id("123"), map[string]string{
+		"owner": "ops",
+		"team":  "a",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineInstanceTags(context.Background(), machineUUID, instance.Id("123"), map[string]string{
+		"owner":       "ops",
+		"cost-centre": "42",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tags, err := s.state.GetMachineInstanceTags(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tags, jc.DeepEquals, map[string]string{
+		"owner":       "ops",
+		"cost-centre": "42",
+	})
+}
+
+// TestSetMachineInstanceTagsReplacedInstance asserts that the tags of a
+// replaced instance are not recorded for its replacement.
+func (s *stateSuite) TestSetMachineInstanceTagsReplacedInstance(c *gc.C) {
+	machineUUID := s.ensureInstance(c, "42")
+	err := s.state.DeleteMachineCloudInstance(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineCloudInstance(context.Background(), machineUUID, instance.Id("456"), "", nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetMachineInstanceTags(context.Background(), machineUUID, instance.Id("123"), map[string]string{
+		"owner": "ops",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	tags, err := s.state.GetMachineInstanceTags(context.Background(), machineUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tags, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestSetMachineInstanceTagsNotProvisioned(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "42", "", "deadbeef")
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetMachineInstanceTags(context.Background(), "deadbeef", instance.Id("123"), map[string]string{"owner": "ops"})
+	c.Assert(err, jc.ErrorIs, machineerrors.NotProvisioned)
+}
+
 func strptr(s string) *string {
 	return &s
 }
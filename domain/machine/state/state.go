@@ -15,9 +15,11 @@ import (
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/status"
+	"github.com/juju/juju/core/unit"
 	"github.com/juju/juju/domain"
 	blockdevice "github.com/juju/juju/domain/blockdevice/state"
 	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	internalerrors "github.com/juju/juju/internal/errors"
 )
@@ -38,13 +40,15 @@ func NewState(factory coredb.TxnRunnerFactory, logger logger.Logger) *State {
 
 // CreateMachine creates or updates the specified machine.
 // Adds a row to machine table, as well as a row to the net_node table.
+// The machine is recorded as a bare-metal machine.
 // It returns a MachineAlreadyExists error if a machine with the same name
 // already exists.
 func (st *State) CreateMachine(ctx context.Context, machineName machine.Name, nodeUUID, machineUUID string) error {
 	return st.createMachine(ctx, createMachineArgs{
-		name:        machineName,
-		netNodeUUID: nodeUUID,
-		machineUUID: machineUUID,
+		name:          machineName,
+		netNodeUUID:   nodeUUID,
+		machineUUID:   machineUUID,
+		containerType: domainmachine.ContainerTypeBareMetal,
 	})
 }
 
@@ -52,15 +56,20 @@ func (st *State) CreateMachine(ctx context.Context, machineName machine.Name, no
 // parent.
 // Adds a row to machine table, as well as a row to the net_node table, and adds
 // a row to the machine_parent table for associating with the specified parent.
+// The machine is recorded as a container of the specified type.
 // It returns a MachineNotFound error if the parent machine does not exist.
 // It returns a MachineAlreadyExists error if a machine with the same name
 // already exists.
-func (st *State) CreateMachineWithParent(ctx context.Context, machineName, parentName machine.Name, nodeUUID, machineUUID string) error {
+func (st *State) CreateMachineWithParent(
+	ctx context.Context, machineName, parentName machine.Name, nodeUUID, machineUUID string,
+	containerType domainmachine.ContainerType,
+) error {
 	return st.createMachine(ctx, createMachineArgs{
-		name:        machineName,
-		netNodeUUID: nodeUUID,
-		machineUUID: machineUUID,
-		parentName:  parentName,
+		name:          machineName,
+		netNodeUUID:   nodeUUID,
+		machineUUID:   machineUUID,
+		parentName:    parentName,
+		containerType: containerType,
 	})
 }
 
@@ -88,14 +97,15 @@ func (st *State) createMachine(ctx context.Context, args createMachineArgs) erro
 
 	// Prepare query for creating machine row.
 	createParams := sqlair.M{
-		"machine_uuid":  args.machineUUID,
-		"net_node_uuid": args.netNodeUUID,
-		"name":          mName,
-		"life_id":       life.Alive,
+		"machine_uuid":      args.machineUUID,
+		"net_node_uuid":     args.netNodeUUID,
+		"name":              mName,
+		"life_id":           life.Alive,
+		"container_type_id": args.containerType,
 	}
 	createMachineQuery := `
-INSERT INTO machine (uuid, net_node_uuid, name, life_id)
-VALUES ($M.machine_uuid, $M.net_node_uuid, $M.name, $M.life_id)
+INSERT INTO machine (uuid, net_node_uuid, name, life_id, container_type_id)
+VALUES ($M.machine_uuid, $M.net_node_uuid, $M.name, $M.life_id, $M.container_type_id)
 `
 	createMachineStmt, err := st.Prepare(createMachineQuery, createParams)
 	if err != nil {
@@ -659,6 +669,134 @@ FROM machine_parent WHERE machine_uuid = $machineUUID.uuid`
 	return parentUUID, errors.Annotatef(err, "getting parent UUID for machine %q", uuid)
 }
 
+// ListZombieContainers returns the containers whose host machine has been
+// removed, or is dead or marked for removal, along with the units running in
+// them.
+func (st *State) ListZombieContainers(ctx context.Context) ([]domainmachine.ZombieContainer, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	query := `
+SELECT m.uuid AS &zombieContainer.uuid,
+       m.name AS &zombieContainer.name,
+       mp.parent_uuid AS &zombieContainer.parent_uuid,
+       m.container_type_id AS &zombieContainer.container_type_id,
+       u.name AS &zombieContainer.unit_name
+FROM machine AS m
+JOIN machine_parent AS mp ON mp.machine_uuid = m.uuid
+LEFT JOIN machine AS p ON p.uuid = mp.parent_uuid
+LEFT JOIN unit AS u ON u.net_node_uuid = m.net_node_uuid
+WHERE p.uuid IS NULL
+OR p.life_id = 2
+OR p.uuid IN (SELECT machine_uuid FROM machine_removals)
+ORDER BY m.name, u.name`
+	queryStmt, err := st.Prepare(query, zombieContainer{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows []zombieContainer
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryStmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "querying zombie containers")
+	}
+
+	var (
+		result []domainmachine.ZombieContainer
+		index  = make(map[string]int)
+	)
+	for _, row := range rows {
+		i, ok := index[row.UUID]
+		if !ok {
+			i = len(result)
+			index[row.UUID] = i
+			result = append(result, domainmachine.ZombieContainer{
+				UUID:          row.UUID,
+				Name:          machine.Name(row.Name),
+				HostUUID:      row.ParentUUID,
+				ContainerType: domainmachine.ContainerType(row.ContainerTypeID),
+			})
+		}
+		if row.UnitName.Valid {
+			result[i].Units = append(result[i].Units, unit.Name(row.UnitName.String))
+		}
+	}
+	return result, nil
+}
+
+// GetMachineContainerCount returns the number of containers hosted on the
+// specified machine, broken down by container type and status.
+// It returns a MachineNotFound if the host machine does not exist.
+func (st *State) GetMachineContainerCount(ctx context.Context, hostUUID string) (domainmachine.ContainerCount, error) {
+	db, err := st.DB()
+	if err != nil {
+		return domainmachine.ContainerCount{}, errors.Trace(err)
+	}
+
+	hostUUIDParam := machineUUID{UUID: hostUUID}
+	machineQuery := `SELECT &machineUUID.uuid FROM machine WHERE uuid = $machineUUID.uuid`
+	machineStmt, err := st.Prepare(machineQuery, hostUUIDParam)
+	if err != nil {
+		return domainmachine.ContainerCount{}, errors.Trace(err)
+	}
+
+	containerQuery := `
+SELECT m.container_type_id AS &hostedContainer.container_type_id,
+       ms.status_id AS &hostedContainer.status_id
+FROM machine AS m
+JOIN machine_parent AS mp ON mp.machine_uuid = m.uuid
+LEFT JOIN machine_status AS ms ON ms.machine_uuid = m.uuid
+WHERE mp.parent_uuid = $machineUUID.uuid`
+	containerStmt, err := st.Prepare(containerQuery, hostUUIDParam, hostedContainer{})
+	if err != nil {
+		return domainmachine.ContainerCount{}, errors.Trace(err)
+	}
+
+	var containers []hostedContainer
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, machineStmt, hostUUIDParam).Get(&hostUUIDParam)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(machineerrors.MachineNotFound, "machine %q", hostUUID)
+		}
+		if err != nil {
+			return errors.Annotatef(err, "checking existence of machine %q", hostUUID)
+		}
+
+		err = tx.Query(ctx, containerStmt, hostUUIDParam).GetAll(&containers)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return domainmachine.ContainerCount{}, errors.Annotatef(err, "counting containers on machine %q", hostUUID)
+	}
+
+	started := int64(fromCoreMachineStatusValue(status.Started))
+	var count domainmachine.ContainerCount
+	for _, container := range containers {
+		count.TotalContainers++
+		switch domainmachine.ContainerType(container.ContainerTypeID) {
+		case domainmachine.ContainerTypeLXD:
+			count.LXDContainers++
+		case domainmachine.ContainerTypeKVM:
+			count.KVMContainers++
+		}
+		if container.StatusID.Valid && container.StatusID.Int64 == started {
+			count.RunningContainers++
+		}
+	}
+	return count, nil
+}
+
 // MarkMachineForRemoval marks the specified machine for removal.
 // It returns NotFound if the machine does not exist.
 // TODO(cderici): use machineerrors.MachineNotFound on rebase after #17759
@@ -19,6 +19,7 @@ import (
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
 	machineerrors "github.com/juju/juju/domain/machine/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
@@ -93,7 +94,7 @@ func (s *stateSuite) TestCreateMachineWithParentSuccess(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create the machine with the created parent
-	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "4", "2")
+	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "4", "2", domainmachine.ContainerTypeLXD)
 	c.Check(err, jc.ErrorIsNil)
 
 	// Make sure the newly created machine with parent has been created.
@@ -121,7 +122,7 @@ WHERE   parent.parent_uuid = 1
 // TestCreateMachineWithParentNotFound asserts that a NotFound error is returned
 // when the parent machine is not found.
 func (s *stateSuite) TestCreateMachineWithParentNotFound(c *gc.C) {
-	err := s.state.CreateMachineWithParent(context.Background(), "667", "666", "4", "2")
+	err := s.state.CreateMachineWithParent(context.Background(), "667", "666", "4", "2", domainmachine.ContainerTypeLXD)
 	c.Check(err, jc.ErrorIs, machineerrors.MachineNotFound)
 }
 
@@ -131,7 +132,7 @@ func (s *stateSuite) TestCreateMachineWithParentAlreadyExists(c *gc.C) {
 	err := s.state.CreateMachine(context.Background(), "666", "", "")
 	c.Assert(err, jc.ErrorIsNil)
 
-	err = s.state.CreateMachineWithParent(context.Background(), "666", "357", "4", "2")
+	err = s.state.CreateMachineWithParent(context.Background(), "666", "357", "4", "2", domainmachine.ContainerTypeLXD)
 	c.Check(err, jc.ErrorIs, machineerrors.MachineAlreadyExists)
 }
 
@@ -144,11 +145,11 @@ func (s *stateSuite) TestCreateMachineWithGrandParentNotAllowed(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create the machine with the created parent.
-	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "2", "456")
+	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "2", "456", domainmachine.ContainerTypeLXD)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create the machine with the created parent.
-	err = s.state.CreateMachineWithParent(context.Background(), "668", "667", "3", "789")
+	err = s.state.CreateMachineWithParent(context.Background(), "668", "667", "3", "789", domainmachine.ContainerTypeLXD)
 	c.Assert(err, jc.ErrorIs, machineerrors.GrandParentNotSupported)
 }
 
@@ -583,7 +584,7 @@ func (s *stateSuite) TestGetMachineParentUUIDSuccess(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Create the machine with the created parent.
-	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "2", "456")
+	err = s.state.CreateMachineWithParent(context.Background(), "667", "666", "2", "456", domainmachine.ContainerTypeLXD)
 	c.Assert(err, jc.ErrorIsNil)
 
 	// Get the parent UUID of the machine.
@@ -609,6 +610,106 @@ func (s *stateSuite) TestGetMachineParentUUIDNoParent(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, machineerrors.MachineHasNoParent)
 }
 
+// TestListZombieContainers asserts that containers are returned if their
+// parent is dead or marked for removal.
+func (s *stateSuite) TestListZombieContainers(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "0", "1", "123")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "0/lxd/0", "0", "2", "456", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachine(context.Background(), "1", "3", "789")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "1/kvm/0", "1", "4", "012", domainmachine.ContainerTypeKVM)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachine(context.Background(), "2", "5", "345")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "2/lxd/0", "2", "6", "678", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.MarkMachineForRemoval(context.Background(), "1")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineLife(context.Background(), "2", life.Dead)
+	c.Assert(err, jc.ErrorIsNil)
+
+	containers, err := s.state.ListZombieContainers(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(containers, jc.DeepEquals, []domainmachine.ZombieContainer{{
+		UUID:          "012",
+		Name:          "1/kvm/0",
+		HostUUID:      "789",
+		ContainerType: domainmachine.ContainerTypeKVM,
+	}, {
+		UUID:          "678",
+		Name:          "2/lxd/0",
+		HostUUID:      "345",
+		ContainerType: domainmachine.ContainerTypeLXD,
+	}})
+}
+
+// TestListZombieContainersNone asserts that no containers are returned if
+// every parent is alive.
+func (s *stateSuite) TestListZombieContainersNone(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "0", "1", "123")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "0/lxd/0", "0", "2", "456", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+
+	containers, err := s.state.ListZombieContainers(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(containers, gc.HasLen, 0)
+}
+
+// TestGetMachineContainerCount asserts that only the containers hosted on
+// the given machine are counted, broken down by type and status.
+func (s *stateSuite) TestGetMachineContainerCount(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "0", "1", "123")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "0/lxd/0", "0", "2", "456", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "0/lxd/1", "0", "3", "789", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "0/kvm/0", "0", "4", "012", domainmachine.ContainerTypeKVM)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachine(context.Background(), "1", "5", "345")
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.CreateMachineWithParent(context.Background(), "1/lxd/0", "1", "6", "678", domainmachine.ContainerTypeLXD)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.state.SetMachineStatus(context.Background(), "0/lxd/0", status.StatusInfo{Status: status.Started})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineStatus(context.Background(), "0/lxd/1", status.StatusInfo{Status: status.Pending})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.state.SetMachineStatus(context.Background(), "1/lxd/0", status.StatusInfo{Status: status.Started})
+	c.Assert(err, jc.ErrorIsNil)
+
+	count, err := s.state.GetMachineContainerCount(context.Background(), "123")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, domainmachine.ContainerCount{
+		TotalContainers:   3,
+		LXDContainers:     2,
+		KVMContainers:     1,
+		RunningContainers: 1,
+	})
+}
+
+// TestGetMachineContainerCountNoContainers asserts that a zero count is
+// returned for a machine hosting no containers.
+func (s *stateSuite) TestGetMachineContainerCountNoContainers(c *gc.C) {
+	err := s.state.CreateMachine(context.Background(), "0", "1", "123")
+	c.Assert(err, jc.ErrorIsNil)
+
+	count, err := s.state.GetMachineContainerCount(context.Background(), "123")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, domainmachine.ContainerCount{})
+}
+
+// TestGetMachineContainerCountNotFound asserts that a NotFound error is
+// returned when the host machine is not found.
+func (s *stateSuite) TestGetMachineContainerCountNotFound(c *gc.C) {
+	_, err := s.state.GetMachineContainerCount(context.Background(), "666")
+	c.Assert(err, jc.ErrorIs, machineerrors.MachineNotFound)
+}
+
 // TestMarkMachineForRemovalSuccess asserts the happy path of
 // MarkMachineForRemoval at the state layer.
 func (s *stateSuite) TestMarkMachineForRemovalSuccess(c *gc.C) {
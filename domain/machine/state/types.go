@@ -4,12 +4,14 @@
 package state
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/juju/juju/core/instance"
 	"github.com/juju/juju/core/machine"
 	"github.com/juju/juju/core/status"
 	"github.com/juju/juju/domain/life"
+	domainmachine "github.com/juju/juju/domain/machine"
 )
 
 // instanceData represents the struct to be inserted into the instance_data
@@ -43,6 +45,60 @@ type instanceDataResult struct {
 	VirtType         *string `db:"virt_type"`
 }
 
+// hardwareCharacteristicsCache represents the struct to be used for the
+// machine_hardware_characteristics_cache table.
+type hardwareCharacteristicsCache struct {
+	MachineUUID      string    `db:"machine_uuid"`
+	InstanceID       string    `db:"instance_id"`
+	Arch             *string   `db:"arch"`
+	Mem              *uint64   `db:"mem"`
+	RootDisk         *uint64   `db:"root_disk"`
+	RootDiskSource   *string   `db:"root_disk_source"`
+	CPUCores         *uint64   `db:"cpu_cores"`
+	CPUPower         *uint64   `db:"cpu_power"`
+	AvailabilityZone *string   `db:"availability_zone_name"`
+	VirtType         *string   `db:"virt_type"`
+	LastRefreshed    time.Time `db:"last_refreshed"`
+}
+
+func newHardwareCharacteristicsCache(
+	machineUUID string, instanceID instance.Id, hc instance.HardwareCharacteristics,
+) hardwareCharacteristicsCache {
+	return hardwareCharacteristicsCache{
+		MachineUUID:      machineUUID,
+		InstanceID:       instanceID.String(),
+		Arch:             hc.Arch,
+		Mem:              hc.Mem,
+		RootDisk:         hc.RootDisk,
+		RootDiskSource:   hc.RootDiskSource,
+		CPUCores:         hc.CpuCores,
+		CPUPower:         hc.CpuPower,
+		AvailabilityZone: hc.AvailabilityZone,
+		VirtType:         hc.VirtType,
+	}
+}
+
+func (c *hardwareCharacteristicsCache) toHardwareCharacteristics() *instance.HardwareCharacteristics {
+	return &instance.HardwareCharacteristics{
+		Arch:             c.Arch,
+		Mem:              c.Mem,
+		RootDisk:         c.RootDisk,
+		RootDiskSource:   c.RootDiskSource,
+		CpuCores:         c.CPUCores,
+		CpuPower:         c.CPUPower,
+		AvailabilityZone: c.AvailabilityZone,
+		VirtType:         c.VirtType,
+	}
+}
+
+// machineInstanceTag represents the struct to be used for the
+// machine_instance_tag table.
+type machineInstanceTag struct {
+	MachineUUID string `db:"machine_uuid"`
+	Key         string `db:"key"`
+	Value       string `db:"value"`
+}
+
 // instanceTag represents the struct to be inserted into the instance_tag
 // table.
 type instanceTag struct {
@@ -253,10 +309,11 @@ func fromCoreInstanceStatusValue(s status.Status) int {
 // createMachineArgs represents the struct to be used for the input parameters
 // of the createMachine state method in the machine domain.
 type createMachineArgs struct {
-	name        machine.Name
-	machineUUID string
-	netNodeUUID string
-	parentName  machine.Name
+	name          machine.Name
+	machineUUID   string
+	netNodeUUID   string
+	parentName    machine.Name
+	containerType domainmachine.ContainerType
 }
 
 // lxdProfile represents the struct to be used for the sqlair statements on the
@@ -266,3 +323,20 @@ type lxdProfile struct {
 	Name        string `db:"name"`
 	Index       int    `db:"array_index"`
 }
+
+// zombieContainer represents a container whose host machine has been
+// removed, or is dead or marked for removal, along with a unit running in it.
+type zombieContainer struct {
+	UUID            string         `db:"uuid"`
+	Name            string         `db:"name"`
+	ParentUUID      string         `db:"parent_uuid"`
+	ContainerTypeID int            `db:"container_type_id"`
+	UnitName        sql.NullString `db:"unit_name"`
+}
+
+// hostedContainer represents a single container hosted on a machine, used to
+// count the containers on a host.
+type hostedContainer struct {
+	ContainerTypeID int           `db:"container_type_id"`
+	StatusID        sql.NullInt64 `db:"status_id"`
+}
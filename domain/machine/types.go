@@ -0,0 +1,88 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package machine
+
+import (
+	"time"
+
+	"github.com/juju/juju/core/instance"
+	"github.com/juju/juju/core/machine"
+	"github.com/juju/juju/core/unit"
+)
+
+// HardwareCharacteristicsCache holds the cached hardware characteristics of a
+// machine's current instance.
+type HardwareCharacteristicsCache struct {
+	// InstanceID is the ID of the machine's current instance.
+	InstanceID instance.Id
+
+	// HardwareCharacteristics are the cached hardware characteristics of
+	// the instance. It is nil if none are cached for the current instance.
+	HardwareCharacteristics *instance.HardwareCharacteristics
+
+	// LastRefreshed is when the hardware characteristics were last
+	// obtained from the provider.
+	LastRefreshed time.Time
+}
+
+// ContainerType represents the type of container a machine is,
+// as recorded in the container_type lookup table.
+type ContainerType int
+
+const (
+	ContainerTypeBareMetal ContainerType = iota
+	ContainerTypeLXD
+	ContainerTypeKVM
+	ContainerTypeDocker
+)
+
+// String returns the name of the container type.
+func (t ContainerType) String() string {
+	switch t {
+	case ContainerTypeBareMetal:
+		return "bare-metal"
+	case ContainerTypeLXD:
+		return "lxd"
+	case ContainerTypeKVM:
+		return "kvm"
+	case ContainerTypeDocker:
+		return "docker"
+	}
+	return ""
+}
+
+// ZombieContainer describes a container whose host machine has been removed,
+// or is dead or marked for removal, leaving the container orphaned.
+type ZombieContainer struct {
+	// UUID is the UUID of the container machine.
+	UUID string
+
+	// Name is the name of the container machine.
+	Name machine.Name
+
+	// HostUUID is the UUID of the last known host machine.
+	HostUUID string
+
+	// ContainerType is the type of the container.
+	ContainerType ContainerType
+
+	// Units are the names of the units running in the container.
+	Units []unit.Name
+}
+
+// ContainerCount describes the containers hosted on a machine.
+type ContainerCount struct {
+	// TotalContainers is the number of containers hosted on the machine.
+	TotalContainers int
+
+	// LXDContainers is the number of LXD containers hosted on the machine.
+	LXDContainers int
+
+	// KVMContainers is the number of KVM containers hosted on the machine.
+	KVMContainers int
+
+	// RunningContainers is the number of containers whose machine status is
+	// started.
+	RunningContainers int
+}
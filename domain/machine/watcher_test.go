@@ -6,7 +6,9 @@ package machine_test
 import (
 	"context"
 
+	"github.com/juju/clock"
 	jc "github.com/juju/testing/checkers"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/changestream"
@@ -40,6 +42,11 @@ func (s *watcherSuite) SetUpTest(c *gc.C) {
 		),
 		domain.NewWatcherFactory(factory, loggertesting.WrapCheckLog(c)),
 		nil,
+		nil,
+		nil,
+		&singleflight.Group{},
+		clock.WallClock,
+		loggertesting.WrapCheckLog(c),
 	)
 }
 
@@ -122,7 +122,7 @@ func (i *importOperation) Setup(scope modelmigration.Scope) error {
 			modelstate.NewModelState(scope.ModelDB(), i.logger),
 		)
 	}
-	i.userService = accessservice.NewService(accessstate.NewState(scope.ControllerDB(), i.logger))
+	i.userService = accessservice.NewService(accessstate.NewState(scope.ControllerDB(), i.logger), i.logger)
 	i.controllerConfigService = controllerconfigservice.NewService(
 		controllerconfigstate.NewState(scope.ControllerDB()),
 	)
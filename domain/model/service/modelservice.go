@@ -26,6 +26,11 @@ type ModelState interface {
 
 	// Model returns the read only model information set in the database.
 	Model(context.Context) (coremodel.ReadOnlyModel, error)
+
+	// GetResourceUsage returns the number of machines, units, storage
+	// volumes and cloud containers in the model, along with the combined size
+	// of the storage volumes.
+	GetResourceUsage(context.Context) (model.ResourceUsageSummary, error)
 }
 
 // ControllerState is the controller state required by this service. This is the
@@ -68,6 +73,18 @@ func (s *ModelService) GetModelInfo(ctx context.Context) (coremodel.ReadOnlyMode
 	return s.modelSt.Model(ctx)
 }
 
+// GetModelResourceUsage returns the aggregated resource usage of the model,
+// for use by billing and capacity planning. The summary is computed on every
+// call; LastComputedAt records when that happened.
+func (s *ModelService) GetModelResourceUsage(ctx context.Context) (model.ResourceUsageSummary, error) {
+	usage, err := s.modelSt.GetResourceUsage(ctx)
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+	usage.LastComputedAt = s.clock.Now().UTC()
+	return usage, nil
+}
+
 // CreateModel is responsible for creating a new model within the model
 // database.
 //
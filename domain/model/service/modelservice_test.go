@@ -26,6 +26,8 @@ type dummyModelState struct {
 	setID  coremodel.UUID
 
 	modelState map[coremodel.UUID]model.ModelState
+
+	resourceUsage model.ResourceUsageSummary
 }
 
 func (d *dummyModelState) Create(ctx context.Context, args model.ReadOnlyModelCreationArgs) error {
@@ -80,6 +82,10 @@ func (d *dummyModelState) Model(ctx context.Context) (coremodel.ReadOnlyModel, e
 	}, nil
 }
 
+func (d *dummyModelState) GetResourceUsage(context.Context) (model.ResourceUsageSummary, error) {
+	return d.resourceUsage, nil
+}
+
 func (d *dummyModelState) Delete(ctx context.Context, modelUUID coremodel.UUID) error {
 	delete(d.models, modelUUID)
 	return nil
@@ -251,3 +257,28 @@ func (s *modelServiceSuite) TestStatusFaildModelNotFound(c *gc.C) {
 	_, err := svc.GetStatus(context.Background())
 	c.Assert(err, jc.ErrorIs, modelerrors.NotFound)
 }
+
+func (s *modelServiceSuite) TestGetModelResourceUsage(c *gc.C) {
+	id := modeltesting.GenModelUUID(c)
+	svc := NewModelService(id, s.state, s.state)
+	now := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+	svc.clock = testclock.NewClock(now)
+
+	s.state.resourceUsage = model.ResourceUsageSummary{
+		MachineCount:       2,
+		UnitCount:          5,
+		StorageVolumeCount: 3,
+		TotalStorageGiB:    1.5,
+		CAASContainerCount: 0,
+	}
+
+	result, err := svc.GetModelResourceUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, model.ResourceUsageSummary{
+		MachineCount:       2,
+		UnitCount:          5,
+		StorageVolumeCount: 3,
+		TotalStorageGiB:    1.5,
+		LastComputedAt:     now,
+	})
+}
@@ -14,12 +14,14 @@ import (
 
 	"github.com/juju/juju/core/credential"
 	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/lease"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/permission"
 	"github.com/juju/juju/core/user"
 	"github.com/juju/juju/domain"
 	accesserrors "github.com/juju/juju/domain/access/errors"
 	clouderrors "github.com/juju/juju/domain/cloud/errors"
+	leasestate "github.com/juju/juju/domain/lease/state"
 	"github.com/juju/juju/domain/life"
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
@@ -672,6 +674,7 @@ func createModel(
 // - Secret backends
 // - Secret backend ref counting
 // - Model agent information
+// - Model agent upgrade progress
 // - Model permissions
 // - Model login information
 func (s *State) Delete(
@@ -690,6 +693,7 @@ func (s *State) Delete(
 		`DELETE FROM secret_backend_reference WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_authorized_keys WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM model_agent WHERE model_uuid = $dbUUID.uuid`,
+		`DELETE FROM model_agent_upgrade_state WHERE model_uuid = $dbUUID.uuid`,
 		`DELETE FROM permission WHERE grant_on = $dbUUID.uuid`,
 		`DELETE FROM model_last_login WHERE model_uuid = $dbUUID.uuid`,
 	}
@@ -714,6 +718,17 @@ func (s *State) Delete(
 			return fmt.Errorf("un-registering model %q database namespaces: %w", uuid, err)
 		}
 
+		// The model is going away, so its leases are removed regardless of
+		// whether they have expired.
+		for _, namespace := range []string{
+			lease.ApplicationLeadershipNamespace,
+			lease.SingularControllerNamespace,
+		} {
+			if err := leasestate.RemoveLeaseGroup(ctx, tx, namespace, uuid.String()); err != nil {
+				return errors.Trace(err)
+			}
+		}
+
 		for _, stmt := range stmts {
 			if err := tx.Query(ctx, stmt, mUUID).Run(); errors.Is(err, sqlair.ErrNoRows) {
 				continue
@@ -18,6 +18,7 @@ import (
 
 	"github.com/juju/juju/cloud"
 	corecredential "github.com/juju/juju/core/credential"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/life"
 	coremodel "github.com/juju/juju/core/model"
 	modeltesting "github.com/juju/juju/core/model/testing"
@@ -33,6 +34,7 @@ import (
 	credentialstate "github.com/juju/juju/domain/credential/state"
 	"github.com/juju/juju/domain/keymanager"
 	keymanagerstate "github.com/juju/juju/domain/keymanager/state"
+	leasestate "github.com/juju/juju/domain/lease/state"
 	"github.com/juju/juju/domain/model"
 	modelerrors "github.com/juju/juju/domain/model/errors"
 	schematesting "github.com/juju/juju/domain/schema/testing"
@@ -735,6 +737,8 @@ func (m *stateSuite) TestSetModelCloudCredentialWithoutRegion(c *gc.C) {
 // This test is also confirming cleaning up of other resources related to the
 // model. Specifically:
 // - Authorized keys onto the model.
+// - Leases held for the model, whether or not they have expired.
+// - Agent upgrade progress recorded for the model.
 func (m *stateSuite) TestDeleteModel(c *gc.C) {
 	keyManagerState := keymanagerstate.NewState(m.TxnRunnerFactory())
 	err := keyManagerState.AddPublicKeysForUser(
@@ -752,6 +756,26 @@ func (m *stateSuite) TestDeleteModel(c *gc.C) {
 	)
 	c.Assert(err, jc.ErrorIsNil)
 
+	leaseSt := leasestate.NewState(m.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	leaseKey := lease.Key{
+		Namespace: lease.ApplicationLeadershipNamespace,
+		ModelUUID: m.uuid.String(),
+		Lease:     "redis",
+	}
+	err = leaseSt.ClaimLease(context.Background(), uuid.MustNewUUID(), leaseKey, lease.Request{
+		Holder:   "redis/0",
+		Duration: time.Minute,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = leaseSt.PinLease(context.Background(), leaseKey, "machine/0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	_, err = m.DB().ExecContext(context.Background(), `
+INSERT INTO model_agent_upgrade_state (model_uuid, agent_name, current_version, target_version, state_type_id)
+VALUES (?, 'machine-0', '4.0.0', '4.0.1', 0)
+	`, m.uuid)
+	c.Assert(err, jc.ErrorIsNil)
+
 	modelSt := NewState(m.TxnRunnerFactory())
 	err = modelSt.Delete(
 		context.Background(),
@@ -781,6 +805,17 @@ WHERE model_uuid = ?
 	// ErrNoRows is not returned by row.Err, it is deferred until row.Scan
 	// is called.
 	c.Assert(row.Scan(nil), jc.ErrorIs, sql.ErrNoRows)
+
+	row = db.QueryRow(`
+SELECT model_uuid
+FROM model_agent_upgrade_state
+WHERE model_uuid = ?
+	`, m.uuid)
+	c.Assert(row.Scan(nil), jc.ErrorIs, sql.ErrNoRows)
+
+	leases, err := leaseSt.LeaseGroup(context.Background(), lease.ApplicationLeadershipNamespace, m.uuid.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(leases, gc.HasLen, 0)
 }
 
 func (m *stateSuite) TestDeleteModelNotFound(c *gc.C) {
@@ -244,3 +244,71 @@ INSERT INTO model (*) VALUES ($dbReadOnlyModel.*)
 
 	return nil
 }
+
+// GetResourceUsage returns the number of machines, units, storage volumes and
+// cloud containers in the model, along with the combined size of the storage
+// volumes. All counts are read in a single transaction. The LastComputedAt
+// field of the result is not set.
+func (s *ModelState) GetResourceUsage(ctx context.Context) (model.ResourceUsageSummary, error) {
+	db, err := s.DB()
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+
+	machineStmt, err := s.Prepare(`SELECT COUNT(*) AS &dbCount.count FROM machine`, dbCount{})
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+
+	unitStmt, err := s.Prepare(`SELECT COUNT(*) AS &dbCount.count FROM unit`, dbCount{})
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+
+	volumeStmt, err := s.Prepare(`
+SELECT COUNT(*) AS &dbVolumeUsage.count,
+       COALESCE(SUM(size_mib), 0) AS &dbVolumeUsage.total_size_mib
+FROM   storage_volume
+`, dbVolumeUsage{})
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+
+	containerStmt, err := s.Prepare(`SELECT COUNT(*) AS &dbCount.count FROM cloud_container`, dbCount{})
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Capture(err)
+	}
+
+	var (
+		machineCount   dbCount
+		unitCount      dbCount
+		volumeUsage    dbVolumeUsage
+		containerCount dbCount
+	)
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := tx.Query(ctx, machineStmt).Get(&machineCount); err != nil {
+			return errors.Errorf("counting machines: %w", err)
+		}
+		if err := tx.Query(ctx, unitStmt).Get(&unitCount); err != nil {
+			return errors.Errorf("counting units: %w", err)
+		}
+		if err := tx.Query(ctx, volumeStmt).Get(&volumeUsage); err != nil {
+			return errors.Errorf("counting storage volumes: %w", err)
+		}
+		if err := tx.Query(ctx, containerStmt).Get(&containerCount); err != nil {
+			return errors.Errorf("counting cloud containers: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return model.ResourceUsageSummary{}, errors.Errorf("getting model resource usage: %w", err)
+	}
+
+	return model.ResourceUsageSummary{
+		MachineCount:       machineCount.Count,
+		UnitCount:          unitCount.Count,
+		StorageVolumeCount: volumeUsage.Count,
+		TotalStorageGiB:    float64(volumeUsage.TotalSizeMiB) / 1024,
+		CAASContainerCount: containerCount.Count,
+	}, nil
+}
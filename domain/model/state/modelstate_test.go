@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"database/sql"
 
 	jc "github.com/juju/testing/checkers"
 	gc "gopkg.in/check.v1"
@@ -207,3 +208,44 @@ func (s *modelSuite) TestModelNotFound(c *gc.C) {
 	_, err := state.Model(context.Background())
 	c.Assert(err, jc.ErrorIs, modelerrors.NotFound)
 }
+
+func (s *modelSuite) TestGetResourceUsage(c *gc.C) {
+	state := NewModelState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	usage, err := state.GetResourceUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, model.ResourceUsageSummary{})
+
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		stmts := []string{
+			`INSERT INTO charm (uuid, reference_name, architecture_id) VALUES ('charm-uuid', 'app', 0)`,
+			`INSERT INTO net_node (uuid) VALUES ('node-uuid')`,
+			`INSERT INTO application (uuid, name, life_id, charm_uuid) VALUES ('app-uuid', 'app', 0, 'charm-uuid')`,
+			`INSERT INTO machine (uuid, net_node_uuid, name, life_id) VALUES ('machine-uuid', 'node-uuid', '0', 0)`,
+			`INSERT INTO unit (uuid, name, life_id, application_uuid, net_node_uuid) VALUES ('unit0-uuid', 'app/0', 0, 'app-uuid', 'node-uuid')`,
+			`INSERT INTO unit (uuid, name, life_id, application_uuid, net_node_uuid) VALUES ('unit1-uuid', 'app/1', 0, 'app-uuid', 'node-uuid')`,
+			`INSERT INTO cloud_container (unit_uuid, provider_id) VALUES ('unit0-uuid', 'pod-0')`,
+			`INSERT INTO storage_volume (uuid, life_id, name, size_mib, provisioning_status_id) VALUES ('vol0-uuid', 0, 'vol0', 1024, 0)`,
+			`INSERT INTO storage_volume (uuid, life_id, name, size_mib, provisioning_status_id) VALUES ('vol1-uuid', 0, 'vol1', 512, 0)`,
+			// A volume without a known size is counted but adds no storage.
+			`INSERT INTO storage_volume (uuid, life_id, name, provisioning_status_id) VALUES ('vol2-uuid', 0, 'vol2', 0)`,
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	usage, err = state.GetResourceUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, model.ResourceUsageSummary{
+		MachineCount:       1,
+		UnitCount:          2,
+		StorageVolumeCount: 3,
+		TotalStorageGiB:    1.5,
+		CAASContainerCount: 1,
+	})
+}
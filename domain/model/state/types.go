@@ -424,3 +424,14 @@ type dbModelState struct {
 	CredentialInvalidReason string `db:"cloud_credential_invalid_reason"`
 	Migrating               bool   `db:"migrating"`
 }
+
+// dbCount is the result of a count query.
+type dbCount struct {
+	Count int64 `db:"count"`
+}
+
+// dbVolumeUsage is the number of storage volumes and their combined size.
+type dbVolumeUsage struct {
+	Count        int64 `db:"count"`
+	TotalSizeMiB int64 `db:"total_size_mib"`
+}
@@ -203,3 +203,22 @@ type ModelState struct {
 	// InvalidCloudCredentialReason is a string that describes the reason for the model's cloud credential being invalid.
 	InvalidCloudCredentialReason string
 }
+
+// ResourceUsageSummary holds the aggregated resource usage of a model, for use
+// by billing and capacity planning.
+type ResourceUsageSummary struct {
+	// MachineCount is the number of machines in the model.
+	MachineCount int64
+	// UnitCount is the number of units in the model.
+	UnitCount int64
+	// StorageVolumeCount is the number of storage volumes in the model.
+	StorageVolumeCount int64
+	// TotalStorageGiB is the combined size of all storage volumes in the
+	// model. Volumes without a known size are not included.
+	TotalStorageGiB float64
+	// CAASContainerCount is the number of cloud containers (k8s pods) backing
+	// units in the model.
+	CAASContainerCount int64
+	// LastComputedAt is the time at which the summary was computed.
+	LastComputedAt time.Time
+}
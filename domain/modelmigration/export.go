@@ -10,6 +10,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	corestorage "github.com/juju/juju/core/storage"
 	access "github.com/juju/juju/domain/access/modelmigration"
+	annotation "github.com/juju/juju/domain/annotation/modelmigration"
 	application "github.com/juju/juju/domain/application/modelmigration"
 	blockcommand "github.com/juju/juju/domain/blockcommand/modelmigration"
 	blockdevice "github.com/juju/juju/domain/blockdevice/modelmigration"
@@ -70,4 +71,5 @@ func (e *Exporter) ExportOperations(registry corestorage.ModelStorageRegistryGet
 	secret.RegisterExport(e.coordinator, e.logger.Child("secret"))
 	application.RegisterExport(e.coordinator, e.storageRegistryGetter, e.clock, e.logger.Child("application"))
 	cloudimagemetadata.RegisterExport(e.coordinator, e.logger.Child("cloudimagemetadata"), e.clock)
+	annotation.RegisterExport(e.coordinator, e.logger.Child("annotation"))
 }
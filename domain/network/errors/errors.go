@@ -17,10 +17,17 @@ const (
 	// SubnetNotFound is returned when a subnet is not found.
 	SubnetNotFound = errors.ConstError("subnet not found")
 
+	// SubnetCIDRNotValid is returned when a subnet CIDR does not satisfy the
+	// constraints of the model's provider.
+	SubnetCIDRNotValid = errors.ConstError("subnet CIDR is not valid")
+
 	// SpaceNameNotValid is returned when a space name is not valid.
 	SpaceNameNotValid = errors.ConstError("space name is not valid")
 
 	// AvailabilityZoneNotFound is returned when an availability zone is
 	// not found.
 	AvailabilityZoneNotFound = errors.ConstError("availability zone not found")
+
+	// MachineNotFound is returned when a machine is not found.
+	MachineNotFound = errors.ConstError("machine not found")
 )
@@ -0,0 +1,41 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/network"
+)
+
+// ListMachineAddressesBySpace returns the addresses of the machine with the
+// input UUID that are in subnets of the space with the input UUID. Addresses
+// are ordered by device name and then by value.
+// The following errors may be returned:
+// - [networkerrors.MachineNotFound] if the machine does not exist.
+// - [networkerrors.SpaceNotFound] if the space does not exist.
+func (s *Service) ListMachineAddressesBySpace(ctx context.Context, machineUUID, spaceUUID string) (network.SpaceAddresses, error) {
+	addrs, err := s.st.ListMachineAddressesBySpace(ctx, machineUUID, spaceUUID)
+	return addrs, errors.Trace(err)
+}
+
+// GetMachineSpaces returns the spaces reachable from the machine with the
+// input UUID. These are the spaces with a subnet containing at least one IP
+// address of the machine. Each space is returned once, with all of its
+// subnets, and spaces are ordered by name.
+// The following errors may be returned:
+// - [networkerrors.MachineNotFound] if the machine does not exist.
+func (s *Service) GetMachineSpaces(ctx context.Context, machineUUID string) (network.SpaceInfos, error) {
+	spaces, err := s.st.GetMachineSpaces(ctx, machineUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	sort.Slice(spaces, func(i, j int) bool {
+		return spaces[i].Name < spaces[j].Name
+	})
+	return spaces, nil
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gomock "go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+)
+
+type addressSuite struct {
+	testing.IsolationSuite
+
+	st *MockState
+}
+
+var _ = gc.Suite(&addressSuite{})
+
+func (s *addressSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.st = NewMockState(ctrl)
+
+	return ctrl
+}
+
+func (s *addressSuite) TestListMachineAddressesBySpace(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := network.SpaceAddresses{
+		network.NewSpaceAddress("10.0.0.5", network.WithCIDR("10.0.0.0/24")),
+	}
+	s.st.EXPECT().ListMachineAddressesBySpace(gomock.Any(), "machine-uuid", "space-uuid").Return(expected, nil)
+
+	addrs, err := NewService(s.st, nil).ListMachineAddressesBySpace(context.Background(), "machine-uuid", "space-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, jc.DeepEquals, expected)
+}
+
+func (s *addressSuite) TestListMachineAddressesBySpaceMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().ListMachineAddressesBySpace(gomock.Any(), "machine-uuid", "space-uuid").
+		Return(nil, networkerrors.MachineNotFound)
+
+	_, err := NewService(s.st, nil).ListMachineAddressesBySpace(context.Background(), "machine-uuid", "space-uuid")
+	c.Assert(err, jc.ErrorIs, networkerrors.MachineNotFound)
+}
+
+func (s *addressSuite) TestGetMachineSpaces(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetMachineSpaces(gomock.Any(), "machine-uuid").Return(network.SpaceInfos{
+		{ID: "space-b", Name: "beta"},
+		{ID: "space-a", Name: "alpha"},
+	}, nil)
+
+	spaces, err := NewService(s.st, nil).GetMachineSpaces(context.Background(), "machine-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(spaces, jc.DeepEquals, network.SpaceInfos{
+		{ID: "space-a", Name: "alpha"},
+		{ID: "space-b", Name: "beta"},
+	})
+}
+
+func (s *addressSuite) TestGetMachineSpacesMachineNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetMachineSpaces(gomock.Any(), "machine-uuid").
+		Return(nil, networkerrors.MachineNotFound)
+
+	_, err := NewService(s.st, nil).GetMachineSpaces(context.Background(), "machine-uuid")
+	c.Assert(err, jc.ErrorIs, networkerrors.MachineNotFound)
+}
@@ -32,6 +32,7 @@ type WatcherFactory interface {
 type State interface {
 	SpaceState
 	SubnetState
+	AddressState
 }
 
 // SpaceState describes persistence layer methods for the space (sub-) domain.
@@ -64,6 +65,9 @@ type SubnetState interface {
 	AddSubnet(ctx context.Context, subnet network.SubnetInfo) error
 	// GetAllSubnets returns all known subnets in the model.
 	GetAllSubnets(ctx context.Context) (network.SubnetInfos, error)
+	// GetModelCloudType returns the type of the cloud the model is running
+	// on.
+	GetModelCloudType(ctx context.Context) (string, error)
 	// GetSubnet returns the subnet by UUID.
 	GetSubnet(ctx context.Context, uuid string) (*network.SubnetInfo, error)
 	// GetSubnetsByCIDR returns the subnets by CIDR.
@@ -81,3 +85,20 @@ type SubnetState interface {
 	// subnet table, needed for the subnets watcher.
 	AllSubnetsQuery(ctx context.Context, db database.TxnRunner) ([]string, error)
 }
+
+// AddressState describes persistence layer methods for the IP addresses
+// assigned to machines.
+type AddressState interface {
+	// ListMachineAddressesBySpace returns the addresses of the machine with
+	// the input UUID that are in subnets of the space with the input UUID.
+	// If the machine is not found, an error is returned matching
+	// [github.com/juju/juju/domain/network/errors.MachineNotFound]. If the
+	// space is not found, an error is returned matching
+	// [github.com/juju/juju/domain/network/errors.SpaceNotFound].
+	ListMachineAddressesBySpace(ctx context.Context, machineUUID, spaceUUID string) (network.SpaceAddresses, error)
+	// GetMachineSpaces returns the spaces with a subnet containing at least
+	// one IP address of the machine with the input UUID.
+	// If the machine is not found, an error is returned matching
+	// [github.com/juju/juju/domain/network/errors.MachineNotFound].
+	GetMachineSpaces(ctx context.Context, machineUUID string) (network.SpaceInfos, error)
+}
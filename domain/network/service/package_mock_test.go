@@ -314,6 +314,84 @@ func (c *MockStateGetAllSubnetsCall) DoAndReturn(f func(context.Context) (networ
 	return c
 }
 
+// GetMachineSpaces mocks base method.
+func (m *MockState) GetMachineSpaces(arg0 context.Context, arg1 string) (network.SpaceInfos, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMachineSpaces", arg0, arg1)
+	ret0, _ := ret[0].(network.SpaceInfos)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMachineSpaces indicates an expected call of GetMachineSpaces.
+func (mr *MockStateMockRecorder) GetMachineSpaces(arg0, arg1 any) *MockStateGetMachineSpacesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMachineSpaces", reflect.TypeOf((*MockState)(nil).GetMachineSpaces), arg0, arg1)
+	return &MockStateGetMachineSpacesCall{Call: call}
+}
+
+// MockStateGetMachineSpacesCall wrap *gomock.Call
+type MockStateGetMachineSpacesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetMachineSpacesCall) Return(arg0 network.SpaceInfos, arg1 error) *MockStateGetMachineSpacesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetMachineSpacesCall) Do(f func(context.Context, string) (network.SpaceInfos, error)) *MockStateGetMachineSpacesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetMachineSpacesCall) DoAndReturn(f func(context.Context, string) (network.SpaceInfos, error)) *MockStateGetMachineSpacesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetModelCloudType mocks base method.
+func (m *MockState) GetModelCloudType(arg0 context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModelCloudType", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModelCloudType indicates an expected call of GetModelCloudType.
+func (mr *MockStateMockRecorder) GetModelCloudType(arg0 any) *MockStateGetModelCloudTypeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModelCloudType", reflect.TypeOf((*MockState)(nil).GetModelCloudType), arg0)
+	return &MockStateGetModelCloudTypeCall{Call: call}
+}
+
+// MockStateGetModelCloudTypeCall wrap *gomock.Call
+type MockStateGetModelCloudTypeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetModelCloudTypeCall) Return(arg0 string, arg1 error) *MockStateGetModelCloudTypeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetModelCloudTypeCall) Do(f func(context.Context) (string, error)) *MockStateGetModelCloudTypeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetModelCloudTypeCall) DoAndReturn(f func(context.Context) (string, error)) *MockStateGetModelCloudTypeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSpace mocks base method.
 func (m *MockState) GetSpace(arg0 context.Context, arg1 string) (*network.SpaceInfo, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +553,45 @@ func (c *MockStateGetSubnetsByCIDRCall) DoAndReturn(f func(context.Context, ...s
 	return c
 }
 
+// ListMachineAddressesBySpace mocks base method.
+func (m *MockState) ListMachineAddressesBySpace(arg0 context.Context, arg1, arg2 string) (network.SpaceAddresses, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMachineAddressesBySpace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(network.SpaceAddresses)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMachineAddressesBySpace indicates an expected call of ListMachineAddressesBySpace.
+func (mr *MockStateMockRecorder) ListMachineAddressesBySpace(arg0, arg1, arg2 any) *MockStateListMachineAddressesBySpaceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMachineAddressesBySpace", reflect.TypeOf((*MockState)(nil).ListMachineAddressesBySpace), arg0, arg1, arg2)
+	return &MockStateListMachineAddressesBySpaceCall{Call: call}
+}
+
+// MockStateListMachineAddressesBySpaceCall wrap *gomock.Call
+type MockStateListMachineAddressesBySpaceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateListMachineAddressesBySpaceCall) Return(arg0 network.SpaceAddresses, arg1 error) *MockStateListMachineAddressesBySpaceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateListMachineAddressesBySpaceCall) Do(f func(context.Context, string, string) (network.SpaceAddresses, error)) *MockStateListMachineAddressesBySpaceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateListMachineAddressesBySpaceCall) DoAndReturn(f func(context.Context, string, string) (network.SpaceAddresses, error)) *MockStateListMachineAddressesBySpaceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSpace mocks base method.
 func (m *MockState) UpdateSpace(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -192,7 +192,7 @@ func (s *ProviderService) saveProviderSubnets(
 		if err != nil {
 			return errors.Trace(err)
 		}
-		if ip.IsInterfaceLocalMulticast() || ip.IsLinkLocalMulticast() || ip.IsLinkLocalUnicast() {
+		if network.ClassifyAddress(ip.String(), nil) == network.ScopeLinkLocal {
 			continue
 		}
 
@@ -5,11 +5,14 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/juju/errors"
 
 	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
 )
 
 // AddSubnet creates and returns a new subnet.
@@ -29,6 +32,57 @@ func (s *Service) AddSubnet(ctx context.Context, args network.SubnetInfo) (netwo
 	return args.ID, nil
 }
 
+// ValidateAndAddSubnet creates and returns a new subnet requested by a user.
+// Unlike [Service.AddSubnet], which records subnets reported by the provider
+// or imported with a model, the subnet CIDR is first validated against the
+// constraints of the model's provider and the model's existing subnets,
+// returning an error satisfying [networkerrors.SubnetCIDRNotValid] if it does
+// not satisfy them.
+func (s *Service) ValidateAndAddSubnet(ctx context.Context, args network.SubnetInfo) (network.Id, error) {
+	if err := s.validateSubnetCIDR(ctx, args); err != nil {
+		return "", errors.Trace(err)
+	}
+	return s.AddSubnet(ctx, args)
+}
+
+// validateSubnetCIDR checks the CIDR of the input subnet against the
+// constraints of the model's provider and the existing subnets of the model.
+// Fan overlay subnets overlap their underlay by design, so are not checked.
+func (s *Service) validateSubnetCIDR(ctx context.Context, subnet network.SubnetInfo) error {
+	if network.IsInFanNetwork(subnet.ProviderId) {
+		return nil
+	}
+
+	cloudType, err := s.st.GetModelCloudType(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	allSubnets, err := s.st.GetAllSubnets(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	existing := make([]string, 0, len(allSubnets))
+	for _, other := range allSubnets {
+		if network.IsInFanNetwork(other.ProviderId) {
+			continue
+		}
+		existing = append(existing, other.CIDR)
+	}
+
+	validationErrs, err := network.ValidateSubnetCIDR(subnet.CIDR, cloudType, existing)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(validationErrs) == 0 {
+		return nil
+	}
+	messages := make([]string, len(validationErrs))
+	for i, validationErr := range validationErrs {
+		messages[i] = validationErr.Message
+	}
+	return fmt.Errorf("%s%w", strings.Join(messages, "; "), errors.Hide(networkerrors.SubnetCIDRNotValid))
+}
+
 // GetAllSubnets returns all the subnets for the model.
 func (s *Service) GetAllSubnets(ctx context.Context) (network.SubnetInfos, error) {
 	allSubnets, err := s.st.GetAllSubnets(ctx)
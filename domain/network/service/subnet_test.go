@@ -13,6 +13,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
 )
 
 type subnetSuite struct {
@@ -91,6 +92,62 @@ func (s *subnetSuite) TestAddSubnet(c *gc.C) {
 	c.Assert(returnedUUID, gc.Equals, expectedUUID)
 }
 
+func (s *subnetSuite) TestAddSubnetNotValidated(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	// Subnets reported by the provider are recorded as they are.
+	s.st.EXPECT().AddSubnet(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := NewService(s.st, nil).AddSubnet(context.Background(), network.SubnetInfo{
+		CIDR: "192.168.0.0/29",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *subnetSuite) TestValidateAndAddSubnet(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetModelCloudType(gomock.Any()).Return("ec2", nil)
+	s.st.EXPECT().GetAllSubnets(gomock.Any()).Return(network.SubnetInfos{{
+		CIDR: "192.168.16.0/20",
+	}}, nil)
+	s.st.EXPECT().AddSubnet(gomock.Any(), gomock.Any()).Return(nil)
+
+	id, err := NewService(s.st, nil).ValidateAndAddSubnet(context.Background(), network.SubnetInfo{
+		CIDR: "192.168.0.0/20",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(id, gc.Not(gc.Equals), network.Id(""))
+}
+
+func (s *subnetSuite) TestValidateAndAddSubnetCIDRNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetModelCloudType(gomock.Any()).Return("ec2", nil)
+	s.st.EXPECT().GetAllSubnets(gomock.Any()).Return(network.SubnetInfos{{
+		CIDR: "192.168.0.0/16",
+	}}, nil)
+
+	_, err := NewService(s.st, nil).ValidateAndAddSubnet(context.Background(), network.SubnetInfo{
+		CIDR: "192.168.0.0/29",
+	})
+	c.Assert(err, jc.ErrorIs, networkerrors.SubnetCIDRNotValid)
+	c.Assert(err, gc.ErrorMatches, `subnet "192.168.0.0/29" is smaller than the /28 minimum for ec2; `+
+		`subnet "192.168.0.0/29" overlaps existing subnet "192.168.0.0/16"`)
+}
+
+func (s *subnetSuite) TestValidateAndAddSubnetFanNotValidated(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().AddSubnet(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := NewService(s.st, nil).ValidateAndAddSubnet(context.Background(), network.SubnetInfo{
+		CIDR:       "252.0.0.0/12",
+		ProviderId: "subnet-0-INFAN-10-0-0-0-16",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *subnetSuite) TestRetrieveAllSubnets(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
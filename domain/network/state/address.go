@@ -0,0 +1,216 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+)
+
+// ListMachineAddressesBySpace returns the IP addresses of the machine with
+// the input UUID which are in subnets of the space with the input UUID.
+// An address is in the subnet recorded for it, or if none is recorded, in the
+// most specific subnet whose CIDR contains it. Addresses which are in no
+// subnet are not returned.
+// The following errors may be returned:
+//   - [networkerrors.MachineNotFound] if the machine does not exist.
+//   - [networkerrors.SpaceNotFound] if the space does not exist.
+func (st *State) ListMachineAddressesBySpace(
+	ctx context.Context,
+	machineUUID, spaceUUID string,
+) (network.SpaceAddresses, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	machine := entityUUID{UUID: machineUUID}
+	machineStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   machine
+WHERE  uuid = $entityUUID.uuid`, machine)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	space := entityUUID{UUID: spaceUUID}
+	spaceStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   space
+WHERE  uuid = $entityUUID.uuid`, space)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows spaceAddresses
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, machineStmt, machine).Get(&machine)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(networkerrors.MachineNotFound, "machine %q", machineUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "checking existence of machine %q", machineUUID)
+		}
+
+		err = tx.Query(ctx, spaceStmt, space).Get(&space)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(networkerrors.SpaceNotFound, "space %q", spaceUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "checking existence of space %q", spaceUUID)
+		}
+
+		addrs, err := st.getMachineSpaceAddresses(ctx, tx, machineUUID)
+		if err != nil {
+			return errors.Annotatef(err, "querying addresses of machine %q", machineUUID)
+		}
+		for _, addr := range addrs {
+			if addr.SpaceUUID == spaceUUID {
+				rows = append(rows, addr)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return rows.ToSpaceAddresses(), nil
+}
+
+// getMachineSpaceAddresses returns the IP addresses of the machine with the
+// input UUID, along with the CIDR of the subnet and the UUID of the space
+// each is in. An address is in the subnet recorded for it, or if none is
+// recorded, in the most specific subnet whose CIDR contains it. Addresses
+// which are in no subnet, or in a subnet with no space, are not returned.
+func (st *State) getMachineSpaceAddresses(ctx context.Context, tx *sqlair.TX, machineUUID string) (spaceAddresses, error) {
+	machine := entityUUID{UUID: machineUUID}
+	addressQuery := `
+SELECT ip.address_value AS &machineAddress.address_value,
+       ip.type_id AS &machineAddress.type_id,
+       ip.config_type_id AS &machineAddress.config_type_id,
+       ip.scope_id AS &machineAddress.scope_id,
+       COALESCE(nnip.is_secondary, false) AS &machineAddress.is_secondary,
+       ip.subnet_uuid AS &machineAddress.subnet_uuid
+FROM   machine AS m
+JOIN   link_layer_device AS lld ON lld.net_node_uuid = m.net_node_uuid
+JOIN   ip_address AS ip ON ip.device_uuid = lld.uuid
+LEFT JOIN net_node_ip_address AS nnip ON nnip.address_uuid = ip.uuid
+WHERE  m.uuid = $entityUUID.uuid
+ORDER BY lld.name, ip.address_value`
+	addressStmt, err := st.Prepare(addressQuery, machine, machineAddress{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "preparing %q", addressQuery)
+	}
+
+	subnetQuery := `
+SELECT &addressSubnet.*
+FROM   subnet`
+	subnetStmt, err := st.Prepare(subnetQuery, addressSubnet{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "preparing %q", subnetQuery)
+	}
+
+	var addrs []machineAddress
+	err = tx.Query(ctx, addressStmt, machine).GetAll(&addrs)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var subnets addressSubnets
+	err = tx.Query(ctx, subnetStmt).GetAll(&subnets)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Annotate(err, "querying subnets")
+	}
+
+	var rows spaceAddresses
+	for _, addr := range addrs {
+		subnet, ok := subnets.forAddress(addr)
+		if !ok || !subnet.SpaceUUID.Valid {
+			continue
+		}
+		rows = append(rows, spaceAddress{
+			Value:        addr.Value,
+			TypeID:       addr.TypeID,
+			ConfigTypeID: addr.ConfigTypeID,
+			ScopeID:      addr.ScopeID,
+			IsSecondary:  addr.IsSecondary,
+			CIDR:         subnet.CIDR,
+			SpaceUUID:    subnet.SpaceUUID.String,
+		})
+	}
+	return rows, nil
+}
+
+// GetMachineSpaces returns the spaces with a subnet containing at least one
+// IP address of the machine with the input UUID. Each space includes all of
+// its subnets, not just those in which the machine has addresses.
+// An address is in the subnet recorded for it, or if none is recorded, in the
+// most specific subnet whose CIDR contains it. Addresses which are in no
+// subnet are not considered.
+// The following errors may be returned:
+//   - [networkerrors.MachineNotFound] if the machine does not exist.
+func (st *State) GetMachineSpaces(ctx context.Context, machineUUID string) (network.SpaceInfos, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	machine := entityUUID{UUID: machineUUID}
+	machineStmt, err := st.Prepare(`
+SELECT &entityUUID.uuid
+FROM   machine
+WHERE  uuid = $entityUUID.uuid`, machine)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	spacesQuery := `
+SELECT &SpaceSubnetRow.*
+FROM   v_space_subnet
+WHERE  uuid IN ($spaceUUIDs[:])`
+	spacesStmt, err := st.Prepare(spacesQuery, spaceUUIDs{}, SpaceSubnetRow{})
+	if err != nil {
+		return nil, errors.Annotatef(err, "preparing %q", spacesQuery)
+	}
+
+	var rows SpaceSubnetRows
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, machineStmt, machine).Get(&machine)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(networkerrors.MachineNotFound, "machine %q", machineUUID)
+		} else if err != nil {
+			return errors.Annotatef(err, "checking existence of machine %q", machineUUID)
+		}
+
+		addrs, err := st.getMachineSpaceAddresses(ctx, tx, machineUUID)
+		if err != nil {
+			return errors.Annotatef(err, "querying addresses of machine %q", machineUUID)
+		}
+		uuids := set.NewStrings()
+		for _, addr := range addrs {
+			uuids.Add(addr.SpaceUUID)
+		}
+		if uuids.IsEmpty() {
+			return nil
+		}
+
+		err = tx.Query(ctx, spacesStmt, spaceUUIDs(uuids.SortedValues())).GetAll(&rows)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying spaces of machine %q", machineUUID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return rows.ToSpaceInfos(), nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/network"
+	networkerrors "github.com/juju/juju/domain/network/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+// addMachineAddresses adds a machine with two devices, and addresses in
+// subnets of two spaces, as well as an address with no subnet.
+func (s *stateSuite) addMachineAddresses(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, q := range []string{
+			"INSERT INTO net_node (uuid) VALUES ('node-0')",
+			"INSERT INTO machine (uuid, name, net_node_uuid, life_id) VALUES ('machine-0', '0', 'node-0', 0)",
+			"INSERT INTO net_node (uuid) VALUES ('node-1')",
+			"INSERT INTO machine (uuid, name, net_node_uuid, life_id) VALUES ('machine-1', '1', 'node-1', 0)",
+			"INSERT INTO space (uuid, name) VALUES ('space-a', 'space-a'), ('space-b', 'space-b')",
+			`INSERT INTO subnet (uuid, cidr, space_uuid) VALUES
+				('subnet-a', '10.0.0.0/24', 'space-a'),
+				('subnet-a6', 'fd00::/64', 'space-a'),
+				('subnet-b', '192.168.0.0/24', 'space-b')`,
+			`INSERT INTO link_layer_device (uuid, net_node_uuid, name, device_type_id, virtual_port_type_id) VALUES
+				('eth0', 'node-0', 'eth0', 2, 0),
+				('eth1', 'node-0', 'eth1', 2, 0),
+				('other-eth0', 'node-1', 'eth0', 2, 0)`,
+			`INSERT INTO ip_address (uuid, address_value, type_id, config_type_id, origin_id, scope_id, device_uuid, subnet_uuid) VALUES
+				('addr-0', '10.0.0.5', 0, 1, 0, 2, 'eth0', 'subnet-a'),
+				('addr-1', 'fd00::5', 1, 4, 1, 2, 'eth1', 'subnet-a6'),
+				('addr-2', '10.0.0.6', 0, 4, 0, 2, 'eth1', 'subnet-a'),
+				('addr-3', '192.168.0.5', 0, 1, 0, 2, 'eth0', 'subnet-b'),
+				('addr-4', '172.16.0.5', 0, 1, 0, 2, 'eth0', NULL),
+				('addr-5', '10.0.0.7', 0, 1, 0, 2, 'other-eth0', 'subnet-a')`,
+			"INSERT INTO net_node_ip_address (address_uuid, is_secondary) VALUES ('addr-2', true)",
+		} {
+			if _, err := tx.ExecContext(ctx, q); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestListMachineAddressesBySpace(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	addrs, err := st.ListMachineAddressesBySpace(context.Background(), "machine-0", "space-a")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, jc.DeepEquals, network.SpaceAddresses{{
+		MachineAddress: network.MachineAddress{
+			Value:      "10.0.0.5",
+			Type:       network.IPv4Address,
+			Scope:      network.ScopeCloudLocal,
+			CIDR:       "10.0.0.0/24",
+			ConfigType: network.ConfigDHCP,
+		},
+		SpaceID: "space-a",
+	}, {
+		MachineAddress: network.MachineAddress{
+			Value:       "10.0.0.6",
+			Type:        network.IPv4Address,
+			Scope:       network.ScopeCloudLocal,
+			CIDR:        "10.0.0.0/24",
+			ConfigType:  network.ConfigStatic,
+			IsSecondary: true,
+		},
+		SpaceID: "space-a",
+	}, {
+		MachineAddress: network.MachineAddress{
+			Value:      "fd00::5",
+			Type:       network.IPv6Address,
+			Scope:      network.ScopeCloudLocal,
+			CIDR:       "fd00::/64",
+			ConfigType: network.ConfigStatic,
+		},
+		SpaceID: "space-a",
+	}})
+
+	addrs, err = st.ListMachineAddressesBySpace(context.Background(), "machine-0", "space-b")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs.Values(), jc.DeepEquals, []string{"192.168.0.5"})
+}
+
+func (s *stateSuite) TestListMachineAddressesBySpaceNoRecordedSubnet(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	// Addresses with no recorded subnet are matched to the most specific
+	// subnet containing them.
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, q := range []string{
+			"INSERT INTO space (uuid, name) VALUES ('space-c', 'space-c')",
+			"INSERT INTO subnet (uuid, cidr, space_uuid) VALUES ('subnet-c', '192.168.0.128/25', 'space-c')",
+			`INSERT INTO ip_address (uuid, address_value, type_id, config_type_id, origin_id, scope_id, device_uuid, subnet_uuid) VALUES
+				('addr-6', '192.168.0.9', 0, 1, 0, 2, 'eth1', NULL),
+				('addr-7', '192.168.0.129', 0, 1, 0, 2, 'eth1', NULL)`,
+		} {
+			if _, err := tx.ExecContext(ctx, q); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	addrs, err := st.ListMachineAddressesBySpace(context.Background(), "machine-0", "space-b")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs.Values(), jc.DeepEquals, []string{"192.168.0.5", "192.168.0.9"})
+
+	addrs, err = st.ListMachineAddressesBySpace(context.Background(), "machine-0", "space-c")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, jc.DeepEquals, network.SpaceAddresses{{
+		MachineAddress: network.MachineAddress{
+			Value:      "192.168.0.129",
+			Type:       network.IPv4Address,
+			Scope:      network.ScopeCloudLocal,
+			CIDR:       "192.168.0.128/25",
+			ConfigType: network.ConfigDHCP,
+		},
+		SpaceID: "space-c",
+	}})
+}
+
+func (s *stateSuite) TestListMachineAddressesBySpaceNoAddresses(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	addrs, err := st.ListMachineAddressesBySpace(context.Background(), "machine-1", "space-b")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(addrs, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestListMachineAddressesBySpaceMachineNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	_, err := st.ListMachineAddressesBySpace(context.Background(), "machine-2", "space-a")
+	c.Assert(err, jc.ErrorIs, networkerrors.MachineNotFound)
+}
+
+func (s *stateSuite) TestListMachineAddressesBySpaceSpaceNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	_, err := st.ListMachineAddressesBySpace(context.Background(), "machine-0", "space-c")
+	c.Assert(err, jc.ErrorIs, networkerrors.SpaceNotFound)
+}
+
+func (s *stateSuite) TestGetMachineSpaces(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	spaces, err := st.GetMachineSpaces(context.Background(), "machine-0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spaces, gc.HasLen, 2)
+
+	spaceA := spaces.GetByID("space-a")
+	c.Assert(spaceA, gc.NotNil)
+	c.Check(spaceA.Name, gc.Equals, network.SpaceName("space-a"))
+	c.Check(spaceA.Subnets, gc.HasLen, 2)
+
+	spaceB := spaces.GetByID("space-b")
+	c.Assert(spaceB, gc.NotNil)
+	c.Check(spaceB.Name, gc.Equals, network.SpaceName("space-b"))
+	c.Check(spaceB.Subnets, gc.HasLen, 1)
+}
+
+func (s *stateSuite) TestGetMachineSpacesSingleSpace(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	spaces, err := st.GetMachineSpaces(context.Background(), "machine-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spaces, gc.HasLen, 1)
+	c.Check(spaces[0].ID, gc.Equals, "space-a")
+}
+
+func (s *stateSuite) TestGetMachineSpacesNoRecordedSubnet(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+INSERT INTO ip_address (uuid, address_value, type_id, config_type_id, origin_id, scope_id, device_uuid, subnet_uuid)
+VALUES ('addr-6', '192.168.0.9', 0, 1, 0, 2, 'other-eth0', NULL)`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	spaces, err := st.GetMachineSpaces(context.Background(), "machine-1")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(spaces, gc.HasLen, 2)
+	c.Check(spaces.GetByID("space-a"), gc.NotNil)
+	c.Check(spaces.GetByID("space-b"), gc.NotNil)
+}
+
+func (s *stateSuite) TestGetMachineSpacesNoAddresses(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, q := range []string{
+			"INSERT INTO net_node (uuid) VALUES ('node-2')",
+			"INSERT INTO machine (uuid, name, net_node_uuid, life_id) VALUES ('machine-2', '2', 'node-2', 0)",
+		} {
+			if _, err := tx.ExecContext(ctx, q); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	spaces, err := st.GetMachineSpaces(context.Background(), "machine-2")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(spaces, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetMachineSpacesMachineNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	s.addMachineAddresses(c)
+
+	_, err := st.GetMachineSpaces(context.Background(), "machine-2")
+	c.Assert(err, jc.ErrorIs, networkerrors.MachineNotFound)
+}
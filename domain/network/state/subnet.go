@@ -14,6 +14,7 @@ import (
 
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/network"
+	modelerrors "github.com/juju/juju/domain/model/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	internaldatabase "github.com/juju/juju/internal/database"
 )
@@ -42,6 +43,32 @@ FROM   subnet`, Subnet{})
 	return transform.Slice(subnets, func(s Subnet) string { return s.UUID }), nil
 }
 
+// GetModelCloudType returns the type of the cloud the model is running on.
+func (st *State) GetModelCloudType(ctx context.Context) (string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	var result modelCloudType
+	stmt, err := st.Prepare(`SELECT &modelCloudType.cloud_type FROM model`, result)
+	if err != nil {
+		return "", errors.Annotate(err, "preparing select model cloud type statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return modelerrors.NotFound
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return "", errors.Annotate(err, "querying model cloud type")
+	}
+	return result.CloudType, nil
+}
+
 // UpsertSubnets updates or adds each one of the provided subnets in one
 // transaction.
 func (st *State) UpsertSubnets(ctx context.Context, subnets []network.SubnetInfo) error {
@@ -181,7 +208,37 @@ VALUES ($ProviderNetworkSubnet.*)`, providerNetworkSubnet)
 		return errors.Annotatef(err, "inserting association between provider network id (%q) %q and subnet %q", pnUUIDStr, subnetInfo.ProviderNetworkId, subnetUUID)
 	}
 
-	return st.addAvailabilityZones(ctx, tx, subnetUUID, subnetInfo)
+	if err := st.addAvailabilityZones(ctx, tx, subnetUUID, subnetInfo); err != nil {
+		return errors.Trace(err)
+	}
+	return st.addProviderAttributes(ctx, tx, subnetUUID, subnetInfo)
+}
+
+// addProviderAttributes adds the provider-specific attributes of a subnet.
+func (st *State) addProviderAttributes(ctx context.Context, tx *sqlair.TX, subnetUUID string, subnet network.SubnetInfo) error {
+	if len(subnet.ProviderAttributes) == 0 {
+		return nil
+	}
+
+	attrs := make([]SubnetProviderAttribute, 0, len(subnet.ProviderAttributes))
+	for k, v := range subnet.ProviderAttributes {
+		attrs = append(attrs, SubnetProviderAttribute{
+			SubnetUUID: subnetUUID,
+			Key:        k,
+			Value:      v,
+		})
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO subnet_provider_attribute (*)
+VALUES ($SubnetProviderAttribute.*)`, SubnetProviderAttribute{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := tx.Query(ctx, insertStmt, attrs).Run(); err != nil {
+		st.logger.Errorf("inserting provider attributes for subnet %q, %v", subnetUUID, err)
+		return errors.Annotatef(err, "inserting provider attributes for subnet %q", subnetUUID)
+	}
+	return nil
 }
 
 // addAvailabilityZones adds the availability zones of a subnet if they don't exist, and
@@ -281,9 +338,26 @@ FROM   v_space_subnet
 		return nil, errors.Annotatef(err, "preparing %q", q)
 	}
 
-	var rows SubnetRows
+	attrStmt, err := st.Prepare(`
+SELECT &SubnetProviderAttribute.*
+FROM   subnet_provider_attribute`, SubnetProviderAttribute{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var (
+		rows  SubnetRows
+		attrs []SubnetProviderAttribute
+	)
 	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		return errors.Trace(tx.Query(ctx, s).GetAll(&rows))
+		if err := tx.Query(ctx, s).GetAll(&rows); err != nil {
+			return errors.Trace(err)
+		}
+		err := tx.Query(ctx, attrStmt).GetAll(&attrs)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotate(err, "querying subnet provider attributes")
+		}
+		return nil
 	}); errors.Is(err, sqlair.ErrNoRows) {
 		return nil, nil
 	} else if err != nil {
@@ -291,7 +365,7 @@ FROM   v_space_subnet
 		return nil, errors.Annotate(err, "querying subnets")
 	}
 
-	return rows.ToSubnetInfos(), nil
+	return withProviderAttributes(rows.ToSubnetInfos(), attrs), nil
 }
 
 // GetSubnet returns the subnet by UUID.
@@ -470,6 +544,18 @@ DELETE FROM availability_zone_subnet WHERE subnet_uuid = $Subnet.uuid;`, subnet)
 	if err != nil {
 		return errors.Annotate(err, "preparing delete availability zone subnet statement")
 	}
+	deleteProviderAttributesStmt, err := st.Prepare(`
+DELETE FROM subnet_provider_attribute WHERE subnet_uuid = $Subnet.uuid;`, subnet)
+	if err != nil {
+		return errors.Annotate(err, "preparing delete subnet provider attribute statement")
+	}
+	// Addresses outlive the subnets they are in, so they are only
+	// dissociated from the subnet.
+	unsetAddressSubnetStmt, err := st.Prepare(`
+UPDATE ip_address SET subnet_uuid = NULL WHERE subnet_uuid = $Subnet.uuid;`, subnet)
+	if err != nil {
+		return errors.Annotate(err, "preparing unset address subnet statement")
+	}
 
 	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
 		err := tx.Query(ctx, selectProviderNetworkStmt, subnet).Get(&providerNetworkSubnet)
@@ -506,6 +592,16 @@ DELETE FROM availability_zone_subnet WHERE subnet_uuid = $Subnet.uuid;`, subnet)
 			return errors.Trace(err)
 		}
 
+		if err := tx.Query(ctx, deleteProviderAttributesStmt, subnet).Run(); err != nil {
+			st.logger.Errorf("removing the provider attributes for subnet %q, %v", uuid, err)
+			return errors.Trace(err)
+		}
+
+		if err := tx.Query(ctx, unsetAddressSubnetStmt, subnet).Run(); err != nil {
+			st.logger.Errorf("removing the addresses from subnet %q, %v", uuid, err)
+			return errors.Trace(err)
+		}
+
 		err = tx.Query(ctx, deleteProviderSubnetStmt, subnet).Get(&outcome)
 		st.logger.Errorf("removing the provider subnet entry for subnet %q, %v", uuid, err)
 		if err != nil {
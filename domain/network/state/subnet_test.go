@@ -5,6 +5,7 @@ package state
 
 import (
 	ctx "context"
+	"database/sql"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -12,8 +13,11 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/network"
+	jujuversion "github.com/juju/juju/core/version"
+	modelerrors "github.com/juju/juju/domain/model/errors"
 	networkerrors "github.com/juju/juju/domain/network/errors"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
 )
 
 func (s *stateSuite) TestUpsertSubnets(c *gc.C) {
@@ -608,3 +612,117 @@ func (s *stateSuite) TestDeleteSubnet(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 	c.Check(subnets, gc.HasLen, 0)
 }
+
+func (s *stateSuite) TestDeleteSubnetWithAddresses(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	subnetUUID, err := uuid.NewV7()
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddSubnet(
+		ctx.Background(),
+		network.SubnetInfo{
+			ID:                network.Id(subnetUUID.String()),
+			CIDR:              "192.168.0.0/20",
+			ProviderId:        "provider-id-0",
+			ProviderNetworkId: "provider-network-id-0",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.TxnRunner().StdTxn(ctx.Background(), func(stdCtx ctx.Context, tx *sql.Tx) error {
+		for _, q := range []string{
+			"INSERT INTO net_node (uuid) VALUES ('node-0')",
+			`INSERT INTO link_layer_device (uuid, net_node_uuid, name, device_type_id, virtual_port_type_id)
+			 VALUES ('eth0', 'node-0', 'eth0', 2, 0)`,
+		} {
+			if _, err := tx.ExecContext(stdCtx, q); err != nil {
+				return err
+			}
+		}
+		_, err := tx.ExecContext(stdCtx, `
+INSERT INTO ip_address (uuid, address_value, type_id, config_type_id, origin_id, scope_id, device_uuid, subnet_uuid)
+VALUES ('addr-0', '192.168.0.5', 0, 1, 0, 2, 'eth0', ?)`, subnetUUID.String())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.DeleteSubnet(ctx.Background(), subnetUUID.String())
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = st.GetSubnet(ctx.Background(), subnetUUID.String())
+	c.Assert(err, jc.ErrorIs, networkerrors.SubnetNotFound)
+
+	// The address remains, but is no longer in the subnet.
+	var addrSubnetUUID sql.NullString
+	row := s.DB().QueryRow("SELECT subnet_uuid FROM ip_address WHERE uuid = 'addr-0'")
+	c.Assert(row.Scan(&addrSubnetUUID), jc.ErrorIsNil)
+	c.Check(addrSubnetUUID.Valid, jc.IsFalse)
+}
+
+func (s *stateSuite) TestGetAllSubnetsWithProviderAttributes(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	subnetUUID0, err := uuid.NewV7()
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddSubnet(
+		ctx.Background(),
+		network.SubnetInfo{
+			ID:                network.Id(subnetUUID0.String()),
+			CIDR:              "192.168.0.0/20",
+			ProviderId:        "provider-id-0",
+			ProviderNetworkId: "provider-network-id-0",
+			ProviderAttributes: map[string]string{
+				"service-endpoints": "Microsoft.Storage,Microsoft.Sql",
+			},
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	subnetUUID1, err := uuid.NewV7()
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.AddSubnet(
+		ctx.Background(),
+		network.SubnetInfo{
+			ID:                network.Id(subnetUUID1.String()),
+			CIDR:              "10.0.0.0/24",
+			ProviderId:        "provider-id-1",
+			ProviderNetworkId: "provider-network-id-1",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+
+	subnets, err := st.GetAllSubnets(ctx.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subnets, gc.HasLen, 2)
+	attrs := make(map[string]map[string]string)
+	for _, subnet := range subnets {
+		attrs[subnet.ID.String()] = subnet.ProviderAttributes
+	}
+	c.Check(attrs, jc.DeepEquals, map[string]map[string]string{
+		subnetUUID0.String(): {"service-endpoints": "Microsoft.Storage,Microsoft.Sql"},
+		subnetUUID1.String(): nil,
+	})
+
+	// Deleting the subnet removes its attributes.
+	err = st.DeleteSubnet(ctx.Background(), subnetUUID0.String())
+	c.Assert(err, jc.ErrorIsNil)
+	row := s.DB().QueryRow("SELECT COUNT(*) FROM subnet_provider_attribute")
+	var count int
+	c.Assert(row.Scan(&count), jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
+func (s *stateSuite) TestGetModelCloudType(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetModelCloudType(ctx.Background())
+	c.Assert(err, jc.ErrorIs, modelerrors.NotFound)
+
+	_, err = s.DB().ExecContext(ctx.Background(), `
+INSERT INTO model (uuid, controller_uuid, target_agent_version, name, type, cloud, cloud_type)
+VALUES (?, ?, ?, "test", "iaas", "fluffy", "ec2")
+`, s.ModelUUID(), coretesting.ControllerTag.Id(), jujuversion.Current.String())
+	c.Assert(err, jc.ErrorIsNil)
+
+	cloudType, err := st.GetModelCloudType(ctx.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cloudType, gc.Equals, "ec2")
+}
@@ -5,8 +5,10 @@ package state
 
 import (
 	"database/sql"
+	"net"
 
 	"github.com/juju/juju/core/network"
+	"github.com/juju/juju/domain/ipaddress"
 )
 
 // Subnet represents a single row from the subnet table.
@@ -79,6 +81,17 @@ type AvailabilityZoneSubnet struct {
 	SubnetUUID string `db:"subnet_uuid"`
 }
 
+// SubnetProviderAttribute represents a row from the
+// subnet_provider_attribute table.
+type SubnetProviderAttribute struct {
+	// SubnetUUID is the unique ID of the subnet.
+	SubnetUUID string `db:"subnet_uuid"`
+	// Key is the name of the attribute.
+	Key string `db:"key"`
+	// Value is the value of the attribute.
+	Value string `db:"value"`
+}
+
 // SubnetRow represents the subnet fields of a single row from the
 // v_space_subnets view.
 type SubnetRow struct {
@@ -252,3 +265,121 @@ func flattenAZs(
 
 	return subnets
 }
+
+// withProviderAttributes sets the provider-specific attributes of each
+// subnet from the input attribute rows.
+func withProviderAttributes(subnets network.SubnetInfos, attrs []SubnetProviderAttribute) network.SubnetInfos {
+	bySubnet := make(map[string]map[string]string)
+	for _, attr := range attrs {
+		if _, ok := bySubnet[attr.SubnetUUID]; !ok {
+			bySubnet[attr.SubnetUUID] = make(map[string]string)
+		}
+		bySubnet[attr.SubnetUUID][attr.Key] = attr.Value
+	}
+	for i, subnet := range subnets {
+		subnets[i].ProviderAttributes = bySubnet[subnet.ID.String()]
+	}
+	return subnets
+}
+
+// modelCloudType represents the cloud type of the model.
+type modelCloudType struct {
+	// CloudType is the type of the cloud the model is running on.
+	CloudType string `db:"cloud_type"`
+}
+
+// entityUUID represents the UUID of an entity, such as a machine or a space.
+type entityUUID struct {
+	UUID string `db:"uuid"`
+}
+
+// spaceUUIDs represents a list of space UUIDs.
+type spaceUUIDs []string
+
+// machineAddress represents an IP address of a machine, along with the
+// subnet recorded for it, if any.
+type machineAddress struct {
+	Value        string         `db:"address_value"`
+	TypeID       int            `db:"type_id"`
+	ConfigTypeID int            `db:"config_type_id"`
+	ScopeID      int            `db:"scope_id"`
+	IsSecondary  bool           `db:"is_secondary"`
+	SubnetUUID   sql.NullString `db:"subnet_uuid"`
+}
+
+// addressSubnet represents a subnet which may contain machine addresses.
+type addressSubnet struct {
+	UUID      string         `db:"uuid"`
+	CIDR      string         `db:"cidr"`
+	SpaceUUID sql.NullString `db:"space_uuid"`
+}
+
+// addressSubnets represents a list of subnets which may contain machine
+// addresses.
+type addressSubnets []addressSubnet
+
+// forAddress returns the subnet recorded for the input address, or if none
+// is recorded, the most specific subnet whose CIDR contains it.
+func (subnets addressSubnets) forAddress(addr machineAddress) (addressSubnet, bool) {
+	if addr.SubnetUUID.Valid {
+		for _, subnet := range subnets {
+			if subnet.UUID == addr.SubnetUUID.String {
+				return subnet, true
+			}
+		}
+		return addressSubnet{}, false
+	}
+
+	ip := net.ParseIP(addr.Value)
+	if ip == nil {
+		return addressSubnet{}, false
+	}
+	var (
+		result    addressSubnet
+		bestMatch = -1
+	)
+	for _, subnet := range subnets {
+		_, ipNet, err := net.ParseCIDR(subnet.CIDR)
+		if err != nil || !ipNet.Contains(ip) {
+			continue
+		}
+		if ones, _ := ipNet.Mask.Size(); ones > bestMatch {
+			result, bestMatch = subnet, ones
+		}
+	}
+	return result, bestMatch >= 0
+}
+
+// spaceAddress represents an IP address of a machine, along with the
+// subnet and space it is in.
+type spaceAddress struct {
+	Value        string `db:"address_value"`
+	TypeID       int    `db:"type_id"`
+	ConfigTypeID int    `db:"config_type_id"`
+	ScopeID      int    `db:"scope_id"`
+	IsSecondary  bool   `db:"is_secondary"`
+	CIDR         string `db:"cidr"`
+	SpaceUUID    string `db:"space_uuid"`
+}
+
+// spaceAddresses represents a list of machine addresses in a space.
+type spaceAddresses []spaceAddress
+
+// ToSpaceAddresses converts the rows to space addresses.
+func (rows spaceAddresses) ToSpaceAddresses() network.SpaceAddresses {
+	result := make(network.SpaceAddresses, len(rows))
+	for i, row := range rows {
+		result[i] = network.SpaceAddress{
+			MachineAddress: network.MachineAddress{
+				Value:       row.Value,
+				Type:        ipaddress.UnmarshallAddressType(ipaddress.AddressType(row.TypeID)),
+				Scope:       ipaddress.UnmarshallScope(ipaddress.Scope(row.ScopeID)),
+				CIDR:        row.CIDR,
+				ConfigType:  ipaddress.UnmarshallConfigType(ipaddress.ConfigType(row.ConfigTypeID)),
+				IsSecondary: row.IsSecondary,
+			},
+			SpaceID: row.SpaceUUID,
+		}
+	}
+	return result
+}
@@ -124,6 +124,45 @@ func (c *MockStateGetAllOpenedPortsCall) DoAndReturn(f func(context.Context) (po
 	return c
 }
 
+// GetApplicationOpenedPortRanges mocks base method.
+func (m *MockState) GetApplicationOpenedPortRanges(arg0 context.Context, arg1 string) (port.UnitGroupedPortRanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationOpenedPortRanges", arg0, arg1)
+	ret0, _ := ret[0].(port.UnitGroupedPortRanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationOpenedPortRanges indicates an expected call of GetApplicationOpenedPortRanges.
+func (mr *MockStateMockRecorder) GetApplicationOpenedPortRanges(arg0, arg1 any) *MockStateGetApplicationOpenedPortRangesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationOpenedPortRanges", reflect.TypeOf((*MockState)(nil).GetApplicationOpenedPortRanges), arg0, arg1)
+	return &MockStateGetApplicationOpenedPortRangesCall{Call: call}
+}
+
+// MockStateGetApplicationOpenedPortRangesCall wrap *gomock.Call
+type MockStateGetApplicationOpenedPortRangesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationOpenedPortRangesCall) Return(arg0 port.UnitGroupedPortRanges, arg1 error) *MockStateGetApplicationOpenedPortRangesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationOpenedPortRangesCall) Do(f func(context.Context, string) (port.UnitGroupedPortRanges, error)) *MockStateGetApplicationOpenedPortRangesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationOpenedPortRangesCall) DoAndReturn(f func(context.Context, string) (port.UnitGroupedPortRanges, error)) *MockStateGetApplicationOpenedPortRangesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationOpenedPorts mocks base method.
 func (m *MockState) GetApplicationOpenedPorts(arg0 context.Context, arg1 application.ID) (port.UnitEndpointPortRanges, error) {
 	m.ctrl.T.Helper()
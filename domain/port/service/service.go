@@ -62,6 +62,10 @@ type State interface {
 	// by endpoint.
 	GetApplicationOpenedPorts(ctx context.Context, applicationUUID coreapplication.ID) (port.UnitEndpointPortRanges, error)
 
+	// GetApplicationOpenedPortRanges returns the opened ports for all the
+	// units of the named application, grouped by unit name.
+	GetApplicationOpenedPortRanges(ctx context.Context, appName string) (port.UnitGroupedPortRanges, error)
+
 	// GetUnitUUID returns the UUID of the unit with the given name.
 	GetUnitUUID(ctx context.Context, unitName coreunit.Name) (coreunit.UUID, error)
 }
@@ -113,6 +117,21 @@ func (s *Service) GetApplicationOpenedPorts(ctx context.Context, applicationUUID
 	return openedPorts.ByUnitByEndpoint(), nil
 }
 
+// GetApplicationOpenedPortRanges returns the opened ports for all the units of
+// the named application, grouped by unit name, in a single query. Units with
+// no opened ports, and applications which do not exist, are absent from the
+// result.
+//
+// NOTE: We do not group by endpoint here. Consumers such as the firewaller
+// which reconcile a whole application at once only need the unit's ranges.
+func (s *Service) GetApplicationOpenedPortRanges(ctx context.Context, appName string) (port.UnitGroupedPortRanges, error) {
+	openedPorts, err := s.st.GetApplicationOpenedPortRanges(ctx, appName)
+	if err != nil {
+		return nil, errors.Errorf("failed to get opened ports for application %q: %w", appName, err)
+	}
+	return openedPorts, nil
+}
+
 // GetApplicationOpenedPortsByEndpoint returns all the opened ports for the given
 // application, across all units, grouped by endpoint.
 //
@@ -97,6 +97,30 @@ func (s *serviceSuite) TestGetAllOpenedPorts(c *gc.C) {
 	})
 }
 
+func (s *serviceSuite) TestGetApplicationOpenedPortRanges(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.st.EXPECT().GetApplicationOpenedPortRanges(gomock.Any(), "app").Return(port.UnitGroupedPortRanges{
+		"app/0": {
+			network.MustParsePortRange("80/tcp"),
+		},
+		"app/1": {
+			network.MustParsePortRange("8000-9000/udp"),
+		},
+	}, nil)
+
+	res, err := s.srv.GetApplicationOpenedPortRanges(context.Background(), "app")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(res, gc.DeepEquals, port.UnitGroupedPortRanges{
+		"app/0": {
+			network.MustParsePortRange("80/tcp"),
+		},
+		"app/1": {
+			network.MustParsePortRange("8000-9000/udp"),
+		},
+	})
+}
+
 func (s *serviceSuite) TestGetMachineOpenedPorts(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -209,6 +209,56 @@ WHERE application_uuid = $applicationUUID.application_uuid
 	return ret, nil
 }
 
+// GetApplicationOpenedPortRanges returns the opened ports for all the units of
+// the named application, grouped by unit name.
+//
+// NOTE: We do not group by endpoint here. It is not needed. Instead, we just
+// group by unit name
+func (st *State) GetApplicationOpenedPortRanges(ctx context.Context, appName string) (port.UnitGroupedPortRanges, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	name := applicationName{Name: appName}
+
+	query, err := st.Prepare(`
+SELECT DISTINCT &unitNamePortRange.*
+FROM v_port_range
+JOIN unit ON unit_uuid = unit.uuid
+WHERE unit.application_uuid = (
+    SELECT uuid FROM application WHERE name = $applicationName.name
+)
+`, unitNamePortRange{}, name)
+	if err != nil {
+		return nil, errors.Errorf("preparing get application opened port ranges statement: %w", err)
+	}
+
+	results := []unitNamePortRange{}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, query, name).GetAll(&results)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Errorf("getting opened ports for application %q: %w", appName, err)
+	}
+
+	groupedPortRanges := port.UnitGroupedPortRanges{}
+	for _, portRange := range results {
+		unitName := portRange.UnitName
+		groupedPortRanges[unitName] = append(groupedPortRanges[unitName], portRange.decode())
+	}
+
+	for _, portRanges := range groupedPortRanges {
+		network.SortPortRanges(portRanges)
+	}
+
+	return groupedPortRanges, nil
+}
+
 // GetColocatedOpenedPorts returns all the open ports for all units co-located with
 // the given unit. Units are considered co-located if they share the same net-node.
 func (st *State) GetColocatedOpenedPorts(ctx domain.AtomicContext, unit coreunit.UUID) ([]network.PortRange, error) {
@@ -410,6 +410,60 @@ func (s *stateSuite) TestGetApplicationOpenedPortsAcrossTwoUnits(c *gc.C) {
 	c.Check(unitEndpointPortRanges, jc.DeepEquals, expect)
 }
 
+func (s *stateSuite) TestGetApplicationOpenedPortRangesBlankDB(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+
+	groupedPortRanges, err := st.GetApplicationOpenedPortRanges(ctx, "non-existent")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(groupedPortRanges, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetApplicationOpenedPortRanges(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory())
+	ctx := context.Background()
+	s.initialiseOpenPort(c, st)
+
+	unit1UUID, unit1Name := s.createUnit(c, netNodeUUIDs[1], appNames[0])
+	_ = s.createApplicationWithRelations(c, appNames[1], "ep0", "ep1", "ep2")
+	unit2UUID, _ := s.createUnit(c, netNodeUUIDs[1], appNames[1])
+	err := st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.UpdateUnitPorts(ctx, unit1UUID, network.GroupedPortRanges{
+			"ep0": {
+				{Protocol: "udp", FromPort: 2000, ToPort: 2500},
+			},
+			"ep1": {
+				{Protocol: "tcp", FromPort: 443, ToPort: 443},
+				{Protocol: "udp", FromPort: 2000, ToPort: 2500},
+			},
+		}, network.GroupedPortRanges{}); err != nil {
+			return err
+		}
+		return st.UpdateUnitPorts(ctx, unit2UUID, network.GroupedPortRanges{
+			"ep0": {
+				{Protocol: "tcp", FromPort: 22, ToPort: 22},
+			},
+		}, network.GroupedPortRanges{})
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Ranges opened on several endpoints are only reported once, and units
+	// of other applications are not reported.
+	groupedPortRanges, err := st.GetApplicationOpenedPortRanges(ctx, appNames[0])
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(groupedPortRanges, jc.DeepEquals, port.UnitGroupedPortRanges{
+		s.unitName: {
+			{Protocol: "tcp", FromPort: 80, ToPort: 80},
+			{Protocol: "tcp", FromPort: 8080, ToPort: 8080},
+			{Protocol: "udp", FromPort: 1000, ToPort: 1500},
+		},
+		unit1Name: {
+			{Protocol: "tcp", FromPort: 443, ToPort: 443},
+			{Protocol: "udp", FromPort: 2000, ToPort: 2500},
+		},
+	})
+}
+
 func (s *stateSuite) TestGetApplicationOpenedPortsAcrossTwoUnitsDifferentApplications(c *gc.C) {
 	st := NewState(s.TxnRunnerFactory())
 	ctx := context.Background()
@@ -161,6 +161,11 @@ type applicationUUID struct {
 	UUID application.ID `db:"application_uuid"`
 }
 
+// applicationName represents an application's name.
+type applicationName struct {
+	Name string `db:"name"`
+}
+
 // unitName represents a unit's name.
 type unitName struct {
 	UUID unit.UUID `db:"uuid"`
@@ -0,0 +1,7 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package relation provides the domain logic for relations between
+// applications in a model, including their status and the history of
+// their status transitions.
+package relation
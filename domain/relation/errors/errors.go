@@ -0,0 +1,42 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package errors
+
+import (
+	"github.com/juju/juju/internal/errors"
+)
+
+const (
+	// RelationNotFound describes an error that occurs when the relation being
+	// operated on does not exist.
+	RelationNotFound = errors.ConstError("relation not found")
+
+	// RelationAlreadyExists describes an error that occurs when a relation
+	// with the same relation ID already exists.
+	RelationAlreadyExists = errors.ConstError("relation already exists")
+
+	// RelationStatusNotValid describes an error that occurs when a relation
+	// status is not one of the known relation statuses.
+	RelationStatusNotValid = errors.ConstError("relation status not valid")
+
+	// RelationAlreadySuspended describes an error that occurs when suspending
+	// a relation which is already suspended, or is being suspended.
+	RelationAlreadySuspended = errors.ConstError("relation already suspended")
+
+	// RelationNotSuspended describes an error that occurs when resuming a
+	// relation which is not suspended.
+	RelationNotSuspended = errors.ConstError("relation not suspended")
+
+	// RelationEndpointNotFound describes an error that occurs when an
+	// endpoint of a relation is not defined by the charm of its application.
+	RelationEndpointNotFound = errors.ConstError("relation endpoint not found")
+
+	// RelationNotDead describes an error that occurs when removing a relation
+	// which is not yet dead.
+	RelationNotDead = errors.ConstError("relation not dead")
+
+	// RelationHasUnits describes an error that occurs when removing a
+	// relation which still has units in it.
+	RelationHasUnits = errors.ConstError("relation has units")
+)
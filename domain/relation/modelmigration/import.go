@@ -0,0 +1,107 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/collections/set"
+	"github.com/juju/description/v8"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/modelmigration"
+	"github.com/juju/juju/domain/relation"
+	"github.com/juju/juju/domain/relation/service"
+	"github.com/juju/juju/domain/relation/state"
+	internalcharm "github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/errors"
+)
+
+// Coordinator is the interface that is used to add operations to a migration.
+type Coordinator interface {
+	// Add adds the given operation to the migration.
+	Add(modelmigration.Operation)
+}
+
+// RegisterImport registers the import operations with the given coordinator.
+func RegisterImport(coordinator Coordinator, clock clock.Clock, logger logger.Logger) {
+	coordinator.Add(&importOperation{
+		clock:  clock,
+		logger: logger,
+	})
+}
+
+// ImportService provides a subset of the relation domain
+// service methods needed for relation import.
+type ImportService interface {
+	// ImportRelation adds a relation between the input endpoints with the
+	// relation ID it had in the source model, returning its UUID.
+	ImportRelation(ctx context.Context, id int, endpoints []relation.EndpointIdentifier) (string, error)
+}
+
+type importOperation struct {
+	modelmigration.BaseOperation
+
+	service ImportService
+	clock   clock.Clock
+	logger  logger.Logger
+}
+
+// Name returns the name of this operation.
+func (i *importOperation) Name() string {
+	return "import relations"
+}
+
+// Setup implements Operation.
+func (i *importOperation) Setup(scope modelmigration.Scope) error {
+	i.service = service.NewService(
+		state.NewState(scope.ModelDB(), i.logger), i.clock, i.logger)
+	return nil
+}
+
+// Execute the import of the relations of the model, keeping the relation IDs
+// they had in the source model. The applications and charms the relation
+// endpoints belong to must have been imported already. Cross model relations,
+// and relations on the implicit juju-info endpoint which is not held as a
+// charm relation, are not yet held in the relation domain, so are skipped.
+func (i *importOperation) Execute(ctx context.Context, model description.Model) error {
+	remoteApps := set.NewStrings()
+	for _, app := range model.RemoteApplications() {
+		remoteApps.Add(app.Name())
+	}
+
+relations:
+	for _, rel := range model.Relations() {
+		var endpoints []relation.EndpointIdentifier
+		for _, ep := range rel.Endpoints() {
+			if remoteApps.Contains(ep.ApplicationName()) {
+				i.logger.Debugf("skipping cross model relation %q", rel.Key())
+				continue relations
+			}
+			if isImplicitEndpoint(ep) {
+				i.logger.Debugf("skipping relation %q on implicit endpoint", rel.Key())
+				continue relations
+			}
+			endpoints = append(endpoints, relation.EndpointIdentifier{
+				ApplicationName: ep.ApplicationName(),
+				EndpointName:    ep.Name(),
+			})
+		}
+		if _, err := i.service.ImportRelation(ctx, rel.Id(), endpoints); err != nil {
+			return errors.Errorf("importing relation %q: %w", rel.Key(), err)
+		}
+	}
+	return nil
+}
+
+// isImplicitEndpoint returns whether the endpoint is the juju-info endpoint
+// provided by juju itself, rather than by the application's charm.
+func isImplicitEndpoint(ep description.Endpoint) bool {
+	return internalcharm.Relation{
+		Name:      ep.Name(),
+		Interface: ep.Interface(),
+		Role:      internalcharm.RelationRole(ep.Role()),
+	}.IsImplicit()
+}
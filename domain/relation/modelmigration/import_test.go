@@ -0,0 +1,167 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package modelmigration
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	"github.com/juju/names/v5"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type importSuite struct {
+	coordinator *MockCoordinator
+	service     *MockImportService
+}
+
+var _ = gc.Suite(&importSuite{})
+
+func (s *importSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.coordinator = NewMockCoordinator(ctrl)
+	s.service = NewMockImportService(ctrl)
+
+	return ctrl
+}
+
+func (s *importSuite) newImportOperation(c *gc.C) *importOperation {
+	return &importOperation{
+		service: s.service,
+		logger:  loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *importSuite) TestRegisterImport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.coordinator.EXPECT().Add(gomock.Any())
+
+	RegisterImport(s.coordinator, nil, loggertesting.WrapCheckLog(c))
+}
+
+func (s *importSuite) TestImport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{})
+	rel := model.AddRelation(description.RelationArgs{
+		Id:  3,
+		Key: "wordpress:db mysql:server",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "wordpress",
+		Name:            "db",
+		Role:            "requirer",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "mysql",
+		Name:            "server",
+		Role:            "provider",
+	})
+	peer := model.AddRelation(description.RelationArgs{
+		Id:  7,
+		Key: "mysql:cluster",
+	})
+	peer.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "mysql",
+		Name:            "cluster",
+		Role:            "peer",
+	})
+
+	s.service.EXPECT().ImportRelation(gomock.Any(), 3, []relation.EndpointIdentifier{
+		{ApplicationName: "wordpress", EndpointName: "db"},
+		{ApplicationName: "mysql", EndpointName: "server"},
+	}).Return("relation-uuid-3", nil)
+	s.service.EXPECT().ImportRelation(gomock.Any(), 7, []relation.EndpointIdentifier{
+		{ApplicationName: "mysql", EndpointName: "cluster"},
+	}).Return("relation-uuid-7", nil)
+
+	op := s.newImportOperation(c)
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *importSuite) TestImportSkipsCrossModelRelations(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{})
+	model.AddRemoteApplication(description.RemoteApplicationArgs{
+		Tag: names.NewApplicationTag("remote-mysql"),
+	})
+	rel := model.AddRelation(description.RelationArgs{
+		Id:  3,
+		Key: "wordpress:db remote-mysql:server",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "wordpress",
+		Name:            "db",
+		Role:            "requirer",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "remote-mysql",
+		Name:            "server",
+		Role:            "provider",
+	})
+
+	op := s.newImportOperation(c)
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *importSuite) TestImportSkipsImplicitEndpointRelations(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{})
+	rel := model.AddRelation(description.RelationArgs{
+		Id:  3,
+		Key: "logging:info mysql:juju-info",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "logging",
+		Name:            "info",
+		Role:            "requirer",
+		Interface:       "juju-info",
+		Scope:           "container",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "mysql",
+		Name:            "juju-info",
+		Role:            "provider",
+		Interface:       "juju-info",
+		Scope:           "global",
+	})
+
+	op := s.newImportOperation(c)
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *importSuite) TestImportError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	model := description.NewModel(description.ModelArgs{})
+	rel := model.AddRelation(description.RelationArgs{
+		Id:  7,
+		Key: "mysql:cluster",
+	})
+	rel.AddEndpoint(description.EndpointArgs{
+		ApplicationName: "mysql",
+		Name:            "cluster",
+		Role:            "peer",
+	})
+
+	s.service.EXPECT().ImportRelation(gomock.Any(), 7, gomock.Any()).Return("", relationerrors.RelationAlreadyExists)
+
+	op := s.newImportOperation(c)
+	err := op.Execute(context.Background(), model)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationAlreadyExists)
+	c.Check(err, gc.ErrorMatches, `importing relation "mysql:cluster": relation already exists`)
+}
@@ -0,0 +1,140 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/relation/modelmigration (interfaces: Coordinator,ImportService)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package modelmigration -destination migrations_mock_test.go github.com/juju/juju/domain/relation/modelmigration Coordinator,ImportService
+//
+
+// Package modelmigration is a generated GoMock package.
+package modelmigration
+
+import (
+	context "context"
+	reflect "reflect"
+
+	modelmigration "github.com/juju/juju/core/modelmigration"
+	relation "github.com/juju/juju/domain/relation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCoordinator is a mock of Coordinator interface.
+type MockCoordinator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCoordinatorMockRecorder
+}
+
+// MockCoordinatorMockRecorder is the mock recorder for MockCoordinator.
+type MockCoordinatorMockRecorder struct {
+	mock *MockCoordinator
+}
+
+// NewMockCoordinator creates a new mock instance.
+func NewMockCoordinator(ctrl *gomock.Controller) *MockCoordinator {
+	mock := &MockCoordinator{ctrl: ctrl}
+	mock.recorder = &MockCoordinatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCoordinator) EXPECT() *MockCoordinatorMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCoordinator) Add(arg0 modelmigration.Operation) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", arg0)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCoordinatorMockRecorder) Add(arg0 any) *MockCoordinatorAddCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCoordinator)(nil).Add), arg0)
+	return &MockCoordinatorAddCall{Call: call}
+}
+
+// MockCoordinatorAddCall wrap *gomock.Call
+type MockCoordinatorAddCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCoordinatorAddCall) Return() *MockCoordinatorAddCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCoordinatorAddCall) Do(f func(modelmigration.Operation)) *MockCoordinatorAddCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCoordinatorAddCall) DoAndReturn(f func(modelmigration.Operation)) *MockCoordinatorAddCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockImportService is a mock of ImportService interface.
+type MockImportService struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportServiceMockRecorder
+}
+
+// MockImportServiceMockRecorder is the mock recorder for MockImportService.
+type MockImportServiceMockRecorder struct {
+	mock *MockImportService
+}
+
+// NewMockImportService creates a new mock instance.
+func NewMockImportService(ctrl *gomock.Controller) *MockImportService {
+	mock := &MockImportService{ctrl: ctrl}
+	mock.recorder = &MockImportServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImportService) EXPECT() *MockImportServiceMockRecorder {
+	return m.recorder
+}
+
+// ImportRelation mocks base method.
+func (m *MockImportService) ImportRelation(arg0 context.Context, arg1 int, arg2 []relation.EndpointIdentifier) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRelation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRelation indicates an expected call of ImportRelation.
+func (mr *MockImportServiceMockRecorder) ImportRelation(arg0, arg1, arg2 any) *MockImportServiceImportRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRelation", reflect.TypeOf((*MockImportService)(nil).ImportRelation), arg0, arg1, arg2)
+	return &MockImportServiceImportRelationCall{Call: call}
+}
+
+// MockImportServiceImportRelationCall wrap *gomock.Call
+type MockImportServiceImportRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockImportServiceImportRelationCall) Return(arg0 string, arg1 error) *MockImportServiceImportRelationCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockImportServiceImportRelationCall) Do(f func(context.Context, int, []relation.EndpointIdentifier) (string, error)) *MockImportServiceImportRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockImportServiceImportRelationCall) DoAndReturn(f func(context.Context, int, []relation.EndpointIdentifier) (string, error)) *MockImportServiceImportRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
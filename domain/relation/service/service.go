@@ -0,0 +1,315 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock"
+
+	corelogger "github.com/juju/juju/core/logger"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// DefaultStatusHistoryLimit is the number of status history entries returned
+// when no limit is supplied.
+const DefaultStatusHistoryLimit = 20
+
+// State describes retrieval and persistence methods for relations.
+type State interface {
+	// SetRelationStatus sets the status of the relation and records the status
+	// transition in the relation status history.
+	SetRelationStatus(ctx context.Context, relationUUID string, info relation.StatusInfo) error
+
+	// GetRelationStatus returns the current status of the relation, along
+	// with who suspended it and when if it is suspended.
+	GetRelationStatus(ctx context.Context, relationUUID string) (relation.RelationStatusInfo, error)
+
+	// GetRelationStatusHistory returns the most recent status transitions of
+	// the relation, newest first, up to the given limit.
+	GetRelationStatusHistory(ctx context.Context, relationUUID string, limit int) ([]relation.RelationStatusHistoryEntry, error)
+
+	// PruneRelationStatusHistory removes all relation status history entries
+	// that were recorded before the given time.
+	PruneRelationStatusHistory(ctx context.Context, before time.Time) (int64, error)
+
+	// CheckRelationConsistency returns the inconsistencies found in the
+	// relation records of the model, removing the orphaned records if
+	// repair is true.
+	CheckRelationConsistency(ctx context.Context, repair bool) ([]relation.Inconsistency, error)
+
+	// ImportRelation inserts a relation with the given relation ID and
+	// endpoints, advancing the relation sequence past the ID.
+	ImportRelation(ctx context.Context, relationUUID string, id int, endpoints []relation.EndpointIdentifier) error
+
+	// GetRelationEndpointBindings returns the space UUID each endpoint of the
+	// relation is bound to, keyed by application and endpoint name.
+	GetRelationEndpointBindings(ctx context.Context, relationUUID string) (map[relation.EndpointIdentifier]string, error)
+
+	// GetRelationLifeSuspendedStatus returns the life and suspended status of
+	// the relation, along with its key.
+	GetRelationLifeSuspendedStatus(ctx context.Context, relationUUID string) (relation.LifeSuspendedStatus, error)
+
+	// DeleteRelation removes a dead relation without units, along with its
+	// endpoints, settings, status and status history.
+	DeleteRelation(ctx context.Context, relationUUID string) error
+}
+
+// Service provides the API for working with relations.
+type Service struct {
+	st     State
+	clock  clock.Clock
+	logger corelogger.Logger
+
+	// securityLogger records operator actions, such as suspending a
+	// relation, which change what the model exposes.
+	securityLogger corelogger.Logger
+}
+
+// NewService returns a new service reference wrapping the input state.
+func NewService(st State, clock clock.Clock, logger corelogger.Logger) *Service {
+	return &Service{
+		st:             st,
+		clock:          clock,
+		logger:         logger,
+		securityLogger: logger.Child("security", corelogger.SECURITY),
+	}
+}
+
+// SetRelationStatus sets the status of the relation, recording the transition
+// in the relation status history. If the status time is not set, the current
+// time is used.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationStatusNotValid] if the status is not known.
+func (s *Service) SetRelationStatus(ctx context.Context, relationUUID string, info relation.StatusInfo) error {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+	if info.Since.IsZero() {
+		info.Since = s.clock.Now()
+	}
+
+	if err := s.st.SetRelationStatus(ctx, relationUUID, info); err != nil {
+		return errors.Errorf("setting status for relation %q: %w", relationUUID, err)
+	}
+	return nil
+}
+
+// SuspendRelation suspends the relation, recording the reason given and the
+// actor suspending it. The suspension is recorded in the relation status
+// history and the security log.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationAlreadySuspended] if the relation is already
+// suspended, or is being suspended.
+func (s *Service) SuspendRelation(ctx context.Context, relationUUID, reason, actor string) error {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+	if actor == "" {
+		return errors.Errorf("suspending relation %q: actor not specified", relationUUID)
+	}
+
+	current, err := s.st.GetRelationLifeSuspendedStatus(ctx, relationUUID)
+	if err != nil {
+		return errors.Errorf("getting status of relation %q: %w", relationUUID, err)
+	}
+	if current.Suspended {
+		return errors.Errorf("suspending relation %q: %w", current.Key, relationerrors.RelationAlreadySuspended)
+	}
+
+	if err := s.st.SetRelationStatus(ctx, relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: reason,
+		Actor:   actor,
+		Since:   s.clock.Now(),
+	}); err != nil {
+		return errors.Errorf("suspending relation %q: %w", current.Key, err)
+	}
+	s.securityLogger.Infof("relation %q (%s) suspended by %q: %s", current.Key, relationUUID, actor, reason)
+	return nil
+}
+
+// ResumeRelation resumes a suspended relation, recording the reason given and
+// the actor resuming it. The resumption is recorded in the relation status
+// history and the security log.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationNotSuspended] if the relation is not suspended.
+func (s *Service) ResumeRelation(ctx context.Context, relationUUID, reason, actor string) error {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+	if actor == "" {
+		return errors.Errorf("resuming relation %q: actor not specified", relationUUID)
+	}
+
+	current, err := s.st.GetRelationLifeSuspendedStatus(ctx, relationUUID)
+	if err != nil {
+		return errors.Errorf("getting status of relation %q: %w", relationUUID, err)
+	}
+	if !current.Suspended {
+		return errors.Errorf("resuming relation %q: %w", current.Key, relationerrors.RelationNotSuspended)
+	}
+
+	if err := s.st.SetRelationStatus(ctx, relationUUID, relation.StatusInfo{
+		Status:  corerelation.Joined,
+		Message: reason,
+		Actor:   actor,
+		Since:   s.clock.Now(),
+	}); err != nil {
+		return errors.Errorf("resuming relation %q: %w", current.Key, err)
+	}
+	s.securityLogger.Infof("relation %q (%s) resumed by %q: %s", current.Key, relationUUID, actor, reason)
+	return nil
+}
+
+// GetRelationStatus returns the current status of the relation. If the
+// relation is suspended, the result records who suspended it and when.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationStatusNotValid] if the relation has no status.
+func (s *Service) GetRelationStatus(ctx context.Context, relationUUID string) (relation.RelationStatusInfo, error) {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return relation.RelationStatusInfo{}, errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+
+	status, err := s.st.GetRelationStatus(ctx, relationUUID)
+	if err != nil {
+		return relation.RelationStatusInfo{}, errors.Errorf("getting status for relation %q: %w", relationUUID, err)
+	}
+	return status, nil
+}
+
+// GetRelationStatusHistory returns the most recent status transitions of the
+// relation, newest first. At most limit entries are returned, if limit is not
+// positive then DefaultStatusHistoryLimit is used.
+// If the relation doesn't exist, an error satisfying
+// [relationerrors.RelationNotFound] is returned.
+func (s *Service) GetRelationStatusHistory(ctx context.Context, relationUUID string, limit int) ([]relation.RelationStatusHistoryEntry, error) {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return nil, errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+	if limit <= 0 {
+		limit = DefaultStatusHistoryLimit
+	}
+
+	history, err := s.st.GetRelationStatusHistory(ctx, relationUUID, limit)
+	if err != nil {
+		return nil, errors.Errorf("getting status history for relation %q: %w", relationUUID, err)
+	}
+	return history, nil
+}
+
+// PruneRelationStatusHistory removes all relation status history entries
+// older than the given maximum age. This is expected to be called with the
+// max-action-results-age model config value.
+func (s *Service) PruneRelationStatusHistory(ctx context.Context, maxAge time.Duration) error {
+	if maxAge <= 0 {
+		return nil
+	}
+
+	pruned, err := s.st.PruneRelationStatusHistory(ctx, s.clock.Now().Add(-maxAge))
+	if err != nil {
+		return errors.Errorf("pruning relation status history: %w", err)
+	}
+	s.logger.Debugf("pruned %d relation status history entries", pruned)
+	return nil
+}
+
+// CheckRelationConsistency scans the relations of the model for inconsistent
+// records, such as relations without endpoints, relation units of dead units
+// or dead relations, and application endpoints which no longer belong to the
+// application's charm. If repair is true, records which are clearly orphaned
+// are removed; the others are only reported. Each inconsistency found is
+// returned, indicating whether it was repaired.
+func (s *Service) CheckRelationConsistency(ctx context.Context, repair bool) ([]relation.Inconsistency, error) {
+	inconsistencies, err := s.st.CheckRelationConsistency(ctx, repair)
+	if err != nil {
+		return nil, errors.Errorf("checking relation consistency: %w", err)
+	}
+
+	var repaired int
+	for _, inconsistency := range inconsistencies {
+		if inconsistency.Repaired {
+			s.logger.Infof("removed inconsistent relation record %q: %s", inconsistency.UUID, inconsistency.Message)
+			repaired++
+		}
+	}
+	if len(inconsistencies) > 0 {
+		s.logger.Debugf("found %d relation inconsistencies, repaired %d", len(inconsistencies), repaired)
+	}
+	return inconsistencies, nil
+}
+
+// ImportRelation adds a relation between the input endpoints with the
+// relation ID it had in the source model of a migration, returning the UUID
+// of the new relation. A peer relation has a single endpoint, any other
+// relation has two. Relations added afterwards are allocated IDs beyond every
+// imported ID.
+// The following errors may be returned:
+// - [relationerrors.RelationAlreadyExists] if a relation with the same ID
+// already exists.
+// - [relationerrors.RelationEndpointNotFound] if an endpoint is not defined
+// by the charm of its application.
+func (s *Service) ImportRelation(ctx context.Context, id int, endpoints []relation.EndpointIdentifier) (string, error) {
+	if id < 0 {
+		return "", errors.Errorf("relation ID %d not valid", id)
+	}
+	if len(endpoints) != 1 && len(endpoints) != 2 {
+		return "", errors.Errorf("relation %d has %d endpoints, expected 1 or 2", id, len(endpoints))
+	}
+	relationUUID, err := uuid.NewUUID()
+	if err != nil {
+		return "", errors.Errorf("generating relation uuid: %w", err)
+	}
+	if err := s.st.ImportRelation(ctx, relationUUID.String(), id, endpoints); err != nil {
+		return "", errors.Errorf("importing relation %d: %w", id, err)
+	}
+	return relationUUID.String(), nil
+}
+
+// GetRelationEndpointBindings returns the space each endpoint of the relation
+// is bound to, keyed by application and endpoint name, with space UUIDs as
+// values. Both endpoints of a relation may have the same name, so the name
+// alone does not identify an endpoint. It is
+// intended to be called once when a unit initialises the relation, so that
+// network-get can resolve the space of the relation endpoint without a
+// separate lookup on every hook.
+// If the relation doesn't exist, an error satisfying
+// [relationerrors.RelationNotFound] is returned.
+func (s *Service) GetRelationEndpointBindings(ctx context.Context, relationUUID string) (map[relation.EndpointIdentifier]string, error) {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return nil, errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+
+	bindings, err := s.st.GetRelationEndpointBindings(ctx, relationUUID)
+	if err != nil {
+		return nil, errors.Errorf("getting endpoint bindings for relation %q: %w", relationUUID, err)
+	}
+	return bindings, nil
+}
+
+// DeleteRelation removes the dead relation from the model. Its endpoints,
+// application settings, status and status history are removed with it.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationNotDead] if the relation is not dead.
+// - [relationerrors.RelationHasUnits] if units are still in the relation.
+func (s *Service) DeleteRelation(ctx context.Context, relationUUID string) error {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+
+	if err := s.st.DeleteRelation(ctx, relationUUID); err != nil {
+		return errors.Errorf("deleting relation %q: %w", relationUUID, err)
+	}
+	return nil
+}
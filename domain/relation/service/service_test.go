@@ -0,0 +1,319 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/clock/testclock"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type serviceSuite struct {
+	testing.IsolationSuite
+
+	state *MockState
+	clock *testclock.Clock
+
+	relationUUID string
+}
+
+var _ = gc.Suite(&serviceSuite{})
+
+func (s *serviceSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.relationUUID = uuid.MustNewUUID().String()
+}
+
+func (s *serviceSuite) TestSetRelationStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	info := relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "maintenance",
+		Actor:   "user-admin",
+		Since:   time.Now(),
+	}
+	s.state.EXPECT().SetRelationStatus(gomock.Any(), s.relationUUID, info).Return(nil)
+
+	err := s.service(c).SetRelationStatus(context.Background(), s.relationUUID, info)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetRelationStatusDefaultsSince(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().SetRelationStatus(gomock.Any(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Joined,
+		Since:  s.clock.Now(),
+	}).Return(nil)
+
+	err := s.service(c).SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Joined,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetRelationStatusInvalidUUID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).SetRelationStatus(context.Background(), "foo", relation.StatusInfo{
+		Status: corerelation.Joined,
+	})
+	c.Assert(err, gc.ErrorMatches, `relation uuid "foo" not valid`)
+}
+
+func (s *serviceSuite) TestSetRelationStatusRelationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().SetRelationStatus(gomock.Any(), s.relationUUID, gomock.Any()).Return(relationerrors.RelationNotFound)
+
+	err := s.service(c).SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Joined,
+	})
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *serviceSuite) TestGetRelationStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := []relation.RelationStatusHistoryEntry{{
+		Status: corerelation.Broken,
+		Actor:  "unit-foo-0",
+		Since:  time.Now(),
+	}}
+	s.state.EXPECT().GetRelationStatusHistory(gomock.Any(), s.relationUUID, 5).Return(expected, nil)
+
+	history, err := s.service(c).GetRelationStatusHistory(context.Background(), s.relationUUID, 5)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, jc.DeepEquals, expected)
+}
+
+func (s *serviceSuite) TestGetRelationStatusHistoryDefaultLimit(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationStatusHistory(gomock.Any(), s.relationUUID, DefaultStatusHistoryLimit).Return(nil, nil)
+
+	_, err := s.service(c).GetRelationStatusHistory(context.Background(), s.relationUUID, 0)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestPruneRelationStatusHistory(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().PruneRelationStatusHistory(gomock.Any(), s.clock.Now().Add(-time.Hour)).Return(int64(3), nil)
+
+	err := s.service(c).PruneRelationStatusHistory(context.Background(), time.Hour)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestPruneRelationStatusHistoryNoMaxAge(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).PruneRelationStatusHistory(context.Background(), 0)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSuspendRelation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).Return(relation.LifeSuspendedStatus{
+		Key: "wordpress:db mysql:server",
+	}, nil)
+	s.state.EXPECT().SetRelationStatus(gomock.Any(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "security review",
+		Actor:   "user-admin",
+		Since:   s.clock.Now(),
+	}).Return(nil)
+
+	err := s.service(c).SuspendRelation(context.Background(), s.relationUUID, "security review", "user-admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSuspendRelationAlreadySuspended(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).Return(relation.LifeSuspendedStatus{
+		Key:       "wordpress:db mysql:server",
+		Suspended: true,
+	}, nil)
+
+	err := s.service(c).SuspendRelation(context.Background(), s.relationUUID, "security review", "user-admin")
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationAlreadySuspended)
+}
+
+func (s *serviceSuite) TestSuspendRelationNoActor(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).SuspendRelation(context.Background(), s.relationUUID, "security review", "")
+	c.Assert(err, gc.ErrorMatches, `suspending relation .*: actor not specified`)
+}
+
+func (s *serviceSuite) TestResumeRelation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).Return(relation.LifeSuspendedStatus{
+		Key:       "wordpress:db mysql:server",
+		Suspended: true,
+	}, nil)
+	s.state.EXPECT().SetRelationStatus(gomock.Any(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Joined,
+		Message: "review complete",
+		Actor:   "user-admin",
+		Since:   s.clock.Now(),
+	}).Return(nil)
+
+	err := s.service(c).ResumeRelation(context.Background(), s.relationUUID, "review complete", "user-admin")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestResumeRelationNotSuspended(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).Return(relation.LifeSuspendedStatus{
+		Key: "wordpress:db mysql:server",
+	}, nil)
+
+	err := s.service(c).ResumeRelation(context.Background(), s.relationUUID, "review complete", "user-admin")
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotSuspended)
+}
+
+func (s *serviceSuite) service(c *gc.C) *Service {
+	return NewService(s.state, s.clock, loggertesting.WrapCheckLog(c))
+}
+
+func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.clock = testclock.NewClock(time.Now())
+
+	return ctrl
+}
+
+func (s *serviceSuite) TestCheckRelationConsistency(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expected := []relation.Inconsistency{{
+		Kind:     relation.RelationWithoutEndpoints,
+		UUID:     s.relationUUID,
+		Message:  "relation 1 has no endpoints",
+		Repaired: true,
+	}}
+	s.state.EXPECT().CheckRelationConsistency(gomock.Any(), true).Return(expected, nil)
+
+	inconsistencies, err := s.service(c).CheckRelationConsistency(context.Background(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(inconsistencies, jc.DeepEquals, expected)
+}
+
+func (s *serviceSuite) TestImportRelation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	endpoints := []relation.EndpointIdentifier{
+		{ApplicationName: "mysql", EndpointName: "db"},
+		{ApplicationName: "wordpress", EndpointName: "db"},
+	}
+	var imported string
+	s.state.EXPECT().ImportRelation(gomock.Any(), gomock.Any(), 7, endpoints).DoAndReturn(func(_ context.Context, relationUUID string, _ int, _ []relation.EndpointIdentifier) error {
+		imported = relationUUID
+		return nil
+	})
+
+	relationUUID, err := s.service(c).ImportRelation(context.Background(), 7, endpoints)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(relationUUID, gc.Equals, imported)
+	c.Check(uuid.IsValidUUIDString(relationUUID), jc.IsTrue)
+}
+
+func (s *serviceSuite) TestImportRelationDuplicateID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	endpoints := []relation.EndpointIdentifier{{ApplicationName: "mysql", EndpointName: "cluster"}}
+	s.state.EXPECT().ImportRelation(gomock.Any(), gomock.Any(), 1, endpoints).Return(relationerrors.RelationAlreadyExists)
+
+	_, err := s.service(c).ImportRelation(context.Background(), 1, endpoints)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationAlreadyExists)
+}
+
+func (s *serviceSuite) TestImportRelationNegativeID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).ImportRelation(context.Background(), -1, nil)
+	c.Assert(err, gc.ErrorMatches, `relation ID -1 not valid`)
+}
+
+func (s *serviceSuite) TestImportRelationNoEndpoints(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).ImportRelation(context.Background(), 1, nil)
+	c.Assert(err, gc.ErrorMatches, `relation 1 has 0 endpoints, expected 1 or 2`)
+}
+
+func (s *serviceSuite) TestDeleteRelation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteRelation(gomock.Any(), s.relationUUID).Return(nil)
+
+	err := s.service(c).DeleteRelation(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteRelationInvalidUUID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service(c).DeleteRelation(context.Background(), "foo")
+	c.Assert(err, gc.ErrorMatches, `relation uuid "foo" not valid`)
+}
+
+func (s *serviceSuite) TestDeleteRelationNotDead(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteRelation(gomock.Any(), s.relationUUID).Return(relationerrors.RelationNotDead)
+
+	err := s.service(c).DeleteRelation(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotDead)
+}
+
+func (s *serviceSuite) TestGetRelationEndpointBindings(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	bindings := map[relation.EndpointIdentifier]string{
+		{ApplicationName: "mysql", EndpointName: "db"}:     "space-uuid",
+		{ApplicationName: "wordpress", EndpointName: "db"}: "other-space-uuid",
+	}
+	s.state.EXPECT().GetRelationEndpointBindings(gomock.Any(), s.relationUUID).Return(bindings, nil)
+
+	result, err := s.service(c).GetRelationEndpointBindings(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, bindings)
+}
+
+func (s *serviceSuite) TestGetRelationEndpointBindingsInvalidUUID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).GetRelationEndpointBindings(context.Background(), "foo")
+	c.Assert(err, gc.ErrorMatches, `relation uuid "foo" not valid`)
+}
+
+func (s *serviceSuite) TestGetRelationEndpointBindingsRelationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationEndpointBindings(gomock.Any(), s.relationUUID).Return(nil, relationerrors.RelationNotFound)
+
+	_, err := s.service(c).GetRelationEndpointBindings(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
@@ -0,0 +1,454 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/domain/relation/service (interfaces: State,WatcherFactory)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/relation/service State,WatcherFactory
+//
+
+// Package service is a generated GoMock package.
+package service
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	changestream "github.com/juju/juju/core/changestream"
+	watcher "github.com/juju/juju/core/watcher"
+	relation "github.com/juju/juju/domain/relation"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockState is a mock of State interface.
+type MockState struct {
+	ctrl     *gomock.Controller
+	recorder *MockStateMockRecorder
+}
+
+// MockStateMockRecorder is the mock recorder for MockState.
+type MockStateMockRecorder struct {
+	mock *MockState
+}
+
+// NewMockState creates a new mock instance.
+func NewMockState(ctrl *gomock.Controller) *MockState {
+	mock := &MockState{ctrl: ctrl}
+	mock.recorder = &MockStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockState) EXPECT() *MockStateMockRecorder {
+	return m.recorder
+}
+
+// CheckRelationConsistency mocks base method.
+func (m *MockState) CheckRelationConsistency(arg0 context.Context, arg1 bool) ([]relation.Inconsistency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckRelationConsistency", arg0, arg1)
+	ret0, _ := ret[0].([]relation.Inconsistency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckRelationConsistency indicates an expected call of CheckRelationConsistency.
+func (mr *MockStateMockRecorder) CheckRelationConsistency(arg0, arg1 any) *MockStateCheckRelationConsistencyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckRelationConsistency", reflect.TypeOf((*MockState)(nil).CheckRelationConsistency), arg0, arg1)
+	return &MockStateCheckRelationConsistencyCall{Call: call}
+}
+
+// MockStateCheckRelationConsistencyCall wrap *gomock.Call
+type MockStateCheckRelationConsistencyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateCheckRelationConsistencyCall) Return(arg0 []relation.Inconsistency, arg1 error) *MockStateCheckRelationConsistencyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateCheckRelationConsistencyCall) Do(f func(context.Context, bool) ([]relation.Inconsistency, error)) *MockStateCheckRelationConsistencyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateCheckRelationConsistencyCall) DoAndReturn(f func(context.Context, bool) ([]relation.Inconsistency, error)) *MockStateCheckRelationConsistencyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteRelation mocks base method.
+func (m *MockState) DeleteRelation(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRelation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRelation indicates an expected call of DeleteRelation.
+func (mr *MockStateMockRecorder) DeleteRelation(arg0, arg1 any) *MockStateDeleteRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRelation", reflect.TypeOf((*MockState)(nil).DeleteRelation), arg0, arg1)
+	return &MockStateDeleteRelationCall{Call: call}
+}
+
+// MockStateDeleteRelationCall wrap *gomock.Call
+type MockStateDeleteRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteRelationCall) Return(arg0 error) *MockStateDeleteRelationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteRelationCall) Do(f func(context.Context, string) error) *MockStateDeleteRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteRelationCall) DoAndReturn(f func(context.Context, string) error) *MockStateDeleteRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationEndpointBindings mocks base method.
+func (m *MockState) GetRelationEndpointBindings(arg0 context.Context, arg1 string) (map[relation.EndpointIdentifier]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationEndpointBindings", arg0, arg1)
+	ret0, _ := ret[0].(map[relation.EndpointIdentifier]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationEndpointBindings indicates an expected call of GetRelationEndpointBindings.
+func (mr *MockStateMockRecorder) GetRelationEndpointBindings(arg0, arg1 any) *MockStateGetRelationEndpointBindingsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationEndpointBindings", reflect.TypeOf((*MockState)(nil).GetRelationEndpointBindings), arg0, arg1)
+	return &MockStateGetRelationEndpointBindingsCall{Call: call}
+}
+
+// MockStateGetRelationEndpointBindingsCall wrap *gomock.Call
+type MockStateGetRelationEndpointBindingsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationEndpointBindingsCall) Return(arg0 map[relation.EndpointIdentifier]string, arg1 error) *MockStateGetRelationEndpointBindingsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationEndpointBindingsCall) Do(f func(context.Context, string) (map[relation.EndpointIdentifier]string, error)) *MockStateGetRelationEndpointBindingsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationEndpointBindingsCall) DoAndReturn(f func(context.Context, string) (map[relation.EndpointIdentifier]string, error)) *MockStateGetRelationEndpointBindingsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationLifeSuspendedStatus mocks base method.
+func (m *MockState) GetRelationLifeSuspendedStatus(arg0 context.Context, arg1 string) (relation.LifeSuspendedStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationLifeSuspendedStatus", arg0, arg1)
+	ret0, _ := ret[0].(relation.LifeSuspendedStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationLifeSuspendedStatus indicates an expected call of GetRelationLifeSuspendedStatus.
+func (mr *MockStateMockRecorder) GetRelationLifeSuspendedStatus(arg0, arg1 any) *MockStateGetRelationLifeSuspendedStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationLifeSuspendedStatus", reflect.TypeOf((*MockState)(nil).GetRelationLifeSuspendedStatus), arg0, arg1)
+	return &MockStateGetRelationLifeSuspendedStatusCall{Call: call}
+}
+
+// MockStateGetRelationLifeSuspendedStatusCall wrap *gomock.Call
+type MockStateGetRelationLifeSuspendedStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationLifeSuspendedStatusCall) Return(arg0 relation.LifeSuspendedStatus, arg1 error) *MockStateGetRelationLifeSuspendedStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationLifeSuspendedStatusCall) Do(f func(context.Context, string) (relation.LifeSuspendedStatus, error)) *MockStateGetRelationLifeSuspendedStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationLifeSuspendedStatusCall) DoAndReturn(f func(context.Context, string) (relation.LifeSuspendedStatus, error)) *MockStateGetRelationLifeSuspendedStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationStatus mocks base method.
+func (m *MockState) GetRelationStatus(arg0 context.Context, arg1 string) (relation.RelationStatusInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationStatus", arg0, arg1)
+	ret0, _ := ret[0].(relation.RelationStatusInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationStatus indicates an expected call of GetRelationStatus.
+func (mr *MockStateMockRecorder) GetRelationStatus(arg0, arg1 any) *MockStateGetRelationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationStatus", reflect.TypeOf((*MockState)(nil).GetRelationStatus), arg0, arg1)
+	return &MockStateGetRelationStatusCall{Call: call}
+}
+
+// MockStateGetRelationStatusCall wrap *gomock.Call
+type MockStateGetRelationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationStatusCall) Return(arg0 relation.RelationStatusInfo, arg1 error) *MockStateGetRelationStatusCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationStatusCall) Do(f func(context.Context, string) (relation.RelationStatusInfo, error)) *MockStateGetRelationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationStatusCall) DoAndReturn(f func(context.Context, string) (relation.RelationStatusInfo, error)) *MockStateGetRelationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRelationStatusHistory mocks base method.
+func (m *MockState) GetRelationStatusHistory(arg0 context.Context, arg1 string, arg2 int) ([]relation.RelationStatusHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRelationStatusHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]relation.RelationStatusHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRelationStatusHistory indicates an expected call of GetRelationStatusHistory.
+func (mr *MockStateMockRecorder) GetRelationStatusHistory(arg0, arg1, arg2 any) *MockStateGetRelationStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRelationStatusHistory", reflect.TypeOf((*MockState)(nil).GetRelationStatusHistory), arg0, arg1, arg2)
+	return &MockStateGetRelationStatusHistoryCall{Call: call}
+}
+
+// MockStateGetRelationStatusHistoryCall wrap *gomock.Call
+type MockStateGetRelationStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetRelationStatusHistoryCall) Return(arg0 []relation.RelationStatusHistoryEntry, arg1 error) *MockStateGetRelationStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetRelationStatusHistoryCall) Do(f func(context.Context, string, int) ([]relation.RelationStatusHistoryEntry, error)) *MockStateGetRelationStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetRelationStatusHistoryCall) DoAndReturn(f func(context.Context, string, int) ([]relation.RelationStatusHistoryEntry, error)) *MockStateGetRelationStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ImportRelation mocks base method.
+func (m *MockState) ImportRelation(arg0 context.Context, arg1 string, arg2 int, arg3 []relation.EndpointIdentifier) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRelation", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportRelation indicates an expected call of ImportRelation.
+func (mr *MockStateMockRecorder) ImportRelation(arg0, arg1, arg2, arg3 any) *MockStateImportRelationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRelation", reflect.TypeOf((*MockState)(nil).ImportRelation), arg0, arg1, arg2, arg3)
+	return &MockStateImportRelationCall{Call: call}
+}
+
+// MockStateImportRelationCall wrap *gomock.Call
+type MockStateImportRelationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateImportRelationCall) Return(arg0 error) *MockStateImportRelationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateImportRelationCall) Do(f func(context.Context, string, int, []relation.EndpointIdentifier) error) *MockStateImportRelationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateImportRelationCall) DoAndReturn(f func(context.Context, string, int, []relation.EndpointIdentifier) error) *MockStateImportRelationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// PruneRelationStatusHistory mocks base method.
+func (m *MockState) PruneRelationStatusHistory(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneRelationStatusHistory", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PruneRelationStatusHistory indicates an expected call of PruneRelationStatusHistory.
+func (mr *MockStateMockRecorder) PruneRelationStatusHistory(arg0, arg1 any) *MockStatePruneRelationStatusHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneRelationStatusHistory", reflect.TypeOf((*MockState)(nil).PruneRelationStatusHistory), arg0, arg1)
+	return &MockStatePruneRelationStatusHistoryCall{Call: call}
+}
+
+// MockStatePruneRelationStatusHistoryCall wrap *gomock.Call
+type MockStatePruneRelationStatusHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatePruneRelationStatusHistoryCall) Return(arg0 int64, arg1 error) *MockStatePruneRelationStatusHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatePruneRelationStatusHistoryCall) Do(f func(context.Context, time.Time) (int64, error)) *MockStatePruneRelationStatusHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatePruneRelationStatusHistoryCall) DoAndReturn(f func(context.Context, time.Time) (int64, error)) *MockStatePruneRelationStatusHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetRelationStatus mocks base method.
+func (m *MockState) SetRelationStatus(arg0 context.Context, arg1 string, arg2 relation.StatusInfo) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRelationStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetRelationStatus indicates an expected call of SetRelationStatus.
+func (mr *MockStateMockRecorder) SetRelationStatus(arg0, arg1, arg2 any) *MockStateSetRelationStatusCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRelationStatus", reflect.TypeOf((*MockState)(nil).SetRelationStatus), arg0, arg1, arg2)
+	return &MockStateSetRelationStatusCall{Call: call}
+}
+
+// MockStateSetRelationStatusCall wrap *gomock.Call
+type MockStateSetRelationStatusCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetRelationStatusCall) Return(arg0 error) *MockStateSetRelationStatusCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetRelationStatusCall) Do(f func(context.Context, string, relation.StatusInfo) error) *MockStateSetRelationStatusCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetRelationStatusCall) DoAndReturn(f func(context.Context, string, relation.StatusInfo) error) *MockStateSetRelationStatusCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockWatcherFactory is a mock of WatcherFactory interface.
+type MockWatcherFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatcherFactoryMockRecorder
+}
+
+// MockWatcherFactoryMockRecorder is the mock recorder for MockWatcherFactory.
+type MockWatcherFactoryMockRecorder struct {
+	mock *MockWatcherFactory
+}
+
+// NewMockWatcherFactory creates a new mock instance.
+func NewMockWatcherFactory(ctrl *gomock.Controller) *MockWatcherFactory {
+	mock := &MockWatcherFactory{ctrl: ctrl}
+	mock.recorder = &MockWatcherFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatcherFactory) EXPECT() *MockWatcherFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewValueWatcher mocks base method.
+func (m *MockWatcherFactory) NewValueWatcher(arg0, arg1 string, arg2 changestream.ChangeType) (watcher.Watcher[struct{}], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewValueWatcher", arg0, arg1, arg2)
+	ret0, _ := ret[0].(watcher.Watcher[struct{}])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewValueWatcher indicates an expected call of NewValueWatcher.
+func (mr *MockWatcherFactoryMockRecorder) NewValueWatcher(arg0, arg1, arg2 any) *MockWatcherFactoryNewValueWatcherCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewValueWatcher", reflect.TypeOf((*MockWatcherFactory)(nil).NewValueWatcher), arg0, arg1, arg2)
+	return &MockWatcherFactoryNewValueWatcherCall{Call: call}
+}
+
+// MockWatcherFactoryNewValueWatcherCall wrap *gomock.Call
+type MockWatcherFactoryNewValueWatcherCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockWatcherFactoryNewValueWatcherCall) Return(arg0 watcher.Watcher[struct{}], arg1 error) *MockWatcherFactoryNewValueWatcherCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockWatcherFactoryNewValueWatcherCall) Do(f func(string, string, changestream.ChangeType) (watcher.Watcher[struct{}], error)) *MockWatcherFactoryNewValueWatcherCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockWatcherFactoryNewValueWatcherCall) DoAndReturn(f func(string, string, changestream.ChangeType) (watcher.Watcher[struct{}], error)) *MockWatcherFactoryNewValueWatcherCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/clock"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/eventsource"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// WatcherFactory describes methods for creating watchers.
+type WatcherFactory interface {
+	// NewValueWatcher returns a watcher for a particular change value
+	// in a namespace, based on the input change mask.
+	NewValueWatcher(
+		namespace, changeValue string,
+		changeMask changestream.ChangeType,
+	) (watcher.NotifyWatcher, error)
+}
+
+// WatchableService provides the API for working with relations, as well as
+// the ability to watch for changes to them.
+type WatchableService struct {
+	*Service
+	watcherFactory WatcherFactory
+}
+
+// NewWatchableService returns a new watchable service reference wrapping the
+// input state.
+func NewWatchableService(
+	st State,
+	watcherFactory WatcherFactory,
+	clock clock.Clock,
+	logger logger.Logger,
+) *WatchableService {
+	return &WatchableService{
+		Service:        NewService(st, clock, logger),
+		watcherFactory: watcherFactory,
+	}
+}
+
+// WatchRelationLifeSuspendedStatus returns a watcher which notifies of changes
+// to the life or suspended status of the relation, delivering the current
+// values of both. The initial event holds the current values. Changes which
+// arrive before an event is consumed are coalesced into a single event. The
+// watcher stops once it has delivered an event for the relation being dead,
+// or for the relation being removed.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+func (s *WatchableService) WatchRelationLifeSuspendedStatus(
+	ctx context.Context,
+	relationUUID string,
+) (watcher.RelationStatusWatcher, error) {
+	if !uuid.IsValidUUIDString(relationUUID) {
+		return nil, errors.Errorf("relation uuid %q not valid", relationUUID)
+	}
+	if _, err := s.st.GetRelationLifeSuspendedStatus(ctx, relationUUID); err != nil {
+		return nil, errors.Errorf("watching life and suspended status of relation %q: %w", relationUUID, err)
+	}
+
+	lifeWatcher, err := s.watcherFactory.NewValueWatcher("relation", relationUUID, changestream.All)
+	if err != nil {
+		return nil, errors.Errorf("watching life of relation %q: %w", relationUUID, err)
+	}
+	statusWatcher, err := s.watcherFactory.NewValueWatcher("relation_status", relationUUID, changestream.All)
+	if err != nil {
+		return nil, errors.Errorf("watching status of relation %q: %w", relationUUID, err)
+	}
+	source, err := eventsource.NewMultiNotifyWatcher(ctx, lifeWatcher, statusWatcher)
+	if err != nil {
+		return nil, errors.Errorf("watching life and suspended status of relation %q: %w", relationUUID, err)
+	}
+
+	getStatus := func(ctx context.Context) (relation.LifeSuspendedStatus, error) {
+		return s.st.GetRelationLifeSuspendedStatus(ctx, relationUUID)
+	}
+	return newRelationLifeSuspendedStatusWatcher(source, getStatus)
+}
+
+// relationLifeSuspendedStatusWatcher turns the notifications of a source
+// watcher into events holding the life and suspended status of a relation.
+type relationLifeSuspendedStatusWatcher struct {
+	catacomb catacomb.Catacomb
+
+	sourceWatcher watcher.NotifyWatcher
+	getStatus     func(context.Context) (relation.LifeSuspendedStatus, error)
+
+	out chan []watcher.RelationStatusChange
+}
+
+func newRelationLifeSuspendedStatusWatcher(
+	sourceWatcher watcher.NotifyWatcher,
+	getStatus func(context.Context) (relation.LifeSuspendedStatus, error),
+) (*relationLifeSuspendedStatusWatcher, error) {
+	w := &relationLifeSuspendedStatusWatcher{
+		sourceWatcher: sourceWatcher,
+		getStatus:     getStatus,
+		out:           make(chan []watcher.RelationStatusChange),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+		Init: []worker.Worker{sourceWatcher},
+	})
+	return w, errors.Capture(err)
+}
+
+func (w *relationLifeSuspendedStatusWatcher) loop() error {
+	defer close(w.out)
+
+	var (
+		// reported is the last change delivered, nil until the initial
+		// event has been delivered.
+		reported *watcher.RelationStatusChange
+		// pending is the latest change not yet delivered. Each read
+		// replaces it, so that a consumer always receives a single
+		// consistent snapshot.
+		pending watcher.RelationStatusChange
+		out     chan []watcher.RelationStatusChange
+	)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-w.sourceWatcher.Changes():
+			if !ok {
+				return errors.New("relation life and status watcher closed")
+			}
+			ctx, cancel := context.WithCancel(w.catacomb.Context(context.Background()))
+			status, err := w.getStatus(ctx)
+			cancel()
+			if errors.Is(err, relationerrors.RelationNotFound) {
+				if reported == nil && out == nil {
+					return errors.Capture(err)
+				}
+				// The relation has been removed, so report it as being
+				// dead, which stops the watcher once delivered.
+				if out == nil {
+					pending = *reported
+				}
+				pending.Life = life.Dead
+				out = w.out
+				continue
+			} else if err != nil {
+				return errors.Capture(err)
+			}
+
+			change := watcher.RelationStatusChange{
+				Key:             status.Key,
+				Life:            status.Life,
+				Suspended:       status.Suspended,
+				SuspendedReason: status.SuspendedReason,
+			}
+			if reported != nil && *reported == change {
+				out = nil
+				continue
+			}
+			pending = change
+			out = w.out
+		case out <- []watcher.RelationStatusChange{pending}:
+			delivered := pending
+			reported = &delivered
+			out = nil
+			if delivered.Life == life.Dead {
+				return nil
+			}
+		}
+	}
+}
+
+// Changes returns the channel of relation life and suspended status changes.
+func (w *relationLifeSuspendedStatusWatcher) Changes() <-chan []watcher.RelationStatusChange {
+	return w.out
+}
+
+// Kill kills the watcher via its catacomb.
+func (w *relationLifeSuspendedStatusWatcher) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait waits for the watcher's catacomb to die, and returns the error with
+// which it was killed.
+func (w *relationLifeSuspendedStatusWatcher) Wait() error {
+	return w.catacomb.Wait()
+}
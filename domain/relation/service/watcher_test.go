@@ -0,0 +1,248 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/changestream"
+	"github.com/juju/juju/core/life"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type watcherSuite struct {
+	testing.IsolationSuite
+
+	state          *MockState
+	watcherFactory *MockWatcherFactory
+
+	relationUUID string
+}
+
+var _ = gc.Suite(&watcherSuite{})
+
+func (s *watcherSuite) SetUpTest(c *gc.C) {
+	s.IsolationSuite.SetUpTest(c)
+
+	s.relationUUID = uuid.MustNewUUID().String()
+}
+
+func (s *watcherSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockState(ctrl)
+	s.watcherFactory = NewMockWatcherFactory(ctrl)
+
+	return ctrl
+}
+
+func (s *watcherSuite) service(c *gc.C) *WatchableService {
+	return NewWatchableService(s.state, s.watcherFactory, nil, loggertesting.WrapCheckLog(c))
+}
+
+func (s *watcherSuite) TestWatchRelationLifeSuspendedStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).Return(relation.LifeSuspendedStatus{
+		Key:       "wordpress:db mysql:server",
+		Life:      life.Alive,
+		Suspended: true,
+	}, nil).AnyTimes()
+
+	// Each source watcher has its initial event ready to be consumed.
+	lifeCh := make(chan struct{}, 1)
+	lifeCh <- struct{}{}
+	statusCh := make(chan struct{}, 1)
+	statusCh <- struct{}{}
+	s.watcherFactory.EXPECT().NewValueWatcher("relation", s.relationUUID, changestream.All).
+		Return(watchertest.NewMockNotifyWatcher(lifeCh), nil)
+	s.watcherFactory.EXPECT().NewValueWatcher("relation_status", s.relationUUID, changestream.All).
+		Return(watchertest.NewMockNotifyWatcher(statusCh), nil)
+
+	w, err := s.service(c).WatchRelationLifeSuspendedStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+	wc := watchertest.NewWatcherC(c, w)
+
+	wc.Check(watchertest.SliceAssert([]watcher.RelationStatusChange{{
+		Key:       "wordpress:db mysql:server",
+		Life:      life.Alive,
+		Suspended: true,
+	}}))
+	wc.AssertNoChange()
+}
+
+func (s *watcherSuite) TestWatchRelationLifeSuspendedStatusRelationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetRelationLifeSuspendedStatus(gomock.Any(), s.relationUUID).
+		Return(relation.LifeSuspendedStatus{}, relationerrors.RelationNotFound)
+
+	_, err := s.service(c).WatchRelationLifeSuspendedStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *watcherSuite) TestWatchRelationLifeSuspendedStatusUUIDNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service(c).WatchRelationLifeSuspendedStatus(context.Background(), "foo")
+	c.Assert(err, gc.ErrorMatches, `relation uuid "foo" not valid`)
+}
+
+func (s *watcherSuite) TestLifeSuspendedStatusWatcherCoalescesChanges(c *gc.C) {
+	st := &fakeLifeSuspendedStatus{status: relation.LifeSuspendedStatus{
+		Key:  "wordpress:db mysql:server",
+		Life: life.Alive,
+	}}
+	ch := make(chan struct{})
+	w, err := newRelationLifeSuspendedStatusWatcher(watchertest.NewMockNotifyWatcher(ch), st.get)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+	wc := watchertest.NewWatcherC(c, w)
+
+	s.notify(c, ch)
+	wc.Check(watchertest.SliceAssert([]watcher.RelationStatusChange{{
+		Key:  "wordpress:db mysql:server",
+		Life: life.Alive,
+	}}))
+
+	// A change which leaves life and suspended status unchanged is not
+	// reported.
+	s.notify(c, ch)
+	wc.AssertNoChange()
+
+	// Life and suspended status changing in quick succession are reported
+	// as a single change.
+	st.set(relation.LifeSuspendedStatus{
+		Key:  "wordpress:db mysql:server",
+		Life: life.Dying,
+	})
+	s.notify(c, ch)
+	st.set(relation.LifeSuspendedStatus{
+		Key:             "wordpress:db mysql:server",
+		Life:            life.Dying,
+		Suspended:       true,
+		SuspendedReason: "maintenance",
+	})
+	s.notify(c, ch)
+	wc.Check(watchertest.SliceAssert([]watcher.RelationStatusChange{{
+		Key:             "wordpress:db mysql:server",
+		Life:            life.Dying,
+		Suspended:       true,
+		SuspendedReason: "maintenance",
+	}}))
+	wc.AssertNoChange()
+}
+
+func (s *watcherSuite) TestLifeSuspendedStatusWatcherStopsWhenDead(c *gc.C) {
+	st := &fakeLifeSuspendedStatus{status: relation.LifeSuspendedStatus{
+		Key:  "wordpress:db mysql:server",
+		Life: life.Dead,
+	}}
+	ch := make(chan struct{})
+	w, err := newRelationLifeSuspendedStatusWatcher(watchertest.NewMockNotifyWatcher(ch), st.get)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+
+	s.notify(c, ch)
+	s.assertFinalChange(c, w, watcher.RelationStatusChange{
+		Key:  "wordpress:db mysql:server",
+		Life: life.Dead,
+	})
+}
+
+func (s *watcherSuite) TestLifeSuspendedStatusWatcherStopsWhenRemoved(c *gc.C) {
+	st := &fakeLifeSuspendedStatus{status: relation.LifeSuspendedStatus{
+		Key:       "wordpress:db mysql:server",
+		Life:      life.Dying,
+		Suspended: true,
+	}}
+	ch := make(chan struct{})
+	w, err := newRelationLifeSuspendedStatusWatcher(watchertest.NewMockNotifyWatcher(ch), st.get)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.DirtyKill(c, w)
+	wc := watchertest.NewWatcherC(c, w)
+
+	s.notify(c, ch)
+	wc.Check(watchertest.SliceAssert([]watcher.RelationStatusChange{{
+		Key:       "wordpress:db mysql:server",
+		Life:      life.Dying,
+		Suspended: true,
+	}}))
+
+	st.setErr(relationerrors.RelationNotFound)
+	s.notify(c, ch)
+	s.assertFinalChange(c, w, watcher.RelationStatusChange{
+		Key:       "wordpress:db mysql:server",
+		Life:      life.Dead,
+		Suspended: true,
+	})
+}
+
+func (s *watcherSuite) notify(c *gc.C, ch chan<- struct{}) {
+	select {
+	case ch <- struct{}{}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out sending change")
+	}
+}
+
+// assertFinalChange asserts that the watcher delivers the expected change, and
+// then stops cleanly, closing its changes channel.
+func (s *watcherSuite) assertFinalChange(c *gc.C, w watcher.RelationStatusWatcher, expected watcher.RelationStatusChange) {
+	select {
+	case changes, ok := <-w.Changes():
+		c.Assert(ok, jc.IsTrue)
+		c.Check(changes, jc.DeepEquals, []watcher.RelationStatusChange{expected})
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for change")
+	}
+	select {
+	case _, ok := <-w.Changes():
+		c.Assert(ok, jc.IsFalse)
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for watcher to stop")
+	}
+	c.Assert(w.Wait(), jc.ErrorIsNil)
+}
+
+// fakeLifeSuspendedStatus returns the life and suspended status of a relation
+// set by a test.
+type fakeLifeSuspendedStatus struct {
+	mu     sync.Mutex
+	status relation.LifeSuspendedStatus
+	err    error
+}
+
+func (f *fakeLifeSuspendedStatus) get(context.Context) (relation.LifeSuspendedStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.status, f.err
+}
+
+func (f *fakeLifeSuspendedStatus) set(status relation.LifeSuspendedStatus) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+}
+
+func (f *fakeLifeSuspendedStatus) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.err = err
+}
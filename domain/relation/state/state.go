@@ -0,0 +1,927 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/canonical/sqlair"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/network"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/life"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	"github.com/juju/juju/internal/charm"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// State represents database interactions dealing with relations.
+type State struct {
+	*domain.StateBase
+	logger logger.Logger
+}
+
+// NewState returns a new relation state based on the input database factory
+// method.
+func NewState(factory coredatabase.TxnRunnerFactory, logger logger.Logger) *State {
+	return &State{
+		StateBase: domain.NewStateBase(factory),
+		logger:    logger,
+	}
+}
+
+// SetRelationStatus sets the status of the relation and records the status
+// transition in the relation status history.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationStatusNotValid] if the status is not known.
+func (st *State) SetRelationStatus(ctx context.Context, relationUUID string, info relation.StatusInfo) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	historyUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating status history UUID: %w", err)
+	}
+
+	statusType := relationStatusType{Name: info.Status.String()}
+	statusTypeStmt, err := st.Prepare(`
+SELECT &relationStatusType.id
+FROM   relation_status_type
+WHERE  name = $relationStatusType.name
+`, statusType)
+	if err != nil {
+		return errors.Errorf("preparing status type query: %w", err)
+	}
+
+	status := relationStatus{
+		RelationUUID: relationUUID,
+		UpdatedAt:    info.Since,
+	}
+	if info.Status == corerelation.Suspended {
+		status.SuspendedReason = info.Message
+		status.SuspendedBy = info.Actor
+		status.SuspendedAt = sql.NullTime{Time: info.Since, Valid: true}
+	}
+	upsertStmt, err := st.Prepare(`
+INSERT INTO relation_status (*) VALUES ($relationStatus.*)
+ON CONFLICT(relation_uuid) DO UPDATE SET
+    relation_status_type_id = excluded.relation_status_type_id,
+    suspended_reason = excluded.suspended_reason,
+    suspended_by = excluded.suspended_by,
+    suspended_at = excluded.suspended_at,
+    updated_at = excluded.updated_at;
+`, status)
+	if err != nil {
+		return errors.Errorf("preparing relation status statement: %w", err)
+	}
+
+	history := relationStatusHistory{
+		UUID:         historyUUID.String(),
+		RelationUUID: relationUUID,
+		Message:      info.Message,
+		Actor:        info.Actor,
+		UpdatedAt:    info.Since,
+	}
+	historyStmt, err := st.Prepare(`
+INSERT INTO relation_status_history (*) VALUES ($relationStatusHistory.*)
+`, history)
+	if err != nil {
+		return errors.Errorf("preparing relation status history statement: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkRelationExists(ctx, tx, relationUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		err := tx.Query(ctx, statusTypeStmt, statusType).Get(&statusType)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w: %q", relationerrors.RelationStatusNotValid, info.Status)
+		} else if err != nil {
+			return errors.Errorf("getting relation status type: %w", err)
+		}
+		status.StatusID = statusType.ID
+		history.StatusID = statusType.ID
+
+		if err := tx.Query(ctx, upsertStmt, status).Run(); err != nil {
+			return errors.Errorf("setting relation status: %w", err)
+		}
+		if err := tx.Query(ctx, historyStmt, history).Run(); err != nil {
+			return errors.Errorf("recording relation status history: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetRelationStatus returns the current status of the relation, along with
+// who suspended it and when if it is suspended.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationStatusNotValid] if the relation has no status.
+func (st *State) GetRelationStatus(ctx context.Context, relUUID string) (relation.RelationStatusInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return relation.RelationStatusInfo{}, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT rst.name AS &relationStatusInfo.status,
+       rs.suspended_reason AS &relationStatusInfo.suspended_reason,
+       rs.suspended_by AS &relationStatusInfo.suspended_by,
+       rs.suspended_at AS &relationStatusInfo.suspended_at,
+       rs.updated_at AS &relationStatusInfo.updated_at
+FROM   relation_status AS rs
+JOIN   relation_status_type AS rst ON rst.id = rs.relation_status_type_id
+WHERE  rs.relation_uuid = $relationUUID.uuid
+`, rel, relationStatusInfo{})
+	if err != nil {
+		return relation.RelationStatusInfo{}, errors.Errorf("preparing relation status query: %w", err)
+	}
+
+	var info relationStatusInfo
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkRelationExists(ctx, tx, relUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		err := tx.Query(ctx, stmt, rel).Get(&info)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w: relation %q has no status", relationerrors.RelationStatusNotValid, relUUID)
+		} else if err != nil {
+			return errors.Errorf("getting relation status: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return relation.RelationStatusInfo{}, errors.Capture(err)
+	}
+
+	result := relation.RelationStatusInfo{
+		Status: corerelation.Status(info.Status),
+		Since:  info.UpdatedAt,
+	}
+	if result.Status == corerelation.Suspended {
+		result.Message = info.SuspendedReason.String
+		result.SuspendedBy = info.SuspendedBy.String
+		if info.SuspendedAt.Valid {
+			result.SuspendedSince = &info.SuspendedAt.Time
+		}
+	}
+	return result, nil
+}
+
+// GetRelationStatusHistory returns the most recent status transitions of the
+// relation, newest first, up to the given limit.
+// If the relation doesn't exist, an error satisfying
+// [relationerrors.RelationNotFound] is returned.
+func (st *State) GetRelationStatusHistory(ctx context.Context, relationUUID string, limit int) ([]relation.RelationStatusHistoryEntry, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	args := historyLimit{
+		RelationUUID: relationUUID,
+		Limit:        limit,
+	}
+	stmt, err := st.Prepare(`
+SELECT    (rst.name, rsh.message, rsh.actor, rsh.updated_at) AS (&relationStatusHistoryEntry.*)
+FROM      relation_status_history AS rsh
+JOIN      relation_status_type AS rst ON rst.id = rsh.relation_status_type_id
+WHERE     rsh.relation_uuid = $historyLimit.relation_uuid
+ORDER BY  rsh.updated_at DESC
+LIMIT     $historyLimit.limit
+`, args, relationStatusHistoryEntry{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation status history query: %w", err)
+	}
+
+	var entries []relationStatusHistoryEntry
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkRelationExists(ctx, tx, relationUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		err := tx.Query(ctx, stmt, args).GetAll(&entries)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation status history: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make([]relation.RelationStatusHistoryEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = relation.RelationStatusHistoryEntry{
+			Status:  corerelation.Status(entry.Status),
+			Message: entry.Message,
+			Actor:   entry.Actor,
+			Since:   entry.UpdatedAt,
+		}
+	}
+	return result, nil
+}
+
+// PruneRelationStatusHistory removes all relation status history entries that
+// were recorded before the given time. The number of removed entries is
+// returned.
+func (st *State) PruneRelationStatusHistory(ctx context.Context, before time.Time) (int64, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Capture(err)
+	}
+
+	args := pruneBefore{Before: before}
+	stmt, err := st.Prepare(`
+DELETE FROM relation_status_history
+WHERE updated_at < $pruneBefore.before
+`, args)
+	if err != nil {
+		return 0, errors.Errorf("preparing prune statement: %w", err)
+	}
+
+	var pruned int64
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, args).Get(&outcome); err != nil {
+			return errors.Errorf("pruning relation status history: %w", err)
+		}
+		pruned, err = outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Errorf("getting rows affected: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return 0, errors.Capture(err)
+	}
+	return pruned, nil
+}
+
+// CheckRelationConsistency scans the relation records of the model for
+// inconsistencies and returns them. If repair is true, records which are
+// clearly orphaned are removed in the same transaction:
+//   - relation units of dead units;
+//   - relation units of dead relations that are no longer in scope;
+//   - relations without endpoints that have no relation units;
+//   - stale application endpoints that are not used by any relation.
+//
+// Other inconsistencies are reported but left in place.
+func (st *State) CheckRelationConsistency(ctx context.Context, repair bool) ([]relation.Inconsistency, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	deadUnitStmt, err := st.Prepare(`
+SELECT ru.uuid AS &inconsistentRelationUnit.uuid,
+       r.relation_id AS &inconsistentRelationUnit.relation_id,
+       u.name AS &inconsistentRelationUnit.unit_name,
+       ru.in_scope AS &inconsistentRelationUnit.in_scope
+FROM   relation_unit AS ru
+JOIN   relation AS r ON r.uuid = ru.relation_uuid
+JOIN   unit AS u ON u.uuid = ru.unit_uuid
+WHERE  u.life_id = 2
+`, inconsistentRelationUnit{})
+	if err != nil {
+		return nil, errors.Errorf("preparing dead unit query: %w", err)
+	}
+
+	deadRelationStmt, err := st.Prepare(`
+SELECT ru.uuid AS &inconsistentRelationUnit.uuid,
+       r.relation_id AS &inconsistentRelationUnit.relation_id,
+       u.name AS &inconsistentRelationUnit.unit_name,
+       ru.in_scope AS &inconsistentRelationUnit.in_scope
+FROM   relation_unit AS ru
+JOIN   relation AS r ON r.uuid = ru.relation_uuid
+JOIN   unit AS u ON u.uuid = ru.unit_uuid
+WHERE  r.life_id = 2
+AND    u.life_id != 2
+`, inconsistentRelationUnit{})
+	if err != nil {
+		return nil, errors.Errorf("preparing dead relation query: %w", err)
+	}
+
+	endpointlessStmt, err := st.Prepare(`
+SELECT    r.uuid AS &endpointlessRelation.uuid,
+          r.relation_id AS &endpointlessRelation.relation_id,
+          COUNT(ru.uuid) AS &endpointlessRelation.unit_count
+FROM      relation AS r
+LEFT JOIN relation_unit AS ru ON ru.relation_uuid = r.uuid
+WHERE     NOT EXISTS (
+    SELECT 1 FROM relation_endpoint AS re WHERE re.relation_uuid = r.uuid
+)
+GROUP BY  r.uuid
+`, endpointlessRelation{})
+	if err != nil {
+		return nil, errors.Errorf("preparing endpointless relation query: %w", err)
+	}
+
+	staleEndpointStmt, err := st.Prepare(`
+SELECT    ae.uuid AS &staleApplicationEndpoint.uuid,
+          a.name AS &staleApplicationEndpoint.application_name,
+          cr.name AS &staleApplicationEndpoint.endpoint_name,
+          COUNT(re.uuid) AS &staleApplicationEndpoint.relation_count
+FROM      application_endpoint AS ae
+JOIN      application AS a ON a.uuid = ae.application_uuid
+JOIN      charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+LEFT JOIN relation_endpoint AS re ON re.endpoint_uuid = ae.uuid
+WHERE     cr.charm_uuid != a.charm_uuid
+GROUP BY  ae.uuid
+`, staleApplicationEndpoint{})
+	if err != nil {
+		return nil, errors.Errorf("preparing stale application endpoint query: %w", err)
+	}
+
+	var result []relation.Inconsistency
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		result = nil
+
+		// Relation units are checked first, so that relations which are only
+		// held by orphaned relation units can be removed once those are gone.
+		var deadUnits []inconsistentRelationUnit
+		if err := tx.Query(ctx, deadUnitStmt).GetAll(&deadUnits); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation units of dead units: %w", err)
+		}
+		for _, ru := range deadUnits {
+			inconsistency := relation.Inconsistency{
+				Kind:    relation.DeadUnitInRelation,
+				UUID:    ru.UUID,
+				Message: fmt.Sprintf("dead unit %q is still in relation %d", ru.UnitName, ru.RelationID),
+			}
+			if repair {
+				if err := st.deleteRelationUnit(ctx, tx, ru.UUID); err != nil {
+					return errors.Capture(err)
+				}
+				inconsistency.Repaired = true
+			}
+			result = append(result, inconsistency)
+		}
+
+		var deadRelationUnits []inconsistentRelationUnit
+		if err := tx.Query(ctx, deadRelationStmt).GetAll(&deadRelationUnits); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation units of dead relations: %w", err)
+		}
+		for _, ru := range deadRelationUnits {
+			inconsistency := relation.Inconsistency{
+				Kind:    relation.DeadRelationWithUnits,
+				UUID:    ru.UUID,
+				Message: fmt.Sprintf("dead relation %d still has unit %q", ru.RelationID, ru.UnitName),
+			}
+			// Units still in scope have yet to run their relation departed
+			// hooks, so they are left for the uniter to clean up.
+			if repair && !ru.InScope {
+				if err := st.deleteRelationUnit(ctx, tx, ru.UUID); err != nil {
+					return errors.Capture(err)
+				}
+				inconsistency.Repaired = true
+			}
+			result = append(result, inconsistency)
+		}
+
+		var endpointless []endpointlessRelation
+		if err := tx.Query(ctx, endpointlessStmt).GetAll(&endpointless); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relations without endpoints: %w", err)
+		}
+		for _, rel := range endpointless {
+			inconsistency := relation.Inconsistency{
+				Kind:    relation.RelationWithoutEndpoints,
+				UUID:    rel.UUID,
+				Message: fmt.Sprintf("relation %d has no endpoints", rel.RelationID),
+			}
+			if repair && rel.UnitCount == 0 {
+				if err := st.deleteRelation(ctx, tx, rel.UUID); err != nil {
+					return errors.Capture(err)
+				}
+				inconsistency.Repaired = true
+			}
+			result = append(result, inconsistency)
+		}
+
+		var staleEndpoints []staleApplicationEndpoint
+		if err := tx.Query(ctx, staleEndpointStmt).GetAll(&staleEndpoints); err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting stale application endpoints: %w", err)
+		}
+		for _, ep := range staleEndpoints {
+			inconsistency := relation.Inconsistency{
+				Kind: relation.StaleApplicationEndpoint,
+				UUID: ep.UUID,
+				Message: fmt.Sprintf("endpoint %q of application %q is not defined by its charm",
+					ep.EndpointName, ep.ApplicationName),
+			}
+			if repair && ep.RelationCount == 0 {
+				if err := st.deleteApplicationEndpoint(ctx, tx, ep.UUID); err != nil {
+					return errors.Capture(err)
+				}
+				inconsistency.Repaired = true
+			}
+			result = append(result, inconsistency)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return result, nil
+}
+
+// ImportRelation inserts an alive relation with the given UUID and relation
+// ID, as taken from the source model of a migration, instead of allocating a
+// fresh relation ID. The relation sequence is advanced past the imported ID
+// so that relations added later never collide with it; importing an ID lower
+// than the current sequence leaves the sequence unchanged.
+// Each endpoint is linked to the relation through the application endpoint
+// of its application's charm relation, which is added, bound to the alpha
+// space, if the application does not have it yet.
+// The following errors may be returned:
+// - [relationerrors.RelationAlreadyExists] if a relation with the same ID
+// already exists.
+// - [relationerrors.RelationEndpointNotFound] if an endpoint is not defined
+// by the charm of its application.
+func (st *State) ImportRelation(ctx context.Context, relationUUID string, id int, endpoints []relation.EndpointIdentifier) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rel := importRelation{
+		UUID:       relationUUID,
+		LifeID:     int(life.Alive),
+		RelationID: id,
+	}
+	existsStmt, err := st.Prepare(`
+SELECT &importRelation.uuid
+FROM   relation
+WHERE  relation_id = $importRelation.relation_id
+`, rel)
+	if err != nil {
+		return errors.Errorf("preparing relation ID query: %w", err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO relation (*) VALUES ($importRelation.*)
+`, rel)
+	if err != nil {
+		return errors.Errorf("preparing insert relation query: %w", err)
+	}
+
+	seq := relationSequence{}
+	getSeqStmt, err := st.Prepare(`
+SELECT &relationSequence.sequence
+FROM   relation_sequence
+`, seq)
+	if err != nil {
+		return errors.Errorf("preparing relation sequence query: %w", err)
+	}
+	insertSeqStmt, err := st.Prepare(`
+INSERT INTO relation_sequence (*) VALUES ($relationSequence.*)
+`, seq)
+	if err != nil {
+		return errors.Errorf("preparing insert relation sequence query: %w", err)
+	}
+	updateSeqStmt, err := st.Prepare(`
+UPDATE relation_sequence SET sequence = $relationSequence.sequence
+`, seq)
+	if err != nil {
+		return errors.Errorf("preparing update relation sequence query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing importRelation
+		err := tx.Query(ctx, existsStmt, rel).Get(&existing)
+		if err == nil {
+			return errors.Errorf("%w: relation ID %d", relationerrors.RelationAlreadyExists, id)
+		} else if !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("checking relation ID %d: %w", id, err)
+		}
+
+		if err := tx.Query(ctx, insertStmt, rel).Run(); err != nil {
+			return errors.Errorf("inserting relation %d: %w", id, err)
+		}
+		for _, ep := range endpoints {
+			if err := st.insertRelationEndpoint(ctx, tx, relationUUID, ep); err != nil {
+				return errors.Errorf("inserting endpoint %s:%s of relation %d: %w",
+					ep.ApplicationName, ep.EndpointName, id, err)
+			}
+		}
+
+		// The sequence is the next relation ID to be allocated, so it only
+		// needs to move if the imported ID is at or beyond it.
+		err = tx.Query(ctx, getSeqStmt).Get(&seq)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			seq.Sequence = id + 1
+			if err := tx.Query(ctx, insertSeqStmt, seq).Run(); err != nil {
+				return errors.Errorf("inserting relation sequence: %w", err)
+			}
+			return nil
+		} else if err != nil {
+			return errors.Errorf("getting relation sequence: %w", err)
+		}
+		if id < seq.Sequence {
+			return nil
+		}
+		seq.Sequence = id + 1
+		if err := tx.Query(ctx, updateSeqStmt, seq).Run(); err != nil {
+			return errors.Errorf("updating relation sequence: %w", err)
+		}
+		return nil
+	})
+}
+
+// insertRelationEndpoint links the relation to the application endpoint
+// identified by the input application and endpoint names, adding the
+// application endpoint bound to the alpha space if it doesn't exist.
+func (st *State) insertRelationEndpoint(ctx context.Context, tx *sqlair.TX, relationUUID string, ep relation.EndpointIdentifier) error {
+	name := endpointName{
+		ApplicationName: ep.ApplicationName,
+		EndpointName:    ep.EndpointName,
+	}
+	charmRelationStmt, err := st.Prepare(`
+SELECT a.uuid AS &applicationEndpoint.application_uuid,
+       cr.uuid AS &applicationEndpoint.charm_relation_uuid
+FROM   application AS a
+JOIN   charm_relation AS cr ON cr.charm_uuid = a.charm_uuid
+WHERE  a.name = $endpointName.application_name
+AND    cr.name = $endpointName.endpoint_name
+`, name, applicationEndpoint{})
+	if err != nil {
+		return errors.Errorf("preparing charm relation query: %w", err)
+	}
+	var appEndpoint applicationEndpoint
+	err = tx.Query(ctx, charmRelationStmt, name).Get(&appEndpoint)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Errorf("%w: %s:%s", relationerrors.RelationEndpointNotFound, ep.ApplicationName, ep.EndpointName)
+	} else if err != nil {
+		return errors.Errorf("getting charm relation: %w", err)
+	}
+
+	appEndpointStmt, err := st.Prepare(`
+SELECT &applicationEndpoint.uuid
+FROM   application_endpoint
+WHERE  application_uuid = $applicationEndpoint.application_uuid
+AND    charm_relation_uuid = $applicationEndpoint.charm_relation_uuid
+`, appEndpoint)
+	if err != nil {
+		return errors.Errorf("preparing application endpoint query: %w", err)
+	}
+	err = tx.Query(ctx, appEndpointStmt, appEndpoint).Get(&appEndpoint)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		if err := st.insertApplicationEndpoint(ctx, tx, &appEndpoint); err != nil {
+			return errors.Capture(err)
+		}
+	} else if err != nil {
+		return errors.Errorf("getting application endpoint: %w", err)
+	}
+
+	relationEndpointUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating relation endpoint uuid: %w", err)
+	}
+	relEndpoint := relationEndpoint{
+		UUID:         relationEndpointUUID.String(),
+		RelationUUID: relationUUID,
+		EndpointUUID: appEndpoint.UUID,
+	}
+	relEndpointStmt, err := st.Prepare(`
+INSERT INTO relation_endpoint (*) VALUES ($relationEndpoint.*)
+`, relEndpoint)
+	if err != nil {
+		return errors.Errorf("preparing insert relation endpoint statement: %w", err)
+	}
+	if err := tx.Query(ctx, relEndpointStmt, relEndpoint).Run(); err != nil {
+		return errors.Errorf("inserting relation endpoint: %w", err)
+	}
+	return nil
+}
+
+// insertApplicationEndpoint inserts the input application endpoint, bound to
+// the alpha space, setting its UUID.
+func (st *State) insertApplicationEndpoint(ctx context.Context, tx *sqlair.TX, appEndpoint *applicationEndpoint) error {
+	endpointUUID, err := uuid.NewUUID()
+	if err != nil {
+		return errors.Errorf("generating application endpoint uuid: %w", err)
+	}
+	appEndpoint.UUID = endpointUUID.String()
+	appEndpoint.SpaceUUID = network.AlphaSpaceId
+
+	stmt, err := st.Prepare(`
+INSERT INTO application_endpoint (*) VALUES ($applicationEndpoint.*)
+`, *appEndpoint)
+	if err != nil {
+		return errors.Errorf("preparing insert application endpoint statement: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, *appEndpoint).Run(); err != nil {
+		return errors.Errorf("inserting application endpoint: %w", err)
+	}
+	return nil
+}
+
+// GetRelationEndpointBindings returns the space each endpoint of the relation
+// is bound to, keyed by the application and endpoint names, with space UUIDs
+// as values.
+// If the relation doesn't exist, an error satisfying
+// [relationerrors.RelationNotFound] is returned.
+func (st *State) GetRelationEndpointBindings(ctx context.Context, relUUID string) (map[relation.EndpointIdentifier]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	stmt, err := st.Prepare(`
+SELECT    a.name AS &endpointBinding.application_name,
+          cr.name AS &endpointBinding.endpoint_name,
+          s.uuid AS &endpointBinding.space_uuid
+FROM      relation_endpoint AS re
+JOIN      application_endpoint AS ae ON ae.uuid = re.endpoint_uuid
+JOIN      application AS a ON a.uuid = ae.application_uuid
+JOIN      charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+JOIN      space AS s ON s.uuid = ae.space_uuid
+WHERE     re.relation_uuid = $relationUUID.uuid
+`, rel, endpointBinding{})
+	if err != nil {
+		return nil, errors.Errorf("preparing relation endpoint bindings query: %w", err)
+	}
+
+	var bindings []endpointBinding
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if err := st.checkRelationExists(ctx, tx, relUUID); err != nil {
+			return errors.Capture(err)
+		}
+
+		err := tx.Query(ctx, stmt, rel).GetAll(&bindings)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation endpoint bindings: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	result := make(map[relation.EndpointIdentifier]string, len(bindings))
+	for _, b := range bindings {
+		result[relation.EndpointIdentifier{
+			ApplicationName: b.ApplicationName,
+			EndpointName:    b.EndpointName,
+		}] = b.SpaceUUID
+	}
+	return result, nil
+}
+
+// GetRelationLifeSuspendedStatus returns the life and suspended status of
+// the relation, along with its key.
+// If the relation doesn't exist, an error satisfying
+// [relationerrors.RelationNotFound] is returned.
+func (st *State) GetRelationLifeSuspendedStatus(ctx context.Context, relUUID string) (relation.LifeSuspendedStatus, error) {
+	db, err := st.DB()
+	if err != nil {
+		return relation.LifeSuspendedStatus{}, errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	statusStmt, err := st.Prepare(`
+SELECT    r.life_id AS &relationLifeStatus.life_id,
+          rst.name AS &relationLifeStatus.status,
+          rs.suspended_reason AS &relationLifeStatus.suspended_reason
+FROM      relation AS r
+LEFT JOIN relation_status AS rs ON rs.relation_uuid = r.uuid
+LEFT JOIN relation_status_type AS rst ON rst.id = rs.relation_status_type_id
+WHERE     r.uuid = $relationUUID.uuid
+`, rel, relationLifeStatus{})
+	if err != nil {
+		return relation.LifeSuspendedStatus{}, errors.Errorf("preparing relation life and status query: %w", err)
+	}
+
+	endpointsStmt, err := st.Prepare(`
+SELECT    a.name AS &relationEndpointName.application_name,
+          cr.name AS &relationEndpointName.endpoint_name,
+          crr.name AS &relationEndpointName.role
+FROM      relation_endpoint AS re
+JOIN      application_endpoint AS ae ON ae.uuid = re.endpoint_uuid
+JOIN      application AS a ON a.uuid = ae.application_uuid
+JOIN      charm_relation AS cr ON cr.uuid = ae.charm_relation_uuid
+JOIN      charm_relation_role AS crr ON crr.id = cr.role_id
+WHERE     re.relation_uuid = $relationUUID.uuid
+`, rel, relationEndpointName{})
+	if err != nil {
+		return relation.LifeSuspendedStatus{}, errors.Errorf("preparing relation endpoints query: %w", err)
+	}
+
+	var (
+		status    relationLifeStatus
+		endpoints []relationEndpointName
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, statusStmt, rel).Get(&status)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w: %q", relationerrors.RelationNotFound, relUUID)
+		} else if err != nil {
+			return errors.Errorf("getting relation life and status: %w", err)
+		}
+
+		err = tx.Query(ctx, endpointsStmt, rel).GetAll(&endpoints)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("getting relation endpoints: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return relation.LifeSuspendedStatus{}, errors.Capture(err)
+	}
+
+	relStatus := corerelation.Status(status.Status.String)
+	return relation.LifeSuspendedStatus{
+		Key:             relationKey(endpoints),
+		Life:            status.Life.Value(),
+		Suspended:       relStatus == corerelation.Suspending || relStatus == corerelation.Suspended,
+		SuspendedReason: status.SuspendedReason.String,
+	}, nil
+}
+
+// endpointRoleOrder orders the endpoints of a relation key by role, as done
+// for relation keys held in mongo.
+var endpointRoleOrder = map[charm.RelationRole]int{
+	charm.RoleRequirer: 0,
+	charm.RoleProvider: 1,
+	charm.RolePeer:     2,
+}
+
+// relationKey returns the key of a relation with the input endpoints, made
+// up of the endpoints ordered by role and then name, separated by spaces.
+func relationKey(endpoints []relationEndpointName) string {
+	sort.Slice(endpoints, func(i, j int) bool {
+		ep1, ep2 := endpoints[i], endpoints[j]
+		if ep1.Role != ep2.Role {
+			return endpointRoleOrder[charm.RelationRole(ep1.Role)] < endpointRoleOrder[charm.RelationRole(ep2.Role)]
+		}
+		return ep1.ApplicationName+":"+ep1.EndpointName < ep2.ApplicationName+":"+ep2.EndpointName
+	})
+	names := make([]string, len(endpoints))
+	for i, ep := range endpoints {
+		names[i] = ep.ApplicationName + ":" + ep.EndpointName
+	}
+	return strings.Join(names, " ")
+}
+
+func (st *State) deleteRelationUnit(ctx context.Context, tx *sqlair.TX, uuid string) error {
+	ru := relationUUID{UUID: uuid}
+	deleteSettingsStmt, err := st.Prepare(`
+DELETE FROM relation_unit_setting
+WHERE relation_unit_uuid = $relationUUID.uuid
+`, ru)
+	if err != nil {
+		return errors.Errorf("preparing delete relation unit settings statement: %w", err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM relation_unit
+WHERE uuid = $relationUUID.uuid
+`, ru)
+	if err != nil {
+		return errors.Errorf("preparing delete relation unit statement: %w", err)
+	}
+
+	if err := tx.Query(ctx, deleteSettingsStmt, ru).Run(); err != nil {
+		return errors.Errorf("deleting settings of relation unit %q: %w", uuid, err)
+	}
+	if err := tx.Query(ctx, deleteStmt, ru).Run(); err != nil {
+		return errors.Errorf("deleting relation unit %q: %w", uuid, err)
+	}
+	return nil
+}
+
+// DeleteRelation removes a dead relation, along with its endpoints, settings,
+// status and status history.
+// The following errors may be returned:
+// - [relationerrors.RelationNotFound] if the relation doesn't exist.
+// - [relationerrors.RelationNotDead] if the relation is not dead.
+// - [relationerrors.RelationHasUnits] if units are still in the relation.
+func (st *State) DeleteRelation(ctx context.Context, relUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	rel := relationUUID{UUID: relUUID}
+	removalStmt, err := st.Prepare(`
+SELECT    r.life_id AS &relationRemoval.life_id,
+          COUNT(ru.uuid) AS &relationRemoval.unit_count
+FROM      relation AS r
+LEFT JOIN relation_unit AS ru ON ru.relation_uuid = r.uuid
+WHERE     r.uuid = $relationUUID.uuid
+GROUP BY  r.uuid
+`, rel, relationRemoval{})
+	if err != nil {
+		return errors.Errorf("preparing relation removal query: %w", err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var removal relationRemoval
+		err := tx.Query(ctx, removalStmt, rel).Get(&removal)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("%w: %q", relationerrors.RelationNotFound, relUUID)
+		} else if err != nil {
+			return errors.Errorf("getting relation life and units: %w", err)
+		}
+		if removal.Life != life.Dead {
+			return errors.Errorf("%w: %q", relationerrors.RelationNotDead, relUUID)
+		}
+		if removal.UnitCount > 0 {
+			return errors.Errorf("%w: %q has %d unit(s)", relationerrors.RelationHasUnits, relUUID, removal.UnitCount)
+		}
+		return st.deleteRelation(ctx, tx, relUUID)
+	})
+}
+
+// deleteRelation removes the relation along with everything that references
+// it, other than its relation units.
+func (st *State) deleteRelation(ctx context.Context, tx *sqlair.TX, uuid string) error {
+	rel := relationUUID{UUID: uuid}
+	settingsStmt, err := st.Prepare(`
+DELETE FROM relation_application_setting
+WHERE relation_endpoint_uuid IN (
+    SELECT uuid FROM relation_endpoint WHERE relation_uuid = $relationUUID.uuid
+)
+`, rel)
+	if err != nil {
+		return errors.Errorf("preparing delete relation application settings statement: %w", err)
+	}
+	if err := tx.Query(ctx, settingsStmt, rel).Run(); err != nil {
+		return errors.Errorf("deleting application settings of relation %q: %w", uuid, err)
+	}
+
+	for _, table := range []string{
+		"relation_endpoint",
+		"relation_status_history",
+		"relation_status",
+	} {
+		stmt, err := st.Prepare(fmt.Sprintf(`
+DELETE FROM %s
+WHERE relation_uuid = $relationUUID.uuid
+`, table), rel)
+		if err != nil {
+			return errors.Errorf("preparing delete from %s statement: %w", table, err)
+		}
+		if err := tx.Query(ctx, stmt, rel).Run(); err != nil {
+			return errors.Errorf("deleting %s of relation %q: %w", table, uuid, err)
+		}
+	}
+
+	stmt, err := st.Prepare(`
+DELETE FROM relation
+WHERE uuid = $relationUUID.uuid
+`, rel)
+	if err != nil {
+		return errors.Errorf("preparing delete relation statement: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, rel).Run(); err != nil {
+		return errors.Errorf("deleting relation %q: %w", uuid, err)
+	}
+	return nil
+}
+
+func (st *State) deleteApplicationEndpoint(ctx context.Context, tx *sqlair.TX, uuid string) error {
+	ep := relationUUID{UUID: uuid}
+	stmt, err := st.Prepare(`
+DELETE FROM application_endpoint
+WHERE uuid = $relationUUID.uuid
+`, ep)
+	if err != nil {
+		return errors.Errorf("preparing delete application endpoint statement: %w", err)
+	}
+	if err := tx.Query(ctx, stmt, ep).Run(); err != nil {
+		return errors.Errorf("deleting application endpoint %q: %w", uuid, err)
+	}
+	return nil
+}
+
+func (st *State) checkRelationExists(ctx context.Context, tx *sqlair.TX, uuid string) error {
+	rel := relationUUID{UUID: uuid}
+	stmt, err := st.Prepare(`
+SELECT &relationUUID.uuid
+FROM   relation
+WHERE  uuid = $relationUUID.uuid
+`, rel)
+	if err != nil {
+		return errors.Errorf("preparing relation exists query: %w", err)
+	}
+
+	err = tx.Query(ctx, stmt, rel).Get(&rel)
+	if errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Errorf("%w: %q", relationerrors.RelationNotFound, uuid)
+	} else if err != nil {
+		return errors.Errorf("checking relation %q exists: %w", uuid, err)
+	}
+	return nil
+}
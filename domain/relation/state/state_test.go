@@ -0,0 +1,670 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	corelife "github.com/juju/juju/core/life"
+	corerelation "github.com/juju/juju/core/relation"
+	"github.com/juju/juju/domain/relation"
+	relationerrors "github.com/juju/juju/domain/relation/errors"
+	schematesting "github.com/juju/juju/domain/schema/testing"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	"github.com/juju/juju/internal/uuid"
+)
+
+type stateSuite struct {
+	schematesting.ModelSuite
+
+	relationUUID string
+}
+
+var _ = gc.Suite(&stateSuite{})
+
+func (s *stateSuite) SetUpTest(c *gc.C) {
+	s.ModelSuite.SetUpTest(c)
+
+	s.relationUUID = s.addRelation(c, 1)
+}
+
+func (s *stateSuite) TestSetRelationStatus(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	now := time.Now().UTC()
+	err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "maintenance",
+		Actor:   "user-admin",
+		Since:   now,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var (
+		status string
+		reason sql.NullString
+	)
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT rst.name, rs.suspended_reason
+FROM relation_status rs
+JOIN relation_status_type rst ON rst.id = rs.relation_status_type_id
+WHERE rs.relation_uuid = ?`, s.relationUUID).Scan(&status, &reason)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, gc.Equals, "suspended")
+	c.Check(reason.String, gc.Equals, "maintenance")
+}
+
+func (s *stateSuite) TestSetRelationStatusRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetRelationStatus(context.Background(), "foo", relation.StatusInfo{
+		Status: corerelation.Joined,
+		Since:  time.Now(),
+	})
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *stateSuite) TestSetRelationStatusNotValid(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Status("bogus"),
+		Since:  time.Now(),
+	})
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationStatusNotValid)
+}
+
+func (s *stateSuite) TestGetRelationStatusSuspended(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "maintenance",
+		Actor:   "user-admin",
+		Since:   now,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	status, err := st.GetRelationStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status.Status, gc.Equals, corerelation.Suspended)
+	c.Check(status.Message, gc.Equals, "maintenance")
+	c.Check(status.SuspendedBy, gc.Equals, "user-admin")
+	c.Assert(status.SuspendedSince, gc.NotNil)
+	c.Check(status.SuspendedSince.Equal(now), jc.IsTrue)
+}
+
+func (s *stateSuite) TestGetRelationStatusResumed(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "maintenance",
+		Actor:   "user-admin",
+		Since:   now,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Joined,
+		Actor:  "user-admin",
+		Since:  now.Add(time.Minute),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	status, err := st.GetRelationStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status.Status, gc.Equals, corerelation.Joined)
+	c.Check(status.SuspendedBy, gc.Equals, "")
+	c.Check(status.SuspendedSince, gc.IsNil)
+	c.Check(status.Since.Equal(now.Add(time.Minute)), jc.IsTrue)
+}
+
+func (s *stateSuite) TestGetRelationStatusRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetRelationStatus(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *stateSuite) TestGetRelationStatusHistory(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	statuses := []corerelation.Status{
+		corerelation.Joined,
+		corerelation.Suspended,
+		corerelation.Broken,
+	}
+	for i, status := range statuses {
+		err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+			Status:  status,
+			Message: status.String(),
+			Actor:   "unit-foo-0",
+			Since:   now.Add(time.Duration(i) * time.Minute),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	history, err := st.GetRelationStatusHistory(context.Background(), s.relationUUID, 2)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(history, gc.HasLen, 2)
+	c.Check(history[0].Status, gc.Equals, corerelation.Broken)
+	c.Check(history[0].Message, gc.Equals, "broken")
+	c.Check(history[0].Actor, gc.Equals, "unit-foo-0")
+	c.Check(history[0].Since.Equal(now.Add(2*time.Minute)), jc.IsTrue)
+	c.Check(history[1].Status, gc.Equals, corerelation.Suspended)
+}
+
+func (s *stateSuite) TestGetRelationStatusHistoryEmpty(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	history, err := st.GetRelationStatusHistory(context.Background(), s.relationUUID, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetRelationStatusHistoryRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetRelationStatusHistory(context.Background(), "foo", 10)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *stateSuite) TestPruneRelationStatusHistory(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	now := time.Now().UTC()
+	for _, since := range []time.Time{now.Add(-2 * time.Hour), now.Add(-time.Hour), now} {
+		err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+			Status: corerelation.Joined,
+			Since:  since,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	pruned, err := st.PruneRelationStatusHistory(context.Background(), now.Add(-30*time.Minute))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(pruned, gc.Equals, int64(2))
+
+	history, err := st.GetRelationStatusHistory(context.Background(), s.relationUUID, 10)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(history, gc.HasLen, 1)
+}
+
+func (s *stateSuite) TestDeleteRelation(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	s.addEndpoints(c)
+	s.addRelationEndpoints(c, s.relationUUID)
+	for _, status := range []corerelation.Status{corerelation.Joined, corerelation.Broken} {
+		err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+			Status: status,
+			Since:  time.Now(),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `
+INSERT INTO relation_application_setting (relation_endpoint_uuid, "key", value)
+SELECT uuid, 'foo', 'bar' FROM relation_endpoint WHERE relation_uuid = ?`, s.relationUUID); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, `UPDATE relation SET life_id = 2 WHERE uuid = ?`, s.relationUUID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.DeleteRelation(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, query := range []string{
+		`SELECT COUNT(*) FROM relation WHERE uuid = ?`,
+		`SELECT COUNT(*) FROM relation_endpoint WHERE relation_uuid = ?`,
+		`SELECT COUNT(*) FROM relation_status WHERE relation_uuid = ?`,
+		`SELECT COUNT(*) FROM relation_status_history WHERE relation_uuid = ?`,
+	} {
+		var count int
+		err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+			return tx.QueryRowContext(ctx, query, s.relationUUID).Scan(&count)
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(count, gc.Equals, 0, gc.Commentf(query))
+	}
+	var settings int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM relation_application_setting`).Scan(&settings)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(settings, gc.Equals, 0)
+}
+
+func (s *stateSuite) TestDeleteRelationNotDead(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteRelation(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotDead)
+}
+
+func (s *stateSuite) TestDeleteRelationHasUnits(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	s.addRelationInconsistencies(c)
+
+	err := st.DeleteRelation(context.Background(), "rel-3-uuid")
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationHasUnits)
+}
+
+func (s *stateSuite) TestDeleteRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.DeleteRelation(context.Background(), "foo")
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *stateSuite) TestCheckRelationConsistency(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	s.addRelationInconsistencies(c)
+
+	// Without repair, all the inconsistencies are reported and left alone.
+	inconsistencies, err := st.CheckRelationConsistency(context.Background(), false)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(inconsistencies, jc.SameContents, []relation.Inconsistency{{
+		Kind:    relation.DeadUnitInRelation,
+		UUID:    "dead-unit-ru-uuid",
+		Message: `dead unit "app/2" is still in relation 2`,
+	}, {
+		Kind:    relation.DeadRelationWithUnits,
+		UUID:    "in-scope-ru-uuid",
+		Message: `dead relation 3 still has unit "app/0"`,
+	}, {
+		Kind:    relation.DeadRelationWithUnits,
+		UUID:    "departed-ru-uuid",
+		Message: `dead relation 3 still has unit "app/1"`,
+	}, {
+		Kind:    relation.RelationWithoutEndpoints,
+		UUID:    s.relationUUID,
+		Message: "relation 1 has no endpoints",
+	}, {
+		Kind:    relation.StaleApplicationEndpoint,
+		UUID:    "stale-endpoint-uuid",
+		Message: `endpoint "old" of application "app" is not defined by its charm`,
+	}, {
+		Kind:    relation.StaleApplicationEndpoint,
+		UUID:    "stale-used-endpoint-uuid",
+		Message: `endpoint "old-used" of application "app" is not defined by its charm`,
+	}})
+
+	// With repair, only the clearly orphaned records are removed.
+	inconsistencies, err = st.CheckRelationConsistency(context.Background(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	repaired := make(map[string]bool)
+	for _, inconsistency := range inconsistencies {
+		repaired[inconsistency.UUID] = inconsistency.Repaired
+	}
+	c.Check(repaired, jc.DeepEquals, map[string]bool{
+		"dead-unit-ru-uuid":        true,
+		"in-scope-ru-uuid":         false,
+		"departed-ru-uuid":         true,
+		s.relationUUID:             true,
+		"stale-endpoint-uuid":      true,
+		"stale-used-endpoint-uuid": false,
+	})
+
+	inconsistencies, err = st.CheckRelationConsistency(context.Background(), false)
+	c.Assert(err, jc.ErrorIsNil)
+	var remaining []string
+	for _, inconsistency := range inconsistencies {
+		remaining = append(remaining, inconsistency.UUID)
+	}
+	c.Check(remaining, jc.SameContents, []string{"in-scope-ru-uuid", "stale-used-endpoint-uuid"})
+}
+
+func (s *stateSuite) TestCheckRelationConsistencyNone(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM relation`)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	inconsistencies, err := st.CheckRelationConsistency(context.Background(), true)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(inconsistencies, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestImportRelation(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ImportRelation(context.Background(), uuid.MustNewUUID().String(), 7, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.relationSequence(c), gc.Equals, 8)
+
+	// Importing an ID beyond the sequence advances it again.
+	err = st.ImportRelation(context.Background(), uuid.MustNewUUID().String(), 12, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.relationSequence(c), gc.Equals, 13)
+}
+
+func (s *stateSuite) TestImportRelationBelowSequence(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.ImportRelation(context.Background(), uuid.MustNewUUID().String(), 12, nil)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// A lower ID is imported without moving the sequence backwards.
+	relUUID := uuid.MustNewUUID().String()
+	err = st.ImportRelation(context.Background(), relUUID, 5, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(s.relationSequence(c), gc.Equals, 13)
+
+	var id int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `SELECT relation_id FROM relation WHERE uuid = ?`, relUUID).Scan(&id)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(id, gc.Equals, 5)
+}
+
+func (s *stateSuite) TestImportRelationDuplicateID(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	// Relation 1 is added by SetUpTest.
+	err := st.ImportRelation(context.Background(), uuid.MustNewUUID().String(), 1, nil)
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationAlreadyExists)
+	c.Check(err, gc.ErrorMatches, `relation already exists: relation ID 1`)
+}
+
+func (s *stateSuite) TestImportRelationWithEndpoints(c *gc.C) {
+	s.addEndpoints(c)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	relUUID := uuid.MustNewUUID().String()
+	err := st.ImportRelation(context.Background(), relUUID, 7, []relation.EndpointIdentifier{
+		{ApplicationName: "mysql", EndpointName: "db"},
+		{ApplicationName: "logging", EndpointName: "info"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The application endpoints are added in the alpha space.
+	bindings, err := st.GetRelationEndpointBindings(context.Background(), relUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, jc.DeepEquals, map[relation.EndpointIdentifier]string{
+		{ApplicationName: "mysql", EndpointName: "db"}:     "0",
+		{ApplicationName: "logging", EndpointName: "info"}: "0",
+	})
+
+	// A second relation on the same endpoint reuses its application endpoint.
+	err = st.ImportRelation(context.Background(), uuid.MustNewUUID().String(), 8, []relation.EndpointIdentifier{
+		{ApplicationName: "mysql", EndpointName: "db"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	var appEndpoints, relEndpoints int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		if err := tx.QueryRowContext(ctx, `SELECT count(*) FROM application_endpoint`).Scan(&appEndpoints); err != nil {
+			return err
+		}
+		return tx.QueryRowContext(ctx, `SELECT count(*) FROM relation_endpoint`).Scan(&relEndpoints)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(appEndpoints, gc.Equals, 2)
+	c.Check(relEndpoints, gc.Equals, 3)
+}
+
+func (s *stateSuite) TestImportRelationEndpointNotFound(c *gc.C) {
+	s.addEndpoints(c)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	relUUID := uuid.MustNewUUID().String()
+	err := st.ImportRelation(context.Background(), relUUID, 7, []relation.EndpointIdentifier{
+		{ApplicationName: "mysql", EndpointName: "db"},
+		{ApplicationName: "logging", EndpointName: "missing"},
+	})
+	c.Assert(err, jc.ErrorIs, relationerrors.RelationEndpointNotFound)
+
+	// Nothing is imported.
+	err = st.ImportRelation(context.Background(), relUUID, 7, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) relationSequence(c *gc.C) int {
+	var seq int
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `SELECT sequence FROM relation_sequence`).Scan(&seq)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return seq
+}
+
+func (s *stateSuite) TestGetRelationEndpointBindings(c *gc.C) {
+	s.addEndpoints(c)
+	s.addRelationEndpoints(c, s.relationUUID)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	bindings, err := st.GetRelationEndpointBindings(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, jc.DeepEquals, map[relation.EndpointIdentifier]string{
+		{ApplicationName: "mysql", EndpointName: "db"}:     "0",
+		{ApplicationName: "logging", EndpointName: "info"}: "space-uuid",
+	})
+}
+
+func (s *stateSuite) TestGetRelationEndpointBindingsSameEndpointName(c *gc.C) {
+	s.addEndpoints(c)
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		stmts := []string{
+			`INSERT INTO space (uuid, name) VALUES ('space-uuid', 'beta')`,
+			`INSERT INTO charm (uuid, reference_name, architecture_id) VALUES ('wordpress-charm-uuid', 'wordpress', 0)`,
+			`INSERT INTO charm_metadata (charm_uuid, name) VALUES ('wordpress-charm-uuid', 'wordpress')`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name, role_id, interface, scope_id) VALUES ('wordpress-db-uuid', 'wordpress-charm-uuid', 1, 'db', 'db', 1, 'mysql', 0)`,
+			`INSERT INTO application (uuid, name, life_id, charm_uuid) VALUES ('wordpress-uuid', 'wordpress', 0, 'wordpress-charm-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('mysql-endpoint-uuid', 'mysql-uuid', '0', 'mysql-db-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('wordpress-endpoint-uuid', 'wordpress-uuid', 'space-uuid', 'wordpress-db-uuid')`,
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		for _, epUUID := range []string{"mysql-endpoint-uuid", "wordpress-endpoint-uuid"} {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES (?, ?, ?)`,
+				uuid.MustNewUUID().String(), s.relationUUID, epUUID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	// Both endpoints are called db, but each keeps its own space.
+	bindings, err := st.GetRelationEndpointBindings(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, jc.DeepEquals, map[relation.EndpointIdentifier]string{
+		{ApplicationName: "mysql", EndpointName: "db"}:     "0",
+		{ApplicationName: "wordpress", EndpointName: "db"}: "space-uuid",
+	})
+}
+
+func (s *stateSuite) TestGetRelationEndpointBindingsNoEndpoints(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	bindings, err := st.GetRelationEndpointBindings(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(bindings, gc.HasLen, 0)
+}
+
+func (s *stateSuite) TestGetRelationEndpointBindingsRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetRelationEndpointBindings(context.Background(), uuid.MustNewUUID().String())
+	c.Check(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+func (s *stateSuite) TestGetRelationLifeSuspendedStatus(c *gc.C) {
+	s.addEndpoints(c)
+	s.addRelationEndpoints(c, s.relationUUID)
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	status, err := st.GetRelationLifeSuspendedStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, jc.DeepEquals, relation.LifeSuspendedStatus{
+		Key:  "logging:info mysql:db",
+		Life: corelife.Alive,
+	})
+}
+
+func (s *stateSuite) TestGetRelationLifeSuspendedStatusSuspended(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	err := st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status:  corerelation.Suspended,
+		Message: "maintenance",
+		Since:   time.Now(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `UPDATE relation SET life_id = 1 WHERE uuid = ?`, s.relationUUID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	status, err := st.GetRelationLifeSuspendedStatus(context.Background(), s.relationUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, jc.DeepEquals, relation.LifeSuspendedStatus{
+		Life:            corelife.Dying,
+		Suspended:       true,
+		SuspendedReason: "maintenance",
+	})
+}
+
+func (s *stateSuite) TestGetRelationLifeSuspendedStatusRelationNotFound(c *gc.C) {
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+
+	_, err := st.GetRelationLifeSuspendedStatus(context.Background(), uuid.MustNewUUID().String())
+	c.Check(err, jc.ErrorIs, relationerrors.RelationNotFound)
+}
+
+// addRelationEndpoints binds the mysql db endpoint to the alpha space and the
+// logging info endpoint to a new beta space, and adds both to the relation.
+func (s *stateSuite) addRelationEndpoints(c *gc.C, relUUID string) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		stmts := []string{
+			`INSERT INTO space (uuid, name) VALUES ('space-uuid', 'beta')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('mysql-endpoint-uuid', 'mysql-uuid', '0', 'mysql-db-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('logging-endpoint-uuid', 'logging-uuid', 'space-uuid', 'logging-info-uuid')`,
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		for _, epUUID := range []string{"mysql-endpoint-uuid", "logging-endpoint-uuid"} {
+			if _, err := tx.ExecContext(ctx, `INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES (?, ?, ?)`,
+				uuid.MustNewUUID().String(), relUUID, epUUID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// addEndpoints adds a principal mysql application providing a global db
+// endpoint and a subordinate logging application requiring a container scoped
+// info endpoint.
+func (s *stateSuite) addEndpoints(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		stmts := []string{
+			`INSERT INTO charm (uuid, reference_name, architecture_id) VALUES ('mysql-charm-uuid', 'mysql', 0)`,
+			`INSERT INTO charm_metadata (charm_uuid, name) VALUES ('mysql-charm-uuid', 'mysql')`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name, role_id, interface, scope_id) VALUES ('mysql-db-uuid', 'mysql-charm-uuid', 0, 'db', 'db', 0, 'mysql', 0)`,
+			`INSERT INTO application (uuid, name, life_id, charm_uuid) VALUES ('mysql-uuid', 'mysql', 0, 'mysql-charm-uuid')`,
+			`INSERT INTO charm (uuid, reference_name, architecture_id) VALUES ('logging-charm-uuid', 'logging', 0)`,
+			`INSERT INTO charm_metadata (charm_uuid, name, subordinate) VALUES ('logging-charm-uuid', 'logging', TRUE)`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name, role_id, interface, scope_id) VALUES ('logging-info-uuid', 'logging-charm-uuid', 1, 'info', 'info', 1, 'juju-info', 1)`,
+			`INSERT INTO application (uuid, name, life_id, charm_uuid) VALUES ('logging-uuid', 'logging', 0, 'logging-charm-uuid')`,
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// addRelationInconsistencies adds an application with units and relations
+// exhibiting each kind of inconsistency, in addition to the relation without
+// endpoints added by SetUpTest.
+func (s *stateSuite) addRelationInconsistencies(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		stmts := []string{
+			`INSERT INTO charm (uuid, reference_name, architecture_id) VALUES ('charm-uuid', 'app', 0)`,
+			`INSERT INTO charm (uuid, reference_name, revision, architecture_id) VALUES ('old-charm-uuid', 'app', 1, 0)`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name) VALUES ('db-uuid', 'charm-uuid', 0, 'db', 'db')`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name) VALUES ('old-uuid', 'old-charm-uuid', 0, 'old', 'old')`,
+			`INSERT INTO charm_relation (uuid, charm_uuid, kind_id, "key", name) VALUES ('old-used-uuid', 'old-charm-uuid', 0, 'old-used', 'old-used')`,
+			`INSERT INTO application (uuid, name, life_id, charm_uuid) VALUES ('app-uuid', 'app', 0, 'charm-uuid')`,
+			`INSERT INTO net_node (uuid) VALUES ('node-uuid')`,
+			`INSERT INTO unit (uuid, name, life_id, application_uuid, net_node_uuid) VALUES ('app/0-uuid', 'app/0', 0, 'app-uuid', 'node-uuid')`,
+			`INSERT INTO unit (uuid, name, life_id, application_uuid, net_node_uuid) VALUES ('app/1-uuid', 'app/1', 0, 'app-uuid', 'node-uuid')`,
+			`INSERT INTO unit (uuid, name, life_id, application_uuid, net_node_uuid) VALUES ('app/2-uuid', 'app/2', 2, 'app-uuid', 'node-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('endpoint-uuid', 'app-uuid', '0', 'db-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('stale-endpoint-uuid', 'app-uuid', '0', 'old-uuid')`,
+			`INSERT INTO application_endpoint (uuid, application_uuid, space_uuid, charm_relation_uuid) VALUES ('stale-used-endpoint-uuid', 'app-uuid', '0', 'old-used-uuid')`,
+
+			// An alive relation with a dead unit.
+			`INSERT INTO relation (uuid, life_id, relation_id) VALUES ('rel-2-uuid', 0, 2)`,
+			`INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES ('rel-2-ep-uuid', 'rel-2-uuid', 'endpoint-uuid')`,
+			`INSERT INTO relation_unit (uuid, relation_uuid, unit_uuid, in_scope) VALUES ('alive-unit-ru-uuid', 'rel-2-uuid', 'app/0-uuid', TRUE)`,
+			`INSERT INTO relation_unit (uuid, relation_uuid, unit_uuid, in_scope) VALUES ('dead-unit-ru-uuid', 'rel-2-uuid', 'app/2-uuid', TRUE)`,
+			`INSERT INTO relation_unit_setting (relation_unit_uuid, "key", value) VALUES ('dead-unit-ru-uuid', 'foo', 'bar')`,
+
+			// A dead relation with units, using a stale endpoint.
+			`INSERT INTO relation (uuid, life_id, relation_id) VALUES ('rel-3-uuid', 2, 3)`,
+			`INSERT INTO relation_endpoint (uuid, relation_uuid, endpoint_uuid) VALUES ('rel-3-ep-uuid', 'rel-3-uuid', 'stale-used-endpoint-uuid')`,
+			`INSERT INTO relation_unit (uuid, relation_uuid, unit_uuid, in_scope) VALUES ('in-scope-ru-uuid', 'rel-3-uuid', 'app/0-uuid', TRUE)`,
+			`INSERT INTO relation_unit (uuid, relation_uuid, unit_uuid, in_scope) VALUES ('departed-ru-uuid', 'rel-3-uuid', 'app/1-uuid', FALSE)`,
+		}
+		for _, stmt := range stmts {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Give the relation without endpoints a status, which is removed along
+	// with it.
+	st := NewState(s.TxnRunnerFactory(), loggertesting.WrapCheckLog(c))
+	err = st.SetRelationStatus(context.Background(), s.relationUUID, relation.StatusInfo{
+		Status: corerelation.Joined,
+		Since:  time.Now(),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) addRelation(c *gc.C, id int) string {
+	relUUID := uuid.MustNewUUID().String()
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `INSERT INTO relation (uuid, life_id, relation_id) VALUES (?, 0, ?)`, relUUID, id)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return relUUID
+}
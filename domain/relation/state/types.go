@@ -0,0 +1,133 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/juju/juju/domain/life"
+)
+
+type relationUUID struct {
+	UUID string `db:"uuid"`
+}
+
+type relationStatusType struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+type relationStatus struct {
+	RelationUUID    string       `db:"relation_uuid"`
+	StatusID        string       `db:"relation_status_type_id"`
+	SuspendedReason string       `db:"suspended_reason"`
+	SuspendedBy     string       `db:"suspended_by"`
+	SuspendedAt     sql.NullTime `db:"suspended_at"`
+	UpdatedAt       time.Time    `db:"updated_at"`
+}
+
+type relationStatusInfo struct {
+	Status          string         `db:"status"`
+	SuspendedReason sql.NullString `db:"suspended_reason"`
+	SuspendedBy     sql.NullString `db:"suspended_by"`
+	SuspendedAt     sql.NullTime   `db:"suspended_at"`
+	UpdatedAt       time.Time      `db:"updated_at"`
+}
+
+type relationStatusHistory struct {
+	UUID         string    `db:"uuid"`
+	RelationUUID string    `db:"relation_uuid"`
+	StatusID     string    `db:"relation_status_type_id"`
+	Message      string    `db:"message"`
+	Actor        string    `db:"actor"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+type relationStatusHistoryEntry struct {
+	Status    string    `db:"name"`
+	Message   string    `db:"message"`
+	Actor     string    `db:"actor"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+type historyLimit struct {
+	RelationUUID string `db:"relation_uuid"`
+	Limit        int    `db:"limit"`
+}
+
+type pruneBefore struct {
+	Before time.Time `db:"before"`
+}
+
+type endpointlessRelation struct {
+	UUID       string `db:"uuid"`
+	RelationID int    `db:"relation_id"`
+	UnitCount  int    `db:"unit_count"`
+}
+
+type inconsistentRelationUnit struct {
+	UUID       string `db:"uuid"`
+	RelationID int    `db:"relation_id"`
+	UnitName   string `db:"unit_name"`
+	InScope    bool   `db:"in_scope"`
+}
+
+type staleApplicationEndpoint struct {
+	UUID            string `db:"uuid"`
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+	RelationCount   int    `db:"relation_count"`
+}
+
+type importRelation struct {
+	UUID       string `db:"uuid"`
+	LifeID     int    `db:"life_id"`
+	RelationID int    `db:"relation_id"`
+}
+
+type relationSequence struct {
+	Sequence int `db:"sequence"`
+}
+
+type endpointName struct {
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+}
+
+type applicationEndpoint struct {
+	UUID              string `db:"uuid"`
+	ApplicationUUID   string `db:"application_uuid"`
+	SpaceUUID         string `db:"space_uuid"`
+	CharmRelationUUID string `db:"charm_relation_uuid"`
+}
+
+type relationEndpoint struct {
+	UUID         string `db:"uuid"`
+	RelationUUID string `db:"relation_uuid"`
+	EndpointUUID string `db:"endpoint_uuid"`
+}
+
+type endpointBinding struct {
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+	SpaceUUID       string `db:"space_uuid"`
+}
+
+type relationLifeStatus struct {
+	Life            life.Life      `db:"life_id"`
+	Status          sql.NullString `db:"status"`
+	SuspendedReason sql.NullString `db:"suspended_reason"`
+}
+
+type relationRemoval struct {
+	Life      life.Life `db:"life_id"`
+	UnitCount int       `db:"unit_count"`
+}
+
+type relationEndpointName struct {
+	ApplicationName string `db:"application_name"`
+	EndpointName    string `db:"endpoint_name"`
+	Role            string `db:"role"`
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package relation
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/juju/juju/core/life"
+	corerelation "github.com/juju/juju/core/relation"
+)
+
+// StatusInfo holds the status information for a relation.
+type StatusInfo struct {
+	// Status is the status of the relation.
+	Status corerelation.Status
+
+	// Message is an optional message associated with the status.
+	Message string
+
+	// Actor identifies the entity that caused the status change.
+	Actor string
+
+	// Since is the time at which the status was set.
+	Since time.Time
+}
+
+// RelationStatusInfo holds the current status of a relation, along with who
+// suspended it and when if it is suspended.
+type RelationStatusInfo struct {
+	// Status is the status of the relation.
+	Status corerelation.Status
+
+	// Message is the message associated with the status. For a suspended
+	// relation, this is the reason it was suspended.
+	Message string
+
+	// Since is the time at which the status was set.
+	Since time.Time
+
+	// SuspendedSince is the time at which the relation was suspended, or nil
+	// if it is not suspended.
+	SuspendedSince *time.Time
+
+	// SuspendedBy identifies the entity that suspended the relation. It is
+	// empty if the relation is not suspended.
+	SuspendedBy string
+}
+
+// EndpointIdentifier identifies a relation endpoint by the name of its
+// application and the name of the endpoint.
+type EndpointIdentifier struct {
+	// ApplicationName is the name of the application the endpoint belongs
+	// to.
+	ApplicationName string
+
+	// EndpointName is the name of the endpoint.
+	EndpointName string
+}
+
+// String returns the identifier in the form "application:endpoint".
+func (e EndpointIdentifier) String() string {
+	return fmt.Sprintf("%s:%s", e.ApplicationName, e.EndpointName)
+}
+
+// LifeSuspendedStatus holds the life and suspended status of a relation.
+type LifeSuspendedStatus struct {
+	// Key is the relation key, made up of the relation's endpoints.
+	Key string
+
+	// Life is the life of the relation.
+	Life life.Value
+
+	// Suspended is true if the relation is suspended, or is being
+	// suspended.
+	Suspended bool
+
+	// SuspendedReason is an optional message explaining why the relation
+	// is suspended.
+	SuspendedReason string
+}
+
+// RelationStatusHistoryEntry is a single status transition of a relation.
+type RelationStatusHistoryEntry struct {
+	// Status is the status the relation transitioned to.
+	Status corerelation.Status
+
+	// Message is the message associated with the status.
+	Message string
+
+	// Actor identifies the entity that caused the status change.
+	Actor string
+
+	// Since is the time at which the status was set.
+	Since time.Time
+}
+
+// InconsistencyKind identifies a kind of inconsistency found in the relation
+// records of a model.
+type InconsistencyKind string
+
+const (
+	// RelationWithoutEndpoints is a relation that has no endpoints.
+	RelationWithoutEndpoints InconsistencyKind = "relation-without-endpoints"
+
+	// DeadUnitInRelation is a relation unit record of a unit that is dead.
+	DeadUnitInRelation InconsistencyKind = "dead-unit-in-relation"
+
+	// DeadRelationWithUnits is a relation unit record of a relation that is
+	// dead. A dead relation must not have any remaining relation units.
+	DeadRelationWithUnits InconsistencyKind = "dead-relation-with-units"
+
+	// StaleApplicationEndpoint is an application endpoint for a charm
+	// relation that does not belong to the application's current charm.
+	StaleApplicationEndpoint InconsistencyKind = "stale-application-endpoint"
+)
+
+// Inconsistency describes a single inconsistent record in the relation
+// records of a model.
+type Inconsistency struct {
+	// Kind identifies the kind of inconsistency.
+	Kind InconsistencyKind
+
+	// UUID is the UUID of the inconsistent record. Depending on the kind,
+	// this is a relation, relation unit or application endpoint UUID.
+	UUID string
+
+	// Message describes the inconsistency.
+	Message string
+
+	// Repaired is true if the inconsistent record was removed.
+	Repaired bool
+}
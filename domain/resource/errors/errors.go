@@ -57,4 +57,13 @@ const (
 	// ResourceAlreadyStored describes an errors where the resource has already
 	// been stored.
 	ResourceAlreadyStored = errors.ConstError("resource already found in storage")
+
+	// ResourcePinned describes an error where a resource cannot be advanced to
+	// another revision because it is pinned, as uploaded resources are.
+	ResourcePinned = errors.ConstError("resource pinned")
+
+	// ResourceRevisionPruned describes an error where an earlier revision of
+	// a resource can no longer be used because its blob has been removed from
+	// the resource store.
+	ResourceRevisionPruned = errors.ConstError("resource revision pruned")
 )
@@ -121,6 +121,45 @@ func (c *MockStateDeleteUnitResourcesCall) DoAndReturn(f func(context.Context, u
 	return c
 }
 
+// DowngradeApplicationResource mocks base method.
+func (m *MockState) DowngradeApplicationResource(arg0 context.Context, arg1 resource0.DowngradeApplicationResourceArgs) (resource0.DowngradeApplicationResourceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DowngradeApplicationResource", arg0, arg1)
+	ret0, _ := ret[0].(resource0.DowngradeApplicationResourceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DowngradeApplicationResource indicates an expected call of DowngradeApplicationResource.
+func (mr *MockStateMockRecorder) DowngradeApplicationResource(arg0, arg1 any) *MockStateDowngradeApplicationResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowngradeApplicationResource", reflect.TypeOf((*MockState)(nil).DowngradeApplicationResource), arg0, arg1)
+	return &MockStateDowngradeApplicationResourceCall{Call: call}
+}
+
+// MockStateDowngradeApplicationResourceCall wrap *gomock.Call
+type MockStateDowngradeApplicationResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDowngradeApplicationResourceCall) Return(arg0 resource0.DowngradeApplicationResourceResult, arg1 error) *MockStateDowngradeApplicationResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDowngradeApplicationResourceCall) Do(f func(context.Context, resource0.DowngradeApplicationResourceArgs) (resource0.DowngradeApplicationResourceResult, error)) *MockStateDowngradeApplicationResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDowngradeApplicationResourceCall) DoAndReturn(f func(context.Context, resource0.DowngradeApplicationResourceArgs) (resource0.DowngradeApplicationResourceResult, error)) *MockStateDowngradeApplicationResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetApplicationResourceID mocks base method.
 func (m *MockState) GetApplicationResourceID(arg0 context.Context, arg1 resource0.GetApplicationResourceIDArgs) (resource.UUID, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +199,45 @@ func (c *MockStateGetApplicationResourceIDCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetApplicationStoredResourceBlobs mocks base method.
+func (m *MockState) GetApplicationStoredResourceBlobs(arg0 context.Context, arg1 application.ID) ([]resource0.StoredResourceBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStoredResourceBlobs", arg0, arg1)
+	ret0, _ := ret[0].([]resource0.StoredResourceBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStoredResourceBlobs indicates an expected call of GetApplicationStoredResourceBlobs.
+func (mr *MockStateMockRecorder) GetApplicationStoredResourceBlobs(arg0, arg1 any) *MockStateGetApplicationStoredResourceBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStoredResourceBlobs", reflect.TypeOf((*MockState)(nil).GetApplicationStoredResourceBlobs), arg0, arg1)
+	return &MockStateGetApplicationStoredResourceBlobsCall{Call: call}
+}
+
+// MockStateGetApplicationStoredResourceBlobsCall wrap *gomock.Call
+type MockStateGetApplicationStoredResourceBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationStoredResourceBlobsCall) Return(arg0 []resource0.StoredResourceBlob, arg1 error) *MockStateGetApplicationStoredResourceBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationStoredResourceBlobsCall) Do(f func(context.Context, application.ID) ([]resource0.StoredResourceBlob, error)) *MockStateGetApplicationStoredResourceBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationStoredResourceBlobsCall) DoAndReturn(f func(context.Context, application.ID) ([]resource0.StoredResourceBlob, error)) *MockStateGetApplicationStoredResourceBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetResource mocks base method.
 func (m *MockState) GetResource(arg0 context.Context, arg1 resource.UUID) (resource0.Resource, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +316,45 @@ func (c *MockStateGetResourceTypeCall) DoAndReturn(f func(context.Context, resou
 	return c
 }
 
+// GetStoredResourceBlobs mocks base method.
+func (m *MockState) GetStoredResourceBlobs(arg0 context.Context) ([]resource0.StoredResourceBlob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStoredResourceBlobs", arg0)
+	ret0, _ := ret[0].([]resource0.StoredResourceBlob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStoredResourceBlobs indicates an expected call of GetStoredResourceBlobs.
+func (mr *MockStateMockRecorder) GetStoredResourceBlobs(arg0 any) *MockStateGetStoredResourceBlobsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoredResourceBlobs", reflect.TypeOf((*MockState)(nil).GetStoredResourceBlobs), arg0)
+	return &MockStateGetStoredResourceBlobsCall{Call: call}
+}
+
+// MockStateGetStoredResourceBlobsCall wrap *gomock.Call
+type MockStateGetStoredResourceBlobsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetStoredResourceBlobsCall) Return(arg0 []resource0.StoredResourceBlob, arg1 error) *MockStateGetStoredResourceBlobsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetStoredResourceBlobsCall) Do(f func(context.Context) ([]resource0.StoredResourceBlob, error)) *MockStateGetStoredResourceBlobsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetStoredResourceBlobsCall) DoAndReturn(f func(context.Context) ([]resource0.StoredResourceBlob, error)) *MockStateGetStoredResourceBlobsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListResources mocks base method.
 func (m *MockState) ListResources(arg0 context.Context, arg1 application.ID) (resource0.ApplicationResources, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +470,45 @@ func (c *MockStateSetApplicationResourceCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// SetApplicationResources mocks base method.
+func (m *MockState) SetApplicationResources(arg0 context.Context, arg1 resource0.SetApplicationResourcesArgs) (resource0.SetApplicationResourcesResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetApplicationResources", arg0, arg1)
+	ret0, _ := ret[0].(resource0.SetApplicationResourcesResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetApplicationResources indicates an expected call of SetApplicationResources.
+func (mr *MockStateMockRecorder) SetApplicationResources(arg0, arg1 any) *MockStateSetApplicationResourcesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetApplicationResources", reflect.TypeOf((*MockState)(nil).SetApplicationResources), arg0, arg1)
+	return &MockStateSetApplicationResourcesCall{Call: call}
+}
+
+// MockStateSetApplicationResourcesCall wrap *gomock.Call
+type MockStateSetApplicationResourcesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetApplicationResourcesCall) Return(arg0 resource0.SetApplicationResourcesResult, arg1 error) *MockStateSetApplicationResourcesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetApplicationResourcesCall) Do(f func(context.Context, resource0.SetApplicationResourcesArgs) (resource0.SetApplicationResourcesResult, error)) *MockStateSetApplicationResourcesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetApplicationResourcesCall) DoAndReturn(f func(context.Context, resource0.SetApplicationResourcesArgs) (resource0.SetApplicationResourcesResult, error)) *MockStateSetApplicationResourcesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetRepositoryResources mocks base method.
 func (m *MockState) SetRepositoryResources(arg0 context.Context, arg1 resource0.SetRepositoryResourcesArgs) error {
 	m.ctrl.T.Helper()
@@ -77,6 +77,45 @@ type State interface {
 	// application to the provided values. The current data for this
 	// application/resource combination will be overwritten.
 	SetRepositoryResources(ctx context.Context, config resource.SetRepositoryResourcesArgs) error
+
+	// SetApplicationResources advances the available resources of the
+	// application to the given revisions in a single transaction, adding a
+	// new resource for each revision to be fetched.
+	//
+	// The following error types can be expected to be returned:
+	//   - [resourceerrors.ApplicationNotFound] if the application does not
+	//     exist.
+	//   - [resourceerrors.ResourceNotFound] if any of the resources is not in
+	//     the current charm metadata, or is not available to the application.
+	//   - [resourceerrors.ResourcePinned] if any of the resources is pinned.
+	SetApplicationResources(ctx context.Context, args resource.SetApplicationResourcesArgs) (resource.SetApplicationResourcesResult, error)
+
+	// DowngradeApplicationResource makes an earlier revision of the named
+	// resource the one in use by the application.
+	//
+	// The following error types can be expected to be returned:
+	//   - [resourceerrors.ApplicationNotFound] if the application does not
+	//     exist.
+	//   - [resourceerrors.ResourceNotFound] if the resource is not in the
+	//     current charm metadata, is not available to the application, or the
+	//     revision is not held for the application.
+	//   - [resourceerrors.ArgumentNotValid] if the revision is not earlier
+	//     than the one in use, or is not compatible with the current charm.
+	//   - [resourceerrors.ResourceRevisionPruned] if the blob of the revision
+	//     is no longer in the resource store.
+	DowngradeApplicationResource(ctx context.Context, args resource.DowngradeApplicationResourceArgs) (resource.DowngradeApplicationResourceResult, error)
+
+	// GetApplicationStoredResourceBlobs returns the blobs held for the
+	// resources of the input application.
+	//
+	// The following error types can be expected to be returned:
+	//   - [resourceerrors.ApplicationNotFound] if the application does not
+	//     exist.
+	GetApplicationStoredResourceBlobs(ctx context.Context, applicationID coreapplication.ID) ([]resource.StoredResourceBlob, error)
+
+	// GetStoredResourceBlobs returns the blobs held for the resources of all
+	// applications in the model.
+	GetStoredResourceBlobs(ctx context.Context) ([]resource.StoredResourceBlob, error)
 }
 
 type ResourceStoreGetter interface {
@@ -408,3 +447,105 @@ func (s *Service) SetRepositoryResources(
 	}
 	return s.st.SetRepositoryResources(ctx, args)
 }
+
+// SetApplicationResources advances several resources of an application to the
+// given revisions, keyed by resource name, in a single transaction. Either all
+// of the resources advance or none do, so resources which must be kept at
+// compatible revisions are never seen out of step. Every resource is
+// validated against the metadata of the application's current charm before
+// any is changed. The result reports which resources changed revision and
+// which were already at the requested revision. Each resource that changes
+// revision is replaced by a new one whose blob is fetched when first opened.
+//
+// The following error types can be expected to be returned:
+//   - [coreerrors.NotValid] is returned if the Application ID is not valid.
+//   - [resourceerrors.ArgumentNotValid] is returned if no revisions are given.
+//   - [resourceerrors.ResourceNameNotValid] if any resource name is empty.
+//   - [resourceerrors.ArgumentNotValid] is returned if any revision is
+//     negative.
+//   - [resourceerrors.ApplicationNotFound] if the specified application does
+//     not exist.
+//   - [resourceerrors.ResourceNotFound] if any of the resources is not in the
+//     current charm metadata, or is not available to the application.
+//   - [resourceerrors.ResourcePinned] if any of the resources is pinned, as
+//     uploaded resources are, and so cannot be advanced.
+func (s *Service) SetApplicationResources(
+	ctx context.Context,
+	applicationID coreapplication.ID,
+	revisions map[string]int,
+) (resource.SetApplicationResourcesResult, error) {
+	if err := applicationID.Validate(); err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Errorf("application id: %w", err)
+	}
+	if len(revisions) == 0 {
+		return resource.SetApplicationResourcesResult{}, errors.Errorf("no revisions: %w", resourceerrors.ArgumentNotValid)
+	}
+	for name, revision := range revisions {
+		if name == "" {
+			return resource.SetApplicationResourcesResult{}, resourceerrors.ResourceNameNotValid
+		}
+		if revision < 0 {
+			return resource.SetApplicationResourcesResult{}, errors.Errorf(
+				"resource %q revision %d: %w", name, revision, resourceerrors.ArgumentNotValid)
+		}
+	}
+
+	result, err := s.st.SetApplicationResources(ctx, resource.SetApplicationResourcesArgs{
+		ApplicationID: applicationID,
+		Revisions:     revisions,
+	})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Errorf("setting application resources: %w", err)
+	}
+	return result, nil
+}
+
+// DowngradeApplicationResource rolls the named resource of an application
+// back to an earlier revision which is still held for the application. It is
+// kept apart from SetApplicationResources so that a rollback is always an
+// explicit request, and each one is logged. The revision must be compatible
+// with the application's current charm, and its blob must not have been
+// pruned from the resource store.
+//
+// The following error types can be expected to be returned:
+//   - [coreerrors.NotValid] is returned if the Application ID is not valid.
+//   - [resourceerrors.ResourceNameNotValid] if the resource name is empty.
+//   - [resourceerrors.ArgumentNotValid] is returned if the revision is
+//     negative, is not earlier than the revision in use, or is not
+//     compatible with the current charm.
+//   - [resourceerrors.ApplicationNotFound] if the specified application does
+//     not exist.
+//   - [resourceerrors.ResourceNotFound] if the resource is not in the current
+//     charm metadata, is not available to the application, or the revision is
+//     not held for the application.
+//   - [resourceerrors.ResourceRevisionPruned] if the blob of the revision is
+//     no longer in the resource store, in which case the revision must be
+//     uploaded again.
+func (s *Service) DowngradeApplicationResource(
+	ctx context.Context,
+	applicationID coreapplication.ID,
+	resourceName string,
+	toRevision int,
+) error {
+	if err := applicationID.Validate(); err != nil {
+		return errors.Errorf("application id: %w", err)
+	}
+	if resourceName == "" {
+		return resourceerrors.ResourceNameNotValid
+	}
+	if toRevision < 0 {
+		return errors.Errorf("resource %q revision %d: %w", resourceName, toRevision, resourceerrors.ArgumentNotValid)
+	}
+
+	result, err := s.st.DowngradeApplicationResource(ctx, resource.DowngradeApplicationResourceArgs{
+		ApplicationID: applicationID,
+		Name:          resourceName,
+		Revision:      toRevision,
+	})
+	if err != nil {
+		return errors.Errorf("downgrading application resource: %w", err)
+	}
+	s.logger.Infof("downgraded resource %q of application %q from revision %d to %d",
+		resourceName, applicationID, result.FromRevision, result.ToRevision)
+	return nil
+}
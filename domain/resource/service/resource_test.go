@@ -611,6 +611,60 @@ func (s *resourceServiceSuite) TestSetRepositoryResources(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *resourceServiceSuite) TestSetApplicationResources(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	revisions := map[string]int{
+		"db-schema": 4,
+		"db-image":  7,
+	}
+	expected := resource.SetApplicationResourcesResult{
+		Changed: []string{"db-image"},
+		Current: []string{"db-schema"},
+	}
+	s.state.EXPECT().SetApplicationResources(gomock.Any(), resource.SetApplicationResourcesArgs{
+		ApplicationID: appID,
+		Revisions:     revisions,
+	}).Return(expected, nil)
+
+	result, err := s.service.SetApplicationResources(context.Background(), appID, revisions)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.DeepEquals, expected)
+}
+
+func (s *resourceServiceSuite) TestSetApplicationResourcesBadID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.SetApplicationResources(context.Background(), "", map[string]int{"db-image": 1})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *resourceServiceSuite) TestSetApplicationResourcesNoRevisions(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.SetApplicationResources(context.Background(), applicationtesting.GenApplicationUUID(c), nil)
+	c.Assert(err, jc.ErrorIs, resourceerrors.ArgumentNotValid)
+}
+
+func (s *resourceServiceSuite) TestSetApplicationResourcesNegativeRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.SetApplicationResources(context.Background(), applicationtesting.GenApplicationUUID(c), map[string]int{"db-image": -1})
+	c.Assert(err, jc.ErrorIs, resourceerrors.ArgumentNotValid)
+}
+
+func (s *resourceServiceSuite) TestSetApplicationResourcesPinned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().SetApplicationResources(gomock.Any(), gomock.Any()).Return(
+		resource.SetApplicationResourcesResult{}, resourceerrors.ResourcePinned)
+
+	_, err := s.service.SetApplicationResources(context.Background(), appID, map[string]int{"db-image": 1})
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourcePinned)
+}
+
 func (s *resourceServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	ctrl := gomock.NewController(c)
 
@@ -622,3 +676,45 @@ func (s *resourceServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
 
 	return ctrl
 }
+
+func (s *resourceServiceSuite) TestDowngradeApplicationResource(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().DowngradeApplicationResource(gomock.Any(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: appID,
+		Name:          "db-image",
+		Revision:      1,
+	}).Return(resource.DowngradeApplicationResourceResult{
+		FromRevision: 3,
+		ToRevision:   1,
+	}, nil)
+
+	err := s.service.DowngradeApplicationResource(context.Background(), appID, "db-image", 1)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *resourceServiceSuite) TestDowngradeApplicationResourceBadName(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.DowngradeApplicationResource(context.Background(), applicationtesting.GenApplicationUUID(c), "", 1)
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourceNameNotValid)
+}
+
+func (s *resourceServiceSuite) TestDowngradeApplicationResourceNegativeRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.DowngradeApplicationResource(context.Background(), applicationtesting.GenApplicationUUID(c), "db-image", -1)
+	c.Assert(err, jc.ErrorIs, resourceerrors.ArgumentNotValid)
+}
+
+func (s *resourceServiceSuite) TestDowngradeApplicationResourcePruned(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	appID := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().DowngradeApplicationResource(gomock.Any(), gomock.Any()).Return(
+		resource.DowngradeApplicationResourceResult{}, resourceerrors.ResourceRevisionPruned)
+
+	err := s.service.DowngradeApplicationResource(context.Background(), appID, "db-image", 1)
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourceRevisionPruned)
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	coreapplication "github.com/juju/juju/core/application"
+	"github.com/juju/juju/domain/resource"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/errors"
+)
+
+// GetApplicationResourceUsage returns the object store space consumed by the
+// stored resources of the given application. Blobs used by several revisions
+// of the application's resources are counted once, and blobs that are also
+// used by other applications are reported in SharedBytes.
+//
+// The following error types can be expected to be returned:
+//   - [coreerrors.NotValid] is returned if the application ID is not valid.
+//   - [resourceerrors.ApplicationNotFound] when the specified application
+//     does not exist.
+func (s *Service) GetApplicationResourceUsage(
+	ctx context.Context,
+	applicationID coreapplication.ID,
+) (resource.ApplicationResourceUsage, error) {
+	if err := applicationID.Validate(); err != nil {
+		return resource.ApplicationResourceUsage{}, errors.Errorf("application id: %w", err)
+	}
+
+	blobs, err := s.st.GetApplicationStoredResourceBlobs(ctx, applicationID)
+	if err != nil {
+		return resource.ApplicationResourceUsage{}, errors.Errorf("getting stored resources: %w", err)
+	}
+	return applicationResourceUsage(applicationID, blobs), nil
+}
+
+// GetModelResourceUsage returns the object store space consumed by the
+// stored resources of every application in the model. Blobs shared by
+// several applications are counted once in the model total.
+func (s *Service) GetModelResourceUsage(ctx context.Context) (resource.ModelResourceUsage, error) {
+	blobs, err := s.st.GetStoredResourceBlobs(ctx)
+	if err != nil {
+		return resource.ModelResourceUsage{}, errors.Errorf("getting stored resources: %w", err)
+	}
+
+	var (
+		result  resource.ModelResourceUsage
+		counted = make(map[blobKey]struct{})
+		byApp   = make(map[coreapplication.ID][]resource.StoredResourceBlob)
+		appIDs  []coreapplication.ID
+	)
+	for _, blob := range blobs {
+		key := blobKey{kind: blob.Type, key: blob.BlobKey}
+		if _, ok := counted[key]; !ok {
+			counted[key] = struct{}{}
+			result.TotalBytes += blob.Size
+		}
+		if _, ok := byApp[blob.ApplicationID]; !ok {
+			appIDs = append(appIDs, blob.ApplicationID)
+		}
+		byApp[blob.ApplicationID] = append(byApp[blob.ApplicationID], blob)
+	}
+	// The blobs are ordered by application, so the applications are too.
+	for _, appID := range appIDs {
+		result.Applications = append(result.Applications, applicationResourceUsage(appID, byApp[appID]))
+	}
+	return result, nil
+}
+
+// blobKey identifies a stored blob. Keys are only unique within a resource
+// type, as file and container image resources are held in different stores.
+type blobKey struct {
+	kind charmresource.Type
+	key  string
+}
+
+// applicationResourceUsage accounts for the input blobs of the given
+// application, which are expected to be ordered by resource name.
+func applicationResourceUsage(
+	applicationID coreapplication.ID,
+	blobs []resource.StoredResourceBlob,
+) resource.ApplicationResourceUsage {
+	result := resource.ApplicationResourceUsage{
+		ApplicationID: applicationID,
+	}
+
+	appCounted := make(map[blobKey]struct{})
+	var (
+		current    *resource.ResourceUsage
+		resCounted map[blobKey]struct{}
+		revisions  map[string]struct{}
+	)
+	for _, blob := range blobs {
+		if current == nil || current.Name != blob.Name {
+			result.Resources = append(result.Resources, resource.ResourceUsage{
+				Name: blob.Name,
+				Type: blob.Type,
+			})
+			current = &result.Resources[len(result.Resources)-1]
+			resCounted = make(map[blobKey]struct{})
+			revisions = make(map[string]struct{})
+		}
+
+		if _, ok := revisions[blob.ResourceUUID.String()]; !ok {
+			revisions[blob.ResourceUUID.String()] = struct{}{}
+			current.RevisionCount++
+		}
+
+		shared := blob.ApplicationCount > 1
+		current.Shared = current.Shared || shared
+
+		key := blobKey{kind: blob.Type, key: blob.BlobKey}
+		if _, ok := resCounted[key]; !ok {
+			resCounted[key] = struct{}{}
+			current.Bytes += blob.Size
+		}
+		if _, ok := appCounted[key]; !ok {
+			appCounted[key] = struct{}{}
+			result.TotalBytes += blob.Size
+			if shared {
+				result.SharedBytes += blob.Size
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coreapplication "github.com/juju/juju/core/application"
+	applicationtesting "github.com/juju/juju/core/application/testing"
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+)
+
+func (s *resourceServiceSuite) storedBlobs(app1, app2 coreapplication.ID) []resource.StoredResourceBlob {
+	return []resource.StoredResourceBlob{{
+		ApplicationID:    app1,
+		ResourceUUID:     "app1-data-1",
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-1",
+		Size:             100,
+		ApplicationCount: 2,
+	}, {
+		ApplicationID:    app1,
+		ResourceUUID:     "app1-data-2",
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-2",
+		Size:             50,
+		ApplicationCount: 1,
+	}, {
+		// A second revision which shares a blob with the first is only
+		// counted once.
+		ApplicationID:    app1,
+		ResourceUUID:     "app1-data-3",
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-2",
+		Size:             50,
+		ApplicationCount: 1,
+	}, {
+		ApplicationID: app1,
+		ResourceUUID:  "app1-image",
+		Name:          "image",
+		Type:          charmresource.TypeContainerImage,
+		// The same key as a file blob, but in a different store.
+		BlobKey:          "blob-1",
+		Size:             28,
+		ApplicationCount: 1,
+	}, {
+		ApplicationID:    app2,
+		ResourceUUID:     "app2-data",
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-1",
+		Size:             100,
+		ApplicationCount: 2,
+	}}
+}
+
+func (s *resourceServiceSuite) TestGetApplicationResourceUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	blobs := s.storedBlobs(id, "other")[:4]
+	s.state.EXPECT().GetApplicationStoredResourceBlobs(gomock.Any(), id).Return(blobs, nil)
+
+	usage, err := s.service.GetApplicationResourceUsage(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, resource.ApplicationResourceUsage{
+		ApplicationID: id,
+		Resources: []resource.ResourceUsage{{
+			Name:          "data",
+			Type:          charmresource.TypeFile,
+			RevisionCount: 3,
+			Bytes:         150,
+			Shared:        true,
+		}, {
+			Name:          "image",
+			Type:          charmresource.TypeContainerImage,
+			RevisionCount: 1,
+			Bytes:         28,
+		}},
+		TotalBytes:  178,
+		SharedBytes: 100,
+	})
+}
+
+func (s *resourceServiceSuite) TestGetApplicationResourceUsageNoResources(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationStoredResourceBlobs(gomock.Any(), id).Return(nil, nil)
+
+	usage, err := s.service.GetApplicationResourceUsage(context.Background(), id)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, resource.ApplicationResourceUsage{ApplicationID: id})
+}
+
+func (s *resourceServiceSuite) TestGetApplicationResourceUsageApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := applicationtesting.GenApplicationUUID(c)
+	s.state.EXPECT().GetApplicationStoredResourceBlobs(gomock.Any(), id).Return(nil, resourceerrors.ApplicationNotFound)
+
+	_, err := s.service.GetApplicationResourceUsage(context.Background(), id)
+	c.Assert(err, jc.ErrorIs, resourceerrors.ApplicationNotFound)
+}
+
+func (s *resourceServiceSuite) TestGetApplicationResourceUsageBadID(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetApplicationResourceUsage(context.Background(), "")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *resourceServiceSuite) TestGetModelResourceUsage(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	app1 := coreapplication.ID("app1")
+	app2 := coreapplication.ID("app2")
+	s.state.EXPECT().GetStoredResourceBlobs(gomock.Any()).Return(s.storedBlobs(app1, app2), nil)
+
+	usage, err := s.service.GetModelResourceUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	// The blob shared by both applications is only counted once.
+	c.Check(usage.TotalBytes, gc.Equals, int64(178))
+	c.Assert(usage.Applications, gc.HasLen, 2)
+	c.Check(usage.Applications[0].ApplicationID, gc.Equals, app1)
+	c.Check(usage.Applications[0].TotalBytes, gc.Equals, int64(178))
+	c.Check(usage.Applications[1], jc.DeepEquals, resource.ApplicationResourceUsage{
+		ApplicationID: app2,
+		Resources: []resource.ResourceUsage{{
+			Name:          "data",
+			Type:          charmresource.TypeFile,
+			RevisionCount: 1,
+			Bytes:         100,
+			Shared:        true,
+		}},
+		TotalBytes:  100,
+		SharedBytes: 100,
+	})
+}
+
+func (s *resourceServiceSuite) TestGetModelResourceUsageNoResources(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetStoredResourceBlobs(gomock.Any()).Return(nil, nil)
+
+	usage, err := s.service.GetModelResourceUsage(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(usage, jc.DeepEquals, resource.ModelResourceUsage{})
+}
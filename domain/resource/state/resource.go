@@ -1125,3 +1125,350 @@ WHERE uuid = $lastPolledResource.uuid
 	})
 	return errors.Capture(err)
 }
+
+// SetApplicationResources advances the available resources of the
+// application to the given revisions in a single transaction. Either every
+// resource advances or none do.
+//
+// A resource is advanced by adding a new resource at the requested revision,
+// with the same origin as the one in use, and linking it to the application.
+// The new resource has no blob, which is fetched when it is first opened. The
+// resource it replaces is left for resource cleanup, so units keep using it
+// until they fetch the new revision.
+//
+// The following error types can be expected to be returned:
+//   - [resourceerrors.ApplicationNotFound] if the application id doesn't belong
+//     to a valid application.
+//   - [resourceerrors.ResourceNotFound] if any of the resources is not in the
+//     metadata of the application's current charm, or is not available to
+//     the application.
+//   - [resourceerrors.ResourcePinned] if any of the resources is pinned to its
+//     current revision.
+func (st *State) SetApplicationResources(
+	ctx context.Context,
+	args resource.SetApplicationResourcesArgs,
+) (resource.SetApplicationResourcesResult, error) {
+	db, err := st.DB()
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	appCharm := applicationCharmUUID{
+		ApplicationID: args.ApplicationID.String(),
+	}
+	getAppCharmStmt, err := st.Prepare(`
+SELECT &applicationCharmUUID.*
+FROM   application
+WHERE  uuid = $applicationCharmUUID.uuid
+`, appCharm)
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	type resourceNames []string
+	getCharmResourcesStmt, err := st.Prepare(`
+SELECT &charmResourceName.*
+FROM   charm_resource
+WHERE  charm_uuid = $applicationCharmUUID.charm_uuid
+AND    name IN ($resourceNames[:])
+`, charmResourceName{}, appCharm, resourceNames{})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	// Only the resources of the current charm which are available to the
+	// application are advanced; those left behind by previous charms are
+	// removed on resource cleanup.
+	getRevisionsStmt, err := st.Prepare(`
+SELECT r.uuid AS &applicationResourceRevision.uuid,
+       r.charm_resource_name AS &applicationResourceRevision.name,
+       r.revision AS &applicationResourceRevision.revision,
+       rot.name AS &applicationResourceRevision.origin_type
+FROM   resource AS r
+JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+JOIN   resource_origin_type AS rot ON r.origin_type_id = rot.id
+JOIN   resource_state AS rs ON r.state_id = rs.id
+WHERE  ar.application_uuid = $applicationCharmUUID.uuid
+AND    r.charm_uuid = $applicationCharmUUID.charm_uuid
+AND    rs.name = 'available'
+AND    r.charm_resource_name IN ($resourceNames[:])
+ORDER BY r.created_at
+`, applicationResourceRevision{}, appCharm, resourceNames{})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	insertRevisionStmt, err := st.Prepare(`
+INSERT INTO resource (uuid, charm_uuid, charm_resource_name, revision,
+       origin_type_id, state_id, created_at)
+SELECT $resourceRevisionToAdd.uuid,
+       charm_uuid,
+       charm_resource_name,
+       $resourceRevisionToAdd.revision,
+       origin_type_id,
+       state_id,
+       $resourceRevisionToAdd.created_at
+FROM   resource
+WHERE  uuid = $resourceRevisionToAdd.replaced_uuid
+`, resourceRevisionToAdd{})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	linkStmt, err := st.Prepare(`
+INSERT INTO application_resource (application_uuid, resource_uuid)
+VALUES ($applicationResource.*)
+`, applicationResource{})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+
+	names := slices.Sorted(maps.Keys(args.Revisions))
+	var result resource.SetApplicationResourcesResult
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		result = resource.SetApplicationResourcesResult{}
+
+		err := tx.Query(ctx, getAppCharmStmt, appCharm).Get(&appCharm)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return resourceerrors.ApplicationNotFound
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		// Validate every resource against the current charm metadata before
+		// changing anything.
+		var charmResources []charmResourceName
+		err = tx.Query(ctx, getCharmResourcesStmt, appCharm, resourceNames(names)).GetAll(&charmResources)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		inCharm := set.NewStrings()
+		for _, res := range charmResources {
+			inCharm.Add(res.Name)
+		}
+		if missing := set.NewStrings(names...).Difference(inCharm); !missing.IsEmpty() {
+			return errors.Errorf("resources %s not in charm metadata: %w",
+				strings.Join(missing.SortedValues(), ", "), resourceerrors.ResourceNotFound)
+		}
+
+		var current []applicationResourceRevision
+		err = tx.Query(ctx, getRevisionsStmt, appCharm, resourceNames(names)).GetAll(&current)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+		// Where a resource has been replaced but not yet cleaned up, the most
+		// recently created one is in use.
+		available := make(map[string]applicationResourceRevision, len(current))
+		for _, res := range current {
+			available[res.Name] = res
+		}
+
+		now := st.clock.Now().UTC()
+		var additions []resourceRevisionToAdd
+		for _, name := range names {
+			res, ok := available[name]
+			if !ok {
+				return errors.Errorf("resource %q not available to application: %w",
+					name, resourceerrors.ResourceNotFound)
+			}
+			// Uploaded resources are pinned to the revision uploaded.
+			if res.OriginType == charmresource.OriginUpload.String() {
+				return errors.Errorf("resource %q: %w", name, resourceerrors.ResourcePinned)
+			}
+			revision := args.Revisions[name]
+			if res.Revision.Valid && int(res.Revision.Int64) == revision {
+				result.Current = append(result.Current, name)
+				continue
+			}
+			resUUID, err := coreresource.NewUUID()
+			if err != nil {
+				return errors.Capture(err)
+			}
+			result.Changed = append(result.Changed, name)
+			additions = append(additions, resourceRevisionToAdd{
+				UUID:         resUUID.String(),
+				ReplacedUUID: res.UUID,
+				Revision:     revision,
+				CreatedAt:    now,
+			})
+		}
+
+		for _, addition := range additions {
+			if err := tx.Query(ctx, insertRevisionStmt, addition).Run(); err != nil {
+				return errors.Errorf("adding resource revision: %w", err)
+			}
+			if err := tx.Query(ctx, linkStmt, applicationResource{
+				ApplicationUUID: appCharm.ApplicationID,
+				ResourceUUID:    addition.UUID,
+			}).Run(); err != nil {
+				return errors.Errorf("linking resource revision to application: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return resource.SetApplicationResourcesResult{}, errors.Capture(err)
+	}
+	return result, nil
+}
+
+// DowngradeApplicationResource makes an earlier revision of the named
+// resource the one in use by the application. The earlier revision must
+// still be held for the application, must have the same kind as the resource
+// in the application's current charm and must still have its blob in the
+// resource store.
+//
+// The following error types can be expected to be returned:
+//   - [resourceerrors.ApplicationNotFound] if the application id doesn't belong
+//     to a valid application.
+//   - [resourceerrors.ResourceNotFound] if the resource is not in the
+//     metadata of the application's current charm, is not available to the
+//     application, or the revision is not held for the application.
+//   - [resourceerrors.ArgumentNotValid] if the revision is not earlier than
+//     the revision in use, or is of a different kind to the resource in the
+//     current charm.
+//   - [resourceerrors.ResourceRevisionPruned] if the blob of the revision is
+//     no longer in the resource store.
+func (st *State) DowngradeApplicationResource(
+	ctx context.Context,
+	args resource.DowngradeApplicationResourceArgs,
+) (resource.DowngradeApplicationResourceResult, error) {
+	db, err := st.DB()
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+
+	appCharm := applicationCharmUUID{
+		ApplicationID: args.ApplicationID.String(),
+	}
+	getAppCharmStmt, err := st.Prepare(`
+SELECT &applicationCharmUUID.*
+FROM   application
+WHERE  uuid = $applicationCharmUUID.uuid
+`, appCharm)
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+
+	charmKind := charmResourceKind{
+		Name: args.Name,
+	}
+	getCharmKindStmt, err := st.Prepare(`
+SELECT &charmResourceKind.*
+FROM   charm_resource
+WHERE  charm_uuid = $applicationCharmUUID.charm_uuid
+AND    name = $charmResourceKind.name
+`, charmKind, appCharm)
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+
+	getCandidatesStmt, err := st.Prepare(`
+SELECT r.uuid AS &resourceRevisionCandidate.uuid,
+       r.charm_uuid AS &resourceRevisionCandidate.charm_uuid,
+       r.revision AS &resourceRevisionCandidate.revision,
+       cr.kind_id AS &resourceRevisionCandidate.kind_id,
+       COALESCE(rfs.store_uuid, ris.store_storage_key, '') AS &resourceRevisionCandidate.store_key
+FROM   resource AS r
+JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+JOIN   charm_resource AS cr ON r.charm_uuid = cr.charm_uuid AND r.charm_resource_name = cr.name
+JOIN   resource_state AS rs ON r.state_id = rs.id
+LEFT JOIN resource_file_store AS rfs ON r.uuid = rfs.resource_uuid
+LEFT JOIN resource_image_store AS ris ON r.uuid = ris.resource_uuid
+WHERE  ar.application_uuid = $applicationCharmUUID.uuid
+AND    r.charm_resource_name = $charmResourceKind.name
+AND    rs.name = 'available'
+ORDER BY r.created_at
+`, resourceRevisionCandidate{}, appCharm, charmKind)
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+
+	updateInUseStmt, err := st.Prepare(`
+UPDATE resource
+SET    charm_uuid = $resourceInUseUpdate.charm_uuid,
+       created_at = $resourceInUseUpdate.created_at
+WHERE  uuid = $resourceInUseUpdate.uuid
+`, resourceInUseUpdate{})
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+
+	var result resource.DowngradeApplicationResourceResult
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, getAppCharmStmt, appCharm).Get(&appCharm)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return resourceerrors.ApplicationNotFound
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		err = tx.Query(ctx, getCharmKindStmt, appCharm, charmKind).Get(&charmKind)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Errorf("resource %q not in charm metadata: %w", args.Name, resourceerrors.ResourceNotFound)
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		var candidates []resourceRevisionCandidate
+		err = tx.Query(ctx, getCandidatesStmt, appCharm, charmKind).GetAll(&candidates)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Capture(err)
+		}
+
+		// As when advancing, the most recently created resource of the
+		// current charm is the one in use. Every revision held for the
+		// application is a candidate to downgrade to, including those
+		// acquired for previous charms.
+		var current, target *resourceRevisionCandidate
+		for i, candidate := range candidates {
+			if candidate.CharmUUID == appCharm.CharmUUID {
+				current = &candidates[i]
+			}
+			if candidate.Revision.Valid && int(candidate.Revision.Int64) == args.Revision {
+				target = &candidates[i]
+			}
+		}
+		if current == nil || !current.Revision.Valid {
+			return errors.Errorf("resource %q not available to application: %w",
+				args.Name, resourceerrors.ResourceNotFound)
+		}
+		from := int(current.Revision.Int64)
+		if args.Revision >= from {
+			return errors.Errorf("resource %q revision %d is not earlier than revision %d in use: %w",
+				args.Name, args.Revision, from, resourceerrors.ArgumentNotValid)
+		}
+		if target == nil {
+			return errors.Errorf("resource %q revision %d not held for application: %w",
+				args.Name, args.Revision, resourceerrors.ResourceNotFound)
+		}
+		if target.KindID != charmKind.KindID {
+			return errors.Errorf("resource %q revision %d is not compatible with the current charm: %w",
+				args.Name, args.Revision, resourceerrors.ArgumentNotValid)
+		}
+		if target.StoreKey == "" {
+			return errors.Errorf(
+				"resource %q revision %d is no longer in the resource store, upload it again to use it: %w",
+				args.Name, args.Revision, resourceerrors.ResourceRevisionPruned)
+		}
+
+		err = tx.Query(ctx, updateInUseStmt, resourceInUseUpdate{
+			UUID:      target.UUID,
+			CharmUUID: appCharm.CharmUUID,
+			CreatedAt: st.clock.Now().UTC(),
+		}).Run()
+		if err != nil {
+			return errors.Errorf("downgrading resource revision: %w", err)
+		}
+
+		result = resource.DowngradeApplicationResourceResult{
+			FromRevision: from,
+			ToRevision:   args.Revision,
+		}
+		return nil
+	})
+	if err != nil {
+		return resource.DowngradeApplicationResourceResult{}, errors.Capture(err)
+	}
+	return result, nil
+}
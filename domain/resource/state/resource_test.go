@@ -621,6 +621,280 @@ func (s *resourceSuite) TestSetRepositoryResourceApplicationNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, resourceerrors.ApplicationNotFound, gc.Commentf("(Act) unexpected error: %v", errors.ErrorStack(err)))
 }
 
+// TestSetApplicationResources verifies that the resources of an application
+// are advanced together by adding new resources, reporting which were already
+// current.
+func (s *resourceSuite) TestSetApplicationResources(c *gc.C) {
+	// Arrange: insert three store resources for app1.
+	resources := s.insertStoreResources(c, map[string]int{
+		"res-1": 1,
+		"res-2": 3,
+		"res-3": 5,
+	})
+
+	// Act: advance res-1, leave res-2 at its current revision.
+	result, err := s.state.SetApplicationResources(context.Background(), resource.SetApplicationResourcesArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Revisions: map[string]int{
+			"res-1": 2,
+			"res-2": 3,
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("(Act) failed to execute SetApplicationResources: %v", errors.ErrorStack(err)))
+
+	// Assert
+	c.Check(result, gc.DeepEquals, resource.SetApplicationResourcesResult{
+		Changed: []string{"res-1"},
+		Current: []string{"res-2"},
+	})
+	c.Check(s.getInUseRevisions(c, "res-1", "res-2", "res-3"), gc.DeepEquals, map[string]int{
+		"res-1": 2,
+		"res-2": 3,
+		"res-3": 5,
+	})
+	// The replaced resource is left as it was, for cleanup.
+	c.Check(s.getRevisions(c, resources), gc.DeepEquals, map[string]int{
+		"res-1": 1,
+		"res-2": 3,
+		"res-3": 5,
+	})
+
+	// The new resource is from the store and has no blob yet, so it will be
+	// fetched when it is first opened.
+	var originType, state string
+	var stored int
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT rot.name, rs.name, COUNT(rfs.resource_uuid)
+FROM   resource AS r
+JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+JOIN   resource_origin_type AS rot ON r.origin_type_id = rot.id
+JOIN   resource_state AS rs ON r.state_id = rs.id
+LEFT JOIN resource_file_store AS rfs ON r.uuid = rfs.resource_uuid
+WHERE  ar.application_uuid = ?
+AND    r.charm_resource_name = 'res-1'
+AND    r.revision = 2`, s.constants.fakeApplicationUUID1).Scan(&originType, &state, &stored)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(originType, gc.Equals, "store")
+	c.Check(state, gc.Equals, "available")
+	c.Check(stored, gc.Equals, 0)
+}
+
+// TestSetApplicationResourcesPinned verifies that no resource is advanced if
+// any of those requested is pinned.
+func (s *resourceSuite) TestSetApplicationResourcesPinned(c *gc.C) {
+	// Arrange: insert a store resource and an uploaded resource for app1.
+	s.insertStoreResources(c, map[string]int{"res-1": 1})
+	uploaded := resourceData{
+		UUID:            "uploaded-id",
+		ApplicationUUID: s.constants.fakeApplicationUUID1,
+		Name:            "uploaded",
+		Revision:        4,
+		OriginType:      "upload",
+		CreatedAt:       time.Now(),
+	}
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return uploaded.insert(ctx, tx)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Act
+	_, err = s.state.SetApplicationResources(context.Background(), resource.SetApplicationResourcesArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Revisions: map[string]int{
+			"res-1":    2,
+			"uploaded": 5,
+		},
+	})
+
+	// Assert: nothing has been advanced.
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourcePinned)
+	c.Check(s.getInUseRevisions(c, "res-1", "uploaded"), gc.DeepEquals, map[string]int{
+		"res-1":    1,
+		"uploaded": 4,
+	})
+}
+
+// TestSetApplicationResourcesNotInCharm verifies that no resource is advanced
+// if any of those requested is not in the current charm metadata.
+func (s *resourceSuite) TestSetApplicationResourcesNotInCharm(c *gc.C) {
+	// Arrange
+	s.insertStoreResources(c, map[string]int{"res-1": 1})
+
+	// Act
+	_, err := s.state.SetApplicationResources(context.Background(), resource.SetApplicationResourcesArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Revisions: map[string]int{
+			"res-1":       2,
+			"no-resource": 1,
+		},
+	})
+
+	// Assert
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourceNotFound)
+	c.Check(err, gc.ErrorMatches, `.*no-resource not in charm metadata.*`)
+	c.Check(s.getInUseRevisions(c, "res-1"), gc.DeepEquals, map[string]int{"res-1": 1})
+}
+
+// TestSetApplicationResourcesApplicationNotFound verifies that setting
+// resources for a non-existent application results in an ApplicationNotFound
+// error.
+func (s *resourceSuite) TestSetApplicationResourcesApplicationNotFound(c *gc.C) {
+	_, err := s.state.SetApplicationResources(context.Background(), resource.SetApplicationResourcesArgs{
+		ApplicationID: "not-an-application",
+		Revisions:     map[string]int{"res-1": 1},
+	})
+	c.Assert(err, jc.ErrorIs, resourceerrors.ApplicationNotFound)
+}
+
+// insertDataRevisions inserts three revisions of the file resource "data" for
+// app1, the latest of which is in use. The blob of revision 2 has been pruned.
+func (s *resourceSuite) insertDataRevisions(c *gc.C) {
+	now := time.Now()
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for i, input := range []resourceData{{
+			UUID:     "data-1-uuid",
+			Revision: 1,
+		}, {
+			UUID:     "data-2-uuid",
+			Revision: 2,
+		}, {
+			UUID:     "data-3-uuid",
+			Revision: 3,
+		}} {
+			input.ApplicationUUID = s.constants.fakeApplicationUUID1
+			input.Name = "data"
+			input.Type = charmresource.TypeFile
+			input.OriginType = "store"
+			input.CreatedAt = now.Add(time.Duration(i-3) * time.Hour)
+			if err := input.insert(ctx, tx); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		for _, q := range []string{
+			`INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size) VALUES
+				('blob-1', 'blob-1', 'blob-1', 100),
+				('blob-3', 'blob-3', 'blob-3', 100)`,
+			`INSERT INTO resource_file_store (resource_uuid, store_uuid) VALUES
+				('data-1-uuid', 'blob-1'),
+				('data-3-uuid', 'blob-3')`,
+		} {
+			if _, err := tx.ExecContext(ctx, q); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// getDataRevisionInUse returns the revision of the "data" resource in use by
+// app1.
+func (s *resourceSuite) getDataRevisionInUse(c *gc.C) int {
+	var revision int
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRow(`
+SELECT r.revision
+FROM   resource AS r
+JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+WHERE  ar.application_uuid = ?
+AND    r.charm_resource_name = 'data'
+ORDER BY r.created_at DESC
+LIMIT 1`, s.constants.fakeApplicationUUID1).Scan(&revision)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return revision
+}
+
+// TestDowngradeApplicationResource verifies that an earlier stored revision
+// of a resource becomes the one in use.
+func (s *resourceSuite) TestDowngradeApplicationResource(c *gc.C) {
+	// Arrange
+	s.insertDataRevisions(c)
+
+	// Act
+	result, err := s.state.DowngradeApplicationResource(context.Background(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Name:          "data",
+		Revision:      1,
+	})
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("(Act) failed to execute DowngradeApplicationResource: %v", errors.ErrorStack(err)))
+
+	// Assert
+	c.Check(result, gc.DeepEquals, resource.DowngradeApplicationResourceResult{
+		FromRevision: 3,
+		ToRevision:   1,
+	})
+	c.Check(s.getDataRevisionInUse(c), gc.Equals, 1)
+}
+
+// TestDowngradeApplicationResourcePruned verifies that a revision whose blob
+// has been pruned cannot be downgraded to.
+func (s *resourceSuite) TestDowngradeApplicationResourcePruned(c *gc.C) {
+	// Arrange
+	s.insertDataRevisions(c)
+
+	// Act
+	_, err := s.state.DowngradeApplicationResource(context.Background(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Name:          "data",
+		Revision:      2,
+	})
+
+	// Assert
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourceRevisionPruned)
+	c.Check(err, gc.ErrorMatches, `.*upload it again.*`)
+	c.Check(s.getDataRevisionInUse(c), gc.Equals, 3)
+}
+
+// TestDowngradeApplicationResourceNotEarlier verifies that a resource cannot
+// be "downgraded" to the revision in use.
+func (s *resourceSuite) TestDowngradeApplicationResourceNotEarlier(c *gc.C) {
+	// Arrange
+	s.insertDataRevisions(c)
+
+	// Act
+	_, err := s.state.DowngradeApplicationResource(context.Background(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Name:          "data",
+		Revision:      3,
+	})
+
+	// Assert
+	c.Assert(err, jc.ErrorIs, resourceerrors.ArgumentNotValid)
+	c.Check(s.getDataRevisionInUse(c), gc.Equals, 3)
+}
+
+// TestDowngradeApplicationResourceRevisionNotHeld verifies that a resource
+// cannot be downgraded to a revision never held for the application.
+func (s *resourceSuite) TestDowngradeApplicationResourceRevisionNotHeld(c *gc.C) {
+	// Arrange
+	s.insertDataRevisions(c)
+
+	// Act
+	_, err := s.state.DowngradeApplicationResource(context.Background(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: application.ID(s.constants.fakeApplicationUUID1),
+		Name:          "data",
+		Revision:      0,
+	})
+
+	// Assert
+	c.Assert(err, jc.ErrorIs, resourceerrors.ResourceNotFound)
+}
+
+// TestDowngradeApplicationResourceApplicationNotFound verifies that
+// downgrading a resource of a non-existent application results in an
+// ApplicationNotFound error.
+func (s *resourceSuite) TestDowngradeApplicationResourceApplicationNotFound(c *gc.C) {
+	_, err := s.state.DowngradeApplicationResource(context.Background(), resource.DowngradeApplicationResourceArgs{
+		ApplicationID: "not-an-application",
+		Name:          "data",
+		Revision:      1,
+	})
+	c.Assert(err, jc.ErrorIs, resourceerrors.ApplicationNotFound)
+}
+
 // TestRecordStoredResourceWithContainerImage tests recording that a container
 // image resource has been stored.
 func (s *resourceSuite) TestRecordStoredResourceWithContainerImage(c *gc.C) {
@@ -1613,6 +1887,77 @@ WHERE  ar.resource_uuid = ?`, resID).Scan(&charmModifiedVersion)
 	return 0
 }
 
+// insertStoreResources inserts an available store resource for app1 at each
+// of the given revisions, keyed by name, and returns their UUIDs keyed by
+// name.
+func (s *resourceSuite) insertStoreResources(c *gc.C, revisions map[string]int) map[string]string {
+	uuids := make(map[string]string, len(revisions))
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for name, revision := range revisions {
+			res := resourceData{
+				UUID:            name + "-id",
+				ApplicationUUID: s.constants.fakeApplicationUUID1,
+				Name:            name,
+				Revision:        revision,
+				OriginType:      "store",
+				State:           "available",
+				CreatedAt:       time.Now(),
+			}
+			if err := res.insert(ctx, tx); err != nil {
+				return errors.Capture(err)
+			}
+			uuids[name] = res.UUID
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil, gc.Commentf("failed to populate DB: %v", errors.ErrorStack(err)))
+	return uuids
+}
+
+// getRevisions returns the revisions of the resources with the given UUIDs,
+// keyed by the same names as the input.
+func (s *resourceSuite) getRevisions(c *gc.C, uuids map[string]string) map[string]int {
+	revisions := make(map[string]int, len(uuids))
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for name, uuid := range uuids {
+			var revision int
+			if err := tx.QueryRow(`SELECT revision FROM resource WHERE uuid = ?`, uuid).Scan(&revision); err != nil {
+				return err
+			}
+			revisions[name] = revision
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return revisions
+}
+
+// getInUseRevisions returns the revisions of the named resources in use by
+// app1, that is, of the most recently created resource of each name.
+func (s *resourceSuite) getInUseRevisions(c *gc.C, names ...string) map[string]int {
+	revisions := make(map[string]int, len(names))
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, name := range names {
+			var revision int
+			err := tx.QueryRowContext(ctx, `
+SELECT   r.revision
+FROM     resource AS r
+JOIN     application_resource AS ar ON r.uuid = ar.resource_uuid
+WHERE    ar.application_uuid = ?
+AND      r.charm_resource_name = ?
+ORDER BY r.created_at DESC
+LIMIT    1`, s.constants.fakeApplicationUUID1, name).Scan(&revision)
+			if err != nil {
+				return err
+			}
+			revisions[name] = revision
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return revisions
+}
+
 // resourceData represents a structure containing meta-information about a resource in the system.
 type resourceData struct {
 	// from resource table
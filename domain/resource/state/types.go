@@ -4,6 +4,7 @@
 package state
 
 import (
+	"database/sql"
 	"time"
 
 	coreapplication "github.com/juju/juju/core/application"
@@ -99,6 +100,41 @@ type applicationNameAndID struct {
 	Name          string             `db:"name"`
 }
 
+// applicationCharmUUID holds the uuid of the charm in use by an application.
+type applicationCharmUUID struct {
+	ApplicationID string `db:"uuid"`
+	CharmUUID     string `db:"charm_uuid"`
+}
+
+// charmResourceName is the name of a resource in a charm's metadata.
+type charmResourceName struct {
+	Name string `db:"name"`
+}
+
+// applicationResourceRevision holds the revision and origin of a resource
+// available to an application.
+type applicationResourceRevision struct {
+	UUID       string        `db:"uuid"`
+	Name       string        `db:"name"`
+	Revision   sql.NullInt64 `db:"revision"`
+	OriginType string        `db:"origin_type"`
+}
+
+// resourceRevisionToAdd is a new revision of a resource, added in place of
+// the resource it replaces.
+type resourceRevisionToAdd struct {
+	UUID         string    `db:"uuid"`
+	ReplacedUUID string    `db:"replaced_uuid"`
+	Revision     int       `db:"revision"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// applicationResource links a resource to an application.
+type applicationResource struct {
+	ApplicationUUID string `db:"application_uuid"`
+	ResourceUUID    string `db:"resource_uuid"`
+}
+
 // kubernetesApplicationResource represents the mapping of a resource to a unit.
 type kubernetesApplicationResource struct {
 	ResourceUUID string    `db:"resource_uuid"`
@@ -114,3 +150,40 @@ type storedContainerImageResource struct {
 	StorageKey   string `db:"store_storage_key"`
 	ResourceUUID string `db:"resource_uuid"`
 }
+
+// storedResourceBlob represents a blob held for an application resource.
+type storedResourceBlob struct {
+	ApplicationUUID  string `db:"application_uuid"`
+	UUID             string `db:"uuid"`
+	Name             string `db:"name"`
+	Kind             string `db:"kind_name"`
+	BlobKey          string `db:"blob_key"`
+	Size             int64  `db:"size"`
+	ApplicationCount int    `db:"application_count"`
+}
+
+// charmResourceKind holds the kind of a resource in a charm's metadata.
+type charmResourceKind struct {
+	Name   string `db:"name"`
+	KindID int    `db:"kind_id"`
+}
+
+// resourceRevisionCandidate holds the details of a revision of a resource
+// available to an application, used when choosing a revision to downgrade to.
+type resourceRevisionCandidate struct {
+	UUID      string        `db:"uuid"`
+	CharmUUID string        `db:"charm_uuid"`
+	Revision  sql.NullInt64 `db:"revision"`
+	KindID    int           `db:"kind_id"`
+	// StoreKey identifies the blob of the revision in the resource store,
+	// and is empty if the blob has been pruned.
+	StoreKey string `db:"store_key"`
+}
+
+// resourceInUseUpdate is used to make a resource the one in use by an
+// application.
+type resourceInUseUpdate struct {
+	UUID      string    `db:"uuid"`
+	CharmUUID string    `db:"charm_uuid"`
+	CreatedAt time.Time `db:"created_at"`
+}
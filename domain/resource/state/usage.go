@@ -0,0 +1,166 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	"github.com/canonical/sqlair"
+
+	"github.com/juju/juju/core/application"
+	coreresource "github.com/juju/juju/core/resource"
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/errors"
+)
+
+// storedResourceBlobsQuery selects the blobs held for application resources.
+// File resources are held in the object store, and their size is that of
+// the stored object. Container image resources only hold the image reference,
+// so their size is that of the stored reference metadata. A blob may be
+// shared by several resources, so the number of applications using each
+// blob is also returned.
+const storedResourceBlobsQuery = `
+WITH blob AS (
+    SELECT ar.application_uuid,
+           r.uuid,
+           r.charm_resource_name AS name,
+           'file' AS kind_name,
+           osm.uuid AS blob_key,
+           osm.size
+    FROM   resource AS r
+    JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+    JOIN   resource_file_store AS rfs ON r.uuid = rfs.resource_uuid
+    JOIN   object_store_metadata AS osm ON rfs.store_uuid = osm.uuid
+    UNION ALL
+    SELECT ar.application_uuid,
+           r.uuid,
+           r.charm_resource_name AS name,
+           'oci-image' AS kind_name,
+           cim.storage_key AS blob_key,
+           LENGTH(cim.registry_path)
+               + COALESCE(LENGTH(cim.username), 0)
+               + COALESCE(LENGTH(cim.password), 0) AS size
+    FROM   resource AS r
+    JOIN   application_resource AS ar ON r.uuid = ar.resource_uuid
+    JOIN   resource_image_store AS ris ON r.uuid = ris.resource_uuid
+    JOIN   resource_container_image_metadata_store AS cim ON ris.store_storage_key = cim.storage_key
+), counted AS (
+    SELECT b.*,
+           (
+               SELECT COUNT(DISTINCT o.application_uuid)
+               FROM   blob AS o
+               WHERE  o.kind_name = b.kind_name
+               AND    o.blob_key = b.blob_key
+           ) AS application_count
+    FROM   blob AS b
+)
+SELECT &storedResourceBlob.*
+FROM   counted`
+
+// GetStoredResourceBlobs returns the blobs held for the resources of all
+// applications in the model, ordered by application, resource name and
+// resource UUID.
+func (st *State) GetStoredResourceBlobs(ctx context.Context) ([]resource.StoredResourceBlob, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(storedResourceBlobsQuery+`
+ORDER BY application_uuid, name, uuid`, storedResourceBlob{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var blobs []storedResourceBlob
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&blobs)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return storedResourceBlobs(blobs).toStoredResourceBlobs()
+}
+
+// GetApplicationStoredResourceBlobs returns the blobs held for the
+// resources of the input application, ordered by resource name and resource
+// UUID.
+//
+// The following error types can be expected to be returned:
+//   - [resourceerrors.ApplicationNotFound] if the application does not
+//     exist.
+func (st *State) GetApplicationStoredResourceBlobs(
+	ctx context.Context,
+	applicationID application.ID,
+) ([]resource.StoredResourceBlob, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	app := applicationNameAndID{ApplicationID: applicationID}
+	appStmt, err := st.Prepare(`
+SELECT &applicationNameAndID.uuid
+FROM   application
+WHERE  uuid = $applicationNameAndID.uuid
+`, app)
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	stmt, err := st.Prepare(storedResourceBlobsQuery+`
+WHERE  application_uuid = $applicationNameAndID.uuid
+ORDER BY name, uuid`, app, storedResourceBlob{})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+
+	var blobs []storedResourceBlob
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, appStmt, app).Get(&app)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return resourceerrors.ApplicationNotFound
+		} else if err != nil {
+			return errors.Capture(err)
+		}
+
+		err = tx.Query(ctx, stmt, app).GetAll(&blobs)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Capture(err)
+	})
+	if err != nil {
+		return nil, errors.Capture(err)
+	}
+	return storedResourceBlobs(blobs).toStoredResourceBlobs()
+}
+
+type storedResourceBlobs []storedResourceBlob
+
+func (rows storedResourceBlobs) toStoredResourceBlobs() ([]resource.StoredResourceBlob, error) {
+	result := make([]resource.StoredResourceBlob, len(rows))
+	for i, row := range rows {
+		kind, err := charmresource.ParseType(row.Kind)
+		if err != nil {
+			return nil, errors.Errorf("parsing resource kind: %w", err)
+		}
+		result[i] = resource.StoredResourceBlob{
+			ApplicationID:    application.ID(row.ApplicationUUID),
+			ResourceUUID:     coreresource.UUID(row.UUID),
+			Name:             row.Name,
+			Type:             kind,
+			BlobKey:          row.BlobKey,
+			Size:             row.Size,
+			ApplicationCount: row.ApplicationCount,
+		}
+	}
+	return result, nil
+}
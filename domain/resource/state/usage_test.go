@@ -0,0 +1,141 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"database/sql"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/core/application"
+	coreresource "github.com/juju/juju/core/resource"
+	"github.com/juju/juju/domain/resource"
+	resourceerrors "github.com/juju/juju/domain/resource/errors"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/errors"
+)
+
+// addStoredResources adds two revisions of a file resource and a container
+// image resource to app1, and a file resource to app2 which shares a blob
+// with app1.
+func (s *resourceSuite) addStoredResources(c *gc.C) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		for _, input := range []resourceData{{
+			UUID:            "app1-data-1-uuid",
+			ApplicationUUID: s.constants.fakeApplicationUUID1,
+			Name:            "data",
+			Revision:        1,
+			Type:            charmresource.TypeFile,
+		}, {
+			UUID:            "app1-data-2-uuid",
+			ApplicationUUID: s.constants.fakeApplicationUUID1,
+			Name:            "data",
+			Revision:        2,
+			Type:            charmresource.TypeFile,
+		}, {
+			UUID:            "app1-image-uuid",
+			ApplicationUUID: s.constants.fakeApplicationUUID1,
+			Name:            "image",
+			Type:            charmresource.TypeContainerImage,
+		}, {
+			// A placeholder with nothing stored.
+			UUID:            "app1-config-uuid",
+			ApplicationUUID: s.constants.fakeApplicationUUID1,
+			Name:            "config",
+			Type:            charmresource.TypeFile,
+		}, {
+			UUID:            "app2-data-uuid",
+			ApplicationUUID: s.constants.fakeApplicationUUID2,
+			Name:            "data",
+			Type:            charmresource.TypeFile,
+		}} {
+			if err := input.insert(ctx, tx); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		for _, q := range []string{
+			`INSERT INTO object_store_metadata (uuid, sha_256, sha_384, size) VALUES
+				('blob-1', 'blob-1', 'blob-1', 100),
+				('blob-2', 'blob-2', 'blob-2', 50)`,
+			`INSERT INTO resource_file_store (resource_uuid, store_uuid) VALUES
+				('app1-data-1-uuid', 'blob-1'),
+				('app1-data-2-uuid', 'blob-2'),
+				('app2-data-uuid', 'blob-1')`,
+			`INSERT INTO resource_container_image_metadata_store (storage_key, registry_path, username)
+				VALUES ('image-key', 'testing@sha256:beef-deed', 'fred')`,
+			`INSERT INTO resource_image_store (resource_uuid, store_storage_key)
+				VALUES ('app1-image-uuid', 'image-key')`,
+		} {
+			if _, err := tx.ExecContext(ctx, q); err != nil {
+				return errors.Capture(err)
+			}
+		}
+		return nil
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *resourceSuite) TestGetApplicationStoredResourceBlobs(c *gc.C) {
+	s.addStoredResources(c)
+
+	blobs, err := s.state.GetApplicationStoredResourceBlobs(context.Background(), application.ID(s.constants.fakeApplicationUUID1))
+	c.Assert(err, jc.ErrorIsNil)
+	appID := application.ID(s.constants.fakeApplicationUUID1)
+	c.Check(blobs, jc.DeepEquals, []resource.StoredResourceBlob{{
+		ApplicationID:    appID,
+		ResourceUUID:     coreresource.UUID("app1-data-1-uuid"),
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-1",
+		Size:             100,
+		ApplicationCount: 2,
+	}, {
+		ApplicationID:    appID,
+		ResourceUUID:     coreresource.UUID("app1-data-2-uuid"),
+		Name:             "data",
+		Type:             charmresource.TypeFile,
+		BlobKey:          "blob-2",
+		Size:             50,
+		ApplicationCount: 1,
+	}, {
+		ApplicationID: appID,
+		ResourceUUID:  coreresource.UUID("app1-image-uuid"),
+		Name:          "image",
+		Type:          charmresource.TypeContainerImage,
+		BlobKey:       "image-key",
+		// The size of the reference metadata: the registry path and
+		// username.
+		Size:             int64(len("testing@sha256:beef-deed") + len("fred")),
+		ApplicationCount: 1,
+	}})
+}
+
+func (s *resourceSuite) TestGetApplicationStoredResourceBlobsNone(c *gc.C) {
+	blobs, err := s.state.GetApplicationStoredResourceBlobs(context.Background(), application.ID(s.constants.fakeApplicationUUID1))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(blobs, gc.HasLen, 0)
+}
+
+func (s *resourceSuite) TestGetApplicationStoredResourceBlobsApplicationNotFound(c *gc.C) {
+	_, err := s.state.GetApplicationStoredResourceBlobs(context.Background(), "unknown-app-uuid")
+	c.Assert(err, jc.ErrorIs, resourceerrors.ApplicationNotFound)
+}
+
+func (s *resourceSuite) TestGetStoredResourceBlobs(c *gc.C) {
+	s.addStoredResources(c)
+
+	blobs, err := s.state.GetStoredResourceBlobs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(blobs, gc.HasLen, 4)
+	var uuids []coreresource.UUID
+	for _, blob := range blobs {
+		uuids = append(uuids, blob.ResourceUUID)
+	}
+	c.Check(uuids, jc.DeepEquals, []coreresource.UUID{
+		"app1-data-1-uuid", "app1-data-2-uuid", "app1-image-uuid", "app2-data-uuid",
+	})
+	c.Check(blobs[3].ApplicationCount, gc.Equals, 2)
+}
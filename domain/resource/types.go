@@ -119,6 +119,47 @@ type SetRepositoryResourcesArgs struct {
 	LastPolled time.Time
 }
 
+// SetApplicationResourcesArgs holds the arguments for the
+// SetApplicationResources method.
+type SetApplicationResourcesArgs struct {
+	// ApplicationID is the id of the application having these resources.
+	ApplicationID application.ID
+	// Revisions maps the name of each resource to advance to the revision it
+	// should be advanced to.
+	Revisions map[string]int
+}
+
+// SetApplicationResourcesResult reports the outcome of the
+// SetApplicationResources method.
+type SetApplicationResourcesResult struct {
+	// Changed holds the names of the resources that were advanced to a new
+	// revision, sorted by name.
+	Changed []string
+	// Current holds the names of the resources that were already at the
+	// requested revision, sorted by name.
+	Current []string
+}
+
+// DowngradeApplicationResourceArgs holds the arguments for the
+// DowngradeApplicationResource method.
+type DowngradeApplicationResourceArgs struct {
+	// ApplicationID is the id of the application having the resource.
+	ApplicationID application.ID
+	// Name is the name of the resource to downgrade.
+	Name string
+	// Revision is the earlier revision to downgrade the resource to.
+	Revision int
+}
+
+// DowngradeApplicationResourceResult reports the outcome of the
+// DowngradeApplicationResource method.
+type DowngradeApplicationResourceResult struct {
+	// FromRevision is the revision the resource was at before the downgrade.
+	FromRevision int
+	// ToRevision is the revision the resource is now at.
+	ToRevision int
+}
+
 // StoreResourceArgs holds the arguments for resource storage methods.
 type StoreResourceArgs struct {
 	// ResourceUUID is the unique identifier of the resource.
@@ -152,3 +193,84 @@ type RecordStoredResourceArgs struct {
 	// version should be incremented or not.
 	IncrementCharmModifiedVersion bool
 }
+
+// StoredResourceBlob describes a blob held for a resource in use by an
+// application.
+type StoredResourceBlob struct {
+	// ApplicationID is the ID of the application using the resource.
+	ApplicationID application.ID
+
+	// ResourceUUID uniquely identifies the resource within the model.
+	ResourceUUID coreresource.UUID
+
+	// Name is the name of the resource as defined by the charm.
+	Name string
+
+	// Type is the type of the resource.
+	Type charmresource.Type
+
+	// BlobKey identifies the stored blob. Resources of the same type with
+	// the same key share a single blob.
+	BlobKey string
+
+	// Size is the size of the blob in bytes. For container image resources
+	// this is the size of the stored image reference metadata, not the size
+	// of the image in the remote registry.
+	Size int64
+
+	// ApplicationCount is the number of applications with resources using
+	// the blob.
+	ApplicationCount int
+}
+
+// ResourceUsage describes the object store space consumed by the revisions
+// of a single application resource.
+type ResourceUsage struct {
+	// Name is the name of the resource as defined by the charm.
+	Name string
+
+	// Type is the type of the resource.
+	Type charmresource.Type
+
+	// RevisionCount is the number of stored revisions of the resource.
+	RevisionCount int
+
+	// Bytes is the total size of the distinct blobs held for the revisions
+	// of the resource.
+	Bytes int64
+
+	// Shared is true if any of the blobs held for the resource are also used
+	// by another application.
+	Shared bool
+}
+
+// ApplicationResourceUsage describes the object store space consumed by the
+// resources of an application.
+type ApplicationResourceUsage struct {
+	// ApplicationID is the ID of the application.
+	ApplicationID application.ID
+
+	// Resources holds the usage of each of the application's stored
+	// resources, ordered by name.
+	Resources []ResourceUsage
+
+	// TotalBytes is the total size of the distinct blobs held for the
+	// application's resources.
+	TotalBytes int64
+
+	// SharedBytes is the part of TotalBytes held in blobs which are also
+	// used by other applications.
+	SharedBytes int64
+}
+
+// ModelResourceUsage describes the object store space consumed by the
+// resources of all applications in a model.
+type ModelResourceUsage struct {
+	// Applications holds the usage of each application with stored
+	// resources, ordered by application ID.
+	Applications []ApplicationResourceUsage
+
+	// TotalBytes is the total size of the distinct blobs held for resources
+	// in the model. Blobs shared by several applications are counted once.
+	TotalBytes int64
+}
@@ -0,0 +1,100 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	"github.com/juju/juju/core/database"
+	"github.com/juju/juju/internal/errors"
+)
+
+// ErrSchemaIntegrity is returned when the schema of a database no longer
+// matches the checksum recorded after the last schema migration.
+const ErrSchemaIntegrity = errors.ConstError("schema integrity check failed")
+
+// RecordMigrationChecksum computes a checksum of all the tables and indexes
+// currently defined in the database and records it in the schema_checksum
+// table. It should be called after the schema migrations have been applied,
+// so that any later manual changes to the schema can be detected.
+func RecordMigrationChecksum(ctx context.Context, db database.TxnRunner) error {
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		hash, err := computeSchemaChecksum(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM schema_checksum`); err != nil {
+			return errors.Errorf("removing previous schema checksum: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `
+INSERT INTO schema_checksum (hash, updated_at) VALUES (?, ?)`, hash, time.Now().UTC())
+		if err != nil {
+			return errors.Errorf("recording schema checksum: %w", err)
+		}
+		return nil
+	})
+}
+
+// VerifySchemaIntegrity recomputes the checksum of all the tables and indexes
+// currently defined in the database and compares it against the checksum
+// recorded by RecordMigrationChecksum. If the checksums differ, an error
+// satisfying ErrSchemaIntegrity is returned. If no checksum has been recorded
+// yet, the check is skipped.
+func VerifySchemaIntegrity(ctx context.Context, db database.TxnRunner) error {
+	return db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		var recorded string
+		row := tx.QueryRowContext(ctx, `SELECT hash FROM schema_checksum`)
+		if err := row.Scan(&recorded); errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Errorf("reading schema checksum: %w", err)
+		}
+
+		current, err := computeSchemaChecksum(ctx, tx)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		if current != recorded {
+			return errors.Errorf("schema checksum %q does not match recorded checksum %q: %w",
+				current, recorded, ErrSchemaIntegrity)
+		}
+		return nil
+	})
+}
+
+// computeSchemaChecksum returns the hex encoded SHA256 hash of all the
+// CREATE TABLE and CREATE INDEX statements in the database. Internally
+// created indexes (which have no SQL) and the checksum table itself are
+// excluded.
+func computeSchemaChecksum(ctx context.Context, tx *sql.Tx) (string, error) {
+	rows, err := tx.QueryContext(ctx, `
+SELECT sql FROM sqlite_master
+WHERE type IN ('table', 'index')
+AND sql IS NOT NULL
+AND tbl_name != 'schema_checksum'
+ORDER BY type, name;`)
+	if err != nil {
+		return "", errors.Errorf("reading schema: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	hash := sha256.New()
+	for rows.Next() {
+		var stmt string
+		if err := rows.Scan(&stmt); err != nil {
+			return "", errors.Capture(err)
+		}
+		_, _ = hash.Write([]byte(stmt))
+		_, _ = hash.Write([]byte{0})
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Capture(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
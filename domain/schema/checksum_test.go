@@ -0,0 +1,77 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package schema
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type checksumSuite struct {
+	schemaBaseSuite
+}
+
+var _ = gc.Suite(&checksumSuite{})
+
+func (s *checksumSuite) TestVerifySchemaIntegrityNoChecksum(c *gc.C) {
+	s.applyDDL(c, ModelDDL())
+
+	err := VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *checksumSuite) TestVerifySchemaIntegrity(c *gc.C) {
+	s.applyDDL(c, ModelDDL())
+
+	err := RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *checksumSuite) TestVerifySchemaIntegrityRecordIsIdempotent(c *gc.C) {
+	s.applyDDL(c, ControllerDDL())
+
+	err := RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	err = RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *checksumSuite) TestVerifySchemaIntegrityManualIndex(c *gc.C) {
+	s.applyDDL(c, ModelDDL())
+
+	err := RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertExecSQL(c, "CREATE INDEX idx_manual_change_log ON change_log (created_at);")
+
+	err = VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIs, ErrSchemaIntegrity)
+
+	// Recording the checksum again accepts the current schema.
+	err = RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *checksumSuite) TestVerifySchemaIntegrityManualTable(c *gc.C) {
+	s.applyDDL(c, ControllerDDL())
+
+	err := RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertExecSQL(c, "CREATE TABLE manual (id TEXT);")
+
+	err = VerifySchemaIntegrity(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIs, ErrSchemaIntegrity)
+}
@@ -1,7 +1,9 @@
 CREATE TABLE controller_node (
     controller_id TEXT NOT NULL PRIMARY KEY,
     dqlite_node_id TEXT,              -- This is the uint64 from Dqlite NodeInfo, stored as text.
-    bind_address TEXT               -- IP address (no port) that Dqlite is bound to. 
+    bind_address TEXT,              -- IP address (no port) that Dqlite is bound to. 
+    api_port INT,                   -- Port that the node's API server listens on.
+    last_heartbeat DATETIME         -- Last time the node's API server reported itself as up.
 );
 
 CREATE UNIQUE INDEX idx_controller_node_dqlite_node
@@ -43,3 +43,34 @@ CREATE TABLE upgrade_info_controller_node (
 
 CREATE UNIQUE INDEX idx_upgrade_info_controller_node
 ON upgrade_info_controller_node (controller_node_id, upgrade_info_uuid);
+
+CREATE TABLE agent_upgrade_state_type (
+    id INT PRIMARY KEY,
+    type TEXT
+);
+
+CREATE UNIQUE INDEX idx_agent_upgrade_state_type_type
+ON agent_upgrade_state_type (type);
+
+INSERT INTO agent_upgrade_state_type VALUES
+(0, 'downloading'),
+(1, 'staging'),
+(2, 'restarting'),
+(3, 'upgraded');
+
+-- model_agent_upgrade_state records the latest progress of each agent in a
+-- model through an agent binary upgrade.
+CREATE TABLE model_agent_upgrade_state (
+    model_uuid TEXT NOT NULL,
+    agent_name TEXT NOT NULL,
+    current_version TEXT NOT NULL,
+    target_version TEXT NOT NULL,
+    state_type_id INT NOT NULL,
+    CONSTRAINT fk_model_agent_upgrade_state_model
+    FOREIGN KEY (model_uuid)
+    REFERENCES model (uuid),
+    CONSTRAINT fk_model_agent_upgrade_state_agent_upgrade_state_type
+    FOREIGN KEY (state_type_id)
+    REFERENCES agent_upgrade_state_type (id),
+    PRIMARY KEY (model_uuid, agent_name)
+);
@@ -16,16 +16,35 @@ INSERT INTO secret_backend_type VALUES
 (1, 'kubernetes', 'the kubernetes secret backend'),
 (2, 'vault', 'the vault secret backend');
 
+CREATE TABLE secret_backend_audit_level (
+    id INT PRIMARY KEY,
+    level TEXT NOT NULL,
+    CONSTRAINT chk_empty_level
+    CHECK (level != '')
+);
+
+CREATE UNIQUE INDEX idx_secret_backend_audit_level_level
+ON secret_backend_audit_level (level);
+
+INSERT INTO secret_backend_audit_level VALUES
+(0, 'none'),
+(1, 'reads-only'),
+(2, 'all');
+
 CREATE TABLE secret_backend (
     uuid TEXT NOT NULL PRIMARY KEY,
     name TEXT NOT NULL,
     backend_type_id INT NOT NULL,
     token_rotate_interval INT,
+    audit_level_id INT NOT NULL DEFAULT 0,
     CONSTRAINT chk_empty_name
     CHECK (name != ''),
     CONSTRAINT fk_secret_backend_type_id
     FOREIGN KEY (backend_type_id)
-    REFERENCES secret_backend_type (id)
+    REFERENCES secret_backend_type (id),
+    CONSTRAINT fk_secret_backend_audit_level_id
+    FOREIGN KEY (audit_level_id)
+    REFERENCES secret_backend_audit_level (id)
 );
 
 CREATE UNIQUE INDEX idx_secret_backend_name
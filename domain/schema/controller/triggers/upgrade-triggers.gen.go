@@ -9,6 +9,45 @@ import (
 )
 
 
+// ChangeLogTriggersForModelAgentUpgradeState generates the triggers for the
+// model_agent_upgrade_state table.
+func ChangeLogTriggersForModelAgentUpgradeState(columnName string, namespaceID int) func() schema.Patch {
+	return func() schema.Patch {
+		return schema.MakePatch(fmt.Sprintf(`
+-- insert namespace for ModelAgentUpgradeState
+INSERT INTO change_log_namespace VALUES (%[2]d, 'model_agent_upgrade_state', 'ModelAgentUpgradeState changes based on %[1]s');
+
+-- insert trigger for ModelAgentUpgradeState
+CREATE TRIGGER trg_log_model_agent_upgrade_state_insert
+AFTER INSERT ON model_agent_upgrade_state FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (1, %[2]d, NEW.%[1]s, DATETIME('now'));
+END;
+
+-- update trigger for ModelAgentUpgradeState
+CREATE TRIGGER trg_log_model_agent_upgrade_state_update
+AFTER UPDATE ON model_agent_upgrade_state FOR EACH ROW
+WHEN 
+	NEW.model_uuid != OLD.model_uuid OR
+	NEW.agent_name != OLD.agent_name OR
+	NEW.current_version != OLD.current_version OR
+	NEW.target_version != OLD.target_version OR
+	NEW.state_type_id != OLD.state_type_id 
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (2, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;
+-- delete trigger for ModelAgentUpgradeState
+CREATE TRIGGER trg_log_model_agent_upgrade_state_delete
+AFTER DELETE ON model_agent_upgrade_state FOR EACH ROW
+BEGIN
+    INSERT INTO change_log (edit_type_id, namespace_id, changed, created_at)
+    VALUES (4, %[2]d, OLD.%[1]s, DATETIME('now'));
+END;`, columnName, namespaceID))
+	}
+}
+
 // ChangeLogTriggersForUpgradeInfo generates the triggers for the
 // upgrade_info table.
 func ChangeLogTriggersForUpgradeInfo(columnName string, namespaceID int) func() schema.Patch {
@@ -36,6 +36,9 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 		"lease_type",
 		"lease_pin",
 
+		// Schema checksum
+		"schema_checksum",
+
 		// Change log
 		"change_log",
 		"change_log_edit_type",
@@ -86,6 +89,8 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 		"upgrade_info",
 		"upgrade_info_controller_node",
 		"upgrade_state_type",
+		"agent_upgrade_state_type",
+		"model_agent_upgrade_state",
 
 		// Object store metadata
 		"object_store_metadata",
@@ -116,6 +121,7 @@ func (s *controllerSchemaSuite) TestControllerTables(c *gc.C) {
 		"secret_backend_config",
 		"secret_backend_rotation",
 		"secret_backend_type",
+		"secret_backend_audit_level",
 		"secret_backend_reference",
 		"model_secret_backend",
 
@@ -225,6 +231,10 @@ func (s *controllerSchemaSuite) TestControllerTriggers(c *gc.C) {
 		"trg_log_upgrade_info_update",
 		"trg_log_upgrade_info_delete",
 
+		"trg_log_model_agent_upgrade_state_insert",
+		"trg_log_model_agent_upgrade_state_update",
+		"trg_log_model_agent_upgrade_state_delete",
+
 		"trg_log_secret_backend_rotation_insert",
 		"trg_log_secret_backend_rotation_update",
 		"trg_log_secret_backend_rotation_delete",
@@ -21,6 +21,7 @@ import (
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-cloud-instance-triggers.gen.go -package=triggers -tables=machine_cloud_instance
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/machine-requires-reboot-triggers.gen.go -package=triggers -tables=machine_requires_reboot
 //go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/application-triggers.gen.go -package=triggers -tables=application,charm,unit,application_scale,port_range
+//go:generate go run ./../../generate/triggergen -db=model -destination=./model/triggers/relation-triggers.gen.go -package=triggers -tables=relation,relation_status
 
 //go:embed model/sql/*.sql
 var modelSchemaDir embed.FS
@@ -52,6 +53,8 @@ const (
 	tablePortRange
 	tableSecretDeletedValueRef
 	tableApplication
+	tableRelation
+	tableRelationStatus
 )
 
 // ModelDDL is used to create model databases.
@@ -113,6 +116,8 @@ func ModelDDL() *schema.Schema {
 		triggers.ChangeLogTriggersForPortRange("unit_uuid", tablePortRange),
 		triggers.ChangeLogTriggersForSecretDeletedValueRef("revision_uuid", tableSecretDeletedValueRef),
 		triggers.ChangeLogTriggersForApplication("uuid", tableApplication),
+		triggers.ChangeLogTriggersForRelation("uuid", tableRelation),
+		triggers.ChangeLogTriggersForRelationStatus("relation_uuid", tableRelationStatus),
 	)
 
 	// Generic triggers.
@@ -129,6 +134,11 @@ func ModelDDL() *schema.Schema {
 			"NEW.sequence <= OLD.sequence",
 			"sequence number must monotonically increase",
 		),
+
+		triggerGuardForTable("relation_sequence",
+			"NEW.sequence <= OLD.sequence",
+			"sequence number must monotonically increase",
+		),
 	)
 
 	modelSchema := schema.New()
@@ -19,6 +19,17 @@ CREATE TABLE provider_subnet (
 CREATE UNIQUE INDEX idx_provider_subnet_subnet_uuid
 ON provider_subnet (subnet_uuid);
 
+-- subnet_provider_attribute holds provider-specific metadata about a subnet.
+CREATE TABLE subnet_provider_attribute (
+    subnet_uuid TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (subnet_uuid, "key"),
+    CONSTRAINT fk_subnet_provider_attribute_subnet
+    FOREIGN KEY (subnet_uuid)
+    REFERENCES subnet (uuid)
+);
+
 CREATE TABLE provider_network (
     uuid TEXT NOT NULL PRIMARY KEY,
     provider_network_id TEXT NOT NULL
@@ -344,7 +344,7 @@ CREATE TABLE storage_volume_attachment_plan_attr (
     value TEXT NOT NULL,
     CONSTRAINT fk_storage_vol_attach_plan_attr_plan
     FOREIGN KEY (attachment_plan_uuid)
-    REFERENCES storage_volume_attachment_plan (attachment_plan_uuid)
+    REFERENCES storage_volume_attachment_plan (uuid)
 );
 
 CREATE UNIQUE INDEX idx_storage_vol_attachment_plan_attr
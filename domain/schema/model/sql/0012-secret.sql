@@ -60,6 +60,34 @@ CREATE TABLE secret_rotation (
     REFERENCES secret_metadata (secret_id)
 );
 
+-- secret_content_schema holds the keys which the content of new revisions of
+-- a secret must contain, or whose values must match a pattern. A secret with
+-- no rows has no content schema.
+CREATE TABLE secret_content_schema (
+    secret_id TEXT NOT NULL,
+    content_key TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    value_pattern TEXT,
+    CONSTRAINT fk_secret_content_schema_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id),
+    PRIMARY KEY (secret_id, content_key)
+);
+
+-- secret_generation_policy holds how the controller generates the value of a
+-- key of a secret's content when the secret is rotated. A secret with no row
+-- has its content supplied by the owner.
+CREATE TABLE secret_generation_policy (
+    secret_id TEXT NOT NULL PRIMARY KEY,
+    content_key TEXT NOT NULL,
+    length INT NOT NULL,
+    charset TEXT NOT NULL,
+    format TEXT NOT NULL,
+    CONSTRAINT fk_secret_generation_policy_secret_metadata_id
+    FOREIGN KEY (secret_id)
+    REFERENCES secret_metadata (secret_id)
+);
+
 -- 1:1
 CREATE TABLE secret_value_ref (
     revision_uuid TEXT NOT NULL PRIMARY KEY,
@@ -117,6 +145,10 @@ CREATE TABLE secret_revision_obsolete (
     -- pending_delete is true if the revision is to be deleted.
     -- It will not be drained to a new active backend.
     pending_delete BOOLEAN NOT NULL DEFAULT (FALSE),
+    -- deleted_at is the time at which the content of the revision was
+    -- deleted from its secret backend. It is NULL until then, and is only
+    -- relevant to revisions with a secret_value_ref.
+    deleted_at DATETIME,
     CONSTRAINT fk_secret_revision_obsolete_revision_uuid
     FOREIGN KEY (revision_uuid)
     REFERENCES secret_revision (uuid)
@@ -264,6 +296,10 @@ CREATE TABLE secret_permission (
     -- It will be an application, unit, relation, or model uuid.
     scope_uuid TEXT NOT NULL,
     scope_type_id TEXT NOT NULL,
+    -- expire_time is when a time limited grant expires. An expired
+    -- grant confers no access and is removed when expired grants
+    -- are pruned.
+    expire_time DATETIME,
     CONSTRAINT pk_secret_permission_secret_id_subject_uuid
     PRIMARY KEY (secret_id, subject_uuid),
     CONSTRAINT chk_empty_scope_uuid
@@ -296,6 +332,7 @@ SELECT
     sp.role_id,
     sp.subject_type_id,
     sp.scope_type_id,
+    sp.expire_time,
     -- subject_id is the natural id of the subject entity (uuid for model)
     (CASE
         WHEN sp.subject_type_id = 0 THEN suu.name
@@ -317,4 +354,6 @@ LEFT JOIN unit AS suu ON sp.subject_uuid = suu.uuid
 LEFT JOIN application AS sua ON sp.subject_uuid = sua.uuid
 LEFT JOIN unit AS scu ON sp.scope_uuid = scu.uuid
 LEFT JOIN application AS sca ON sp.scope_uuid = sca.uuid
-INNER JOIN model AS m;
+INNER JOIN model AS m
+-- Expired grants are excluded, so every access check treats them as revoked.
+WHERE sp.expire_time IS NULL OR JULIANDAY(sp.expire_time) > JULIANDAY('now');
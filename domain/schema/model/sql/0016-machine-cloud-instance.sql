@@ -34,6 +34,43 @@ SELECT
 FROM machine_cloud_instance AS m
 LEFT JOIN availability_zone AS az ON m.availability_zone_uuid = az.uuid;
 
+-- machine_hardware_characteristics_cache holds the hardware characteristics
+-- of a machine's instance as last reported by the provider, so that they need
+-- not be fetched from the provider every time they are read. It is populated
+-- when the machine is provisioned, and removed along with the instance. The
+-- instance_id records the instance to which the row applies, so that it is
+-- not used for a replacement instance.
+CREATE TABLE machine_hardware_characteristics_cache (
+    machine_uuid TEXT NOT NULL PRIMARY KEY,
+    instance_id TEXT NOT NULL,
+    arch TEXT,
+    mem INT,
+    root_disk INT,
+    root_disk_source TEXT,
+    cpu_cores INT,
+    cpu_power INT,
+    availability_zone_name TEXT,
+    virt_type TEXT,
+    last_refreshed DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f', 'NOW', 'utc')),
+    CONSTRAINT fk_machine_hardware_characteristics_cache_machine_cloud_instance
+    FOREIGN KEY (machine_uuid)
+    REFERENCES machine_cloud_instance (machine_uuid)
+);
+
+-- machine_instance_tag holds the key-value tags of a machine's instance as
+-- last reported by the provider, including any added directly in the cloud
+-- rather than by Juju. They are replaced as a whole each time they are synced
+-- from the provider, and removed along with the instance.
+CREATE TABLE machine_instance_tag (
+    machine_uuid TEXT NOT NULL,
+    "key" TEXT NOT NULL,
+    value TEXT NOT NULL,
+    PRIMARY KEY (machine_uuid, "key"),
+    CONSTRAINT fk_machine_instance_tag_machine_cloud_instance
+    FOREIGN KEY (machine_uuid)
+    REFERENCES machine_cloud_instance (machine_uuid)
+);
+
 CREATE TABLE instance_tag (
     machine_uuid TEXT NOT NULL,
     tag TEXT NOT NULL,
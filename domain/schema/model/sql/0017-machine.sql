@@ -1,3 +1,17 @@
+CREATE TABLE container_type (
+    id INT PRIMARY KEY,
+    value TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_container_type_value
+ON container_type (value);
+
+INSERT INTO container_type VALUES
+(0, 'bare-metal'),
+(1, 'lxd'),
+(2, 'kvm'),
+(3, 'docker');
+
 CREATE TABLE machine (
     uuid TEXT NOT NULL PRIMARY KEY,
     name TEXT NOT NULL,
@@ -14,12 +28,17 @@ CREATE TABLE machine (
     hostname TEXT,
     is_controller BOOLEAN,
     keep_instance BOOLEAN,
+    -- container_type_id is set when the machine is created.
+    container_type_id INT NOT NULL DEFAULT 0,
     CONSTRAINT fk_machine_net_node
     FOREIGN KEY (net_node_uuid)
     REFERENCES net_node (uuid),
     CONSTRAINT fk_machine_life
     FOREIGN KEY (life_id)
-    REFERENCES life (id)
+    REFERENCES life (id),
+    CONSTRAINT fk_machine_container_type
+    FOREIGN KEY (container_type_id)
+    REFERENCES container_type (id)
 );
 
 CREATE UNIQUE INDEX idx_name
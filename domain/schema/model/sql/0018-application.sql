@@ -56,6 +56,23 @@ CREATE TABLE application_scale (
     REFERENCES application (uuid)
 );
 
+-- The scale_target_history table records each change to the desired scale
+-- of an application, along with who requested it, for auditing.
+CREATE TABLE scale_target_history (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    application_uuid TEXT NOT NULL,
+    actor TEXT,
+    old_scale INT NOT NULL,
+    new_scale INT NOT NULL,
+    changed_at TIMESTAMP NOT NULL,
+    CONSTRAINT fk_scale_target_history_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
+CREATE INDEX idx_scale_target_history_application
+ON scale_target_history (application_uuid, changed_at);
+
 CREATE TABLE application_endpoint_space (
     application_uuid TEXT NOT NULL,
     space_uuid TEXT,
@@ -126,6 +143,19 @@ CREATE TABLE application_platform (
     REFERENCES architecture (id)
 );
 
+-- The application_latest_charm_revision table caches the latest revision of
+-- the charm available in the charm repository for the channel that the
+-- application is tracking. It is maintained by the charm revision updater, so
+-- that pending charm upgrades can be found without querying the repository.
+CREATE TABLE application_latest_charm_revision (
+    application_uuid TEXT NOT NULL PRIMARY KEY,
+    revision INT NOT NULL,
+    updated_at DATETIME NOT NULL,
+    CONSTRAINT fk_application_latest_charm_revision_application
+    FOREIGN KEY (application_uuid)
+    REFERENCES application (uuid)
+);
+
 CREATE TABLE application_channel (
     application_uuid TEXT NOT NULL,
     track TEXT,
@@ -147,6 +147,8 @@ CREATE TABLE ip_address (
     scope_id INT NOT NULL,
     -- the link layer device this address belongs to.
     device_uuid TEXT NOT NULL,
+    -- the subnet this address belongs to, if known.
+    subnet_uuid TEXT,
 
     CONSTRAINT fk_ip_address_link_layer_device
     FOREIGN KEY (device_uuid)
@@ -162,9 +164,15 @@ CREATE TABLE ip_address (
     REFERENCES ip_address_config_type (id),
     CONSTRAINT fk_ip_address_scope
     FOREIGN KEY (scope_id)
-    REFERENCES ip_address_scope (id)
+    REFERENCES ip_address_scope (id),
+    CONSTRAINT fk_ip_address_subnet
+    FOREIGN KEY (subnet_uuid)
+    REFERENCES subnet (uuid)
 );
 
+CREATE INDEX idx_ip_address_subnet
+ON ip_address (subnet_uuid);
+
 CREATE TABLE net_node_ip_address (
     address_uuid TEXT NOT NULL PRIMARY KEY,
 
@@ -53,6 +53,11 @@ CREATE TABLE relation (
     REFERENCES life (id)
 );
 
+-- Relation IDs are allocated from relation_sequence and are never reused
+-- within a model.
+CREATE UNIQUE INDEX idx_relation_id
+ON relation (relation_id);
+
 -- The relation_unit table links a relation to a specific unit.
 CREATE TABLE relation_unit (
     uuid TEXT NOT NULL PRIMARY KEY,
@@ -105,6 +110,10 @@ CREATE TABLE relation_status (
     relation_uuid TEXT NOT NULL PRIMARY KEY,
     relation_status_type_id TEXT NOT NULL,
     suspended_reason TEXT,
+    -- suspended_by and suspended_at record who suspended the relation and
+    -- when. They are only set while the relation is suspended.
+    suspended_by TEXT,
+    suspended_at TIMESTAMP,
     updated_at TIMESTAMP NOT NULL,
     CONSTRAINT fk_relation_uuid
     FOREIGN KEY (relation_uuid)
@@ -127,17 +136,40 @@ INSERT INTO relation_status_type VALUES
 (1, 'joined'),
 (2, 'broken'),
 (3, 'suspending'),
-(4, 'suspended');
+(4, 'suspended'),
+(5, 'error');
 
 -- The relation_sequence table is used to keep track of the
 -- sequence number for relation IDs within a model. Each
 -- relation must have an relation ID.
 CREATE TABLE relation_sequence (
     -- The sequence number will start at 0 for each model and will be
-    -- incremented.
+    -- incremented. It is the next relation ID to be allocated, so it is
+    -- always greater than every relation ID in use.
     sequence INT NOT NULL DEFAULT 0
 );
 
 -- A unique constraint over a constant index ensures only 1 entry matching the
 -- condition can exist.
 CREATE UNIQUE INDEX idx_singleton_relation_sequence ON relation_sequence ((1));
+
+-- The relation_status_history table records each status
+-- transition of a relation, so that operators can see the
+-- sequence of status changes that led to the current status.
+CREATE TABLE relation_status_history (
+    uuid TEXT NOT NULL PRIMARY KEY,
+    relation_uuid TEXT NOT NULL,
+    relation_status_type_id TEXT NOT NULL,
+    message TEXT,
+    actor TEXT,
+    updated_at TIMESTAMP NOT NULL,
+    CONSTRAINT fk_relation_uuid
+    FOREIGN KEY (relation_uuid)
+    REFERENCES relation (uuid),
+    CONSTRAINT fk_relation_status_type_id
+    FOREIGN KEY (relation_status_type_id)
+    REFERENCES relation_status_type (id)
+);
+
+CREATE INDEX idx_relation_status_history_relation
+ON relation_status_history (relation_uuid, updated_at);
@@ -0,0 +1,9 @@
+-- The schema_checksum table records a checksum of the tables and indexes
+-- of the database, computed after the schema migrations are applied. It is
+-- used to detect schema changes made outside of the migrations.
+CREATE TABLE schema_checksum (
+    hash TEXT NOT NULL,
+    updated_at DATETIME NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_singleton_schema_checksum ON schema_checksum ((1));
@@ -31,9 +31,11 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"application_constraint",
 		"application_endpoint_space",
 		"application_endpoint_cidr",
+		"application_latest_charm_revision",
 		"application_platform",
 		"application_setting",
 		"application_scale",
+		"scale_target_history",
 		"cloud_service",
 
 		// Annotations
@@ -126,6 +128,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"constraint_zone",
 
 		// Machine
+		"container_type",
 		"machine",
 		"machine_parent",
 		"machine_constraint",
@@ -141,6 +144,8 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"machine_cloud_instance_status_value",
 		"machine_cloud_instance_status",
 		"machine_cloud_instance_status_data",
+		"machine_hardware_characteristics_cache",
+		"machine_instance_tag",
 		"machine_lxd_profile",
 
 		// Charm
@@ -197,6 +202,7 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		// Subnet
 		"subnet",
 		"provider_subnet",
+		"subnet_provider_attribute",
 		"provider_network",
 		"provider_network_subnet",
 		"availability_zone",
@@ -233,6 +239,8 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"secret_reference",
 		"secret_metadata",
 		"secret_rotation",
+		"secret_content_schema",
+		"secret_generation_policy",
 		"secret_value_ref",
 		"secret_deleted_value_ref",
 		"secret_content",
@@ -260,9 +268,13 @@ func (s *modelSchemaSuite) TestModelTables(c *gc.C) {
 		"relation_endpoint",
 		"relation_sequence",
 		"relation_status",
+		"relation_status_history",
 		"relation_status_type",
 		"relation_unit",
 		"relation_unit_setting",
+
+		// Schema checksum
+		"schema_checksum",
 	)
 	got := readEntityNames(c, s.DB(), "table")
 	wanted := expected.Union(internalTableNames)
@@ -358,6 +370,14 @@ func (s *modelSchemaSuite) TestModelTriggers(c *gc.C) {
 		"trg_log_port_range_insert",
 		"trg_log_port_range_update",
 
+		"trg_log_relation_delete",
+		"trg_log_relation_insert",
+		"trg_log_relation_update",
+
+		"trg_log_relation_status_delete",
+		"trg_log_relation_status_insert",
+		"trg_log_relation_status_update",
+
 		"trg_log_secret_deleted_value_ref_delete",
 		"trg_log_secret_deleted_value_ref_insert",
 		"trg_log_secret_deleted_value_ref_update",
@@ -425,6 +445,7 @@ func (s *modelSchemaSuite) TestModelTriggers(c *gc.C) {
 		"trg_model_immutable_delete",
 		"trg_model_immutable_update",
 
+		"trg_relation_sequence_guard_update",
 		"trg_secret_permission_guard_update",
 		"trg_sequence_charm_local_guard_update",
 	)
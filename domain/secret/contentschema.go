@@ -0,0 +1,131 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+// ContentSchema describes the content which each new revision of a secret
+// must have. A secret without a schema accepts any content.
+type ContentSchema struct {
+	// RequiredKeys are the keys which the content must contain.
+	RequiredKeys []string
+
+	// ValuePatterns maps content keys to regular expressions which the
+	// decoded value of the key must match in full, if the key is present.
+	ValuePatterns map[string]string
+}
+
+// IsEmpty returns true if the schema places no constraints on content.
+func (s ContentSchema) IsEmpty() bool {
+	return len(s.RequiredKeys) == 0 && len(s.ValuePatterns) == 0
+}
+
+// Validate returns an error satisfying [errors.NotValid] if the schema
+// contains an empty key or a value pattern which cannot be compiled.
+func (s ContentSchema) Validate() error {
+	for _, key := range s.RequiredKeys {
+		if key == "" {
+			return errors.NotValidf("empty required key")
+		}
+	}
+	for key, pattern := range s.ValuePatterns {
+		if key == "" {
+			return errors.NotValidf("empty pattern key")
+		}
+		if _, err := compileValuePattern(pattern); err != nil {
+			return errors.NotValidf("value pattern %q for key %q", pattern, key)
+		}
+	}
+	return nil
+}
+
+// ValidateContent checks the input secret data against the schema. The data
+// values are base64 encoded, as held in [coresecrets.SecretData], and
+// patterns are matched against the decoded values. If the data does not
+// satisfy the schema, a *ContentValidationError is returned.
+func (s ContentSchema) ValidateContent(data coresecrets.SecretData) error {
+	var missing, invalid []string
+	for _, key := range s.RequiredKeys {
+		if _, ok := data[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	for key, pattern := range s.ValuePatterns {
+		value, ok := data[key]
+		if !ok {
+			continue
+		}
+		re, err := compileValuePattern(pattern)
+		if err != nil {
+			return errors.NotValidf("value pattern %q for key %q", pattern, key)
+		}
+		if decoded, err := base64.StdEncoding.DecodeString(value); err == nil {
+			value = string(decoded)
+		}
+		if !re.MatchString(value) {
+			invalid = append(invalid, key)
+		}
+	}
+	if len(missing) == 0 && len(invalid) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	sort.Strings(invalid)
+	return &ContentValidationError{
+		MissingKeys: missing,
+		InvalidKeys: invalid,
+	}
+}
+
+func compileValuePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// ContentValidationError is returned when the content of a secret does not
+// satisfy its content schema. It satisfies
+// [secreterrors.SecretContentNotValid].
+type ContentValidationError struct {
+	// MissingKeys are the required keys which the content does not contain.
+	MissingKeys []string
+
+	// InvalidKeys are the keys whose values do not match their pattern.
+	InvalidKeys []string
+}
+
+// Error implements error.
+func (e *ContentValidationError) Error() string {
+	var problems []string
+	if len(e.MissingKeys) > 0 {
+		problems = append(problems, fmt.Sprintf("missing keys %s", quoteKeys(e.MissingKeys)))
+	}
+	if len(e.InvalidKeys) > 0 {
+		problems = append(problems, fmt.Sprintf("invalid values for keys %s", quoteKeys(e.InvalidKeys)))
+	}
+	return fmt.Sprintf("%s: %s", secreterrors.SecretContentNotValid, strings.Join(problems, ", "))
+}
+
+// Unwrap returns [secreterrors.SecretContentNotValid], so that errors.Is can
+// be used to test for content validation errors.
+func (e *ContentValidationError) Unwrap() error {
+	return secreterrors.SecretContentNotValid
+}
+
+func quoteKeys(keys []string) string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = fmt.Sprintf("%q", key)
+	}
+	return strings.Join(quoted, ", ")
+}
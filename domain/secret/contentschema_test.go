@@ -0,0 +1,84 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"encoding/base64"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+)
+
+type contentSchemaSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&contentSchemaSuite{})
+
+func encode(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func (s *contentSchemaSuite) TestValidate(c *gc.C) {
+	schema := ContentSchema{
+		RequiredKeys:  []string{"username"},
+		ValuePatterns: map[string]string{"port": "[0-9]+"},
+	}
+	c.Assert(schema.Validate(), jc.ErrorIsNil)
+
+	schema.ValuePatterns["port"] = "[0-9"
+	c.Assert(schema.Validate(), jc.ErrorIs, errors.NotValid)
+
+	schema = ContentSchema{RequiredKeys: []string{""}}
+	c.Assert(schema.Validate(), jc.ErrorIs, errors.NotValid)
+}
+
+func (s *contentSchemaSuite) TestValidateContent(c *gc.C) {
+	schema := ContentSchema{
+		RequiredKeys:  []string{"username", "password"},
+		ValuePatterns: map[string]string{"port": "[0-9]+"},
+	}
+	err := schema.ValidateContent(coresecrets.SecretData{
+		"username": encode("fred"),
+		"password": encode("secret"),
+		"port":     encode("8080"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Keys with a pattern are optional unless also required.
+	err = schema.ValidateContent(coresecrets.SecretData{
+		"username": encode("fred"),
+		"password": encode("secret"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *contentSchemaSuite) TestValidateContentNotValid(c *gc.C) {
+	schema := ContentSchema{
+		RequiredKeys:  []string{"username", "password"},
+		ValuePatterns: map[string]string{"port": "[0-9]+"},
+	}
+	err := schema.ValidateContent(coresecrets.SecretData{
+		"username": encode("fred"),
+		// The pattern must match the whole value.
+		"port": encode("8080x"),
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretContentNotValid)
+	c.Assert(err, gc.ErrorMatches, `secret content not valid: missing keys "password", invalid values for keys "port"`)
+
+	var validationErr *ContentValidationError
+	c.Assert(errors.As(err, &validationErr), jc.IsTrue)
+	c.Check(validationErr.MissingKeys, jc.DeepEquals, []string{"password"})
+	c.Check(validationErr.InvalidKeys, jc.DeepEquals, []string{"port"})
+}
+
+func (s *contentSchemaSuite) TestIsEmpty(c *gc.C) {
+	c.Check(ContentSchema{}.IsEmpty(), jc.IsTrue)
+	c.Check(ContentSchema{RequiredKeys: []string{"a"}}.IsEmpty(), jc.IsFalse)
+}
@@ -41,6 +41,19 @@ const (
 	// being operated on does not exist.
 	SecretAccessScopeNotFound = errors.ConstError("secret access scope not found")
 
+	// SecretContentNotExternal describes an error that occurs when a reference
+	// to the content of a secret revision is requested but the content is held
+	// by the controller rather than an external backend.
+	SecretContentNotExternal = errors.ConstError("secret content is not stored in an external backend")
+
+	// SecretContentNotValid describes an error that occurs when the content of
+	// a secret does not satisfy the content schema registered for the secret.
+	SecretContentNotValid = errors.ConstError("secret content not valid")
+
 	// MissingSecretBackendID describes an error that occurs when importing a secret and the backend doesn't exist.
 	MissingSecretBackendID = errors.ConstError("missing secret backend id")
+
+	// SecretURINotValid describes an error that occurs when a secret URI
+	// cannot be parsed, or does not refer to a secret in its canonical form.
+	SecretURINotValid = errors.ConstError("secret URI not valid")
 )
@@ -0,0 +1,176 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"math/big"
+
+	"github.com/juju/errors"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+)
+
+// MaxGeneratedLength is the longest value which can be generated for a
+// secret.
+const MaxGeneratedLength = 4096
+
+// GenerationCharset is the set of characters from which a generated text
+// value is drawn.
+type GenerationCharset string
+
+const (
+	// CharsetAlphanumeric draws from upper and lower case letters and
+	// digits. It is the default charset.
+	CharsetAlphanumeric GenerationCharset = "alphanumeric"
+
+	// CharsetAlpha draws from upper and lower case letters.
+	CharsetAlpha GenerationCharset = "alpha"
+
+	// CharsetNumeric draws from digits.
+	CharsetNumeric GenerationCharset = "numeric"
+
+	// CharsetPrintable draws from the printable ASCII characters, other
+	// than space.
+	CharsetPrintable GenerationCharset = "printable"
+)
+
+var charsets = map[GenerationCharset]string{
+	CharsetAlphanumeric: "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789",
+	CharsetAlpha:        "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz",
+	CharsetNumeric:      "0123456789",
+	CharsetPrintable:    "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~",
+}
+
+// GenerationFormat is the form of a generated value.
+type GenerationFormat string
+
+const (
+	// FormatText generates characters drawn from the policy's charset. It is
+	// the default format.
+	FormatText GenerationFormat = "text"
+
+	// FormatBase64 generates random bytes, encoded as unpadded standard
+	// base64.
+	FormatBase64 GenerationFormat = "base64"
+
+	// FormatHex generates random bytes, encoded as lower case hex.
+	FormatHex GenerationFormat = "hex"
+)
+
+// GenerationPolicy describes how the controller generates the value of a
+// key of a secret's content, rather than the value being supplied. The policy
+// is held alongside the secret and is never part of its content.
+type GenerationPolicy struct {
+	// Key is the content key whose value is generated.
+	Key string
+
+	// Length is the number of characters in the generated value.
+	Length int
+
+	// Charset is the set of characters from which a text value is drawn.
+	// It must be empty unless the format is text.
+	Charset GenerationCharset
+
+	// Format is the form of the generated value.
+	Format GenerationFormat
+}
+
+// IsEmpty returns true if the policy does not generate any value.
+func (p GenerationPolicy) IsEmpty() bool {
+	return p == GenerationPolicy{}
+}
+
+// Validate returns an error satisfying [errors.NotValid] if the policy
+// cannot be used to generate a value.
+func (p GenerationPolicy) Validate() error {
+	if p.Key == "" {
+		return errors.NotValidf("empty generated key")
+	}
+	if p.Length < 1 || p.Length > MaxGeneratedLength {
+		return errors.NotValidf("generated length %d, must be between 1 and %d", p.Length, MaxGeneratedLength)
+	}
+	switch p.format() {
+	case FormatText:
+		if _, ok := charsets[p.charset()]; !ok {
+			return errors.NotValidf("generation charset %q", p.Charset)
+		}
+	case FormatBase64, FormatHex:
+		if p.Charset != "" {
+			return errors.NotValidf("generation charset %q with format %q", p.Charset, p.Format)
+		}
+	default:
+		return errors.NotValidf("generation format %q", p.Format)
+	}
+	return nil
+}
+
+func (p GenerationPolicy) format() GenerationFormat {
+	if p.Format == "" {
+		return FormatText
+	}
+	return p.Format
+}
+
+func (p GenerationPolicy) charset() GenerationCharset {
+	if p.Charset == "" {
+		return CharsetAlphanumeric
+	}
+	return p.Charset
+}
+
+// Generate returns a new value for the policy's key, drawn from a
+// cryptographically secure source. The value is base64 encoded, as held in
+// [coresecrets.SecretData].
+func (p GenerationPolicy) Generate() (coresecrets.SecretData, error) {
+	return p.generate(rand.Reader)
+}
+
+func (p GenerationPolicy) generate(source io.Reader) (coresecrets.SecretData, error) {
+	if err := p.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+	var value string
+	switch p.format() {
+	case FormatText:
+		chars := charsets[p.charset()]
+		size := big.NewInt(int64(len(chars)))
+		result := make([]byte, p.Length)
+		for i := range result {
+			// rand.Int draws uniformly, so no character is favoured.
+			n, err := rand.Int(source, size)
+			if err != nil {
+				return nil, errors.Annotate(err, "generating secret value")
+			}
+			result[i] = chars[n.Int64()]
+		}
+		value = string(result)
+	case FormatBase64:
+		buf, err := randomBytes(source, (p.Length*3+3)/4)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		value = base64.RawStdEncoding.EncodeToString(buf)[:p.Length]
+	case FormatHex:
+		buf, err := randomBytes(source, (p.Length+1)/2)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		value = hex.EncodeToString(buf)[:p.Length]
+	}
+	return coresecrets.SecretData{
+		p.Key: base64.StdEncoding.EncodeToString([]byte(value)),
+	}, nil
+}
+
+func randomBytes(source io.Reader, n int) ([]byte, error) {
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(source, buf); err != nil {
+		return nil, errors.Annotate(err, "generating secret value")
+	}
+	return buf, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secret
+
+import (
+	"encoding/base64"
+	"regexp"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+)
+
+type generationPolicySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&generationPolicySuite{})
+
+func (s *generationPolicySuite) TestValidate(c *gc.C) {
+	for _, policy := range []GenerationPolicy{
+		{Key: "password", Length: 16},
+		{Key: "password", Length: 16, Charset: CharsetPrintable, Format: FormatText},
+		{Key: "token", Length: MaxGeneratedLength, Format: FormatHex},
+		{Key: "token", Length: 1, Format: FormatBase64},
+	} {
+		c.Check(policy.Validate(), jc.ErrorIsNil, gc.Commentf("%+v", policy))
+	}
+}
+
+func (s *generationPolicySuite) TestValidateNotValid(c *gc.C) {
+	for _, policy := range []GenerationPolicy{
+		{Length: 16},
+		{Key: "password"},
+		{Key: "password", Length: MaxGeneratedLength + 1},
+		{Key: "password", Length: 16, Charset: "emoji"},
+		{Key: "password", Length: 16, Format: "uuencode"},
+		{Key: "password", Length: 16, Charset: CharsetNumeric, Format: FormatHex},
+	} {
+		c.Check(policy.Validate(), jc.ErrorIs, errors.NotValid, gc.Commentf("%+v", policy))
+	}
+}
+
+func (s *generationPolicySuite) TestGenerate(c *gc.C) {
+	for _, t := range []struct {
+		policy  GenerationPolicy
+		pattern string
+	}{{
+		policy:  GenerationPolicy{Key: "password", Length: 32},
+		pattern: "[A-Za-z0-9]{32}",
+	}, {
+		policy:  GenerationPolicy{Key: "password", Length: 10, Charset: CharsetAlpha},
+		pattern: "[A-Za-z]{10}",
+	}, {
+		policy:  GenerationPolicy{Key: "pin", Length: 6, Charset: CharsetNumeric},
+		pattern: "[0-9]{6}",
+	}, {
+		policy:  GenerationPolicy{Key: "password", Length: 20, Charset: CharsetPrintable},
+		pattern: "[!-~]{20}",
+	}, {
+		policy:  GenerationPolicy{Key: "token", Length: 15, Format: FormatHex},
+		pattern: "[0-9a-f]{15}",
+	}, {
+		policy:  GenerationPolicy{Key: "token", Length: 22, Format: FormatBase64},
+		pattern: "[A-Za-z0-9+/]{22}",
+	}} {
+		data, err := t.policy.Generate()
+		c.Assert(err, jc.ErrorIsNil)
+		c.Assert(data, gc.HasLen, 1)
+		decoded, err := base64.StdEncoding.DecodeString(data[t.policy.Key])
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(regexp.MustCompile("^"+t.pattern+"$").MatchString(string(decoded)), jc.IsTrue,
+			gc.Commentf("%+v generated %q", t.policy, decoded))
+	}
+}
+
+func (s *generationPolicySuite) TestGenerateIsRandom(c *gc.C) {
+	policy := GenerationPolicy{Key: "password", Length: 32}
+	first, err := policy.Generate()
+	c.Assert(err, jc.ErrorIsNil)
+	second, err := policy.Generate()
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(first, gc.Not(jc.DeepEquals), second)
+}
+
+func (s *generationPolicySuite) TestGenerateNotValid(c *gc.C) {
+	_, err := GenerationPolicy{Key: "password"}.Generate()
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+}
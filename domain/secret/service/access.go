@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/juju/errors"
 	"github.com/juju/names/v5"
@@ -19,13 +20,16 @@ import (
 // GetSecretGrants returns the subjects which have the specified access to the secret.
 // It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
 func (s *SecretService) GetSecretGrants(ctx context.Context, uri *secrets.URI, role secrets.SecretRole) ([]SecretAccess, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Trace(err)
+	}
 	accessors, err := s.secretState.GetSecretGrants(ctx, uri, role)
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
 	result := make([]SecretAccess, len(accessors))
 	for i, accessor := range accessors {
-		sa := SecretAccess{Role: role}
+		sa := SecretAccess{Role: role, ExpireTime: accessor.ExpireTime}
 		sa.Subject.ID = accessor.SubjectID
 		switch accessor.SubjectTypeID {
 		case domainsecret.SubjectUnit:
@@ -62,6 +66,9 @@ func (s *SecretService) GetSecretGrants(ctx context.Context, uri *secrets.URI, r
 // GetSecretAccessScope returns the access scope for the specified accessor's permission on the secret.
 // It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
 func (s *SecretService) GetSecretAccessScope(ctx context.Context, uri *secrets.URI, accessor SecretAccessor) (SecretAccessScope, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return SecretAccessScope{}, errors.Trace(err)
+	}
 	ap := domainsecret.AccessParams{
 		SubjectID: accessor.ID,
 	}
@@ -127,7 +134,14 @@ func (s *SecretService) getSecretAccess(ctx context.Context, uri *secrets.URI, a
 // If an attempt is made to change an existing permission's scope or subject type, an error
 // satisfying [secreterrors.InvalidSecretPermissionChange] is returned.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// It returns an error satisfying [errors.NotValid] if the grant expiry is not in the future.
 func (s *SecretService) GrantSecretAccess(ctx context.Context, uri *secrets.URI, params SecretAccessParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Trace(err)
+	}
+	if err := s.validateGrantExpiry(params.ExpireTime); err != nil {
+		return errors.Trace(err)
+	}
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Trace(err)
@@ -138,22 +152,80 @@ func (s *SecretService) GrantSecretAccess(ctx context.Context, uri *secrets.URI,
 	})
 }
 
+// BulkGrantSecretAccess grants access to the secret for each of the specified
+// subjects in a single transaction. Each grant behaves as per
+// [SecretService.GrantSecretAccess]; repeating an existing grant is a no-op and
+// granting a different role to a subject which already has access updates the
+// role.
+//
+// Grants are recorded per subject and are never merged. A unit's effective
+// access to a secret is the broader of its own grant and that of its
+// application, so granting access to an application is never narrowed by a
+// pre-existing grant to one of its units, and the unit grant is left intact.
+//
+// The result holds the outcome of each grant, in the order of the params. A
+// grant for a subject or scope which doesn't exist, or which attempts to change
+// an existing permission's scope or subject type, is reported in its result
+// and doesn't prevent the other grants from being applied.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+// It returns an error satisfying [errors.NotValid] if any grant expiry is not in the future.
+func (s *SecretService) BulkGrantSecretAccess(
+	ctx context.Context, uri *secrets.URI, params SecretBulkAccessParams,
+) ([]SecretAccessResult, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, g := range params.Grants {
+		if err := s.validateGrantExpiry(g.ExpireTime); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	grants := make([]domainsecret.GrantParams, len(params.Grants))
+	for i, g := range params.Grants {
+		grants[i] = grantParams(SecretAccessParams{
+			Scope:      g.Scope,
+			Subject:    g.Subject,
+			Role:       g.Role,
+			ExpireTime: g.ExpireTime,
+		})
+	}
+
+	var results []error
+	err = withCaveat(ctx, func(innerCtx context.Context) error {
+		var err error
+		results, err = s.secretState.GrantAccessBulk(innerCtx, uri, grants)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return accessResults(params.Grants, results), nil
+}
+
+// validateGrantExpiry returns an error satisfying [errors.NotValid] if the
+// expiry of a grant being made has already passed.
+func (s *SecretService) validateGrantExpiry(expireTime *time.Time) error {
+	if expireTime != nil && !expireTime.After(s.clock.Now()) {
+		return errors.NotValidf("grant expiry %s in the past", expireTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 func grantParams(in SecretAccessParams) domainsecret.GrantParams {
 	p := domainsecret.GrantParams{
-		ScopeID:   in.Scope.ID,
-		SubjectID: in.Subject.ID,
-		RoleID:    domainsecret.MarshallRole(in.Role),
-	}
-	switch in.Subject.Kind {
-	case UnitAccessor:
-		p.SubjectTypeID = domainsecret.SubjectUnit
-	case ApplicationAccessor:
-		p.SubjectTypeID = domainsecret.SubjectApplication
-	case RemoteApplicationAccessor:
-		p.SubjectTypeID = domainsecret.SubjectRemoteApplication
-	case ModelAccessor:
-		p.SubjectTypeID = domainsecret.SubjectModel
+		ScopeID:    in.Scope.ID,
+		RoleID:     domainsecret.MarshallRole(in.Role),
+		ExpireTime: in.ExpireTime,
 	}
+	ap := accessParams(in.Subject)
+	p.SubjectTypeID = ap.SubjectTypeID
+	p.SubjectID = ap.SubjectID
 
 	switch in.Scope.Kind {
 	case UnitAccessScope:
@@ -168,18 +240,11 @@ func grantParams(in SecretAccessParams) domainsecret.GrantParams {
 	return p
 }
 
-// RevokeSecretAccess revokes access to the secret for the specified subject.
-// It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
-func (s *SecretService) RevokeSecretAccess(ctx context.Context, uri *secrets.URI, params SecretAccessParams) error {
-	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
-	if err != nil {
-		return errors.Trace(err)
-	}
-
+func accessParams(subject SecretAccessor) domainsecret.AccessParams {
 	p := domainsecret.AccessParams{
-		SubjectID: params.Subject.ID,
+		SubjectID: subject.ID,
 	}
-	switch params.Subject.Kind {
+	switch subject.Kind {
 	case UnitAccessor:
 		p.SubjectTypeID = domainsecret.SubjectUnit
 	case ApplicationAccessor:
@@ -189,12 +254,90 @@ func (s *SecretService) RevokeSecretAccess(ctx context.Context, uri *secrets.URI
 	case ModelAccessor:
 		p.SubjectTypeID = domainsecret.SubjectModel
 	}
+	return p
+}
 
+func accessResults(grants []SecretAccessGrant, errs []error) []SecretAccessResult {
+	results := make([]SecretAccessResult, len(grants))
+	for i, g := range grants {
+		results[i].Subject = g.Subject
+		if i < len(errs) {
+			results[i].Error = errs[i]
+		}
+	}
+	return results
+}
+
+// RevokeSecretAccess revokes access to the secret for the specified subject.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
+func (s *SecretService) RevokeSecretAccess(ctx context.Context, uri *secrets.URI, params SecretAccessParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Trace(err)
+	}
+	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	p := accessParams(params.Subject)
 	return withCaveat(ctx, func(innerCtx context.Context) error {
 		return s.secretState.RevokeAccess(innerCtx, uri, p)
 	})
 }
 
+// BulkRevokeSecretAccess revokes access to the secret for each of the
+// specified subjects in a single transaction. Only the subject of each grant
+// is used. Revoking access for a subject which has no access is a no-op.
+// Revoking access for an application doesn't revoke any grants made to its
+// units.
+//
+// The result holds the outcome of each revoke, in the order of the params. A
+// revoke for a subject which doesn't exist is reported in its result and
+// doesn't prevent the other revokes from being applied.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
+func (s *SecretService) BulkRevokeSecretAccess(
+	ctx context.Context, uri *secrets.URI, params SecretBulkAccessParams,
+) ([]SecretAccessResult, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Trace(err)
+	}
+	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	subjects := make([]domainsecret.AccessParams, len(params.Grants))
+	for i, g := range params.Grants {
+		subjects[i] = accessParams(g.Subject)
+	}
+
+	var results []error
+	err = withCaveat(ctx, func(innerCtx context.Context) error {
+		var err error
+		results, err = s.secretState.RevokeAccessBulk(innerCtx, uri, subjects)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return accessResults(params.Grants, results), nil
+}
+
+// PruneExpiredSecretGrants removes the secret grants which have expired.
+// Expired grants confer no access whether or not they have been pruned; this
+// keeps them from accumulating.
+func (s *SecretService) PruneExpiredSecretGrants(ctx context.Context) error {
+	pruned, err := s.secretState.DeleteExpiredSecretGrants(ctx)
+	if err != nil {
+		return errors.Annotate(err, "pruning expired secret grants")
+	}
+	if pruned > 0 {
+		s.logger.Debugf("pruned %d expired secret grants", pruned)
+	}
+	return nil
+}
+
 // getManagementCaveat returns a function within which an operation can be
 // executed if the caveat remains satisfied.
 // If the secret is unit-owned and the unit can manage it, the caveat is always
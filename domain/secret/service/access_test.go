@@ -55,6 +55,31 @@ func (s *serviceSuite) TestGetManagementCaveatLeaderUnitAppSecret(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestGetManagementCaveatLeaderUnitNarrowerUnitGrant(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	// A unit grant which is narrower than the application grant doesn't
+	// override it.
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mariadb",
+	}).Return("manage", nil)
+
+	s.ensurer.EXPECT().LeadershipCheck("mariadb", "mariadb/0").Return(goodToken{})
+
+	_, err := s.service.getManagementCaveat(context.Background(), uri, SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestGetManagementCaveatUserSecrets(c *gc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
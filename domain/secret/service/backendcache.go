@@ -0,0 +1,83 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/juju/clock"
+)
+
+// DefaultBackendFailureTTL is how long a failure to connect to a secret
+// backend is remembered when no other window is configured.
+const DefaultBackendFailureTTL = 5 * time.Second
+
+// BackendFailureCache remembers, per secret backend, the most recent failure
+// to connect to it. While a failure is remembered, operations against that
+// backend fail fast with the cached error rather than retrying the
+// connection. A cache is intended to be shared by every secret service
+// created for the controller, since each one is short lived.
+type BackendFailureCache struct {
+	clock clock.Clock
+	ttl   time.Duration
+
+	mu       sync.Mutex
+	failures map[string]backendFailure
+}
+
+type backendFailure struct {
+	err    error
+	expiry time.Time
+}
+
+// NewBackendFailureCache returns a cache which remembers a failure to connect
+// to a secret backend for ttl. If ttl is not positive,
+// [DefaultBackendFailureTTL] is used.
+func NewBackendFailureCache(clock clock.Clock, ttl time.Duration) *BackendFailureCache {
+	if ttl <= 0 {
+		ttl = DefaultBackendFailureTTL
+	}
+	return &BackendFailureCache{
+		clock:    clock,
+		ttl:      ttl,
+		failures: make(map[string]backendFailure),
+	}
+}
+
+// get returns the remembered connection failure for the backend, or nil if
+// there is none or it has expired.
+func (c *BackendFailureCache) get(backendID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	failure, ok := c.failures[backendID]
+	if !ok {
+		return nil
+	}
+	if !c.clock.Now().Before(failure.expiry) {
+		delete(c.failures, backendID)
+		return nil
+	}
+	return failure.err
+}
+
+// set remembers a connection failure for the backend.
+func (c *BackendFailureCache) set(backendID string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures[backendID] = backendFailure{
+		err:    err,
+		expiry: c.clock.Now().Add(c.ttl),
+	}
+}
+
+// clear forgets any connection failure for the backend.
+func (c *BackendFailureCache) clear(backendID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.failures, backendID)
+}
@@ -0,0 +1,143 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	"github.com/juju/juju/domain/secretbackend"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+	"github.com/juju/juju/internal/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func (s *serviceSuite) TestGetBackendCachesFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := backendConfigs.Configs["backend-id"]
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(nil, errors.New("connection refused"))
+
+	_, err := s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, "connection refused")
+
+	// The failure is cached, so the backend is not connected to again.
+	_, err = s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, `secret backend "backend-id" recently unreachable: connection refused`)
+
+	// Once the window has passed, the connection is retried.
+	s.clock.Advance(DefaultBackendFailureTTL)
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(s.secretsBackend, nil)
+	backend, err := s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(backend, gc.Equals, s.secretsBackend)
+}
+
+func (s *serviceSuite) TestGetBackendFailureIsPerBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := backendConfigs.Configs["backend-id"]
+	otherCfg := backendConfigs.Configs["other-backend-id"]
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(nil, errors.New("connection refused"))
+	s.secretsBackendProvider.EXPECT().NewBackend(&otherCfg).Return(s.secretsBackend, nil)
+
+	_, err := s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, "connection refused")
+
+	backend, err := s.service.getBackend("other-backend-id", &otherCfg)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(backend, gc.Equals, s.secretsBackend)
+}
+
+func (s *serviceSuite) TestBackendFailureSharedBetweenServices(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := backendConfigs.Configs["backend-id"]
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(nil, errors.New("connection refused"))
+
+	_, err := s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, "connection refused")
+
+	// Another service using the same cache sees the failure, rather than
+	// connecting to the backend again.
+	other := NewSecretService(s.state, s.secretBackendState, s.ensurer, loggertesting.WrapCheckLog(c), SecretServiceParams{
+		BackendFailures: s.service.backendFailures,
+	})
+	other.providerGetter = s.service.providerGetter
+	_, err = other.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, `secret backend "backend-id" recently unreachable: connection refused`)
+}
+
+func (s *serviceSuite) TestBackendFailureTTL(c *gc.C) {
+	cache := NewBackendFailureCache(s.clock, time.Second)
+	cache.set("backend-id", errors.New("boom"))
+	c.Check(cache.get("backend-id"), gc.ErrorMatches, "boom")
+	s.clock.Advance(time.Second)
+	c.Check(cache.get("backend-id"), jc.ErrorIsNil)
+
+	c.Check(NewBackendFailureCache(s.clock, 0).ttl, gc.Equals, DefaultBackendFailureTTL)
+}
+
+func (s *serviceSuite) expectModelBackends() {
+	modelUUID := coremodel.UUID(coretesting.ModelTag.Id())
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).Return(secretbackend.ModelSecretBackend{
+		ControllerUUID:  coretesting.ControllerTag.Id(),
+		ModelName:       "some-model",
+		SecretBackendID: "backend-id",
+	}, nil)
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return([]*secretbackend.SecretBackend{{
+		ID:          "backend-id",
+		BackendType: "active-type",
+		Config:      map[string]interface{}{"foo": "active-type"},
+	}}, nil)
+}
+
+func (s *serviceSuite) TestCheckSecretBackendClearsFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := backendConfigs.Configs["backend-id"]
+	s.service.backendFailures.set("backend-id", errors.New("connection refused"))
+
+	s.expectModelBackends()
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(s.secretsBackend, nil).Times(2)
+	s.secretsBackend.EXPECT().Ping().Return(nil)
+
+	err := s.service.CheckSecretBackend(context.Background(), "backend-id")
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The cached failure is gone without waiting for the window to pass.
+	_, err = s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCheckSecretBackendCachesFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	cfg := backendConfigs.Configs["backend-id"]
+	s.expectModelBackends()
+	s.secretsBackendProvider.EXPECT().NewBackend(&cfg).Return(s.secretsBackend, nil)
+	s.secretsBackend.EXPECT().Ping().Return(errors.New("connection refused"))
+
+	err := s.service.CheckSecretBackend(context.Background(), "backend-id")
+	c.Assert(err, gc.ErrorMatches, `checking secret backend "backend-id": connection refused`)
+
+	_, err = s.service.getBackend("backend-id", &cfg)
+	c.Assert(err, gc.ErrorMatches, `secret backend "backend-id" recently unreachable: connection refused`)
+}
+
+func (s *serviceSuite) TestCheckSecretBackendNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectModelBackends()
+
+	err := s.service.CheckSecretBackend(context.Background(), "other-backend-id")
+	c.Assert(err, jc.ErrorIs, backenderrors.NotFound)
+}
@@ -20,6 +20,9 @@ import (
 // If there's not currently a consumer record for the secret, the latest revision is still returned,
 // along with an error satisfying [secreterrors.SecretConsumerNotFound].
 func (s *SecretService) GetSecretConsumerAndLatest(ctx context.Context, uri *secrets.URI, unitName string) (*secrets.SecretConsumerMetadata, int, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, 0, errors.Trace(err)
+	}
 	consumerMetadata, latestRevision, err := s.secretState.GetSecretConsumer(ctx, uri, unitName)
 	if err != nil {
 		return nil, latestRevision, errors.Trace(err)
@@ -47,6 +50,9 @@ func (s *SecretService) GetSecretConsumerAndLatest(ctx context.Context, uri *sec
 // If there's not currently a consumer record for the secret, an error satisfying [secreterrors.SecretConsumerNotFound]
 // is returned.
 func (s *SecretService) GetSecretConsumer(ctx context.Context, uri *secrets.URI, unitName string) (*secrets.SecretConsumerMetadata, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Trace(err)
+	}
 	result, _, err := s.GetSecretConsumerAndLatest(ctx, uri, unitName)
 	return result, err
 }
@@ -55,6 +61,9 @@ func (s *SecretService) GetSecretConsumer(ctx context.Context, uri *secrets.URI,
 // If the unit does not exist, an error satisfying [applicationerrors.UnitNotFound] is returned.
 // If the secret does not exist, an error satisfying [secreterrors.SecretNotFound] is returned.
 func (s *SecretService) SaveSecretConsumer(ctx context.Context, uri *secrets.URI, unitName string, md *secrets.SecretConsumerMetadata) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Trace(err)
+	}
 	return s.secretState.SaveSecretConsumer(ctx, uri, unitName, md)
 }
 
@@ -67,27 +76,47 @@ func (s *SecretService) GetURIByConsumerLabel(ctx context.Context, label string,
 
 // GetConsumedRevision returns the secret revision number for the specified consumer, possibly updating
 // the label associated with the secret for the consumer.
+//
+// If refresh is true, the consumer's tracked revision is moved to the latest revision.
+// If peek is true, the latest revision is returned and the consumer's tracked revision is left
+// alone, so a subsequent secret-changed event for the latest revision still fires. A peek only
+// ever updates the label of an existing consumer; a unit which is not yet tracking the secret
+// starts tracking it, and records any label, on its next read which isn't a peek.
 func (s *SecretService) GetConsumedRevision(ctx context.Context, uri *secrets.URI, unitName string, refresh, peek bool, labelToUpdate *string) (int, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return 0, errors.Trace(err)
+	}
 	consumerInfo, latestRevision, err := s.GetSecretConsumerAndLatest(ctx, uri, unitName)
 	if err != nil && !errors.Is(err, secreterrors.SecretConsumerNotFound) {
 		return 0, errors.Trace(err)
 	}
+	// Revisions start at 1, so a consumer with no current
+	// revision isn't tracking the secret yet.
+	tracking := err == nil && consumerInfo.CurrentRevision > 0
+
+	if peek && !refresh {
+		if err == nil && labelToUpdate != nil && *labelToUpdate != consumerInfo.Label {
+			if err := s.secretState.UpdateSecretConsumerLabel(ctx, uri, unitName, *labelToUpdate); err != nil {
+				return 0, errors.Trace(err)
+			}
+		}
+		return latestRevision, nil
+	}
+
 	refresh = refresh ||
-		err != nil // Not found, so need to create one.
+		!tracking // Not tracking, so need to start.
 
 	var wantRevision int
-	if err == nil {
+	if tracking {
 		wantRevision = consumerInfo.CurrentRevision
 	}
 
-	// Use the latest revision as the current one if --refresh or --peek.
-	if refresh || peek {
+	// Use the latest revision as the current one if --refresh.
+	if refresh {
 		if consumerInfo == nil {
 			consumerInfo = &secrets.SecretConsumerMetadata{}
 		}
-		if refresh {
-			consumerInfo.CurrentRevision = latestRevision
-		}
+		consumerInfo.CurrentRevision = latestRevision
 		wantRevision = latestRevision
 	}
 	// Save the latest consumer info if required.
@@ -131,6 +160,9 @@ func (s *SecretService) ListGrantedSecretsForBackend(
 // UpdateRemoteConsumedRevision returns the latest revision for the specified secret,
 // updating the tracked revision for the specified consumer if refresh is true.
 func (s *SecretService) UpdateRemoteConsumedRevision(ctx context.Context, uri *secrets.URI, unitName string, refresh bool) (int, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return 0, errors.Trace(err)
+	}
 	consumerInfo, latestRevision, err := s.secretState.GetSecretRemoteConsumer(ctx, uri, unitName)
 	if err != nil && !errors.Is(err, secreterrors.SecretConsumerNotFound) {
 		return 0, errors.Trace(err)
@@ -153,5 +185,8 @@ func (s *SecretService) UpdateRemoteConsumedRevision(ctx context.Context, uri *s
 // UpdateRemoteSecretRevision records the specified revision for the secret
 // which has been consumed from a different model.
 func (s *SecretService) UpdateRemoteSecretRevision(ctx context.Context, uri *secrets.URI, latestRevision int) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Trace(err)
+	}
 	return s.secretState.UpdateRemoteSecretRevision(ctx, uri, latestRevision)
 }
@@ -6,8 +6,11 @@ package service
 import (
 	"context"
 
+	jujuerrors "github.com/juju/errors"
+
 	"github.com/juju/juju/core/secrets"
 	"github.com/juju/juju/domain"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -28,6 +31,9 @@ func (s *SecretService) DeleteObsoleteUserSecretRevisions(ctx context.Context) e
 // If revisions is nil or the last remaining revisions are removed.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
 func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, params DeleteSecretParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
@@ -44,3 +50,46 @@ func (s *SecretService) DeleteSecret(ctx context.Context, uri *secrets.URI, para
 		return nil
 	})
 }
+
+// DeleteSecretRevisionContent deletes the content referenced by ref from its
+// secret backend. Content which is already gone, a backend which no longer
+// exists, or a backend which does not support deletion, is not an error.
+func (s *SecretService) DeleteSecretRevisionContent(ctx context.Context, ref secrets.ValueRef) error {
+	backend, ok := s.backends[ref.BackendID]
+	if !ok {
+		// The backend may have been added since the backends were loaded.
+		if err := s.loadBackendInfo(ctx, false); err != nil {
+			return errors.Capture(err)
+		}
+		if backend, ok = s.backends[ref.BackendID]; !ok {
+			// The backend has been removed along with its content, so there
+			// is nothing left to delete.
+			s.logger.Warningf("secret backend %q for secret content %q not found, treating content as deleted", ref.BackendID, ref.RevisionID)
+			return nil
+		}
+	}
+	err := backend.DeleteContent(ctx, ref.RevisionID)
+	if err != nil &&
+		!errors.Is(err, jujuerrors.NotFound) &&
+		!errors.Is(err, jujuerrors.NotSupported) &&
+		!errors.Is(err, secreterrors.SecretRevisionNotFound) {
+		return errors.Errorf("deleting secret content %q from backend %q: %w", ref.RevisionID, ref.BackendID, err)
+	}
+	return nil
+}
+
+// MarkSecretRevisionDeleted records that the backend content of the specified
+// obsolete secret revision has been deleted, so that it is no longer reported
+// as pending deletion, and drops the revision's reference to the backend.
+// It returns [secreterrors.SecretRevisionNotFound] if the revision is not
+// obsolete.
+func (s *SecretService) MarkSecretRevisionDeleted(ctx context.Context, revisionUUID string) error {
+	if err := s.secretState.MarkSecretRevisionDeleted(ctx, revisionUUID); err != nil {
+		return errors.Errorf("marking secret revision %q deleted: %w", revisionUUID, err)
+	}
+	if err := s.secretBackendState.RemoveSecretBackendReference(ctx, revisionUUID); err != nil {
+		// We don't want to error out if we can't remove the backend reference.
+		s.logger.Errorf("failed to remove secret backend reference for deleted secret revision %q: %v", revisionUUID, err)
+	}
+	return nil
+}
@@ -12,7 +12,10 @@ import (
 
 	coresecrets "github.com/juju/juju/core/secrets"
 	domainsecret "github.com/juju/juju/domain/secret"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	domaintesting "github.com/juju/juju/domain/testing"
+	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/secrets/provider"
 	"github.com/juju/juju/internal/uuid"
 )
@@ -58,3 +61,79 @@ func (s *serviceSuite) TestDeleteSecret(c *gc.C) {
 	})
 	c.Assert(err, jc.ErrorIsNil)
 }
+
+func (s *serviceSuite) TestDeleteSecretRevisionContent(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.service.backends = map[string]provider.SecretsBackend{
+		"backend-id": s.secretsBackend,
+	}
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-id").Return(nil)
+
+	err := s.service.DeleteSecretRevisionContent(context.Background(), coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteSecretRevisionContentAlreadyDeleted(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.service.backends = map[string]provider.SecretsBackend{
+		"backend-id": s.secretsBackend,
+	}
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-id").Return(secreterrors.SecretRevisionNotFound)
+
+	err := s.service.DeleteSecretRevisionContent(context.Background(), coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteSecretRevisionContentBackendNotFound(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), s.modelID).Return(secretbackend.ModelSecretBackend{
+		SecretBackendID: "other-backend-id",
+	}, nil)
+	s.secretBackendState.EXPECT().ListSecretBackendsForModel(gomock.Any(), s.modelID, true).Return(nil, nil)
+
+	err := s.service.DeleteSecretRevisionContent(context.Background(), coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestDeleteSecretRevisionContentError(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.service.backends = map[string]provider.SecretsBackend{
+		"backend-id": s.secretsBackend,
+	}
+	s.secretsBackend.EXPECT().DeleteContent(gomock.Any(), "rev-id").Return(errors.New("boom"))
+
+	err := s.service.DeleteSecretRevisionContent(context.Background(), coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	})
+	c.Assert(err, gc.ErrorMatches, `deleting secret content "rev-id" from backend "backend-id": boom`)
+}
+
+func (s *serviceSuite) TestMarkSecretRevisionDeleted(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	s.state.EXPECT().MarkSecretRevisionDeleted(gomock.Any(), "rev-uuid").Return(nil)
+	s.secretBackendState.EXPECT().RemoveSecretBackendReference(gomock.Any(), "rev-uuid").Return(nil)
+
+	err := s.service.MarkSecretRevisionDeleted(context.Background(), "rev-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+}
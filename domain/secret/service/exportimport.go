@@ -88,7 +88,8 @@ func (s *SecretService) GetSecretsForExport(ctx context.Context) (*SecretExport,
 					Kind: SecretAccessorKind(grant.SubjectTypeID.String()),
 					ID:   grant.SubjectID,
 				},
-				Role: coresecrets.SecretRole(grant.RoleID.String()),
+				Role:       coresecrets.SecretRole(grant.RoleID.String()),
+				ExpireTime: grant.ExpireTime,
 			}
 			secretAccess[i] = access
 		}
@@ -188,7 +189,8 @@ func (s *SecretService) ImportSecrets(ctx context.Context, modelSecrets *SecretE
 					Kind: access.Subject.Kind,
 					ID:   access.Subject.ID,
 				},
-				Role: access.Role,
+				Role:       access.Role,
+				ExpireTime: access.ExpireTime,
 			})
 			if err := s.secretState.GrantAccess(ctx, md.URI, p); err != nil {
 				return errors.Annotatef(err, "saving secret access for %s-%s for secret %q",
@@ -65,15 +65,21 @@ type State interface {
 	ListCharmSecrets(ctx context.Context,
 		appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
 	) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error)
+	SearchSecrets(ctx context.Context, query string, scope domainsecret.SecretSearchScope) ([]*secrets.SecretMetadata, error)
 	GetSecretConsumer(ctx context.Context, uri *secrets.URI, unitName string) (*secrets.SecretConsumerMetadata, int, error)
 	SaveSecretConsumer(ctx context.Context, uri *secrets.URI, unitName string, md *secrets.SecretConsumerMetadata) error
+	UpdateSecretConsumerLabel(ctx context.Context, uri *secrets.URI, unitName string, label string) error
 	GetUserSecretURIByLabel(ctx context.Context, label string) (*secrets.URI, error)
+	GetSecretURIByOwnerLabel(ctx context.Context, label string, ownerKind secrets.OwnerKind, ownerName string) (*secrets.URI, error)
 	GetURIByConsumerLabel(ctx context.Context, label string, unitName string) (*secrets.URI, error)
 	GetSecretRemoteConsumer(ctx context.Context, uri *secrets.URI, unitName string) (*secrets.SecretConsumerMetadata, int, error)
 	SaveSecretRemoteConsumer(ctx context.Context, uri *secrets.URI, unitName string, md *secrets.SecretConsumerMetadata) error
 	UpdateRemoteSecretRevision(ctx context.Context, uri *secrets.URI, latestRevision int) error
 	GrantAccess(ctx context.Context, uri *secrets.URI, params domainsecret.GrantParams) error
 	RevokeAccess(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) error
+	GrantAccessBulk(ctx context.Context, uri *secrets.URI, params []domainsecret.GrantParams) ([]error, error)
+	RevokeAccessBulk(ctx context.Context, uri *secrets.URI, params []domainsecret.AccessParams) ([]error, error)
+	DeleteExpiredSecretGrants(ctx context.Context) (int64, error)
 	GetSecretAccess(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (string, error)
 	GetSecretAccessScope(ctx context.Context, uri *secrets.URI, params domainsecret.AccessParams) (*domainsecret.AccessScope, error)
 	GetSecretGrants(ctx context.Context, uri *secrets.URI, role secrets.SecretRole) ([]domainsecret.GrantParams, error)
@@ -87,6 +93,11 @@ type State interface {
 	ListUserSecretsToDrain(ctx context.Context) ([]*secrets.SecretMetadataForDrain, error)
 	SecretRotated(ctx context.Context, uri *secrets.URI, next time.Time) error
 	GetRotatePolicy(ctx context.Context, uri *secrets.URI) (secrets.RotatePolicy, error)
+	GetApplicationUnitNames(ctx context.Context, appName string) ([]string, error)
+	GetSecretContentSchema(ctx domain.AtomicContext, uri *secrets.URI) (domainsecret.ContentSchema, error)
+	SetSecretContentSchema(ctx domain.AtomicContext, uri *secrets.URI, schema domainsecret.ContentSchema) error
+	GetSecretGenerationPolicy(ctx domain.AtomicContext, uri *secrets.URI) (domainsecret.GenerationPolicy, error)
+	SetSecretGenerationPolicy(ctx domain.AtomicContext, uri *secrets.URI, policy domainsecret.GenerationPolicy) error
 	GetRotationExpiryInfo(ctx context.Context, uri *secrets.URI) (*domainsecret.RotationExpiryInfo, error)
 	GetSecretRevisionID(ctx context.Context, uri *secrets.URI, revision int) (string, error)
 	ChangeSecretBackend(
@@ -104,6 +115,12 @@ type State interface {
 	// For watching obsolete user secret revisions to prune.
 	GetObsoleteUserSecretRevisionsReadyToPrune(ctx context.Context) ([]string, error)
 
+	// For watching obsolete secret revisions whose backend content is
+	// pending deletion.
+	InitialWatchStatementForSecretRevisionsPendingDeletion() (tableName string, statement eventsource.NamespaceQuery)
+	GetSecretRevisionsPendingDeletion(ctx context.Context, revisionUUIDs ...string) ([]domainsecret.RevisionPendingDeletion, error)
+	MarkSecretRevisionDeleted(ctx context.Context, revisionUUID string) error
+
 	// For watching consumed local secret changes.
 	InitialWatchStatementForConsumedSecretsChange(unitName string) (string, eventsource.NamespaceQuery)
 	GetConsumedSecretURIsWithChanges(ctx context.Context, unitName string, revisionIDs ...string) ([]string, error)
@@ -137,6 +154,9 @@ type State interface {
 	AllSecretConsumers(ctx context.Context) (map[string][]domainsecret.ConsumerInfo, error)
 	AllSecretRemoteConsumers(ctx context.Context) (map[string][]domainsecret.ConsumerInfo, error)
 	AllRemoteSecrets(ctx context.Context) ([]domainsecret.RemoteSecretInfo, error)
+
+	// For validating cross model secret URIs.
+	IsSecretSourceModelConsumed(ctx context.Context, sourceModelUUID string) (bool, error)
 }
 
 // SecretBackendReferenceMutator describes methods
@@ -476,6 +476,45 @@ func (c *MockStateCreateUserSecretCall) DoAndReturn(f func(domain.AtomicContext,
 	return c
 }
 
+// DeleteExpiredSecretGrants mocks base method.
+func (m *MockState) DeleteExpiredSecretGrants(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredSecretGrants", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredSecretGrants indicates an expected call of DeleteExpiredSecretGrants.
+func (mr *MockStateMockRecorder) DeleteExpiredSecretGrants(arg0 any) *MockStateDeleteExpiredSecretGrantsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredSecretGrants", reflect.TypeOf((*MockState)(nil).DeleteExpiredSecretGrants), arg0)
+	return &MockStateDeleteExpiredSecretGrantsCall{Call: call}
+}
+
+// MockStateDeleteExpiredSecretGrantsCall wrap *gomock.Call
+type MockStateDeleteExpiredSecretGrantsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateDeleteExpiredSecretGrantsCall) Return(arg0 int64, arg1 error) *MockStateDeleteExpiredSecretGrantsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateDeleteExpiredSecretGrantsCall) Do(f func(context.Context) (int64, error)) *MockStateDeleteExpiredSecretGrantsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateDeleteExpiredSecretGrantsCall) DoAndReturn(f func(context.Context) (int64, error)) *MockStateDeleteExpiredSecretGrantsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DeleteObsoleteUserSecretRevisions mocks base method.
 func (m *MockState) DeleteObsoleteUserSecretRevisions(arg0 context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -592,6 +631,45 @@ func (c *MockStateGetApplicationUUIDCall) DoAndReturn(f func(domain.AtomicContex
 	return c
 }
 
+// GetApplicationUnitNames mocks base method.
+func (m *MockState) GetApplicationUnitNames(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationUnitNames", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationUnitNames indicates an expected call of GetApplicationUnitNames.
+func (mr *MockStateMockRecorder) GetApplicationUnitNames(arg0, arg1 any) *MockStateGetApplicationUnitNamesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationUnitNames", reflect.TypeOf((*MockState)(nil).GetApplicationUnitNames), arg0, arg1)
+	return &MockStateGetApplicationUnitNamesCall{Call: call}
+}
+
+// MockStateGetApplicationUnitNamesCall wrap *gomock.Call
+type MockStateGetApplicationUnitNamesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetApplicationUnitNamesCall) Return(arg0 []string, arg1 error) *MockStateGetApplicationUnitNamesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetApplicationUnitNamesCall) Do(f func(context.Context, string) ([]string, error)) *MockStateGetApplicationUnitNamesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetApplicationUnitNamesCall) DoAndReturn(f func(context.Context, string) ([]string, error)) *MockStateGetApplicationUnitNamesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetConsumedRemoteSecretURIsWithChanges mocks base method.
 func (m *MockState) GetConsumedRemoteSecretURIsWithChanges(arg0 context.Context, arg1 string, arg2 ...string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1120,6 +1198,84 @@ func (c *MockStateGetSecretConsumerCall) DoAndReturn(f func(context.Context, *se
 	return c
 }
 
+// GetSecretContentSchema mocks base method.
+func (m *MockState) GetSecretContentSchema(arg0 domain.AtomicContext, arg1 *secrets.URI) (secret.ContentSchema, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretContentSchema", arg0, arg1)
+	ret0, _ := ret[0].(secret.ContentSchema)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretContentSchema indicates an expected call of GetSecretContentSchema.
+func (mr *MockStateMockRecorder) GetSecretContentSchema(arg0, arg1 any) *MockStateGetSecretContentSchemaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretContentSchema", reflect.TypeOf((*MockState)(nil).GetSecretContentSchema), arg0, arg1)
+	return &MockStateGetSecretContentSchemaCall{Call: call}
+}
+
+// MockStateGetSecretContentSchemaCall wrap *gomock.Call
+type MockStateGetSecretContentSchemaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretContentSchemaCall) Return(arg0 secret.ContentSchema, arg1 error) *MockStateGetSecretContentSchemaCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretContentSchemaCall) Do(f func(domain.AtomicContext, *secrets.URI) (secret.ContentSchema, error)) *MockStateGetSecretContentSchemaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretContentSchemaCall) DoAndReturn(f func(domain.AtomicContext, *secrets.URI) (secret.ContentSchema, error)) *MockStateGetSecretContentSchemaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretGenerationPolicy mocks base method.
+func (m *MockState) GetSecretGenerationPolicy(arg0 domain.AtomicContext, arg1 *secrets.URI) (secret.GenerationPolicy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretGenerationPolicy", arg0, arg1)
+	ret0, _ := ret[0].(secret.GenerationPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretGenerationPolicy indicates an expected call of GetSecretGenerationPolicy.
+func (mr *MockStateMockRecorder) GetSecretGenerationPolicy(arg0, arg1 any) *MockStateGetSecretGenerationPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretGenerationPolicy", reflect.TypeOf((*MockState)(nil).GetSecretGenerationPolicy), arg0, arg1)
+	return &MockStateGetSecretGenerationPolicyCall{Call: call}
+}
+
+// MockStateGetSecretGenerationPolicyCall wrap *gomock.Call
+type MockStateGetSecretGenerationPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretGenerationPolicyCall) Return(arg0 secret.GenerationPolicy, arg1 error) *MockStateGetSecretGenerationPolicyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretGenerationPolicyCall) Do(f func(domain.AtomicContext, *secrets.URI) (secret.GenerationPolicy, error)) *MockStateGetSecretGenerationPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretGenerationPolicyCall) DoAndReturn(f func(domain.AtomicContext, *secrets.URI) (secret.GenerationPolicy, error)) *MockStateGetSecretGenerationPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretGrants mocks base method.
 func (m *MockState) GetSecretGrants(arg0 context.Context, arg1 *secrets.URI, arg2 secrets.SecretRole) ([]secret.GrantParams, error) {
 	m.ctrl.T.Helper()
@@ -1277,6 +1433,89 @@ func (c *MockStateGetSecretRevisionIDCall) DoAndReturn(f func(context.Context, *
 	return c
 }
 
+// GetSecretRevisionsPendingDeletion mocks base method.
+func (m *MockState) GetSecretRevisionsPendingDeletion(arg0 context.Context, arg1 ...string) ([]secret.RevisionPendingDeletion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{arg0}
+	for _, a := range arg1 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecretRevisionsPendingDeletion", varargs...)
+	ret0, _ := ret[0].([]secret.RevisionPendingDeletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretRevisionsPendingDeletion indicates an expected call of GetSecretRevisionsPendingDeletion.
+func (mr *MockStateMockRecorder) GetSecretRevisionsPendingDeletion(arg0 any, arg1 ...any) *MockStateGetSecretRevisionsPendingDeletionCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{arg0}, arg1...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretRevisionsPendingDeletion", reflect.TypeOf((*MockState)(nil).GetSecretRevisionsPendingDeletion), varargs...)
+	return &MockStateGetSecretRevisionsPendingDeletionCall{Call: call}
+}
+
+// MockStateGetSecretRevisionsPendingDeletionCall wrap *gomock.Call
+type MockStateGetSecretRevisionsPendingDeletionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretRevisionsPendingDeletionCall) Return(arg0 []secret.RevisionPendingDeletion, arg1 error) *MockStateGetSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretRevisionsPendingDeletionCall) Do(f func(context.Context, ...string) ([]secret.RevisionPendingDeletion, error)) *MockStateGetSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretRevisionsPendingDeletionCall) DoAndReturn(f func(context.Context, ...string) ([]secret.RevisionPendingDeletion, error)) *MockStateGetSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSecretURIByOwnerLabel mocks base method.
+func (m *MockState) GetSecretURIByOwnerLabel(arg0 context.Context, arg1 string, arg2 secrets.OwnerKind, arg3 string) (*secrets.URI, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretURIByOwnerLabel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*secrets.URI)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretURIByOwnerLabel indicates an expected call of GetSecretURIByOwnerLabel.
+func (mr *MockStateMockRecorder) GetSecretURIByOwnerLabel(arg0, arg1, arg2, arg3 any) *MockStateGetSecretURIByOwnerLabelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretURIByOwnerLabel", reflect.TypeOf((*MockState)(nil).GetSecretURIByOwnerLabel), arg0, arg1, arg2, arg3)
+	return &MockStateGetSecretURIByOwnerLabelCall{Call: call}
+}
+
+// MockStateGetSecretURIByOwnerLabelCall wrap *gomock.Call
+type MockStateGetSecretURIByOwnerLabelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretURIByOwnerLabelCall) Return(arg0 *secrets.URI, arg1 error) *MockStateGetSecretURIByOwnerLabelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretURIByOwnerLabelCall) Do(f func(context.Context, string, secrets.OwnerKind, string) (*secrets.URI, error)) *MockStateGetSecretURIByOwnerLabelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretURIByOwnerLabelCall) DoAndReturn(f func(context.Context, string, secrets.OwnerKind, string) (*secrets.URI, error)) *MockStateGetSecretURIByOwnerLabelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretValue mocks base method.
 func (m *MockState) GetSecretValue(arg0 context.Context, arg1 *secrets.URI, arg2 int) (secrets.SecretData, *secrets.ValueRef, error) {
 	m.ctrl.T.Helper()
@@ -1599,6 +1838,45 @@ func (c *MockStateGrantAccessCall) DoAndReturn(f func(context.Context, *secrets.
 	return c
 }
 
+// GrantAccessBulk mocks base method.
+func (m *MockState) GrantAccessBulk(arg0 context.Context, arg1 *secrets.URI, arg2 []secret.GrantParams) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccessBulk", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GrantAccessBulk indicates an expected call of GrantAccessBulk.
+func (mr *MockStateMockRecorder) GrantAccessBulk(arg0, arg1, arg2 any) *MockStateGrantAccessBulkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccessBulk", reflect.TypeOf((*MockState)(nil).GrantAccessBulk), arg0, arg1, arg2)
+	return &MockStateGrantAccessBulkCall{Call: call}
+}
+
+// MockStateGrantAccessBulkCall wrap *gomock.Call
+type MockStateGrantAccessBulkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGrantAccessBulkCall) Return(arg0 []error, arg1 error) *MockStateGrantAccessBulkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGrantAccessBulkCall) Do(f func(context.Context, *secrets.URI, []secret.GrantParams) ([]error, error)) *MockStateGrantAccessBulkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGrantAccessBulkCall) DoAndReturn(f func(context.Context, *secrets.URI, []secret.GrantParams) ([]error, error)) *MockStateGrantAccessBulkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementForConsumedRemoteSecretsChange mocks base method.
 func (m *MockState) InitialWatchStatementForConsumedRemoteSecretsChange(arg0 string) (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -1755,6 +2033,45 @@ func (c *MockStateInitialWatchStatementForRemoteConsumedSecretsChangesFromOfferi
 	return c
 }
 
+// InitialWatchStatementForSecretRevisionsPendingDeletion mocks base method.
+func (m *MockState) InitialWatchStatementForSecretRevisionsPendingDeletion() (string, eventsource.NamespaceQuery) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitialWatchStatementForSecretRevisionsPendingDeletion")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(eventsource.NamespaceQuery)
+	return ret0, ret1
+}
+
+// InitialWatchStatementForSecretRevisionsPendingDeletion indicates an expected call of InitialWatchStatementForSecretRevisionsPendingDeletion.
+func (mr *MockStateMockRecorder) InitialWatchStatementForSecretRevisionsPendingDeletion() *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitialWatchStatementForSecretRevisionsPendingDeletion", reflect.TypeOf((*MockState)(nil).InitialWatchStatementForSecretRevisionsPendingDeletion))
+	return &MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall{Call: call}
+}
+
+// MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall wrap *gomock.Call
+type MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall) Return(arg0 string, arg1 eventsource.NamespaceQuery) *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall) Do(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall) DoAndReturn(f func() (string, eventsource.NamespaceQuery)) *MockStateInitialWatchStatementForSecretRevisionsPendingDeletionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementForSecretsRevisionExpiryChanges mocks base method.
 func (m *MockState) InitialWatchStatementForSecretsRevisionExpiryChanges(arg0 secret.ApplicationOwners, arg1 secret.UnitOwners) (string, eventsource.NamespaceQuery) {
 	m.ctrl.T.Helper()
@@ -1833,6 +2150,45 @@ func (c *MockStateInitialWatchStatementForSecretsRotationChangesCall) DoAndRetur
 	return c
 }
 
+// IsSecretSourceModelConsumed mocks base method.
+func (m *MockState) IsSecretSourceModelConsumed(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSecretSourceModelConsumed", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsSecretSourceModelConsumed indicates an expected call of IsSecretSourceModelConsumed.
+func (mr *MockStateMockRecorder) IsSecretSourceModelConsumed(arg0, arg1 any) *MockStateIsSecretSourceModelConsumedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSecretSourceModelConsumed", reflect.TypeOf((*MockState)(nil).IsSecretSourceModelConsumed), arg0, arg1)
+	return &MockStateIsSecretSourceModelConsumedCall{Call: call}
+}
+
+// MockStateIsSecretSourceModelConsumedCall wrap *gomock.Call
+type MockStateIsSecretSourceModelConsumedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateIsSecretSourceModelConsumedCall) Return(arg0 bool, arg1 error) *MockStateIsSecretSourceModelConsumedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateIsSecretSourceModelConsumedCall) Do(f func(context.Context, string) (bool, error)) *MockStateIsSecretSourceModelConsumedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateIsSecretSourceModelConsumedCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockStateIsSecretSourceModelConsumedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListCharmSecrets mocks base method.
 func (m *MockState) ListCharmSecrets(arg0 context.Context, arg1 secret.ApplicationOwners, arg2 secret.UnitOwners) ([]*secrets.SecretMetadata, [][]*secrets.SecretRevisionMetadata, error) {
 	m.ctrl.T.Helper()
@@ -2030,6 +2386,44 @@ func (c *MockStateListUserSecretsToDrainCall) DoAndReturn(f func(context.Context
 	return c
 }
 
+// MarkSecretRevisionDeleted mocks base method.
+func (m *MockState) MarkSecretRevisionDeleted(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSecretRevisionDeleted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSecretRevisionDeleted indicates an expected call of MarkSecretRevisionDeleted.
+func (mr *MockStateMockRecorder) MarkSecretRevisionDeleted(arg0, arg1 any) *MockStateMarkSecretRevisionDeletedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSecretRevisionDeleted", reflect.TypeOf((*MockState)(nil).MarkSecretRevisionDeleted), arg0, arg1)
+	return &MockStateMarkSecretRevisionDeletedCall{Call: call}
+}
+
+// MockStateMarkSecretRevisionDeletedCall wrap *gomock.Call
+type MockStateMarkSecretRevisionDeletedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateMarkSecretRevisionDeletedCall) Return(arg0 error) *MockStateMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateMarkSecretRevisionDeletedCall) Do(f func(context.Context, string) error) *MockStateMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateMarkSecretRevisionDeletedCall) DoAndReturn(f func(context.Context, string) error) *MockStateMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RevokeAccess mocks base method.
 func (m *MockState) RevokeAccess(arg0 context.Context, arg1 *secrets.URI, arg2 secret.AccessParams) error {
 	m.ctrl.T.Helper()
@@ -2068,6 +2462,45 @@ func (c *MockStateRevokeAccessCall) DoAndReturn(f func(context.Context, *secrets
 	return c
 }
 
+// RevokeAccessBulk mocks base method.
+func (m *MockState) RevokeAccessBulk(arg0 context.Context, arg1 *secrets.URI, arg2 []secret.AccessParams) ([]error, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessBulk", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAccessBulk indicates an expected call of RevokeAccessBulk.
+func (mr *MockStateMockRecorder) RevokeAccessBulk(arg0, arg1, arg2 any) *MockStateRevokeAccessBulkCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessBulk", reflect.TypeOf((*MockState)(nil).RevokeAccessBulk), arg0, arg1, arg2)
+	return &MockStateRevokeAccessBulkCall{Call: call}
+}
+
+// MockStateRevokeAccessBulkCall wrap *gomock.Call
+type MockStateRevokeAccessBulkCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRevokeAccessBulkCall) Return(arg0 []error, arg1 error) *MockStateRevokeAccessBulkCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRevokeAccessBulkCall) Do(f func(context.Context, *secrets.URI, []secret.AccessParams) ([]error, error)) *MockStateRevokeAccessBulkCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRevokeAccessBulkCall) DoAndReturn(f func(context.Context, *secrets.URI, []secret.AccessParams) ([]error, error)) *MockStateRevokeAccessBulkCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // RunAtomic mocks base method.
 func (m *MockState) RunAtomic(arg0 context.Context, arg1 func(domain.AtomicContext) error) error {
 	m.ctrl.T.Helper()
@@ -2182,6 +2615,45 @@ func (c *MockStateSaveSecretRemoteConsumerCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// SearchSecrets mocks base method.
+func (m *MockState) SearchSecrets(arg0 context.Context, arg1 string, arg2 secret.SecretSearchScope) ([]*secrets.SecretMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchSecrets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*secrets.SecretMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchSecrets indicates an expected call of SearchSecrets.
+func (mr *MockStateMockRecorder) SearchSecrets(arg0, arg1, arg2 any) *MockStateSearchSecretsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchSecrets", reflect.TypeOf((*MockState)(nil).SearchSecrets), arg0, arg1, arg2)
+	return &MockStateSearchSecretsCall{Call: call}
+}
+
+// MockStateSearchSecretsCall wrap *gomock.Call
+type MockStateSearchSecretsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSearchSecretsCall) Return(arg0 []*secrets.SecretMetadata, arg1 error) *MockStateSearchSecretsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSearchSecretsCall) Do(f func(context.Context, string, secret.SecretSearchScope) ([]*secrets.SecretMetadata, error)) *MockStateSearchSecretsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSearchSecretsCall) DoAndReturn(f func(context.Context, string, secret.SecretSearchScope) ([]*secrets.SecretMetadata, error)) *MockStateSearchSecretsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SecretRotated mocks base method.
 func (m *MockState) SecretRotated(arg0 context.Context, arg1 *secrets.URI, arg2 time.Time) error {
 	m.ctrl.T.Helper()
@@ -2220,6 +2692,82 @@ func (c *MockStateSecretRotatedCall) DoAndReturn(f func(context.Context, *secret
 	return c
 }
 
+// SetSecretContentSchema mocks base method.
+func (m *MockState) SetSecretContentSchema(arg0 domain.AtomicContext, arg1 *secrets.URI, arg2 secret.ContentSchema) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecretContentSchema", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecretContentSchema indicates an expected call of SetSecretContentSchema.
+func (mr *MockStateMockRecorder) SetSecretContentSchema(arg0, arg1, arg2 any) *MockStateSetSecretContentSchemaCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecretContentSchema", reflect.TypeOf((*MockState)(nil).SetSecretContentSchema), arg0, arg1, arg2)
+	return &MockStateSetSecretContentSchemaCall{Call: call}
+}
+
+// MockStateSetSecretContentSchemaCall wrap *gomock.Call
+type MockStateSetSecretContentSchemaCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetSecretContentSchemaCall) Return(arg0 error) *MockStateSetSecretContentSchemaCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetSecretContentSchemaCall) Do(f func(domain.AtomicContext, *secrets.URI, secret.ContentSchema) error) *MockStateSetSecretContentSchemaCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetSecretContentSchemaCall) DoAndReturn(f func(domain.AtomicContext, *secrets.URI, secret.ContentSchema) error) *MockStateSetSecretContentSchemaCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetSecretGenerationPolicy mocks base method.
+func (m *MockState) SetSecretGenerationPolicy(arg0 domain.AtomicContext, arg1 *secrets.URI, arg2 secret.GenerationPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecretGenerationPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecretGenerationPolicy indicates an expected call of SetSecretGenerationPolicy.
+func (mr *MockStateMockRecorder) SetSecretGenerationPolicy(arg0, arg1, arg2 any) *MockStateSetSecretGenerationPolicyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecretGenerationPolicy", reflect.TypeOf((*MockState)(nil).SetSecretGenerationPolicy), arg0, arg1, arg2)
+	return &MockStateSetSecretGenerationPolicyCall{Call: call}
+}
+
+// MockStateSetSecretGenerationPolicyCall wrap *gomock.Call
+type MockStateSetSecretGenerationPolicyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetSecretGenerationPolicyCall) Return(arg0 error) *MockStateSetSecretGenerationPolicyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetSecretGenerationPolicyCall) Do(f func(domain.AtomicContext, *secrets.URI, secret.GenerationPolicy) error) *MockStateSetSecretGenerationPolicyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetSecretGenerationPolicyCall) DoAndReturn(f func(domain.AtomicContext, *secrets.URI, secret.GenerationPolicy) error) *MockStateSetSecretGenerationPolicyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateRemoteSecretRevision mocks base method.
 func (m *MockState) UpdateRemoteSecretRevision(arg0 context.Context, arg1 *secrets.URI, arg2 int) error {
 	m.ctrl.T.Helper()
@@ -2296,6 +2844,44 @@ func (c *MockStateUpdateSecretCall) DoAndReturn(f func(domain.AtomicContext, *se
 	return c
 }
 
+// UpdateSecretConsumerLabel mocks base method.
+func (m *MockState) UpdateSecretConsumerLabel(arg0 context.Context, arg1 *secrets.URI, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecretConsumerLabel", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSecretConsumerLabel indicates an expected call of UpdateSecretConsumerLabel.
+func (mr *MockStateMockRecorder) UpdateSecretConsumerLabel(arg0, arg1, arg2, arg3 any) *MockStateUpdateSecretConsumerLabelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecretConsumerLabel", reflect.TypeOf((*MockState)(nil).UpdateSecretConsumerLabel), arg0, arg1, arg2, arg3)
+	return &MockStateUpdateSecretConsumerLabelCall{Call: call}
+}
+
+// MockStateUpdateSecretConsumerLabelCall wrap *gomock.Call
+type MockStateUpdateSecretConsumerLabelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateUpdateSecretConsumerLabelCall) Return(arg0 error) *MockStateUpdateSecretConsumerLabelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateUpdateSecretConsumerLabelCall) Do(f func(context.Context, *secrets.URI, string, string) error) *MockStateUpdateSecretConsumerLabelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateUpdateSecretConsumerLabelCall) DoAndReturn(f func(context.Context, *secrets.URI, string, string) error) *MockStateUpdateSecretConsumerLabelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockSecretBackendState is a mock of SecretBackendState interface.
 type MockSecretBackendState struct {
 	ctrl     *gomock.Controller
@@ -8,6 +8,7 @@ import (
 
 	gc "gopkg.in/check.v1"
 
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/internal/errors"
 )
 
@@ -31,3 +32,9 @@ type badToken struct{}
 func (t badToken) Check() error {
 	return errors.New("not leader")
 }
+
+type notHeldToken struct{}
+
+func (t notHeldToken) Check() error {
+	return lease.ErrNotHeld
+}
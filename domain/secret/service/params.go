@@ -9,12 +9,18 @@ import (
 
 	"github.com/juju/juju/core/secrets"
 	coresecrets "github.com/juju/juju/core/secrets"
+	domainsecret "github.com/juju/juju/domain/secret"
 )
 
 // SecretServiceParams defines parameters used to create a secret service for
 // managing secret content.
 type SecretServiceParams struct {
 	BackendUserSecretConfigGetter BackendUserSecretConfigGetter
+
+	// BackendFailures remembers failures to connect to secret backends,
+	// during which operations against those backends fail fast. If nil, the
+	// service remembers failures by itself for [DefaultBackendFailureTTL].
+	BackendFailures *BackendFailureCache
 }
 
 // CreateCharmSecretParams are used to create charm a secret.
@@ -23,6 +29,10 @@ type CreateCharmSecretParams struct {
 	Version int
 
 	CharmOwner CharmSecretOwner
+
+	// ContentSchema, if set, is registered for the secret and all its
+	// revisions, including the first, must satisfy it.
+	ContentSchema *domainsecret.ContentSchema
 }
 
 // UpdateCharmSecretParams are used to update a charm secret.
@@ -37,12 +47,22 @@ type UpdateCharmSecretParams struct {
 	Data         secrets.SecretData
 	ValueRef     *secrets.ValueRef
 	Checksum     string
+
+	// GenerationPolicy, if set, replaces the policy the controller uses to
+	// generate the content of the secret when it is rotated. An empty policy
+	// removes any existing policy. When creating a secret with no content,
+	// the content of the first revision is generated from the policy.
+	GenerationPolicy *domainsecret.GenerationPolicy
 }
 
 // CreateUserSecretParams are used to create a user secret.
 type CreateUserSecretParams struct {
 	UpdateUserSecretParams
 	Version int
+
+	// ContentSchema, if set, is registered for the secret and all its
+	// revisions, including the first, must satisfy it.
+	ContentSchema *domainsecret.ContentSchema
 }
 
 // UpdateUserSecretParams are used to update a user secret.
@@ -57,6 +77,16 @@ type UpdateUserSecretParams struct {
 	AutoPrune   *bool
 }
 
+// SetSecretContentSchemaParams are used to set the content schema of a
+// secret.
+type SetSecretContentSchemaParams struct {
+	Accessor SecretAccessor
+
+	// Schema is the schema which the content of new revisions must
+	// satisfy. An empty schema removes any existing schema.
+	Schema domainsecret.ContentSchema
+}
+
 // DeleteSecretParams are used to delete a secret.
 type DeleteSecretParams struct {
 	Accessor SecretAccessor
@@ -79,6 +109,35 @@ type SecretAccessParams struct {
 	Scope   SecretAccessScope
 	Subject SecretAccessor
 	Role    secrets.SecretRole
+
+	// ExpireTime, if set, is when the grant expires. Once expired, the grant
+	// confers no access.
+	ExpireTime *time.Time
+}
+
+// SecretBulkAccessParams are used to define access to a secret for
+// many subjects at once.
+type SecretBulkAccessParams struct {
+	Accessor SecretAccessor
+
+	Grants []SecretAccessGrant
+}
+
+// SecretAccessGrant defines access to a secret for a single subject.
+type SecretAccessGrant struct {
+	Scope   SecretAccessScope
+	Subject SecretAccessor
+	Role    secrets.SecretRole
+
+	// ExpireTime, if set, is when the grant expires.
+	ExpireTime *time.Time
+}
+
+// SecretAccessResult holds the result of granting or revoking
+// access to a secret for a single subject.
+type SecretAccessResult struct {
+	Subject SecretAccessor
+	Error   error
 }
 
 // ChangeSecretBackendParams are used to change the backend of a secret.
@@ -134,6 +193,9 @@ type SecretAccess struct {
 	Scope   SecretAccessScope
 	Subject SecretAccessor
 	Role    secrets.SecretRole
+
+	// ExpireTime, if set, is when the grant expires.
+	ExpireTime *time.Time
 }
 
 // CharmSecretOwnerKind represents the kind of a charm secret owner entity.
@@ -185,3 +247,22 @@ type RemoteSecret struct {
 	LatestRevision  int
 	Accessor        SecretAccessor
 }
+
+// SecretOwnerStatus summarises the health of the secrets owned by an
+// application.
+type SecretOwnerStatus struct {
+	// TotalSecrets is the number of secrets owned by the application.
+	TotalSecrets int
+	// OverdueForRotation holds the URIs of the secrets whose next
+	// rotation time has passed.
+	OverdueForRotation []string
+	// ExpiredRevisions holds the URIs of the secrets with a revision
+	// whose expiry time has passed.
+	ExpiredRevisions []string
+	// GrantErrors holds the URIs of the secrets with a time limited
+	// grant which has expired and so confers no access.
+	GrantErrors []string
+	// BackendUnreachable is true if connecting to a backend holding the
+	// secrets, or the model's secret backend, recently failed.
+	BackendUnreachable bool
+}
@@ -14,6 +14,7 @@ import (
 
 	coreapplication "github.com/juju/juju/core/application"
 	"github.com/juju/juju/core/leadership"
+	"github.com/juju/juju/core/lease"
 	"github.com/juju/juju/core/logger"
 	coremodel "github.com/juju/juju/core/model"
 	"github.com/juju/juju/core/secrets"
@@ -21,6 +22,7 @@ import (
 	"github.com/juju/juju/domain"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 	"github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/secrets/provider"
@@ -36,6 +38,10 @@ func NewSecretService(
 	logger logger.Logger,
 	params SecretServiceParams,
 ) *SecretService {
+	backendFailures := params.BackendFailures
+	if backendFailures == nil {
+		backendFailures = NewBackendFailureCache(clock.WallClock, DefaultBackendFailureTTL)
+	}
 	return &SecretService{
 		secretState:        secretState,
 		secretBackendState: secretBackendState,
@@ -45,6 +51,8 @@ func NewSecretService(
 		userSecretConfigGetter: params.BackendUserSecretConfigGetter,
 		uuidGenerator:          uuid.NewUUID,
 
+		backendFailures: backendFailures,
+
 		clock:  clock.WallClock,
 		logger: logger,
 	}
@@ -72,6 +80,7 @@ type SecretService struct {
 
 	activeBackendID string
 	backends        map[string]provider.SecretsBackend
+	backendFailures *BackendFailureCache
 	uuidGenerator   func() (uuid.UUID, error)
 
 	leaderEnsurer leadership.Ensurer
@@ -97,12 +106,83 @@ func (s *SecretService) CreateSecretURIs(ctx context.Context, count int) ([]*sec
 	return result, nil
 }
 
-func (s *SecretService) getBackend(cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+// getBackend connects to the backend with the specified ID. If connecting to
+// the backend failed within the last failure window, the cached error is
+// returned without trying again.
+func (s *SecretService) getBackend(backendID string, cfg *provider.ModelBackendConfig) (provider.SecretsBackend, error) {
+	if err := s.backendFailures.get(backendID); err != nil {
+		return nil, errors.Errorf("secret backend %q recently unreachable: %w", backendID, err)
+	}
 	p, err := s.providerGetter(cfg.BackendType)
 	if err != nil {
 		return nil, jujuerrors.Trace(err)
 	}
-	return p.NewBackend(cfg)
+	backend, err := p.NewBackend(cfg)
+	if err != nil {
+		s.backendFailures.set(backendID, err)
+		return nil, jujuerrors.Trace(err)
+	}
+	s.backendFailures.clear(backendID)
+	return backend, nil
+}
+
+// CheckSecretBackend connects to the specified backend used by the model and
+// pings it, ignoring any recently cached connection failure. A successful ping
+// clears the cached failure so that operations against the backend are
+// attempted again immediately; a failed one is cached.
+// If the backend is not used by the model, an error satisfying
+// [backenderrors.NotFound] is returned.
+func (s *SecretService) CheckSecretBackend(ctx context.Context, backendID string) error {
+	mUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Errorf("getting model UUID: %w", err)
+	}
+	modelUUID := coremodel.UUID(mUUID)
+
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return errors.Errorf("getting model secret backend: %w", err)
+	}
+	backends, err := s.secretBackendState.ListSecretBackendsForModel(ctx, modelUUID, true)
+	if err != nil {
+		return errors.Errorf("listing secret backends: %w", err)
+	}
+
+	for _, b := range backends {
+		if b.ID != backendID {
+			continue
+		}
+		p, err := s.providerGetter(b.BackendType)
+		if err != nil {
+			return errors.Capture(err)
+		}
+		cfg := modelBackendConfig(mUUID, modelBackend, b)
+		backend, err := p.NewBackend(&cfg)
+		if err == nil {
+			err = backend.Ping()
+		}
+		if err != nil {
+			s.backendFailures.set(backendID, err)
+			return errors.Errorf("checking secret backend %q: %w", backendID, err)
+		}
+		s.backendFailures.clear(backendID)
+		return nil
+	}
+	return errors.Errorf("secret backend %q: %w", backendID, backenderrors.NotFound)
+}
+
+// modelBackendConfig returns the config used to connect to the backend on
+// behalf of the model.
+func modelBackendConfig(modelUUID string, modelBackend secretbackend.ModelSecretBackend, b *secretbackend.SecretBackend) provider.ModelBackendConfig {
+	return provider.ModelBackendConfig{
+		ControllerUUID: modelBackend.ControllerUUID,
+		ModelUUID:      modelUUID,
+		ModelName:      modelBackend.ModelName,
+		BackendConfig: provider.BackendConfig{
+			BackendType: b.BackendType,
+			Config:      b.Config,
+		},
+	}
 }
 
 func (s *SecretService) getBackendForUserSecrets(ctx context.Context, accessor SecretAccessor) (provider.SecretsBackend, string, error) {
@@ -115,7 +195,7 @@ func (s *SecretService) getBackendForUserSecrets(ctx context.Context, accessor S
 	if !ok {
 		return nil, "", fmt.Errorf("active backend config for %q: %w", activeBackendID, backenderrors.NotFound)
 	}
-	backend, err := s.getBackend(&cfg)
+	backend, err := s.getBackend(activeBackendID, &cfg)
 	if err != nil {
 		return nil, "", jujuerrors.Trace(err)
 	}
@@ -146,17 +226,8 @@ func (s *SecretService) loadBackendInfo(ctx context.Context, activeOnly bool) er
 			continue
 		}
 
-		cfg := provider.ModelBackendConfig{
-			ControllerUUID: modelBackend.ControllerUUID,
-			ModelUUID:      mUUID,
-			ModelName:      modelBackend.ModelName,
-			BackendConfig: provider.BackendConfig{
-				BackendType: b.BackendType,
-				Config:      b.Config,
-			},
-		}
-
-		s.backends[b.ID], err = s.getBackend(&cfg)
+		cfg := modelBackendConfig(mUUID, modelBackend, b)
+		s.backends[b.ID], err = s.getBackend(b.ID, &cfg)
 		if err != nil {
 			if b.ID != s.activeBackendID && cfg.BackendType == kubernetes.BackendType {
 				// TODO(secrets) - on an iaas controller, attempting to get the "model" k8s backend fails
@@ -180,9 +251,15 @@ func (s *SecretService) loadBackendInfo(ctx context.Context, activeOnly bool) er
 // satisfying [secreterrors.SecretLabelAlreadyExists] if the secret owner already has
 // a secret with the same label.
 func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI, params CreateUserSecretParams) (errOut error) {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	if len(params.Data) == 0 {
 		return jujuerrors.NotValidf("empty secret value")
 	}
+	if err := validateNewSecretContent(params.ContentSchema, params.Data); err != nil {
+		return jujuerrors.Trace(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
@@ -247,7 +324,10 @@ func (s *SecretService) CreateUserSecret(ctx context.Context, uri *secrets.URI,
 	}()
 
 	if err = s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
-		return s.createSecret(ctx, params.Version, uri, secrets.Owner{Kind: secrets.ModelOwner}, p)
+		if err := s.createSecret(ctx, params.Version, uri, secrets.Owner{Kind: secrets.ModelOwner}, p); err != nil {
+			return jujuerrors.Trace(err)
+		}
+		return s.setNewSecretContentSchema(ctx, uri, params.ContentSchema)
 	}); err != nil {
 		return jujuerrors.Annotatef(err, "creating user secret %q", uri.ID)
 	}
@@ -262,9 +342,25 @@ func ptr[T any](s T) *T {
 // returning an error satisfying [secreterrors.SecretLabelAlreadyExists] if the
 // secret owner already has a secret with the same label.
 func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI, params CreateCharmSecretParams) (errOut error) {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return jujuerrors.New("must specify either content or a value reference but not both")
 	}
+	if err := validateGenerationPolicy(params.GenerationPolicy); err != nil {
+		return jujuerrors.Trace(err)
+	}
+	if len(params.Data) == 0 && params.ValueRef == nil && params.GenerationPolicy != nil && !params.GenerationPolicy.IsEmpty() {
+		data, err := params.GenerationPolicy.Generate()
+		if err != nil {
+			return jujuerrors.Trace(err)
+		}
+		params.Data = data
+	}
+	if err := validateNewSecretContent(params.ContentSchema, params.Data); err != nil {
+		return jujuerrors.Trace(err)
+	}
 
 	p := domainsecret.UpsertSecretParams{
 		Description: params.Description,
@@ -322,7 +418,13 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 			ID:   params.CharmOwner.ID,
 			Kind: secrets.OwnerKind(params.CharmOwner.Kind),
 		}
-		return s.createSecret(ctx, params.Version, uri, owner, p)
+		if err := s.createSecret(ctx, params.Version, uri, owner, p); err != nil {
+			return jujuerrors.Trace(err)
+		}
+		if err := s.setNewSecretContentSchema(ctx, uri, params.ContentSchema); err != nil {
+			return jujuerrors.Trace(err)
+		}
+		return s.setSecretGenerationPolicy(ctx, uri, params.GenerationPolicy)
 	})
 	if err != nil {
 		return jujuerrors.Annotatef(err, "cannot create charm secret %q", uri.ID)
@@ -336,6 +438,9 @@ func (s *SecretService) CreateCharmSecret(ctx context.Context, uri *secrets.URI,
 // the secret owner already has a secret with the same label.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
 func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI, params UpdateUserSecretParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
@@ -367,6 +472,14 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 				// Check if the uri exists or not.
 				return errors.Capture(err)
 			}
+			// The content is validated before it is saved to the backend,
+			// after which only a reference to it is held.
+			err = s.secretState.RunAtomic(innerCtx, func(innerInnerCtx domain.AtomicContext) error {
+				return s.validateSecretContent(innerInnerCtx, uri, params.Data)
+			})
+			if err != nil {
+				return errors.Capture(err)
+			}
 			revId, err := backend.SaveContent(innerCtx, uri, latestRevision+1, secrets.NewSecretValue(params.Data))
 			if err != nil && !errors.Is(err, jujuerrors.NotSupported) {
 				return errors.Errorf("saving secret content to backend: %w", err)
@@ -434,9 +547,15 @@ func (s *SecretService) UpdateUserSecret(ctx context.Context, uri *secrets.URI,
 // the secret owner already has a secret with the same label.
 // It returns [secreterrors.PermissionDenied] if the secret cannot be managed by the accessor.
 func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI, params UpdateCharmSecretParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	if len(params.Data) > 0 && params.ValueRef != nil {
 		return jujuerrors.New("must specify either content or a value reference but not both")
 	}
+	if err := validateGenerationPolicy(params.GenerationPolicy); err != nil {
+		return jujuerrors.Trace(err)
+	}
 
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
@@ -497,7 +616,10 @@ func (s *SecretService) UpdateCharmSecret(ctx context.Context, uri *secrets.URI,
 		// TODO (manadart 2024-11-29): This context naming is nasty,
 		// but will be removed with RunAtomic.
 		err := s.secretState.RunAtomic(innerCtx, func(innerInnerCtx domain.AtomicContext) error {
-			return s.updateSecret(innerInnerCtx, uri, p)
+			if err := s.updateSecret(innerInnerCtx, uri, p); err != nil {
+				return jujuerrors.Trace(err)
+			}
+			return s.setSecretGenerationPolicy(innerInnerCtx, uri, params.GenerationPolicy)
 		})
 		if err != nil {
 			return jujuerrors.Annotatef(err, "cannot update charm secret %q", uri.ID)
@@ -586,10 +708,102 @@ func (s *SecretService) updateSecret(ctx domain.AtomicContext, uri *secrets.URI,
 			return fmt.Errorf("secret with label %q is already being used: %w", *params.Label, secreterrors.SecretLabelAlreadyExists)
 		}
 	}
+	// Content stored in an external backend has already been validated,
+	// if it was written by the controller, and cannot be checked here.
+	if err := s.validateSecretContent(ctx, uri, params.Data); err != nil {
+		return jujuerrors.Trace(err)
+	}
 	err := s.secretState.UpdateSecret(ctx, uri, params)
 	return jujuerrors.Trace(err)
 }
 
+// validateNewSecretContent checks that the content schema of a new secret is
+// valid, and that the content of its first revision satisfies it.
+func validateNewSecretContent(schema *domainsecret.ContentSchema, data secrets.SecretData) error {
+	if schema == nil {
+		return nil
+	}
+	if err := schema.Validate(); err != nil {
+		return jujuerrors.Annotate(err, "secret content schema")
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return schema.ValidateContent(data)
+}
+
+// setNewSecretContentSchema registers the content schema, if any, of a
+// newly created secret.
+func (s *SecretService) setNewSecretContentSchema(ctx domain.AtomicContext, uri *secrets.URI, schema *domainsecret.ContentSchema) error {
+	if schema == nil || schema.IsEmpty() {
+		return nil
+	}
+	return jujuerrors.Trace(s.secretState.SetSecretContentSchema(ctx, uri, *schema))
+}
+
+// validateGenerationPolicy checks that the generation policy, if any, can be
+// used to generate secret content.
+func validateGenerationPolicy(policy *domainsecret.GenerationPolicy) error {
+	if policy == nil || policy.IsEmpty() {
+		return nil
+	}
+	return jujuerrors.Annotate(policy.Validate(), "secret generation policy")
+}
+
+// setSecretGenerationPolicy replaces the generation policy of a secret, if a
+// policy is specified.
+func (s *SecretService) setSecretGenerationPolicy(ctx domain.AtomicContext, uri *secrets.URI, policy *domainsecret.GenerationPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	return jujuerrors.Trace(s.secretState.SetSecretGenerationPolicy(ctx, uri, *policy))
+}
+
+// validateSecretContent checks the content of a new revision of the
+// specified secret against the secret's content schema, returning an error
+// satisfying [secreterrors.SecretContentNotValid] if it does not satisfy it.
+// Every write of new content, including content written when a secret is
+// rotated, goes through this check.
+func (s *SecretService) validateSecretContent(ctx domain.AtomicContext, uri *secrets.URI, data secrets.SecretData) error {
+	if len(data) == 0 {
+		return nil
+	}
+	schema, err := s.secretState.GetSecretContentSchema(ctx, uri)
+	if err != nil {
+		return jujuerrors.Trace(err)
+	}
+	return schema.ValidateContent(data)
+}
+
+// SetSecretContentSchema registers the schema which the content of new
+// revisions of the specified secret must satisfy. An empty schema removes any
+// existing schema. Existing revisions are not checked.
+// It returns [secreterrors.PermissionDenied] if the secret cannot be managed by
+// the accessor, and an error satisfying [secreterrors.SecretNotFound] if the
+// secret does not exist.
+func (s *SecretService) SetSecretContentSchema(ctx context.Context, uri *secrets.URI, params SetSecretContentSchemaParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
+	if err := params.Schema.Validate(); err != nil {
+		return errors.Errorf("secret content schema: %w", err)
+	}
+
+	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
+	if err != nil {
+		return errors.Capture(err)
+	}
+	return withCaveat(ctx, func(innerCtx context.Context) error {
+		err := s.secretState.RunAtomic(innerCtx, func(innerInnerCtx domain.AtomicContext) error {
+			return s.secretState.SetSecretContentSchema(innerInnerCtx, uri, params.Schema)
+		})
+		if err != nil {
+			return errors.Errorf("setting content schema for secret %q: %w", uri.ID, err)
+		}
+		return nil
+	})
+}
+
 // GetSecretsForOwners returns the secrets owned by the specified apps and/or units.
 func (s *SecretService) GetSecretsForOwners(ctx domain.AtomicContext, owners ...CharmSecretOwner) ([]*secrets.URI, error) {
 	appOwners, unitOwners := splitCharmSecretOwners(owners...)
@@ -606,6 +820,22 @@ func (s *SecretService) ListSecrets(ctx context.Context, uri *secrets.URI,
 	return s.secretState.ListSecrets(ctx, uri, revision, labels)
 }
 
+// SearchSecrets returns the secrets in the given scope whose label or
+// description contains the query, ignoring case. It returns an error
+// satisfying [jujuerrors.NotValid] if the query is empty or the scope is
+// not valid.
+func (s *SecretService) SearchSecrets(
+	ctx context.Context, query string, scope domainsecret.SecretSearchScope,
+) ([]*secrets.SecretMetadata, error) {
+	if query == "" {
+		return nil, jujuerrors.NotValidf("empty secret search query")
+	}
+	if err := scope.Validate(); err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	return s.secretState.SearchSecrets(ctx, query, scope)
+}
+
 func splitCharmSecretOwners(owners ...CharmSecretOwner) (domainsecret.ApplicationOwners, domainsecret.UnitOwners) {
 	var (
 		appOwners  domainsecret.ApplicationOwners
@@ -631,9 +861,77 @@ func (s *SecretService) ListCharmSecrets(
 	return s.secretState.ListCharmSecrets(ctx, appOwners, unitOwners)
 }
 
+// GetSecretOwnerApplicationStatus returns a summary of the health of the
+// secrets owned by the specified application, so that a charm can report
+// when its secrets need attention.
+// It returns an error satisfying [applicationerrors.ApplicationNotFound] if
+// the application does not exist.
+func (s *SecretService) GetSecretOwnerApplicationStatus(ctx context.Context, appName string) (SecretOwnerStatus, error) {
+	if err := s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		_, err := s.secretState.GetApplicationUUID(ctx, appName)
+		return err
+	}); err != nil {
+		return SecretOwnerStatus{}, errors.Capture(err)
+	}
+
+	metadata, revisions, err := s.secretState.ListCharmSecrets(ctx, domainsecret.ApplicationOwners{appName}, nil)
+	if err != nil {
+		return SecretOwnerStatus{}, errors.Errorf("listing secrets owned by %q: %w", appName, err)
+	}
+	grants, err := s.secretState.AllSecretGrants(ctx)
+	if err != nil {
+		return SecretOwnerStatus{}, errors.Errorf("getting secret grants: %w", err)
+	}
+
+	mUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return SecretOwnerStatus{}, errors.Errorf("getting model UUID: %w", err)
+	}
+	modelBackend, err := s.secretBackendState.GetModelSecretBackendDetails(ctx, coremodel.UUID(mUUID))
+	if err != nil {
+		return SecretOwnerStatus{}, errors.Errorf("getting model secret backend: %w", err)
+	}
+	status := SecretOwnerStatus{
+		TotalSecrets:       len(metadata),
+		BackendUnreachable: s.backendFailures.get(modelBackend.SecretBackendID) != nil,
+	}
+
+	now := s.clock.Now()
+	for i, md := range metadata {
+		uri := md.URI.String()
+		if md.NextRotateTime != nil && md.NextRotateTime.Before(now) {
+			status.OverdueForRotation = append(status.OverdueForRotation, uri)
+		}
+
+		expired := false
+		for _, rev := range revisions[i] {
+			if rev.ExpireTime != nil && rev.ExpireTime.Before(now) {
+				expired = true
+			}
+			if rev.ValueRef != nil && s.backendFailures.get(rev.ValueRef.BackendID) != nil {
+				status.BackendUnreachable = true
+			}
+		}
+		if expired {
+			status.ExpiredRevisions = append(status.ExpiredRevisions, uri)
+		}
+
+		for _, grant := range grants[md.URI.ID] {
+			if grant.ExpireTime != nil && grant.ExpireTime.Before(now) {
+				status.GrantErrors = append(status.GrantErrors, uri)
+				break
+			}
+		}
+	}
+	return status, nil
+}
+
 // GetSecret returns the secret with the specified URI.
 // If returns [secreterrors.SecretNotFound] is there's no such secret.
 func (s *SecretService) GetSecret(ctx context.Context, uri *secrets.URI) (*secrets.SecretMetadata, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Capture(err)
+	}
 	return s.secretState.GetSecret(ctx, uri)
 }
 
@@ -643,6 +941,14 @@ func (s *SecretService) GetUserSecretURIByLabel(ctx context.Context, label strin
 	return s.secretState.GetUserSecretURIByLabel(ctx, label)
 }
 
+// GetSecretByLabel returns the URI of the secret with the specified owner
+// label, owned by the application, unit or model identified by ownerKind and
+// ownerName. The owner name is not used for model owned secrets.
+// It returns [secreterrors.SecretNotFound] if there's no such secret.
+func (s *SecretService) GetSecretByLabel(ctx context.Context, label string, ownerKind, ownerName string) (*secrets.URI, error) {
+	return s.secretState.GetSecretURIByOwnerLabel(ctx, label, secrets.OwnerKind(ownerKind), ownerName)
+}
+
 // ListCharmSecretsToDrain returns secret drain revision info for
 // the secrets owned by the specified apps and units.
 func (s *SecretService) ListCharmSecretsToDrain(
@@ -661,6 +967,9 @@ func (s *SecretService) ListUserSecretsToDrain(ctx context.Context) ([]*secrets.
 // GetSecretValue returns the value of the specified secret revision.
 // If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
 func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, rev int, accessor SecretAccessor) (secrets.SecretValue, *secrets.ValueRef, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, nil, errors.Capture(err)
+	}
 	if err := s.canRead(ctx, uri, accessor); err != nil {
 		return nil, nil, jujuerrors.Trace(err)
 	}
@@ -668,10 +977,36 @@ func (s *SecretService) GetSecretValue(ctx context.Context, uri *secrets.URI, re
 	return secrets.NewSecretValue(data), ref, jujuerrors.Trace(err)
 }
 
+// GetSecretContentRef returns the reference to the content of the specified
+// secret revision in the backend which holds it, but not the content itself.
+// The same access checks as for [SecretService.GetSecretValue] are applied.
+// If the content is held by the controller's internal backend,
+// [secreterrors.SecretContentNotExternal] is returned.
+// If returns [secreterrors.SecretRevisionNotFound] is there's no such secret revision.
+func (s *SecretService) GetSecretContentRef(ctx context.Context, accessor SecretAccessor, uri *secrets.URI, rev int) (*secrets.ValueRef, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Capture(err)
+	}
+	if err := s.canRead(ctx, uri, accessor); err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	_, ref, err := s.secretState.GetSecretValue(ctx, uri, rev)
+	if err != nil {
+		return nil, jujuerrors.Trace(err)
+	}
+	if ref == nil {
+		return nil, fmt.Errorf("secret %s revision %d%w", uri.ID, rev, jujuerrors.Hide(secreterrors.SecretContentNotExternal))
+	}
+	return ref, nil
+}
+
 // GetSecretContentFromBackend retrieves the content for the specified secret revision.
 // If the content is not found, it may be that the secret has been drained so it tries
 // again using the new active backend.
 func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *secrets.URI, rev int) (secrets.SecretValue, error) {
+	if err := validateSecretURI(uri); err != nil {
+		return nil, errors.Capture(err)
+	}
 	if s.activeBackendID == "" {
 		err := s.loadBackendInfo(ctx, false)
 		if err != nil {
@@ -729,6 +1064,11 @@ func (s *SecretService) GetSecretContentFromBackend(ctx context.Context, uri *se
 func (s *SecretService) ProcessCharmSecretConsumerLabel(
 	ctx context.Context, unitName string, uri *secrets.URI, label string,
 ) (_ *secrets.URI, _ *string, err error) {
+	if uri != nil {
+		if err := validateSecretURI(uri); err != nil {
+			return nil, nil, errors.Capture(err)
+		}
+	}
 	modelUUID, err := s.secretState.GetModelUUID(ctx)
 	if err != nil {
 		return nil, nil, jujuerrors.Annotate(err, "getting model uuid")
@@ -839,6 +1179,22 @@ func (s *SecretService) getAppOwnedOrUnitOwnedSecretMetadata(
 		Kind: UnitOwner,
 		ID:   unitName,
 	}}
+
+	// Without a URI, the label is an owner label, so look it up directly
+	// rather than listing every secret owned by the caller.
+	if uri == nil {
+		for _, owner := range owners {
+			ownerURI, err := s.GetSecretByLabel(ctx, label, string(owner.Kind), owner.ID)
+			if errors.Is(err, secreterrors.SecretNotFound) {
+				continue
+			} else if err != nil {
+				return nil, jujuerrors.Trace(err)
+			}
+			return s.secretState.GetSecret(ctx, ownerURI)
+		}
+		return nil, notFoundErr
+	}
+
 	metadata, _, err := s.ListCharmSecrets(ctx, owners...)
 	if err != nil {
 		return nil, jujuerrors.Trace(err)
@@ -861,6 +1217,9 @@ func (s *SecretService) getAppOwnedOrUnitOwnedSecretMetadata(
 func (s *SecretService) ChangeSecretBackend(
 	ctx context.Context, uri *secrets.URI, revision int, params ChangeSecretBackendParams,
 ) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
@@ -903,8 +1262,36 @@ func (s *SecretService) ChangeSecretBackend(
 	})
 }
 
+// GetApplicationLeaderForSecretRotation returns the name of the unit which
+// currently holds leadership of the specified application. Only the leader is
+// sent secret-rotate events for application owned secrets, so that the units
+// of an application don't race each other to rotate the same secret.
+// It returns an error satisfying [applicationerrors.ApplicationNotFound] if the
+// application does not exist, or [jujuerrors.NotFound] if no unit is leader.
+func (s *SecretService) GetApplicationLeaderForSecretRotation(ctx context.Context, appName string) (string, error) {
+	unitNames, err := s.secretState.GetApplicationUnitNames(ctx, appName)
+	if err != nil {
+		return "", errors.Capture(err)
+	}
+	for _, unitName := range unitNames {
+		err := s.leaderEnsurer.LeadershipCheck(appName, unitName).Check()
+		if err == nil {
+			return unitName, nil
+		}
+		// The lease backed ensurer reports a unit which isn't leader as
+		// the lease not being held, rather than as a leadership error.
+		if !leadership.IsNotLeaderError(err) && !errors.Is(err, lease.ErrNotHeld) {
+			return "", errors.Errorf("checking leadership of %q: %w", unitName, err)
+		}
+	}
+	return "", jujuerrors.NotFoundf("leader of application %q", appName)
+}
+
 // SecretRotated rotates the secret with the specified URI.
 func (s *SecretService) SecretRotated(ctx context.Context, uri *secrets.URI, params SecretRotatedParams) error {
+	if err := validateSecretURI(uri); err != nil {
+		return errors.Capture(err)
+	}
 	withCaveat, err := s.getManagementCaveat(ctx, uri, params.Accessor)
 	if err != nil {
 		return errors.Capture(err)
@@ -935,12 +1322,129 @@ func (s *SecretService) SecretRotated(ctx context.Context, uri *secrets.URI, par
 		s.logger.Warningf("secret %q rev %d will expire before next scheduled rotation", uri.ID, info.LatestRevision)
 	}
 
-	if willExpire && forcedRotateTime.Before(*info.LatestExpireTime) || !params.Skip && info.LatestRevision == params.OriginalRevision {
+	// If the owner did not add a new revision when the secret was due to
+	// rotate, and the secret has a generation policy, the controller
+	// generates the new revision itself.
+	notUpdated := !params.Skip && info.LatestRevision == params.OriginalRevision
+	var generationPolicy domainsecret.GenerationPolicy
+	if notUpdated {
+		err := s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+			var err error
+			generationPolicy, err = s.secretState.GetSecretGenerationPolicy(ctx, uri)
+			return err
+		})
+		if err != nil {
+			return errors.Capture(err)
+		}
+	}
+	generate := notUpdated && !generationPolicy.IsEmpty()
+
+	if willExpire && forcedRotateTime.Before(*info.LatestExpireTime) || notUpdated && !generate {
 		nextRotateTime = forcedRotateTime
 	}
 	s.logger.Debugf("secret %q next rotate time is now: %s", uri.ID, nextRotateTime.UTC().Format(time.RFC3339))
 
 	return withCaveat(ctx, func(innerCtx context.Context) (errOut error) {
+		if generate {
+			if err := s.addGeneratedSecretRevision(innerCtx, uri, info.LatestRevision, generationPolicy); err != nil {
+				return errors.Errorf("generating content for secret %q: %w", uri.ID, err)
+			}
+		}
 		return s.secretState.SecretRotated(innerCtx, uri, nextRotateTime)
 	})
 }
+
+// addGeneratedSecretRevision adds a revision of the secret whose content is
+// that of the latest revision, with the value of the policy's key generated
+// afresh. The new content is validated against any content schema and saved
+// to the model's active backend like any other, so consumers are notified of
+// the new revision.
+func (s *SecretService) addGeneratedSecretRevision(
+	ctx context.Context, uri *secrets.URI, latestRevision int, policy domainsecret.GenerationPolicy,
+) (errOut error) {
+	latest, err := s.GetSecretContentFromBackend(ctx, uri, latestRevision)
+	if err != nil {
+		return errors.Errorf("getting latest content: %w", err)
+	}
+	generated, err := policy.Generate()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	data := make(secrets.SecretData)
+	for k, v := range latest.EncodedValues() {
+		data[k] = v
+	}
+	for k, v := range generated {
+		data[k] = v
+	}
+
+	// The content is validated before it is saved to the backend, after
+	// which only a reference to it is held.
+	err = s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		return s.validateSecretContent(ctx, uri, data)
+	})
+	if err != nil {
+		return errors.Capture(err)
+	}
+
+	// The active backend may have changed since the backends were loaded.
+	if err := s.loadBackendInfo(ctx, false); err != nil {
+		return errors.Capture(err)
+	}
+	backend, ok := s.backends[s.activeBackendID]
+	if !ok {
+		return errors.Errorf("active secret backend %q: %w", s.activeBackendID, backenderrors.NotFound)
+	}
+
+	p := domainsecret.UpsertSecretParams{
+		Data: data,
+	}
+	revId, err := backend.SaveContent(ctx, uri, latestRevision+1, secrets.NewSecretValue(data))
+	if err != nil && !errors.Is(err, jujuerrors.NotSupported) {
+		return errors.Errorf("saving secret content to backend: %w", err)
+	}
+	if err == nil {
+		defer func() {
+			if errOut != nil {
+				// If we failed to add the revision, we should delete the
+				// secret value from the backend.
+				if err2 := backend.DeleteContent(ctx, revId); err2 != nil &&
+					!errors.Is(err2, jujuerrors.NotSupported) &&
+					!errors.Is(err2, secreterrors.SecretRevisionNotFound) {
+					s.logger.Warningf("failed to delete secret %q: %v", revId, err2)
+				}
+			}
+		}()
+		p.Data = nil
+		p.ValueRef = &secrets.ValueRef{
+			BackendID:  s.activeBackendID,
+			RevisionID: revId,
+		}
+	}
+
+	revisionID, err := s.uuidGenerator()
+	if err != nil {
+		return errors.Capture(err)
+	}
+	p.RevisionID = ptr(revisionID.String())
+
+	modelID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return errors.Errorf("getting model uuid: %w", err)
+	}
+	rollBack, err := s.secretBackendState.AddSecretBackendReference(ctx, p.ValueRef, coremodel.UUID(modelID), revisionID.String())
+	if err != nil {
+		return errors.Capture(err)
+	}
+	defer func() {
+		if errOut != nil {
+			if err := rollBack(); err != nil {
+				s.logger.Warningf("failed to roll back secret reference count: %v", err)
+			}
+		}
+	}()
+
+	return s.secretState.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		return s.updateSecret(ctx, uri, p)
+	})
+}
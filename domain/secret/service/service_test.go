@@ -5,6 +5,7 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
 	"time"
 
 	"github.com/juju/clock/testclock"
@@ -26,8 +27,10 @@ import (
 	"github.com/juju/juju/core/watcher/eventsource"
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/domain"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
 	domainsecret "github.com/juju/juju/domain/secret"
 	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/domain/secretbackend"
 	domaintesting "github.com/juju/juju/domain/testing"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	"github.com/juju/juju/internal/secrets/provider"
@@ -108,6 +111,7 @@ func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 		leaderEnsurer:          s.ensurer,
 		userSecretConfigGetter: s.userSecretConfigGetter,
 		uuidGenerator:          func() (uuid.UUID, error) { return s.fakeUUID, nil },
+		backendFailures:        NewBackendFailureCache(s.clock, DefaultBackendFailureTTL),
 		clock:                  s.clock,
 		logger:                 loggertesting.WrapCheckLog(c),
 	}
@@ -283,6 +287,13 @@ func (s *serviceSuite) assertUpdateUserSecret(c *gc.C, isInternal, finalStepFail
 		return nil
 	}, nil)
 
+	// The content is validated before it is saved to the backend, and
+	// again when it is held by the controller.
+	schemaLookups := 1
+	if isInternal && !labelExists {
+		schemaLookups = 2
+	}
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{}, nil).Times(schemaLookups)
 	s.state.EXPECT().GetSecretOwner(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.Owner{Kind: domainsecret.ModelOwner}, nil)
 	s.state.EXPECT().CheckUserSecretLabelExists(domaintesting.IsAtomicContextChecker, "my secret").Return(labelExists, nil)
 	if !labelExists {
@@ -558,6 +569,7 @@ func (s *serviceSuite) TestUpdateCharmSecretNoRotate(c *gc.C) {
 	}, nil)
 	s.state.EXPECT().GetSecretOwner(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.Owner{Kind: domainsecret.UnitOwner, UUID: unitUUID.String()}, nil)
 	s.state.EXPECT().CheckUnitSecretLabelExists(domaintesting.IsAtomicContextChecker, unitUUID, "my secret").Return(false, nil)
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{}, nil)
 	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, p).Return(nil)
 
 	err = s.service.UpdateCharmSecret(context.Background(), uri, UpdateCharmSecretParams{
@@ -610,6 +622,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForUnitOwned(c *gc.C) {
 
 	s.state.EXPECT().GetSecretOwner(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.Owner{Kind: domainsecret.UnitOwner, UUID: unitUUID.String()}, nil)
 	s.state.EXPECT().CheckUnitSecretLabelExists(domaintesting.IsAtomicContextChecker, unitUUID, "my secret").Return(false, nil)
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{}, nil)
 	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, gomock.Any()).DoAndReturn(func(_ domain.AtomicContext, _ *coresecrets.URI, got domainsecret.UpsertSecretParams) error {
 		c.Assert(got.NextRotateTime, gc.NotNil)
 		c.Assert(*got.NextRotateTime, jc.Almost, *p.NextRotateTime)
@@ -635,6 +648,222 @@ func (s *serviceSuite) TestUpdateCharmSecretForUnitOwned(c *gc.C) {
 	c.Assert(rollbackCalled, jc.IsFalse)
 }
 
+func (s *serviceSuite) TestCreateCharmSecretWithContentSchema(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	unitUUID, err := coreunit.NewUUID()
+	c.Assert(err, jc.ErrorIsNil)
+	schema := domainsecret.ContentSchema{
+		RequiredKeys:  []string{"foo"},
+		ValuePatterns: map[string]string{"foo": "b.*"},
+	}
+
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+	s.state.EXPECT().GetUnitUUID(domaintesting.IsAtomicContextChecker, "mariadb/0").Return(unitUUID, nil)
+	s.state.EXPECT().CreateCharmUnitSecret(domaintesting.IsAtomicContextChecker, 1, uri, unitUUID, gomock.Any()).Return(nil)
+	s.state.EXPECT().SetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri, schema).Return(nil)
+
+	err = s.service.CreateCharmSecret(context.Background(), uri, CreateCharmSecretParams{
+		UpdateCharmSecretParams: UpdateCharmSecretParams{
+			Accessor: SecretAccessor{
+				Kind: UnitAccessor,
+				ID:   "mariadb/0",
+			},
+			Data: map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+		CharmOwner: CharmSecretOwner{
+			Kind: UnitOwner,
+			ID:   "mariadb/0",
+		},
+		ContentSchema: &schema,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCreateCharmSecretContentNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateCharmSecret(context.Background(), coresecrets.NewURI(), CreateCharmSecretParams{
+		UpdateCharmSecretParams: UpdateCharmSecretParams{
+			Accessor: SecretAccessor{
+				Kind: UnitAccessor,
+				ID:   "mariadb/0",
+			},
+			Data: map[string]string{"foo": "bar"},
+		},
+		Version: 1,
+		CharmOwner: CharmSecretOwner{
+			Kind: UnitOwner,
+			ID:   "mariadb/0",
+		},
+		ContentSchema: &domainsecret.ContentSchema{
+			RequiredKeys: []string{"password"},
+		},
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretContentNotValid)
+	c.Assert(err, gc.ErrorMatches, `secret content not valid: missing keys "password"`)
+}
+
+func (s *serviceSuite) TestCreateCharmSecretWithGenerationPolicy(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	unitUUID, err := coreunit.NewUUID()
+	c.Assert(err, jc.ErrorIsNil)
+	policy := domainsecret.GenerationPolicy{
+		Key:    "password",
+		Length: 12,
+		Format: domainsecret.FormatHex,
+	}
+
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
+		return nil
+	}, nil)
+	s.state.EXPECT().GetUnitUUID(domaintesting.IsAtomicContextChecker, "mariadb/0").Return(unitUUID, nil)
+	s.state.EXPECT().CreateCharmUnitSecret(domaintesting.IsAtomicContextChecker, 1, uri, unitUUID, gomock.Any()).DoAndReturn(
+		func(_ domain.AtomicContext, _ int, _ *coresecrets.URI, _ coreunit.UUID, got domainsecret.UpsertSecretParams) error {
+			c.Check(got.Data, gc.HasLen, 1)
+			password, err := base64.StdEncoding.DecodeString(got.Data["password"])
+			c.Check(err, jc.ErrorIsNil)
+			c.Check(string(password), gc.Matches, "[0-9a-f]{12}")
+			return nil
+		})
+	s.state.EXPECT().SetSecretGenerationPolicy(domaintesting.IsAtomicContextChecker, uri, policy).Return(nil)
+
+	err = s.service.CreateCharmSecret(context.Background(), uri, CreateCharmSecretParams{
+		UpdateCharmSecretParams: UpdateCharmSecretParams{
+			Accessor: SecretAccessor{
+				Kind: UnitAccessor,
+				ID:   "mariadb/0",
+			},
+			GenerationPolicy: &policy,
+		},
+		Version: 1,
+		CharmOwner: CharmSecretOwner{
+			Kind: UnitOwner,
+			ID:   "mariadb/0",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestCreateCharmSecretGenerationPolicyNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.CreateCharmSecret(context.Background(), coresecrets.NewURI(), CreateCharmSecretParams{
+		UpdateCharmSecretParams: UpdateCharmSecretParams{
+			Accessor: SecretAccessor{
+				Kind: UnitAccessor,
+				ID:   "mariadb/0",
+			},
+			GenerationPolicy: &domainsecret.GenerationPolicy{
+				Key: "password",
+			},
+		},
+		Version: 1,
+		CharmOwner: CharmSecretOwner{
+			Kind: UnitOwner,
+			ID:   "mariadb/0",
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestUpdateCharmSecretContentNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	rollbackCalled := false
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), nil, s.modelID, s.fakeUUID.String()).Return(func() error {
+		rollbackCalled = true
+		return nil
+	}, nil)
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{
+		ValuePatterns: map[string]string{"port": "[0-9]+"},
+	}, nil)
+
+	// Content written when the secret is rotated must also satisfy the
+	// schema, so the rotation fails rather than storing it.
+	err := s.service.UpdateCharmSecret(context.Background(), uri, UpdateCharmSecretParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Data: map[string]string{"port": "eighty"},
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretContentNotValid)
+	c.Assert(err, gc.ErrorMatches, `cannot update charm secret .*: secret content not valid: invalid values for keys "port"`)
+	c.Assert(rollbackCalled, jc.IsTrue)
+}
+
+func (s *serviceSuite) TestSetSecretContentSchema(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	schema := domainsecret.ContentSchema{RequiredKeys: []string{"foo"}}
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().SetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri, schema).Return(nil)
+
+	err := s.service.SetSecretContentSchema(context.Background(), uri, SetSecretContentSchemaParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Schema: schema,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetSecretContentSchemaNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	err := s.service.SetSecretContentSchema(context.Background(), coresecrets.NewURI(), SetSecretContentSchemaParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		Schema: domainsecret.ContentSchema{
+			ValuePatterns: map[string]string{"foo": "[0-9"},
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestSetSecretContentSchemaPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.ensurer.EXPECT().LeadershipCheck("mariadb", "mariadb/0").Return(badToken{})
+
+	err := s.service.SetSecretContentSchema(context.Background(), uri, SetSecretContentSchemaParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.PermissionDenied)
+}
+
 func (s *serviceSuite) TestUpdateCharmSecretForUnitOwnedFailedLabelExists(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -711,6 +940,7 @@ func (s *serviceSuite) TestUpdateCharmSecretForAppOwned(c *gc.C) {
 
 	s.state.EXPECT().GetSecretOwner(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.Owner{Kind: domainsecret.ApplicationOwner, UUID: appUUID.String()}, nil)
 	s.state.EXPECT().CheckApplicationSecretLabelExists(domaintesting.IsAtomicContextChecker, appUUID, "my secret").Return(false, nil)
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{}, nil)
 	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, gomock.Any()).DoAndReturn(func(_ domain.AtomicContext, _ *coresecrets.URI, got domainsecret.UpsertSecretParams) error {
 		c.Assert(got.NextRotateTime, gc.NotNil)
 		c.Assert(*got.NextRotateTime, jc.Almost, *p.NextRotateTime)
@@ -793,6 +1023,35 @@ func (s *serviceSuite) TestGetSecret(c *gc.C) {
 	c.Assert(got, jc.DeepEquals, md)
 }
 
+func (s *serviceSuite) TestSearchSecrets(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	md := []*coresecrets.SecretMetadata{{
+		URI:   coresecrets.NewURI(),
+		Label: "my secret",
+	}}
+
+	s.state.EXPECT().SearchSecrets(gomock.Any(), "secret", domainsecret.SearchOwned).Return(md, nil)
+
+	got, err := s.service.SearchSecrets(context.Background(), "secret", domainsecret.SearchOwned)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, md)
+}
+
+func (s *serviceSuite) TestSearchSecretsEmptyQuery(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.SearchSecrets(context.Background(), "", domainsecret.SearchAll)
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestSearchSecretsInvalidScope(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.SearchSecrets(context.Background(), "secret", "mine")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
 func (s *serviceSuite) TestGetSecretValue(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -813,6 +1072,70 @@ func (s *serviceSuite) TestGetSecretValue(c *gc.C) {
 	c.Assert(data, jc.DeepEquals, coresecrets.NewSecretValue(map[string]string{"foo": "bar"}))
 }
 
+func (s *serviceSuite) TestGetSecretContentRef(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(nil, &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}, nil)
+
+	ref, err := s.service.GetSecretContentRef(context.Background(), SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	}, uri, 666)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(ref, jc.DeepEquals, &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	})
+}
+
+func (s *serviceSuite) TestGetSecretContentRefInternalBackend(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("view", nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{"foo": "bar"}, nil, nil)
+
+	_, err := s.service.GetSecretContentRef(context.Background(), SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	}, uri, 666)
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretContentNotExternal)
+}
+
+func (s *serviceSuite) TestGetSecretContentRefPermissionDenied(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("", nil)
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mariadb",
+	}).Return("", nil)
+
+	_, err := s.service.GetSecretContentRef(context.Background(), SecretAccessor{
+		Kind: UnitAccessor,
+		ID:   "mariadb/0",
+	}, uri, 666)
+	c.Assert(err, jc.ErrorIs, secreterrors.PermissionDenied)
+}
+
 func (s *serviceSuite) TestGetSecretConsumer(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -873,6 +1196,18 @@ func (s *serviceSuite) TestGetUserSecretURIByLabel(c *gc.C) {
 	c.Assert(got, jc.DeepEquals, uri)
 }
 
+func (s *serviceSuite) TestGetSecretByLabel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretURIByOwnerLabel(gomock.Any(), "my label", coresecrets.UnitOwner, "mysql/0").Return(uri, nil)
+
+	got, err := s.service.GetSecretByLabel(context.Background(), "my label", "unit", "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, uri)
+}
+
 func (s *serviceSuite) TestListCharmSecretsToDrain(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -944,6 +1279,95 @@ func (s *serviceSuite) TestListCharmSecrets(c *gc.C) {
 	c.Assert(gotRevisions, jc.DeepEquals, revs)
 }
 
+func (s *serviceSuite) TestGetSecretOwnerApplicationStatus(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	past := s.clock.Now().Add(-time.Hour)
+	future := s.clock.Now().Add(time.Hour)
+	healthy := coresecrets.NewURI()
+	overdue := coresecrets.NewURI()
+	expired := coresecrets.NewURI()
+	badGrant := coresecrets.NewURI()
+	md := []*coresecrets.SecretMetadata{
+		{URI: healthy, NextRotateTime: &future},
+		{URI: overdue, NextRotateTime: &past},
+		{URI: expired},
+		{URI: badGrant},
+	}
+	revs := [][]*coresecrets.SecretRevisionMetadata{
+		{{Revision: 1, ExpireTime: &future}},
+		{{Revision: 1}},
+		{{Revision: 1, ExpireTime: &past}, {Revision: 2}},
+		{{Revision: 1}},
+	}
+	grants := map[string][]domainsecret.GrantParams{
+		healthy.ID: {{
+			SubjectTypeID: domainsecret.SubjectApplication,
+			SubjectID:     "wordpress",
+			RoleID:        domainsecret.RoleView,
+			ExpireTime:    &future,
+		}},
+		badGrant.ID: {{
+			SubjectTypeID: domainsecret.SubjectApplication,
+			SubjectID:     "wordpress",
+			RoleID:        domainsecret.RoleView,
+			ExpireTime:    &past,
+		}},
+	}
+
+	s.state.EXPECT().GetApplicationUUID(domaintesting.IsAtomicContextChecker, "mysql").Return("app-uuid", nil)
+	s.state.EXPECT().ListCharmSecrets(gomock.Any(), domainsecret.ApplicationOwners{"mysql"}, nil).Return(md, revs, nil)
+	s.state.EXPECT().AllSecretGrants(gomock.Any()).Return(grants, nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), coremodel.UUID(coretesting.ModelTag.Id())).
+		Return(secretbackend.ModelSecretBackend{SecretBackendID: "backend-id"}, nil)
+
+	status, err := s.service.GetSecretOwnerApplicationStatus(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, jc.DeepEquals, SecretOwnerStatus{
+		TotalSecrets:       4,
+		OverdueForRotation: []string{overdue.String()},
+		ExpiredRevisions:   []string{expired.String()},
+		GrantErrors:        []string{badGrant.String()},
+	})
+}
+
+func (s *serviceSuite) TestGetSecretOwnerApplicationStatusBackendUnreachable(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	md := []*coresecrets.SecretMetadata{{URI: uri}}
+	revs := [][]*coresecrets.SecretRevisionMetadata{{{
+		Revision: 1,
+		ValueRef: &coresecrets.ValueRef{BackendID: "vault-id", RevisionID: "rev-id"},
+	}}}
+	s.service.backendFailures.set("vault-id", errors.New("connection refused"))
+
+	s.state.EXPECT().GetApplicationUUID(domaintesting.IsAtomicContextChecker, "mysql").Return("app-uuid", nil)
+	s.state.EXPECT().ListCharmSecrets(gomock.Any(), domainsecret.ApplicationOwners{"mysql"}, nil).Return(md, revs, nil)
+	s.state.EXPECT().AllSecretGrants(gomock.Any()).Return(nil, nil)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.secretBackendState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), coremodel.UUID(coretesting.ModelTag.Id())).
+		Return(secretbackend.ModelSecretBackend{SecretBackendID: "backend-id"}, nil)
+
+	status, err := s.service.GetSecretOwnerApplicationStatus(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(status, jc.DeepEquals, SecretOwnerStatus{
+		TotalSecrets:       1,
+		BackendUnreachable: true,
+	})
+}
+
+func (s *serviceSuite) TestGetSecretOwnerApplicationStatusApplicationNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUUID(domaintesting.IsAtomicContextChecker, "mysql").
+		Return("", applicationerrors.ApplicationNotFound)
+
+	_, err := s.service.GetSecretOwnerApplicationStatus(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *serviceSuite) TestListCharmJustApplication(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1115,6 +1539,75 @@ func (s *serviceSuite) TestGrantSecretApplicationAccess(c *gc.C) {
 	c.Assert(err, jc.ErrorIsNil)
 }
 
+func (s *serviceSuite) TestGrantSecretAccessWithExpiry(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expire := s.clock.Now().Add(time.Hour)
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "another/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GrantAccess(gomock.Any(), uri, domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+		RoleID:        domainsecret.RoleView,
+		ExpireTime:    &expire,
+	}).Return(nil)
+
+	err := s.service.GrantSecretAccess(context.Background(), uri, SecretAccessParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "another/0",
+		},
+		Scope: SecretAccessScope{
+			Kind: ApplicationAccessScope,
+			ID:   "mysql",
+		},
+		Subject: SecretAccessor{
+			Kind: ApplicationAccessor,
+			ID:   "mysql",
+		},
+		Role:       "view",
+		ExpireTime: &expire,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestGrantSecretAccessExpiryInPast(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	expire := s.clock.Now()
+	err := s.service.GrantSecretAccess(context.Background(), coresecrets.NewURI(), SecretAccessParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "another/0",
+		},
+		Scope: SecretAccessScope{
+			Kind: ApplicationAccessScope,
+			ID:   "mysql",
+		},
+		Subject: SecretAccessor{
+			Kind: ApplicationAccessor,
+			ID:   "mysql",
+		},
+		Role:       "view",
+		ExpireTime: &expire,
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
+func (s *serviceSuite) TestPruneExpiredSecretGrants(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().DeleteExpiredSecretGrants(gomock.Any()).Return(int64(2), nil)
+
+	err := s.service.PruneExpiredSecretGrants(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *serviceSuite) TestGrantSecretModelAccess(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1186,52 +1679,146 @@ func (s *serviceSuite) TestRevokeSecretUnitAccess(c *gc.C) {
 	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
 		SubjectTypeID: domainsecret.SubjectUnit,
 		SubjectID:     "mysql/0",
-	}).Return("manage", nil)
-	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
+	}).Return("manage", nil)
+	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "another/0",
+	}).Return(nil)
+
+	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mysql/0",
+		},
+		Subject: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "another/0",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRevokeSecretApplicationAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "another",
+	}).Return(nil)
+
+	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mysql/0",
+		},
+		Subject: SecretAccessor{
+			Kind: ApplicationAccessor,
+			ID:   "another",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestRevokeSecretModelAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     "model-uuid",
+	}).Return("manage", nil)
+	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+	}).Return(nil)
+
+	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+		Accessor: SecretAccessor{
+			Kind: ModelAccessor,
+			ID:   "model-uuid",
+		},
+		Subject: SecretAccessor{
+			Kind: ApplicationAccessor,
+			ID:   "mysql",
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestBulkGrantSecretAccess(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "another/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GrantAccessBulk(gomock.Any(), uri, []domainsecret.GrantParams{{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+		RoleID:        domainsecret.RoleView,
+	}, {
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
 		SubjectTypeID: domainsecret.SubjectUnit,
-		SubjectID:     "another/0",
-	}).Return(nil)
+		SubjectID:     "mysql/1",
+		RoleID:        domainsecret.RoleView,
+	}}).Return([]error{nil, applicationerrors.UnitNotFound}, nil)
 
-	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+	results, err := s.service.BulkGrantSecretAccess(context.Background(), uri, SecretBulkAccessParams{
 		Accessor: SecretAccessor{
-			Kind: UnitAccessor,
-			ID:   "mysql/0",
-		},
-		Subject: SecretAccessor{
 			Kind: UnitAccessor,
 			ID:   "another/0",
 		},
+		Grants: []SecretAccessGrant{{
+			Scope:   SecretAccessScope{Kind: ApplicationAccessScope, ID: "mysql"},
+			Subject: SecretAccessor{Kind: UnitAccessor, ID: "mysql/0"},
+			Role:    "view",
+		}, {
+			Scope:   SecretAccessScope{Kind: ApplicationAccessScope, ID: "mysql"},
+			Subject: SecretAccessor{Kind: UnitAccessor, ID: "mysql/1"},
+			Role:    "view",
+		}},
 	})
 	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 2)
+	c.Check(results[0].Subject, jc.DeepEquals, SecretAccessor{Kind: UnitAccessor, ID: "mysql/0"})
+	c.Check(results[0].Error, jc.ErrorIsNil)
+	c.Check(results[1].Subject, jc.DeepEquals, SecretAccessor{Kind: UnitAccessor, ID: "mysql/1"})
+	c.Check(results[1].Error, jc.ErrorIs, applicationerrors.UnitNotFound)
 }
 
-func (s *serviceSuite) TestRevokeSecretApplicationAccess(c *gc.C) {
+func (s *serviceSuite) TestBulkGrantSecretAccessPermissionDenied(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	uri := coresecrets.NewURI()
 	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
-		SubjectTypeID: domainsecret.SubjectUnit,
-		SubjectID:     "mysql/0",
-	}).Return("manage", nil)
-	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
 		SubjectTypeID: domainsecret.SubjectApplication,
 		SubjectID:     "another",
-	}).Return(nil)
+	}).Return("view", nil)
 
-	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+	_, err := s.service.BulkGrantSecretAccess(context.Background(), uri, SecretBulkAccessParams{
 		Accessor: SecretAccessor{
-			Kind: UnitAccessor,
-			ID:   "mysql/0",
-		},
-		Subject: SecretAccessor{
 			Kind: ApplicationAccessor,
 			ID:   "another",
 		},
+		Grants: []SecretAccessGrant{{
+			Scope:   SecretAccessScope{Kind: ApplicationAccessScope, ID: "mysql"},
+			Subject: SecretAccessor{Kind: ApplicationAccessor, ID: "mysql"},
+			Role:    "view",
+		}},
 	})
-	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(err, jc.ErrorIs, secreterrors.PermissionDenied)
 }
 
-func (s *serviceSuite) TestRevokeSecretModelAccess(c *gc.C) {
+func (s *serviceSuite) TestBulkRevokeSecretAccess(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	uri := coresecrets.NewURI()
@@ -1239,22 +1826,31 @@ func (s *serviceSuite) TestRevokeSecretModelAccess(c *gc.C) {
 		SubjectTypeID: domainsecret.SubjectModel,
 		SubjectID:     "model-uuid",
 	}).Return("manage", nil)
-	s.state.EXPECT().RevokeAccess(gomock.Any(), uri, domainsecret.AccessParams{
+	s.state.EXPECT().RevokeAccessBulk(gomock.Any(), uri, []domainsecret.AccessParams{{
 		SubjectTypeID: domainsecret.SubjectApplication,
 		SubjectID:     "mysql",
-	}).Return(nil)
+	}, {
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	}}).Return([]error{nil, nil}, nil)
 
-	err := s.service.RevokeSecretAccess(context.Background(), uri, SecretAccessParams{
+	results, err := s.service.BulkRevokeSecretAccess(context.Background(), uri, SecretBulkAccessParams{
 		Accessor: SecretAccessor{
 			Kind: ModelAccessor,
 			ID:   "model-uuid",
 		},
-		Subject: SecretAccessor{
-			Kind: ApplicationAccessor,
-			ID:   "mysql",
-		},
+		Grants: []SecretAccessGrant{{
+			Subject: SecretAccessor{Kind: ApplicationAccessor, ID: "mysql"},
+		}, {
+			Subject: SecretAccessor{Kind: UnitAccessor, ID: "mysql/0"},
+		}},
 	})
 	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, jc.DeepEquals, []SecretAccessResult{{
+		Subject: SecretAccessor{Kind: ApplicationAccessor, ID: "mysql"},
+	}, {
+		Subject: SecretAccessor{Kind: UnitAccessor, ID: "mysql/0"},
+	}})
 }
 
 func (s *serviceSuite) TestGetSecretAccess(c *gc.C) {
@@ -1562,6 +2158,7 @@ func (s *serviceSuite) TestSecretsRotatedRetry(c *gc.C) {
 		RotatePolicy:   coresecrets.RotateHourly,
 		LatestRevision: 666,
 	}, nil)
+	s.state.EXPECT().GetSecretGenerationPolicy(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.GenerationPolicy{}, nil)
 
 	err := s.service.SecretRotated(ctx, uri, SecretRotatedParams{
 		Accessor: SecretAccessor{
@@ -1573,6 +2170,80 @@ func (s *serviceSuite) TestSecretsRotatedRetry(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `boom`)
 }
 
+func (s *serviceSuite) TestSecretsRotatedGeneratesRevision(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	nextRotateTime := s.clock.Now().Add(time.Hour)
+	s.service.activeBackendID = "backend-id"
+
+	s.state.EXPECT().GetSecretAccess(gomock.Any(), uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mariadb/0",
+	}).Return("manage", nil)
+	s.state.EXPECT().GetRotationExpiryInfo(ctx, uri).Return(&domainsecret.RotationExpiryInfo{
+		RotatePolicy:   coresecrets.RotateHourly,
+		LatestRevision: 666,
+	}, nil)
+	s.state.EXPECT().GetSecretGenerationPolicy(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.GenerationPolicy{
+		Key:    "password",
+		Length: 16,
+	}, nil)
+	s.state.EXPECT().GetSecretValue(gomock.Any(), uri, 666).Return(coresecrets.SecretData{
+		"username": "Zm9v",
+		"password": "b2xk",
+	}, nil, nil)
+	s.state.EXPECT().GetSecretContentSchema(domaintesting.IsAtomicContextChecker, uri).Return(domainsecret.ContentSchema{}, nil)
+
+	// The generated content is saved to the active backend.
+	s.expectModelBackends()
+	s.secretsBackendProvider.EXPECT().NewBackend(ptr(backendConfigs.Configs["backend-id"])).Return(s.secretsBackend, nil)
+	s.secretsBackend.EXPECT().SaveContent(gomock.Any(), uri, 667, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *coresecrets.URI, _ int, value coresecrets.SecretValue) (string, error) {
+			data := value.EncodedValues()
+			c.Check(data, gc.HasLen, 2)
+			c.Check(data["username"], gc.Equals, "Zm9v")
+			password, err := base64.StdEncoding.DecodeString(data["password"])
+			c.Check(err, jc.ErrorIsNil)
+			c.Check(password, gc.HasLen, 16)
+			return "rev-id", nil
+		})
+	valueRef := &coresecrets.ValueRef{
+		BackendID:  "backend-id",
+		RevisionID: "rev-id",
+	}
+
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(s.modelID.String(), nil)
+	rollbackCalled := false
+	s.secretBackendState.EXPECT().AddSecretBackendReference(gomock.Any(), valueRef, s.modelID, s.fakeUUID.String()).Return(func() error {
+		rollbackCalled = true
+		return nil
+	}, nil)
+	s.state.EXPECT().UpdateSecret(domaintesting.IsAtomicContextChecker, uri, gomock.Any()).DoAndReturn(
+		func(ctx domain.AtomicContext, uri *coresecrets.URI, params domainsecret.UpsertSecretParams) error {
+			c.Check(params.RevisionID, gc.DeepEquals, ptr(s.fakeUUID.String()))
+			c.Check(params.Data, gc.HasLen, 0)
+			c.Check(params.ValueRef, jc.DeepEquals, valueRef)
+			return nil
+		})
+	s.state.EXPECT().SecretRotated(ctx, uri, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, uri *coresecrets.URI, next time.Time) error {
+			c.Assert(next, jc.Almost, nextRotateTime)
+			return nil
+		})
+
+	err := s.service.SecretRotated(ctx, uri, SecretRotatedParams{
+		Accessor: SecretAccessor{
+			Kind: UnitAccessor,
+			ID:   "mariadb/0",
+		},
+		OriginalRevision: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rollbackCalled, jc.IsFalse)
+}
+
 func (s *serviceSuite) TestSecretsRotatedForce(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1714,6 +2385,79 @@ func (s *serviceSuite) TestGetSecretConsumedRevisionPeek(c *gc.C) {
 	c.Assert(rev, gc.Equals, 668)
 }
 
+func (s *serviceSuite) TestGetSecretConsumedRevisionPeekFirstTime(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretConsumer(gomock.Any(), uri, "mariadb/0").Return(nil, 668, secreterrors.SecretConsumerNotFound)
+
+	rev, err := s.service.GetConsumedRevision(context.Background(), uri, "mariadb/0", false, true, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rev, gc.Equals, 668)
+}
+
+func (s *serviceSuite) TestGetSecretConsumedRevisionPeekFirstTimeUpdateLabel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretConsumer(gomock.Any(), uri, "mariadb/0").Return(nil, 668, secreterrors.SecretConsumerNotFound)
+
+	rev, err := s.service.GetConsumedRevision(context.Background(), uri, "mariadb/0", false, true, ptr("label"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rev, gc.Equals, 668)
+}
+
+func (s *serviceSuite) TestGetSecretConsumedRevisionPeekUpdateLabel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretConsumer(gomock.Any(), uri, "mariadb/0").Return(&coresecrets.SecretConsumerMetadata{
+		Label:           "old-label",
+		CurrentRevision: 666,
+	}, 668, nil)
+	s.state.EXPECT().UpdateSecretConsumerLabel(gomock.Any(), uri, "mariadb/0", "new-label")
+
+	rev, err := s.service.GetConsumedRevision(context.Background(), uri, "mariadb/0", false, true, ptr("new-label"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rev, gc.Equals, 668)
+}
+
+func (s *serviceSuite) TestGetSecretConsumedRevisionPeekSameLabel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretConsumer(gomock.Any(), uri, "mariadb/0").Return(&coresecrets.SecretConsumerMetadata{
+		Label:           "label",
+		CurrentRevision: 666,
+	}, 668, nil)
+
+	rev, err := s.service.GetConsumedRevision(context.Background(), uri, "mariadb/0", false, true, ptr("label"))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rev, gc.Equals, 668)
+}
+
+func (s *serviceSuite) TestGetSecretConsumedRevisionAfterPeekStartsTracking(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+
+	s.state.EXPECT().GetSecretConsumer(gomock.Any(), uri, "mariadb/0").Return(&coresecrets.SecretConsumerMetadata{
+		Label: "label",
+	}, 668, nil)
+	s.state.EXPECT().SaveSecretConsumer(gomock.Any(), uri, "mariadb/0", &coresecrets.SecretConsumerMetadata{
+		Label:           "label",
+		CurrentRevision: 668,
+	})
+
+	rev, err := s.service.GetConsumedRevision(context.Background(), uri, "mariadb/0", false, false, nil)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(rev, gc.Equals, 668)
+}
+
 func (s *serviceSuite) TestGetSecretConsumedRevisionSecretNotFound(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -1788,15 +2532,17 @@ func (s *serviceSuite) TestProcessCharmSecretConsumerLabelForUnitOwnedSecretLook
 	defer s.setupMocks(c).Finish()
 
 	uri := coresecrets.NewURI()
-	md := []*coresecrets.SecretMetadata{{
+	md := &coresecrets.SecretMetadata{
 		URI:            uri,
 		LatestRevision: 668,
 		Label:          "foo",
-	}}
-	revs := [][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}}}
+	}
 
-	s.state.EXPECT().ListCharmSecrets(gomock.Any(), domainsecret.ApplicationOwners{"mariadb"}, domainsecret.UnitOwners{"mariadb/0"}).
-		Return(md, revs, nil)
+	s.state.EXPECT().GetSecretURIByOwnerLabel(gomock.Any(), "foo", coresecrets.ApplicationOwner, "mariadb").
+		Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().GetSecretURIByOwnerLabel(gomock.Any(), "foo", coresecrets.UnitOwner, "mariadb/0").
+		Return(uri, nil)
+	s.state.EXPECT().GetSecret(gomock.Any(), uri).Return(md, nil)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
 
 	gotURI, gotLabel, err := s.service.ProcessCharmSecretConsumerLabel(context.Background(), "mariadb/0", nil, "foo")
@@ -1809,15 +2555,11 @@ func (s *serviceSuite) TestProcessCharmSecretConsumerLabelLookupURI(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	uri := coresecrets.NewURI()
-	md := []*coresecrets.SecretMetadata{{
-		URI:            uri,
-		LatestRevision: 668,
-		Label:          "foz",
-	}}
-	revs := [][]*coresecrets.SecretRevisionMetadata{{{Revision: 1}}}
 
-	s.state.EXPECT().ListCharmSecrets(gomock.Any(), domainsecret.ApplicationOwners{"mariadb"}, domainsecret.UnitOwners{"mariadb/0"}).
-		Return(md, revs, nil)
+	s.state.EXPECT().GetSecretURIByOwnerLabel(gomock.Any(), "foo", coresecrets.ApplicationOwner, "mariadb").
+		Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().GetSecretURIByOwnerLabel(gomock.Any(), "foo", coresecrets.UnitOwner, "mariadb/0").
+		Return(nil, secreterrors.SecretNotFound)
 	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
 	s.state.EXPECT().GetURIByConsumerLabel(gomock.Any(), "foo", "mariadb/0").Return(uri, nil)
 
@@ -1936,7 +2678,7 @@ func (s *serviceSuite) TestWatchObsoleteUserSecretsToPrune(c *gc.C) {
 	mockAutoPruneWatcher.EXPECT().Wait().Return(nil).AnyTimes()
 	mockAutoPruneWatcher.EXPECT().Kill().AnyTimes()
 
-	mockWatcherFactory.EXPECT().NewNamespaceNotifyMapperWatcher("secret_revision_obsolete", changestream.Create, gomock.Any()).Return(mockObsoleteWatcher, nil)
+	mockWatcherFactory.EXPECT().NewNamespaceNotifyMapperWatcher("secret_revision_obsolete", changestream.Create|changestream.Update, gomock.Any()).Return(mockObsoleteWatcher, nil)
 	mockWatcherFactory.EXPECT().NewNamespaceNotifyMapperWatcher("secret_metadata", changestream.Update, gomock.Any()).Return(mockAutoPruneWatcher, nil)
 
 	svc := NewWatchableService(
@@ -2157,6 +2899,180 @@ func (s *serviceSuite) TestWatchSecretsRotationChanges(c *gc.C) {
 	wC.AssertNoChange()
 }
 
+func (s *serviceSuite) TestGetApplicationLeaderForSecretRotation(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitNames(gomock.Any(), "mysql").Return([]string{"mysql/0", "mysql/1"}, nil)
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/0").Return(notHeldToken{})
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/1").Return(goodToken{})
+
+	leader, err := s.service.GetApplicationLeaderForSecretRotation(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(leader, gc.Equals, "mysql/1")
+}
+
+func (s *serviceSuite) TestGetApplicationLeaderForSecretRotationNoLeader(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitNames(gomock.Any(), "mysql").Return([]string{"mysql/0"}, nil)
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/0").Return(notHeldToken{})
+
+	_, err := s.service.GetApplicationLeaderForSecretRotation(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
+func (s *serviceSuite) TestGetApplicationLeaderForSecretRotationCheckError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetApplicationUnitNames(gomock.Any(), "mysql").Return([]string{"mysql/0"}, nil)
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/0").Return(badToken{})
+
+	_, err := s.service.GetApplicationLeaderForSecretRotation(context.Background(), "mysql")
+	c.Assert(err, gc.ErrorMatches, `checking leadership of "mysql/0": not leader`)
+}
+
+func (s *serviceSuite) TestWatchSecretsRotationChangesLeaderOnly(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	mockWatcherFactory := NewMockWatcherFactory(ctrl)
+	svc := NewWatchableService(
+		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c), SecretServiceParams{})
+
+	uri := coresecrets.NewURI()
+	now := s.clock.Now()
+
+	var namespaceQuery eventsource.NamespaceQuery = func(context.Context, database.TxnRunner) ([]string, error) {
+		return nil, nil
+	}
+	s.state.EXPECT().GetApplicationUnitNames(gomock.Any(), "mysql").Return([]string{"mysql/0", "mysql/1"}, nil).AnyTimes()
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/0").Return(goodToken{}).AnyTimes()
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/1").Return(notHeldToken{}).AnyTimes()
+
+	// Both units of the application watch for rotation of its secrets, but
+	// only the leader is to be sent the change.
+	unitWatcher := func(unitName string, expected domainsecret.ApplicationOwners, result []domainsecret.RotationInfo) (watcher.SecretTriggerWatcher, chan []string) {
+		ch := make(chan []string)
+		mockStringWatcher := NewMockStringsWatcher(ctrl)
+		mockStringWatcher.EXPECT().Changes().Return(ch).AnyTimes()
+		mockStringWatcher.EXPECT().Wait().Return(nil).AnyTimes()
+		mockStringWatcher.EXPECT().Kill().AnyTimes()
+
+		unitOwners := domainsecret.UnitOwners{unitName}
+		s.state.EXPECT().InitialWatchStatementForSecretsRotationChanges(
+			domainsecret.ApplicationOwners{"mysql"}, unitOwners,
+		).Return("secret_rotation", namespaceQuery)
+		mockWatcherFactory.EXPECT().NewNamespaceWatcher("secret_rotation", changestream.All, gomock.Any()).Return(mockStringWatcher, nil)
+		s.state.EXPECT().GetSecretsRotationChanges(gomock.Any(), expected, unitOwners, uri.ID).Return(result, nil)
+
+		w, err := svc.WatchSecretsRotationChanges(context.Background(),
+			CharmSecretOwner{
+				Kind: ApplicationOwner,
+				ID:   "mysql",
+			},
+			CharmSecretOwner{
+				Kind: UnitOwner,
+				ID:   unitName,
+			},
+		)
+		c.Assert(err, jc.ErrorIsNil)
+		return w, ch
+	}
+
+	w0, ch0 := unitWatcher("mysql/0", domainsecret.ApplicationOwners{"mysql"}, []domainsecret.RotationInfo{{
+		URI:             uri,
+		Revision:        1,
+		NextTriggerTime: now,
+	}})
+	defer workertest.CleanKill(c, w0)
+	w1, ch1 := unitWatcher("mysql/1", nil, nil)
+	defer workertest.CleanKill(c, w1)
+
+	for _, ch := range []chan []string{ch0, ch1} {
+		select {
+		case ch <- []string{uri.ID}:
+		case <-time.After(coretesting.ShortWait):
+			c.Fatalf("timed out waiting to send changes")
+		}
+	}
+
+	wC0 := watchertest.NewSecretsTriggerWatcherC(c, w0)
+	wC0.AssertChange(watcher.SecretTriggerChange{
+		URI:             uri,
+		Revision:        1,
+		NextTriggerTime: now,
+	})
+	wC0.AssertNoChange()
+
+	wC1 := watchertest.NewSecretsTriggerWatcherC(c, w1)
+	wC1.AssertChange()
+	wC1.AssertNoChange()
+}
+
+func (s *serviceSuite) TestWatchSecretsRotationChangesNoLeader(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	mockWatcherFactory := NewMockWatcherFactory(ctrl)
+	svc := NewWatchableService(
+		s.state, s.secretBackendState, s.ensurer, mockWatcherFactory, loggertesting.WrapCheckLog(c), SecretServiceParams{})
+
+	uri := coresecrets.NewURI()
+	now := s.clock.Now()
+
+	ch := make(chan []string)
+	mockStringWatcher := NewMockStringsWatcher(ctrl)
+	mockStringWatcher.EXPECT().Changes().Return(ch).AnyTimes()
+	mockStringWatcher.EXPECT().Wait().Return(nil).AnyTimes()
+	mockStringWatcher.EXPECT().Kill().AnyTimes()
+
+	var namespaceQuery eventsource.NamespaceQuery = func(context.Context, database.TxnRunner) ([]string, error) {
+		return nil, nil
+	}
+	appOwners := domainsecret.ApplicationOwners{"mysql"}
+	unitOwners := domainsecret.UnitOwners{"mysql/0"}
+	s.state.EXPECT().InitialWatchStatementForSecretsRotationChanges(appOwners, unitOwners).Return("secret_rotation", namespaceQuery)
+	mockWatcherFactory.EXPECT().NewNamespaceWatcher("secret_rotation", changestream.All, gomock.Any()).Return(mockStringWatcher, nil)
+
+	// While leadership is being handed over there is no leader, so the
+	// change is delivered to the watching unit rather than being dropped.
+	s.state.EXPECT().GetApplicationUnitNames(gomock.Any(), "mysql").Return([]string{"mysql/0", "mysql/1"}, nil)
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/0").Return(notHeldToken{})
+	s.ensurer.EXPECT().LeadershipCheck("mysql", "mysql/1").Return(notHeldToken{})
+	s.state.EXPECT().GetSecretsRotationChanges(gomock.Any(), appOwners, unitOwners, uri.ID).Return([]domainsecret.RotationInfo{{
+		URI:             uri,
+		Revision:        1,
+		NextTriggerTime: now,
+	}}, nil)
+
+	w, err := svc.WatchSecretsRotationChanges(context.Background(),
+		CharmSecretOwner{
+			Kind: ApplicationOwner,
+			ID:   "mysql",
+		},
+		CharmSecretOwner{
+			Kind: UnitOwner,
+			ID:   "mysql/0",
+		},
+	)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	select {
+	case ch <- []string{uri.ID}:
+	case <-time.After(coretesting.ShortWait):
+		c.Fatalf("timed out waiting to send changes")
+	}
+
+	wC := watchertest.NewSecretsTriggerWatcherC(c, w)
+	wC.AssertChange(watcher.SecretTriggerChange{
+		URI:             uri,
+		Revision:        1,
+		NextTriggerTime: now,
+	})
+	wC.AssertNoChange()
+}
+
 func (s *serviceSuite) TestWatchSecretRevisionsExpiryChanges(c *gc.C) {
 	ctrl := s.setupMocks(c)
 	defer ctrl.Finish()
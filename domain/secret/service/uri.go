@@ -0,0 +1,125 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	"github.com/juju/juju/internal/errors"
+	"github.com/juju/juju/internal/uuid"
+)
+
+// ValidateSecretURI parses the input secret URI and returns it in canonical
+// form, always qualified by the UUID of the model which hosts the secret.
+// The scheme may be omitted, and the source model UUID is case insensitive.
+//
+// A URI qualified by the UUID of a different model refers to a cross model
+// secret. Such a URI is not valid if the secret is in fact hosted by this
+// model. Otherwise it is only accepted if cross model access to secrets from
+// that model is configured, meaning units of this model consume secrets from
+// it; if not, an error satisfying [secreterrors.SecretIsNotLocal] is returned.
+//
+// If the URI is malformed, or names the wrong model, an error satisfying
+// [secreterrors.SecretURINotValid] is returned which describes the problem.
+func (s *SecretService) ValidateSecretURI(ctx context.Context, uri string) (secrets.URI, error) {
+	parsed, err := parseSecretURI(uri)
+	if err != nil {
+		return secrets.URI{}, errors.Capture(err)
+	}
+
+	modelUUID, err := s.secretState.GetModelUUID(ctx)
+	if err != nil {
+		return secrets.URI{}, errors.Errorf("getting model uuid: %w", err)
+	}
+	if parsed.IsLocal(modelUUID) {
+		return *parsed.WithSource(modelUUID), nil
+	}
+
+	// Secret IDs are unique across models, so a cross model URI must not
+	// name a secret which this model hosts.
+	_, err = s.secretState.GetSecret(ctx, &secrets.URI{ID: parsed.ID})
+	if err == nil {
+		return secrets.URI{}, errors.Errorf(
+			"secret URI %q refers to model %q, but the secret is hosted by this model: %w",
+			uri, parsed.SourceUUID, secreterrors.SecretURINotValid)
+	} else if !errors.Is(err, secreterrors.SecretNotFound) {
+		return secrets.URI{}, errors.Errorf("looking up secret %q: %w", parsed.ID, err)
+	}
+
+	consumed, err := s.secretState.IsSecretSourceModelConsumed(ctx, parsed.SourceUUID)
+	if err != nil {
+		return secrets.URI{}, errors.Errorf("checking cross model secrets from %q: %w", parsed.SourceUUID, err)
+	}
+	if !consumed {
+		return secrets.URI{}, errors.Errorf(
+			"secret URI %q refers to model %q, for which no cross model secret access is configured: %w",
+			uri, parsed.SourceUUID, secreterrors.SecretIsNotLocal)
+	}
+	return *parsed, nil
+}
+
+// parseSecretURI parses the input secret URI, reporting exactly what is wrong
+// with it if it is not valid.
+func parseSecretURI(str string) (*secrets.URI, error) {
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return nil, errors.Errorf("empty secret URI: %w", secreterrors.SecretURINotValid)
+	}
+	u, err := url.Parse(str)
+	if err != nil {
+		return nil, errors.Errorf("secret URI %q is malformed: %w", str, secreterrors.SecretURINotValid)
+	}
+	if u.Scheme != "" && u.Scheme != secrets.SecretScheme {
+		return nil, errors.Errorf("secret URI %q has scheme %q, expected %q: %w",
+			str, u.Scheme, secrets.SecretScheme, secreterrors.SecretURINotValid)
+	}
+
+	// The source model may be given as the host, or as the first path
+	// element of an opaque URI.
+	sourceUUID := strings.ToLower(u.Host)
+	id := strings.TrimLeft(u.Path, "/")
+	if id == "" {
+		id = u.Opaque
+	}
+	if source, rest, ok := strings.Cut(id, "/"); ok {
+		if sourceUUID != "" {
+			return nil, errors.Errorf("secret URI %q has more than one source model: %w",
+				str, secreterrors.SecretURINotValid)
+		}
+		sourceUUID, id = strings.ToLower(source), rest
+	}
+	if id == "" {
+		return nil, errors.Errorf("secret URI %q has no secret ID: %w", str, secreterrors.SecretURINotValid)
+	}
+
+	result := &secrets.URI{
+		SourceUUID: sourceUUID,
+		ID:         id,
+	}
+	if err := validateSecretURI(result); err != nil {
+		return nil, errors.Errorf("secret URI %q: %w", str, err)
+	}
+	return result, nil
+}
+
+// validateSecretURI checks that the input URI refers to a secret, so that
+// service methods fail early and consistently when given a bad URI.
+func validateSecretURI(uri *secrets.URI) error {
+	if uri == nil {
+		return errors.Errorf("missing secret URI: %w", secreterrors.SecretURINotValid)
+	}
+	if uri.SourceUUID != "" && !uuid.IsValidUUIDString(uri.SourceUUID) {
+		return errors.Errorf("source model UUID %q not valid: %w", uri.SourceUUID, secreterrors.SecretURINotValid)
+	}
+	if _, err := xid.FromString(uri.ID); err != nil {
+		return errors.Errorf("secret ID %q not valid: %w", uri.ID, secreterrors.SecretURINotValid)
+	}
+	return nil
+}
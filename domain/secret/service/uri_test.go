@@ -0,0 +1,113 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"strings"
+
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	secreterrors "github.com/juju/juju/domain/secret/errors"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+func (s *serviceSuite) TestValidateSecretURILocal(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := coretesting.ModelTag.Id()
+	uri := coresecrets.NewURI()
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(modelUUID, nil).Times(3)
+
+	expected := coresecrets.URI{SourceUUID: modelUUID, ID: uri.ID}
+	for _, in := range []string{
+		uri.String(),
+		uri.ID,
+		" secret://" + strings.ToUpper(modelUUID) + "/" + uri.ID + " ",
+	} {
+		got, err := s.service.ValidateSecretURI(context.Background(), in)
+		c.Assert(err, jc.ErrorIsNil, gc.Commentf("%q", in))
+		c.Check(got, jc.DeepEquals, expected, gc.Commentf("%q", in))
+	}
+}
+
+func (s *serviceSuite) TestValidateSecretURICrossModel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sourceUUID := s.modelID.String()
+	uri := coresecrets.NewURI().WithSource(sourceUUID)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.state.EXPECT().GetSecret(gomock.Any(), &coresecrets.URI{ID: uri.ID}).Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().IsSecretSourceModelConsumed(gomock.Any(), sourceUUID).Return(true, nil)
+
+	got, err := s.service.ValidateSecretURI(context.Background(), uri.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got, jc.DeepEquals, *uri)
+}
+
+func (s *serviceSuite) TestValidateSecretURICrossModelAccessNotConfigured(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sourceUUID := s.modelID.String()
+	uri := coresecrets.NewURI().WithSource(sourceUUID)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.state.EXPECT().GetSecret(gomock.Any(), &coresecrets.URI{ID: uri.ID}).Return(nil, secreterrors.SecretNotFound)
+	s.state.EXPECT().IsSecretSourceModelConsumed(gomock.Any(), sourceUUID).Return(false, nil)
+
+	_, err := s.service.ValidateSecretURI(context.Background(), uri.String())
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretIsNotLocal)
+}
+
+func (s *serviceSuite) TestValidateSecretURICrossModelHostedLocally(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	sourceUUID := s.modelID.String()
+	uri := coresecrets.NewURI().WithSource(sourceUUID)
+	s.state.EXPECT().GetModelUUID(gomock.Any()).Return(coretesting.ModelTag.Id(), nil)
+	s.state.EXPECT().GetSecret(gomock.Any(), &coresecrets.URI{ID: uri.ID}).Return(&coresecrets.SecretMetadata{URI: uri}, nil)
+
+	_, err := s.service.ValidateSecretURI(context.Background(), uri.String())
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+	c.Check(err, gc.ErrorMatches, `.* but the secret is hosted by this model: secret URI not valid`)
+}
+
+func (s *serviceSuite) TestValidateSecretURINotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	id := coresecrets.NewURI().ID
+	for in, problem := range map[string]string{
+		"":                          "empty secret URI",
+		"secret:":                   `.* has no secret ID`,
+		"foo://" + id:               `.* has scheme "foo", expected "secret"`,
+		"secret://not-a-uuid/" + id: `.* source model UUID "not-a-uuid" not valid`,
+		"secret:" + id + "x":        `.* secret ID ".*" not valid`,
+		"secret://" + s.modelID.String() + "/" + s.modelID.String() + "/" + id: `.* more than one source model`,
+	} {
+		_, err := s.service.ValidateSecretURI(context.Background(), in)
+		c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid, gc.Commentf("%q", in))
+		c.Check(err, gc.ErrorMatches, problem+": secret URI not valid", gc.Commentf("%q", in))
+	}
+}
+
+func (s *serviceSuite) TestServiceMethodsValidateSecretURI(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	_, err := s.service.GetSecret(context.Background(), nil)
+	c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+
+	err = s.service.DeleteSecret(context.Background(), &coresecrets.URI{ID: "bad"}, DeleteSecretParams{})
+	c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+
+	_, err = s.service.BulkGrantSecretAccess(context.Background(), nil, SecretBulkAccessParams{})
+	c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+
+	_, err = s.service.BulkRevokeSecretAccess(context.Background(), &coresecrets.URI{ID: "bad"}, SecretBulkAccessParams{})
+	c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+
+	_, _, err = s.service.ProcessCharmSecretConsumerLabel(context.Background(), "mariadb/0", &coresecrets.URI{ID: "bad"}, "label")
+	c.Check(err, jc.ErrorIs, secreterrors.SecretURINotValid)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/collections/set"
 	"github.com/juju/errors"
+	"github.com/juju/names/v5"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/catacomb"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
+	domainsecret "github.com/juju/juju/domain/secret"
 )
 
 // WatchableService provides the API for working with the secret service.
@@ -170,7 +172,17 @@ func (s *WatchableService) WatchSecretsRotationChanges(_ context.Context, owners
 		return nil, errors.Trace(err)
 	}
 	processChanges := func(ctx context.Context, secretIDs ...string) ([]watcher.SecretTriggerChange, error) {
-		result, err := s.secretState.GetSecretsRotationChanges(ctx, appOwners, unitOwners, secretIDs...)
+		// Leadership is checked as each change is processed, rather than
+		// when the watcher is created, so that a unit which has lost
+		// leadership stops being sent rotations for application secrets.
+		leaderAppOwners, err := s.rotationLeaderApplicationOwners(ctx, appOwners, unitOwners)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if len(leaderAppOwners) == 0 && len(unitOwners) == 0 {
+			return nil, nil
+		}
+		result, err := s.secretState.GetSecretsRotationChanges(ctx, leaderAppOwners, unitOwners, secretIDs...)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -187,6 +199,44 @@ func (s *WatchableService) WatchSecretsRotationChanges(_ context.Context, owners
 	return newSecretStringWatcher(w, s.logger, processChanges)
 }
 
+// rotationLeaderApplicationOwners returns the application owners whose
+// secret rotations are to be delivered to the unit owners being watched.
+// An application owner is dropped if the application leader is known and is
+// not one of its watched units; the leader's own watcher delivers the change.
+// An application owner watched without any of its units is kept, since there
+// is no unit to check. An application owner is also kept while the
+// application has no leader, such as during a leadership handover, since a
+// dropped change is not sent again unless the secret changes once more.
+func (s *WatchableService) rotationLeaderApplicationOwners(
+	ctx context.Context, appOwners domainsecret.ApplicationOwners, unitOwners domainsecret.UnitOwners,
+) (domainsecret.ApplicationOwners, error) {
+	var result domainsecret.ApplicationOwners
+	for _, appName := range appOwners {
+		units := set.NewStrings()
+		for _, unitName := range unitOwners {
+			if unitApp, _ := names.UnitApplication(unitName); unitApp == appName {
+				units.Add(unitName)
+			}
+		}
+		if units.IsEmpty() {
+			result = append(result, appName)
+			continue
+		}
+		leader, err := s.GetApplicationLeaderForSecretRotation(ctx, appName)
+		if errors.Is(err, errors.NotFound) {
+			s.logger.Debugf("application %q has no leader, delivering its secret rotations to units %v", appName, units.SortedValues())
+			result = append(result, appName)
+			continue
+		} else if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if units.Contains(leader) {
+			result = append(result, appName)
+		}
+	}
+	return result, nil
+}
+
 // WatchObsoleteUserSecretsToPrune returns a watcher that notifies when a user secret revision is obsolete and ready to be pruned.
 func (s *WatchableService) WatchObsoleteUserSecretsToPrune(ctx context.Context) (watcher.NotifyWatcher, error) {
 	mapper := func(ctx context.Context, db coredatabase.TxnRunner, changes []changestream.ChangeEvent) ([]changestream.ChangeEvent, error) {
@@ -205,8 +255,10 @@ func (s *WatchableService) WatchObsoleteUserSecretsToPrune(ctx context.Context)
 		return changes[:1], nil
 	}
 
+	// Updates are included as revisions with content in a secret backend are
+	// only ready to prune once that content has been marked as deleted.
 	wObsolete, err := s.watcherFactory.NewNamespaceNotifyMapperWatcher(
-		"secret_revision_obsolete", changestream.Create, mapper,
+		"secret_revision_obsolete", changestream.Create|changestream.Update, mapper,
 	)
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -221,6 +273,40 @@ func (s *WatchableService) WatchObsoleteUserSecretsToPrune(ctx context.Context)
 	return eventsource.NewMultiNotifyWatcher(ctx, wObsolete, wAutoPrune)
 }
 
+// WatchSecretsRevisionsPendingDeletion returns a watcher that notifies of
+// obsolete revisions of auto-pruned user secrets whose content is held in a
+// secret backend and has not yet been deleted from it. Charm secret revisions
+// are left to the owning charm's secret-remove hook. The initial event reports every such
+// revision, so that content is still cleaned up if it was missed while the
+// watcher was not running.
+func (s *WatchableService) WatchSecretsRevisionsPendingDeletion(ctx context.Context) (watcher.SecretRevisionWatcher, error) {
+	table, query := s.secretState.InitialWatchStatementForSecretRevisionsPendingDeletion()
+	w, err := s.watcherFactory.NewNamespaceWatcher(
+		// A revision is obsoleted by either inserting or updating its row.
+		table, changestream.All, query,
+	)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	processChanges := func(ctx context.Context, revisionUUIDs ...string) ([]watcher.SecretRevisionPendingDeletion, error) {
+		result, err := s.secretState.GetSecretRevisionsPendingDeletion(ctx, revisionUUIDs...)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		changes := make([]watcher.SecretRevisionPendingDeletion, len(result))
+		for i, r := range result {
+			changes[i] = watcher.SecretRevisionPendingDeletion{
+				RevisionUUID: r.RevisionUUID,
+				URI:          r.URI,
+				Revision:     r.Revision,
+				ValueRef:     r.ValueRef,
+			}
+		}
+		return changes, nil
+	}
+	return newSecretStringWatcher(w, s.logger, processChanges)
+}
+
 // secretWatcher is a watcher that watches for secret changes to a set of strings.
 type secretWatcher[T any] struct {
 	catacomb catacomb.Catacomb
@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strconv"
 	"strings"
@@ -137,6 +138,56 @@ WHERE name=$unit.name`, u)
 	return u.UUID, errors.Trace(err)
 }
 
+// GetApplicationUnitNames returns the names of the units of the given application, ordered by name,
+// returning an error satisfying [applicationerrors.ApplicationNotFound] if the application does not exist.
+func (st State) GetApplicationUnitNames(ctx context.Context, appName string) ([]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	app := application{Name: appName}
+	selectApplicationUUIDStmt, err := st.Prepare(`
+SELECT &application.uuid
+FROM application
+WHERE name=$application.name`, app)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	selectUnitNamesStmt, err := st.Prepare(`
+SELECT &unit.name
+FROM unit
+WHERE application_uuid=$application.uuid
+ORDER BY name`, app, unit{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var units []unit
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, selectApplicationUUIDStmt, app).Get(&app)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("application %q not found%w", appName, errors.Hide(applicationerrors.ApplicationNotFound))
+		}
+		if err != nil {
+			return errors.Annotatef(err, "looking up application UUID for %q", appName)
+		}
+		err = tx.Query(ctx, selectUnitNamesStmt, app).GetAll(&units)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying units of application %q", appName)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	result := make([]string, len(units))
+	for i, u := range units {
+		result[i] = u.Name
+	}
+	return result, nil
+}
+
 // CheckApplicationSecretLabelExists checks if a charm application secret with the given label already exists.
 func (st State) CheckApplicationSecretLabelExists(ctx domain.AtomicContext, appUUID coreapplication.ID, label string) (bool, error) {
 	if label == "" {
@@ -1110,6 +1161,64 @@ func (st State) ListSecrets(ctx context.Context, uri *coresecrets.URI,
 	return secrets, revisionResult, nil
 }
 
+// SearchSecrets returns the secrets in the given scope whose label or
+// description contains the query, ignoring case.
+func (st State) SearchSecrets(
+	ctx context.Context, query string, scope domainsecret.SecretSearchScope,
+) ([]*coresecrets.SecretMetadata, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	q := secretsAnyOwnerQuery + `
+WHERE  (sm.description LIKE $secretSearch.pattern ESCAPE '\'
+        OR so.label LIKE $secretSearch.pattern ESCAPE '\')`
+	switch scope {
+	case domainsecret.SearchOwned:
+		q += "\nAND    so.owner_kind = $ownerKind.model_owner_kind"
+	case domainsecret.SearchAccessible:
+		q += `
+AND    sm.secret_id IN (
+           SELECT secret_id
+           FROM   v_secret_permission
+           WHERE  subject_type_id = $secretSearch.subject_type_id
+       )`
+	}
+	q += "\nGROUP BY sm.secret_id"
+
+	stmt, err := st.Prepare(q, secretInfo{}, secretOwner{}, ownerKindParam, secretSearch{})
+	if err != nil {
+		st.logger.Tracef("failed to prepare err: %v, query: \n%s", err, q)
+		return nil, errors.Trace(err)
+	}
+
+	search := secretSearch{
+		Pattern:       "%" + escapeLikePattern(query) + "%",
+		SubjectTypeID: domainsecret.SubjectModel,
+	}
+	var (
+		dbSecrets      secretInfos
+		dbsecretOwners []secretOwner
+	)
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, ownerKindParam, search).GetAll(&dbSecrets, &dbsecretOwners)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	}); err != nil {
+		return nil, errors.Annotatef(err, "searching secrets for %q", query)
+	}
+	return dbSecrets.toSecretMetadata(dbsecretOwners)
+}
+
+// escapeLikePattern escapes the wildcard characters in s so that it
+// is matched literally by a LIKE expression using a backslash as the escape.
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
 // GetSecret returns the secret with the given URI, returning an error satisfying [secreterrors.SecretNotFound]
 // if the secret does not exist.
 func (st State) GetSecret(ctx context.Context, uri *coresecrets.URI) (*coresecrets.SecretMetadata, error) {
@@ -1242,11 +1351,188 @@ WHERE  sm.secret_id = $secretID.id`, secretID{}, secretInfo{})
 	return coresecrets.RotatePolicy(info.RotatePolicy), nil
 }
 
-func (st State) listSecretsAnyOwner(
-	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI,
-) ([]*coresecrets.SecretMetadata, error) {
+// GetSecretContentSchema returns the content schema of the specified secret.
+// The schema is empty if none has been set.
+func (st State) GetSecretContentSchema(ctx domain.AtomicContext, uri *coresecrets.URI) (domainsecret.ContentSchema, error) {
+	stmt, err := st.Prepare(`
+SELECT &secretContentSchemaKey.*
+FROM   secret_content_schema
+WHERE  secret_id = $secretID.id`, secretID{}, secretContentSchemaKey{})
+	if err != nil {
+		return domainsecret.ContentSchema{}, errors.Trace(err)
+	}
 
-	query := `
+	var keys []secretContentSchemaKey
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, secretID{ID: uri.ID}).GetAll(&keys)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return domainsecret.ContentSchema{}, errors.Annotatef(err, "getting content schema for secret %q", uri)
+	}
+
+	var schema domainsecret.ContentSchema
+	for _, key := range keys {
+		if key.Required {
+			schema.RequiredKeys = append(schema.RequiredKeys, key.Key)
+		}
+		if key.ValuePattern.Valid {
+			if schema.ValuePatterns == nil {
+				schema.ValuePatterns = make(map[string]string)
+			}
+			schema.ValuePatterns[key.Key] = key.ValuePattern.String
+		}
+	}
+	return schema, nil
+}
+
+// SetSecretContentSchema replaces the content schema of the specified secret.
+// An empty schema removes any existing schema.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist.
+func (st State) SetSecretContentSchema(ctx domain.AtomicContext, uri *coresecrets.URI, schema domainsecret.ContentSchema) error {
+	existsStmt, err := st.Prepare(`
+SELECT sm.secret_id AS &secretID.id
+FROM   secret_metadata sm
+WHERE  sm.secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM secret_content_schema WHERE secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_content_schema (*) VALUES ($secretContentSchemaKey.*)`, secretContentSchemaKey{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	keys := make(map[string]*secretContentSchemaKey)
+	keyFor := func(name string) *secretContentSchemaKey {
+		key, ok := keys[name]
+		if !ok {
+			key = &secretContentSchemaKey{SecretID: uri.ID, Key: name}
+			keys[name] = key
+		}
+		return key
+	}
+	for _, name := range schema.RequiredKeys {
+		keyFor(name).Required = true
+	}
+	for name, pattern := range schema.ValuePatterns {
+		keyFor(name).ValuePattern = sql.NullString{String: pattern, Valid: true}
+	}
+
+	id := secretID{ID: uri.ID}
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, id).Get(&id)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("secret %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		if err := tx.Query(ctx, deleteStmt, id).Run(); err != nil {
+			return errors.Trace(err)
+		}
+		for _, key := range keys {
+			if err := tx.Query(ctx, insertStmt, *key).Run(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	return errors.Annotatef(err, "setting content schema for secret %q", uri)
+}
+
+// GetSecretGenerationPolicy returns the policy used to generate the content
+// of the specified secret. The policy is empty if none has been set.
+func (st State) GetSecretGenerationPolicy(ctx domain.AtomicContext, uri *coresecrets.URI) (domainsecret.GenerationPolicy, error) {
+	stmt, err := st.Prepare(`
+SELECT &secretGenerationPolicy.*
+FROM   secret_generation_policy
+WHERE  secret_id = $secretID.id`, secretID{}, secretGenerationPolicy{})
+	if err != nil {
+		return domainsecret.GenerationPolicy{}, errors.Trace(err)
+	}
+
+	var policy secretGenerationPolicy
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, secretID{ID: uri.ID}).Get(&policy)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return domainsecret.GenerationPolicy{}, errors.Annotatef(err, "getting generation policy for secret %q", uri)
+	}
+	return domainsecret.GenerationPolicy{
+		Key:     policy.Key,
+		Length:  policy.Length,
+		Charset: domainsecret.GenerationCharset(policy.Charset),
+		Format:  domainsecret.GenerationFormat(policy.Format),
+	}, nil
+}
+
+// SetSecretGenerationPolicy replaces the policy used to generate the content
+// of the specified secret. An empty policy removes any existing policy.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// does not exist.
+func (st State) SetSecretGenerationPolicy(ctx domain.AtomicContext, uri *coresecrets.URI, policy domainsecret.GenerationPolicy) error {
+	existsStmt, err := st.Prepare(`
+SELECT sm.secret_id AS &secretID.id
+FROM   secret_metadata sm
+WHERE  sm.secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	deleteStmt, err := st.Prepare(`
+DELETE FROM secret_generation_policy WHERE secret_id = $secretID.id`, secretID{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	insertStmt, err := st.Prepare(`
+INSERT INTO secret_generation_policy (*) VALUES ($secretGenerationPolicy.*)`, secretGenerationPolicy{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	id := secretID{ID: uri.ID}
+	err = domain.Run(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, id).Get(&id)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("secret %q not found%w", uri, errors.Hide(secreterrors.SecretNotFound))
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		if err := tx.Query(ctx, deleteStmt, id).Run(); err != nil {
+			return errors.Trace(err)
+		}
+		if policy.IsEmpty() {
+			return nil
+		}
+		row := secretGenerationPolicy{
+			SecretID: uri.ID,
+			Key:      policy.Key,
+			Length:   policy.Length,
+			Charset:  string(policy.Charset),
+			Format:   string(policy.Format),
+		}
+		return errors.Trace(tx.Query(ctx, insertStmt, row).Run())
+	})
+	return errors.Annotatef(err, "setting generation policy for secret %q", uri)
+}
+
+// secretsAnyOwnerQuery selects the metadata of secrets along with their
+// owner, regardless of the kind of owner.
+const secretsAnyOwnerQuery = `
 SELECT sm.secret_id AS &secretInfo.secret_id,
        sm.version AS &secretInfo.version,
        sm.description AS &secretInfo.description,
@@ -1281,6 +1567,12 @@ FROM   secret_metadata sm
           WHERE  unit.uuid = so.unit_uuid
        ) so ON so.secret_id = sm.secret_id`
 
+func (st State) listSecretsAnyOwner(
+	ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI,
+) ([]*coresecrets.SecretMetadata, error) {
+
+	query := secretsAnyOwnerQuery
+
 	queryTypes := []any{
 		secretInfo{},
 		secretOwner{},
@@ -1619,6 +1911,77 @@ WHERE  mso.label = $M.label
 	return coresecrets.ParseURI(dbSecrets[0].ID)
 }
 
+// GetSecretURIByOwnerLabel returns the URI for the secret with the specified
+// owner label, owned by the named application or unit, or by the model. The
+// owner name is ignored for model owned secrets. It returns an error
+// satisfying [secreterrors.SecretNotFound] if there's no corresponding URI.
+func (st State) GetSecretURIByOwnerLabel(
+	ctx context.Context, label string, ownerKind coresecrets.OwnerKind, ownerName string,
+) (*coresecrets.URI, error) {
+	if label == "" {
+		return nil, errors.NotValidf("empty secret label")
+	}
+
+	var query string
+	switch ownerKind {
+	case coresecrets.ApplicationOwner:
+		query = `
+SELECT sao.secret_id AS &secretInfo.secret_id
+FROM   secret_application_owner sao
+JOIN   application a ON a.uuid = sao.application_uuid
+WHERE  sao.label = $M.label
+AND    a.name = $M.owner_name`
+	case coresecrets.UnitOwner:
+		query = `
+SELECT suo.secret_id AS &secretInfo.secret_id
+FROM   secret_unit_owner suo
+JOIN   unit u ON u.uuid = suo.unit_uuid
+WHERE  suo.label = $M.label
+AND    u.name = $M.owner_name`
+	case coresecrets.ModelOwner:
+		query = `
+SELECT smo.secret_id AS &secretInfo.secret_id
+FROM   secret_model_owner smo
+WHERE  smo.label = $M.label`
+	default:
+		return nil, errors.NotValidf("secret owner kind %q", ownerKind)
+	}
+
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	arg := sqlair.M{"label": label}
+	if ownerKind != coresecrets.ModelOwner {
+		arg["owner_name"] = ownerName
+	}
+	queryStmt, err := st.Prepare(query, secretInfo{}, arg)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var dbSecrets secretInfos
+	if err := db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, queryStmt, arg).GetAll(&dbSecrets)
+		if err != nil && !errors.Is(err, sqlair.ErrNoRows) {
+			return errors.Annotatef(err, "querying secret URI for label %q", label)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	if len(dbSecrets) == 0 {
+		if ownerKind == coresecrets.ModelOwner {
+			return nil, fmt.Errorf("secret with label %q not found%w", label, errors.Hide(secreterrors.SecretNotFound))
+		}
+		return nil, fmt.Errorf(
+			"secret with label %q owned by %s %q not found%w", label, ownerKind, ownerName, errors.Hide(secreterrors.SecretNotFound))
+	}
+	return coresecrets.ParseURI(dbSecrets[0].ID)
+}
+
 // GetURIByConsumerLabel looks up the secret URI using the label previously
 // registered by the specified unit,returning an error satisfying
 // [secreterrors.SecretNotFound] if there's no corresponding URI.
@@ -2020,6 +2383,56 @@ ON CONFLICT DO NOTHING`
 	return errors.Trace(err)
 }
 
+// UpdateSecretConsumerLabel updates the label the specified unit uses for the
+// secret, leaving the consumer's tracked revision unchanged.
+// If the unit does not exist, an error satisfying [applicationerrors.UnitNotFound] is returned.
+// If the unit is not a consumer of the secret, an error satisfying
+// [secreterrors.SecretConsumerNotFound] is returned.
+func (st State) UpdateSecretConsumerLabel(
+	ctx context.Context, uri *coresecrets.URI, unitName string, label string,
+) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	updateQuery := `
+UPDATE secret_unit_consumer
+SET    label = $secretUnitConsumer.label
+WHERE  secret_id = $secretUnitConsumer.secret_id
+AND    unit_uuid = $secretUnitConsumer.unit_uuid`
+
+	updateStmt, err := st.Prepare(updateQuery, secretUnitConsumer{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	consumer := secretUnitConsumer{
+		SecretID: uri.ID,
+		Label:    label,
+	}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		consumer.UnitUUID, err = st.getUnitUUID(ctx, tx, unitName)
+		if err != nil {
+			return errors.Trace(err)
+		}
+
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, updateStmt, consumer).Get(&outcome); err != nil {
+			return errors.Annotatef(err, "updating consumer label for %q", uri)
+		}
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("secret consumer for %q and unit %q%w", uri.ID, unitName, secreterrors.SecretConsumerNotFound)
+		}
+		return nil
+	})
+	return errors.Trace(err)
+}
+
 // AllSecretConsumers loads all local secret consumers keyed by secret id.
 func (st State) AllSecretConsumers(ctx context.Context) (map[string][]domainsecret.ConsumerInfo, error) {
 	db, err := st.DB()
@@ -2306,6 +2719,39 @@ FROM   secret_unit_consumer suc
 	return secrets, nil
 }
 
+// IsSecretSourceModelConsumed returns true if any unit in this model consumes
+// a secret hosted by the specified model.
+func (st State) IsSecretSourceModelConsumed(ctx context.Context, sourceModelUUID string) (bool, error) {
+	db, err := st.DB()
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	consumer := secretUnitConsumer{SourceModelUUID: sourceModelUUID}
+	stmt, err := st.Prepare(`
+SELECT &secretUnitConsumer.source_model_uuid
+FROM   secret_unit_consumer
+WHERE  source_model_uuid = $secretUnitConsumer.source_model_uuid
+LIMIT  1
+`, consumer)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+
+	var consumed bool
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, consumer).Get(&consumer)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+		consumed = true
+		return nil
+	})
+	return consumed, errors.Trace(err)
+}
+
 // GrantAccess grants access to the secret for the specified subject with the specified scope.
 // It returns an error satisfying [secreterrors.SecretNotFound] if the secret is not found.
 // If an attempt is made to change an existing permission's scope or subject type, an error
@@ -2316,59 +2762,131 @@ func (st State) GrantAccess(ctx context.Context, uri *coresecrets.URI, params do
 		return errors.Trace(err)
 	}
 
-	checkInvariantQuery := `
-SELECT sp.secret_id AS &secretID.id
-FROM   secret_permission sp
-WHERE  sp.secret_id = $secretPermission.secret_id
-AND    sp.subject_uuid = $secretPermission.subject_uuid
-AND    (sp.subject_type_id <> $secretPermission.subject_type_id
-        OR sp.scope_uuid <> $secretPermission.scope_uuid
-        OR sp.scope_type_id <> $secretPermission.scope_type_id)`
-
-	checkInvariantStmt, err := st.Prepare(checkInvariantQuery, secretPermission{}, secretID{})
+	checkInvariantStmt, err := st.Prepare(checkGrantInvariantQuery, secretPermission{}, secretID{})
 	if err != nil {
 		return errors.Trace(err)
 	}
 
 	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		perm := secretPermission{
-			SecretID: uri.ID,
-			RoleID:   params.RoleID,
-		}
 		if isLocal, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
 			return errors.Trace(err)
 		} else if !isLocal {
 			// Should never happen.
 			return secreterrors.SecretIsNotLocal
 		}
+		return st.grantSubjectAccess(ctx, tx, checkInvariantStmt, uri, params)
+	})
+	return errors.Trace(err)
+}
 
-		// Look up the UUID of the subject.
-		perm.SubjectTypeID = params.SubjectTypeID
-		perm.SubjectUUID, err = st.lookupSubjectUUID(ctx, tx, params.SubjectID, params.SubjectTypeID)
-		if err != nil {
-			return errors.Trace(err)
-		}
+// GrantAccessBulk grants access to the secret for each of the specified
+// subjects in a single transaction. Each grant behaves as per [GrantAccess];
+// granting the same access twice is a no-op and granting a different role to
+// an existing subject updates the role.
+// The result contains an error for each grant, in the order of the params,
+// which is nil if the grant was applied. A grant for a subject or scope which
+// doesn't exist, or which attempts to change an existing permission's scope or
+// subject type, is reported in its result and doesn't prevent the other grants
+// from being applied.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// is not found.
+func (st State) GrantAccessBulk(
+	ctx context.Context, uri *coresecrets.URI, params []domainsecret.GrantParams,
+) ([]error, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
-		// Look up the UUID of the access scope entity.
-		perm.ScopeTypeID = params.ScopeTypeID
-		perm.ScopeUUID, err = st.lookupScopeUUID(ctx, tx, params.ScopeID, params.ScopeTypeID)
-		if err != nil {
-			return errors.Trace(err)
-		}
+	checkInvariantStmt, err := st.Prepare(checkGrantInvariantQuery, secretPermission{}, secretID{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
-		// Check that the access scope or subject type is not changing.
-		id := secretID{}
-		err = tx.Query(ctx, checkInvariantStmt, perm).Get(&id)
-		if err == nil {
+	var results []error
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		results = make([]error, len(params))
+		if isLocal, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
+			return errors.Trace(err)
+		} else if !isLocal {
 			// Should never happen.
-			return secreterrors.InvalidSecretPermissionChange
-		} else if !errors.Is(err, sqlair.ErrNoRows) {
-			return errors.Annotatef(err, "checking duplicate permission record for secret %q", uri)
+			return secreterrors.SecretIsNotLocal
 		}
-
-		return st.grantAccess(ctx, tx, perm)
+		for i, p := range params {
+			err := st.grantSubjectAccess(ctx, tx, checkInvariantStmt, uri, p)
+			if isGrantSubjectError(err) {
+				results[i] = err
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
 	})
-	return errors.Trace(err)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results, nil
+}
+
+const checkGrantInvariantQuery = `
+SELECT sp.secret_id AS &secretID.id
+FROM   secret_permission sp
+WHERE  sp.secret_id = $secretPermission.secret_id
+AND    sp.subject_uuid = $secretPermission.subject_uuid
+AND    (sp.subject_type_id <> $secretPermission.subject_type_id
+        OR sp.scope_uuid <> $secretPermission.scope_uuid
+        OR sp.scope_type_id <> $secretPermission.scope_type_id)`
+
+// grantSubjectAccess grants access to the secret for a single subject within
+// the given transaction. The secret is expected to exist.
+func (st State) grantSubjectAccess(
+	ctx context.Context, tx *sqlair.TX, checkInvariantStmt *sqlair.Statement,
+	uri *coresecrets.URI, params domainsecret.GrantParams,
+) error {
+	perm := secretPermission{
+		SecretID: uri.ID,
+		RoleID:   params.RoleID,
+	}
+	if params.ExpireTime != nil {
+		expireTime := params.ExpireTime.UTC()
+		perm.ExpireTime = &expireTime
+	}
+
+	// Look up the UUID of the subject.
+	var err error
+	perm.SubjectTypeID = params.SubjectTypeID
+	perm.SubjectUUID, err = st.lookupSubjectUUID(ctx, tx, params.SubjectID, params.SubjectTypeID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Look up the UUID of the access scope entity.
+	perm.ScopeTypeID = params.ScopeTypeID
+	perm.ScopeUUID, err = st.lookupScopeUUID(ctx, tx, params.ScopeID, params.ScopeTypeID)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	// Check that the access scope or subject type is not changing.
+	id := secretID{}
+	err = tx.Query(ctx, checkInvariantStmt, perm).Get(&id)
+	if err == nil {
+		// Should never happen.
+		return secreterrors.InvalidSecretPermissionChange
+	} else if !errors.Is(err, sqlair.ErrNoRows) {
+		return errors.Annotatef(err, "checking duplicate permission record for secret %q", uri)
+	}
+
+	return st.grantAccess(ctx, tx, perm)
+}
+
+// isGrantSubjectError returns true if the error is caused by the subject or
+// scope of a single grant or revoke, rather than by the transaction as a whole.
+func isGrantSubjectError(err error) bool {
+	return errors.Is(err, applicationerrors.UnitNotFound) ||
+		errors.Is(err, applicationerrors.ApplicationNotFound) ||
+		errors.Is(err, modelerrors.NotFound) ||
+		errors.Is(err, secreterrors.InvalidSecretPermissionChange)
 }
 
 const (
@@ -2473,6 +2991,7 @@ INSERT INTO secret_permission (*)
 VALUES ($secretPermission.*)
 ON CONFLICT(secret_id, subject_uuid) DO UPDATE SET
     role_id=excluded.role_id,
+    expire_time=excluded.expire_time,
     -- These are needed to fire the immutable trigger.
     subject_type_id=excluded.subject_type_id,
     scope_type_id=excluded.scope_type_id,
@@ -2499,38 +3018,123 @@ func (st State) RevokeAccess(ctx context.Context, uri *coresecrets.URI, params d
 		return errors.Trace(err)
 	}
 
-	deleteQuery := `
+	deleteStmt, err := st.Prepare(revokeAccessQuery, secretPermission{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		if isLocal, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
+			return errors.Trace(err)
+		} else if !isLocal {
+			// Should never happen.
+			return secreterrors.SecretIsNotLocal
+		}
+		return st.revokeSubjectAccess(ctx, tx, deleteStmt, uri, params)
+	})
+	return errors.Trace(err)
+}
+
+// RevokeAccessBulk revokes access to the secret for each of the specified
+// subjects in a single transaction. Revoking access for a subject which has
+// no access is a no-op.
+// The result contains an error for each revoke, in the order of the params,
+// which is nil if the revoke was applied. A revoke for a subject which doesn't
+// exist is reported in its result and doesn't prevent the other revokes from
+// being applied.
+// It returns an error satisfying [secreterrors.SecretNotFound] if the secret
+// is not found.
+func (st State) RevokeAccessBulk(
+	ctx context.Context, uri *coresecrets.URI, params []domainsecret.AccessParams,
+) ([]error, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	deleteStmt, err := st.Prepare(revokeAccessQuery, secretPermission{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var results []error
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		results = make([]error, len(params))
+		if isLocal, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
+			return errors.Trace(err)
+		} else if !isLocal {
+			// Should never happen.
+			return secreterrors.SecretIsNotLocal
+		}
+		for i, p := range params {
+			err := st.revokeSubjectAccess(ctx, tx, deleteStmt, uri, p)
+			if isGrantSubjectError(err) {
+				results[i] = err
+			} else if err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return results, nil
+}
+
+const revokeAccessQuery = `
 DELETE FROM secret_permission
 WHERE  secret_id = $secretPermission.secret_id
 AND    subject_type_id = $secretPermission.subject_type_id
 AND    subject_uuid = $secretPermission.subject_uuid`
 
+// revokeSubjectAccess revokes access to the secret for a single subject
+// within the given transaction. The secret is expected to exist.
+func (st State) revokeSubjectAccess(
+	ctx context.Context, tx *sqlair.TX, deleteStmt *sqlair.Statement,
+	uri *coresecrets.URI, params domainsecret.AccessParams,
+) error {
 	perm := secretPermission{
 		SecretID:      uri.ID,
 		SubjectTypeID: params.SubjectTypeID,
 	}
-	deleteStmt, err := st.Prepare(deleteQuery, perm)
+
+	// Look up the UUID of the subject.
+	var err error
+	perm.SubjectUUID, err = st.lookupSubjectUUID(ctx, tx, params.SubjectID, params.SubjectTypeID)
 	if err != nil {
 		return errors.Trace(err)
 	}
+	err = tx.Query(ctx, deleteStmt, perm).Run()
+	return errors.Annotatef(err, "deleting secret grant for %q on %q", params.SubjectID, uri)
+}
 
-	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		if isLocal, err := st.checkExistsIfLocal(ctx, tx, uri); err != nil {
-			return errors.Trace(err)
-		} else if !isLocal {
-			// Should never happen.
-			return secreterrors.SecretIsNotLocal
-		}
+// DeleteExpiredSecretGrants removes all secret grants which have expired,
+// returning the number removed.
+func (st State) DeleteExpiredSecretGrants(ctx context.Context) (int64, error) {
+	db, err := st.DB()
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
 
-		// Look up the UUID of the subject.
-		perm.SubjectUUID, err = st.lookupSubjectUUID(ctx, tx, params.SubjectID, params.SubjectTypeID)
-		if err != nil {
-			return errors.Trace(err)
+	deleteStmt, err := st.Prepare(`
+DELETE FROM secret_permission
+WHERE  expire_time IS NOT NULL
+AND    JULIANDAY(expire_time) <= JULIANDAY('now')`)
+	if err != nil {
+		return 0, errors.Trace(err)
+	}
+
+	var deleted int64
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, deleteStmt).Get(&outcome); err != nil {
+			return errors.Annotate(err, "deleting expired secret grants")
 		}
-		err = tx.Query(ctx, deleteStmt, perm).Run()
-		return errors.Annotatef(err, "deleting secret grant for %q on %q", params.SubjectID, uri)
+		deleted, err = outcome.Result().RowsAffected()
+		return errors.Trace(err)
 	})
-	return errors.Trace(err)
+	return deleted, errors.Trace(err)
 }
 
 // GetSecretAccess returns the access to the secret for the specified accessor.
@@ -3344,7 +3948,10 @@ FROM   secret_model_owner smo
        JOIN secret_metadata sm ON sm.secret_id = smo.secret_id
        JOIN secret_revision sr ON sr.secret_id = smo.secret_id
        LEFT JOIN secret_revision_obsolete sro ON sro.revision_uuid = sr.uuid
-WHERE  sm.auto_prune = true AND sro.obsolete = true`
+       LEFT JOIN secret_value_ref svr ON svr.revision_uuid = sr.uuid
+WHERE  sm.auto_prune = true AND sro.obsolete = true
+-- Revisions with content in a backend are only pruned once it is deleted.
+AND    (svr.revision_uuid IS NULL OR sro.deleted_at IS NOT NULL)`
 
 	stmt, err := st.Prepare(q, secretID{}, secretExternalRevision{}, revisions{})
 	if err != nil {
@@ -3494,6 +4101,10 @@ DELETE FROM secret_revision WHERE uuid IN ($revisionUUIDs[:])`
 func (st State) deleteSecret(ctx context.Context, tx *sqlair.TX, uri *coresecrets.URI) error {
 	deleteSecretRotation := `
 DELETE FROM secret_rotation WHERE secret_id = $secretID.id`
+	deleteSecretContentSchema := `
+DELETE FROM secret_content_schema WHERE secret_id = $secretID.id`
+	deleteSecretGenerationPolicy := `
+DELETE FROM secret_generation_policy WHERE secret_id = $secretID.id`
 	deleteSecretUnitOwner := `
 DELETE FROM secret_unit_owner WHERE secret_id = $secretID.id`
 	deleteSecretApplicationOwner := `
@@ -3515,6 +4126,8 @@ DELETE FROM secret WHERE id = $secretID.id`
 
 	deleteSecretQueries := []string{
 		deleteSecretRotation,
+		deleteSecretContentSchema,
+		deleteSecretGenerationPolicy,
 		deleteSecretUnitOwner,
 		deleteSecretApplicationOwner,
 		deleteSecretModelOwner,
@@ -3875,6 +4488,147 @@ func (st State) GetSecretsRevisionExpiryChanges(
 	return st.getSecretsRevisionExpiryChanges(ctx, db, appOwners, unitOwners, revisionUUIDs...)
 }
 
+// revisionsPendingDeletionQuery selects the obsolete revisions of auto-pruned
+// user secrets whose content is held in a secret backend and has not been
+// deleted from it yet. The obsolete revisions of charm secrets are removed by
+// the owning charm through the secret-remove hook, so they are not included.
+const revisionsPendingDeletionQuery = `
+SELECT %s
+FROM   secret_revision_obsolete sro
+       JOIN secret_revision sr ON sr.uuid = sro.revision_uuid
+       JOIN secret_metadata sm ON sm.secret_id = sr.secret_id
+       JOIN secret_value_ref svr ON svr.revision_uuid = sr.uuid
+       JOIN secret_model_owner smo ON smo.secret_id = sr.secret_id
+WHERE  sro.obsolete = true
+AND    sro.pending_delete = true
+AND    sro.deleted_at IS NULL
+AND    sm.auto_prune = true`
+
+// InitialWatchStatementForSecretRevisionsPendingDeletion returns the initial
+// watch statement and the table name for watching obsolete revisions whose
+// backend content is pending deletion.
+func (st State) InitialWatchStatementForSecretRevisionsPendingDeletion() (string, eventsource.NamespaceQuery) {
+	queryFunc := func(ctx context.Context, runner coredatabase.TxnRunner) ([]string, error) {
+		stmt, err := st.Prepare(fmt.Sprintf(revisionsPendingDeletionQuery, "sro.revision_uuid AS &revisionUUID.uuid"), revisionUUID{})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		var revisions []revisionUUID
+		err = runner.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+			err := tx.Query(ctx, stmt).GetAll(&revisions)
+			if errors.Is(err, sqlair.ErrNoRows) {
+				return nil
+			}
+			return errors.Trace(err)
+		})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		revUUIDs := make([]string, len(revisions))
+		for i, rev := range revisions {
+			revUUIDs[i] = rev.UUID
+		}
+		return revUUIDs, nil
+	}
+	return "secret_revision_obsolete", queryFunc
+}
+
+// GetSecretRevisionsPendingDeletion returns those of the specified revisions
+// which are obsolete and whose backend content is pending deletion.
+func (st State) GetSecretRevisionsPendingDeletion(
+	ctx context.Context, revUUIDs ...string,
+) ([]domainsecret.RevisionPendingDeletion, error) {
+	if len(revUUIDs) == 0 {
+		return nil, nil
+	}
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	q := fmt.Sprintf(revisionsPendingDeletionQuery, `
+       sr.secret_id AS &revisionPendingDeletion.secret_id,
+       sr.revision AS &revisionPendingDeletion.revision,
+       sr.uuid AS &revisionPendingDeletion.revision_uuid,
+       svr.backend_uuid AS &revisionPendingDeletion.backend_uuid,
+       svr.revision_id AS &revisionPendingDeletion.revision_id`[1:]) + `
+AND    sr.uuid IN ($revisionUUIDs[:])`
+	stmt, err := st.Prepare(q, revisionUUIDs{}, revisionPendingDeletion{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows []revisionPendingDeletion
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, revisionUUIDs(revUUIDs)).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			// The revisions have already been dealt with.
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]domainsecret.RevisionPendingDeletion, len(rows))
+	for i, row := range rows {
+		uri, err := coresecrets.ParseURI(row.SecretID)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		result[i] = domainsecret.RevisionPendingDeletion{
+			URI:          uri,
+			Revision:     row.Revision,
+			RevisionUUID: row.RevisionUUID,
+			ValueRef: coresecrets.ValueRef{
+				BackendID:  row.BackendUUID,
+				RevisionID: row.RevisionID,
+			},
+		}
+	}
+	return result, nil
+}
+
+// MarkSecretRevisionDeleted records that the content of the specified
+// obsolete revision has been deleted from its secret backend.
+// It returns [secreterrors.SecretRevisionNotFound] if the revision is not
+// obsolete or does not exist.
+func (st State) MarkSecretRevisionDeleted(ctx context.Context, revisionUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleted := secretRevisionDeleted{
+		ID:        revisionUUID,
+		DeletedAt: time.Now().UTC(),
+	}
+	stmt, err := st.Prepare(`
+UPDATE secret_revision_obsolete
+SET    deleted_at = $secretRevisionDeleted.deleted_at
+WHERE  revision_uuid = $secretRevisionDeleted.revision_uuid
+AND    obsolete = true`, deleted)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var outcome sqlair.Outcome
+		if err := tx.Query(ctx, stmt, deleted).Get(&outcome); err != nil {
+			return errors.Annotatef(err, "marking secret revision %q deleted", revisionUUID)
+		}
+		affected, err := outcome.Result().RowsAffected()
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if affected == 0 {
+			return fmt.Errorf("obsolete secret revision %q not found%w", revisionUUID, errors.Hide(secreterrors.SecretRevisionNotFound))
+		}
+		return nil
+	})
+}
+
 // GetObsoleteUserSecretRevisionReadyToPrune returns the specified user secret revision with secret ID if it is ready to prune.
 func (st State) GetObsoleteUserSecretRevisionsReadyToPrune(ctx context.Context) ([]string, error) {
 	db, err := st.DB()
@@ -3887,7 +4641,10 @@ FROM   secret_model_owner smo
        JOIN secret_metadata sm ON sm.secret_id = smo.secret_id
        JOIN secret_revision sr ON sr.secret_id = smo.secret_id
        LEFT JOIN secret_revision_obsolete sro ON sro.revision_uuid = sr.uuid
-WHERE  sm.auto_prune = true AND sro.obsolete = true`
+       LEFT JOIN secret_value_ref svr ON svr.revision_uuid = sr.uuid
+WHERE  sm.auto_prune = true AND sro.obsolete = true
+-- Revisions with content in a backend are only pruned once it is deleted.
+AND    (svr.revision_uuid IS NULL OR sro.deleted_at IS NOT NULL)`
 	stmt, err := st.Prepare(q, obsoleteRevisionRow{})
 	if err != nil {
 		return nil, errors.Trace(err)
@@ -265,6 +265,135 @@ func (s *stateSuite) TestGetRotatePolicyNotFound(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
 }
 
+func (s *stateSuite) TestSetSecretContentSchema(c *gc.C) {
+	s.setupUnits(c, "mysql")
+
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createCharmApplicationSecret(ctx, st, 1, uri, "mysql", sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	schema := domainsecret.ContentSchema{
+		RequiredKeys: []string{"username", "port"},
+		ValuePatterns: map[string]string{
+			"port":  "[0-9]+",
+			"token": "[a-f0-9]{32}",
+		},
+	}
+	var result domainsecret.ContentSchema
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.SetSecretContentSchema(ctx, uri, schema); err != nil {
+			return err
+		}
+		result, err = st.GetSecretContentSchema(ctx, uri)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.RequiredKeys, jc.SameContents, schema.RequiredKeys)
+	c.Check(result.ValuePatterns, jc.DeepEquals, schema.ValuePatterns)
+
+	// Setting an empty schema removes the existing one.
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.SetSecretContentSchema(ctx, uri, domainsecret.ContentSchema{}); err != nil {
+			return err
+		}
+		result, err = st.GetSecretContentSchema(ctx, uri)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.IsEmpty(), jc.IsTrue)
+}
+
+func (s *stateSuite) TestSetSecretContentSchemaNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	err := st.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return st.SetSecretContentSchema(ctx, coresecrets.NewURI(), domainsecret.ContentSchema{
+			RequiredKeys: []string{"username"},
+		})
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestGetSecretContentSchemaNone(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	var result domainsecret.ContentSchema
+	err := st.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		var err error
+		result, err = st.GetSecretContentSchema(ctx, coresecrets.NewURI())
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.IsEmpty(), jc.IsTrue)
+}
+
+func (s *stateSuite) TestSetSecretGenerationPolicy(c *gc.C) {
+	s.setupUnits(c, "mysql")
+
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createCharmApplicationSecret(ctx, st, 1, uri, "mysql", sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	policy := domainsecret.GenerationPolicy{
+		Key:     "password",
+		Length:  24,
+		Charset: domainsecret.CharsetPrintable,
+		Format:  domainsecret.FormatText,
+	}
+	var result domainsecret.GenerationPolicy
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.SetSecretGenerationPolicy(ctx, uri, policy); err != nil {
+			return err
+		}
+		result, err = st.GetSecretGenerationPolicy(ctx, uri)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, policy)
+
+	// The policy is not part of the secret's content.
+	data, _, err := st.GetSecretValue(ctx, uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(data, jc.DeepEquals, coresecrets.SecretData{"foo": "bar"})
+
+	// Setting an empty policy removes the existing one.
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		if err := st.SetSecretGenerationPolicy(ctx, uri, domainsecret.GenerationPolicy{}); err != nil {
+			return err
+		}
+		result, err = st.GetSecretGenerationPolicy(ctx, uri)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result.IsEmpty(), jc.IsTrue)
+}
+
+func (s *stateSuite) TestSetSecretGenerationPolicyNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	err := st.RunAtomic(context.Background(), func(ctx domain.AtomicContext) error {
+		return st.SetSecretGenerationPolicy(ctx, coresecrets.NewURI(), domainsecret.GenerationPolicy{
+			Key:    "password",
+			Length: 16,
+		})
+	})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
 func (s *stateSuite) TestGetRotationExpiryInfo(c *gc.C) {
 	s.setupUnits(c, "mysql")
 
@@ -674,6 +803,106 @@ func (s *stateSuite) TestListSecretsByURI(c *gc.C) {
 	c.Assert(revs[0].CreateTime, jc.Almost, now)
 }
 
+func (s *stateSuite) TestSearchSecrets(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := []domainsecret.UpsertSecretParams{{
+		Description: ptr("user secret"),
+		Label:       ptr("DB password"),
+		Data:        coresecrets.SecretData{"foo": "bar"},
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}, {
+		Description: ptr("credentials for the db"),
+		Label:       ptr("creds"),
+		Data:        coresecrets.SecretData{"foo": "bar2"},
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}, {
+		Description: ptr("nightly"),
+		Label:       ptr("db-backup"),
+		Data:        coresecrets.SecretData{"foo": "bar3"},
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}, {
+		Description: ptr("unrelated"),
+		Label:       ptr("other"),
+		Data:        coresecrets.SecretData{"foo": "bar4"},
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}}
+	uri := []*coresecrets.URI{
+		coresecrets.NewURI(),
+		coresecrets.NewURI(),
+		coresecrets.NewURI(),
+		coresecrets.NewURI(),
+	}
+
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri[0], sp[0])
+	c.Assert(err, jc.ErrorIsNil)
+	err = createCharmApplicationSecret(ctx, st, 1, uri[1], "mysql", sp[1])
+	c.Assert(err, jc.ErrorIsNil)
+	err = createCharmApplicationSecret(ctx, st, 1, uri[2], "mysql", sp[2])
+	c.Assert(err, jc.ErrorIsNil)
+	err = createCharmUnitSecret(ctx, st, 1, uri[3], "mysql/0", sp[3])
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.GrantAccess(ctx, uri[1], domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeModel,
+		ScopeID:       s.modelUUID,
+		SubjectTypeID: domainsecret.SubjectModel,
+		SubjectID:     s.modelUUID,
+		RoleID:        domainsecret.RoleView,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	search := func(query string, scope domainsecret.SecretSearchScope) []string {
+		secrets, err := st.SearchSecrets(ctx, query, scope)
+		c.Assert(err, jc.ErrorIsNil)
+		ids := make([]string, len(secrets))
+		for i, md := range secrets {
+			ids[i] = md.URI.ID
+		}
+		return ids
+	}
+
+	c.Check(search("db", domainsecret.SearchAll), jc.SameContents, []string{uri[0].ID, uri[1].ID, uri[2].ID})
+	c.Check(search("DB", domainsecret.SearchOwned), jc.SameContents, []string{uri[0].ID})
+	c.Check(search("Db", domainsecret.SearchAccessible), jc.SameContents, []string{uri[0].ID, uri[1].ID})
+	c.Check(search("unrel", domainsecret.SearchAll), jc.SameContents, []string{uri[3].ID})
+	c.Check(search("unrel", domainsecret.SearchOwned), gc.HasLen, 0)
+
+	// Wildcards in the query are matched literally.
+	c.Check(search("%", domainsecret.SearchAll), gc.HasLen, 0)
+	c.Check(search("db_backup", domainsecret.SearchAll), gc.HasLen, 0)
+
+	secrets, err := st.SearchSecrets(ctx, "password", domainsecret.SearchAll)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(secrets, gc.HasLen, 1)
+	md := secrets[0]
+	c.Check(md.Label, gc.Equals, "DB password")
+	c.Check(md.Description, gc.Equals, "user secret")
+	c.Check(md.LatestRevision, gc.Equals, 1)
+	c.Check(md.Owner, jc.DeepEquals, coresecrets.Owner{Kind: coresecrets.ModelOwner, ID: s.modelUUID})
+}
+
+func (s *stateSuite) TestGetApplicationUnitNames(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+	s.setupUnits(c, "mediawiki")
+
+	unitNames, err := st.GetApplicationUnitNames(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(unitNames, jc.DeepEquals, []string{"mysql/0", "mysql/1"})
+}
+
+func (s *stateSuite) TestGetApplicationUnitNamesApplicationNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	_, err := st.GetApplicationUnitNames(context.Background(), "mysql")
+	c.Assert(err, jc.ErrorIs, applicationerrors.ApplicationNotFound)
+}
+
 func (s *stateSuite) setupUnits(c *gc.C, appName string) {
 	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
 		charmUUID := uuid.MustNewUUID().String()
@@ -1523,6 +1752,61 @@ func (s *stateSuite) TestSaveSecretConsumerUnitNotExists(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, applicationerrors.UnitNotFound)
 }
 
+func (s *stateSuite) TestUpdateSecretConsumerLabel(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		ValueRef:    &coresecrets.ValueRef{BackendID: "some-backend", RevisionID: "some-revision"},
+		AutoPrune:   ptr(true),
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI().WithSource(s.modelUUID)
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{
+		Label:           "my label",
+		CurrentRevision: 666,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.UpdateSecretConsumerLabel(ctx, uri, "mysql/0", "new label")
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, _, err := st.GetSecretConsumer(ctx, uri, "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(got, jc.DeepEquals, &coresecrets.SecretConsumerMetadata{
+		Label:           "new label",
+		CurrentRevision: 666,
+	})
+}
+
+func (s *stateSuite) TestUpdateSecretConsumerLabelConsumerNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		ValueRef:    &coresecrets.ValueRef{BackendID: "some-backend", RevisionID: "some-revision"},
+		AutoPrune:   ptr(true),
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+	}
+	uri := coresecrets.NewURI().WithSource(s.modelUUID)
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.UpdateSecretConsumerLabel(ctx, uri, "mysql/0", "new label")
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretConsumerNotFound)
+}
+
 func (s *stateSuite) TestSaveSecretConsumerDifferentModel(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
@@ -1585,6 +1869,33 @@ SELECT latest_revision FROM secret_reference WHERE secret_id = ?
 	c.Assert(latest, gc.Equals, 666)
 }
 
+func (s *stateSuite) TestIsSecretSourceModelConsumed(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	ctx := context.Background()
+	consumed, err := st.IsSecretSourceModelConsumed(ctx, "some-other-model")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumed, jc.IsFalse)
+
+	uri := coresecrets.NewURI().WithSource("some-other-model")
+	err = st.UpdateRemoteSecretRevision(ctx, uri, 1)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.SaveSecretConsumer(ctx, uri, "mysql/0", &coresecrets.SecretConsumerMetadata{
+		CurrentRevision: 1,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	consumed, err = st.IsSecretSourceModelConsumed(ctx, "some-other-model")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumed, jc.IsTrue)
+
+	consumed, err = st.IsSecretSourceModelConsumed(ctx, "another-model")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(consumed, jc.IsFalse)
+}
+
 func (s *stateSuite) TestAllRemoteSecrets(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
@@ -1725,6 +2036,60 @@ func (s *stateSuite) TestGetUserSecretURIByLabelSecretNotExists(c *gc.C) {
 	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
 }
 
+func (s *stateSuite) TestGetSecretURIByOwnerLabel(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	ctx := context.Background()
+	appURI := coresecrets.NewURI()
+	err := createCharmApplicationSecret(ctx, st, 1, appURI, "mysql", domainsecret.UpsertSecretParams{
+		Label:      ptr("app label"),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	unitURI := coresecrets.NewURI()
+	err = createCharmUnitSecret(ctx, st, 1, unitURI, "mysql/0", domainsecret.UpsertSecretParams{
+		Label:      ptr("unit label"),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	userURI := coresecrets.NewURI()
+	err = createUserSecret(ctx, st, 1, userURI, domainsecret.UpsertSecretParams{
+		Label:      ptr("user label"),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	got, err := st.GetSecretURIByOwnerLabel(ctx, "app label", coresecrets.ApplicationOwner, "mysql")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.ID, gc.Equals, appURI.ID)
+
+	got, err = st.GetSecretURIByOwnerLabel(ctx, "unit label", coresecrets.UnitOwner, "mysql/0")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.ID, gc.Equals, unitURI.ID)
+
+	got, err = st.GetSecretURIByOwnerLabel(ctx, "user label", coresecrets.ModelOwner, "")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(got.ID, gc.Equals, userURI.ID)
+
+	// Labels are scoped to their owner.
+	_, err = st.GetSecretURIByOwnerLabel(ctx, "unit label", coresecrets.ApplicationOwner, "mysql")
+	c.Check(err, jc.ErrorIs, secreterrors.SecretNotFound)
+	_, err = st.GetSecretURIByOwnerLabel(ctx, "app label", coresecrets.UnitOwner, "mysql/1")
+	c.Check(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestGetSecretURIByOwnerLabelInvalidOwnerKind(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	_, err := st.GetSecretURIByOwnerLabel(context.Background(), "my label", "cheese", "mysql")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
+
 func (s *stateSuite) TestGetURIByConsumerLabel(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
@@ -2893,24 +3258,179 @@ func (s *stateSuite) TestGetAccessNoGrant(c *gc.C) {
 	c.Assert(role, gc.Equals, "")
 }
 
-func (s *stateSuite) TestGetSecretGrantsNone(c *gc.C) {
+func (s *stateSuite) TestGrantAccessWithExpiry(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
+	s.setupUnits(c, "mysql")
+
 	sp := domainsecret.UpsertSecretParams{
-		RevisionID:  ptr(uuid.MustNewUUID().String()),
-		Description: ptr("my secretMetadata"),
-		Label:       ptr("my label"),
-		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
 	}
 	uri := coresecrets.NewURI()
 	ctx := context.Background()
 	err := createUserSecret(ctx, st, 1, uri, sp)
 	c.Assert(err, jc.ErrorIsNil)
 
-	g, err := st.GetSecretGrants(ctx, uri, coresecrets.RoleView)
-	c.Assert(err, jc.ErrorIsNil)
-	c.Assert(g, gc.HasLen, 0)
-}
+	expire := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	p := domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+		RoleID:        domainsecret.RoleView,
+		ExpireTime:    &expire,
+	}
+	err = st.GrantAccess(ctx, uri, p)
+	c.Assert(err, jc.ErrorIsNil)
+
+	role, err := st.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: p.SubjectTypeID,
+		SubjectID:     p.SubjectID,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(role, gc.Equals, "view")
+
+	g, err := st.GetSecretGrants(ctx, uri, coresecrets.RoleView)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(g, gc.HasLen, 1)
+	c.Assert(g[0].ExpireTime, gc.NotNil)
+	c.Assert(g[0].ExpireTime.Equal(expire), jc.IsTrue)
+}
+
+func (s *stateSuite) expireSecretGrants(c *gc.C, uri *coresecrets.URI) {
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+UPDATE secret_permission SET expire_time = ? WHERE secret_id = ?
+`, time.Now().Add(-time.Minute).UTC(), uri.ID)
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *stateSuite) TestExpiredGrantConfersNoAccess(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	p := domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+		RoleID:        domainsecret.RoleView,
+	}
+	err = st.GrantAccess(ctx, uri, p)
+	c.Assert(err, jc.ErrorIsNil)
+	s.expireSecretGrants(c, uri)
+
+	// Updating the secret's metadata must not resurrect the grant.
+	err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("new description"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	ap := domainsecret.AccessParams{
+		SubjectTypeID: p.SubjectTypeID,
+		SubjectID:     p.SubjectID,
+	}
+	role, err := st.GetSecretAccess(ctx, uri, ap)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(role, gc.Equals, "")
+
+	g, err := st.GetSecretGrants(ctx, uri, coresecrets.RoleView)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(g, gc.HasLen, 0)
+
+	// Granting again replaces the expired grant.
+	err = st.GrantAccess(ctx, uri, p)
+	c.Assert(err, jc.ErrorIsNil)
+	role, err = st.GetSecretAccess(ctx, uri, ap)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(role, gc.Equals, "view")
+}
+
+func (s *stateSuite) TestDeleteExpiredSecretGrants(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	}
+	ctx := context.Background()
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+	uri2 := coresecrets.NewURI()
+	sp.RevisionID = ptr(uuid.MustNewUUID().String())
+	err = createUserSecret(ctx, st, 1, uri2, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	p := domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+		RoleID:        domainsecret.RoleView,
+	}
+	err = st.GrantAccess(ctx, uri, p)
+	c.Assert(err, jc.ErrorIsNil)
+	err = st.GrantAccess(ctx, uri2, p)
+	c.Assert(err, jc.ErrorIsNil)
+	s.expireSecretGrants(c, uri)
+	var expired int64
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM secret_permission WHERE secret_id = ?", uri.ID).Scan(&expired)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(expired > 0, jc.IsTrue)
+
+	n, err := st.DeleteExpiredSecretGrants(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(n, gc.Equals, expired)
+
+	var count int
+	err = s.TxnRunner().StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT count(*) FROM secret_permission WHERE secret_id = ?", uri.ID).Scan(&count)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(count, gc.Equals, 0)
+
+	g, err := st.GetSecretGrants(ctx, uri2, coresecrets.RoleView)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(g, gc.HasLen, 1)
+}
+
+func (s *stateSuite) TestGetSecretGrantsNone(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	g, err := st.GetSecretGrants(ctx, uri, coresecrets.RoleView)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(g, gc.HasLen, 0)
+}
 
 func (s *stateSuite) TestGetSecretGrantsAppUnit(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
@@ -3131,6 +3651,218 @@ func (s *stateSuite) TestRevokeAccess(c *gc.C) {
 	}})
 }
 
+func (s *stateSuite) TestGrantAccessBulk(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	grant := func(unitName string, role domainsecret.Role) domainsecret.GrantParams {
+		return domainsecret.GrantParams{
+			ScopeTypeID:   domainsecret.ScopeApplication,
+			ScopeID:       "mysql",
+			SubjectTypeID: domainsecret.SubjectUnit,
+			SubjectID:     unitName,
+			RoleID:        role,
+		}
+	}
+	results, err := st.GrantAccessBulk(ctx, uri, []domainsecret.GrantParams{
+		grant("mysql/0", domainsecret.RoleView),
+		grant("mysql/1", domainsecret.RoleView),
+		// Duplicate grants are idempotent.
+		grant("mysql/0", domainsecret.RoleView),
+		grant("mysql/2", domainsecret.RoleView),
+		// A different role for the same subject updates the grant.
+		grant("mysql/1", domainsecret.RoleManage),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 5)
+	c.Check(results[0], jc.ErrorIsNil)
+	c.Check(results[1], jc.ErrorIsNil)
+	c.Check(results[2], jc.ErrorIsNil)
+	c.Check(results[3], jc.ErrorIs, applicationerrors.UnitNotFound)
+	c.Check(results[4], jc.ErrorIsNil)
+
+	role, err := st.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, gc.Equals, "view")
+	role, err = st.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/1",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, gc.Equals, "manage")
+}
+
+func (s *stateSuite) TestGrantAccessBulkApplicationWithNarrowerUnitGrant(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.GrantAccess(ctx, uri, domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeUnit,
+		ScopeID:       "mysql/0",
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+		RoleID:        domainsecret.RoleView,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	results, err := st.GrantAccessBulk(ctx, uri, []domainsecret.GrantParams{{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+		RoleID:        domainsecret.RoleManage,
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(results, jc.DeepEquals, []error{nil})
+
+	// The application grant is recorded in full and the unit grant is
+	// left as it was.
+	role, err := st.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectApplication,
+		SubjectID:     "mysql",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, gc.Equals, "manage")
+	role, err = st.GetSecretAccess(ctx, uri, domainsecret.AccessParams{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(role, gc.Equals, "view")
+}
+
+func (s *stateSuite) TestGrantAccessBulkInvariantScope(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createCharmUnitSecret(ctx, st, 1, uri, "mysql/0", sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	p := domainsecret.GrantParams{
+		ScopeTypeID:   domainsecret.ScopeUnit,
+		ScopeID:       "mysql/0",
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/1",
+		RoleID:        domainsecret.RoleView,
+	}
+	err = st.GrantAccess(ctx, uri, p)
+	c.Assert(err, jc.ErrorIsNil)
+
+	p2 := p
+	p2.ScopeID = "mysql"
+	p2.ScopeTypeID = domainsecret.ScopeApplication
+	results, err := st.GrantAccessBulk(ctx, uri, []domainsecret.GrantParams{p2})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 1)
+	c.Check(results[0], jc.ErrorIs, secreterrors.InvalidSecretPermissionChange)
+}
+
+func (s *stateSuite) TestGrantAccessBulkSecretNotFound(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	_, err := st.GrantAccessBulk(context.Background(), coresecrets.NewURI(), []domainsecret.GrantParams{{
+		ScopeTypeID:   domainsecret.ScopeUnit,
+		ScopeID:       "mysql/0",
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+		RoleID:        domainsecret.RoleView,
+	}})
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretNotFound)
+}
+
+func (s *stateSuite) TestRevokeAccessBulk(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	s.setupUnits(c, "mysql")
+
+	sp := domainsecret.UpsertSecretParams{
+		RevisionID:  ptr(uuid.MustNewUUID().String()),
+		Description: ptr("my secretMetadata"),
+		Label:       ptr("my label"),
+		Data:        coresecrets.SecretData{"foo": "bar", "hello": "world"},
+	}
+	uri := coresecrets.NewURI()
+	ctx := context.Background()
+	err := createUserSecret(ctx, st, 1, uri, sp)
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, unitName := range []string{"mysql/0", "mysql/1"} {
+		err = st.GrantAccess(ctx, uri, domainsecret.GrantParams{
+			ScopeTypeID:   domainsecret.ScopeApplication,
+			ScopeID:       "mysql",
+			SubjectTypeID: domainsecret.SubjectUnit,
+			SubjectID:     unitName,
+			RoleID:        domainsecret.RoleView,
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+
+	results, err := st.RevokeAccessBulk(ctx, uri, []domainsecret.AccessParams{{
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	}, {
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/2",
+	}, {
+		// Revoking twice is a no-op.
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/0",
+	}})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(results, gc.HasLen, 3)
+	c.Check(results[0], jc.ErrorIsNil)
+	c.Check(results[1], jc.ErrorIs, applicationerrors.UnitNotFound)
+	c.Check(results[2], jc.ErrorIsNil)
+
+	g, err := st.GetSecretGrants(ctx, uri, coresecrets.RoleView)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(g, jc.DeepEquals, []domainsecret.GrantParams{{
+		ScopeTypeID:   domainsecret.ScopeApplication,
+		ScopeID:       "mysql",
+		SubjectTypeID: domainsecret.SubjectUnit,
+		SubjectID:     "mysql/1",
+		RoleID:        domainsecret.RoleView,
+	}})
+}
+
 func (s *stateSuite) TestListGrantedSecrets(c *gc.C) {
 	st := newSecretState(c, s.TxnRunnerFactory())
 
@@ -3615,6 +4347,12 @@ func (s *stateSuite) assertDeleteAllRevisions(c *gc.C, revs []int) {
 	c.Assert(err, jc.ErrorIsNil)
 	err = st.SaveSecretRemoteConsumer(ctx, uri, "remote-app/0", consumer)
 	c.Assert(err, jc.ErrorIsNil)
+	err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+		return st.SetSecretContentSchema(ctx, uri, domainsecret.ContentSchema{
+			RequiredKeys: []string{"foo"},
+		})
+	})
+	c.Assert(err, jc.ErrorIsNil)
 
 	uri2 := coresecrets.NewURI()
 	sp.RevisionID = ptr(uuid.MustNewUUID().String())
@@ -4353,3 +5091,109 @@ func (s *stateSuite) TestChangeSecretBackendFailed(c *gc.C) {
 	err = st.ChangeSecretBackend(ctx, uuid.MustNewUUID(), valueRefInput, dataInput)
 	c.Assert(err, gc.ErrorMatches, "both valueRef and data cannot be set")
 }
+
+func (s *stateSuite) TestSecretRevisionsPendingDeletion(c *gc.C) {
+	s.setupUnits(c, "mysql")
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := context.Background()
+	valueRef := func(revID string) *coresecrets.ValueRef {
+		return &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: revID}
+	}
+	uriUser := coresecrets.NewURI()
+	uriUserNoPrune := coresecrets.NewURI()
+	uriCharm := coresecrets.NewURI()
+	uriInternal := coresecrets.NewURI()
+
+	err := createUserSecret(ctx, st, 1, uriUser, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		ValueRef:   valueRef("user-1"),
+		AutoPrune:  ptr(true),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = createUserSecret(ctx, st, 1, uriUserNoPrune, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		ValueRef:   valueRef("user-no-prune-1"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = createCharmApplicationSecret(ctx, st, 1, uriCharm, "mysql", domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		ValueRef:   valueRef("charm-1"),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	err = createUserSecret(ctx, st, 1, uriInternal, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+		AutoPrune:  ptr(true),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	for _, uri := range []*coresecrets.URI{uriUser, uriUserNoPrune, uriCharm} {
+		err = updateSecret(ctx, st, uri, domainsecret.UpsertSecretParams{
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+			ValueRef:   valueRef(uri.ID + "-2"),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}
+	err = updateSecret(ctx, st, uriInternal, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "baz"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	userRev := getRevUUID(c, s.DB(), uriUser, 1)
+	charmRev := getRevUUID(c, s.DB(), uriCharm, 1)
+	internalRev := getRevUUID(c, s.DB(), uriInternal, 1)
+	allRevs := []string{
+		userRev, charmRev, internalRev,
+		getRevUUID(c, s.DB(), uriUser, 2),
+		getRevUUID(c, s.DB(), uriUserNoPrune, 1),
+	}
+
+	_, initial := st.InitialWatchStatementForSecretRevisionsPendingDeletion()
+	initialRevs, err := initial(ctx, s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(initialRevs, jc.SameContents, []string{userRev})
+
+	result, err := st.GetSecretRevisionsPendingDeletion(ctx, allRevs...)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.SameContents, []domainsecret.RevisionPendingDeletion{{
+		URI:          uriUser,
+		Revision:     1,
+		RevisionUUID: userRev,
+		ValueRef:     *valueRef("user-1"),
+	}})
+
+	// The obsolete user secret revision is not pruned until its content
+	// has been deleted from the backend.
+	deleted, err := st.DeleteObsoleteUserSecretRevisions(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(deleted, jc.SameContents, []string{internalRev})
+	assertRevision(c, s.DB(), uriUser, 1, true)
+
+	err = st.MarkSecretRevisionDeleted(ctx, userRev)
+	c.Assert(err, jc.ErrorIsNil)
+
+	result, err = st.GetSecretRevisionsPendingDeletion(ctx, allRevs...)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, gc.HasLen, 0)
+
+	deleted, err = st.DeleteObsoleteUserSecretRevisions(ctx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(deleted, jc.SameContents, []string{userRev})
+}
+
+func (s *stateSuite) TestMarkSecretRevisionDeletedNotObsolete(c *gc.C) {
+	st := newSecretState(c, s.TxnRunnerFactory())
+
+	ctx := context.Background()
+	uri := coresecrets.NewURI()
+	err := createUserSecret(ctx, st, 1, uri, domainsecret.UpsertSecretParams{
+		RevisionID: ptr(uuid.MustNewUUID().String()),
+		Data:       coresecrets.SecretData{"foo": "bar"},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.MarkSecretRevisionDeleted(ctx, getRevUUID(c, s.DB(), uri, 1))
+	c.Assert(err, jc.ErrorIs, secreterrors.SecretRevisionNotFound)
+}
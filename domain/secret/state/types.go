@@ -4,6 +4,7 @@
 package state
 
 import (
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -126,6 +127,19 @@ type secretRevisionObsolete struct {
 	PendingDelete bool   `db:"pending_delete"`
 }
 
+type revisionPendingDeletion struct {
+	SecretID     string `db:"secret_id"`
+	Revision     int    `db:"revision"`
+	RevisionUUID string `db:"revision_uuid"`
+	BackendUUID  string `db:"backend_uuid"`
+	RevisionID   string `db:"revision_id"`
+}
+
+type secretRevisionDeleted struct {
+	ID        string    `db:"revision_uuid"`
+	DeletedAt time.Time `db:"deleted_at"`
+}
+
 type secretRevisionExpire struct {
 	RevisionUUID string    `db:"revision_uuid"`
 	ExpireTime   time.Time `db:"expire_time"`
@@ -191,6 +205,7 @@ type secretPermission struct {
 	SubjectTypeID domainsecret.GrantSubjectType `db:"subject_type_id"`
 	ScopeUUID     string                        `db:"scope_uuid"`
 	ScopeTypeID   domainsecret.GrantScopeType   `db:"scope_type_id"`
+	ExpireTime    *time.Time                    `db:"expire_time"`
 }
 
 type secretAccessor struct {
@@ -198,6 +213,7 @@ type secretAccessor struct {
 	SubjectID     string                        `db:"subject_id"`
 	SubjectTypeID domainsecret.GrantSubjectType `db:"subject_type_id"`
 	RoleID        domainsecret.Role             `db:"role_id"`
+	ExpireTime    *time.Time                    `db:"expire_time"`
 }
 
 type secretAccessorType struct {
@@ -217,6 +233,11 @@ type secretAccessScope struct {
 	ScopeTypeID domainsecret.GrantScopeType `db:"scope_type_id"`
 }
 
+type secretSearch struct {
+	Pattern       string                        `db:"pattern"`
+	SubjectTypeID domainsecret.GrantSubjectType `db:"subject_type_id"`
+}
+
 type ownerKind struct {
 	Model       string `db:"model_owner_kind"`
 	Unit        string `db:"unit_owner_kind"`
@@ -460,6 +481,7 @@ func (rows secretAccessors) toSecretGrants(scopes secretAccessScopes) ([]domains
 			RoleID:        row.RoleID,
 			ScopeTypeID:   scopes[i].ScopeTypeID,
 			ScopeID:       scopes[i].ScopeID,
+			ExpireTime:    row.ExpireTime,
 		}
 	}
 	return result, nil
@@ -478,6 +500,7 @@ func (rows secretAccessors) toSecretGrantsBySecret(scopes secretAccessScopes) (m
 			RoleID:        row.RoleID,
 			ScopeTypeID:   scopes[i].ScopeTypeID,
 			ScopeID:       scopes[i].ScopeID,
+			ExpireTime:    row.ExpireTime,
 		}
 		result[row.SecretID] = append(result[row.SecretID], params)
 	}
@@ -503,3 +526,21 @@ type count struct {
 	// Num is the number of rows.
 	Num int `db:"num"`
 }
+
+// secretContentSchemaKey represents a row of the secret_content_schema table.
+type secretContentSchemaKey struct {
+	SecretID     string         `db:"secret_id"`
+	Key          string         `db:"content_key"`
+	Required     bool           `db:"required"`
+	ValuePattern sql.NullString `db:"value_pattern"`
+}
+
+// secretGenerationPolicy represents a row of the secret_generation_policy
+// table.
+type secretGenerationPolicy struct {
+	SecretID string `db:"secret_id"`
+	Key      string `db:"content_key"`
+	Length   int    `db:"length"`
+	Charset  string `db:"charset"`
+	Format   string `db:"format"`
+}
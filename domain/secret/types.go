@@ -6,6 +6,8 @@ package secret
 import (
 	"time"
 
+	"github.com/juju/errors"
+
 	"github.com/juju/juju/core/secrets"
 )
 
@@ -32,6 +34,30 @@ const (
 	ModelOwner       = secrets.ModelOwner
 )
 
+// SecretSearchScope restricts the secrets considered when searching.
+type SecretSearchScope string
+
+const (
+	// SearchAll includes every secret in the model.
+	SearchAll SecretSearchScope = "all"
+	// SearchOwned includes only the secrets owned by the model,
+	// ie user secrets.
+	SearchOwned SecretSearchScope = "owned"
+	// SearchAccessible includes only the secrets the model has been
+	// granted access to.
+	SearchAccessible SecretSearchScope = "accessible"
+)
+
+// Validate returns an error satisfying [errors.NotValid] if the scope
+// is not one of the known values.
+func (s SecretSearchScope) Validate() error {
+	switch s {
+	case SearchAll, SearchOwned, SearchAccessible:
+		return nil
+	}
+	return errors.NotValidf("secret search scope %q", s)
+}
+
 // Owner is the owner of a secret.
 type Owner struct {
 	Kind secrets.OwnerKind
@@ -75,6 +101,10 @@ type GrantParams struct {
 	SubjectID     string
 
 	RoleID Role
+
+	// ExpireTime is when the grant expires, after which it confers no
+	// access. A nil value means the grant doesn't expire.
+	ExpireTime *time.Time
 }
 
 // AccessParams are used when querying secret access.
@@ -116,6 +146,15 @@ type ExpiryInfo struct {
 	NextTriggerTime time.Time
 }
 
+// RevisionPendingDeletion holds information about an obsolete secret revision
+// whose content has not yet been deleted from its secret backend.
+type RevisionPendingDeletion struct {
+	URI          *secrets.URI
+	Revision     int
+	RevisionUUID string
+	ValueRef     secrets.ValueRef
+}
+
 // ConsumerInfo holds information about a secret consumer.
 type ConsumerInfo struct {
 	SubjectTypeID   GrantSubjectType
@@ -850,6 +850,101 @@ func (s *watcherSuite) TestWatchSecretsRevisionExpiryChanges(c *gc.C) {
 	harness1.Run(c, []corewatcher.SecretTriggerChange(nil))
 }
 
+func (s *watcherSuite) TestWatchSecretsRevisionsPendingDeletion(c *gc.C) {
+	ctx := context.Background()
+	svc, st := s.setupServiceAndState(c)
+
+	uri := coresecrets.NewURI()
+	valueRef := func(revID string) *coresecrets.ValueRef {
+		return &coresecrets.ValueRef{BackendID: "backend-id", RevisionID: revID}
+	}
+	pendingDeletionAssert := func(expect ...corewatcher.SecretRevisionPendingDeletion) watchertest.WatcherAssert[[]corewatcher.SecretRevisionPendingDeletion] {
+		return func(c *gc.C, changes [][]corewatcher.SecretRevisionPendingDeletion) bool {
+			var received []corewatcher.SecretRevisionPendingDeletion
+			for _, change := range changes {
+				received = append(received, change...)
+			}
+			if len(received) >= len(expect) {
+				c.Assert(received, jc.SameContents, expect)
+				return true
+			}
+			return false
+		}
+	}
+
+	w, err := svc.WatchSecretsRevisionsPendingDeletion(ctx)
+	c.Assert(err, gc.IsNil)
+	c.Assert(w, gc.NotNil)
+	defer watchertest.CleanKill(c, w)
+
+	harness := watchertest.NewHarness(s, watchertest.NewWatcherC(c, w))
+	harness.AddTest(func(c *gc.C) {
+		err := createUserSecret(ctx, st, 1, uri, secret.UpsertSecretParams{
+			ValueRef:   valueRef("rev-1"),
+			RevisionID: ptr(uuid.MustNewUUID().String()),
+			AutoPrune:  ptr(true),
+		})
+		c.Assert(err, jc.ErrorIsNil)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretRevisionPendingDeletion]) {
+		w.AssertNoChange()
+	})
+
+	// Adding revision 2 makes revision 1 obsolete.
+	var revUUID string
+	harness.AddTest(func(c *gc.C) {
+		err = st.RunAtomic(ctx, func(ctx domain.AtomicContext) error {
+			return st.UpdateSecret(ctx, uri, secret.UpsertSecretParams{
+				ValueRef:   valueRef("rev-2"),
+				RevisionID: ptr(uuid.MustNewUUID().String()),
+			})
+		})
+		c.Assert(err, jc.ErrorIsNil)
+		revUUID = s.getRevUUID(c, uri, 1)
+	}, func(w watchertest.WatcherC[[]corewatcher.SecretRevisionPendingDeletion]) {
+		w.Check(pendingDeletionAssert(corewatcher.SecretRevisionPendingDeletion{
+			RevisionUUID: revUUID,
+			URI:          uri,
+			Revision:     1,
+			ValueRef:     *valueRef("rev-1"),
+		}))
+	})
+
+	harness.Run(c, []corewatcher.SecretRevisionPendingDeletion(nil))
+
+	// Pretend that the controller restarted before the content was deleted;
+	// the revision is reported again by the new watcher.
+	w1, err := svc.WatchSecretsRevisionsPendingDeletion(ctx)
+	c.Assert(err, gc.IsNil)
+	c.Assert(w1, gc.NotNil)
+	defer watchertest.CleanKill(c, w1)
+
+	harness1 := watchertest.NewHarness(s, watchertest.NewWatcherC(c, w1))
+	harness1.AddTest(func(c *gc.C) {}, func(w watchertest.WatcherC[[]corewatcher.SecretRevisionPendingDeletion]) {
+		w.Check(pendingDeletionAssert(corewatcher.SecretRevisionPendingDeletion{
+			RevisionUUID: revUUID,
+			URI:          uri,
+			Revision:     1,
+			ValueRef:     *valueRef("rev-1"),
+		}))
+	})
+	harness1.Run(c, []corewatcher.SecretRevisionPendingDeletion(nil))
+
+	err = st.MarkSecretRevisionDeleted(ctx, revUUID)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Once the content is deleted, the revision is no longer reported.
+	w2, err := svc.WatchSecretsRevisionsPendingDeletion(ctx)
+	c.Assert(err, gc.IsNil)
+	c.Assert(w2, gc.NotNil)
+	defer watchertest.CleanKill(c, w2)
+
+	harness2 := watchertest.NewHarness(s, watchertest.NewWatcherC(c, w2))
+	harness2.AddTest(func(c *gc.C) {}, func(w watchertest.WatcherC[[]corewatcher.SecretRevisionPendingDeletion]) {
+		w.AssertNoChange()
+	})
+	harness2.Run(c, []corewatcher.SecretRevisionPendingDeletion(nil))
+}
+
 func (s *watcherSuite) setupUnits(c *gc.C, appName string) {
 	logger := loggertesting.WrapCheckLog(c)
 	st := applicationstate.NewState(s.TxnRunnerFactory(), clock.WallClock, logger)
@@ -899,6 +994,17 @@ func (s *watcherSuite) setupServiceAndState(c *gc.C) (*service.WatchableService,
 	return service.NewWatchableService(st, nil, nil, factory, logger, service.SecretServiceParams{}), st
 }
 
+func (s *watcherSuite) getRevUUID(c *gc.C, uri *coresecrets.URI, rev int) string {
+	var uuid string
+	err := s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, `
+SELECT uuid FROM secret_revision
+WHERE secret_id = ? AND revision = ?`, uri.ID, rev).Scan(&uuid)
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return uuid
+}
+
 func revID(uri *coresecrets.URI, rev int) string {
 	return fmt.Sprintf("%s/%d", uri.ID, rev)
 }
@@ -0,0 +1,164 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	coremodel "github.com/juju/juju/core/model"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	"github.com/juju/juju/internal/secrets/provider/kubernetes"
+)
+
+// SecretBackendsExport holds the secret backend definitions a model depends
+// on, for use in model migration.
+type SecretBackendsExport struct {
+	// Backends are the user-defined secret backends which hold secrets for
+	// the model, or which the model is configured to use.
+	Backends []secretbackend.SecretBackend
+	// ModelBackendName is the name of the secret backend the model is
+	// configured to use, or "auto" if the model uses the built-in backend
+	// for its type.
+	ModelBackendName string
+}
+
+// isBuiltInBackend returns true if the named backend is one of the built-in
+// backends, which every controller provides for itself.
+func isBuiltInBackend(name string) bool {
+	return name == juju.BackendName || name == kubernetes.BackendName || name == provider.Auto
+}
+
+// GetSecretBackendsForExport returns the definitions of the user-defined
+// secret backends that the specified model depends on, along with the name of
+// the backend the model is configured to use. Built-in backends are not
+// included since the target controller resolves its own.
+func (s *Service) GetSecretBackendsForExport(ctx context.Context, modelUUID coremodel.UUID) (*SecretBackendsExport, error) {
+	backends, err := s.st.ListSecretBackendsForModel(ctx, modelUUID, false)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	details, err := s.st.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := &SecretBackendsExport{
+		ModelBackendName: details.SecretBackendName,
+	}
+	if isBuiltInBackend(details.SecretBackendName) {
+		result.ModelBackendName = provider.Auto
+	}
+
+	seen := set.NewStrings()
+	add := func(b *secretbackend.SecretBackend) {
+		if isBuiltInBackend(b.Name) || seen.Contains(b.ID) {
+			return
+		}
+		seen.Add(b.ID)
+		result.Backends = append(result.Backends, secretbackend.SecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			Config:              b.Config,
+		})
+	}
+	for _, b := range backends {
+		add(b)
+	}
+
+	// The model's configured backend may not yet hold any of its secrets.
+	if result.ModelBackendName != provider.Auto && !seen.Contains(details.SecretBackendID) {
+		b, err := s.st.GetSecretBackend(ctx, secretbackend.BackendIdentifier{ID: details.SecretBackendID})
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		add(b)
+	}
+
+	// Carry over the token rotation schedule so the target controller does
+	// not rotate any earlier or later than the source would have.
+	var rotating []string
+	for _, b := range result.Backends {
+		if b.TokenRotateInterval != nil && *b.TokenRotateInterval > 0 {
+			rotating = append(rotating, b.ID)
+		}
+	}
+	if len(rotating) == 0 {
+		return result, nil
+	}
+	changes, err := s.st.GetSecretBackendRotateChanges(ctx, rotating...)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	nextRotateTimes := make(map[string]time.Time, len(changes))
+	for _, ch := range changes {
+		nextRotateTimes[ch.ID] = ch.NextTriggerTime
+	}
+	for i, b := range result.Backends {
+		if next, ok := nextRotateTimes[b.ID]; ok {
+			result.Backends[i].NextRotateTime = &next
+		}
+	}
+	return result, nil
+}
+
+// ImportSecretBackends creates the exported secret backend definitions on
+// this controller and sets the secret backend for the specified model, in a
+// single transaction.
+//
+// Built-in backends are skipped. A backend which already exists with the same
+// ID and name is assumed to be shared with the source controller and is left
+// as is. If a backend's name is used by a different backend on this
+// controller, or its ID is used by a backend with a different name, an error
+// satisfying [secretbackenderrors.AlreadyExists] is returned. The config of
+// each backend is validated as it would be for a new backend.
+func (s *Service) ImportSecretBackends(ctx context.Context, modelUUID coremodel.UUID, in SecretBackendsExport) error {
+	var params []secretbackend.CreateSecretBackendParams
+	for _, b := range in.Backends {
+		if isBuiltInBackend(b.Name) {
+			continue
+		}
+		p, err := s.prepareSecretBackend(coresecrets.SecretBackend{
+			ID:                  b.ID,
+			Name:                b.Name,
+			BackendType:         b.BackendType,
+			TokenRotateInterval: b.TokenRotateInterval,
+			Config:              b.Config,
+		})
+		if err != nil {
+			return fmt.Errorf("importing secret backend %q: %w", b.Name, err)
+		}
+		// Keep the rotation schedule from the source controller.
+		if p.NextRotateTime != nil && b.NextRotateTime != nil {
+			p.NextRotateTime = b.NextRotateTime
+		}
+		params = append(params, p)
+	}
+
+	// The built-in backend for the model is resolved for the model type on
+	// this controller.
+	backendName := in.ModelBackendName
+	if backendName == "" || isBuiltInBackend(backendName) {
+		modelType, err := s.st.GetModelType(ctx, modelUUID)
+		if err != nil {
+			return fmt.Errorf("getting model type for %q: %w", modelUUID, err)
+		}
+		var ok bool
+		if backendName, ok = builtInBackendName(modelType); !ok {
+			return fmt.Errorf("importing secret backends for unsupported model type %q for model %q",
+				modelType, modelUUID,
+			)
+		}
+	}
+	return errors.Trace(s.st.ImportSecretBackends(ctx, modelUUID, params, backendName))
+}
@@ -0,0 +1,202 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coremodel "github.com/juju/juju/core/model"
+	modeltesting "github.com/juju/juju/core/model/testing"
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/secretbackend"
+	"github.com/juju/juju/internal/secrets/provider"
+	"github.com/juju/juju/internal/secrets/provider/juju"
+	"github.com/juju/juju/internal/secrets/provider/vault"
+)
+
+func (s *serviceSuite) TestGetSecretBackendsForExport(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, false).Return([]*secretbackend.SecretBackend{{
+		ID:          jujuBackendID,
+		Name:        juju.BackendName,
+		BackendType: juju.BackendType,
+	}, {
+		ID:          vaultBackendID,
+		Name:        "myvault",
+		BackendType: vault.BackendType,
+		Config:      map[string]any{"endpoint": "http://vault"},
+		NumSecrets:  2,
+	}}, nil)
+	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).Return(secretbackend.ModelSecretBackend{
+		ModelID:           modelUUID,
+		ModelType:         coremodel.IAAS,
+		SecretBackendID:   "other-vault-id",
+		SecretBackendName: "othervault",
+	}, nil)
+	rotateInterval := time.Hour
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "other-vault-id"}).Return(&secretbackend.SecretBackend{
+		ID:                  "other-vault-id",
+		Name:                "othervault",
+		BackendType:         vault.BackendType,
+		TokenRotateInterval: &rotateInterval,
+		Config:              map[string]any{"endpoint": "http://othervault"},
+	}, nil)
+	nextRotateTime := time.Now().Add(time.Minute).UTC()
+	s.mockState.EXPECT().GetSecretBackendRotateChanges(gomock.Any(), "other-vault-id").Return([]watcher.SecretBackendRotateChange{{
+		ID:              "other-vault-id",
+		Name:            "othervault",
+		NextTriggerTime: nextRotateTime,
+	}}, nil)
+
+	export, err := svc.GetSecretBackendsForExport(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(export, jc.DeepEquals, &SecretBackendsExport{
+		ModelBackendName: "othervault",
+		Backends: []secretbackend.SecretBackend{{
+			ID:          vaultBackendID,
+			Name:        "myvault",
+			BackendType: vault.BackendType,
+			Config:      map[string]any{"endpoint": "http://vault"},
+		}, {
+			ID:                  "other-vault-id",
+			Name:                "othervault",
+			BackendType:         vault.BackendType,
+			TokenRotateInterval: &rotateInterval,
+			NextRotateTime:      &nextRotateTime,
+			Config:              map[string]any{"endpoint": "http://othervault"},
+		}},
+	})
+}
+
+func (s *serviceSuite) TestGetSecretBackendsForExportBuiltIn(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, false).Return([]*secretbackend.SecretBackend{{
+		ID:          jujuBackendID,
+		Name:        juju.BackendName,
+		BackendType: juju.BackendType,
+	}}, nil)
+	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).Return(secretbackend.ModelSecretBackend{
+		ModelID:           modelUUID,
+		ModelType:         coremodel.IAAS,
+		SecretBackendID:   jujuBackendID,
+		SecretBackendName: juju.BackendName,
+	}, nil)
+
+	export, err := svc.GetSecretBackendsForExport(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(export, jc.DeepEquals, &SecretBackendsExport{
+		ModelBackendName: provider.Auto,
+	})
+}
+
+func (s *serviceSuite) TestImportSecretBackends(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	svc := newService(s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			c.Assert(backendType, gc.Equals, vault.BackendType)
+			return providerWithConfig{
+				SecretBackendProvider: s.mockRegistry,
+			}, nil
+		},
+	)
+
+	addedConfig := map[string]any{
+		"endpoint":  "http://vault",
+		"namespace": "foo",
+	}
+	s.mockRegistry.EXPECT().NewBackend(&provider.ModelBackendConfig{
+		BackendConfig: provider.BackendConfig{
+			BackendType: vault.BackendType,
+			Config:      addedConfig,
+		},
+	}).Return(s.mockSecretProvider, nil)
+	s.mockRegistry.EXPECT().Type().Return(vault.BackendType).AnyTimes()
+	s.mockSecretProvider.EXPECT().Ping().Return(nil)
+
+	rotateInterval := time.Hour
+	nextRotateTime := s.clock.Now().Add(time.Minute)
+	s.mockState.EXPECT().ImportSecretBackends(gomock.Any(), modelUUID, []secretbackend.CreateSecretBackendParams{{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   vaultBackendID,
+			Name: "myvault",
+		},
+		BackendType:         vault.BackendType,
+		TokenRotateInterval: &rotateInterval,
+		NextRotateTime:      &nextRotateTime,
+		Config:              convertConfigToString(addedConfig),
+	}}, "myvault").Return(nil)
+
+	err := svc.ImportSecretBackends(context.Background(), modelUUID, SecretBackendsExport{
+		ModelBackendName: "myvault",
+		Backends: []secretbackend.SecretBackend{{
+			ID:          jujuBackendID,
+			Name:        juju.BackendName,
+			BackendType: juju.BackendType,
+		}, {
+			ID:                  vaultBackendID,
+			Name:                "myvault",
+			BackendType:         vault.BackendType,
+			TokenRotateInterval: &rotateInterval,
+			NextRotateTime:      &nextRotateTime,
+			Config:              map[string]any{"endpoint": "http://vault"},
+		}},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestImportSecretBackendsResolvesBuiltIn(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().GetModelType(gomock.Any(), modelUUID).Return(coremodel.IAAS, nil)
+	s.mockState.EXPECT().ImportSecretBackends(gomock.Any(), modelUUID, nil, juju.BackendName).Return(nil)
+
+	err := svc.ImportSecretBackends(context.Background(), modelUUID, SecretBackendsExport{
+		ModelBackendName: provider.Auto,
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestImportSecretBackendsInvalidConfig(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	svc := newService(s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+
+	s.mockRegistry.EXPECT().NewBackend(gomock.Any()).Return(s.mockSecretProvider, nil)
+	s.mockSecretProvider.EXPECT().Ping().Return(errors.New("boom"))
+	s.mockRegistry.EXPECT().Type().Return(vault.BackendType).AnyTimes()
+
+	err := svc.ImportSecretBackends(context.Background(), modelUUID, SecretBackendsExport{
+		ModelBackendName: "myvault",
+		Backends: []secretbackend.SecretBackend{{
+			ID:          vaultBackendID,
+			Name:        "myvault",
+			BackendType: vault.BackendType,
+		}},
+	})
+	c.Assert(err, gc.ErrorMatches, `importing secret backend "myvault": boom`)
+}
@@ -26,6 +26,9 @@ type State interface {
 	ListSecretBackendIDs(ctx context.Context) ([]string, error)
 	SecretBackendRotated(ctx context.Context, backendID string, next time.Time) error
 	SetModelSecretBackend(ctx context.Context, modelUUID coremodel.UUID, secretBackendName string) error
+	ImportSecretBackends(ctx context.Context, modelUUID coremodel.UUID, backends []secretbackend.CreateSecretBackendParams, modelBackendName string) error
+	SetSecretBackendAuditLevel(ctx context.Context, backendID string, level secretbackend.AuditLevel) error
+	GetSecretBackendAuditLevel(ctx context.Context, backendID string) (secretbackend.AuditLevel, error)
 
 	ListSecretBackendsForModel(ctx context.Context, modelUUID coremodel.UUID, includeEmpty bool) ([]*secretbackend.SecretBackend, error)
 	GetModelSecretBackendDetails(ctx context.Context, modelUUID coremodel.UUID) (secretbackend.ModelSecretBackend, error)
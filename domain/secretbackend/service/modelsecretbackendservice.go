@@ -64,12 +64,8 @@ func (s *ModelSecretBackendService) SetModelSecretBackend(ctx context.Context, b
 		if err != nil {
 			return fmt.Errorf("getting model type for %q: %w", s.modelID, err)
 		}
-		switch modelType {
-		case coremodel.IAAS:
-			backendName = provider.Internal
-		case coremodel.CAAS:
-			backendName = kubernetes.BackendName
-		default:
+		var ok bool
+		if backendName, ok = builtInBackendName(modelType); !ok {
 			// Should never happen.
 			return fmt.Errorf("setting model secret backend for unsupported model type %q for model %q",
 				modelType, s.modelID,
@@ -81,3 +77,16 @@ func (s *ModelSecretBackendService) SetModelSecretBackend(ctx context.Context, b
 	}
 	return nil
 }
+
+// builtInBackendName returns the name of the built-in secret backend which
+// "auto" resolves to for models of the given type, and false if the model type
+// has no built-in backend.
+func builtInBackendName(modelType coremodel.ModelType) (string, bool) {
+	switch modelType {
+	case coremodel.IAAS:
+		return provider.Internal, true
+	case coremodel.CAAS:
+		return kubernetes.BackendName, true
+	}
+	return "", false
+}
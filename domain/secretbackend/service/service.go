@@ -78,14 +78,15 @@ func (s *Service) GetSecretBackendConfigForAdmin(ctx context.Context, modelUUID
 	if err != nil {
 		return nil, errors.Trace(err)
 	}
-	currentBackendName := modelSecretBackend.SecretBackendName
+	activeBackend, err := s.GetEffectiveBackendForModel(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
 
 	var info provider.ModelBackendConfigInfo
+	info.ActiveID = activeBackend.ID
 	info.Configs = make(map[string]provider.ModelBackendConfig)
 	for _, b := range backends {
-		if b.Name == currentBackendName {
-			info.ActiveID = b.ID
-		}
 		info.Configs[b.ID] = provider.ModelBackendConfig{
 			ControllerUUID: modelSecretBackend.ControllerUUID,
 			ModelUUID:      modelSecretBackend.ModelID.String(),
@@ -96,12 +97,53 @@ func (s *Service) GetSecretBackendConfigForAdmin(ctx context.Context, modelUUID
 			},
 		}
 	}
-	if info.ActiveID == "" {
-		return nil, fmt.Errorf("%w: %q", secretbackenderrors.NotFound, currentBackendName)
+	if _, ok := info.Configs[info.ActiveID]; !ok {
+		return nil, fmt.Errorf("%w: %q", secretbackenderrors.NotFound, activeBackend.Name)
 	}
 	return &info, nil
 }
 
+// GetEffectiveBackendForModel returns the secret backend in use by the
+// specified model. If the model is configured to use "auto", the built-in
+// backend for the model's type is returned: the controller backend for IAAS
+// models and the kubernetes backend for CAAS models.
+// The following errors may be returned:
+// - [modelerrors.NotFound] if the model does not exist.
+// - [secretbackenderrors.NotFound] if the backend does not exist.
+func (s *Service) GetEffectiveBackendForModel(ctx context.Context, modelUUID coremodel.UUID) (*secretbackend.SecretBackend, error) {
+	details, err := s.st.GetModelSecretBackendDetails(ctx, modelUUID)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	backendName, err := effectiveBackendName(details)
+	if err != nil {
+		return nil, errors.Annotatef(err, "resolving secret backend for model %q", modelUUID)
+	}
+
+	identifier := secretbackend.BackendIdentifier{Name: backendName}
+	if backendName == details.SecretBackendName && details.SecretBackendID != "" {
+		identifier = secretbackend.BackendIdentifier{ID: details.SecretBackendID}
+	}
+	backend, err := s.st.GetSecretBackend(ctx, identifier)
+	if err != nil {
+		return nil, errors.Annotatef(err, "getting secret backend %q for model %q", backendName, modelUUID)
+	}
+	return backend, nil
+}
+
+// effectiveBackendName returns the name of the secret backend in use by the
+// model, resolving "auto" to the built-in backend for the model's type.
+func effectiveBackendName(details secretbackend.ModelSecretBackend) (string, error) {
+	if details.SecretBackendName != "" && details.SecretBackendName != provider.Auto {
+		return details.SecretBackendName, nil
+	}
+	name, ok := builtInBackendName(details.ModelType)
+	if !ok {
+		return "", errors.NotSupportedf("model type %q", details.ModelType)
+	}
+	return name, nil
+}
+
 // DrainBackendConfigInfo returns the secret backend config for the drain worker to use.
 func (s *Service) DrainBackendConfigInfo(
 	ctx context.Context, p DrainBackendConfigParams,
@@ -419,18 +461,30 @@ func (s *Service) ListBackendIDs(ctx context.Context) ([]string, error) {
 
 // CreateSecretBackend creates a new secret backend.
 func (s *Service) CreateSecretBackend(ctx context.Context, backend coresecrets.SecretBackend) error {
+	params, err := s.prepareSecretBackend(backend)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	_, err = s.st.CreateSecretBackend(ctx, params)
+	return errors.Trace(err)
+}
+
+// prepareSecretBackend validates the supplied backend, applying the provider
+// config defaults and checking the backend can be reached, and returns the
+// parameters needed to record it in state.
+func (s *Service) prepareSecretBackend(backend coresecrets.SecretBackend) (secretbackend.CreateSecretBackendParams, error) {
 	if backend.ID == "" {
-		return fmt.Errorf("%w: missing ID", secretbackenderrors.NotValid)
+		return secretbackend.CreateSecretBackendParams{}, fmt.Errorf("%w: missing ID", secretbackenderrors.NotValid)
 	}
 	if backend.Name == "" {
-		return fmt.Errorf("%w: missing name", secretbackenderrors.NotValid)
+		return secretbackend.CreateSecretBackendParams{}, fmt.Errorf("%w: missing name", secretbackenderrors.NotValid)
 	}
 	if err := validateExternalBackendName(backend.Name); err != nil {
-		return errors.Trace(err)
+		return secretbackend.CreateSecretBackendParams{}, errors.Trace(err)
 	}
 	p, err := s.registry(backend.BackendType)
 	if err != nil {
-		return fmt.Errorf("getting backend provider type %q: %w", backend.BackendType, err)
+		return secretbackend.CreateSecretBackendParams{}, fmt.Errorf("getting backend provider type %q: %w", backend.BackendType, err)
 	}
 	configValidator, ok := p.(provider.ProviderConfig)
 	if ok {
@@ -445,36 +499,33 @@ func (s *Service) CreateSecretBackend(ctx context.Context, backend coresecrets.S
 		}
 		err = configValidator.ValidateConfig(nil, backend.Config)
 		if err != nil {
-			return fmt.Errorf("%w: config for provider %q: %w", secretbackenderrors.NotValid, backend.BackendType, err)
+			return secretbackend.CreateSecretBackendParams{}, fmt.Errorf("%w: config for provider %q: %w", secretbackenderrors.NotValid, backend.BackendType, err)
 		}
 	}
 	if err := pingBackend(p, backend.Config); err != nil {
-		return errors.Trace(err)
+		return secretbackend.CreateSecretBackendParams{}, errors.Trace(err)
 	}
 
 	var nextRotateTime *time.Time
 	if backend.TokenRotateInterval != nil && *backend.TokenRotateInterval > 0 {
 		if !provider.HasAuthRefresh(p) {
-			return fmt.Errorf("%w: token refresh on secret backend of type %q", secretbackenderrors.NotSupported, p.Type())
+			return secretbackend.CreateSecretBackendParams{}, fmt.Errorf("%w: token refresh on secret backend of type %q", secretbackenderrors.NotSupported, p.Type())
 		}
 		nextRotateTime, err = coresecrets.NextBackendRotateTime(s.clock.Now(), *backend.TokenRotateInterval)
 		if err != nil {
-			return errors.Trace(err)
+			return secretbackend.CreateSecretBackendParams{}, errors.Trace(err)
 		}
 	}
-	_, err = s.st.CreateSecretBackend(
-		ctx, secretbackend.CreateSecretBackendParams{
-			BackendIdentifier: secretbackend.BackendIdentifier{
-				ID:   backend.ID,
-				Name: backend.Name,
-			},
-			BackendType:         backend.BackendType,
-			TokenRotateInterval: backend.TokenRotateInterval,
-			Config:              convertConfigToString(backend.Config),
-			NextRotateTime:      nextRotateTime,
+	return secretbackend.CreateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backend.ID,
+			Name: backend.Name,
 		},
-	)
-	return errors.Trace(err)
+		BackendType:         backend.BackendType,
+		TokenRotateInterval: backend.TokenRotateInterval,
+		Config:              convertConfigToString(backend.Config),
+		NextRotateTime:      nextRotateTime,
+	}, nil
 }
 
 // UpdateSecretBackend updates an existing secret backend.
@@ -600,6 +651,76 @@ func (s *Service) RotateBackendToken(ctx context.Context, backendID string) erro
 	return errors.Trace(err)
 }
 
+// SetSecretBackendAuditLevel sets which accesses to the secrets stored in the
+// specified backend are recorded in the audit log.
+// It returns an error satisfying [secretbackenderrors.NotValid] if the level
+// is not valid, [secretbackenderrors.NotFound] if the backend does not exist,
+// or [secretbackenderrors.Forbidden] if the backend is a built-in backend.
+func (s *Service) SetSecretBackendAuditLevel(ctx context.Context, backendID string, level secretbackend.AuditLevel) error {
+	if err := level.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	return s.st.SetSecretBackendAuditLevel(ctx, backendID, level)
+}
+
+// GetSecretBackendAuditLevel returns which accesses to the secrets stored in
+// the specified backend are recorded in the audit log.
+// It returns an error satisfying [secretbackenderrors.NotFound] if the backend
+// does not exist.
+func (s *Service) GetSecretBackendAuditLevel(ctx context.Context, backendID string) (secretbackend.AuditLevel, error) {
+	return s.st.GetSecretBackendAuditLevel(ctx, backendID)
+}
+
+// EstimateSecretBackendCapacity returns an estimate of how much of the
+// specified secret backend's storage is in use. Each secret revision is
+// stored as a separate object in the backend, so any hard limit reported
+// by the backend is applied to the revision count.
+func (s *Service) EstimateSecretBackendCapacity(ctx context.Context, backendID string) (BackendCapacityEstimate, error) {
+	backendInfo, err := s.st.GetSecretBackend(ctx, secretbackend.BackendIdentifier{ID: backendID})
+	if err != nil {
+		return BackendCapacityEstimate{}, errors.Trace(err)
+	}
+	result := BackendCapacityEstimate{
+		CurrentSecretCount:   backendInfo.NumSecrets,
+		CurrentRevisionCount: backendInfo.NumSecrets,
+	}
+	// The built-in backends are configured per model and
+	// don't have a controller wide limit.
+	if len(backendInfo.Config) == 0 {
+		return result, nil
+	}
+
+	p, err := s.registry(backendInfo.BackendType)
+	if err != nil {
+		return BackendCapacityEstimate{}, errors.Trace(err)
+	}
+	b, err := p.NewBackend(&provider.ModelBackendConfig{
+		BackendConfig: provider.BackendConfig{BackendType: backendInfo.BackendType, Config: backendInfo.Config},
+	})
+	if err != nil {
+		return BackendCapacityEstimate{}, errors.Trace(err)
+	}
+	capacity, ok := b.(provider.SupportCapacity)
+	if !ok {
+		return result, nil
+	}
+	limit, err := capacity.MaxSecretRevisions(ctx)
+	if err != nil {
+		return BackendCapacityEstimate{}, errors.Annotatef(err, "getting capacity of secret backend %q", backendInfo.Name)
+	}
+	if limit == nil || *limit <= 0 {
+		return result, nil
+	}
+
+	remaining := *limit - int64(result.CurrentRevisionCount)
+	if remaining < 0 {
+		remaining = 0
+	}
+	result.EstimatedRemainingCapacity = &remaining
+	result.PercentUsed = float64(result.CurrentRevisionCount) / float64(*limit) * 100
+	return result, nil
+}
+
 // GetRevisionsToDrain looks at the supplied revisions and returns any which should be
 // drained to a different backend for the specified model.
 func (s *Service) GetRevisionsToDrain(ctx context.Context, modelUUID coremodel.UUID, revs []coresecrets.SecretExternalRevision) ([]RevisionInfo, error) {
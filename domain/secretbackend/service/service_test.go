@@ -177,7 +177,8 @@ func (s *serviceSuite) expectGetSecretBackendConfigForAdminDefault(
 		}}
 	}
 
-	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return(append(builtIn, backends...), nil)
+	all := append(builtIn, backends...)
+	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return(all, nil)
 	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
 		Return(secretbackend.ModelSecretBackend{
 			ControllerUUID:    jujutesting.ControllerTag.Id(),
@@ -186,7 +187,104 @@ func (s *serviceSuite) expectGetSecretBackendConfigForAdminDefault(
 			ModelType:         coremodel.ModelType(modelType),
 			SecretBackendID:   modelBackend.ID,
 			SecretBackendName: modelBackend.Name,
+		}, nil).Times(2)
+	s.expectGetSecretBackend(all)
+}
+
+// expectGetSecretBackend expects the backend in use by the model to be looked
+// up, by ID or name, amongst the given backends.
+func (s *serviceSuite) expectGetSecretBackend(backends []*secretbackend.SecretBackend) {
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, identifier secretbackend.BackendIdentifier) (*secretbackend.SecretBackend, error) {
+			for _, b := range backends {
+				if identifier.ID != "" && b.ID == identifier.ID || identifier.ID == "" && b.Name == identifier.Name {
+					return b, nil
+				}
+			}
+			return nil, secretbackenderrors.NotFound
+		})
+}
+
+func (s *serviceSuite) TestGetEffectiveBackendForModel(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
+	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
+		Return(secretbackend.ModelSecretBackend{
+			ModelID:           modelUUID,
+			ModelType:         coremodel.IAAS,
+			SecretBackendID:   vaultBackendID,
+			SecretBackendName: "myvault",
+		}, nil)
+	expected := &secretbackend.SecretBackend{
+		ID:          vaultBackendID,
+		Name:        "myvault",
+		BackendType: "vault",
+	}
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: vaultBackendID}).
+		Return(expected, nil)
+
+	backend, err := svc.GetEffectiveBackendForModel(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(backend, jc.DeepEquals, expected)
+}
+
+func (s *serviceSuite) TestGetEffectiveBackendForModelAuto(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
+	for modelType, backendName := range map[coremodel.ModelType]string{
+		coremodel.IAAS: juju.BackendName,
+		coremodel.CAAS: kubernetes.BackendName,
+	} {
+		s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
+			Return(secretbackend.ModelSecretBackend{
+				ModelID:           modelUUID,
+				ModelType:         modelType,
+				SecretBackendName: provider.Auto,
+			}, nil)
+		expected := &secretbackend.SecretBackend{Name: backendName}
+		s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{Name: backendName}).
+			Return(expected, nil)
+
+		backend, err := svc.GetEffectiveBackendForModel(context.Background(), modelUUID)
+		c.Assert(err, jc.ErrorIsNil)
+		c.Check(backend, jc.DeepEquals, expected)
+	}
+}
+
+func (s *serviceSuite) TestGetEffectiveBackendForModelUnsupportedModelType(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
+	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
+		Return(secretbackend.ModelSecretBackend{
+			ModelID:           modelUUID,
+			ModelType:         "bad-type",
+			SecretBackendName: provider.Auto,
 		}, nil)
+
+	_, err := svc.GetEffectiveBackendForModel(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIs, errors.NotSupported)
+}
+
+func (s *serviceSuite) TestGetEffectiveBackendForModelNotFound(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
+	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
+		Return(secretbackend.ModelSecretBackend{}, modelerrors.NotFound)
+
+	_, err := svc.GetEffectiveBackendForModel(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIs, modelerrors.NotFound)
 }
 
 func (s *serviceSuite) TestGetSecretBackendConfigForAdmin(c *gc.C) {
@@ -202,7 +300,7 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdmin(c *gc.C) {
 	)
 
 	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
-	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return([]*secretbackend.SecretBackend{
+	backends := []*secretbackend.SecretBackend{
 		{
 			ID:          jujuBackendID,
 			Name:        juju.BackendName,
@@ -227,7 +325,8 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdmin(c *gc.C) {
 				"is-controller-cloud": true,
 			},
 		},
-	}, nil)
+	}
+	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return(backends, nil)
 	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
 		Return(secretbackend.ModelSecretBackend{
 			ControllerUUID:    jujutesting.ControllerTag.Id(),
@@ -236,7 +335,8 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdmin(c *gc.C) {
 			ModelType:         coremodel.CAAS,
 			SecretBackendID:   vaultBackendID,
 			SecretBackendName: "myvault",
-		}, nil)
+		}, nil).Times(2)
+	s.expectGetSecretBackend(backends)
 
 	info, err := svc.GetSecretBackendConfigForAdmin(context.Background(), modelUUID)
 	c.Assert(err, jc.ErrorIsNil)
@@ -263,7 +363,7 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdminFailedNotFound(c *gc.C)
 	)
 
 	modelUUID := coremodel.UUID(jujutesting.ModelTag.Id())
-	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return([]*secretbackend.SecretBackend{
+	backends := []*secretbackend.SecretBackend{
 		{
 			ID:          k8sBackendID,
 			Name:        kubernetes.BackendName,
@@ -275,7 +375,8 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdminFailedNotFound(c *gc.C)
 				"is-controller-cloud": true,
 			},
 		},
-	}, nil)
+	}
+	s.mockState.EXPECT().ListSecretBackendsForModel(gomock.Any(), modelUUID, true).Return(backends, nil)
 	s.mockState.EXPECT().GetModelSecretBackendDetails(gomock.Any(), modelUUID).
 		Return(secretbackend.ModelSecretBackend{
 			ControllerUUID:    jujutesting.ControllerTag.Id(),
@@ -284,7 +385,8 @@ func (s *serviceSuite) TestGetSecretBackendConfigForAdminFailedNotFound(c *gc.C)
 			ModelType:         coremodel.CAAS,
 			SecretBackendID:   vaultBackendID,
 			SecretBackendName: "myvault",
-		}, nil)
+		}, nil).Times(2)
+	s.expectGetSecretBackend(backends)
 
 	_, err := svc.GetSecretBackendConfigForAdmin(context.Background(), modelUUID)
 	c.Assert(err, jc.ErrorIs, secretbackenderrors.NotFound)
@@ -1771,3 +1873,138 @@ func (s *serviceSuite) TestGetRevisionsToDrainExternal(c *gc.C) {
 		},
 	)
 }
+
+type backendWithCapacity struct {
+	provider.SecretsBackend
+	limit *int64
+}
+
+func (b backendWithCapacity) MaxSecretRevisions(context.Context) (*int64, error) {
+	return b.limit, nil
+}
+
+func (s *serviceSuite) TestEstimateSecretBackendCapacity(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+
+	config := map[string]any{"endpoint": "http://vault"}
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "backend-uuid"}).Return(&secretbackend.SecretBackend{
+		ID:          "backend-uuid",
+		Name:        "myvault",
+		BackendType: vault.BackendType,
+		Config:      config,
+		NumSecrets:  25,
+	}, nil)
+	s.mockRegistry.EXPECT().NewBackend(&provider.ModelBackendConfig{
+		BackendConfig: provider.BackendConfig{
+			BackendType: vault.BackendType,
+			Config:      config,
+		},
+	}).Return(backendWithCapacity{SecretsBackend: s.mockSecretProvider, limit: ptr(int64(100))}, nil)
+
+	result, err := svc.EstimateSecretBackendCapacity(context.Background(), "backend-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, BackendCapacityEstimate{
+		CurrentSecretCount:         25,
+		CurrentRevisionCount:       25,
+		EstimatedRemainingCapacity: ptr(int64(75)),
+		PercentUsed:                25,
+	})
+}
+
+func (s *serviceSuite) TestEstimateSecretBackendCapacityNoLimit(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+
+	config := map[string]any{"endpoint": "http://vault"}
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: "backend-uuid"}).Return(&secretbackend.SecretBackend{
+		ID:          "backend-uuid",
+		Name:        "myvault",
+		BackendType: vault.BackendType,
+		Config:      config,
+		NumSecrets:  25,
+	}, nil)
+	s.mockRegistry.EXPECT().NewBackend(gomock.Any()).Return(s.mockSecretProvider, nil)
+
+	result, err := svc.EstimateSecretBackendCapacity(context.Background(), "backend-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, BackendCapacityEstimate{
+		CurrentSecretCount:   25,
+		CurrentRevisionCount: 25,
+	})
+}
+
+func (s *serviceSuite) TestEstimateSecretBackendCapacityBuiltIn(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(
+		s.mockState, s.logger, s.clock,
+		func(backendType string) (provider.SecretBackendProvider, error) {
+			return s.mockRegistry, nil
+		},
+	)
+
+	s.mockState.EXPECT().GetSecretBackend(gomock.Any(), secretbackend.BackendIdentifier{ID: jujuBackendID}).Return(&secretbackend.SecretBackend{
+		ID:          jujuBackendID,
+		Name:        juju.BackendName,
+		BackendType: juju.BackendType,
+		NumSecrets:  3,
+	}, nil)
+
+	result, err := svc.EstimateSecretBackendCapacity(context.Background(), jujuBackendID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, BackendCapacityEstimate{
+		CurrentSecretCount:   3,
+		CurrentRevisionCount: 3,
+	})
+}
+
+func (s *serviceSuite) TestSetSecretBackendAuditLevel(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().SetSecretBackendAuditLevel(gomock.Any(), "backend-uuid", secretbackend.AuditLevelReadsOnly).Return(nil)
+
+	err := svc.SetSecretBackendAuditLevel(context.Background(), "backend-uuid", secretbackend.AuditLevelReadsOnly)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetSecretBackendAuditLevelNotValid(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	err := svc.SetSecretBackendAuditLevel(context.Background(), "backend-uuid", "writes-only")
+	c.Assert(err, jc.ErrorIs, secretbackenderrors.NotValid)
+}
+
+func (s *serviceSuite) TestGetSecretBackendAuditLevel(c *gc.C) {
+	ctrl := s.setupMocks(c)
+	defer ctrl.Finish()
+
+	svc := newService(s.mockState, s.logger, s.clock, nil)
+
+	s.mockState.EXPECT().GetSecretBackendAuditLevel(gomock.Any(), "backend-uuid").Return(secretbackend.AuditLevelAll, nil)
+
+	level, err := svc.GetSecretBackendAuditLevel(context.Background(), "backend-uuid")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(level, gc.Equals, secretbackend.AuditLevelAll)
+}
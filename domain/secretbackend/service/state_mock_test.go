@@ -277,6 +277,45 @@ func (c *MockStateGetSecretBackendCall) DoAndReturn(f func(context.Context, secr
 	return c
 }
 
+// GetSecretBackendAuditLevel mocks base method.
+func (m *MockState) GetSecretBackendAuditLevel(arg0 context.Context, arg1 string) (secretbackend.AuditLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretBackendAuditLevel", arg0, arg1)
+	ret0, _ := ret[0].(secretbackend.AuditLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretBackendAuditLevel indicates an expected call of GetSecretBackendAuditLevel.
+func (mr *MockStateMockRecorder) GetSecretBackendAuditLevel(arg0, arg1 any) *MockStateGetSecretBackendAuditLevelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretBackendAuditLevel", reflect.TypeOf((*MockState)(nil).GetSecretBackendAuditLevel), arg0, arg1)
+	return &MockStateGetSecretBackendAuditLevelCall{Call: call}
+}
+
+// MockStateGetSecretBackendAuditLevelCall wrap *gomock.Call
+type MockStateGetSecretBackendAuditLevelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetSecretBackendAuditLevelCall) Return(arg0 secretbackend.AuditLevel, arg1 error) *MockStateGetSecretBackendAuditLevelCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetSecretBackendAuditLevelCall) Do(f func(context.Context, string) (secretbackend.AuditLevel, error)) *MockStateGetSecretBackendAuditLevelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetSecretBackendAuditLevelCall) DoAndReturn(f func(context.Context, string) (secretbackend.AuditLevel, error)) *MockStateGetSecretBackendAuditLevelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetSecretBackendRotateChanges mocks base method.
 func (m *MockState) GetSecretBackendRotateChanges(arg0 context.Context, arg1 ...string) ([]watcher.SecretBackendRotateChange, error) {
 	m.ctrl.T.Helper()
@@ -321,6 +360,44 @@ func (c *MockStateGetSecretBackendRotateChangesCall) DoAndReturn(f func(context.
 	return c
 }
 
+// ImportSecretBackends mocks base method.
+func (m *MockState) ImportSecretBackends(arg0 context.Context, arg1 model.UUID, arg2 []secretbackend.CreateSecretBackendParams, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportSecretBackends", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportSecretBackends indicates an expected call of ImportSecretBackends.
+func (mr *MockStateMockRecorder) ImportSecretBackends(arg0, arg1, arg2, arg3 any) *MockStateImportSecretBackendsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportSecretBackends", reflect.TypeOf((*MockState)(nil).ImportSecretBackends), arg0, arg1, arg2, arg3)
+	return &MockStateImportSecretBackendsCall{Call: call}
+}
+
+// MockStateImportSecretBackendsCall wrap *gomock.Call
+type MockStateImportSecretBackendsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateImportSecretBackendsCall) Return(arg0 error) *MockStateImportSecretBackendsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateImportSecretBackendsCall) Do(f func(context.Context, model.UUID, []secretbackend.CreateSecretBackendParams, string) error) *MockStateImportSecretBackendsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateImportSecretBackendsCall) DoAndReturn(f func(context.Context, model.UUID, []secretbackend.CreateSecretBackendParams, string) error) *MockStateImportSecretBackendsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // InitialWatchStatementForSecretBackendRotationChanges mocks base method.
 func (m *MockState) InitialWatchStatementForSecretBackendRotationChanges() (string, string) {
 	m.ctrl.T.Helper()
@@ -553,6 +630,44 @@ func (c *MockStateSetModelSecretBackendCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// SetSecretBackendAuditLevel mocks base method.
+func (m *MockState) SetSecretBackendAuditLevel(arg0 context.Context, arg1 string, arg2 secretbackend.AuditLevel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSecretBackendAuditLevel", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSecretBackendAuditLevel indicates an expected call of SetSecretBackendAuditLevel.
+func (mr *MockStateMockRecorder) SetSecretBackendAuditLevel(arg0, arg1, arg2 any) *MockStateSetSecretBackendAuditLevelCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSecretBackendAuditLevel", reflect.TypeOf((*MockState)(nil).SetSecretBackendAuditLevel), arg0, arg1, arg2)
+	return &MockStateSetSecretBackendAuditLevelCall{Call: call}
+}
+
+// MockStateSetSecretBackendAuditLevelCall wrap *gomock.Call
+type MockStateSetSecretBackendAuditLevelCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetSecretBackendAuditLevelCall) Return(arg0 error) *MockStateSetSecretBackendAuditLevelCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetSecretBackendAuditLevelCall) Do(f func(context.Context, string, secretbackend.AuditLevel) error) *MockStateSetSecretBackendAuditLevelCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetSecretBackendAuditLevelCall) DoAndReturn(f func(context.Context, string, secretbackend.AuditLevel) error) *MockStateSetSecretBackendAuditLevelCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateSecretBackend mocks base method.
 func (m *MockState) UpdateSecretBackend(arg0 context.Context, arg1 secretbackend.UpdateSecretBackendParams) (string, error) {
 	m.ctrl.T.Helper()
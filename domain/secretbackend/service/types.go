@@ -38,3 +38,20 @@ type RevisionInfo struct {
 	Revision int
 	ValueRef *coresecrets.ValueRef
 }
+
+// BackendCapacityEstimate describes how much of a secret backend's
+// storage is in use.
+type BackendCapacityEstimate struct {
+	// CurrentSecretCount is the number of secrets stored in the backend,
+	// counted the same way as for secret backend summaries.
+	CurrentSecretCount int
+	// CurrentRevisionCount is the number of secret revisions
+	// stored in the backend.
+	CurrentRevisionCount int
+	// EstimatedRemainingCapacity is the number of further secret
+	// revisions the backend can store, or nil if it has no hard limit.
+	EstimatedRemainingCapacity *int64
+	// PercentUsed is the percentage of the backend's hard limit in use,
+	// or 0 if it has no hard limit.
+	PercentUsed float64
+}
@@ -782,6 +782,93 @@ WHERE uuid = $M.uuid`, sqlair.M{}, SecretBackendRotationRow{})
 	return err
 }
 
+// SetSecretBackendAuditLevel sets the audit level of the secret backend,
+// returning an error satisfying [secretbackenderrors.NotFound] if the backend
+// does not exist, or [secretbackenderrors.Forbidden] if the backend is one of
+// the immutable built-in backends.
+func (s *State) SetSecretBackendAuditLevel(ctx context.Context, backendID string, level secretbackend.AuditLevel) error {
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	getStmt, err := s.Prepare(`
+SELECT uuid AS &secretBackendAuditLevel.uuid
+FROM secret_backend
+WHERE uuid = $secretBackendAuditLevel.uuid`, secretBackendAuditLevel{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	updateStmt, err := s.Prepare(`
+UPDATE secret_backend
+SET audit_level_id = (
+    SELECT id FROM secret_backend_audit_level
+    WHERE level = $secretBackendAuditLevel.level
+)
+WHERE uuid = $secretBackendAuditLevel.uuid`, secretBackendAuditLevel{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	input := secretBackendAuditLevel{ID: backendID, Level: string(level)}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		var existing secretBackendAuditLevel
+		err := tx.Query(ctx, getStmt, input).Get(&existing)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("%w: %q", secretbackenderrors.NotFound, backendID)
+		}
+		if err != nil {
+			return fmt.Errorf("checking if secret backend %q exists: %w", backendID, err)
+		}
+
+		err = tx.Query(ctx, updateStmt, input).Run()
+		if database.IsErrConstraintTrigger(err) {
+			return fmt.Errorf("%w: %q is immutable", secretbackenderrors.Forbidden, backendID)
+		}
+		if database.IsErrConstraintNotNull(err) {
+			return fmt.Errorf("%w: audit level %q", secretbackenderrors.NotValid, level)
+		}
+		if err != nil {
+			return fmt.Errorf("updating audit level for secret backend %q: %w", backendID, err)
+		}
+		return nil
+	})
+}
+
+// GetSecretBackendAuditLevel returns the audit level of the secret backend,
+// returning an error satisfying [secretbackenderrors.NotFound] if the backend
+// does not exist.
+func (s *State) GetSecretBackendAuditLevel(ctx context.Context, backendID string) (secretbackend.AuditLevel, error) {
+	db, err := s.DB()
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	stmt, err := s.Prepare(`
+SELECT b.uuid AS &secretBackendAuditLevel.uuid,
+       l.level AS &secretBackendAuditLevel.level
+FROM secret_backend AS b
+JOIN secret_backend_audit_level AS l ON l.id = b.audit_level_id
+WHERE b.uuid = $secretBackendAuditLevel.uuid`, secretBackendAuditLevel{})
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+
+	result := secretBackendAuditLevel{ID: backendID}
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, result).Get(&result)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("%w: %q", secretbackenderrors.NotFound, backendID)
+		}
+		if err != nil {
+			return fmt.Errorf("getting audit level for secret backend %q: %w", backendID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return secretbackend.AuditLevel(result.Level), nil
+}
+
 // SetModelSecretBackend sets the secret backend for the given model,
 // returning an error satisfying [secretbackenderrors.NotFound] if the backend provided does not exist,
 // returning an error satisfying [modelerrors.NotFound] if the model provided does not exist.
@@ -790,7 +877,12 @@ func (s *State) SetModelSecretBackend(ctx context.Context, modelUUID coremodel.U
 	if err != nil {
 		return errors.Trace(err)
 	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		return s.setModelSecretBackend(ctx, tx, modelUUID, secretBackendName)
+	})
+}
 
+func (s *State) setModelSecretBackend(ctx context.Context, tx *sqlair.TX, modelUUID coremodel.UUID, secretBackendName string) error {
 	backendInfo := ModelSecretBackend{
 		ModelID:           modelUUID,
 		SecretBackendName: secretBackendName,
@@ -815,30 +907,83 @@ WHERE  model_uuid = $ModelSecretBackend.uuid`
 		return errors.Trace(err)
 	}
 
-	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
-		err = tx.Query(ctx, secretBackendSelectStmt, backendInfo).Get(&backendInfo)
-		if errors.Is(err, sql.ErrNoRows) {
-			return fmt.Errorf("cannot get secret backend %q: %w", backendInfo.SecretBackendName, secretbackenderrors.NotFound)
-		}
-		if err != nil {
-			return fmt.Errorf("cannot get secret backend %q: %w", backendInfo.SecretBackendName, err)
-		}
+	err = tx.Query(ctx, secretBackendSelectStmt, backendInfo).Get(&backendInfo)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("cannot get secret backend %q: %w", backendInfo.SecretBackendName, secretbackenderrors.NotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot get secret backend %q: %w", backendInfo.SecretBackendName, err)
+	}
 
-		var outcome sqlair.Outcome
-		err = tx.Query(ctx, modelBackendUpdateStmt, backendInfo).Get(&outcome)
-		if err != nil {
-			return fmt.Errorf("setting secret backend %q for model %q: %w", backendInfo.SecretBackendName, modelUUID, err)
-		}
-		affected, err := outcome.Result().RowsAffected()
-		if err != nil {
+	var outcome sqlair.Outcome
+	err = tx.Query(ctx, modelBackendUpdateStmt, backendInfo).Get(&outcome)
+	if err != nil {
+		return fmt.Errorf("setting secret backend %q for model %q: %w", backendInfo.SecretBackendName, modelUUID, err)
+	}
+	affected, err := outcome.Result().RowsAffected()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("cannot set secret backend %q for model %q: %w",
+			backendInfo.SecretBackendName, backendInfo.ModelID, modelerrors.NotFound,
+		)
+	}
+	return nil
+}
+
+// ImportSecretBackends creates the supplied secret backends and sets the
+// named backend for the given model, all in a single transaction.
+// A backend which already exists with the same ID and name is left as is.
+// If a backend's name is used by a different backend, or its ID is used by a
+// backend with a different name, an error satisfying
+// [secretbackenderrors.AlreadyExists] is returned and nothing is written.
+func (s *State) ImportSecretBackends(
+	ctx context.Context, modelUUID coremodel.UUID, backends []secretbackend.CreateSecretBackendParams, modelBackendName string,
+) error {
+	for _, b := range backends {
+		if err := b.Validate(); err != nil {
 			return errors.Trace(err)
 		}
-		if affected == 0 {
-			return fmt.Errorf("cannot set secret backend %q for model %q: %w",
-				backendInfo.SecretBackendName, backendInfo.ModelID, modelerrors.NotFound,
-			)
+	}
+
+	db, err := s.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	return db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		for _, b := range backends {
+			existing, err := s.getSecretBackend(ctx, tx, secretbackend.BackendIdentifier{ID: b.ID})
+			if err == nil {
+				if existing.Name != b.Name {
+					return fmt.Errorf("importing secret backend %q: ID %q used by secret backend %q%w",
+						b.Name, b.ID, existing.Name, errors.Hide(secretbackenderrors.AlreadyExists))
+				}
+				continue
+			} else if !errors.Is(err, secretbackenderrors.NotFound) {
+				return fmt.Errorf("importing secret backend %q: %w", b.Name, err)
+			}
+
+			existing, err = s.getSecretBackend(ctx, tx, secretbackend.BackendIdentifier{Name: b.Name})
+			if err == nil {
+				return fmt.Errorf("importing secret backend %q: name used by secret backend with ID %q%w",
+					b.Name, existing.ID, errors.Hide(secretbackenderrors.AlreadyExists))
+			} else if !errors.Is(err, secretbackenderrors.NotFound) {
+				return fmt.Errorf("importing secret backend %q: %w", b.Name, err)
+			}
+
+			if _, err := s.upsertSecretBackend(ctx, tx, upsertSecretBackendParams{
+				ID:                  b.ID,
+				Name:                b.Name,
+				BackendType:         b.BackendType,
+				TokenRotateInterval: b.TokenRotateInterval,
+				NextRotateTime:      b.NextRotateTime,
+				Config:              b.Config,
+			}); err != nil {
+				return fmt.Errorf("importing secret backend %q: %w", b.Name, err)
+			}
 		}
-		return nil
+		return s.setModelSecretBackend(ctx, tx, modelUUID, modelBackendName)
 	})
 }
 
@@ -1566,6 +1566,91 @@ func (s *stateSuite) TestSetModelSecretBackendBackendNotFound(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `cannot get secret backend "non-existing-backend-name": secret backend not found`)
 }
 
+func (s *stateSuite) TestImportSecretBackends(c *gc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	backendID := uuid.MustNewUUID().String()
+	rotateInterval := 24 * time.Hour
+	nextRotateTime := time.Now().Add(time.Hour).UTC()
+	err := s.state.ImportSecretBackends(context.Background(), modelUUID, []secretbackend.CreateSecretBackendParams{{
+		// Shared with the source controller, so left as is.
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   s.vaultBackendID,
+			Name: "my-backend",
+		},
+		BackendType: "vault",
+	}, {
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "imported-backend",
+		},
+		BackendType:         "vault",
+		TokenRotateInterval: &rotateInterval,
+		NextRotateTime:      &nextRotateTime,
+		Config: map[string]string{
+			"endpoint": "http://vault",
+		},
+	}}, "imported-backend")
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.assertSecretBackend(c, secretbackend.SecretBackend{
+		ID:                  backendID,
+		Name:                "imported-backend",
+		BackendType:         "vault",
+		TokenRotateInterval: &rotateInterval,
+		Config: map[string]any{
+			"endpoint": "http://vault",
+		},
+	}, &nextRotateTime)
+
+	row := s.DB().QueryRow(`
+SELECT secret_backend_uuid
+FROM model_secret_backend
+WHERE model_uuid = ?`, modelUUID)
+	var actualBackendID string
+	err = row.Scan(&actualBackendID)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(actualBackendID, gc.Equals, backendID)
+}
+
+func (s *stateSuite) TestImportSecretBackendsNameCollision(c *gc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	backendID := uuid.MustNewUUID().String()
+	err := s.state.ImportSecretBackends(context.Background(), modelUUID, []secretbackend.CreateSecretBackendParams{{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "imported-backend",
+		},
+		BackendType: "vault",
+	}, {
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   uuid.MustNewUUID().String(),
+			Name: "my-backend",
+		},
+		BackendType: "vault",
+	}}, "imported-backend")
+	c.Assert(err, jc.ErrorIs, backenderrors.AlreadyExists)
+	c.Assert(err, gc.ErrorMatches, `importing secret backend "my-backend": name used by secret backend with ID ".*"`)
+
+	// Nothing is written if any backend can't be imported.
+	_, err = s.state.GetSecretBackend(context.Background(), secretbackend.BackendIdentifier{ID: backendID})
+	c.Assert(err, jc.ErrorIs, backenderrors.NotFound)
+}
+
+func (s *stateSuite) TestImportSecretBackendsIDCollision(c *gc.C) {
+	modelUUID := s.createModel(c, coremodel.IAAS)
+
+	err := s.state.ImportSecretBackends(context.Background(), modelUUID, []secretbackend.CreateSecretBackendParams{{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   s.vaultBackendID,
+			Name: "other-backend",
+		},
+		BackendType: "vault",
+	}}, "other-backend")
+	c.Assert(err, jc.ErrorIs, backenderrors.AlreadyExists)
+}
+
 func (s *stateSuite) TestSetModelSecretBackendModelNotFound(c *gc.C) {
 	backendID := uuid.MustNewUUID().String()
 	result, err := s.state.CreateSecretBackend(context.Background(), secretbackend.CreateSecretBackendParams{
@@ -1767,3 +1852,65 @@ func (s *stateSuite) TestGetSecretBackendRotateChanges(c *gc.C) {
 	c.Assert(changes[1].Name, gc.Equals, "my-backend2")
 	c.Assert(changes[1].NextTriggerTime.Equal(nextRotateTime2), jc.IsTrue)
 }
+
+func (s *stateSuite) TestSetSecretBackendAuditLevel(c *gc.C) {
+	backendID := uuid.MustNewUUID().String()
+	_, err := s.state.CreateSecretBackend(context.Background(), secretbackend.CreateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "my-backend",
+		},
+		BackendType: "vault",
+	})
+	c.Assert(err, gc.IsNil)
+
+	level, err := s.state.GetSecretBackendAuditLevel(context.Background(), backendID)
+	c.Assert(err, gc.IsNil)
+	c.Check(level, gc.Equals, secretbackend.AuditLevelNone)
+
+	err = s.state.SetSecretBackendAuditLevel(context.Background(), backendID, secretbackend.AuditLevelReadsOnly)
+	c.Assert(err, gc.IsNil)
+
+	level, err = s.state.GetSecretBackendAuditLevel(context.Background(), backendID)
+	c.Assert(err, gc.IsNil)
+	c.Check(level, gc.Equals, secretbackend.AuditLevelReadsOnly)
+}
+
+func (s *stateSuite) TestSetSecretBackendAuditLevelNotValid(c *gc.C) {
+	backendID := uuid.MustNewUUID().String()
+	_, err := s.state.CreateSecretBackend(context.Background(), secretbackend.CreateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "my-backend",
+		},
+		BackendType: "vault",
+	})
+	c.Assert(err, gc.IsNil)
+
+	err = s.state.SetSecretBackendAuditLevel(context.Background(), backendID, "writes-only")
+	c.Assert(err, jc.ErrorIs, backenderrors.NotValid)
+}
+
+func (s *stateSuite) TestSetSecretBackendAuditLevelFailedForInternalBackend(c *gc.C) {
+	backendID := uuid.MustNewUUID().String()
+	_, err := s.state.CreateSecretBackend(context.Background(), secretbackend.CreateSecretBackendParams{
+		BackendIdentifier: secretbackend.BackendIdentifier{
+			ID:   backendID,
+			Name: "my-backend",
+		},
+		BackendType: "controller",
+	})
+	c.Assert(err, gc.IsNil)
+
+	err = s.state.SetSecretBackendAuditLevel(context.Background(), backendID, secretbackend.AuditLevelAll)
+	c.Assert(err, jc.ErrorIs, backenderrors.Forbidden)
+	c.Assert(err, gc.ErrorMatches, fmt.Sprintf(`secret backend operation forbidden: %q is immutable`, backendID))
+}
+
+func (s *stateSuite) TestSecretBackendAuditLevelNotFound(c *gc.C) {
+	err := s.state.SetSecretBackendAuditLevel(context.Background(), "unknown", secretbackend.AuditLevelAll)
+	c.Check(err, jc.ErrorIs, backenderrors.NotFound)
+
+	_, err = s.state.GetSecretBackendAuditLevel(context.Background(), "unknown")
+	c.Check(err, jc.ErrorIs, backenderrors.NotFound)
+}
@@ -118,6 +118,14 @@ type SecretBackend struct {
 	TokenRotateInterval database.NullDuration `db:"token_rotate_interval"`
 }
 
+// secretBackendAuditLevel represents the audit level of a secret backend.
+type secretBackendAuditLevel struct {
+	// ID is the unique identifier for the secret backend.
+	ID string `db:"uuid"`
+	// Level is the name of the audit level.
+	Level string `db:"level"`
+}
+
 // SecretBackendRotation represents a single row from the state database's
 // secret_backend_rotation table.
 type SecretBackendRotation struct {
@@ -4,7 +4,10 @@
 package secretbackend
 
 import (
+	"fmt"
+
 	coremodel "github.com/juju/juju/core/model"
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
 )
 
 // ModelSecretBackend represents a set of data about a model and its secret backend config.
@@ -22,3 +25,26 @@ type ModelSecretBackend struct {
 	// SecretBackendName is the name of the secret backend configured for the model.
 	SecretBackendName string
 }
+
+// AuditLevel describes which accesses to the secrets stored in a backend are
+// recorded in the audit log.
+type AuditLevel string
+
+const (
+	// AuditLevelNone means no access is recorded.
+	AuditLevelNone AuditLevel = "none"
+	// AuditLevelReadsOnly means only reads of secret content are recorded.
+	AuditLevelReadsOnly AuditLevel = "reads-only"
+	// AuditLevelAll means all reads and writes of secret content are recorded.
+	AuditLevelAll AuditLevel = "all"
+)
+
+// Validate returns an error satisfying [backenderrors.NotValid] if the audit
+// level is not one of the known levels.
+func (l AuditLevel) Validate() error {
+	switch l {
+	case AuditLevelNone, AuditLevelReadsOnly, AuditLevelAll:
+		return nil
+	}
+	return fmt.Errorf("%w: audit level %q", backenderrors.NotValid, l)
+}
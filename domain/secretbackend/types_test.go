@@ -0,0 +1,25 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretbackend
+
+import (
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	backenderrors "github.com/juju/juju/domain/secretbackend/errors"
+)
+
+type typesSuite struct{}
+
+var _ = gc.Suite(&typesSuite{})
+
+func (s *typesSuite) TestAuditLevelValidate(c *gc.C) {
+	for _, level := range []AuditLevel{AuditLevelNone, AuditLevelReadsOnly, AuditLevelAll} {
+		c.Check(level.Validate(), jc.ErrorIsNil, gc.Commentf("level %q", level))
+	}
+
+	err := AuditLevel("writes-only").Validate()
+	c.Check(err, jc.ErrorIs, backenderrors.NotValid)
+	c.Check(err, gc.ErrorMatches, `secret backend not valid: audit level "writes-only"`)
+}
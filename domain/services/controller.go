@@ -155,8 +155,11 @@ func (s *ControllerServices) Flag() *flagservice.Service {
 
 // Access returns the access service, this includes users and permissions.
 func (s *ControllerServices) Access() *accessservice.Service {
+	log := s.logger.Child("access")
+
 	return accessservice.NewService(
-		accessstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), s.logger.Child("access")),
+		accessstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
+		log,
 	)
 }
 
@@ -8,6 +8,7 @@ import (
 	"net/url"
 
 	"github.com/juju/clock"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/juju/juju/core/changestream"
 	"github.com/juju/juju/core/lease"
@@ -54,6 +55,8 @@ import (
 	portservice "github.com/juju/juju/domain/port/service"
 	portstate "github.com/juju/juju/domain/port/state"
 	proxy "github.com/juju/juju/domain/proxy/service"
+	relationservice "github.com/juju/juju/domain/relation/service"
+	relationstate "github.com/juju/juju/domain/relation/state"
 	resourceservice "github.com/juju/juju/domain/resource/service"
 	resourcestate "github.com/juju/juju/domain/resource/state"
 	secretservice "github.com/juju/juju/domain/secret/service"
@@ -94,6 +97,9 @@ type ModelServices struct {
 	storageRegistry   corestorage.ModelStorageRegistryGetter
 	publicKeyImporter PublicKeyImporter
 	leaseManager      lease.ModelLeaseManagerGetter
+
+	secretBackendFailures *secretservice.BackendFailureCache
+	hardwareRefreshes     *singleflight.Group
 }
 
 // NewModelServices returns a new registry which uses the provided modelDB
@@ -107,6 +113,8 @@ func NewModelServices(
 	storageRegistry corestorage.ModelStorageRegistryGetter,
 	publicKeyImporter PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	secretBackendFailures *secretservice.BackendFailureCache,
+	hardwareRefreshes *singleflight.Group,
 	clock clock.Clock,
 	logger logger.Logger,
 ) *ModelServices {
@@ -125,6 +133,9 @@ func NewModelServices(
 		storageRegistry:   storageRegistry,
 		publicKeyImporter: publicKeyImporter,
 		leaseManager:      leaseManager,
+
+		secretBackendFailures: secretBackendFailures,
+		hardwareRefreshes:     hardwareRefreshes,
 	}
 }
 
@@ -154,12 +165,19 @@ func (s *ModelServices) Config() *modelconfigservice.WatchableService {
 	)
 }
 
-// Machine returns the model's machine service.
+// Machine returns the model's machine service. Refreshes of hardware
+// characteristics from the provider are shared across all the machine services
+// of the controller.
 func (s *ModelServices) Machine() *machineservice.WatchableService {
 	return machineservice.NewWatchableService(
 		machinestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), s.logger.Child("machine")),
 		s.modelWatcherFactory("machine"),
 		providertracker.ProviderRunner[machineservice.Provider](s.providerFactory, s.modelUUID.String()),
+		providertracker.ProviderRunner[machineservice.HardwareCharacteristicsProvider](s.providerFactory, s.modelUUID.String()),
+		providertracker.ProviderRunner[machineservice.InstanceTagsProvider](s.providerFactory, s.modelUUID.String()),
+		s.hardwareRefreshes,
+		s.clock,
+		s.logger.Child("machine"),
 	)
 }
 
@@ -249,12 +267,15 @@ func (s *ModelServices) Storage() *storageservice.Service {
 		storagestate.NewState(changestream.NewTxnRunnerFactory(s.modelDB)),
 		s.logger.Child("storage"),
 		s.storageRegistry,
+		providertracker.ProviderRunner[storageservice.VolumeProvider](s.providerFactory, s.modelUUID.String()),
 	)
 }
 
-// Secret returns the model's secret service.
+// Secret returns the model's secret service. Failures to connect to secret
+// backends are remembered across all the secret services of the controller.
 func (s *ModelServices) Secret(params secretservice.SecretServiceParams) *secretservice.WatchableService {
 	log := s.logger.Child("secret")
+	params.BackendFailures = s.secretBackendFailures
 	return secretservice.NewWatchableService(
 		secretstate.NewState(changestream.NewTxnRunnerFactory(s.modelDB), log),
 		secretbackendstate.NewState(changestream.NewTxnRunnerFactory(s.controllerDB), log),
@@ -347,6 +368,19 @@ func (s *ModelServices) BlockCommand() *blockcommandservice.Service {
 	)
 }
 
+// Relation returns the service for managing relation status.
+func (s *ModelServices) Relation() *relationservice.WatchableService {
+	return relationservice.NewWatchableService(
+		relationstate.NewState(
+			changestream.NewTxnRunnerFactory(s.modelDB),
+			s.logger.Child("relation.state"),
+		),
+		s.modelWatcherFactory("relation"),
+		s.clock,
+		s.logger.Child("relation.service"),
+	)
+}
+
 // Resource returns the service for persisting and retrieving application
 // resources for the current model.
 func (s *ModelServices) Resource() *resourceservice.Service {
@@ -11,6 +11,7 @@ import (
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/cloud"
@@ -36,6 +37,7 @@ import (
 	modelconfigbootstrap "github.com/juju/juju/domain/modelconfig/bootstrap"
 	modeldefaultsbootstrap "github.com/juju/juju/domain/modeldefaults/bootstrap"
 	schematesting "github.com/juju/juju/domain/schema/testing"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	backendbootstrap "github.com/juju/juju/domain/secretbackend/bootstrap"
 	domainservicefactory "github.com/juju/juju/domain/services"
 	domainservices "github.com/juju/juju/domain/services"
@@ -272,6 +274,8 @@ func (s *DomainServicesSuite) DomainServicesGetterWithStorageRegistry(c *gc.C, o
 			modelApplicationLeaseManagerGetter(func() lease.Checker {
 				return leaseManager
 			}),
+			secretservice.NewBackendFailureCache(clock, secretservice.DefaultBackendFailureTTL),
+			&singleflight.Group{},
 			clock,
 			logger,
 		)
@@ -28,3 +28,14 @@ const (
 	// MissingSharedStorageDirectiveError is used when a storage directive for shared storage is not provided.
 	MissingSharedStorageDirectiveError = errors.ConstError("no storage directive specified")
 )
+
+// These errors are used for volume operations.
+const (
+	// VolumeNotFound is used when a volume is not found.
+	VolumeNotFound = errors.ConstError("volume not found")
+	// VolumeAttached is used when a volume is attached to a machine.
+	VolumeAttached = errors.ConstError("volume is attached")
+	// VolumeNotOrphaned is used when a volume is still known to the
+	// provider.
+	VolumeNotOrphaned = errors.ConstError("volume is not orphaned")
+)
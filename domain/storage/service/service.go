@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
 	"github.com/juju/juju/internal/errors"
 	internalstorage "github.com/juju/juju/internal/storage"
@@ -15,21 +16,35 @@ import (
 // State defines an interface for interacting with the underlying state.
 type State interface {
 	StoragePoolState
+	VolumeState
 }
 
 // Service defines a service for interacting with the underlying state.
 type Service struct {
 	*StoragePoolService
+	*VolumeService
 }
 
 // NewService returns a new Service for interacting with the underlying state.
-func NewService(st State, logger logger.Logger, registryGetter storage.ModelStorageRegistryGetter) *Service {
+// The provider getter may be nil, in which case orphaned volumes are not
+// detected.
+func NewService(
+	st State,
+	logger logger.Logger,
+	registryGetter storage.ModelStorageRegistryGetter,
+	providerGetter providertracker.ProviderGetter[VolumeProvider],
+) *Service {
 	return &Service{
 		StoragePoolService: &StoragePoolService{
 			st:             st,
 			logger:         logger,
 			registryGetter: registryGetter,
 		},
+		VolumeService: &VolumeService{
+			st:             st,
+			providerGetter: providerGetter,
+			logger:         logger,
+		},
 	}
 }
 
@@ -53,7 +53,7 @@ func (s *serviceSuite) setupMocks(c *gc.C) *gomock.Controller {
 	s.storageRegistryGetter = NewMockModelStorageRegistryGetter(ctrl)
 	s.storageRegistry = NewMockProviderRegistry(ctrl)
 
-	s.service = NewService(s.state, logtesting.WrapCheckLog(c), s.storageRegistryGetter)
+	s.service = NewService(s.state, logtesting.WrapCheckLog(c), s.storageRegistryGetter, nil)
 
 	return ctrl
 }
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/juju/juju/domain/storage/service (interfaces: State,StoragePoolState)
+// Source: github.com/juju/juju/domain/storage/service (interfaces: State,StoragePoolState,VolumeState,VolumeProvider)
 //
 // Generated by this command:
 //
-//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/storage/service State,StoragePoolState
+//	mockgen -typed -package service -destination state_mock_test.go github.com/juju/juju/domain/storage/service State,StoragePoolState,VolumeState,VolumeProvider
 //
 
 // Package service is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	storage "github.com/juju/juju/domain/storage"
+	envcontext "github.com/juju/juju/environs/envcontext"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -155,6 +156,84 @@ func (c *MockStateGetStoragePoolByNameCall) DoAndReturn(f func(context.Context,
 	return c
 }
 
+// GetUnattachedVolumes mocks base method.
+func (m *MockState) GetUnattachedVolumes(arg0 context.Context) ([]storage.VolumeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnattachedVolumes", arg0)
+	ret0, _ := ret[0].([]storage.VolumeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnattachedVolumes indicates an expected call of GetUnattachedVolumes.
+func (mr *MockStateMockRecorder) GetUnattachedVolumes(arg0 any) *MockStateGetUnattachedVolumesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnattachedVolumes", reflect.TypeOf((*MockState)(nil).GetUnattachedVolumes), arg0)
+	return &MockStateGetUnattachedVolumesCall{Call: call}
+}
+
+// MockStateGetUnattachedVolumesCall wrap *gomock.Call
+type MockStateGetUnattachedVolumesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetUnattachedVolumesCall) Return(arg0 []storage.VolumeInfo, arg1 error) *MockStateGetUnattachedVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetUnattachedVolumesCall) Do(f func(context.Context) ([]storage.VolumeInfo, error)) *MockStateGetUnattachedVolumesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetUnattachedVolumesCall) DoAndReturn(f func(context.Context) ([]storage.VolumeInfo, error)) *MockStateGetUnattachedVolumesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetVolumeProviderIDs mocks base method.
+func (m *MockState) GetVolumeProviderIDs(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeProviderIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeProviderIDs indicates an expected call of GetVolumeProviderIDs.
+func (mr *MockStateMockRecorder) GetVolumeProviderIDs(arg0 any) *MockStateGetVolumeProviderIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeProviderIDs", reflect.TypeOf((*MockState)(nil).GetVolumeProviderIDs), arg0)
+	return &MockStateGetVolumeProviderIDsCall{Call: call}
+}
+
+// MockStateGetVolumeProviderIDsCall wrap *gomock.Call
+type MockStateGetVolumeProviderIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateGetVolumeProviderIDsCall) Return(arg0 []string, arg1 error) *MockStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateGetVolumeProviderIDsCall) Do(f func(context.Context) ([]string, error)) *MockStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateGetVolumeProviderIDsCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListStoragePools mocks base method.
 func (m *MockState) ListStoragePools(arg0 context.Context, arg1 storage.Names, arg2 storage.Providers) ([]storage.StoragePoolDetails, error) {
 	m.ctrl.T.Helper()
@@ -194,6 +273,44 @@ func (c *MockStateListStoragePoolsCall) DoAndReturn(f func(context.Context, stor
 	return c
 }
 
+// RemoveVolume mocks base method.
+func (m *MockState) RemoveVolume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVolume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVolume indicates an expected call of RemoveVolume.
+func (mr *MockStateMockRecorder) RemoveVolume(arg0, arg1 any) *MockStateRemoveVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockState)(nil).RemoveVolume), arg0, arg1)
+	return &MockStateRemoveVolumeCall{Call: call}
+}
+
+// MockStateRemoveVolumeCall wrap *gomock.Call
+type MockStateRemoveVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateRemoveVolumeCall) Return(arg0 error) *MockStateRemoveVolumeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateRemoveVolumeCall) Do(f func(context.Context, string) error) *MockStateRemoveVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateRemoveVolumeCall) DoAndReturn(f func(context.Context, string) error) *MockStateRemoveVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ReplaceStoragePool mocks base method.
 func (m *MockState) ReplaceStoragePool(arg0 context.Context, arg1 storage.StoragePoolDetails) error {
 	m.ctrl.T.Helper()
@@ -446,3 +563,204 @@ func (c *MockStoragePoolStateReplaceStoragePoolCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// MockVolumeState is a mock of VolumeState interface.
+type MockVolumeState struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeStateMockRecorder
+}
+
+// MockVolumeStateMockRecorder is the mock recorder for MockVolumeState.
+type MockVolumeStateMockRecorder struct {
+	mock *MockVolumeState
+}
+
+// NewMockVolumeState creates a new mock instance.
+func NewMockVolumeState(ctrl *gomock.Controller) *MockVolumeState {
+	mock := &MockVolumeState{ctrl: ctrl}
+	mock.recorder = &MockVolumeStateMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeState) EXPECT() *MockVolumeStateMockRecorder {
+	return m.recorder
+}
+
+// GetUnattachedVolumes mocks base method.
+func (m *MockVolumeState) GetUnattachedVolumes(arg0 context.Context) ([]storage.VolumeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnattachedVolumes", arg0)
+	ret0, _ := ret[0].([]storage.VolumeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnattachedVolumes indicates an expected call of GetUnattachedVolumes.
+func (mr *MockVolumeStateMockRecorder) GetUnattachedVolumes(arg0 any) *MockVolumeStateGetUnattachedVolumesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnattachedVolumes", reflect.TypeOf((*MockVolumeState)(nil).GetUnattachedVolumes), arg0)
+	return &MockVolumeStateGetUnattachedVolumesCall{Call: call}
+}
+
+// MockVolumeStateGetUnattachedVolumesCall wrap *gomock.Call
+type MockVolumeStateGetUnattachedVolumesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeStateGetUnattachedVolumesCall) Return(arg0 []storage.VolumeInfo, arg1 error) *MockVolumeStateGetUnattachedVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeStateGetUnattachedVolumesCall) Do(f func(context.Context) ([]storage.VolumeInfo, error)) *MockVolumeStateGetUnattachedVolumesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeStateGetUnattachedVolumesCall) DoAndReturn(f func(context.Context) ([]storage.VolumeInfo, error)) *MockVolumeStateGetUnattachedVolumesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetVolumeProviderIDs mocks base method.
+func (m *MockVolumeState) GetVolumeProviderIDs(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeProviderIDs", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeProviderIDs indicates an expected call of GetVolumeProviderIDs.
+func (mr *MockVolumeStateMockRecorder) GetVolumeProviderIDs(arg0 any) *MockVolumeStateGetVolumeProviderIDsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeProviderIDs", reflect.TypeOf((*MockVolumeState)(nil).GetVolumeProviderIDs), arg0)
+	return &MockVolumeStateGetVolumeProviderIDsCall{Call: call}
+}
+
+// MockVolumeStateGetVolumeProviderIDsCall wrap *gomock.Call
+type MockVolumeStateGetVolumeProviderIDsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeStateGetVolumeProviderIDsCall) Return(arg0 []string, arg1 error) *MockVolumeStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeStateGetVolumeProviderIDsCall) Do(f func(context.Context) ([]string, error)) *MockVolumeStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeStateGetVolumeProviderIDsCall) DoAndReturn(f func(context.Context) ([]string, error)) *MockVolumeStateGetVolumeProviderIDsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveVolume mocks base method.
+func (m *MockVolumeState) RemoveVolume(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVolume", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveVolume indicates an expected call of RemoveVolume.
+func (mr *MockVolumeStateMockRecorder) RemoveVolume(arg0, arg1 any) *MockVolumeStateRemoveVolumeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockVolumeState)(nil).RemoveVolume), arg0, arg1)
+	return &MockVolumeStateRemoveVolumeCall{Call: call}
+}
+
+// MockVolumeStateRemoveVolumeCall wrap *gomock.Call
+type MockVolumeStateRemoveVolumeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeStateRemoveVolumeCall) Return(arg0 error) *MockVolumeStateRemoveVolumeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeStateRemoveVolumeCall) Do(f func(context.Context, string) error) *MockVolumeStateRemoveVolumeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeStateRemoveVolumeCall) DoAndReturn(f func(context.Context, string) error) *MockVolumeStateRemoveVolumeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockVolumeProvider is a mock of VolumeProvider interface.
+type MockVolumeProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockVolumeProviderMockRecorder
+}
+
+// MockVolumeProviderMockRecorder is the mock recorder for MockVolumeProvider.
+type MockVolumeProviderMockRecorder struct {
+	mock *MockVolumeProvider
+}
+
+// NewMockVolumeProvider creates a new mock instance.
+func NewMockVolumeProvider(ctrl *gomock.Controller) *MockVolumeProvider {
+	mock := &MockVolumeProvider{ctrl: ctrl}
+	mock.recorder = &MockVolumeProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVolumeProvider) EXPECT() *MockVolumeProviderMockRecorder {
+	return m.recorder
+}
+
+// ListVolumes mocks base method.
+func (m *MockVolumeProvider) ListVolumes(arg0 envcontext.ProviderCallContext) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockVolumeProviderMockRecorder) ListVolumes(arg0 any) *MockVolumeProviderListVolumesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockVolumeProvider)(nil).ListVolumes), arg0)
+	return &MockVolumeProviderListVolumesCall{Call: call}
+}
+
+// MockVolumeProviderListVolumesCall wrap *gomock.Call
+type MockVolumeProviderListVolumesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockVolumeProviderListVolumesCall) Return(arg0 []string, arg1 error) *MockVolumeProviderListVolumesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockVolumeProviderListVolumesCall) Do(f func(envcontext.ProviderCallContext) ([]string, error)) *MockVolumeProviderListVolumesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockVolumeProviderListVolumesCall) DoAndReturn(f func(envcontext.ProviderCallContext) ([]string, error)) *MockVolumeProviderListVolumesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/juju/collections/set"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/logger"
+	"github.com/juju/juju/core/providertracker"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	"github.com/juju/juju/environs/envcontext"
+)
+
+// VolumeState defines an interface for interacting with the storage volumes
+// in the underlying state.
+type VolumeState interface {
+	// GetUnattachedVolumes returns the alive, provisioned volumes in the
+	// model which have no attachment to any machine.
+	GetUnattachedVolumes(ctx context.Context) ([]domainstorage.VolumeInfo, error)
+	// GetVolumeProviderIDs returns the provider IDs of all the volumes
+	// recorded in the model.
+	GetVolumeProviderIDs(ctx context.Context) ([]string, error)
+	// RemoveVolume removes the record of the unattached volume with the
+	// input UUID from the model.
+	RemoveVolume(ctx context.Context, volumeUUID string) error
+}
+
+// VolumeProvider represents an underlying cloud provider that can list the
+// volumes it holds for the model.
+type VolumeProvider interface {
+	// ListVolumes returns the provider IDs of the volumes in the model.
+	ListVolumes(ctx envcontext.ProviderCallContext) ([]string, error)
+}
+
+// VolumeService defines a service for inspecting the volumes in the model
+// alongside those held by the provider.
+type VolumeService struct {
+	st             VolumeState
+	providerGetter providertracker.ProviderGetter[VolumeProvider]
+	logger         logger.Logger
+}
+
+// ListUnattachedVolumes returns the volumes which are not attached to any
+// machine. These are the alive, provisioned volumes recorded in the model
+// without an attachment, and the volumes reported by the provider which have
+// no record in the model at all.
+// A volume is flagged as orphaned if it is recorded in the model but not
+// reported by the provider, or reported by the provider but not recorded in
+// the model. If the provider can't list its volumes, only the volumes
+// recorded in the model are returned, and none are flagged.
+func (s *VolumeService) ListUnattachedVolumes(ctx context.Context) ([]domainstorage.VolumeInfo, error) {
+	volumes, err := s.st.GetUnattachedVolumes(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	providerIDs, err := s.listProviderVolumes(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		s.logger.Debugf("provider can't list volumes, orphaned volumes will not be detected")
+		return volumes, nil
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	for i, volume := range volumes {
+		volumes[i].Orphaned = !providerIDs.Contains(volume.ProviderID)
+	}
+
+	knownIDs, err := s.st.GetVolumeProviderIDs(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	for _, providerID := range providerIDs.Difference(set.NewStrings(knownIDs...)).SortedValues() {
+		volumes = append(volumes, domainstorage.VolumeInfo{
+			ProviderID: providerID,
+			Orphaned:   true,
+		})
+	}
+	return volumes, nil
+}
+
+// RemoveOrphanedVolume removes the record of the orphaned volume with the
+// input UUID from the model. Only the model's record is removed; nothing is
+// destroyed in the provider. The following errors may be returned:
+// - [storageerrors.VolumeNotFound] if the volume does not exist, or is not
+// an unattached, provisioned volume.
+// - [storageerrors.VolumeNotOrphaned] if the provider still reports the
+// volume, or can't list its volumes.
+// - [storageerrors.VolumeAttached] if the volume was attached to a machine
+// in the meantime.
+func (s *VolumeService) RemoveOrphanedVolume(ctx context.Context, volumeUUID string) error {
+	if volumeUUID == "" {
+		return errors.NotValidf("empty volume UUID")
+	}
+
+	volumes, err := s.st.GetUnattachedVolumes(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var providerID string
+	for _, volume := range volumes {
+		if volume.UUID == volumeUUID {
+			providerID = volume.ProviderID
+			break
+		}
+	}
+	if providerID == "" {
+		return fmt.Errorf("unattached volume %q %w", volumeUUID, storageerrors.VolumeNotFound)
+	}
+
+	// Without the provider's volumes, removing the record could leak a
+	// volume which still exists in the cloud.
+	providerIDs, err := s.listProviderVolumes(ctx)
+	if errors.Is(err, errors.NotSupported) {
+		return fmt.Errorf("volume %q %w: provider can't list volumes", volumeUUID, storageerrors.VolumeNotOrphaned)
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+	if providerIDs.Contains(providerID) {
+		return fmt.Errorf("volume %q %w: provider volume %q exists", volumeUUID, storageerrors.VolumeNotOrphaned, providerID)
+	}
+
+	return errors.Trace(s.st.RemoveVolume(ctx, volumeUUID))
+}
+
+// listProviderVolumes returns the provider IDs of the volumes held by the
+// provider for the model. It returns an error satisfying
+// [errors.NotSupported] if the provider can't list them.
+func (s *VolumeService) listProviderVolumes(ctx context.Context) (set.Strings, error) {
+	if s.providerGetter == nil {
+		return nil, errors.NotSupportedf("volume provider")
+	}
+	provider, err := s.providerGetter(ctx)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	ids, err := provider.ListVolumes(envcontext.WithoutCredentialInvalidator(ctx))
+	if err != nil {
+		return nil, errors.Annotate(err, "listing provider volumes")
+	}
+	return set.NewStrings(ids...), nil
+}
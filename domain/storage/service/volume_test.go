@@ -0,0 +1,166 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type volumeServiceSuite struct {
+	testing.IsolationSuite
+
+	state    *MockVolumeState
+	provider *MockVolumeProvider
+}
+
+var _ = gc.Suite(&volumeServiceSuite{})
+
+func (s *volumeServiceSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+
+	s.state = NewMockVolumeState(ctrl)
+	s.provider = NewMockVolumeProvider(ctrl)
+
+	return ctrl
+}
+
+func (s *volumeServiceSuite) service(c *gc.C) *VolumeService {
+	return &VolumeService{
+		st: s.state,
+		providerGetter: func(context.Context) (VolumeProvider, error) {
+			return s.provider, nil
+		},
+		logger: loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *volumeServiceSuite) unsupportedService(c *gc.C) *VolumeService {
+	return &VolumeService{
+		st: s.state,
+		providerGetter: func(context.Context) (VolumeProvider, error) {
+			return nil, errors.NotSupportedf("provider type")
+		},
+		logger: loggertesting.WrapCheckLog(c),
+	}
+}
+
+func (s *volumeServiceSuite) TestListUnattachedVolumes(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return([]domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		Name:       "0",
+		ProviderID: "provider-0",
+	}, {
+		UUID:       "vol-1",
+		Name:       "1",
+		ProviderID: "provider-1",
+	}}, nil)
+	s.provider.EXPECT().ListVolumes(gomock.Any()).Return([]string{"provider-0", "provider-2", "provider-3"}, nil)
+	s.state.EXPECT().GetVolumeProviderIDs(gomock.Any()).Return([]string{"provider-0", "provider-1", "provider-2"}, nil)
+
+	result, err := s.service(c).ListUnattachedVolumes(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		Name:       "0",
+		ProviderID: "provider-0",
+	}, {
+		UUID:       "vol-1",
+		Name:       "1",
+		ProviderID: "provider-1",
+		Orphaned:   true,
+	}, {
+		ProviderID: "provider-3",
+		Orphaned:   true,
+	}})
+}
+
+func (s *volumeServiceSuite) TestListUnattachedVolumesProviderNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	volumes := []domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		Name:       "0",
+		ProviderID: "provider-0",
+	}}
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return(volumes, nil)
+
+	result, err := s.unsupportedService(c).ListUnattachedVolumes(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, volumes)
+}
+
+func (s *volumeServiceSuite) TestListUnattachedVolumesProviderError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return(nil, nil)
+	s.provider.EXPECT().ListVolumes(gomock.Any()).Return(nil, errors.New("boom"))
+
+	_, err := s.service(c).ListUnattachedVolumes(context.Background())
+	c.Assert(err, gc.ErrorMatches, "listing provider volumes: boom")
+}
+
+func (s *volumeServiceSuite) TestRemoveOrphanedVolume(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return([]domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		ProviderID: "provider-0",
+	}}, nil)
+	s.provider.EXPECT().ListVolumes(gomock.Any()).Return([]string{"provider-1"}, nil)
+	s.state.EXPECT().RemoveVolume(gomock.Any(), "vol-0").Return(nil)
+
+	err := s.service(c).RemoveOrphanedVolume(context.Background(), "vol-0")
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *volumeServiceSuite) TestRemoveOrphanedVolumeNotUnattached(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return(nil, nil)
+
+	err := s.service(c).RemoveOrphanedVolume(context.Background(), "vol-0")
+	c.Assert(err, jc.ErrorIs, storageerrors.VolumeNotFound)
+}
+
+func (s *volumeServiceSuite) TestRemoveOrphanedVolumeStillInProvider(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return([]domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		ProviderID: "provider-0",
+	}}, nil)
+	s.provider.EXPECT().ListVolumes(gomock.Any()).Return([]string{"provider-0"}, nil)
+
+	err := s.service(c).RemoveOrphanedVolume(context.Background(), "vol-0")
+	c.Assert(err, jc.ErrorIs, storageerrors.VolumeNotOrphaned)
+}
+
+func (s *volumeServiceSuite) TestRemoveOrphanedVolumeProviderNotSupported(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.state.EXPECT().GetUnattachedVolumes(gomock.Any()).Return([]domainstorage.VolumeInfo{{
+		UUID:       "vol-0",
+		ProviderID: "provider-0",
+	}}, nil)
+
+	err := s.unsupportedService(c).RemoveOrphanedVolume(context.Background(), "vol-0")
+	c.Assert(err, jc.ErrorIs, storageerrors.VolumeNotOrphaned)
+}
+
+func (s *volumeServiceSuite) TestRemoveOrphanedVolumeEmptyUUID(c *gc.C) {
+	err := s.service(c).RemoveOrphanedVolume(context.Background(), "")
+	c.Assert(err, jc.ErrorIs, errors.NotValid)
+}
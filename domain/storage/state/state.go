@@ -8,9 +8,10 @@ import (
 	"github.com/juju/juju/domain"
 )
 
-// State represents database interactions dealing with storage pools.
+// State represents database interactions dealing with storage.
 type State struct {
 	*StoragePoolState
+	*VolumeState
 }
 
 // NewState returns a new storage state
@@ -20,5 +21,8 @@ func NewState(factory coredatabase.TxnRunnerFactory) *State {
 		StoragePoolState: &StoragePoolState{
 			StateBase: domain.NewStateBase(factory),
 		},
+		VolumeState: &VolumeState{
+			StateBase: domain.NewStateBase(factory),
+		},
 	}
 }
@@ -0,0 +1,179 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/canonical/sqlair"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/domain"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+// VolumeState represents database interactions dealing with storage
+// volumes.
+type VolumeState struct {
+	*domain.StateBase
+}
+
+// GetUnattachedVolumes returns the alive, provisioned volumes in the model
+// which have no attachment to any machine, ordered by name.
+func (st VolumeState) GetUnattachedVolumes(ctx context.Context) ([]domainstorage.VolumeInfo, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &storageVolume.*
+FROM   storage_volume AS v
+WHERE  v.life_id = 0
+AND    v.provisioning_status_id = 1
+AND    v.provider_id IS NOT NULL
+AND    NOT EXISTS (
+    SELECT 1
+    FROM   storage_volume_attachment AS a
+    WHERE  a.storage_volume_uuid = v.uuid
+)
+ORDER BY v.name`, storageVolume{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows []storageVolume
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "querying unattached volumes")
+	}
+
+	result := make([]domainstorage.VolumeInfo, len(rows))
+	for i, row := range rows {
+		result[i] = domainstorage.VolumeInfo{
+			UUID:       row.UUID,
+			Name:       row.Name,
+			ProviderID: row.ProviderID,
+			SizeMiB:    uint64(row.SizeMiB.Int64),
+		}
+	}
+	return result, nil
+}
+
+// GetVolumeProviderIDs returns the provider IDs of all the volumes recorded
+// in the model, whatever their life or attachments.
+func (st VolumeState) GetVolumeProviderIDs(ctx context.Context) ([]string, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	stmt, err := st.Prepare(`
+SELECT &storageVolume.provider_id
+FROM   storage_volume
+WHERE  provider_id IS NOT NULL`, storageVolume{})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var rows []storageVolume
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "querying volume provider ids")
+	}
+
+	result := make([]string, len(rows))
+	for i, row := range rows {
+		result[i] = row.ProviderID
+	}
+	return result, nil
+}
+
+// RemoveVolume removes the record of the unattached volume with the input
+// UUID from the model, along with its link to any storage instance and any
+// attachment plans. The following errors may be returned:
+// - [storageerrors.VolumeNotFound] if the volume does not exist.
+// - [storageerrors.VolumeAttached] if the volume is attached to a machine.
+func (st VolumeState) RemoveVolume(ctx context.Context, volumeUUID string) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	volume := storageVolumeUUID{UUID: volumeUUID}
+	existsStmt, err := st.Prepare(`
+SELECT &storageVolumeUUID.uuid
+FROM   storage_volume
+WHERE  uuid = $storageVolumeUUID.uuid`, volume)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	attachmentsStmt, err := st.Prepare(`
+SELECT COUNT(*) AS &volumeAttachmentCount.count
+FROM   storage_volume_attachment
+WHERE  storage_volume_uuid = $storageVolumeUUID.uuid`, volume, volumeAttachmentCount{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	deleteQueries := []string{
+		`
+DELETE FROM storage_volume_attachment_plan_attr
+WHERE  attachment_plan_uuid IN (
+    SELECT uuid
+    FROM   storage_volume_attachment_plan
+    WHERE  storage_volume_uuid = $storageVolumeUUID.uuid
+)`,
+		`DELETE FROM storage_volume_attachment_plan WHERE storage_volume_uuid = $storageVolumeUUID.uuid`,
+		`DELETE FROM storage_instance_volume WHERE storage_volume_uuid = $storageVolumeUUID.uuid`,
+		`DELETE FROM storage_volume WHERE uuid = $storageVolumeUUID.uuid`,
+	}
+	deleteStmts := make([]*sqlair.Statement, len(deleteQueries))
+	for i, query := range deleteQueries {
+		deleteStmts[i], err = st.Prepare(query, volume)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, existsStmt, volume).Get(&volume)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return fmt.Errorf("volume %q %w", volumeUUID, storageerrors.VolumeNotFound)
+		} else if err != nil {
+			return errors.Trace(err)
+		}
+
+		var attachments volumeAttachmentCount
+		if err := tx.Query(ctx, attachmentsStmt, volume).Get(&attachments); err != nil {
+			return errors.Trace(err)
+		}
+		if attachments.Count > 0 {
+			return fmt.Errorf("volume %q %w", volumeUUID, storageerrors.VolumeAttached)
+		}
+
+		for _, stmt := range deleteStmts {
+			if err := tx.Query(ctx, stmt, volume).Run(); err != nil {
+				return errors.Trace(err)
+			}
+		}
+		return nil
+	})
+	return errors.Annotatef(err, "removing volume %q", volumeUUID)
+}
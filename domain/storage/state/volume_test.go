@@ -0,0 +1,147 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import (
+	"context"
+
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain"
+	"github.com/juju/juju/domain/schema/testing"
+	domainstorage "github.com/juju/juju/domain/storage"
+	storageerrors "github.com/juju/juju/domain/storage/errors"
+)
+
+type volumeSuite struct {
+	testing.ModelSuite
+}
+
+var _ = gc.Suite(&volumeSuite{})
+
+func newVolumeState(factory coredatabase.TxnRunnerFactory) *VolumeState {
+	return &VolumeState{
+		StateBase: domain.NewStateBase(factory),
+	}
+}
+
+func (s *volumeSuite) addVolume(c *gc.C, uuid, providerID string, lifeID, provisioningStatusID int) {
+	_, err := s.DB().Exec(`
+INSERT INTO storage_volume (uuid, life_id, name, provider_id, size_mib, provisioning_status_id)
+VALUES (?, ?, ?, ?, 1024, ?)`, uuid, lifeID, "vol-"+uuid, providerID, provisioningStatusID)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *volumeSuite) attachVolume(c *gc.C, volumeUUID string) {
+	_, err := s.DB().Exec(`INSERT INTO net_node (uuid) VALUES (?)`, "node-"+volumeUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_volume_attachment (uuid, storage_volume_uuid, net_node_uuid, life_id, provisioning_status_id)
+VALUES (?, ?, ?, 0, 1)`, "attachment-"+volumeUUID, volumeUUID, "node-"+volumeUUID)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *volumeSuite) TestGetUnattachedVolumes(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+	s.addVolume(c, "1", "provider-1", 0, 1)
+	s.addVolume(c, "0", "provider-0", 0, 1)
+	s.addVolume(c, "attached", "provider-attached", 0, 1)
+	s.attachVolume(c, "attached")
+	s.addVolume(c, "dying", "provider-dying", 1, 1)
+	s.addVolume(c, "pending", "provider-pending", 0, 0)
+
+	result, err := st.GetUnattachedVolumes(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.DeepEquals, []domainstorage.VolumeInfo{{
+		UUID:       "0",
+		Name:       "vol-0",
+		ProviderID: "provider-0",
+		SizeMiB:    1024,
+	}, {
+		UUID:       "1",
+		Name:       "vol-1",
+		ProviderID: "provider-1",
+		SizeMiB:    1024,
+	}})
+}
+
+func (s *volumeSuite) TestGetVolumeProviderIDs(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+	s.addVolume(c, "0", "provider-0", 0, 1)
+	s.addVolume(c, "attached", "provider-attached", 0, 1)
+	s.attachVolume(c, "attached")
+	s.addVolume(c, "dying", "provider-dying", 1, 1)
+
+	result, err := st.GetVolumeProviderIDs(context.Background())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(result, jc.SameContents, []string{"provider-0", "provider-attached", "provider-dying"})
+}
+
+func (s *volumeSuite) TestRemoveVolume(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+	s.addVolume(c, "0", "provider-0", 0, 1)
+	_, err := s.DB().Exec(`
+INSERT INTO storage_instance (uuid, storage_kind_id, name, life_id, storage_pool)
+VALUES ('storage-0', 0, 'data-0', 0, 'loop')`)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_instance_volume (storage_instance_uuid, storage_volume_uuid)
+VALUES ('storage-0', '0')`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveVolume(context.Background(), "0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	err = s.DB().QueryRow(`SELECT COUNT(*) FROM storage_volume`).Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+	err = s.DB().QueryRow(`SELECT COUNT(*) FROM storage_instance_volume`).Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
+func (s *volumeSuite) TestRemoveVolumeWithAttachmentPlan(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+	s.addVolume(c, "0", "provider-0", 0, 1)
+	_, err := s.DB().Exec(`INSERT INTO net_node (uuid) VALUES ('node-0')`)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_volume_attachment_plan (uuid, storage_volume_uuid, net_node_uuid, life_id, provisioning_status_id)
+VALUES ('plan-0', '0', 'node-0', 2, 1)`)
+	c.Assert(err, jc.ErrorIsNil)
+	_, err = s.DB().Exec(`
+INSERT INTO storage_volume_attachment_plan_attr (uuid, attachment_plan_uuid, "key", value)
+VALUES ('attr-0', 'plan-0', 'foo', 'bar')`)
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = st.RemoveVolume(context.Background(), "0")
+	c.Assert(err, jc.ErrorIsNil)
+
+	var count int
+	err = s.DB().QueryRow(`SELECT COUNT(*) FROM storage_volume_attachment_plan`).Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+	err = s.DB().QueryRow(`SELECT COUNT(*) FROM storage_volume_attachment_plan_attr`).Scan(&count)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(count, gc.Equals, 0)
+}
+
+func (s *volumeSuite) TestRemoveVolumeNotFound(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+
+	err := st.RemoveVolume(context.Background(), "0")
+	c.Assert(err, jc.ErrorIs, storageerrors.VolumeNotFound)
+}
+
+func (s *volumeSuite) TestRemoveVolumeAttached(c *gc.C) {
+	st := newVolumeState(s.TxnRunnerFactory())
+	s.addVolume(c, "0", "provider-0", 0, 1)
+	s.attachVolume(c, "0")
+
+	err := st.RemoveVolume(context.Background(), "0")
+	c.Assert(err, jc.ErrorIs, storageerrors.VolumeAttached)
+}
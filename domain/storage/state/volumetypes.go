@@ -0,0 +1,24 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package state
+
+import "database/sql"
+
+// These structs represent the persistent storage volume entity schema in
+// the database.
+
+type storageVolume struct {
+	UUID       string        `db:"uuid"`
+	Name       string        `db:"name"`
+	ProviderID string        `db:"provider_id"`
+	SizeMiB    sql.NullInt64 `db:"size_mib"`
+}
+
+type storageVolumeUUID struct {
+	UUID string `db:"uuid"`
+}
+
+type volumeAttachmentCount struct {
+	Count int `db:"count"`
+}
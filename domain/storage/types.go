@@ -27,6 +27,23 @@ type StoragePoolDetails struct {
 	Attrs    Attrs
 }
 
+// VolumeInfo describes a volume which is not attached to any machine.
+type VolumeInfo struct {
+	// UUID is the UUID of the volume in the model. It is empty for a volume
+	// reported by the provider which has no record in the model.
+	UUID string
+	// Name is the name of the volume in the model.
+	Name string
+	// ProviderID is the ID of the volume in the provider.
+	ProviderID string
+	// SizeMiB is the size of the volume in MiB.
+	SizeMiB uint64
+	// Orphaned is true if the volume is recorded in the model but not
+	// reported by the provider, or reported by the provider but not recorded
+	// in the model.
+	Orphaned bool
+}
+
 // These type aliases are used to specify filter terms.
 type (
 	Names     []string
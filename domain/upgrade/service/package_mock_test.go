@@ -162,6 +162,83 @@ func (c *MockStateCreateUpgradeCall) DoAndReturn(f func(context.Context, version
 	return c
 }
 
+// ModelAgentUpgradeStates mocks base method.
+func (m *MockState) ModelAgentUpgradeStates(arg0 context.Context, arg1 string) ([]upgrade0.AgentUpgradeState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModelAgentUpgradeStates", arg0, arg1)
+	ret0, _ := ret[0].([]upgrade0.AgentUpgradeState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModelAgentUpgradeStates indicates an expected call of ModelAgentUpgradeStates.
+func (mr *MockStateMockRecorder) ModelAgentUpgradeStates(arg0, arg1 any) *MockStateModelAgentUpgradeStatesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModelAgentUpgradeStates", reflect.TypeOf((*MockState)(nil).ModelAgentUpgradeStates), arg0, arg1)
+	return &MockStateModelAgentUpgradeStatesCall{Call: call}
+}
+
+// MockStateModelAgentUpgradeStatesCall wrap *gomock.Call
+type MockStateModelAgentUpgradeStatesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateModelAgentUpgradeStatesCall) Return(arg0 []upgrade0.AgentUpgradeState, arg1 error) *MockStateModelAgentUpgradeStatesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateModelAgentUpgradeStatesCall) Do(f func(context.Context, string) ([]upgrade0.AgentUpgradeState, error)) *MockStateModelAgentUpgradeStatesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateModelAgentUpgradeStatesCall) DoAndReturn(f func(context.Context, string) ([]upgrade0.AgentUpgradeState, error)) *MockStateModelAgentUpgradeStatesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetAgentUpgradeState mocks base method.
+func (m *MockState) SetAgentUpgradeState(arg0 context.Context, arg1 string, arg2 upgrade0.AgentUpgradeState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgentUpgradeState", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAgentUpgradeState indicates an expected call of SetAgentUpgradeState.
+func (mr *MockStateMockRecorder) SetAgentUpgradeState(arg0, arg1, arg2 any) *MockStateSetAgentUpgradeStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgentUpgradeState", reflect.TypeOf((*MockState)(nil).SetAgentUpgradeState), arg0, arg1, arg2)
+	return &MockStateSetAgentUpgradeStateCall{Call: call}
+}
+
+// MockStateSetAgentUpgradeStateCall wrap *gomock.Call
+type MockStateSetAgentUpgradeStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStateSetAgentUpgradeStateCall) Return(arg0 error) *MockStateSetAgentUpgradeStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStateSetAgentUpgradeStateCall) Do(f func(context.Context, string, upgrade0.AgentUpgradeState) error) *MockStateSetAgentUpgradeStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStateSetAgentUpgradeStateCall) DoAndReturn(f func(context.Context, string, upgrade0.AgentUpgradeState) error) *MockStateSetAgentUpgradeStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetControllerDone mocks base method.
 func (m *MockState) SetControllerDone(arg0 context.Context, arg1 upgrade0.UUID, arg2 string) error {
 	m.ctrl.T.Helper()
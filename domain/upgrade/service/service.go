@@ -11,6 +11,7 @@ import (
 
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
+	coremodel "github.com/juju/juju/core/model"
 	coreupgrade "github.com/juju/juju/core/upgrade"
 	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/eventsource"
@@ -29,6 +30,8 @@ type State interface {
 	SetDBUpgradeCompleted(context.Context, upgrade.UUID) error
 	SetDBUpgradeFailed(context.Context, upgrade.UUID) error
 	UpgradeInfo(context.Context, upgrade.UUID) (coreupgrade.Info, error)
+	SetAgentUpgradeState(context.Context, string, upgrade.AgentUpgradeState) error
+	ModelAgentUpgradeStates(context.Context, string) ([]upgrade.AgentUpgradeState, error)
 }
 
 // WatcherFactory describes methods for creating watchers.
@@ -132,6 +135,22 @@ func (s *Service) IsUpgrading(ctx context.Context) (bool, error) {
 	return false, errors.Trace(err)
 }
 
+// SetAgentUpgradeState records the latest progress of an agent in the model
+// through an agent binary upgrade. It returns a NotValid error if the agent
+// state is incomplete, and a NotFound error if the model does not exist.
+func (s *Service) SetAgentUpgradeState(ctx context.Context, modelUUID coremodel.UUID, agentState upgrade.AgentUpgradeState) error {
+	if err := modelUUID.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	if agentState.AgentName == "" {
+		return errors.NotValidf("empty agent name")
+	}
+	if _, ok := coreupgrade.AgentStates[agentState.State]; !ok {
+		return errors.NotValidf("agent upgrade state %d", agentState.State)
+	}
+	return errors.Trace(s.st.SetAgentUpgradeState(ctx, modelUUID.String(), agentState))
+}
+
 // WatchableService provides the API for working with upgrade info
 type WatchableService struct {
 	Service
@@ -190,3 +209,25 @@ func (s *WatchableService) WatchForUpgradeState(ctx context.Context, upgradeUUID
 	}
 	return s.watcherFactory.NewValueMapperWatcher("upgrade_info", upgradeUUID.String(), mask, mapper)
 }
+
+// WatchModelUpgradeState returns a watcher which reports the agents in the
+// model whose upgrade progress has changed. The initial event holds every
+// agent that has reported any progress. Changes to the same agent that occur
+// before an event is consumed are coalesced, so only its latest state is
+// reported.
+func (s *WatchableService) WatchModelUpgradeState(ctx context.Context, modelUUID coremodel.UUID) (watcher.ModelUpgradeStateWatcher, error) {
+	if err := modelUUID.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	mapper := func(ctx context.Context, db coredatabase.TxnRunner, changes []changestream.ChangeEvent) ([]changestream.ChangeEvent, error) {
+		return changes, nil
+	}
+	source, err := s.watcherFactory.NewValueMapperWatcher("model_agent_upgrade_state", modelUUID.String(), changestream.All, mapper)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return newModelUpgradeStateWatcher(source, func(ctx context.Context) ([]upgrade.AgentUpgradeState, error) {
+		return s.st.ModelAgentUpgradeStates(ctx, modelUUID.String())
+	})
+}
@@ -5,16 +5,22 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/version/v2"
+	"github.com/juju/worker/v4/workertest"
 	"go.uber.org/mock/gomock"
 	gc "gopkg.in/check.v1"
 
+	modeltesting "github.com/juju/juju/core/model/testing"
 	coreupgrade "github.com/juju/juju/core/upgrade"
+	"github.com/juju/juju/core/watcher"
 	"github.com/juju/juju/core/watcher/watchertest"
+	"github.com/juju/juju/domain/upgrade"
 	upgradeerrors "github.com/juju/juju/domain/upgrade/errors"
+	coretesting "github.com/juju/juju/internal/testing"
 )
 
 type serviceSuite struct {
@@ -201,3 +207,156 @@ func (s *serviceSuite) TestIsUpgradeError(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `boom`)
 	c.Assert(upgrading, jc.IsFalse)
 }
+
+func (s *serviceSuite) TestSetAgentUpgradeState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	agentState := upgrade.AgentUpgradeState{
+		AgentName:      "machine-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          coreupgrade.AgentRestarting,
+	}
+	s.state.EXPECT().SetAgentUpgradeState(gomock.Any(), modelUUID.String(), agentState).Return(nil)
+
+	err := s.service.SetAgentUpgradeState(context.Background(), modelUUID, agentState)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *serviceSuite) TestSetAgentUpgradeStateNotValid(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	err := s.service.SetAgentUpgradeState(context.Background(), modelUUID, upgrade.AgentUpgradeState{
+		State: coreupgrade.AgentStaging,
+	})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	err = s.service.SetAgentUpgradeState(context.Background(), modelUUID, upgrade.AgentUpgradeState{
+		AgentName: "machine-0",
+		State:     coreupgrade.AgentState(42),
+	})
+	c.Check(err, jc.ErrorIs, errors.NotValid)
+
+	err = s.service.SetAgentUpgradeState(context.Background(), "bad", upgrade.AgentUpgradeState{
+		AgentName: "machine-0",
+	})
+	c.Check(err, gc.NotNil)
+}
+
+func (s *serviceSuite) TestWatchModelUpgradeState(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	ch := make(chan struct{}, 1)
+	s.watcherFactory.EXPECT().NewValueMapperWatcher("model_agent_upgrade_state", modelUUID.String(), gomock.Any(), gomock.Any()).
+		Return(watchertest.NewMockNotifyWatcher(ch), nil)
+
+	machine := upgrade.AgentUpgradeState{
+		AgentName:      "machine-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          coreupgrade.AgentDownloading,
+	}
+	unit := upgrade.AgentUpgradeState{
+		AgentName:      "unit-mysql-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          coreupgrade.AgentDownloading,
+	}
+	upgradedMachine := machine
+	upgradedMachine.CurrentVersion = version.MustParse("4.0.1")
+	upgradedMachine.State = coreupgrade.AgentUpgraded
+
+	gomock.InOrder(
+		s.state.EXPECT().ModelAgentUpgradeStates(gomock.Any(), modelUUID.String()).Return(nil, nil),
+		s.state.EXPECT().ModelAgentUpgradeStates(gomock.Any(), modelUUID.String()).Return(
+			[]upgrade.AgentUpgradeState{machine, unit}, nil),
+		s.state.EXPECT().ModelAgentUpgradeStates(gomock.Any(), modelUUID.String()).Return(
+			[]upgrade.AgentUpgradeState{upgradedMachine, unit}, nil),
+	)
+
+	w, err := s.service.WatchModelUpgradeState(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The initial event is sent even though no agent has reported progress.
+	ch <- struct{}{}
+	c.Check(s.nextUpgradeStates(c, w), gc.HasLen, 0)
+
+	ch <- struct{}{}
+	c.Check(s.nextUpgradeStates(c, w), jc.DeepEquals, []watcher.ModelUpgradeStateEvent{
+		upgradeStateEvent(machine), upgradeStateEvent(unit),
+	})
+
+	// Only agents whose progress has changed are reported.
+	ch <- struct{}{}
+	c.Check(s.nextUpgradeStates(c, w), jc.DeepEquals, []watcher.ModelUpgradeStateEvent{
+		upgradeStateEvent(upgradedMachine),
+	})
+}
+
+func (s *serviceSuite) TestWatchModelUpgradeStateCoalesces(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	modelUUID := modeltesting.GenModelUUID(c)
+	ch := make(chan struct{})
+	s.watcherFactory.EXPECT().NewValueMapperWatcher("model_agent_upgrade_state", modelUUID.String(), gomock.Any(), gomock.Any()).
+		Return(watchertest.NewMockNotifyWatcher(ch), nil)
+
+	unit := upgrade.AgentUpgradeState{
+		AgentName:      "unit-mysql-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+	}
+	var calls []any
+	for _, state := range []coreupgrade.AgentState{
+		coreupgrade.AgentDownloading,
+		coreupgrade.AgentStaging,
+		coreupgrade.AgentRestarting,
+	} {
+		unit.State = state
+		calls = append(calls, s.state.EXPECT().ModelAgentUpgradeStates(gomock.Any(), modelUUID.String()).Return(
+			[]upgrade.AgentUpgradeState{unit}, nil))
+	}
+	gomock.InOrder(calls...)
+
+	w, err := s.service.WatchModelUpgradeState(context.Background(), modelUUID)
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, w)
+
+	// The unbuffered source channel ensures each change has been read
+	// before the next is sent, while the output is not being consumed.
+	for range calls {
+		select {
+		case ch <- struct{}{}:
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out sending change")
+		}
+	}
+
+	c.Check(s.nextUpgradeStates(c, w), jc.DeepEquals, []watcher.ModelUpgradeStateEvent{
+		upgradeStateEvent(unit),
+	})
+}
+
+func (s *serviceSuite) nextUpgradeStates(c *gc.C, w watcher.ModelUpgradeStateWatcher) []watcher.ModelUpgradeStateEvent {
+	select {
+	case changes, ok := <-w.Changes():
+		c.Assert(ok, jc.IsTrue)
+		return changes
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for upgrade state changes")
+	}
+	return nil
+}
+
+func upgradeStateEvent(state upgrade.AgentUpgradeState) watcher.ModelUpgradeStateEvent {
+	return watcher.ModelUpgradeStateEvent{
+		AgentName:      state.AgentName,
+		CurrentVersion: state.CurrentVersion,
+		TargetVersion:  state.TargetVersion,
+		State:          state.State,
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/watcher"
+	"github.com/juju/juju/domain/upgrade"
+)
+
+// modelUpgradeStateWatcher reports the agents in a model whose upgrade
+// progress has changed since it was last reported.
+type modelUpgradeStateWatcher struct {
+	catacomb catacomb.Catacomb
+
+	source    watcher.NotifyWatcher
+	getStates func(context.Context) ([]upgrade.AgentUpgradeState, error)
+
+	out chan []watcher.ModelUpgradeStateEvent
+}
+
+func newModelUpgradeStateWatcher(
+	source watcher.NotifyWatcher,
+	getStates func(context.Context) ([]upgrade.AgentUpgradeState, error),
+) (*modelUpgradeStateWatcher, error) {
+	w := &modelUpgradeStateWatcher{
+		source:    source,
+		getStates: getStates,
+		out:       make(chan []watcher.ModelUpgradeStateEvent),
+	}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+		Init: []worker.Worker{source},
+	})
+	return w, errors.Trace(err)
+}
+
+func (w *modelUpgradeStateWatcher) loop() error {
+	defer close(w.out)
+
+	var (
+		// reported holds the last state reported for each agent.
+		reported = make(map[string]watcher.ModelUpgradeStateEvent)
+		// pending holds the latest state of each agent that has changed
+		// since it was last reported.
+		pending = make(map[string]watcher.ModelUpgradeStateEvent)
+
+		out     chan []watcher.ModelUpgradeStateEvent
+		changes []watcher.ModelUpgradeStateEvent
+		initial = true
+	)
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return w.catacomb.ErrDying()
+		case _, ok := <-w.source.Changes():
+			if !ok {
+				return errors.Errorf("event watcher closed")
+			}
+			ctx, cancel := context.WithCancel(w.catacomb.Context(context.Background()))
+			states, err := w.getStates(ctx)
+			cancel()
+			if err != nil {
+				return errors.Trace(err)
+			}
+			for _, state := range states {
+				event := watcher.ModelUpgradeStateEvent{
+					AgentName:      state.AgentName,
+					CurrentVersion: state.CurrentVersion,
+					TargetVersion:  state.TargetVersion,
+					State:          state.State,
+				}
+				if last, ok := reported[event.AgentName]; ok && last == event {
+					delete(pending, event.AgentName)
+					continue
+				}
+				pending[event.AgentName] = event
+			}
+			if len(pending) == 0 && !initial {
+				out = nil
+				continue
+			}
+			changes = sortedEvents(pending)
+			out = w.out
+		case out <- changes:
+			for _, event := range changes {
+				reported[event.AgentName] = event
+			}
+			pending = make(map[string]watcher.ModelUpgradeStateEvent)
+			changes = nil
+			out = nil
+			initial = false
+		}
+	}
+}
+
+func sortedEvents(events map[string]watcher.ModelUpgradeStateEvent) []watcher.ModelUpgradeStateEvent {
+	result := make([]watcher.ModelUpgradeStateEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, event)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].AgentName < result[j].AgentName
+	})
+	return result
+}
+
+// Changes returns the channel of agent upgrade state changes.
+func (w *modelUpgradeStateWatcher) Changes() <-chan []watcher.ModelUpgradeStateEvent {
+	return w.out
+}
+
+// Kill is part of the worker.Worker interface.
+func (w *modelUpgradeStateWatcher) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *modelUpgradeStateWatcher) Wait() error {
+	return w.catacomb.Wait()
+}
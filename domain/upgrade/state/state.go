@@ -13,6 +13,7 @@ import (
 	coredatabase "github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/upgrade"
 	"github.com/juju/juju/domain"
+	modelerrors "github.com/juju/juju/domain/model/errors"
 	domainupgrade "github.com/juju/juju/domain/upgrade"
 	upgradeerrors "github.com/juju/juju/domain/upgrade/errors"
 	"github.com/juju/juju/internal/database"
@@ -450,3 +451,81 @@ AND state_type_id = $M.from;`, info, m)
 	}
 	return nil
 }
+
+// SetAgentUpgradeState records the latest upgrade progress of an agent in the
+// model, replacing any progress previously recorded for it. It returns a
+// NotFound error if the model does not exist.
+func (st *State) SetAgentUpgradeState(ctx context.Context, modelUUID string, agentState domainupgrade.AgentUpgradeState) error {
+	db, err := st.DB()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	row := ModelAgentUpgradeState{
+		ModelUUID:      modelUUID,
+		AgentName:      agentState.AgentName,
+		CurrentVersion: agentState.CurrentVersion.String(),
+		TargetVersion:  agentState.TargetVersion.String(),
+		StateTypeID:    int(agentState.State),
+	}
+
+	stmt, err := st.Prepare(`
+INSERT INTO model_agent_upgrade_state (*)
+VALUES ($ModelAgentUpgradeState.*)
+ON CONFLICT (model_uuid, agent_name) DO UPDATE SET
+    current_version = excluded.current_version,
+    target_version = excluded.target_version,
+    state_type_id = excluded.state_type_id
+`, row)
+	if err != nil {
+		return errors.Annotatef(err, "preparing upsert agent upgrade state statement")
+	}
+
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, row).Run()
+		if database.IsErrConstraintForeignKey(err) {
+			return errors.Annotatef(modelerrors.NotFound, "model %q", modelUUID)
+		}
+		return errors.Trace(err)
+	})
+	return errors.Trace(err)
+}
+
+// ModelAgentUpgradeStates returns the latest upgrade progress of every agent
+// in the model which has reported any, ordered by agent name.
+func (st *State) ModelAgentUpgradeStates(ctx context.Context, modelUUID string) ([]domainupgrade.AgentUpgradeState, error) {
+	db, err := st.DB()
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	arg := ModelAgentUpgradeState{ModelUUID: modelUUID}
+
+	stmt, err := st.Prepare(`
+SELECT &ModelAgentUpgradeState.*
+FROM model_agent_upgrade_state
+WHERE model_uuid = $ModelAgentUpgradeState.model_uuid
+ORDER BY agent_name
+`, arg)
+	if err != nil {
+		return nil, errors.Annotatef(err, "preparing select agent upgrade states statement")
+	}
+
+	var rows []ModelAgentUpgradeState
+	err = db.Txn(ctx, func(ctx context.Context, tx *sqlair.TX) error {
+		err := tx.Query(ctx, stmt, arg).GetAll(&rows)
+		if errors.Is(err, sqlair.ErrNoRows) {
+			return nil
+		}
+		return errors.Trace(err)
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	result := make([]domainupgrade.AgentUpgradeState, len(rows))
+	for i, row := range rows {
+		if result[i], err = row.ToAgentUpgradeState(); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return result, nil
+}
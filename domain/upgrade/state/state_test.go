@@ -13,6 +13,8 @@ import (
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/upgrade"
+	modelerrors "github.com/juju/juju/domain/model/errors"
+	modelstatetesting "github.com/juju/juju/domain/model/state/testing"
 	schematesting "github.com/juju/juju/domain/schema/testing"
 	domainupgrade "github.com/juju/juju/domain/upgrade"
 	upgradeerrors "github.com/juju/juju/domain/upgrade/errors"
@@ -90,6 +92,27 @@ func (s *stateSuite) TestEnsureUpgradeTypesMatchCore(c *gc.C) {
 	}
 }
 
+func (s *stateSuite) TestEnsureAgentUpgradeStateTypesMatchCore(c *gc.C) {
+	rows, err := s.DB().Query(`SELECT id, type FROM agent_upgrade_state_type`)
+	c.Assert(err, jc.ErrorIsNil)
+	defer rows.Close()
+
+	received := make(map[upgrade.AgentState]string)
+	for rows.Next() {
+		var (
+			id   int
+			name string
+		)
+		err = rows.Scan(&id, &name)
+		c.Assert(err, jc.ErrorIsNil)
+
+		c.Check(upgrade.AgentState(id).String(), gc.Equals, name)
+		received[upgrade.AgentState(id)] = name
+	}
+	c.Assert(rows.Err(), jc.ErrorIsNil)
+	c.Check(received, jc.DeepEquals, upgrade.AgentStates)
+}
+
 func (s *stateSuite) TestCreateUpgrade(c *gc.C) {
 	uuid, err := s.st.CreateUpgrade(context.Background(), version.MustParse("3.0.0"), version.MustParse("3.0.1"))
 	c.Assert(err, jc.ErrorIsNil)
@@ -462,3 +485,49 @@ WHERE upgrade_info_uuid = $M.info_uuid`
 	c.Assert(err, jc.ErrorIsNil)
 	return nodeInfos
 }
+
+func (s *stateSuite) TestSetAgentUpgradeState(c *gc.C) {
+	modelUUID := modelstatetesting.CreateTestModel(c, s.TxnRunnerFactory(), "upgrade")
+	ctx := context.Background()
+
+	states, err := s.st.ModelAgentUpgradeStates(ctx, modelUUID.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(states, gc.HasLen, 0)
+
+	unit := domainupgrade.AgentUpgradeState{
+		AgentName:      "unit-mysql-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          upgrade.AgentDownloading,
+	}
+	machine := domainupgrade.AgentUpgradeState{
+		AgentName:      "machine-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          upgrade.AgentStaging,
+	}
+	err = s.st.SetAgentUpgradeState(ctx, modelUUID.String(), unit)
+	c.Assert(err, jc.ErrorIsNil)
+	err = s.st.SetAgentUpgradeState(ctx, modelUUID.String(), machine)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// Only the latest state of each agent is kept.
+	unit.CurrentVersion = version.MustParse("4.0.1")
+	unit.State = upgrade.AgentUpgraded
+	err = s.st.SetAgentUpgradeState(ctx, modelUUID.String(), unit)
+	c.Assert(err, jc.ErrorIsNil)
+
+	states, err = s.st.ModelAgentUpgradeStates(ctx, modelUUID.String())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(states, jc.DeepEquals, []domainupgrade.AgentUpgradeState{machine, unit})
+}
+
+func (s *stateSuite) TestSetAgentUpgradeStateModelNotFound(c *gc.C) {
+	err := s.st.SetAgentUpgradeState(context.Background(), uuid.MustNewUUID().String(), domainupgrade.AgentUpgradeState{
+		AgentName:      "machine-0",
+		CurrentVersion: version.MustParse("4.0.0"),
+		TargetVersion:  version.MustParse("4.0.1"),
+		State:          upgrade.AgentDownloading,
+	})
+	c.Assert(err, jc.ErrorIs, modelerrors.NotFound)
+}
@@ -7,7 +7,10 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/juju/version/v2"
+
 	"github.com/juju/juju/core/upgrade"
+	domainupgrade "github.com/juju/juju/domain/upgrade"
 )
 
 // Info holds the information about database upgrade
@@ -54,3 +57,39 @@ type ControllerNodeInfo struct {
 type Count struct {
 	Num int `db:"num"`
 }
+
+// ModelAgentUpgradeState holds the upgrade progress of an agent in a model.
+type ModelAgentUpgradeState struct {
+	// ModelUUID holds the UUID of the agent's model.
+	ModelUUID string `db:"model_uuid"`
+	// AgentName holds the name of the agent.
+	AgentName string `db:"agent_name"`
+	// CurrentVersion holds the version of the agent binary being run.
+	CurrentVersion string `db:"current_version"`
+	// TargetVersion holds the version being upgraded to.
+	TargetVersion string `db:"target_version"`
+	// StateTypeID holds the type id of the agent's upgrade state.
+	StateTypeID int `db:"state_type_id"`
+}
+
+// ToAgentUpgradeState converts the row to a domainupgrade.AgentUpgradeState.
+func (s ModelAgentUpgradeState) ToAgentUpgradeState() (domainupgrade.AgentUpgradeState, error) {
+	state := upgrade.AgentState(s.StateTypeID)
+	if _, ok := upgrade.AgentStates[state]; !ok {
+		return domainupgrade.AgentUpgradeState{}, fmt.Errorf("unknown agent upgrade state id %d", s.StateTypeID)
+	}
+	current, err := version.Parse(s.CurrentVersion)
+	if err != nil {
+		return domainupgrade.AgentUpgradeState{}, fmt.Errorf("parsing current version of agent %q: %w", s.AgentName, err)
+	}
+	target, err := version.Parse(s.TargetVersion)
+	if err != nil {
+		return domainupgrade.AgentUpgradeState{}, fmt.Errorf("parsing target version of agent %q: %w", s.AgentName, err)
+	}
+	return domainupgrade.AgentUpgradeState{
+		AgentName:      s.AgentName,
+		CurrentVersion: current,
+		TargetVersion:  target,
+		State:          state,
+	}, nil
+}
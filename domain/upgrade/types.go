@@ -6,7 +6,9 @@ package upgrade
 import (
 	"github.com/juju/errors"
 	"github.com/juju/utils/v4"
+	"github.com/juju/version/v2"
 
+	coreupgrade "github.com/juju/juju/core/upgrade"
 	"github.com/juju/juju/internal/uuid"
 )
 
@@ -46,3 +48,17 @@ func (u UUID) Validate() error {
 func (u UUID) String() string {
 	return string(u)
 }
+
+// AgentUpgradeState holds the latest progress of an agent in a model through
+// an agent binary upgrade.
+type AgentUpgradeState struct {
+	// AgentName is the name of the agent, such as "machine-0" or
+	// "unit-mysql-0".
+	AgentName string
+	// CurrentVersion is the version of the agent binary being run.
+	CurrentVersion version.Number
+	// TargetVersion is the version of the agent binary being upgraded to.
+	TargetVersion version.Number
+	// State is how far through the upgrade the agent is.
+	State coreupgrade.AgentState
+}
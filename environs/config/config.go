@@ -92,6 +92,10 @@ const (
 	// container provisioner workers per machine setting.
 	NumContainerProvisionWorkersKey = "num-container-provision-workers"
 
+	// MaxContainersPerMachineKey is the key for the maximum number of
+	// containers that may be hosted on a single machine.
+	MaxContainersPerMachineKey = "max-containers-per-machine"
+
 	// ImageStreamKey is the key used to specify the stream
 	// for OS images.
 	ImageStreamKey = "image-stream"
@@ -870,6 +874,10 @@ func Validate(_ctx context.Context, cfg, old *Config) error {
 		return errors.Trace(err)
 	}
 
+	if err := cfg.validateMaxContainersPerMachine(); err != nil {
+		return errors.Trace(err)
+	}
+
 	if old != nil {
 		// Check the immutable config values.  These can't change
 		for _, attr := range immutableAttributes {
@@ -1442,6 +1450,23 @@ func (c *Config) validateNumContainerProvisionWorkers() error {
 	return nil
 }
 
+// MaxContainersPerMachine returns the maximum number of containers that may
+// be hosted on a single machine. Zero means there is no limit.
+func (c *Config) MaxContainersPerMachine() int {
+	value, _ := c.defined[MaxContainersPerMachineKey].(int)
+	return value
+}
+
+// validateMaxContainersPerMachine ensures the maximum number of containers
+// per machine is not negative.
+func (c *Config) validateMaxContainersPerMachine() error {
+	value, ok := c.defined[MaxContainersPerMachineKey].(int)
+	if ok && value < 0 {
+		return errors.Errorf("%s: must not be negative", MaxContainersPerMachineKey)
+	}
+	return nil
+}
+
 // ImageStream returns the simplestreams stream
 // used to identify which image ids to search
 // when starting an instance.
@@ -1789,6 +1814,7 @@ var alwaysOptional = schema.Defaults{
 	ProvisionerHarvestModeKey:       schema.Omit,
 	NumProvisionWorkersKey:          schema.Omit,
 	NumContainerProvisionWorkersKey: schema.Omit,
+	MaxContainersPerMachineKey:      schema.Omit,
 	HTTPProxyKey:                    schema.Omit,
 	HTTPSProxyKey:                   schema.Omit,
 	FTPProxyKey:                     schema.Omit,
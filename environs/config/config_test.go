@@ -390,6 +390,19 @@ var configTests = []configTest{
 			"num-container-provision-workers": 26,
 		}),
 		err: `num-container-provision-workers: must be less than 25`,
+	}, {
+		about:       "max-containers-per-machine: 5",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-containers-per-machine": 5,
+		}),
+	}, {
+		about:       "max-containers-per-machine: negative",
+		useDefaults: config.UseDefaults,
+		attrs: minimalConfigAttrs.Merge(testing.Attrs{
+			"max-containers-per-machine": -1,
+		}),
+		err: `max-containers-per-machine: must not be negative`,
 	}, {
 		about:       "default image stream",
 		useDefaults: config.UseDefaults,
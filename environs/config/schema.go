@@ -262,6 +262,11 @@ global or per instance security groups.`,
 		Type:        environschema.Tint,
 		Group:       environschema.EnvironGroup,
 	},
+	MaxContainersPerMachineKey: {
+		Description: "The maximum number of containers that may be hosted on a single machine (0 means no limit)",
+		Type:        environschema.Tint,
+		Group:       environschema.EnvironGroup,
+	},
 	"proxy-ssh": {
 		// default: true
 		Description: `Whether SSH commands should be proxied through the API server`,
@@ -4,6 +4,8 @@
 package instances
 
 import (
+	"time"
+
 	"github.com/juju/juju/core/instance"
 	corenetwork "github.com/juju/juju/core/network"
 	"github.com/juju/juju/core/network/firewall"
@@ -41,3 +43,16 @@ type InstanceFirewaller interface {
 	// address rules for that port range.
 	IngressRules(ctx envcontext.ProviderCallContext, machineId string) (firewall.IngressRules, error)
 }
+
+// MaintenanceEvent describes maintenance which the provider has scheduled for
+// an instance, such as the retirement of the host it is running on.
+type MaintenanceEvent struct {
+	// Code identifies the kind of maintenance, as reported by the provider.
+	Code string
+
+	// Description is a human readable description of the maintenance.
+	Description string
+
+	// NotBefore is the earliest time at which the maintenance may start.
+	NotBefore time.Time
+}
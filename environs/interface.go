@@ -540,6 +540,16 @@ type InstanceTagger interface {
 	TagInstance(ctx envcontext.ProviderCallContext, id instance.Id, tags map[string]string) error
 }
 
+// InstanceMaintenanceLister is an interface that can be used to find the
+// maintenance which the provider has scheduled for instances.
+type InstanceMaintenanceLister interface {
+	// InstanceMaintenanceEvents returns the maintenance events currently
+	// scheduled for each of the given instances, in the same order as the
+	// ids. Events which have been cancelled or have completed are not
+	// returned.
+	InstanceMaintenanceEvents(ctx envcontext.ProviderCallContext, ids []instance.Id) ([][]instances.MaintenanceEvent, error)
+}
+
 // InstanceTypesFetcher is an interface that allows for instance information from
 // a provider to be obtained.
 type InstanceTypesFetcher interface {
@@ -28,13 +28,15 @@ import (
 
 func main() {
 	var (
-		tables = stringslice{}
+		tables        = stringslice{}
+		ignoreColumns = stringslice{}
 
 		dbTypeFlag         = flag.String("db", "controller", "Database type to use (controller|model)")
 		destinationPackage = flag.String("package", "schema", "Package name to use")
 		destination        = flag.String("destination", "", "Destination directory to write the triggers to")
 	)
 	flag.Var(&tables, "tables", "Tables to generate triggers for")
+	flag.Var(&ignoreColumns, "ignore-columns", "Columns, as table.column, whose updates do not generate change log entries")
 	flag.Parse()
 
 	path, err := os.MkdirTemp(os.TempDir(), *dbTypeFlag)
@@ -81,7 +83,7 @@ func main() {
 		log.Fatalln("cannot open db runner", err)
 	}
 
-	tableColumns, err := readTableColumns(ctx, runner, tables)
+	tableColumns, err := readTableColumns(ctx, runner, tables, ignoreColumns)
 	if err != nil {
 		log.Fatalln("cannot read table columns", err)
 	}
@@ -134,7 +136,12 @@ func initDBRunner(ctx context.Context, db *sql.DB, dbType string) (*txnRunner, e
 	return runner, nil
 }
 
-func readTableColumns(ctx context.Context, runner *txnRunner, tables []string) (map[string][]columnInfo, error) {
+func readTableColumns(ctx context.Context, runner *txnRunner, tables, ignoreColumns []string) (map[string][]columnInfo, error) {
+	ignored := make(map[string]bool, len(ignoreColumns))
+	for _, column := range ignoreColumns {
+		ignored[column] = true
+	}
+
 	tableColumns := make(map[string][]columnInfo)
 	if err := runner.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
 		for _, table := range tables {
@@ -163,6 +170,9 @@ func readTableColumns(ctx context.Context, runner *txnRunner, tables []string) (
 
 			columnInfos := make([]columnInfo, 0)
 			for _, column := range columns {
+				if ignored[table+"."+column] {
+					continue
+				}
 				info, ok := info[column]
 				if !ok {
 					return errors.Errorf("column %q not found in table %q", column, table)
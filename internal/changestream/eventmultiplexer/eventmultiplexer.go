@@ -74,6 +74,10 @@ type EventMultiplexer struct {
 	subscriptionsCount uint64
 	dispatchErrorCount int
 
+	// term is the number of terms received from the stream. It's used to
+	// identify the terms that resubscribing subscriptions may have missed.
+	term uint64
+
 	// (un)subscription related channels to serialize adding and removing
 	// subscriptions. This allows the queue to be lock less.
 	subscriptionCh   chan requestSubscription
@@ -132,6 +136,41 @@ func (e *EventMultiplexer) Subscribe(opts ...changestream.SubscriptionOption) (c
 	}
 }
 
+// Resubscribe creates a new subscription to replace a previous subscription,
+// typically one that has been dropped for failing to consume changes in time.
+// The returned TermGap describes the terms that the previous subscription was
+// guaranteed to have observed and the current term, so that the subscriber
+// can decide if it needs to resync. If the previous subscription is still
+// active, it is removed and the new subscription is a contiguous
+// continuation of it.
+func (e *EventMultiplexer) Resubscribe(previous changestream.Subscription, opts ...changestream.SubscriptionOption) (changestream.Subscription, TermGap, error) {
+	prev, ok := previous.(*subscription)
+	if !ok || prev == nil {
+		return nil, TermGap{}, errors.NotValidf("previous subscription %T", previous)
+	}
+
+	result := make(chan requestSubscriptionResult)
+	select {
+	case <-e.catacomb.Dying():
+		return nil, TermGap{}, database.ErrEventMultiplexerDying
+	case e.subscriptionCh <- requestSubscription{
+		opts:     opts,
+		previous: prev,
+		result:   result,
+	}:
+	}
+
+	select {
+	case <-e.catacomb.Dying():
+		return nil, TermGap{}, database.ErrEventMultiplexerDying
+	case res := <-result:
+		if res.err != nil {
+			return nil, TermGap{}, errors.Trace(res.err)
+		}
+		return res.sub, res.gap, nil
+	}
+}
+
 // Kill stops the event queue.
 func (e *EventMultiplexer) Kill() {
 	e.catacomb.Kill(nil)
@@ -217,6 +256,7 @@ func (e *EventMultiplexer) loop() error {
 				e.logger.Infof("change stream term channel is closed")
 				return nil
 			}
+			e.term++
 
 			changeSet := make(map[*subscription]ChangeSet)
 			for _, change := range term.Changes() {
@@ -247,17 +287,63 @@ func (e *EventMultiplexer) loop() error {
 			}
 			e.metrics.DispatchDurationObserve(e.clock.Now().Sub(begin).Seconds(), err != nil)
 
+			// Remove any subscriptions that failed to consume the changes in
+			// time. They missed this term, so they're only guaranteed to
+			// have observed the previous one.
+			for sub := range changeSet {
+				if !sub.dropped.Load() {
+					continue
+				}
+				e.logger.Debugf("dropping subscription %d at term %d", sub.id, e.term)
+				e.removeSubscription(sub.id, e.term-1)
+				e.metrics.SubscriptionsDec()
+			}
+
 			// We should guarantee that the change set is not empty, so we
 			// can force false here.
 			term.Done(false, e.catacomb.Dying())
 
 		case request := <-e.subscriptionCh:
+			// If this is a resubscription, then work out which terms the
+			// previous subscription may have missed. If the previous
+			// subscription is still active, remove it, so the new one is a
+			// contiguous continuation of it.
+			var gap TermGap
+			if prev := request.previous; prev != nil {
+				if active, ok := e.subscriptions[prev.id]; ok && active == prev {
+					e.removeSubscription(prev.id, e.term)
+					e.metrics.SubscriptionsDec()
+				} else if !prev.removed {
+					select {
+					case <-e.catacomb.Dying():
+						return e.catacomb.ErrDying()
+					case request.result <- requestSubscriptionResult{
+						err: errors.NotFoundf("previous subscription %d", prev.id),
+					}:
+						continue
+					}
+				}
+				gap = TermGap{
+					LastTerm:    prev.lastTerm,
+					CurrentTerm: e.term,
+				}
+			}
+
 			// Get a new subscription count without using any mutexes.
 			subID := atomic.AddUint64(&e.subscriptionsCount, 1)
 
 			e.metrics.SubscriptionsInc()
 
-			sub := newSubscription(subID, func() { e.unsubscribe(subID) })
+			// Dropped subscriptions are removed by the loop once the term
+			// has been dispatched, as the loop can't receive an
+			// unsubscription whilst it's dispatching.
+			var sub *subscription
+			sub = newSubscription(subID, func() {
+				if sub.dropped.Load() {
+					return
+				}
+				e.unsubscribe(subID)
+			})
 
 			if err := e.catacomb.Add(sub); err != nil {
 				e.metrics.SubscriptionsDec()
@@ -303,44 +389,13 @@ func (e *EventMultiplexer) loop() error {
 				return e.catacomb.ErrDying()
 			case request.result <- requestSubscriptionResult{
 				sub: sub,
+				gap: gap,
 			}:
 				continue
 			}
 
 		case subscriptionID := <-e.unsubscriptionCh:
-			sub, found := e.subscriptions[subscriptionID]
-			if !found {
-				continue
-			}
-
-			for topic := range sub.topics {
-				var updatedFilters []*eventFilter
-				for _, filter := range e.subscriptionsByNS[topic] {
-					if filter.subscriptionID == subscriptionID {
-						continue
-					}
-					updatedFilters = append(updatedFilters, filter)
-				}
-
-				// If we don't have any more filters for this topic, remove it
-				// otherwise we'll keep iterating over it.
-				if len(updatedFilters) == 0 {
-					delete(e.subscriptionsByNS, topic)
-					continue
-				}
-
-				e.subscriptionsByNS[topic] = updatedFilters
-			}
-
-			delete(e.subscriptions, subscriptionID)
-			delete(e.subscriptionsAll, subscriptionID)
-
-			// If the subscription errors out on a close, we don't want that
-			// to bring down the entire multiplexer. Instead, just log it out
-			// and continue.
-			if err := sub.close(); err != nil {
-				e.logger.Infof("error closing subscription: %v", err)
-			}
+			e.removeSubscription(subscriptionID, e.term)
 
 		case r := <-e.reportsCh:
 			r.data["subscriptions"] = len(e.subscriptions)
@@ -357,6 +412,48 @@ func (e *EventMultiplexer) loop() error {
 	}
 }
 
+// removeSubscription removes the subscription from the event multiplexer and
+// closes it, recording the last term that the subscription was guaranteed to
+// have observed.
+func (e *EventMultiplexer) removeSubscription(subscriptionID uint64, lastTerm uint64) {
+	sub, found := e.subscriptions[subscriptionID]
+	if !found {
+		return
+	}
+
+	for topic := range sub.topics {
+		var updatedFilters []*eventFilter
+		for _, filter := range e.subscriptionsByNS[topic] {
+			if filter.subscriptionID == subscriptionID {
+				continue
+			}
+			updatedFilters = append(updatedFilters, filter)
+		}
+
+		// If we don't have any more filters for this topic, remove it
+		// otherwise we'll keep iterating over it.
+		if len(updatedFilters) == 0 {
+			delete(e.subscriptionsByNS, topic)
+			continue
+		}
+
+		e.subscriptionsByNS[topic] = updatedFilters
+	}
+
+	delete(e.subscriptions, subscriptionID)
+	delete(e.subscriptionsAll, subscriptionID)
+
+	sub.lastTerm = lastTerm
+	sub.removed = true
+
+	// If the subscription errors out on a close, we don't want that
+	// to bring down the entire multiplexer. Instead, just log it out
+	// and continue.
+	if err := sub.close(); err != nil {
+		e.logger.Infof("error closing subscription: %v", err)
+	}
+}
+
 type reporter interface {
 	Report() map[string]interface{}
 }
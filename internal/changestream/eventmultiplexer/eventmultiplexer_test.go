@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/juju/errors"
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4/workertest"
 	"go.uber.org/mock/gomock"
@@ -987,6 +988,120 @@ func (s *eventMultiplexerSuite) TestReportWithTopicRemovalAfterUnsubscribe(c *gc
 	workertest.CleanKill(c, queue)
 }
 
+func (s *eventMultiplexerSuite) TestResubscribeActiveSubscription(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.metrics.EXPECT().SubscriptionsInc().Times(2)
+	s.metrics.EXPECT().SubscriptionsDec().Times(2)
+	s.clock.EXPECT().Now().MinTimes(1)
+	s.metrics.EXPECT().DispatchDurationObserve(gomock.Any(), false)
+
+	sub, err := queue.Subscribe(changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.expectTerm(c, changeEvent{
+		ctype:   changestream.Create,
+		ns:      "topic",
+		changed: "1",
+	})
+	s.dispatchTerm(c, terms)
+
+	select {
+	case <-sub.Changes():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for event")
+	}
+
+	resub, gap, err := queue.Resubscribe(sub, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(gap, gc.Equals, TermGap{LastTerm: 1, CurrentTerm: 1})
+
+	_, _, missed := gap.Missed()
+	c.Check(missed, jc.IsFalse)
+
+	// The previous subscription is removed as part of the resubscribe.
+	select {
+	case <-sub.Done():
+	case <-time.After(testing.ShortWait):
+		c.Fatal("timed out waiting for previous subscription to be done")
+	}
+
+	s.unsubscribe(c, resub)
+}
+
+func (s *eventMultiplexerSuite) TestResubscribeMissedTerms(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).MinTimes(1)
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	s.metrics.EXPECT().SubscriptionsInc().Times(2)
+	s.metrics.EXPECT().SubscriptionsDec().Times(2)
+
+	sub, err := queue.Subscribe(changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	s.unsubscribe(c, sub)
+
+	// Dispatch two terms whilst there is no subscription.
+	for i := 0; i < 2; i++ {
+		s.expectEmptyTerm(c, changeEvent{
+			ctype:   changestream.Create,
+			ns:      "topic",
+			changed: "1",
+		})
+		select {
+		case <-s.dispatchTerm(c, terms):
+		case <-time.After(testing.ShortWait):
+			c.Fatal("timed out waiting for term to be dispatched")
+		}
+	}
+
+	resub, gap, err := queue.Resubscribe(sub, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIsNil)
+
+	from, to, missed := gap.Missed()
+	c.Check(missed, jc.IsTrue)
+	c.Check(from, gc.Equals, uint64(1))
+	c.Check(to, gc.Equals, uint64(2))
+
+	s.unsubscribe(c, resub)
+}
+
+func (s *eventMultiplexerSuite) TestResubscribeUnknownSubscription(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectStreamDying(make(<-chan struct{}))
+
+	terms := make(chan changestream.Term)
+	s.stream.EXPECT().Terms().Return(terms).AnyTimes()
+
+	queue, err := New(s.stream, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	c.Assert(err, jc.ErrorIsNil)
+	defer workertest.CleanKill(c, queue)
+
+	other := newSubscription(666, func() {})
+	defer workertest.CleanKill(c, other)
+
+	_, _, err = queue.Resubscribe(other, changestream.Namespace("topic", changestream.Create))
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
 func (s *eventMultiplexerSuite) unsubscribe(c *gc.C, sub changestream.Subscription) {
 	sub.Unsubscribe()
 
@@ -6,6 +6,7 @@ package eventmultiplexer
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"gopkg.in/tomb.v2"
@@ -22,15 +23,39 @@ const (
 )
 
 type requestSubscription struct {
-	opts   []changestream.SubscriptionOption
-	result chan requestSubscriptionResult
+	opts     []changestream.SubscriptionOption
+	previous *subscription
+	result   chan requestSubscriptionResult
 }
 
 type requestSubscriptionResult struct {
 	sub *subscription
+	gap TermGap
 	err error
 }
 
+// TermGap describes the terms that a subscription may have missed between
+// a previous subscription being removed and a new subscription replacing it.
+type TermGap struct {
+	// LastTerm is the last term that the previous subscription was
+	// guaranteed to have observed.
+	LastTerm uint64
+
+	// CurrentTerm is the last term processed by the event multiplexer at the
+	// point the new subscription was created.
+	CurrentTerm uint64
+}
+
+// Missed returns the inclusive range of terms that the previous subscription
+// may have missed. If the new subscription is a contiguous continuation of
+// the previous subscription, then false is returned.
+func (g TermGap) Missed() (from, to uint64, missed bool) {
+	if g.CurrentTerm <= g.LastTerm {
+		return 0, 0, false
+	}
+	return g.LastTerm + 1, g.CurrentTerm, true
+}
+
 // subscription represents a subscriber in the event queue. It holds a tomb, so
 // that we can tie the lifecycle of a subscription to the event queue.
 type subscription struct {
@@ -40,6 +65,16 @@ type subscription struct {
 	topics        map[string]struct{}
 	changes       chan ChangeSet
 	unsubscribeFn func()
+
+	// dropped is set when the subscription failed to consume a dispatch
+	// within the signal timeout.
+	dropped atomic.Bool
+
+	// lastTerm is the last term the subscription was guaranteed to have
+	// observed. It is only set by the event multiplexer loop once the
+	// subscription has been removed.
+	lastTerm uint64
+	removed  bool
 }
 
 func newSubscription(id uint64, unsubscribeFn func()) *subscription {
@@ -110,7 +145,10 @@ func (s *subscription) dispatch(ctx context.Context, changes ChangeSet) error {
 		// notified via the done channel. The listener will still have the
 		// opportunity to resubscribe in the future. They're just no longer
 		// par-taking in this term whilst they're unresponsive.
+		// The subscription is marked as dropped, so that the event
+		// multiplexer can record the term that was missed.
 		if err := ctx.Err(); err != nil && errors.Is(err, context.DeadlineExceeded) {
+			s.dropped.Store(true)
 			s.Unsubscribe()
 		}
 
@@ -164,10 +164,21 @@ func (s *subscriptionSuite) TestSubscriptionDoesNotWitnessChangesWithUnsub(c *gc
 
 	// We should have witnessed the unsubscribe
 	c.Check(atomic.LoadInt64(&witnessed), gc.Equals, int64(1))
+	c.Check(sub.dropped.Load(), jc.IsTrue)
 
 	workertest.CleanKill(c, sub)
 }
 
+func (s *subscriptionSuite) TestTermGapMissed(c *gc.C) {
+	_, _, missed := TermGap{LastTerm: 3, CurrentTerm: 3}.Missed()
+	c.Check(missed, jc.IsFalse)
+
+	from, to, missed := TermGap{LastTerm: 3, CurrentTerm: 7}.Missed()
+	c.Check(missed, jc.IsTrue)
+	c.Check(from, gc.Equals, uint64(4))
+	c.Check(to, gc.Equals, uint64(7))
+}
+
 func (s *subscriptionSuite) TestSubscriptionDoesNotWitnessChangesWithDying(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
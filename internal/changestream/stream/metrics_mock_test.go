@@ -110,6 +110,42 @@ func (c *MockMetricsCollectorChangesRequestDurationObserveCall) DoAndReturn(f fu
 	return c
 }
 
+// ChangesTermSplitsInc mocks base method.
+func (m *MockMetricsCollector) ChangesTermSplitsInc() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ChangesTermSplitsInc")
+}
+
+// ChangesTermSplitsInc indicates an expected call of ChangesTermSplitsInc.
+func (mr *MockMetricsCollectorMockRecorder) ChangesTermSplitsInc() *MockMetricsCollectorChangesTermSplitsIncCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangesTermSplitsInc", reflect.TypeOf((*MockMetricsCollector)(nil).ChangesTermSplitsInc))
+	return &MockMetricsCollectorChangesTermSplitsIncCall{Call: call}
+}
+
+// MockMetricsCollectorChangesTermSplitsIncCall wrap *gomock.Call
+type MockMetricsCollectorChangesTermSplitsIncCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockMetricsCollectorChangesTermSplitsIncCall) Return() *MockMetricsCollectorChangesTermSplitsIncCall {
+	c.Call = c.Call.Return()
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockMetricsCollectorChangesTermSplitsIncCall) Do(f func()) *MockMetricsCollectorChangesTermSplitsIncCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockMetricsCollectorChangesTermSplitsIncCall) DoAndReturn(f func()) *MockMetricsCollectorChangesTermSplitsIncCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // WatermarkInsertsInc mocks base method.
 func (m *MockMetricsCollector) WatermarkInsertsInc() {
 	m.ctrl.T.Helper()
@@ -105,6 +105,7 @@ func (s *baseSuite) expectFileNotifyWatcher() chan bool {
 func (s *baseSuite) expectMetrics() {
 	s.metrics.EXPECT().ChangesRequestDurationObserve(gomock.Any()).AnyTimes()
 	s.metrics.EXPECT().ChangesCountObserve(gomock.Any()).AnyTimes()
+	s.metrics.EXPECT().ChangesTermSplitsInc().AnyTimes()
 	s.metrics.EXPECT().WatermarkInsertsInc().AnyTimes()
 	s.metrics.EXPECT().WatermarkRetriesInc().AnyTimes()
 }
@@ -54,6 +54,7 @@ type MetricsCollector interface {
 	WatermarkRetriesInc()
 	ChangesRequestDurationObserve(val float64)
 	ChangesCountObserve(val int)
+	ChangesTermSplitsInc()
 }
 
 // FileNotifyWatcher notifies when a file has been created or deleted within
@@ -117,14 +118,16 @@ type Stream struct {
 	logger       logger.Logger
 	metrics      MetricsCollector
 
-	terms chan changestream.Term
+	terms       chan changestream.Term
+	maxTermSize int
 
 	watermarksMutex       sync.Mutex
 	watermarks            []*termView
 	lastRecordedWatermark *termView
 }
 
-// New creates a new Stream.
+// New creates a new Stream. A poll which returns more than maxTermSize
+// changes is split into multiple terms.
 func New(
 	id string,
 	db coredatabase.TxnRunner,
@@ -132,8 +135,9 @@ func New(
 	clock clock.Clock,
 	metrics MetricsCollector,
 	logger logger.Logger,
+	maxTermSize int,
 ) *Stream {
-	return NewInternalStates(id, db, fileNotifier, clock, metrics, logger, nil)
+	return NewInternalStates(id, db, fileNotifier, clock, metrics, logger, maxTermSize, nil)
 }
 
 // NewInternalStates creates a new Stream with an internal state channel.
@@ -144,8 +148,13 @@ func NewInternalStates(
 	clock clock.Clock,
 	metrics MetricsCollector,
 	logger logger.Logger,
+	maxTermSize int,
 	internalStates chan string,
 ) *Stream {
+	if maxTermSize <= 0 {
+		maxTermSize = changestream.DefaultMaxTermSize
+	}
+
 	stream := &Stream{
 		id:             id,
 		db:             db,
@@ -154,6 +163,7 @@ func NewInternalStates(
 		logger:         logger,
 		metrics:        metrics,
 		terms:          make(chan changestream.Term),
+		maxTermSize:    maxTermSize,
 		watermarks:     make([]*termView, changestream.DefaultNumTermWatermarks),
 		internalStates: internalStates,
 	}
@@ -171,6 +181,7 @@ func (s *Stream) Report() map[string]any {
 	m := map[string]any{
 		"id":                      s.id,
 		"last-recorded-watermark": "",
+		"max-term-size":           s.maxTermSize,
 	}
 
 	if s.lastRecordedWatermark != nil {
@@ -342,112 +353,169 @@ func (s *Stream) loop() error {
 
 			// Record the number of retrieved changes on metrics.
 			s.metrics.ChangesCountObserve(len(changes))
-			var (
-				// Term encapsulates a set of changes that are bounded by a
-				// coalesced set.
-				term = &Term{
-					done: make(chan bool),
-				}
 
-				lower = int64(math.MaxInt64)
-				upper = int64(math.MinInt64)
-			)
-			for _, change := range changes {
+			// Bound the size of each term, so that a burst of changes doesn't
+			// result in one giant term. The changes are ordered by id and
+			// have already been coalesced by namespace and changed value, so
+			// each change only appears once in the poll. Splitting the
+			// ordered changes into consecutive chunks therefore preserves
+			// the ordering that subscribers rely on.
+			chunks := splitChanges(changes, s.maxTermSize)
+			if len(chunks) > 1 {
+				s.metrics.ChangesTermSplitsInc()
+
 				if traceEnabled {
-					s.logger.Tracef("change event: %v", change)
+					s.logger.Tracef("split %d changes into %d terms", len(changes), len(chunks))
 				}
-				term.changes = append(term.changes, change)
+			}
 
-				if change.id < lower {
-					lower = change.id
+			var (
+				aborted bool
+				empty   = true
+			)
+			for _, chunk := range chunks {
+				termEmpty, termAborted, err := s.processTerm(chunk, traceEnabled)
+				if err != nil {
+					// Return the error as is, the tomb requires that
+					// tomb.ErrDying is not wrapped.
+					return err
 				}
-				if change.id > upper {
-					upper = change.id
+				if termAborted {
+					aborted = true
+					break
 				}
+				empty = empty && termEmpty
 			}
-			if lower == math.MaxInt64 || upper == math.MinInt64 {
-				// This should never happen, but if it does, just continue.
-				s.logger.Infof("invalid lower or upper bound: lower: %d, upper: %d", lower, upper)
+			if aborted {
+				// If the event mux has been killed, then the term has been
+				// aborted, so we just continue. This is likely the case
+				// when the worker is dying. We don't want to block the
+				// change stream, so we just continue.
+				s.logger.Infof("term has been aborted")
 				continue
 			}
 
-			// Send the term to the terms channel, and wait for it to be
-			// completed. This will block the outer loop until the term has
-			// been completed. It is the responsibility of the consumer of the
-			// terms channel to ensure that the term is completed in the
-			// fastest possible time.
-
-			if traceEnabled {
-				s.logger.Tracef("term start: processing changes %d", len(changes))
-			}
+			// If all the resulting term change sets are empty, then wait for
+			// the back-off strategy to complete before attempting to read
+			// changes again.
+			// This is to prevent the worker from polling the database
+			// too frequently and allow us to attempt to coalesce changes
+			// when there is less activity.
+			if empty {
+				attempt++
 
-			select {
-			case <-s.tomb.Dying():
-				return tomb.ErrDying
-			case s.terms <- term:
-			}
+				if traceEnabled {
+					s.logger.Tracef("empty term, with attempt %d", attempt)
+				}
 
-			select {
-			case <-s.tomb.Dying():
-				return tomb.ErrDying
-
-			case <-s.clock.After(defaultWaitTermTimeout):
-				// This is a critical error, we should never get here if juju
-				// is humming along. This is a sign that something is wrong
-				// with the dependencies of the worker. We have no choice but
-				// to return an error and to bounce the world.
-				return errors.Errorf("term has not been completed in time")
-
-			case empty, ok := <-term.done:
-				if !ok {
-					// If the event mux has been killed, then the term has been
-					// aborted, so we just continue. This is likely the case
-					// when the worker is dying. We don't want to block the
-					// change stream, so we just continue.
-					s.logger.Infof("term has been aborted")
+				select {
+				case <-s.tomb.Dying():
+					return tomb.ErrDying
+				case <-s.clock.After(backOffStrategy(0, attempt)):
 					continue
 				}
+			}
 
-				// Only when the term is completed, do we update the lower
-				// and upper bounds of the watermark. This ensures that all
-				// changes are read and processed from the term and that we
-				// don't prematurely update the watermark.
-				s.recordTermView(&termView{
-					lower: lower,
-					upper: upper,
-				})
-
-				// If the resulting term change set is empty, then wait for
-				// the back-off strategy to complete before attempting to
-				// read changes again.
-				// This is to prevent the worker from polling the database
-				// too frequently and allow us to attempt to coalesce changes
-				// when there is less activity.
-				if empty {
-					attempt++
-
-					if traceEnabled {
-						s.logger.Tracef("empty term, with attempt %d", attempt)
-					}
+			// Reset the attempt counter if we get changes, so the
+			// back=off strategy is reset.
+			attempt = 0
+		}
+	}
+}
 
-					select {
-					case <-s.tomb.Dying():
-						return tomb.ErrDying
-					case <-s.clock.After(backOffStrategy(0, attempt)):
-						continue
-					}
-				}
+// processTerm sends the changes as a single term to the terms channel, and
+// waits for it to be completed. It returns whether the term was empty, or
+// whether it was aborted by the consumer.
+func (s *Stream) processTerm(changes []changeEvent, traceEnabled bool) (bool, bool, error) {
+	var (
+		// Term encapsulates a set of changes that are bounded by a
+		// coalesced set.
+		term = &Term{
+			done: make(chan bool),
+		}
 
-				// Reset the attempt counter if we get changes, so the
-				// back=off strategy is reset.
-				attempt = 0
+		lower = int64(math.MaxInt64)
+		upper = int64(math.MinInt64)
+	)
+	for _, change := range changes {
+		if traceEnabled {
+			s.logger.Tracef("change event: %v", change)
+		}
+		term.changes = append(term.changes, change)
 
-				if traceEnabled {
-					s.logger.Tracef("term done: processed changes %d", len(changes))
-				}
-			}
+		if change.id < lower {
+			lower = change.id
+		}
+		if change.id > upper {
+			upper = change.id
+		}
+	}
+	if lower == math.MaxInt64 || upper == math.MinInt64 {
+		// This should never happen, but if it does, just continue.
+		s.logger.Infof("invalid lower or upper bound: lower: %d, upper: %d", lower, upper)
+		return false, false, nil
+	}
+
+	// Send the term to the terms channel, and wait for it to be
+	// completed. This will block the outer loop until the term has
+	// been completed. It is the responsibility of the consumer of the
+	// terms channel to ensure that the term is completed in the
+	// fastest possible time.
+
+	if traceEnabled {
+		s.logger.Tracef("term start: processing changes %d", len(changes))
+	}
+
+	select {
+	case <-s.tomb.Dying():
+		return false, false, tomb.ErrDying
+	case s.terms <- term:
+	}
+
+	select {
+	case <-s.tomb.Dying():
+		return false, false, tomb.ErrDying
+
+	case <-s.clock.After(defaultWaitTermTimeout):
+		// This is a critical error, we should never get here if juju
+		// is humming along. This is a sign that something is wrong
+		// with the dependencies of the worker. We have no choice but
+		// to return an error and to bounce the world.
+		return false, false, errors.Errorf("term has not been completed in time")
+
+	case empty, ok := <-term.done:
+		if !ok {
+			return false, true, nil
 		}
+
+		// Only when the term is completed, do we update the lower
+		// and upper bounds of the watermark. This ensures that all
+		// changes are read and processed from the term and that we
+		// don't prematurely update the watermark.
+		s.recordTermView(&termView{
+			lower: lower,
+			upper: upper,
+		})
+
+		if traceEnabled {
+			s.logger.Tracef("term done: processed changes %d", len(changes))
+		}
+		return empty, false, nil
+	}
+}
+
+// splitChanges splits the ordered changes into consecutive chunks of at most
+// max changes. If max is not positive, the changes are not split.
+func splitChanges(changes []changeEvent, max int) [][]changeEvent {
+	if max <= 0 || len(changes) <= max {
+		return [][]changeEvent{changes}
+	}
+	chunks := make([][]changeEvent, 0, (len(changes)+max-1)/max)
+	for len(changes) > max {
+		chunks = append(chunks, changes[:max:max])
+		changes = changes[max:]
 	}
+	return append(chunks, changes)
 }
 
 const (
@@ -46,7 +46,7 @@ func (s *streamSuite) TestWithNoNamespace(c *gc.C) {
 	s.expectClock()
 	s.expectMetrics()
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	select {
@@ -69,7 +69,7 @@ func (s *streamSuite) TestNoData(c *gc.C) {
 
 	s.insertNamespace(c, 1000, "foo")
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	select {
@@ -98,7 +98,7 @@ func (s *streamSuite) TestOneChange(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var results []changestream.ChangeEvent
@@ -137,7 +137,7 @@ func (s *streamSuite) TestOneChangeDoesNotRepeatSameChange(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var results []changestream.ChangeEvent
@@ -193,7 +193,7 @@ func (s *streamSuite) TestOneChangeWithEmptyResults(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var results []changestream.ChangeEvent
@@ -228,7 +228,7 @@ func (s *streamSuite) TestOneChangeWithClosedAbort(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var results []changestream.ChangeEvent
@@ -267,7 +267,7 @@ func (s *streamSuite) TestOneChangeWithDelayedTermDone(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var (
@@ -306,7 +306,7 @@ func (s *streamSuite) TestOneChangeWithTermDoneAfterKill(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	var (
@@ -355,7 +355,7 @@ func (s *streamSuite) TestOneChangeWithTimeoutCausesWorkerToBounce(c *gc.C) {
 	}
 	s.insertChange(c, chg)
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	select {
@@ -387,7 +387,7 @@ func (s *streamSuite) TestMultipleTerms(c *gc.C) {
 
 	s.insertNamespace(c, 1000, "foo")
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	for i := 0; i < 10; i++ {
@@ -448,7 +448,7 @@ func (s *streamSuite) TestMultipleTermsAllEmpty(c *gc.C) {
 
 	s.insertNamespace(c, 1000, "foo")
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	for i := 0; i < 10; i++ {
@@ -491,7 +491,7 @@ func (s *streamSuite) TestSecondTermDoesNotStartUntilFirstTermDone(c *gc.C) {
 
 	s.insertNamespace(c, 1000, "foo")
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Insert a change and wait for it to be streamed.
@@ -592,7 +592,7 @@ func (s *streamSuite) TestMultipleChangesWithSameUUIDCoalesce(c *gc.C) {
 		inserts = append(inserts, ch)
 	}
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Wait to ensure that the loop has been given enough time to read the
@@ -638,7 +638,7 @@ func (s *streamSuite) TestMultipleChangesWithNamespaces(c *gc.C) {
 		inserts = append(inserts, ch)
 	}
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Wait to ensure that the loop has been given enough time to read the
@@ -703,7 +703,7 @@ func (s *streamSuite) TestMultipleChangesWithNamespacesCoalesce(c *gc.C) {
 		inserts = append(inserts, ch)
 	}
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Wait to ensure that the loop has been given enough time to read the
@@ -731,6 +731,68 @@ func (s *streamSuite) TestMultipleChangesWithNamespacesCoalesce(c *gc.C) {
 	workertest.CleanKill(c, stream)
 }
 
+func (s *streamSuite) TestMultipleChangesSplitIntoTerms(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	s.expectTermAfterAnyTimes()
+	s.expectBackoffAnyTimes(done)
+	s.expectFileNotifyWatcher()
+	s.expectTimer()
+	s.expectClock()
+
+	s.metrics.EXPECT().ChangesRequestDurationObserve(gomock.Any()).AnyTimes()
+	s.metrics.EXPECT().ChangesCountObserve(gomock.Any()).AnyTimes()
+	s.metrics.EXPECT().ChangesTermSplitsInc()
+
+	s.insertNamespace(c, 1000, "foo")
+	s.insertNamespace(c, 2000, "bar")
+
+	var inserts []change
+	for i := 0; i < 8; i++ {
+		ch := change{
+			id:   ((i % 2) + 1) * 1000,
+			uuid: uuid.MustNewUUID().String(),
+		}
+		s.insertChange(c, ch)
+		inserts = append(inserts, ch)
+	}
+
+	// Force a coalesce change through, the first change should then be
+	// ordered after all the others.
+	s.insertChange(c, inserts[0])
+	inserts = append(inserts[1:], inserts[0])
+
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), 3)
+	defer workertest.DirtyKill(c, stream)
+
+	var results []changestream.ChangeEvent
+	for _, size := range []int{3, 3, 2} {
+		select {
+		case term := <-stream.Terms():
+			c.Check(term.Changes(), gc.HasLen, size)
+			results = append(results, term.Changes()...)
+			term.Done(false, make(chan struct{}))
+		case <-time.After(testing.ShortWait):
+			c.Fatal("timed out waiting for change")
+		}
+	}
+
+	c.Assert(results, gc.HasLen, 8)
+	for i, result := range results {
+		namespace := "foo"
+		if inserts[i].id == 2000 {
+			namespace = "bar"
+		}
+		c.Check(result.Namespace(), gc.Equals, namespace)
+		c.Check(result.Changed(), gc.Equals, inserts[i].uuid)
+	}
+
+	workertest.CleanKill(c, stream)
+}
+
 func (s *streamSuite) TestMultipleChangesWithNoNamespacesDoNotCoalesce(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -776,7 +838,7 @@ func (s *streamSuite) TestMultipleChangesWithNoNamespacesDoNotCoalesce(c *gc.C)
 		inserts = append(inserts, ch)
 	}
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Wait to ensure that the loop has been given enough time to read the
@@ -818,7 +880,7 @@ func (s *streamSuite) TestOneChangeIsBlockedByFile(c *gc.C) {
 
 	s.insertNamespace(c, 1000, "foo")
 
-	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(uuid.MustNewUUID().String(), s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	expectNotifyBlock := func(block bool) {
@@ -905,7 +967,7 @@ func (s *streamSuite) TestReport(c *gc.C) {
 	})
 
 	id := uuid.MustNewUUID().String()
-	stream := New(id, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(id, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	for i := 0; i < changestream.DefaultNumTermWatermarks; i++ {
@@ -949,6 +1011,7 @@ func (s *streamSuite) TestReport(c *gc.C) {
 		"id":                      id,
 		"watermarks":              constructWatermark(0, changestream.DefaultNumTermWatermarks),
 		"last-recorded-watermark": "",
+		"max-term-size":           changestream.DefaultMaxTermSize,
 	})
 
 	select {
@@ -970,6 +1033,7 @@ func (s *streamSuite) TestReport(c *gc.C) {
 		"id":                      id,
 		"watermarks":              constructWatermark(1, changestream.DefaultNumTermWatermarks),
 		"last-recorded-watermark": "(lower: 1, upper: 1)",
+		"max-term-size":           changestream.DefaultMaxTermSize,
 	})
 
 	workertest.CleanKill(c, stream)
@@ -1003,7 +1067,7 @@ func (s *streamSuite) TestWatermarkWrite(c *gc.C) {
 	})
 
 	tag := uuid.MustNewUUID().String()
-	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	for i := 0; i < changestream.DefaultNumTermWatermarks; i++ {
@@ -1067,7 +1131,7 @@ func (s *streamSuite) TestWatermarkWriteIsIgnored(c *gc.C) {
 	})
 
 	tag := uuid.MustNewUUID().String()
-	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	for i := 0; i < changestream.DefaultNumTermWatermarks-1; i++ {
@@ -1131,7 +1195,7 @@ func (s *streamSuite) TestWatermarkWriteUpdatesToTheLaterOne(c *gc.C) {
 	})
 
 	tag := uuid.MustNewUUID().String()
-	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c))
+	stream := New(tag, s.TxnRunner(), s.FileNotifier, s.clock, s.metrics, loggertesting.WrapCheckLog(c), changestream.DefaultMaxTermSize)
 	defer workertest.DirtyKill(c, stream)
 
 	// Insert the first change, which will be the first watermark.
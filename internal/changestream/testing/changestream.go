@@ -48,7 +48,7 @@ func NewTestWatchableDB(c *gc.C, id string, db database.TxnRunner) *TestWatchabl
 	states := make(chan string, 1)
 
 	logger := loggertesting.WrapCheckLog(c)
-	stream := stream.NewInternalStates(id, db, newNoopFileWatcher(), clock.WallClock, noopMetrics{}, logger, states)
+	stream := stream.NewInternalStates(id, db, newNoopFileWatcher(), clock.WallClock, noopMetrics{}, logger, changestream.DefaultMaxTermSize, states)
 	mux, err := eventmultiplexer.New(stream, clock.WallClock, noopMetrics{}, logger)
 	c.Assert(err, jc.ErrorIsNil)
 
@@ -153,6 +153,7 @@ func (noopMetrics) WatermarkInsertsInc()                             {}
 func (noopMetrics) WatermarkRetriesInc()                             {}
 func (noopMetrics) ChangesRequestDurationObserve(val float64)        {}
 func (noopMetrics) ChangesCountObserve(val int)                      {}
+func (noopMetrics) ChangesTermSplitsInc()                            {}
 func (noopMetrics) SubscriptionsInc()                                {}
 func (noopMetrics) SubscriptionsDec()                                {}
 func (noopMetrics) DispatchDurationObserve(val float64, failed bool) {}
@@ -61,13 +61,38 @@ type Command interface {
 }
 
 // CommandBase provides the default implementation for SetFlags, Init, and Help.
-type CommandBase struct{}
+type CommandBase struct {
+	output    Output
+	outputErr error
+}
 
 // IsSuperCommand implements Command.IsSuperCommand
 func (c *CommandBase) IsSuperCommand() bool {
 	return false
 }
 
+// AddFormatFlags injects the --format and --output command line flags into
+// f, offering the given formats from the DefaultFormatterRegistry. If no
+// formats are given, all the registered formats are offered. Values are then
+// written in the selected format with WriteFormatted. If the default format
+// is not offered, no flags are added and WriteFormatted returns the error.
+func (c *CommandBase) AddFormatFlags(f *gnuflag.FlagSet, defaultFormat string, formats ...string) {
+	c.outputErr = c.output.AddRegisteredFlags(f, DefaultFormatterRegistry, defaultFormat, formats...)
+}
+
+// WriteFormatted writes the value using the format selected with the
+// --format flag added by AddFormatFlags, to the output selected with the
+// --output flag.
+func (c *CommandBase) WriteFormatted(ctx *Context, value interface{}) error {
+	if c.outputErr != nil {
+		return c.outputErr
+	}
+	if c.output.formatter == nil {
+		return fmt.Errorf("format flags not added to command")
+	}
+	return c.output.Write(ctx, value)
+}
+
 // SetFlags does nothing in the simplest case.
 func (c *CommandBase) SetFlags(f *gnuflag.FlagSet) {}
 
@@ -0,0 +1,98 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENSE file for details.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FormatterRegistry holds the named formatters that commands can offer with
+// the --format flag. Commands select the formats they support from a
+// registry, so that a format name produces the same kind of output across all
+// commands. Plugins can register additional formats.
+type FormatterRegistry struct {
+	mu         sync.RWMutex
+	formatters map[string]TypeFormatter
+}
+
+// NewFormatterRegistry returns a registry holding the given formatters.
+func NewFormatterRegistry(initial map[string]TypeFormatter) *FormatterRegistry {
+	r := &FormatterRegistry{
+		formatters: make(map[string]TypeFormatter, len(initial)),
+	}
+	for name, f := range initial {
+		r.formatters[name] = f
+	}
+	return r
+}
+
+// Register adds a formatter to the registry under the given name. It is an
+// error to register a formatter under a name that is already registered.
+func (r *FormatterRegistry) Register(name string, formatter TypeFormatter) error {
+	if name == "" {
+		return fmt.Errorf("formatter name cannot be empty")
+	}
+	if formatter.Formatter == nil {
+		return fmt.Errorf("formatter %q cannot be nil", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.formatters[name]; ok {
+		return fmt.Errorf("formatter %q already registered", name)
+	}
+	r.formatters[name] = formatter
+	return nil
+}
+
+// Lookup returns the formatter registered under the given name.
+func (r *FormatterRegistry) Lookup(name string) (TypeFormatter, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	f, ok := r.formatters[name]
+	return f, ok
+}
+
+// selectRegistered returns the formatters registered under the given names,
+// ignoring any names which are not registered. If no names are given, all the
+// registered formatters are returned.
+func (r *FormatterRegistry) selectRegistered(names ...string) map[string]Formatter {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(names) == 0 {
+		return formatters(r.formatters).Formatters()
+	}
+	result := make(map[string]Formatter, len(names))
+	for _, name := range names {
+		if f, ok := r.formatters[name]; ok {
+			result[name] = f.Formatter
+		}
+	}
+	return result
+}
+
+// DefaultFormatterRegistry holds the formatters available to all commands.
+// It initially holds the DefaultFormatters.
+var DefaultFormatterRegistry = NewFormatterRegistry(DefaultFormatters)
+
+// RegisterFormatter adds a formatter to the DefaultFormatterRegistry under
+// the given name, making it available to commands which select it.
+func RegisterFormatter(name string, formatter TypeFormatter) error {
+	return DefaultFormatterRegistry.Register(name, formatter)
+}
+
+// notSupportedFormatError returns the error for a format which is known to
+// the registry, but is not supported by the command.
+func notSupportedFormatError(name string, supported map[string]Formatter) error {
+	names := make([]string, 0, len(supported))
+	for n := range supported {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return fmt.Errorf("format %q not supported by this command, supported formats: %s",
+		name, strings.Join(names, ", "))
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the LGPLv3, see LICENSE file for details.
+
+package cmd_test
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/juju/gnuflag"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	"github.com/juju/juju/internal/cmd"
+	"github.com/juju/juju/internal/cmd/cmdtesting"
+)
+
+type FormatterRegistrySuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&FormatterRegistrySuite{})
+
+func formatCSV(writer io.Writer, value interface{}) error {
+	_, err := fmt.Fprintf(writer, "%v\n", value)
+	return err
+}
+
+func (s *FormatterRegistrySuite) TestRegister(c *gc.C) {
+	registry := cmd.NewFormatterRegistry(cmd.DefaultFormatters)
+
+	err := registry.Register("csv", cmd.TypeFormatter{Formatter: formatCSV, Serialisable: true})
+	c.Assert(err, jc.ErrorIsNil)
+
+	f, ok := registry.Lookup("csv")
+	c.Assert(ok, jc.IsTrue)
+	c.Check(f.Serialisable, jc.IsTrue)
+
+	// The registry is independent of the initial formatters.
+	_, ok = cmd.DefaultFormatters["csv"]
+	c.Check(ok, jc.IsFalse)
+}
+
+func (s *FormatterRegistrySuite) TestRegisterDuplicate(c *gc.C) {
+	registry := cmd.NewFormatterRegistry(cmd.DefaultFormatters)
+
+	err := registry.Register("json", cmd.TypeFormatter{Formatter: formatCSV})
+	c.Assert(err, gc.ErrorMatches, `formatter "json" already registered`)
+}
+
+func (s *FormatterRegistrySuite) TestRegisterInvalid(c *gc.C) {
+	registry := cmd.NewFormatterRegistry(nil)
+
+	err := registry.Register("", cmd.TypeFormatter{Formatter: formatCSV})
+	c.Assert(err, gc.ErrorMatches, `formatter name cannot be empty`)
+	err = registry.Register("csv", cmd.TypeFormatter{})
+	c.Assert(err, gc.ErrorMatches, `formatter "csv" cannot be nil`)
+}
+
+// registryOutputCommand is a command that uses the formatter registry
+// through its CommandBase.
+type registryOutputCommand struct {
+	cmd.CommandBase
+	formats []string
+	value   interface{}
+}
+
+func (c *registryOutputCommand) Info() *cmd.Info {
+	return &cmd.Info{
+		Name:    "registry-output",
+		Purpose: "I like to output",
+	}
+}
+
+func (c *registryOutputCommand) SetFlags(f *gnuflag.FlagSet) {
+	c.AddFormatFlags(f, "yaml", c.formats...)
+}
+
+func (c *registryOutputCommand) Run(ctx *cmd.Context) error {
+	return c.WriteFormatted(ctx, c.value)
+}
+
+func (s *FormatterRegistrySuite) TestCommandWriteFormatted(c *gc.C) {
+	command := &registryOutputCommand{
+		formats: []string{"json", "yaml"},
+		value:   map[string]int{"juju": 1},
+	}
+	ctx, err := cmdtesting.RunCommand(c, command, "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `{"juju":1}`+"\n")
+}
+
+func (s *FormatterRegistrySuite) TestCommandDefaultFormat(c *gc.C) {
+	command := &registryOutputCommand{
+		value: map[string]int{"juju": 1},
+	}
+	ctx, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, "juju: 1\n")
+}
+
+func (s *FormatterRegistrySuite) TestCommandFormatNotSupported(c *gc.C) {
+	command := &registryOutputCommand{
+		formats: []string{"json", "yaml"},
+	}
+	_, err := cmdtesting.RunCommand(c, command, "--format", "smart")
+	c.Assert(err, gc.ErrorMatches,
+		`invalid value "smart" for flag --format: format "smart" not supported by this command, supported formats: json, yaml`)
+}
+
+func (s *FormatterRegistrySuite) TestCommandUnknownFormat(c *gc.C) {
+	command := &registryOutputCommand{
+		formats: []string{"json", "yaml"},
+	}
+	_, err := cmdtesting.RunCommand(c, command, "--format", "cuneiform")
+	c.Assert(err, gc.ErrorMatches, `invalid value "cuneiform" for flag --format: unknown format "cuneiform"`)
+}
+
+func (s *FormatterRegistrySuite) TestCommandOffersUnregisteredFormat(c *gc.C) {
+	command := &registryOutputCommand{
+		formats: []string{"json", "yaml", "cuneiform"},
+		value:   map[string]int{"juju": 1},
+	}
+	ctx, err := cmdtesting.RunCommand(c, command, "--format", "json")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(cmdtesting.Stdout(ctx), gc.Equals, `{"juju":1}`+"\n")
+
+	_, err = cmdtesting.RunCommand(c, command, "--format", "cuneiform")
+	c.Assert(err, gc.ErrorMatches, `invalid value "cuneiform" for flag --format: unknown format "cuneiform"`)
+}
+
+func (s *FormatterRegistrySuite) TestCommandWithoutFormatFlags(c *gc.C) {
+	var base cmd.CommandBase
+	err := base.WriteFormatted(cmdtesting.Context(c), nil)
+	c.Assert(err, gc.ErrorMatches, `format flags not added to command`)
+}
+
+func (s *FormatterRegistrySuite) TestCommandDefaultFormatNotOffered(c *gc.C) {
+	command := &registryOutputCommand{
+		formats: []string{"json", "smart"},
+		value:   map[string]int{"juju": 1},
+	}
+	_, err := cmdtesting.RunCommand(c, command)
+	c.Assert(err, gc.ErrorMatches, `default format: format "yaml" not supported by this command, supported formats: json, smart`)
+}
+
+func (s *FormatterRegistrySuite) TestAddRegisteredFlagsUnknownDefault(c *gc.C) {
+	var output cmd.Output
+	f := gnuflag.NewFlagSet("", gnuflag.ContinueOnError)
+	err := output.AddRegisteredFlags(f, cmd.DefaultFormatterRegistry, "cuneiform")
+	c.Assert(err, gc.ErrorMatches, `default format: unknown format "cuneiform"`)
+	c.Check(f.Lookup("format"), gc.IsNil)
+}
@@ -116,6 +116,7 @@ var DefaultFormatters = formatters{
 type formatterValue struct {
 	name       string
 	formatters map[string]Formatter
+	registry   *FormatterRegistry
 }
 
 // newFormatterValue returns a new formatterValue. The initial Formatter name
@@ -131,6 +132,11 @@ func newFormatterValue(initial string, formatters map[string]Formatter) *formatt
 // Set stores the chosen formatter name in v.name.
 func (v *formatterValue) Set(value string) error {
 	if v.formatters[value] == nil {
+		if v.registry != nil {
+			if _, ok := v.registry.Lookup(value); ok {
+				return notSupportedFormatError(value, v.formatters)
+			}
+		}
 		return fmt.Errorf("unknown format %q", value)
 	}
 	v.name = value
@@ -169,6 +175,29 @@ func (c *Output) AddFlags(f *gnuflag.FlagSet, defaultFormatter string, formatter
 	f.StringVar(&c.outPath, "output", "", "")
 }
 
+// AddRegisteredFlags injects the --format and --output command line flags
+// into f, offering the given formats from the registry. If no formats are
+// given, all the formats in the registry are offered. Requesting a format
+// which is registered but not offered results in an error listing the
+// offered formats. Formats which are not registered are not offered, so
+// requesting one results in the usual unknown format error.
+// An error is returned, and no flags are added, if the default format is not
+// one of the offered formats.
+func (c *Output) AddRegisteredFlags(f *gnuflag.FlagSet, registry *FormatterRegistry, defaultFormatter string, formats ...string) error {
+	value := &formatterValue{
+		formatters: registry.selectRegistered(formats...),
+		registry:   registry,
+	}
+	if err := value.Set(defaultFormatter); err != nil {
+		return fmt.Errorf("default format: %w", err)
+	}
+	c.formatter = value
+	f.Var(c.formatter, "format", c.formatter.doc())
+	f.StringVar(&c.outPath, "o", "", "Specify an output file")
+	f.StringVar(&c.outPath, "output", "", "")
+	return nil
+}
+
 // Write formats and outputs the value as directed by the --format and
 // --output command line flags.
 func (c *Output) Write(ctx *Context, value interface{}) (err error) {
@@ -10,7 +10,6 @@ import (
 // versionCommand is a cmd.Command that prints the current version.
 type versionCommand struct {
 	CommandBase
-	out           Output
 	version       string
 	versionDetail interface{}
 
@@ -32,17 +31,13 @@ func (v *versionCommand) Info() *Info {
 }
 
 func (v *versionCommand) SetFlags(f *gnuflag.FlagSet) {
-	formatters := make(map[string]Formatter, len(DefaultFormatters))
-	for k, v := range DefaultFormatters {
-		formatters[k] = v.Formatter
-	}
-	v.out.AddFlags(f, "smart", formatters)
+	v.AddFormatFlags(f, "smart", "smart", "yaml", "json")
 	f.BoolVar(&v.showAll, "all", false, "Prints all version information")
 }
 
 func (v *versionCommand) Run(ctxt *Context) error {
 	if v.showAll {
-		return v.out.Write(ctxt, v.versionDetail)
+		return v.WriteFormatted(ctxt, v.versionDetail)
 	}
-	return v.out.Write(ctxt, v.version)
+	return v.WriteFormatted(ctxt, v.version)
 }
@@ -127,7 +127,7 @@ func BootstrapDqlite(
 	return nil
 }
 
-func runMigration(ctx context.Context, dqlite *app.App, namespace string, schema Schema, init bootstrapInit, logger logger.Logger) (coredatabase.TxnRunner, error) {
+func runMigration(ctx context.Context, dqlite *app.App, namespace string, ddl Schema, init bootstrapInit, logger logger.Logger) (coredatabase.TxnRunner, error) {
 	db, err := dqlite.Open(ctx, namespace)
 	if err != nil {
 		return nil, errors.Annotatef(err, "opening database for namespace %q", namespace)
@@ -139,11 +139,15 @@ func runMigration(ctx context.Context, dqlite *app.App, namespace string, schema
 
 	runner := &txnRunner{db: db}
 
-	migration := NewDBMigration(runner, logger, schema)
+	migration := NewDBMigration(runner, logger, ddl)
 	if err := migration.Apply(ctx); err != nil {
 		return nil, errors.Annotatef(err, "creating database with namespace %q schema", namespace)
 	}
 
+	if err := schema.RecordMigrationChecksum(ctx, runner); err != nil {
+		return nil, errors.Annotatef(err, "recording schema checksum for namespace %q", namespace)
+	}
+
 	if err := init(ctx, runner, dqlite); err != nil {
 		return nil, errors.Annotatef(err, "running init for database with namespace %q", namespace)
 	}
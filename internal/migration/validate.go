@@ -0,0 +1,172 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/juju/description/v8"
+	"github.com/juju/errors"
+
+	"github.com/juju/juju/core/migration"
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	"github.com/juju/juju/internal/charm"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+)
+
+// ValidateBinariesConfig provides the configuration that ValidateBinaries
+// needs to cross-check a model description against the binaries to be
+// transferred with it.
+type ValidateBinariesConfig struct {
+	// ModelBytes is the serialized model description.
+	ModelBytes []byte
+
+	// Charms are the URLs of the charms to be transferred.
+	Charms []string
+	// CharmService is used to check the charms are in the source store.
+	CharmService CharmService
+
+	// Resources are the resources to be transferred.
+	Resources []migration.SerializedModelResource
+}
+
+// Validate makes sure that all the config values are non-nil.
+func (c *ValidateBinariesConfig) Validate() error {
+	if c.CharmService == nil {
+		return errors.NotValidf("missing CharmService")
+	}
+	return nil
+}
+
+// ValidateBinaries checks, before anything is sent to the target controller,
+// that every charm and resource referenced by the model description will be
+// transferred with it. Charms are checked against the charms to be uploaded
+// and the source charm store; resources are checked against the resources to
+// be uploaded by their recorded identity: the revision of a store resource,
+// or the fingerprint of an uploaded one. Every missing or mismatched
+// artefact is reported in the returned error, so they can all be fixed at
+// once.
+func ValidateBinaries(ctx context.Context, config ValidateBinariesConfig) error {
+	if err := config.Validate(); err != nil {
+		return errors.Trace(err)
+	}
+	model, err := description.Deserialize(config.ModelBytes)
+	if err != nil {
+		return errors.Annotate(err, "deserializing model")
+	}
+
+	missingCharms, err := missingCharms(ctx, model, config)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	missingResources := missingResources(model, config.Resources)
+	if len(missingCharms) == 0 && len(missingResources) == 0 {
+		return nil
+	}
+
+	var missing []string
+	for _, curl := range missingCharms {
+		missing = append(missing, fmt.Sprintf("charm %s", curl))
+	}
+	missing = append(missing, missingResources...)
+	return errors.NotFoundf("binaries referenced by the model (%s)", strings.Join(missing, ", "))
+}
+
+// missingCharms returns the URLs of the charms used by applications in the
+// model which are either not going to be transferred or are not in the
+// source charm store.
+func missingCharms(ctx context.Context, model description.Model, config ValidateBinariesConfig) ([]string, error) {
+	toTransfer := make(map[string]bool, len(config.Charms))
+	for _, curl := range config.Charms {
+		toTransfer[curl] = true
+	}
+
+	var (
+		missing []string
+		checked = make(map[string]bool)
+	)
+	for _, app := range model.Applications() {
+		charmURL := app.CharmURL()
+		if checked[charmURL] {
+			continue
+		}
+		checked[charmURL] = true
+
+		if !toTransfer[charmURL] {
+			missing = append(missing, charmURL)
+			continue
+		}
+		curl, err := charm.ParseURL(charmURL)
+		if err != nil {
+			return nil, errors.Annotatef(err, "bad charm URL %q", charmURL)
+		}
+		charmSource, err := domaincharm.ParseCharmSchema(charm.Schema(curl.Schema))
+		if err != nil {
+			return nil, errors.Annotatef(err, "bad charm URL schema %q", charmURL)
+		}
+		_, err = config.CharmService.GetCharmID(ctx, domaincharm.GetCharmArgs{
+			Name:     curl.Name,
+			Revision: ptr(curl.Revision),
+			Source:   charmSource,
+		})
+		if errors.Is(err, applicationerrors.CharmNotFound) {
+			missing = append(missing, charmURL)
+		} else if err != nil {
+			return nil, errors.Annotatef(err, "checking charm %s", charmURL)
+		}
+	}
+	return missing, nil
+}
+
+// missingResources returns a description of each application resource
+// revision in the model which is not going to be transferred, or whose
+// transferred revision has a different identity. Placeholders, which have no
+// content yet, are not transferred and so are not checked.
+func missingResources(model description.Model, resources []migration.SerializedModelResource) []string {
+	toTransfer := make(map[string]migration.SerializedModelResource, len(resources))
+	for _, res := range resources {
+		rev := res.ApplicationRevision
+		toTransfer[rev.ApplicationID+"/"+rev.Name] = res
+	}
+
+	var missing []string
+	for _, app := range model.Applications() {
+		for _, res := range app.Resources() {
+			rev := res.ApplicationRevision()
+			if rev == nil || rev.Timestamp().IsZero() {
+				continue
+			}
+			key := app.Name() + "/" + res.Name()
+			transferred, ok := toTransfer[key]
+			if !ok || !sameResourceIdentity(rev, transferred.ApplicationRevision.Resource) {
+				missing = append(missing, fmt.Sprintf("resource %s (%s)", key, resourceIdentity(rev)))
+			}
+		}
+	}
+	return missing
+}
+
+// sameResourceIdentity reports whether the transferred resource is the
+// revision recorded in the model description. Store resources are identified
+// by their revision and uploaded resources, which have no meaningful
+// revision, by their fingerprint.
+func sameResourceIdentity(rev description.ResourceRevision, transferred charmresource.Resource) bool {
+	if rev.Origin() != transferred.Origin.String() {
+		return false
+	}
+	if transferred.Origin == charmresource.OriginStore {
+		return rev.Revision() == transferred.Revision
+	}
+	return rev.FingerprintHex() == transferred.Fingerprint.Hex()
+}
+
+func resourceIdentity(rev description.ResourceRevision) string {
+	if rev.Origin() == charmresource.OriginStore.String() {
+		return fmt.Sprintf("store revision %d", rev.Revision())
+	}
+	return fmt.Sprintf("%s fingerprint %s", rev.Origin(), rev.FingerprintHex())
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package migration_test
+
+import (
+	"context"
+
+	"github.com/juju/description/v8"
+	"github.com/juju/errors"
+	"github.com/juju/names/v5"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	corecharm "github.com/juju/juju/core/charm"
+	coremigration "github.com/juju/juju/core/migration"
+	"github.com/juju/juju/core/resource"
+	resourcetesting "github.com/juju/juju/core/resource/testing"
+	domaincharm "github.com/juju/juju/domain/application/charm"
+	applicationerrors "github.com/juju/juju/domain/application/errors"
+	charmresource "github.com/juju/juju/internal/charm/resource"
+	"github.com/juju/juju/internal/migration"
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type ValidateBinariesSuite struct {
+	coretesting.BaseSuite
+}
+
+var _ = gc.Suite(&ValidateBinariesSuite{})
+
+func (s *ValidateBinariesSuite) TestValidateBinariesConfigValidate(c *gc.C) {
+	config := migration.ValidateBinariesConfig{}
+	c.Check(config.Validate(), gc.ErrorMatches, "missing CharmService not valid")
+}
+
+func (s *ValidateBinariesSuite) TestValidateBinaries(c *gc.C) {
+	uploaded := resourcetesting.NewResource(c, nil, "data", "mysql", "content").Resource
+	store := resourcetesting.NewResource(c, nil, "image", "mysql", "image").Resource
+	store.Origin = charmresource.OriginStore
+	store.Revision = 3
+
+	model := s.newModel()
+	s.addApplication(model, "mysql", "ch:mysql-1", uploaded, store)
+
+	err := migration.ValidateBinaries(context.Background(), migration.ValidateBinariesConfig{
+		ModelBytes:   s.serialize(c, model),
+		Charms:       []string{"ch:mysql-1"},
+		CharmService: &storeCharmService{charms: []string{"mysql"}},
+		Resources: []coremigration.SerializedModelResource{
+			{ApplicationRevision: uploaded},
+			{ApplicationRevision: store},
+		},
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *ValidateBinariesSuite) TestValidateBinariesReportsAllMissing(c *gc.C) {
+	uploaded := resourcetesting.NewResource(c, nil, "data", "mysql", "content").Resource
+	store := resourcetesting.NewResource(c, nil, "image", "mysql", "image").Resource
+	store.Origin = charmresource.OriginStore
+	store.Revision = 3
+	placeholder := resourcetesting.NewPlaceholderResource(c, "config", "mysql")
+	missing := resourcetesting.NewResource(c, nil, "data", "wordpress", "content").Resource
+
+	model := s.newModel()
+	s.addApplication(model, "mysql", "ch:mysql-1", uploaded, store, placeholder)
+	s.addApplication(model, "wordpress", "ch:wordpress-2", missing)
+	s.addApplication(model, "postgresql", "ch:postgresql-3")
+
+	// The uploaded resource has different content, the store resource a
+	// different revision, and the wordpress resource isn't transferred.
+	// The wordpress charm isn't in the store and the postgresql charm isn't
+	// transferred at all.
+	otherUploaded := resourcetesting.NewResource(c, nil, "data", "mysql", "other").Resource
+	otherStore := store
+	otherStore.Revision = 4
+
+	err := migration.ValidateBinaries(context.Background(), migration.ValidateBinariesConfig{
+		ModelBytes:   s.serialize(c, model),
+		Charms:       []string{"ch:mysql-1", "ch:wordpress-2"},
+		CharmService: &storeCharmService{charms: []string{"mysql"}},
+		Resources: []coremigration.SerializedModelResource{
+			{ApplicationRevision: otherUploaded},
+			{ApplicationRevision: otherStore},
+		},
+	})
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+	c.Check(err, gc.ErrorMatches, `binaries referenced by the model \(`+
+		`charm ch:wordpress-2, charm ch:postgresql-3, `+
+		`resource mysql/data \(upload fingerprint [0-9a-f]+\), `+
+		`resource mysql/image \(store revision 3\), `+
+		`resource wordpress/data \(upload fingerprint [0-9a-f]+\)\) not found`)
+}
+
+func (s *ValidateBinariesSuite) newModel() description.Model {
+	model := description.NewModel(description.ModelArgs{
+		Type:   "iaas",
+		Owner:  names.NewUserTag("admin"),
+		Config: coretesting.FakeConfig(),
+	})
+	model.SetStatus(description.StatusArgs{Value: "available"})
+	return model
+}
+
+func (s *ValidateBinariesSuite) addApplication(model description.Model, name, charmURL string, resources ...resource.Resource) {
+	app := model.AddApplication(description.ApplicationArgs{
+		Tag:      names.NewApplicationTag(name),
+		CharmURL: charmURL,
+	})
+	app.SetStatus(description.StatusArgs{Value: "active"})
+	for _, res := range resources {
+		descRes := app.AddResource(description.ResourceArgs{Name: res.Name})
+		descRes.SetApplicationRevision(description.ResourceRevisionArgs{
+			Revision:       res.Revision,
+			Type:           res.Type.String(),
+			Path:           res.Path,
+			Origin:         res.Origin.String(),
+			FingerprintHex: res.Fingerprint.Hex(),
+			Size:           res.Size,
+			Timestamp:      res.Timestamp,
+			Username:       res.Username,
+		})
+	}
+}
+
+func (s *ValidateBinariesSuite) serialize(c *gc.C, model description.Model) []byte {
+	bytes, err := description.Serialize(model)
+	c.Assert(err, jc.ErrorIsNil)
+	return bytes
+}
+
+// storeCharmService is a charm service whose store holds the named charms.
+type storeCharmService struct {
+	migration.CharmService
+	charms []string
+}
+
+func (s *storeCharmService) GetCharmID(_ context.Context, args domaincharm.GetCharmArgs) (corecharm.ID, error) {
+	for _, name := range s.charms {
+		if name == args.Name {
+			return corecharm.NewID()
+		}
+	}
+	return "", applicationerrors.CharmNotFound
+}
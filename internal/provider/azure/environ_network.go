@@ -101,13 +101,33 @@ func (env *azureEnviron) allSubnets(ctx envcontext.ProviderCallContext) ([]netwo
 		}
 
 		results = append(results, network.SubnetInfo{
-			CIDR:       cidr,
-			ProviderId: network.Id(id),
+			CIDR:               cidr,
+			ProviderId:         network.Id(id),
+			ProviderAttributes: subnetProviderAttributes(sub.Properties),
 		})
 	}
 	return results, nil
 }
 
+// subnetProviderAttributes returns the Azure specific attributes of the
+// subnet. Service endpoints are recorded as a comma separated list of
+// service names.
+func subnetProviderAttributes(props *azurenetwork.SubnetPropertiesFormat) map[string]string {
+	var services []string
+	for _, ep := range props.ServiceEndpoints {
+		if ep == nil || toValue(ep.Service) == "" {
+			continue
+		}
+		services = append(services, toValue(ep.Service))
+	}
+	if len(services) == 0 {
+		return nil
+	}
+	return map[string]string{
+		"service-endpoints": strings.Join(services, ","),
+	}
+}
+
 func (env *azureEnviron) allPublicIPs(ctx envcontext.ProviderCallContext) (map[string]network.ProviderAddress, error) {
 	idToIPMap := make(map[string]network.ProviderAddress)
 
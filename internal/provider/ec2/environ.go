@@ -1305,6 +1305,23 @@ func (e *environ) Instances(ctx envcontext.ProviderCallContext, ids []instance.I
 	return insts, nil
 }
 
+// InstanceTags returns the tags of the instance with the given ID, including
+// any added outside of Juju.
+func (e *environ) InstanceTags(ctx envcontext.ProviderCallContext, id instance.Id) (map[string]string, error) {
+	insts, err := e.Instances(ctx, []instance.Id{id})
+	if err == environs.ErrNoInstances {
+		return nil, errors.NotFoundf("instance %q", id)
+	} else if err != nil {
+		return nil, errors.Trace(err)
+	}
+	inst := insts[0].(*sdkInstance)
+	tags := make(map[string]string, len(inst.i.Tags))
+	for _, tag := range inst.i.Tags {
+		tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+	}
+	return tags, nil
+}
+
 // gatherInstances tries to get information on each instance
 // id whose corresponding insts slot is nil.
 //
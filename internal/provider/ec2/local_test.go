@@ -2241,6 +2241,27 @@ func (t *localServerSuite) TestInstanceTags(c *gc.C) {
 	})
 }
 
+func (t *localServerSuite) TestEnvironInstanceTags(c *gc.C) {
+	env := t.prepareAndBootstrap(c)
+
+	instances, err := env.AllRunningInstances(t.callCtx)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(instances, gc.HasLen, 1)
+
+	tagger, ok := env.(interface {
+		InstanceTags(envcontext.ProviderCallContext, instance.Id) (map[string]string, error)
+	})
+	c.Assert(ok, jc.IsTrue)
+
+	tags, err := tagger.InstanceTags(t.callCtx, instances[0].Id())
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(tags["juju-model-uuid"], gc.Equals, coretesting.ModelTag.Id())
+	c.Check(tags["juju-is-controller"], gc.Equals, "true")
+
+	_, err = tagger.InstanceTags(t.callCtx, "i-missing")
+	c.Check(err, jc.ErrorIs, errors.NotFound)
+}
+
 func (t *localServerSuite) TestRootDiskTags(c *gc.C) {
 	env := t.prepareAndBootstrap(c)
 
@@ -92,12 +92,14 @@ func (e *environ) getMatchingSubnets(
 			return nil, errors.NotFoundf("network %q for subnet %q", subnet.Network, subnet.Name)
 		}
 		if subnetIds.Include(subnet.Name) {
-			results = append(results, makeSubnetInfo(
+			info := makeSubnetInfo(
 				corenetwork.Id(subnet.Name),
 				corenetwork.Id(netwk.Name),
 				subnet.IpCidrRange,
 				zones,
-			))
+			)
+			info.ProviderAttributes = subnetProviderAttributes(subnet)
+			results = append(results, info)
 		}
 	}
 	// We have to include networks in 'LEGACY' mode that do not have subnetworks.
@@ -361,6 +363,21 @@ func makeSubnetInfo(
 	}
 }
 
+// subnetProviderAttributes returns the GCE specific attributes of the
+// subnet. Secondary ranges are recorded as comma separated name=cidr pairs.
+func subnetProviderAttributes(subnet *compute.Subnetwork) map[string]string {
+	if len(subnet.SecondaryIpRanges) == 0 {
+		return nil
+	}
+	ranges := make([]string, len(subnet.SecondaryIpRanges))
+	for i, r := range subnet.SecondaryIpRanges {
+		ranges[i] = fmt.Sprintf("%s=%s", r.RangeName, r.IpCidrRange)
+	}
+	return map[string]string{
+		"secondary-ranges": strings.Join(ranges, ","),
+	}
+}
+
 // IncludeSet represents a set of items that can be crossed off once,
 // and when you're finished crossing items off then you can see what's
 // left.
@@ -129,6 +129,32 @@ func (s *environNetSuite) TestRestrictingToSubnets(c *gc.C) {
 	}})
 }
 
+func (s *environNetSuite) TestSubnetsWithSecondaryRanges(c *gc.C) {
+	s.cannedData()
+	s.FakeConn.Subnets[1].SecondaryIpRanges = []*compute.SubnetworkSecondaryRange{{
+		RangeName:   "pods",
+		IpCidrRange: "10.4.0.0/14",
+	}, {
+		RangeName:   "services",
+		IpCidrRange: "10.8.0.0/20",
+	}}
+
+	subnets, err := s.NetEnv.Subnets(s.CallCtx, instance.UnknownId, []corenetwork.Id{
+		"shellac",
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	c.Assert(subnets, gc.DeepEquals, []corenetwork.SubnetInfo{{
+		ProviderId:        "shellac",
+		ProviderNetworkId: "albini",
+		CIDR:              "10.0.20.0/24",
+		AvailabilityZones: []string{"a-zone", "b-zone"},
+		VLANTag:           0,
+		ProviderAttributes: map[string]string{
+			"secondary-ranges": "pods=10.4.0.0/14,services=10.8.0.0/20",
+		},
+	}})
+}
+
 func (s *environNetSuite) TestRestrictingToSubnetsWithMissing(c *gc.C) {
 	s.cannedData()
 
@@ -1125,6 +1125,18 @@ func (env *maasEnviron) getInstance(ctx envcontext.ProviderCallContext, instId i
 	return inst, nil
 }
 
+// InstanceHardwareCharacteristics returns the hardware characteristics of
+// the instance with the given ID, as currently reported by MAAS.
+func (env *maasEnviron) InstanceHardwareCharacteristics(
+	ctx envcontext.ProviderCallContext, id instance.Id,
+) (*instance.HardwareCharacteristics, error) {
+	inst, err := env.getInstance(ctx, id)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return inst.(*maasInstance).hardwareCharacteristics()
+}
+
 // subnetToSpaceIds fetches the spaces from MAAS and builds a map of subnets to
 // space ids.
 func (env *maasEnviron) subnetToSpaceIds(ctx envcontext.ProviderCallContext) (map[string]corenetwork.Id, error) {
@@ -177,6 +177,28 @@ func (suite *maasEnvironSuite) TestInstances(c *gc.C) {
 	c.Assert(actualMachines, gc.DeepEquals, expectedMachines)
 }
 
+func (suite *maasEnvironSuite) TestInstanceHardwareCharacteristics(c *gc.C) {
+	machine := newFakeMachine("jake", arch.DefaultArchitecture, "Deployed")
+	machine.cpuCount = 4
+	machine.memory = 2048
+	machine.zoneName = "zone1"
+	machine.tags = []string{"fast"}
+	controller := newFakeController()
+	controller.machines = []gomaasapi.Machine{machine}
+	env := suite.makeEnviron(c, controller)
+
+	hc, err := env.InstanceHardwareCharacteristics(suite.callCtx, "jake")
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(hc.String(), gc.Equals, fmt.Sprintf("arch=%s cores=4 mem=2048M tags=fast availability-zone=zone1", arch.DefaultArchitecture))
+}
+
+func (suite *maasEnvironSuite) TestInstanceHardwareCharacteristicsNotFound(c *gc.C) {
+	env := suite.makeEnvironWithMachines(c, []string{"jake"}, nil)
+
+	_, err := env.InstanceHardwareCharacteristics(suite.callCtx, "jake")
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+}
+
 func (suite *maasEnvironSuite) TestInstancesInvalidCredential(c *gc.C) {
 	controller := &fakeController{
 		machinesError: gomaasapi.NewPermissionError("fail auth here"),
@@ -22,3 +22,14 @@ type ConfigChangedMessage struct {
 	// different machines, and the forwarding of those messages cross each other.
 	// Adding a version could allow subscribers to ignore lower versioned messages.
 }
+
+// PermissionsChanged messages are published by the apiserver client
+// controller facade whenever a user's access is changed, so that API
+// connections stop using permissions they have cached.
+// data: `PermissionsChangedMessage`
+const PermissionsChanged = "controller.permissions-changed"
+
+// PermissionsChangedMessage identifies the user whose access changed.
+type PermissionsChangedMessage struct {
+	UserName string
+}
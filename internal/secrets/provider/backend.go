@@ -22,6 +22,14 @@ type SecretsBackend interface {
 	DeleteContent(_ context.Context, revisionId string) error
 }
 
+// SupportCapacity is implemented by secrets backends which have a hard
+// limit on the number of secret revisions they can store.
+type SupportCapacity interface {
+	// MaxSecretRevisions returns the maximum number of secret revisions
+	// the backend can store, or nil if there is no hard limit.
+	MaxSecretRevisions(ctx context.Context) (*int64, error)
+}
+
 // BackendConfig is used when constructing a secrets backend.
 type BackendConfig struct {
 	BackendType string
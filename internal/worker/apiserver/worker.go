@@ -28,6 +28,7 @@ import (
 	"github.com/juju/juju/core/presence"
 	"github.com/juju/juju/internal/services"
 	"github.com/juju/juju/internal/worker/trace"
+	"github.com/juju/juju/rpc"
 	"github.com/juju/juju/state"
 )
 
@@ -172,6 +173,11 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		return nil, fmt.Errorf("gathering authenticators for apiserver: %w", err)
 	}
 
+	requestTimeouts := rpc.RequestTimeouts{
+		Default: controllerConfig.APIRequestTimeout(),
+		Watcher: controllerConfig.APIWatcherRequestTimeout(),
+	}
+
 	serverConfig := apiserver.ServerConfig{
 		StatePool:                     config.StatePool,
 		Clock:                         config.Clock,
@@ -188,6 +194,7 @@ func NewWorker(ctx context.Context, config Config) (worker.Worker, error) {
 		UpgradeComplete:               config.UpgradeComplete,
 		PublicDNSName:                 controllerConfig.AutocertDNSName(),
 		AllowModelAccess:              controllerConfig.AllowModelAccess(),
+		RequestTimeouts:               requestTimeouts,
 		NewObserver:                   observerFactory,
 		RegisterIntrospectionHandlers: config.RegisterIntrospectionHTTPHandlers,
 		MetricsCollector:              config.MetricsCollector,
@@ -5,6 +5,7 @@ package apiserver_test
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/collections/set"
 	mgotesting "github.com/juju/mgo/v3/testing"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/core/model"
 	coretesting "github.com/juju/juju/internal/testing"
 	"github.com/juju/juju/internal/worker/apiserver"
+	"github.com/juju/juju/rpc"
 	statetesting "github.com/juju/juju/state/testing"
 )
 
@@ -80,7 +82,10 @@ func (s *WorkerStateSuite) TestStart(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
 	s.controllerConfigService.EXPECT().ControllerConfig(gomock.Any()).Return(
-		map[string]any{"controller-uuid": coretesting.ControllerTag.Id()},
+		map[string]any{
+			"controller-uuid":     coretesting.ControllerTag.Id(),
+			"api-request-timeout": "1m",
+		},
 		nil,
 	)
 	s.modelService.EXPECT().ControllerModel(gomock.Any()).Return(model.Model{
@@ -136,6 +141,7 @@ func (s *WorkerStateSuite) TestStart(c *gc.C) {
 		Hub:                        &s.hub,
 		PublicDNSName:              "",
 		AllowModelAccess:           false,
+		RequestTimeouts:            rpc.RequestTimeouts{Default: time.Minute},
 		LogSinkConfig:              &logSinkConfig,
 		LeaseManager:               s.leaseManager,
 		MetricsCollector:           s.metricsCollector,
@@ -25,7 +25,7 @@ type MetricsCollectorFn = func() *Collector
 
 // WatchableDBFn is an alias function that allows the creation of
 // EventQueueWorker.
-type WatchableDBFn = func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger) (WatchableDBWorker, error)
+type WatchableDBFn = func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger, int) (WatchableDBWorker, error)
 
 // ManifoldConfig defines the names of the manifolds on which a Manifold will
 // depend.
@@ -39,6 +39,9 @@ type ManifoldConfig struct {
 	NewMetricsCollector  MetricsCollectorFn
 	PrometheusRegisterer prometheus.Registerer
 	NewWatchableDB       WatchableDBFn
+
+	// MaxTermSize is the maximum number of changes within a single term.
+	MaxTermSize int
 }
 
 func (cfg ManifoldConfig) Validate() error {
@@ -66,6 +69,9 @@ func (cfg ManifoldConfig) Validate() error {
 	if cfg.NewMetricsCollector == nil {
 		return errors.NotValidf("nil NewMetricsCollector")
 	}
+	if cfg.MaxTermSize <= 0 {
+		return errors.NotValidf("non-positive MaxTermSize")
+	}
 	return nil
 }
 
@@ -115,6 +121,7 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 				Logger:            config.Logger,
 				Metrics:           metricsCollector,
 				NewWatchableDB:    config.NewWatchableDB,
+				MaxTermSize:       config.MaxTermSize,
 			}
 
 			w, err := newWorker(cfg)
@@ -49,6 +49,10 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg.NewMetricsCollector = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 
+	cfg = s.getConfig(c)
+	cfg.MaxTermSize = 0
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
 	cfg = s.getConfig(c)
 	cfg.NewWatchableDB = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
@@ -63,8 +67,9 @@ func (s *manifoldSuite) getConfig(c *gc.C) ManifoldConfig {
 		Logger:               loggertesting.WrapCheckLog(c),
 		NewMetricsCollector:  NewMetricsCollector,
 		PrometheusRegisterer: s.prometheusRegisterer,
-		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger) (WatchableDBWorker, error) {
+		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger, int) (WatchableDBWorker, error) {
 			return nil, nil
 		},
+		MaxTermSize: 1000,
 	}
 }
@@ -35,6 +35,7 @@ type NamespaceMetrics interface {
 	WatermarkRetriesInc()
 	ChangesRequestDurationObserve(val float64)
 	ChangesCountObserve(val int)
+	ChangesTermSplitsInc()
 	// EventMultiplexer metrics.
 	SubscriptionsInc()
 	SubscriptionsDec()
@@ -70,6 +71,12 @@ func (c *NamespaceCollector) ChangesCountObserve(val int) {
 	c.ChangesCount.WithLabelValues(c.Namespace).Observe(float64(val))
 }
 
+// ChangesTermSplitsInc increments the number of times the changes request
+// was split into multiple terms.
+func (c *NamespaceCollector) ChangesTermSplitsInc() {
+	c.ChangesTermSplits.WithLabelValues(c.Namespace).Inc()
+}
+
 // SubscriptionsInc increments the number of current subscriptions.
 func (c *NamespaceCollector) SubscriptionsInc() {
 	c.Subscriptions.WithLabelValues(c.Namespace).Inc()
@@ -93,6 +100,7 @@ type Collector struct {
 	WatermarkRetries       *prometheus.CounterVec
 	ChangesRequestDuration *prometheus.HistogramVec
 	ChangesCount           *prometheus.HistogramVec
+	ChangesTermSplits      *prometheus.CounterVec
 	// EventMultiplexer metrics.
 	Subscriptions    *prometheus.GaugeVec
 	DispatchDuration *prometheus.HistogramVec
@@ -112,6 +120,7 @@ func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
 	c.WatermarkRetries.Describe(ch)
 	c.ChangesRequestDuration.Describe(ch)
 	c.ChangesCount.Describe(ch)
+	c.ChangesTermSplits.Describe(ch)
 	// EventMultiplexer metrics.
 	c.Subscriptions.Describe(ch)
 	c.DispatchDuration.Describe(ch)
@@ -124,6 +133,7 @@ func (c *Collector) Collect(ch chan<- prometheus.Metric) {
 	c.WatermarkRetries.Collect(ch)
 	c.ChangesRequestDuration.Collect(ch)
 	c.ChangesCount.Collect(ch)
+	c.ChangesTermSplits.Collect(ch)
 	// EventMultiplexer metrics.
 	c.Subscriptions.Collect(ch)
 	c.DispatchDuration.Collect(ch)
@@ -157,6 +167,12 @@ func NewMetricsCollector() *Collector {
 			Name:      "changestream_count",
 			Help:      "Total number of changes returned by the changestream requests.",
 		}, labelNames),
+		ChangesTermSplits: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: changestreamMetricsNamespace,
+			Subsystem: changestreamSubsystemNamespace,
+			Name:      "changestream_term_splits",
+			Help:      "Total number of changestream requests split into multiple terms.",
+		}, labelNames),
 		// EventMultiplexer metrics.
 		Subscriptions: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Namespace: changestreamMetricsNamespace,
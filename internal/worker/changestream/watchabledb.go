@@ -44,8 +44,9 @@ func NewWatchableDB(
 	clock clock.Clock,
 	metrics NamespaceMetrics,
 	logger logger.Logger,
+	maxTermSize int,
 ) (WatchableDBWorker, error) {
-	stream := stream.New(tag, db, fileNotifier, clock, metrics, logger)
+	stream := stream.New(tag, db, fileNotifier, clock, metrics, logger, maxTermSize)
 
 	mux, err := eventmultiplexer.New(stream, clock, metrics, logger)
 	if err != nil {
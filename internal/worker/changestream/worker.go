@@ -40,6 +40,7 @@ type WorkerConfig struct {
 	Logger            logger.Logger
 	Metrics           Metrics
 	NewWatchableDB    WatchableDBFn
+	MaxTermSize       int
 }
 
 // Validate ensures that the config values are valid.
@@ -65,6 +66,9 @@ func (c *WorkerConfig) Validate() error {
 	if c.NewWatchableDB == nil {
 		return errors.NotValidf("missing NewWatchableDB")
 	}
+	if c.MaxTermSize <= 0 {
+		return errors.NotValidf("non-positive MaxTermSize")
+	}
 	return nil
 }
 
@@ -157,7 +161,7 @@ func (w *changeStreamWorker) GetWatchableDB(namespace string) (changestream.Watc
 		mux, err := w.cfg.NewWatchableDB(w.cfg.AgentTag, db, fileNotifyWatcher{
 			fileNotifier: w.cfg.FileNotifyWatcher,
 			fileName:     namespace,
-		}, w.cfg.Clock, w.cfg.Metrics.ForNamespace(namespace), w.cfg.Logger)
+		}, w.cfg.Clock, w.cfg.Metrics.ForNamespace(namespace), w.cfg.Logger, w.cfg.MaxTermSize)
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
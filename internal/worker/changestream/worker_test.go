@@ -56,6 +56,10 @@ func (s *workerSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig(c)
 	cfg.NewWatchableDB = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig(c)
+	cfg.MaxTermSize = 0
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *workerSuite) getConfig(c *gc.C) WorkerConfig {
@@ -66,9 +70,10 @@ func (s *workerSuite) getConfig(c *gc.C) WorkerConfig {
 		Clock:             s.clock,
 		Logger:            loggertesting.WrapCheckLog(c),
 		Metrics:           NewMetricsCollector(),
-		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger) (WatchableDBWorker, error) {
+		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger, int) (WatchableDBWorker, error) {
 			return nil, nil
 		},
+		MaxTermSize: 1000,
 	}
 }
 
@@ -199,13 +204,14 @@ func (s *workerSuite) newWorker(c *gc.C, attempts int) worker.Worker {
 		Clock:             s.clock,
 		Logger:            loggertesting.WrapCheckLog(c),
 		Metrics:           NewMetricsCollector(),
-		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger) (WatchableDBWorker, error) {
+		NewWatchableDB: func(string, coredatabase.TxnRunner, FileNotifier, clock.Clock, NamespaceMetrics, logger.Logger, int) (WatchableDBWorker, error) {
 			attempts--
 			if attempts < 0 {
 				c.Fatal("NewWatchableDB called too many times")
 			}
 			return s.watchableDBWorker, nil
 		},
+		MaxTermSize: 1000,
 	}
 
 	w, err := newWorker(cfg)
@@ -72,6 +72,16 @@ func WithLogger(logger logger.Logger) TrackedDBWorkerOption {
 	}
 }
 
+// WithStrictSchemaCheckingFunc enables the schema integrity check when the
+// database is opened. The function is used to determine if a failed check
+// should prevent the database from being opened, and is passed the database
+// being opened.
+func WithStrictSchemaCheckingFunc(f func(context.Context, coredatabase.TxnRunner) (bool, error)) TrackedDBWorkerOption {
+	return func(w *trackedDBWorker) {
+		w.strictSchemaCheckingFunc = f
+	}
+}
+
 // WithMetricsCollector sets the metrics collector used by the worker.
 func WithMetricsCollector(metrics *Collector) TrackedDBWorkerOption {
 	return func(w *trackedDBWorker) {
@@ -94,7 +104,8 @@ type trackedDBWorker struct {
 	metrics      *Collector
 	dbTxnMetrics txn.Metrics
 
-	pingDBFunc func(context.Context, *sql.DB) error
+	pingDBFunc               func(context.Context, *sql.DB) error
+	strictSchemaCheckingFunc func(context.Context, coredatabase.TxnRunner) (bool, error)
 
 	report *report
 }
@@ -170,6 +181,10 @@ func newTrackedDBWorker(
 		}
 	}
 
+	if err := w.verifySchemaIntegrity(ctx); err != nil {
+		return nil, errors.Trace(err)
+	}
+
 	w.tomb.Go(w.loop)
 
 	return w, nil
@@ -200,7 +215,38 @@ func (w *trackedDBWorker) ensureModelDBInitialised(ctx context.Context) error {
 		return nil
 	}
 
-	return errors.Trace(database.NewDBMigration(w, w.logger, schema.ModelDDL()).Apply(ctx))
+	if err := database.NewDBMigration(w, w.logger, schema.ModelDDL()).Apply(ctx); err != nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(schema.RecordMigrationChecksum(ctx, w))
+}
+
+// verifySchemaIntegrity checks that the schema of the database has not been
+// altered outside of the schema migrations. A failed check is logged, and
+// only prevents the database from being opened if strict schema checking
+// is enabled.
+func (w *trackedDBWorker) verifySchemaIntegrity(ctx context.Context) error {
+	if w.strictSchemaCheckingFunc == nil {
+		return nil
+	}
+
+	err := schema.VerifySchemaIntegrity(ctx, w)
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, schema.ErrSchemaIntegrity) {
+		return errors.Annotatef(err, "verifying schema integrity for namespace %q", w.namespace)
+	}
+
+	w.logger.Warningf("schema for namespace %q has been modified outside of schema migrations: %v", w.namespace, err)
+
+	strict, checkErr := w.strictSchemaCheckingFunc(ctx, w)
+	if checkErr != nil {
+		return errors.Annotate(checkErr, "reading strict schema checking config")
+	}
+	if strict {
+		return errors.Annotatef(err, "namespace %q", w.namespace)
+	}
+	return nil
 }
 
 // Txn executes the input function against the tracked database,
@@ -19,6 +19,7 @@ import (
 	gc "gopkg.in/check.v1"
 
 	coredatabase "github.com/juju/juju/core/database"
+	"github.com/juju/juju/domain/schema"
 	"github.com/juju/juju/internal/testing"
 )
 
@@ -47,6 +48,50 @@ func (s *trackedDBWorkerSuite) TestWorkerStartup(c *gc.C) {
 	workertest.CleanKill(c, w)
 }
 
+func (s *trackedDBWorkerSuite) TestWorkerStartupSchemaIntegrityFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+	defer s.expectTimer(0)()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	s.alterSchemaAfterChecksum(c)
+
+	var called bool
+	strictFn := func(context.Context, coredatabase.TxnRunner) (bool, error) {
+		called = true
+		return false, nil
+	}
+
+	w, err := NewTrackedDBWorker(context.Background(), s.dbApp, "controller",
+		WithClock(s.clock), WithLogger(s.logger), WithMetricsCollector(NewMetricsCollector()),
+		WithStrictSchemaCheckingFunc(strictFn))
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(called, jc.IsTrue)
+
+	workertest.CleanKill(c, w)
+}
+
+func (s *trackedDBWorkerSuite) TestWorkerStartupStrictSchemaIntegrityFailure(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	s.expectClock()
+
+	s.dbApp.EXPECT().Open(gomock.Any(), "controller").Return(s.DB(), nil)
+
+	s.alterSchemaAfterChecksum(c)
+
+	strictFn := func(context.Context, coredatabase.TxnRunner) (bool, error) {
+		return true, nil
+	}
+
+	_, err := NewTrackedDBWorker(context.Background(), s.dbApp, "controller",
+		WithClock(s.clock), WithLogger(s.logger), WithMetricsCollector(NewMetricsCollector()),
+		WithStrictSchemaCheckingFunc(strictFn))
+	c.Assert(err, jc.ErrorIs, schema.ErrSchemaIntegrity)
+}
+
 func (s *trackedDBWorkerSuite) TestWorkerReport(c *gc.C) {
 	defer s.setupMocks(c).Finish()
 
@@ -447,6 +492,17 @@ func (s *trackedDBWorkerSuite) newTrackedDBWorker(pingFn func(context.Context, *
 	)
 }
 
+func (s *trackedDBWorkerSuite) alterSchemaAfterChecksum(c *gc.C) {
+	err := schema.RecordMigrationChecksum(context.Background(), s.TxnRunner())
+	c.Assert(err, jc.ErrorIsNil)
+
+	err = s.TxnRunner().StdTxn(context.Background(), func(ctx context.Context, tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "CREATE TABLE manual (id TEXT)")
+		return err
+	})
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *trackedDBWorkerSuite) ensureStartup(c *gc.C) {
 	select {
 	case state := <-s.states:
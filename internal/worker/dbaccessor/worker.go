@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"net"
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/juju/worker/v4/catacomb"
 	"github.com/juju/worker/v4/dependency"
 
+	"github.com/juju/juju/controller"
 	"github.com/juju/juju/core/database"
 	"github.com/juju/juju/core/logger"
 	"github.com/juju/juju/domain/controllernode/service"
@@ -680,6 +682,7 @@ func (w *dbWorker) openDatabase(namespace string) error {
 			WithClock(w.cfg.Clock),
 			WithLogger(w.cfg.Logger),
 			WithMetricsCollector(w.cfg.MetricsCollector),
+			WithStrictSchemaCheckingFunc(w.strictSchemaCheckingFunc(namespace)),
 		)
 	})
 	if errors.Is(err, errors.AlreadyExists) {
@@ -688,6 +691,35 @@ func (w *dbWorker) openDatabase(namespace string) error {
 	return errors.Trace(err)
 }
 
+// strictSchemaCheckingFunc returns a function that reads the
+// strict-schema-checking controller config value. The controller database is
+// the database being opened for the controller namespace; for all other
+// namespaces it is the already running controller database.
+func (w *dbWorker) strictSchemaCheckingFunc(namespace string) func(context.Context, database.TxnRunner) (bool, error) {
+	return func(ctx context.Context, db database.TxnRunner) (bool, error) {
+		if namespace != database.ControllerNS {
+			var err error
+			if db, err = w.workerFromCache(database.ControllerNS); err != nil {
+				return false, errors.Trace(err)
+			} else if db == nil {
+				return false, errors.NotFoundf("controller database")
+			}
+		}
+
+		var value string
+		err := db.StdTxn(ctx, func(ctx context.Context, tx *sql.Tx) error {
+			row := tx.QueryRowContext(ctx, "SELECT value FROM controller_config WHERE key = ?", controller.StrictSchemaChecking)
+			return row.Scan(&value)
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return controller.DefaultStrictSchemaChecking, nil
+		} else if err != nil {
+			return false, errors.Trace(err)
+		}
+		return strconv.ParseBool(value)
+	}
+}
+
 type killableWorker interface {
 	worker.Worker
 	KillWithReason(error)
@@ -5,11 +5,13 @@ package domainservices
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
+	"golang.org/x/sync/singleflight"
 
 	"github.com/juju/juju/core/changestream"
 	coredatabase "github.com/juju/juju/core/database"
@@ -20,6 +22,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 	sshimporter "github.com/juju/juju/internal/ssh/importer"
@@ -42,6 +45,10 @@ type ManifoldConfig struct {
 	NewDomainServicesGetter     DomainServicesGetterFn
 	NewControllerDomainServices ControllerDomainServicesFn
 	NewModelDomainServices      ModelDomainServicesFn
+
+	// SecretBackendFailureTTL is how long a failure to connect to a secret
+	// backend is remembered by the secret services.
+	SecretBackendFailureTTL time.Duration
 }
 
 // DomainServicesGetterFn is a function that returns a domain services getter.
@@ -54,6 +61,8 @@ type DomainServicesGetterFn func(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
+	*secretservice.BackendFailureCache,
+	*singleflight.Group,
 	clock.Clock,
 	logger.Logger,
 ) services.DomainServicesGetter
@@ -76,6 +85,8 @@ type ModelDomainServicesFn func(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
+	*secretservice.BackendFailureCache,
+	*singleflight.Group,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices
@@ -121,6 +132,9 @@ func (config ManifoldConfig) Validate() error {
 	if config.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
+	if config.SecretBackendFailureTTL <= 0 {
+		return errors.NotValidf("non-positive SecretBackendFailureTTL")
+	}
 	return nil
 }
 
@@ -201,6 +215,7 @@ func (config ManifoldConfig) start(ctx context.Context, getter dependency.Getter
 		NewDomainServicesGetter:     config.NewDomainServicesGetter,
 		NewControllerDomainServices: config.NewControllerDomainServices,
 		NewModelDomainServices:      config.NewModelDomainServices,
+		SecretBackendFailureTTL:     config.SecretBackendFailureTTL,
 	})
 }
 
@@ -251,6 +266,8 @@ func NewProviderTrackerModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	secretBackendFailures *secretservice.BackendFailureCache,
+	hardwareRefreshes *singleflight.Group,
 	clock clock.Clock,
 	logger logger.Logger,
 ) services.ModelDomainServices {
@@ -263,6 +280,8 @@ func NewProviderTrackerModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
+		secretBackendFailures,
+		hardwareRefreshes,
 		clock,
 		logger,
 	)
@@ -278,6 +297,8 @@ func NewDomainServicesGetter(
 	storageRegistryGetter storage.StorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.Manager,
+	secretBackendFailures *secretservice.BackendFailureCache,
+	hardwareRefreshes *singleflight.Group,
 	clock clock.Clock,
 	logger logger.Logger,
 ) services.DomainServicesGetter {
@@ -292,6 +313,8 @@ func NewDomainServicesGetter(
 		storageRegistryGetter:  storageRegistryGetter,
 		publicKeyImporter:      publicKeyImporter,
 		leaseManager:           leaseManager,
+		secretBackendFailures:  secretBackendFailures,
+		hardwareRefreshes:      hardwareRefreshes,
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	dt "github.com/juju/worker/v4/dependency/testing"
 	"github.com/juju/worker/v4/workertest"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/changestream"
@@ -24,6 +25,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 )
@@ -91,6 +93,10 @@ func (s *manifoldSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig()
 	cfg.Clock = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.SecretBackendFailureTTL = 0
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *manifoldSuite) TestStart(c *gc.C) {
@@ -122,6 +128,7 @@ func (s *manifoldSuite) TestStart(c *gc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		Clock:                       s.clock,
+		SecretBackendFailureTTL:     secretservice.DefaultBackendFailureTTL,
 	})
 	w, err := manifold.Start(context.Background(), dt.StubGetter(getter))
 	c.Assert(err, jc.ErrorIsNil)
@@ -146,6 +153,7 @@ func (s *manifoldSuite) TestOutputControllerDomainServices(c *gc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		Clock:                       s.clock,
+		SecretBackendFailureTTL:     secretservice.DefaultBackendFailureTTL,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	defer workertest.DirtyKill(c, w)
@@ -173,6 +181,7 @@ func (s *manifoldSuite) TestOutputDomainServicesGetter(c *gc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		Clock:                       s.clock,
+		SecretBackendFailureTTL:     secretservice.DefaultBackendFailureTTL,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	defer workertest.DirtyKill(c, w)
@@ -200,6 +209,7 @@ func (s *manifoldSuite) TestOutputInvalid(c *gc.C) {
 		NewControllerDomainServices: NewControllerDomainServices,
 		NewModelDomainServices:      NewProviderTrackerModelDomainServices,
 		Clock:                       s.clock,
+		SecretBackendFailureTTL:     secretservice.DefaultBackendFailureTTL,
 	})
 	c.Assert(err, jc.ErrorIsNil)
 	defer workertest.DirtyKill(c, w)
@@ -224,6 +234,8 @@ func (s *manifoldSuite) TestNewModelDomainServices(c *gc.C) {
 		s.modelStorageRegistryGetter,
 		s.publicKeyImporter,
 		s.modelLeaseManagerGetter,
+		secretservice.NewBackendFailureCache(s.clock, secretservice.DefaultBackendFailureTTL),
+		&singleflight.Group{},
 		s.clock,
 		s.logger,
 	)
@@ -241,6 +253,8 @@ func (s *manifoldSuite) TestNewDomainServicesGetter(c *gc.C) {
 		s.storageRegistryGetter,
 		s.publicKeyImporter,
 		s.leaseManager,
+		secretservice.NewBackendFailureCache(s.clock, secretservice.DefaultBackendFailureTTL),
+		&singleflight.Group{},
 		s.clock,
 		s.logger,
 	)
@@ -267,6 +281,7 @@ func (s *manifoldSuite) getConfig() ManifoldConfig {
 		NewDomainServicesGetter:     noopDomainServicesGetter,
 		NewControllerDomainServices: noopControllerDomainServices,
 		NewModelDomainServices:      noopModelDomainServices,
+		SecretBackendFailureTTL:     secretservice.DefaultBackendFailureTTL,
 	}
 }
 
@@ -279,6 +294,8 @@ func noopDomainServicesGetter(
 	storage.StorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.Manager,
+	*secretservice.BackendFailureCache,
+	*singleflight.Group,
 	clock.Clock,
 	logger.Logger,
 ) services.DomainServicesGetter {
@@ -302,6 +319,8 @@ func noopModelDomainServices(
 	storage.ModelStorageRegistryGetter,
 	domainservices.PublicKeyImporter,
 	lease.ModelLeaseManagerGetter,
+	*secretservice.BackendFailureCache,
+	*singleflight.Group,
 	clock.Clock,
 	logger.Logger,
 ) services.ModelDomainServices {
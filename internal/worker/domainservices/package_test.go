@@ -9,6 +9,7 @@ import (
 
 	"github.com/juju/clock"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	changestream "github.com/juju/juju/core/changestream"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/storage"
 	domaintesting "github.com/juju/juju/domain/schema/testing"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainservices "github.com/juju/juju/domain/services"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	services "github.com/juju/juju/internal/services"
@@ -114,6 +116,8 @@ func NewModelDomainServices(
 	storageRegistry storage.ModelStorageRegistryGetter,
 	publicKeyImporter domainservices.PublicKeyImporter,
 	leaseManager lease.ModelLeaseManagerGetter,
+	secretBackendFailures *secretservice.BackendFailureCache,
+	hardwareRefreshes *singleflight.Group,
 	clock clock.Clock,
 	logger logger.Logger,
 ) services.ModelDomainServices {
@@ -126,6 +130,8 @@ func NewModelDomainServices(
 		storageRegistry,
 		publicKeyImporter,
 		leaseManager,
+		secretBackendFailures,
+		hardwareRefreshes,
 		clock,
 		logger,
 	)
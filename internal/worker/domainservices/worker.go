@@ -5,10 +5,12 @@ package domainservices
 
 import (
 	"context"
+	"time"
 
 	"github.com/juju/clock"
 	"github.com/juju/errors"
 	"github.com/juju/worker/v4"
+	"golang.org/x/sync/singleflight"
 	"gopkg.in/tomb.v2"
 
 	"github.com/juju/juju/core/changestream"
@@ -19,6 +21,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainservices "github.com/juju/juju/domain/services"
 	internalerrors "github.com/juju/juju/internal/errors"
 	"github.com/juju/juju/internal/services"
@@ -57,6 +60,10 @@ type Config struct {
 	NewDomainServicesGetter     DomainServicesGetterFn
 	NewControllerDomainServices ControllerDomainServicesFn
 	NewModelDomainServices      ModelDomainServicesFn
+
+	// SecretBackendFailureTTL is how long a failure to connect to a secret
+	// backend is remembered by the secret services.
+	SecretBackendFailureTTL time.Duration
 }
 
 // Validate validates the domain services configuration.
@@ -98,6 +105,9 @@ func (config Config) Validate() error {
 	if config.Clock == nil {
 		return errors.NotValidf("nil Clock")
 	}
+	if config.SecretBackendFailureTTL <= 0 {
+		return errors.NotValidf("non-positive SecretBackendFailureTTL")
+	}
 	return nil
 }
 
@@ -119,6 +129,14 @@ func NewWorker(config Config) (worker.Worker, error) {
 			config.StorageRegistryGetter,
 			config.PublicKeyImporter,
 			config.LeaseManager,
+			// The failures are shared by the secret services of every
+			// model, which are created on demand, for the life of the
+			// worker.
+			secretservice.NewBackendFailureCache(config.Clock, config.SecretBackendFailureTTL),
+			// Likewise, the in flight hardware characteristics refreshes
+			// are shared by the machine services of every model. They are
+			// keyed on machine UUID, so models can't collide.
+			&singleflight.Group{},
 			config.Clock,
 			config.Logger,
 		),
@@ -185,6 +203,8 @@ type domainServicesGetter struct {
 	storageRegistryGetter  storage.StorageRegistryGetter
 	publicKeyImporter      domainservices.PublicKeyImporter
 	leaseManager           lease.Manager
+	secretBackendFailures  *secretservice.BackendFailureCache
+	hardwareRefreshes      *singleflight.Group
 }
 
 // ServicesForModel returns the domain services for the given model uuid.
@@ -208,6 +228,8 @@ func (s *domainServicesGetter) ServicesForModel(modelUUID coremodel.UUID) servic
 				modelUUID: modelUUID,
 				manager:   s.leaseManager,
 			},
+			s.secretBackendFailures,
+			s.hardwareRefreshes,
 			s.clock,
 			s.logger,
 		),
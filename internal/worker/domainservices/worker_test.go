@@ -9,6 +9,7 @@ import (
 	jc "github.com/juju/testing/checkers"
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/workertest"
+	"golang.org/x/sync/singleflight"
 	gc "gopkg.in/check.v1"
 
 	"github.com/juju/juju/core/changestream"
@@ -19,6 +20,7 @@ import (
 	"github.com/juju/juju/core/objectstore"
 	"github.com/juju/juju/core/providertracker"
 	"github.com/juju/juju/core/storage"
+	secretservice "github.com/juju/juju/domain/secret/service"
 	domainservices "github.com/juju/juju/domain/services"
 	"github.com/juju/juju/internal/services"
 )
@@ -82,6 +84,10 @@ func (s *workerSuite) TestValidateConfig(c *gc.C) {
 	cfg = s.getConfig()
 	cfg.PublicKeyImporter = nil
 	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
+
+	cfg = s.getConfig()
+	cfg.SecretBackendFailureTTL = 0
+	c.Check(cfg.Validate(), jc.ErrorIs, errors.NotValid)
 }
 
 func (s *workerSuite) getConfig() Config {
@@ -95,6 +101,8 @@ func (s *workerSuite) getConfig() Config {
 		LeaseManager:          s.leaseManager,
 		Clock:                 s.clock,
 		Logger:                s.logger,
+
+		SecretBackendFailureTTL: secretservice.DefaultBackendFailureTTL,
 		NewDomainServicesGetter: func(
 			services.ControllerDomainServices,
 			changestream.WatchableDBGetter,
@@ -104,6 +112,8 @@ func (s *workerSuite) getConfig() Config {
 			storage.StorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.Manager,
+			*secretservice.BackendFailureCache,
+			*singleflight.Group,
 			clock.Clock,
 			logger.Logger,
 		) services.DomainServicesGetter {
@@ -125,6 +135,8 @@ func (s *workerSuite) getConfig() Config {
 			storage.ModelStorageRegistryGetter,
 			domainservices.PublicKeyImporter,
 			lease.ModelLeaseManagerGetter,
+			*secretservice.BackendFailureCache,
+			*singleflight.Group,
 			clock.Clock,
 			logger.Logger,
 		) services.ModelDomainServices {
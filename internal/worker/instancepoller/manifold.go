@@ -12,6 +12,7 @@ import (
 	"github.com/juju/worker/v4"
 	"github.com/juju/worker/v4/dependency"
 
+	"github.com/juju/juju/agent/engine"
 	"github.com/juju/juju/api/base"
 	"github.com/juju/juju/api/controller/instancepoller"
 	"github.com/juju/juju/core/instance"
@@ -114,6 +115,16 @@ func Manifold(config ManifoldConfig) dependency.Manifold {
 			config.EnvironName,
 			config.ClockName,
 		},
-		Start: config.start,
+		Start:  config.start,
+		Output: output,
 	}
 }
+
+// output exposes the worker as a ForcePoller.
+func output(in worker.Worker, out interface{}) error {
+	w, ok := in.(*updaterWorker)
+	if !ok {
+		return errors.Errorf("expected *updaterWorker, got %T", in)
+	}
+	return engine.OutputValue[ForcePoller](w, out)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/juju/juju/environs (interfaces: InstanceMaintenanceLister)
+//
+// Generated by this command:
+//
+//	mockgen -typed -package mocks -destination mocks/mocks_environs.go github.com/juju/juju/environs InstanceMaintenanceLister
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	reflect "reflect"
+
+	instance "github.com/juju/juju/core/instance"
+	envcontext "github.com/juju/juju/environs/envcontext"
+	instances "github.com/juju/juju/environs/instances"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInstanceMaintenanceLister is a mock of InstanceMaintenanceLister interface.
+type MockInstanceMaintenanceLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockInstanceMaintenanceListerMockRecorder
+}
+
+// MockInstanceMaintenanceListerMockRecorder is the mock recorder for MockInstanceMaintenanceLister.
+type MockInstanceMaintenanceListerMockRecorder struct {
+	mock *MockInstanceMaintenanceLister
+}
+
+// NewMockInstanceMaintenanceLister creates a new mock instance.
+func NewMockInstanceMaintenanceLister(ctrl *gomock.Controller) *MockInstanceMaintenanceLister {
+	mock := &MockInstanceMaintenanceLister{ctrl: ctrl}
+	mock.recorder = &MockInstanceMaintenanceListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInstanceMaintenanceLister) EXPECT() *MockInstanceMaintenanceListerMockRecorder {
+	return m.recorder
+}
+
+// InstanceMaintenanceEvents mocks base method.
+func (m *MockInstanceMaintenanceLister) InstanceMaintenanceEvents(arg0 envcontext.ProviderCallContext, arg1 []instance.Id) ([][]instances.MaintenanceEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstanceMaintenanceEvents", arg0, arg1)
+	ret0, _ := ret[0].([][]instances.MaintenanceEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstanceMaintenanceEvents indicates an expected call of InstanceMaintenanceEvents.
+func (mr *MockInstanceMaintenanceListerMockRecorder) InstanceMaintenanceEvents(arg0, arg1 any) *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstanceMaintenanceEvents", reflect.TypeOf((*MockInstanceMaintenanceLister)(nil).InstanceMaintenanceEvents), arg0, arg1)
+	return &MockInstanceMaintenanceListerInstanceMaintenanceEventsCall{Call: call}
+}
+
+// MockInstanceMaintenanceListerInstanceMaintenanceEventsCall wrap *gomock.Call
+type MockInstanceMaintenanceListerInstanceMaintenanceEventsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall) Return(arg0 [][]instances.MaintenanceEvent, arg1 error) *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall) Do(f func(envcontext.ProviderCallContext, []instance.Id) ([][]instances.MaintenanceEvent, error)) *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall) DoAndReturn(f func(envcontext.ProviderCallContext, []instance.Id) ([][]instances.MaintenanceEvent, error)) *MockInstanceMaintenanceListerInstanceMaintenanceEventsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -11,6 +11,7 @@ import (
 
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/mocks_watcher.go github.com/juju/juju/core/watcher StringsWatcher
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/mocks_instances.go github.com/juju/juju/environs/instances Instance
+//go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/mocks_environs.go github.com/juju/juju/environs InstanceMaintenanceLister
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/mocks_cred_api.go github.com/juju/juju/internal/worker/common CredentialAPI
 //go:generate go run go.uber.org/mock/mockgen -typed -package mocks -destination mocks/mocks_instancepoller.go github.com/juju/juju/internal/worker/instancepoller Environ,Machine
 
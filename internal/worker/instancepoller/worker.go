@@ -5,6 +5,7 @@ package instancepoller
 
 import (
 	stdcontext "context"
+	"fmt"
 	"time"
 
 	"github.com/juju/clock"
@@ -116,6 +117,10 @@ type pollGroupEntry struct {
 
 	shortPollInterval time.Duration
 	shortPollAt       time.Time
+
+	// maintenance holds the maintenance events the provider last reported
+	// as scheduled for the instance.
+	maintenance []instances.MaintenanceEvent
 }
 
 func (e *pollGroupEntry) resetShortPollInterval(clk clock.Clock) {
@@ -131,6 +136,21 @@ func (e *pollGroupEntry) bumpShortPollInterval(clk clock.Clock) {
 	e.shortPollAt = clk.Now().Add(e.shortPollInterval)
 }
 
+// ForcePoller is implemented by the instance poller worker, allowing an
+// immediate poll of a machine to be requested out of band.
+type ForcePoller interface {
+	// ForcePoll polls the provider for the status and addresses of the
+	// machine with the input tag immediately, rather than waiting for its
+	// next scheduled poll. Concurrent requests for the same machine are
+	// served by a single poll.
+	ForcePoll(ctx stdcontext.Context, tag names.MachineTag) error
+}
+
+type forcePollRequest struct {
+	tag  names.MachineTag
+	done chan error
+}
+
 type updaterWorker struct {
 	config   Config
 	catacomb catacomb.Catacomb
@@ -139,6 +159,8 @@ type updaterWorker struct {
 	instanceIDToGroupEntry map[instance.Id]*pollGroupEntry
 	callContextFunc        common.CloudCallContextFunc
 
+	forcePollRequests chan forcePollRequest
+
 	// Hook function which tests can use to be notified when the worker
 	// has processed a full loop iteration.
 	loopCompletedHook func()
@@ -159,6 +181,7 @@ func NewWorker(config Config) (worker.Worker, error) {
 		},
 		instanceIDToGroupEntry: make(map[instance.Id]*pollGroupEntry),
 		callContextFunc:        common.NewCloudCallContextFunc(config.CredentialAPI),
+		forcePollRequests:      make(chan forcePollRequest),
 	}
 	err := catacomb.Invoke(catacomb.Plan{
 		Site: &u.catacomb,
@@ -180,6 +203,29 @@ func (u *updaterWorker) Wait() error {
 	return u.catacomb.Wait()
 }
 
+// ForcePoll is part of the ForcePoller interface.
+func (u *updaterWorker) ForcePoll(ctx stdcontext.Context, tag names.MachineTag) error {
+	req := forcePollRequest{
+		tag:  tag,
+		done: make(chan error, 1),
+	}
+	select {
+	case u.forcePollRequests <- req:
+	case <-u.catacomb.Dying():
+		return u.catacomb.ErrDying()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-req.done:
+		return err
+	case <-u.catacomb.Dying():
+		return u.catacomb.ErrDying()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (u *updaterWorker) loop() error {
 	ctx, cancel := u.scopedContext()
 	defer cancel()
@@ -224,6 +270,8 @@ func (u *updaterWorker) loop() error {
 				return err
 			}
 			longPollTimer.Reset(LongPoll)
+		case req := <-u.forcePollRequests:
+			u.handleForcePollRequests(ctx, req)
 		}
 
 		if u.loopCompletedHook != nil {
@@ -397,6 +445,8 @@ func (u *updaterWorker) pollGroupMembers(ctx stdcontext.Context, groupType pollG
 		return errors.Annotate(err, "enumerating network interface list for instances")
 	}
 
+	u.updateMaintenanceEvents(ctx, instList)
+
 	for idx, info := range infoList {
 		var nics network.InterfaceInfos
 		if netList != nil {
@@ -445,6 +495,152 @@ func (u *updaterWorker) processOneInstance(
 	return nil
 }
 
+// handleForcePollRequests serves the input request along with any other
+// requests that are already waiting, polling each requested machine once so
+// that concurrent requests for the same machine are coalesced.
+func (u *updaterWorker) handleForcePollRequests(ctx stdcontext.Context, req forcePollRequest) {
+	waiters := map[names.MachineTag][]chan error{
+		req.tag: {req.done},
+	}
+	order := []names.MachineTag{req.tag}
+	for more := true; more; {
+		select {
+		case req := <-u.forcePollRequests:
+			if _, ok := waiters[req.tag]; !ok {
+				order = append(order, req.tag)
+			}
+			waiters[req.tag] = append(waiters[req.tag], req.done)
+		default:
+			more = false
+		}
+	}
+
+	for _, tag := range order {
+		err := u.forcePollMachine(ctx, tag)
+		if err != nil {
+			u.config.Logger.Warningf("force poll of machine %q failed: %v", tag.Id(), err)
+		}
+		for _, done := range waiters[tag] {
+			done <- err
+		}
+	}
+}
+
+// forcePollMachine polls the provider for the machine with the input tag
+// immediately, updating its instance status and addresses and moving it to
+// the poll group appropriate for its new state. Machines not yet known to the
+// worker are queued for polling first. If the poll fails, the machine's
+// regular poll schedule is left as it was.
+func (u *updaterWorker) forcePollMachine(ctx stdcontext.Context, tag names.MachineTag) error {
+	entry, groupType := u.lookupPolledMachine(tag)
+	if entry == nil {
+		if err := u.queueMachineForPolling(ctx, tag); err != nil {
+			return errors.Trace(err)
+		}
+		// Manual machines are not polled; their status is set when they
+		// are queued.
+		if entry, groupType = u.lookupPolledMachine(tag); entry == nil {
+			return nil
+		}
+	}
+
+	shortPollInterval, shortPollAt := entry.shortPollInterval, entry.shortPollAt
+	if err := u.pollEntry(ctx, entry, groupType); err != nil {
+		entry.shortPollInterval, entry.shortPollAt = shortPollInterval, shortPollAt
+		return errors.Trace(err)
+	}
+	return nil
+}
+
+// pollEntry polls the provider for the instance of a single entry and
+// processes the result.
+func (u *updaterWorker) pollEntry(ctx stdcontext.Context, entry *pollGroupEntry, groupType pollGroupType) error {
+	if err := u.resolveInstanceID(ctx, entry); err != nil {
+		return errors.Trace(err)
+	}
+	instList := []instance.Id{entry.instanceID}
+
+	infoList, err := u.config.Environ.Instances(u.callContextFunc(ctx), instList)
+	if isPartialOrNoInstancesError(err) || (err == nil && infoList[0] == nil) {
+		return errors.NotFoundf("instance %q for machine %q", entry.instanceID, entry.m.Id())
+	} else if err != nil {
+		return errors.Trace(err)
+	}
+
+	netList, err := u.config.Environ.NetworkInterfaces(u.callContextFunc(ctx), instList)
+	if err != nil && !isPartialOrNoInstancesError(err) {
+		return errors.Annotate(err, "enumerating network interface list for instance")
+	}
+	var nics network.InterfaceInfos
+	if netList != nil {
+		nics = netList[0]
+	}
+
+	u.updateMaintenanceEvents(ctx, instList)
+
+	providerStatus, providerAddrCount, err := u.processProviderInfo(ctx, entry, infoList[0], nics)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	machineStatus, err := entry.m.Status(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+
+	u.maybeSwitchPollGroup(groupType, entry, providerStatus, status.Status(machineStatus.Status), providerAddrCount)
+	return nil
+}
+
+// updateMaintenanceEvents records the maintenance scheduled by the provider
+// for each of the input instances, where the environ is able to report it.
+// Maintenance is informational only, so if it can't be retrieved the events
+// last reported are kept and polling carries on as normal.
+func (u *updaterWorker) updateMaintenanceEvents(ctx stdcontext.Context, instList []instance.Id) {
+	lister, ok := u.config.Environ.(environs.InstanceMaintenanceLister)
+	if !ok {
+		return
+	}
+	eventList, err := lister.InstanceMaintenanceEvents(u.callContextFunc(ctx), instList)
+	if errors.Is(err, errors.NotSupported) {
+		return
+	} else if err != nil {
+		u.config.Logger.Warningf("cannot retrieve scheduled maintenance for instances: %v", err)
+		return
+	} else if len(eventList) != len(instList) {
+		u.config.Logger.Warningf("expected scheduled maintenance for %d instances, got %d", len(instList), len(eventList))
+		return
+	}
+	for idx, id := range instList {
+		if entry, ok := u.instanceIDToGroupEntry[id]; ok {
+			entry.maintenance = eventList[idx]
+		}
+	}
+}
+
+// withMaintenance returns the input instance status, with its message noting
+// the earliest of the maintenance events scheduled for the instance.
+func withMaintenance(instStatus instance.Status, events []instances.MaintenanceEvent) instance.Status {
+	if len(events) == 0 {
+		return instStatus
+	}
+	next := events[0]
+	for _, event := range events[1:] {
+		if event.NotBefore.Before(next.NotBefore) {
+			next = event
+		}
+	}
+	note := fmt.Sprintf("scheduled maintenance at %s", next.NotBefore.UTC().Format(time.RFC3339))
+	if next.Description != "" {
+		note += ": " + next.Description
+	}
+	if instStatus.Message != "" {
+		note = instStatus.Message + "; " + note
+	}
+	instStatus.Message = note
+	return instStatus
+}
+
 func (u *updaterWorker) resolveInstanceID(ctx stdcontext.Context, entry *pollGroupEntry) error {
 	if entry.instanceID != "" {
 		return nil // already resolved
@@ -480,8 +676,10 @@ func (u *updaterWorker) processProviderInfo(
 		return status.Unknown, -1, nil
 	}
 
-	// Check for status changes
-	providerStatus := info.Status(u.callContextFunc(stdcontext.Background()))
+	// Check for status changes. Any maintenance scheduled for the instance
+	// is noted in its status message, so that the note is cleared when the
+	// provider cancels the maintenance.
+	providerStatus := withMaintenance(info.Status(u.callContextFunc(stdcontext.Background())), entry.maintenance)
 	curInstStatus := instance.Status{
 		Status:  status.Status(curStatus.Status),
 		Message: curStatus.Info,
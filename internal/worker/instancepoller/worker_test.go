@@ -27,6 +27,7 @@ import (
 	"github.com/juju/juju/environs/instances"
 	loggertesting "github.com/juju/juju/internal/logger/testing"
 	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/internal/worker/common"
 	"github.com/juju/juju/internal/worker/instancepoller/mocks"
 	"github.com/juju/juju/rpc/params"
 )
@@ -590,6 +591,279 @@ func (s *workerSuite) TestShortPollNoMachineInGroupKnownByProviderIntervalBackof
 	c.Assert(entry.shortPollInterval, gc.Equals, time.Duration(float64(ShortPoll)*ShortPollBackoff))
 }
 
+func (s *workerSuite) TestForcePollMovesStartedMachineToLongPollGroup(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	// Machine "0" is in the short poll group with a backed off poll
+	// interval.
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Life().Return(life.Alive)
+	machine.EXPECT().InstanceId(gomock.Any()).Return(instance.Id("b4dc0ffee"), nil)
+	machine.EXPECT().InstanceStatus(gomock.Any()).Return(params.StatusResult{Status: string(status.Running)}, nil)
+	machine.EXPECT().Status(gomock.Any()).Return(params.StatusResult{Status: string(status.Started)}, nil)
+	machine.EXPECT().SetProviderNetworkConfig(gomock.Any(), testNetIfs).Return(testAddrs, true, nil)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().String().Return("machine-0").AnyTimes()
+	updWorker.appendToShortPollGroup(machineTag, machine)
+	entry, _ := updWorker.lookupPolledMachine(machineTag)
+	entry.bumpShortPollInterval(mocked.clock)
+
+	instInfo := mocks.NewMockInstance(ctrl)
+	instInfo.EXPECT().Status(gomock.Any()).Return(instance.Status{Status: status.Running})
+	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return([]instances.Instance{instInfo}, nil)
+	mocked.environ.EXPECT().NetworkInterfaces(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(
+		[]network.InterfaceInfos{testNetIfs}, nil,
+	)
+
+	// The poll happens straight away, without advancing the clock to the
+	// machine's next scheduled poll.
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	c.Assert(updWorker.pollGroup[shortPollGroup], gc.HasLen, 0)
+	c.Assert(updWorker.pollGroup[longPollGroup], gc.HasLen, 1)
+}
+
+func (s *workerSuite) TestForcePollFailureKeepsPollInterval(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	updWorker.appendToShortPollGroup(machineTag, machine)
+	entry, _ := updWorker.lookupPolledMachine(machineTag)
+	entry.bumpShortPollInterval(mocked.clock)
+	shortPollInterval, shortPollAt := entry.shortPollInterval, entry.shortPollAt
+
+	instID := instance.Id("d3adc0de")
+	machine.EXPECT().InstanceId(gomock.Any()).Return(instID, nil)
+	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{instID}).Return(
+		nil, environs.ErrNoInstances,
+	)
+
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIs, errors.NotFound)
+
+	// The worker is still running and the machine keeps its schedule.
+	workertest.CheckAlive(c, w)
+	c.Assert(updWorker.pollGroup[shortPollGroup], gc.HasLen, 1)
+	c.Assert(entry.shortPollInterval, gc.Equals, shortPollInterval)
+	c.Assert(entry.shortPollAt, gc.Equals, shortPollAt)
+}
+
+func (s *workerSuite) TestForcePollUnknownMachineIsQueued(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorker(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().IsManual(gomock.Any()).Return(false, nil)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().InstanceId(gomock.Any()).Return(instance.Id("b4dc0ffee"), nil)
+	machine.EXPECT().Life().Return(life.Alive)
+	machine.EXPECT().InstanceStatus(gomock.Any()).Return(params.StatusResult{Status: string(status.Provisioning)}, nil)
+	machine.EXPECT().SetInstanceStatus(gomock.Any(), status.Pending, "", nil).Return(nil)
+	machine.EXPECT().SetProviderNetworkConfig(gomock.Any(), network.InterfaceInfos(nil)).Return(nil, false, nil)
+	machine.EXPECT().Status(gomock.Any()).Return(params.StatusResult{Status: string(status.Pending)}, nil)
+	mocked.facadeAPI.addMachine(machineTag, machine)
+
+	instInfo := mocks.NewMockInstance(ctrl)
+	instInfo.EXPECT().Status(gomock.Any()).Return(instance.Status{Status: status.Pending})
+	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return([]instances.Instance{instInfo}, nil)
+	mocked.environ.EXPECT().NetworkInterfaces(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(nil, environs.ErrNoInstances)
+
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIsNil)
+
+	// The machine is still pending so it stays in the short poll group.
+	c.Assert(updWorker.pollGroup[shortPollGroup], gc.HasLen, 1)
+}
+
+func (s *workerSuite) TestForcePollRequestsAreCoalesced(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	environ := mocks.NewMockEnviron(ctrl)
+	clk := testclock.NewClock(time.Now())
+	updWorker := &updaterWorker{
+		config: Config{
+			Clock:   clk,
+			Environ: environ,
+			Logger:  loggertesting.WrapCheckLog(c),
+		},
+		pollGroup: [2]map[names.MachineTag]*pollGroupEntry{
+			make(map[names.MachineTag]*pollGroupEntry),
+			make(map[names.MachineTag]*pollGroupEntry),
+		},
+		instanceIDToGroupEntry: make(map[instance.Id]*pollGroupEntry),
+		callContextFunc:        common.NewCloudCallContextFunc(mocks.NewMockCredentialAPI(ctrl)),
+		forcePollRequests:      make(chan forcePollRequest, 2),
+	}
+
+	machineTag := names.NewMachineTag("0")
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().InstanceId(gomock.Any()).Return(instance.Id("d3adc0de"), nil)
+	updWorker.appendToShortPollGroup(machineTag, machine)
+
+	// The provider is only asked once, even though three requests are
+	// waiting to be served.
+	environ.EXPECT().Instances(gomock.Any(), []instance.Id{"d3adc0de"}).Return(nil, environs.ErrNoInstances)
+
+	newRequest := func() forcePollRequest {
+		return forcePollRequest{tag: machineTag, done: make(chan error, 1)}
+	}
+	first, second, third := newRequest(), newRequest(), newRequest()
+	updWorker.forcePollRequests <- second
+	updWorker.forcePollRequests <- third
+
+	updWorker.handleForcePollRequests(context.Background(), first)
+
+	for _, req := range []forcePollRequest{first, second, third} {
+		select {
+		case err := <-req.done:
+			c.Check(err, jc.ErrorIs, errors.NotFound)
+		default:
+			c.Fatal("force poll request not answered")
+		}
+	}
+}
+
+func (s *workerSuite) TestForcePollNotesScheduledMaintenance(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorkerWithMaintenance(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	notBefore := time.Date(2026, 11, 2, 9, 30, 0, 0, time.UTC)
+	machineTag := names.NewMachineTag("0")
+	machine := s.expectForcePolledMachine(ctrl, mocked, "")
+	updWorker.appendToShortPollGroup(machineTag, machine)
+
+	// The note for the scheduled maintenance is added to the instance
+	// status reported by the provider.
+	mocked.maintenance.EXPECT().InstanceMaintenanceEvents(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(
+		[][]instances.MaintenanceEvent{{{
+			Code:        "instance-retirement",
+			Description: "host retirement",
+			NotBefore:   notBefore,
+		}}}, nil,
+	)
+	machine.EXPECT().SetInstanceStatus(gomock.Any(), status.Running,
+		"Running wild; scheduled maintenance at 2026-11-02T09:30:00Z: host retirement", nil,
+	).Return(nil)
+
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestForcePollClearsCancelledMaintenance(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorkerWithMaintenance(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineTag := names.NewMachineTag("0")
+	machine := s.expectForcePolledMachine(ctrl, mocked,
+		"Running wild; scheduled maintenance at 2026-11-02T09:30:00Z: host retirement")
+	updWorker.appendToShortPollGroup(machineTag, machine)
+
+	// The provider no longer reports the maintenance, so the note is
+	// cleared from the instance status.
+	mocked.maintenance.EXPECT().InstanceMaintenanceEvents(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(
+		[][]instances.MaintenanceEvent{nil}, nil,
+	)
+	machine.EXPECT().SetInstanceStatus(gomock.Any(), status.Running, "Running wild", nil).Return(nil)
+
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+func (s *workerSuite) TestForcePollKeepsMaintenanceWhenLookupFails(c *gc.C) {
+	ctrl := gomock.NewController(c)
+	defer ctrl.Finish()
+
+	w, mocked := s.startWorkerWithMaintenance(c, ctrl)
+	defer workertest.CleanKill(c, w)
+	updWorker := w.(*updaterWorker)
+
+	machineTag := names.NewMachineTag("0")
+	machine := s.expectForcePolledMachine(ctrl, mocked,
+		"Running wild; scheduled maintenance at 2026-11-02T09:30:00Z: host retirement")
+	updWorker.appendToShortPollGroup(machineTag, machine)
+	entry, _ := updWorker.lookupPolledMachine(machineTag)
+	entry.maintenance = []instances.MaintenanceEvent{{
+		Description: "host retirement",
+		NotBefore:   time.Date(2026, 11, 2, 9, 30, 0, 0, time.UTC),
+	}}
+
+	// Failing to retrieve the maintenance leaves the instance status as it
+	// was, rather than treating the maintenance as cancelled.
+	mocked.maintenance.EXPECT().InstanceMaintenanceEvents(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(
+		nil, errors.New("boom"),
+	)
+
+	err := updWorker.ForcePoll(context.Background(), machineTag)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
+// expectForcePolledMachine returns a started machine whose instance is
+// reported as running by the provider, with the input current instance
+// status message.
+func (s *workerSuite) expectForcePolledMachine(ctrl *gomock.Controller, mocked workerMocks, statusInfo string) *mocks.MockMachine {
+	machine := mocks.NewMockMachine(ctrl)
+	machine.EXPECT().Life().Return(life.Alive)
+	machine.EXPECT().InstanceId(gomock.Any()).Return(instance.Id("b4dc0ffee"), nil)
+	machine.EXPECT().InstanceStatus(gomock.Any()).Return(params.StatusResult{Status: string(status.Running), Info: statusInfo}, nil)
+	machine.EXPECT().Status(gomock.Any()).Return(params.StatusResult{Status: string(status.Started)}, nil)
+	machine.EXPECT().SetProviderNetworkConfig(gomock.Any(), testNetIfs).Return(testAddrs, false, nil)
+	machine.EXPECT().Id().Return("0").AnyTimes()
+	machine.EXPECT().String().Return("machine-0").AnyTimes()
+
+	instInfo := mocks.NewMockInstance(ctrl)
+	instInfo.EXPECT().Status(gomock.Any()).Return(instance.Status{Status: status.Running, Message: "Running wild"})
+	mocked.environ.EXPECT().Instances(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return([]instances.Instance{instInfo}, nil)
+	mocked.environ.EXPECT().NetworkInterfaces(gomock.Any(), []instance.Id{"b4dc0ffee"}).Return(
+		[]network.InterfaceInfos{testNetIfs}, nil,
+	)
+	return machine
+}
+
+func (s *workerSuite) TestWithMaintenanceNotesEarliestEvent(c *gc.C) {
+	instStatus := withMaintenance(instance.Status{Status: status.Running}, []instances.MaintenanceEvent{{
+		Description: "host retirement",
+		NotBefore:   time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC),
+	}, {
+		NotBefore: time.Date(2026, 11, 2, 9, 30, 0, 0, time.UTC),
+	}})
+	c.Assert(instStatus, gc.Equals, instance.Status{
+		Status:  status.Running,
+		Message: "scheduled maintenance at 2026-11-02T09:30:00Z",
+	})
+
+	instStatus = withMaintenance(instance.Status{Status: status.Running, Message: "Running wild"}, nil)
+	c.Assert(instStatus, gc.Equals, instance.Status{Status: status.Running, Message: "Running wild"})
+}
+
 func (s *workerSuite) assertWorkerCompletesLoop(c *gc.C, w *updaterWorker, triggerFn func()) {
 	s.assertWorkerCompletesLoops(c, w, 1, triggerFn)
 }
@@ -611,23 +885,47 @@ func (s *workerSuite) assertWorkerCompletesLoops(c *gc.C, w *updaterWorker, numL
 }
 
 type workerMocks struct {
-	clock     *testclock.Clock
-	facadeAPI *mockFacadeAPI
-	environ   *mocks.MockEnviron
+	clock       *testclock.Clock
+	facadeAPI   *mockFacadeAPI
+	environ     *mocks.MockEnviron
+	maintenance *mocks.MockInstanceMaintenanceLister
+}
+
+// maintenanceEnviron is an Environ which also reports the maintenance
+// scheduled for instances.
+type maintenanceEnviron struct {
+	*mocks.MockEnviron
+	*mocks.MockInstanceMaintenanceLister
 }
 
 func (s *workerSuite) startWorker(c *gc.C, ctrl *gomock.Controller) (worker.Worker, workerMocks) {
+	return s.startWorkerWithEnviron(c, ctrl, false)
+}
+
+func (s *workerSuite) startWorkerWithMaintenance(c *gc.C, ctrl *gomock.Controller) (worker.Worker, workerMocks) {
+	return s.startWorkerWithEnviron(c, ctrl, true)
+}
+
+func (s *workerSuite) startWorkerWithEnviron(c *gc.C, ctrl *gomock.Controller, withMaintenance bool) (worker.Worker, workerMocks) {
 	workerMainLoopEnteredCh := make(chan struct{}, 1)
 	mocked := workerMocks{
 		clock:     testclock.NewClock(time.Now()),
 		facadeAPI: newMockFacadeAPI(ctrl, workerMainLoopEnteredCh),
 		environ:   mocks.NewMockEnviron(ctrl),
 	}
+	var environ Environ = mocked.environ
+	if withMaintenance {
+		mocked.maintenance = mocks.NewMockInstanceMaintenanceLister(ctrl)
+		environ = maintenanceEnviron{
+			MockEnviron:                   mocked.environ,
+			MockInstanceMaintenanceLister: mocked.maintenance,
+		}
+	}
 
 	w, err := NewWorker(Config{
 		Clock:         mocked.clock,
 		Facade:        mocked.facadeAPI,
-		Environ:       mocked.environ,
+		Environ:       environ,
 		CredentialAPI: mocks.NewMockCredentialAPI(ctrl),
 		Logger:        loggertesting.WrapCheckLog(c),
 	})
@@ -61,6 +61,12 @@ func (*ValidateSuite) TestMissingUploadBinaries(c *gc.C) {
 	checkNotValid(c, config, "nil UploadBinaries not valid")
 }
 
+func (*ValidateSuite) TestMissingValidateBinaries(c *gc.C) {
+	config := validConfig()
+	config.ValidateBinaries = nil
+	checkNotValid(c, config, "nil ValidateBinaries not valid")
+}
+
 func (*ValidateSuite) TestMissingCharmService(c *gc.C) {
 	config := validConfig()
 	config.CharmService = nil
@@ -81,14 +87,15 @@ func (*ValidateSuite) TestMissingClock(c *gc.C) {
 
 func validConfig() migrationmaster.Config {
 	return migrationmaster.Config{
-		ModelUUID:       coretesting.ModelTag.Id(),
-		Guard:           struct{ fortress.Guard }{},
-		Facade:          struct{ migrationmaster.Facade }{},
-		APIOpen:         func(context.Context, *api.Info, api.DialOpts) (api.Connection, error) { return nil, nil },
-		UploadBinaries:  func(context.Context, migration.UploadBinariesConfig, logger.Logger) error { return nil },
-		CharmService:    struct{ migrationmaster.CharmService }{},
-		ToolsDownloader: struct{ migration.ToolsDownloader }{},
-		Clock:           struct{ clock.Clock }{},
+		ModelUUID:        coretesting.ModelTag.Id(),
+		Guard:            struct{ fortress.Guard }{},
+		Facade:           struct{ migrationmaster.Facade }{},
+		APIOpen:          func(context.Context, *api.Info, api.DialOpts) (api.Connection, error) { return nil, nil },
+		UploadBinaries:   func(context.Context, migration.UploadBinariesConfig, logger.Logger) error { return nil },
+		ValidateBinaries: func(context.Context, migration.ValidateBinariesConfig) error { return nil },
+		CharmService:     struct{ migrationmaster.CharmService }{},
+		ToolsDownloader:  struct{ migration.ToolsDownloader }{},
+		Clock:            struct{ clock.Clock }{},
 	}
 }
 
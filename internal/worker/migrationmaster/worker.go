@@ -136,14 +136,15 @@ type CharmService interface {
 
 // Config defines the operation of a Worker.
 type Config struct {
-	ModelUUID       string
-	Facade          Facade
-	CharmService    CharmService
-	Guard           fortress.Guard
-	APIOpen         func(context.Context, *api.Info, api.DialOpts) (api.Connection, error)
-	UploadBinaries  func(context.Context, migration.UploadBinariesConfig, logger.Logger) error
-	ToolsDownloader migration.ToolsDownloader
-	Clock           clock.Clock
+	ModelUUID        string
+	Facade           Facade
+	CharmService     CharmService
+	Guard            fortress.Guard
+	APIOpen          func(context.Context, *api.Info, api.DialOpts) (api.Connection, error)
+	UploadBinaries   func(context.Context, migration.UploadBinariesConfig, logger.Logger) error
+	ValidateBinaries func(context.Context, migration.ValidateBinariesConfig) error
+	ToolsDownloader  migration.ToolsDownloader
+	Clock            clock.Clock
 }
 
 // Validate returns an error if config cannot drive a Worker.
@@ -166,6 +167,9 @@ func (config Config) Validate() error {
 	if config.UploadBinaries == nil {
 		return errors.NotValidf("nil UploadBinaries")
 	}
+	if config.ValidateBinaries == nil {
+		return errors.NotValidf("nil ValidateBinaries")
+	}
 	if config.ToolsDownloader == nil {
 		return errors.NotValidf("nil ToolsDownloader")
 	}
@@ -445,6 +449,19 @@ func (w *Worker) transferModel(ctx context.Context, targetInfo coremigration.Tar
 		return errors.Annotate(err, "model export failed")
 	}
 
+	// Binaries are uploaded after the model is imported, so check that
+	// none are missing before the target controller is touched.
+	w.setInfoStatus(ctx, "validating model binaries")
+	err = w.config.ValidateBinaries(ctx, migration.ValidateBinariesConfig{
+		ModelBytes:   serialized.Bytes,
+		Charms:       serialized.Charms,
+		CharmService: w.config.CharmService,
+		Resources:    serialized.Resources,
+	})
+	if err != nil {
+		return errors.Annotate(err, "model binaries validation failed")
+	}
+
 	w.setInfoStatus(ctx, "importing model into target controller")
 	conn, err := w.openAPIConn(ctx, targetInfo)
 	if err != nil {
@@ -452,7 +469,7 @@ func (w *Worker) transferModel(ctx context.Context, targetInfo coremigration.Tar
 	}
 	defer conn.Close()
 	targetClient := migrationtarget.NewClient(conn)
-	err = targetClient.Import(ctx, serialized.Bytes)
+	err = targetClient.Import(ctx, serialized.Bytes, serialized.SecretBackends)
 	if err != nil {
 		return errors.Annotate(err, "failed to import model into target controller")
 	}
@@ -187,14 +187,15 @@ func (s *Suite) SetUpTest(c *gc.C) {
 	// The default worker Config used by most of the tests. Tests may
 	// tweak parts of this as needed.
 	s.config = migrationmaster.Config{
-		ModelUUID:       uuid.MustNewUUID().String(),
-		Facade:          s.facade,
-		CharmService:    fakeCharmService,
-		Guard:           newStubGuard(s.stub),
-		APIOpen:         s.apiOpen,
-		UploadBinaries:  nullUploadBinaries,
-		ToolsDownloader: fakeToolsDownloader,
-		Clock:           s.clock,
+		ModelUUID:        uuid.MustNewUUID().String(),
+		Facade:           s.facade,
+		CharmService:     fakeCharmService,
+		Guard:            newStubGuard(s.stub),
+		APIOpen:          s.apiOpen,
+		UploadBinaries:   nullUploadBinaries,
+		ValidateBinaries: noopValidateBinaries,
+		ToolsDownloader:  fakeToolsDownloader,
+		Clock:            s.clock,
 	}
 }
 
@@ -232,6 +233,7 @@ func (s *Suite) TestSuccessfulMigration(c *gc.C) {
 	s.facade.queueMinionReports(makeMinionReports(coremigration.VALIDATION))
 	s.facade.queueMinionReports(makeMinionReports(coremigration.SUCCESS))
 	s.config.UploadBinaries = makeStubUploadBinaries(s.stub)
+	s.config.ValidateBinaries = makeStubValidateBinaries(s.stub, nil)
 
 	s.checkWorkerReturns(c, migrationmaster.ErrMigrated)
 
@@ -257,6 +259,12 @@ func (s *Suite) TestSuccessfulMigration(c *gc.C) {
 
 			//IMPORT
 			{FuncName: "facade.Export", Args: nil},
+			{FuncName: "ValidateBinaries", Args: []interface{}{
+				fakeModelBytes,
+				[]string{"charm0", "charm1"},
+				fakeCharmService,
+				s.facade.exportedResources,
+			}},
 			apiOpenControllerCall,
 			importCall,
 			{FuncName: "UploadBinaries", Args: []interface{}{
@@ -580,6 +588,28 @@ func (s *Suite) TestExportFailure(c *gc.C) {
 	))
 }
 
+func (s *Suite) TestValidateBinariesFailure(c *gc.C) {
+	s.facade.queueStatus(s.makeStatus(coremigration.IMPORT))
+	s.config.ValidateBinaries = makeStubValidateBinaries(s.stub, errors.New("charm ch:foo-1 not found"))
+
+	// The target controller is never contacted for the import.
+	s.checkWorkerReturns(c, migrationmaster.ErrInactive)
+	s.stub.CheckCalls(c, joinCalls(
+		watchStatusLockdownCalls,
+		[]jujutesting.StubCall{
+			{FuncName: "facade.MinionReportTimeout", Args: nil},
+			{FuncName: "facade.Export", Args: nil},
+			{FuncName: "ValidateBinaries", Args: []interface{}{
+				fakeModelBytes,
+				[]string{"charm0", "charm1"},
+				fakeCharmService,
+				[]coremigration.SerializedModelResource(nil),
+			}},
+		},
+		abortCalls,
+	))
+}
+
 func (s *Suite) TestAPIOpenFailure(c *gc.C) {
 	s.facade.queueStatus(s.makeStatus(coremigration.IMPORT))
 	s.connectionErr = errors.New("boom")
@@ -1534,6 +1564,25 @@ func makeStubUploadBinaries(stub *jujutesting.Stub) func(context.Context, migrat
 	}
 }
 
+func makeStubValidateBinaries(stub *jujutesting.Stub, err error) func(context.Context, migration.ValidateBinariesConfig) error {
+	return func(_ context.Context, config migration.ValidateBinariesConfig) error {
+		stub.AddCall(
+			"ValidateBinaries",
+			config.ModelBytes,
+			config.Charms,
+			config.CharmService,
+			config.Resources,
+		)
+		return err
+	}
+}
+
+// noopValidateBinaries is a ValidateBinaries variant which finds nothing
+// missing.
+func noopValidateBinaries(context.Context, migration.ValidateBinariesConfig) error {
+	return nil
+}
+
 // nullUploadBinaries is a UploadBinaries variant which is intended to
 // not get called.
 func nullUploadBinaries(context.Context, migration.UploadBinariesConfig, logger.Logger) error {
@@ -0,0 +1,11 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+// Package secretcontentpruner provides a worker which deletes the content of
+// obsolete auto-pruned user secret revisions from the secret backends holding
+// it. Obsolete charm secret revisions are removed by the owning charm.
+//
+// The worker reacts to every obsolete revision reported as pending deletion,
+// including those made obsolete while it was not running, so that content
+// does not linger in an external backend after a controller restart.
+package secretcontentpruner
@@ -0,0 +1,75 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentpruner
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/dependency"
+
+	"github.com/juju/juju/core/logger"
+	secretservice "github.com/juju/juju/domain/secret/service"
+	"github.com/juju/juju/internal/services"
+)
+
+// ManifoldConfig describes the resources used by the secretcontentpruner
+// worker.
+type ManifoldConfig struct {
+	DomainServicesName string
+	Logger             logger.Logger
+
+	NewWorker func(Config) (worker.Worker, error)
+}
+
+// Manifold returns a Manifold that encapsulates the secretcontentpruner
+// worker.
+func Manifold(config ManifoldConfig) dependency.Manifold {
+	return dependency.Manifold{
+		Inputs: []string{
+			config.DomainServicesName,
+		},
+		Start: config.start,
+	}
+}
+
+// Validate is called by start to check for bad configuration.
+func (cfg ManifoldConfig) Validate() error {
+	if cfg.DomainServicesName == "" {
+		return errors.NotValidf("empty DomainServicesName")
+	}
+	if cfg.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	if cfg.NewWorker == nil {
+		return errors.NotValidf("nil NewWorker")
+	}
+	return nil
+}
+
+// start is a StartFunc for a Worker manifold.
+func (cfg ManifoldConfig) start(ctx context.Context, getter dependency.Getter) (worker.Worker, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	var domainServices services.DomainServices
+	if err := getter.Get(cfg.DomainServicesName, &domainServices); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w, err := cfg.NewWorker(Config{
+		// The worker only deletes existing content, so it never needs the
+		// backend used for saving new user secrets.
+		SecretService: domainServices.Secret(secretservice.SecretServiceParams{
+			BackendUserSecretConfigGetter: secretservice.NotImplementedBackendUserSecretConfigGetter,
+		}),
+		Logger: cfg.Logger,
+	})
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return w, nil
+}
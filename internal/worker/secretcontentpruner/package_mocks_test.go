@@ -0,0 +1,157 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: worker.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -package secretcontentpruner -destination package_mocks_test.go -source worker.go
+//
+
+// Package secretcontentpruner is a generated GoMock package.
+package secretcontentpruner
+
+import (
+	context "context"
+	reflect "reflect"
+
+	secrets "github.com/juju/juju/core/secrets"
+	watcher "github.com/juju/juju/core/watcher"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSecretService is a mock of SecretService interface.
+type MockSecretService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretServiceMockRecorder
+}
+
+// MockSecretServiceMockRecorder is the mock recorder for MockSecretService.
+type MockSecretServiceMockRecorder struct {
+	mock *MockSecretService
+}
+
+// NewMockSecretService creates a new mock instance.
+func NewMockSecretService(ctrl *gomock.Controller) *MockSecretService {
+	mock := &MockSecretService{ctrl: ctrl}
+	mock.recorder = &MockSecretServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretService) EXPECT() *MockSecretServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecretRevisionContent mocks base method.
+func (m *MockSecretService) DeleteSecretRevisionContent(ctx context.Context, ref secrets.ValueRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecretRevisionContent", ctx, ref)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecretRevisionContent indicates an expected call of DeleteSecretRevisionContent.
+func (mr *MockSecretServiceMockRecorder) DeleteSecretRevisionContent(ctx, ref any) *MockSecretServiceDeleteSecretRevisionContentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretRevisionContent", reflect.TypeOf((*MockSecretService)(nil).DeleteSecretRevisionContent), ctx, ref)
+	return &MockSecretServiceDeleteSecretRevisionContentCall{Call: call}
+}
+
+// MockSecretServiceDeleteSecretRevisionContentCall wrap *gomock.Call
+type MockSecretServiceDeleteSecretRevisionContentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceDeleteSecretRevisionContentCall) Return(arg0 error) *MockSecretServiceDeleteSecretRevisionContentCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceDeleteSecretRevisionContentCall) Do(f func(context.Context, secrets.ValueRef) error) *MockSecretServiceDeleteSecretRevisionContentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceDeleteSecretRevisionContentCall) DoAndReturn(f func(context.Context, secrets.ValueRef) error) *MockSecretServiceDeleteSecretRevisionContentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MarkSecretRevisionDeleted mocks base method.
+func (m *MockSecretService) MarkSecretRevisionDeleted(ctx context.Context, revisionUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkSecretRevisionDeleted", ctx, revisionUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkSecretRevisionDeleted indicates an expected call of MarkSecretRevisionDeleted.
+func (mr *MockSecretServiceMockRecorder) MarkSecretRevisionDeleted(ctx, revisionUUID any) *MockSecretServiceMarkSecretRevisionDeletedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkSecretRevisionDeleted", reflect.TypeOf((*MockSecretService)(nil).MarkSecretRevisionDeleted), ctx, revisionUUID)
+	return &MockSecretServiceMarkSecretRevisionDeletedCall{Call: call}
+}
+
+// MockSecretServiceMarkSecretRevisionDeletedCall wrap *gomock.Call
+type MockSecretServiceMarkSecretRevisionDeletedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceMarkSecretRevisionDeletedCall) Return(arg0 error) *MockSecretServiceMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceMarkSecretRevisionDeletedCall) Do(f func(context.Context, string) error) *MockSecretServiceMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceMarkSecretRevisionDeletedCall) DoAndReturn(f func(context.Context, string) error) *MockSecretServiceMarkSecretRevisionDeletedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// WatchSecretsRevisionsPendingDeletion mocks base method.
+func (m *MockSecretService) WatchSecretsRevisionsPendingDeletion(ctx context.Context) (watcher.SecretRevisionWatcher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchSecretsRevisionsPendingDeletion", ctx)
+	ret0, _ := ret[0].(watcher.SecretRevisionWatcher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchSecretsRevisionsPendingDeletion indicates an expected call of WatchSecretsRevisionsPendingDeletion.
+func (mr *MockSecretServiceMockRecorder) WatchSecretsRevisionsPendingDeletion(ctx any) *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchSecretsRevisionsPendingDeletion", reflect.TypeOf((*MockSecretService)(nil).WatchSecretsRevisionsPendingDeletion), ctx)
+	return &MockSecretServiceWatchSecretsRevisionsPendingDeletionCall{Call: call}
+}
+
+// MockSecretServiceWatchSecretsRevisionsPendingDeletionCall wrap *gomock.Call
+type MockSecretServiceWatchSecretsRevisionsPendingDeletionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall) Return(arg0 watcher.SecretRevisionWatcher, arg1 error) *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall) Do(f func(context.Context) (watcher.SecretRevisionWatcher, error)) *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall) DoAndReturn(f func(context.Context) (watcher.SecretRevisionWatcher, error)) *MockSecretServiceWatchSecretsRevisionsPendingDeletionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,16 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentpruner
+
+import (
+	stdtesting "testing"
+
+	gc "gopkg.in/check.v1"
+)
+
+//go:generate go run go.uber.org/mock/mockgen -typed -package secretcontentpruner -destination package_mocks_test.go -source worker.go
+
+func TestPackage(t *stdtesting.T) {
+	gc.TestingT(t)
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentpruner
+
+import (
+	"context"
+
+	"github.com/juju/errors"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/catacomb"
+
+	"github.com/juju/juju/core/logger"
+	coresecrets "github.com/juju/juju/core/secrets"
+	"github.com/juju/juju/core/watcher"
+)
+
+// SecretService provides the secret service methods the worker needs.
+type SecretService interface {
+	// WatchSecretsRevisionsPendingDeletion returns a watcher that notifies of
+	// obsolete secret revisions whose backend content has not been deleted.
+	WatchSecretsRevisionsPendingDeletion(ctx context.Context) (watcher.SecretRevisionWatcher, error)
+
+	// DeleteSecretRevisionContent deletes the referenced content from its
+	// secret backend.
+	DeleteSecretRevisionContent(ctx context.Context, ref coresecrets.ValueRef) error
+
+	// MarkSecretRevisionDeleted records that the backend content of the
+	// revision has been deleted.
+	MarkSecretRevisionDeleted(ctx context.Context, revisionUUID string) error
+}
+
+// Config defines the operation of the Worker.
+type Config struct {
+	SecretService SecretService
+	Logger        logger.Logger
+}
+
+// Validate returns an error if config cannot drive the Worker.
+func (config Config) Validate() error {
+	if config.SecretService == nil {
+		return errors.NotValidf("nil SecretService")
+	}
+	if config.Logger == nil {
+		return errors.NotValidf("nil Logger")
+	}
+	return nil
+}
+
+// NewWorker returns a secretcontentpruner Worker backed by config, or an
+// error.
+func NewWorker(config Config) (worker.Worker, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Trace(err)
+	}
+
+	w := &Worker{config: config}
+	err := catacomb.Invoke(catacomb.Plan{
+		Site: &w.catacomb,
+		Work: w.loop,
+	})
+	return w, errors.Trace(err)
+}
+
+// Worker deletes the backend content of obsolete secret revisions.
+type Worker struct {
+	catacomb catacomb.Catacomb
+	config   Config
+}
+
+// Kill is defined on worker.Worker.
+func (w *Worker) Kill() {
+	w.catacomb.Kill(nil)
+}
+
+// Wait is part of the worker.Worker interface.
+func (w *Worker) Wait() error {
+	return w.catacomb.Wait()
+}
+
+func (w *Worker) loop() (err error) {
+	ctx, cancel := w.scopeContext()
+	defer cancel()
+
+	watcher, err := w.config.SecretService.WatchSecretsRevisionsPendingDeletion(ctx)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if err := w.catacomb.Add(watcher); err != nil {
+		return errors.Trace(err)
+	}
+
+	for {
+		select {
+		case <-w.catacomb.Dying():
+			return errors.Trace(w.catacomb.ErrDying())
+		case changes, ok := <-watcher.Changes():
+			if !ok {
+				return errors.New("secret revisions pending deletion watch closed")
+			}
+			for _, change := range changes {
+				if err := w.deleteContent(ctx, change); err != nil {
+					return errors.Trace(err)
+				}
+			}
+		}
+	}
+}
+
+// deleteContent deletes the backend content of the revision and then records
+// that it has been deleted. If the worker fails in between, the revision is
+// reported again when the worker restarts and deleting its content again is
+// harmless.
+func (w *Worker) deleteContent(ctx context.Context, change watcher.SecretRevisionPendingDeletion) error {
+	w.config.Logger.Debugf("deleting backend content of obsolete secret revision %#v", change)
+	if err := w.config.SecretService.DeleteSecretRevisionContent(ctx, change.ValueRef); err != nil {
+		return errors.Annotatef(err, "deleting content of secret %s revision %d", change.URI.ID, change.Revision)
+	}
+	if err := w.config.SecretService.MarkSecretRevisionDeleted(ctx, change.RevisionUUID); err != nil {
+		return errors.Annotatef(err, "marking secret %s revision %d deleted", change.URI.ID, change.Revision)
+	}
+	return nil
+}
+
+func (w *Worker) scopeContext() (context.Context, context.CancelFunc) {
+	return context.WithCancel(w.catacomb.Context(context.Background()))
+}
@@ -0,0 +1,121 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package secretcontentpruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/juju/errors"
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	"github.com/juju/worker/v4"
+	"github.com/juju/worker/v4/workertest"
+	"go.uber.org/mock/gomock"
+	gc "gopkg.in/check.v1"
+
+	coresecrets "github.com/juju/juju/core/secrets"
+	coretesting "github.com/juju/juju/core/testing"
+	"github.com/juju/juju/core/watcher"
+	loggertesting "github.com/juju/juju/internal/logger/testing"
+)
+
+type workerSuite struct {
+	testing.IsolationSuite
+
+	secretService *MockSecretService
+	changes       chan []watcher.SecretRevisionPendingDeletion
+}
+
+var _ = gc.Suite(&workerSuite{})
+
+func (s *workerSuite) setupMocks(c *gc.C) *gomock.Controller {
+	ctrl := gomock.NewController(c)
+	s.secretService = NewMockSecretService(ctrl)
+
+	s.changes = make(chan []watcher.SecretRevisionPendingDeletion, 1)
+	s.secretService.EXPECT().WatchSecretsRevisionsPendingDeletion(gomock.Any()).Return(
+		&revisionWatcher{Worker: workertest.NewErrorWorker(nil), changes: s.changes}, nil,
+	)
+	return ctrl
+}
+
+func (s *workerSuite) newWorker(c *gc.C) worker.Worker {
+	w, err := NewWorker(Config{
+		SecretService: s.secretService,
+		Logger:        loggertesting.WrapCheckLog(c),
+	})
+	c.Assert(err, jc.ErrorIsNil)
+	return w
+}
+
+func (s *workerSuite) TestValidateConfig(c *gc.C) {
+	cfg := Config{Logger: loggertesting.WrapCheckLog(c)}
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil SecretService not valid")
+
+	cfg = Config{SecretService: &MockSecretService{}}
+	c.Check(cfg.Validate(), gc.ErrorMatches, "nil Logger not valid")
+}
+
+func (s *workerSuite) TestDeleteContent(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ref := coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id"}
+
+	done := make(chan struct{})
+	gomock.InOrder(
+		s.secretService.EXPECT().DeleteSecretRevisionContent(gomock.Any(), ref).Return(nil),
+		s.secretService.EXPECT().MarkSecretRevisionDeleted(gomock.Any(), "rev-uuid").DoAndReturn(
+			func(context.Context, string) error {
+				close(done)
+				return nil
+			}),
+	)
+
+	w := s.newWorker(c)
+	defer workertest.CleanKill(c, w)
+
+	s.changes <- []watcher.SecretRevisionPendingDeletion{{
+		RevisionUUID: "rev-uuid",
+		URI:          uri,
+		Revision:     666,
+		ValueRef:     ref,
+	}}
+	select {
+	case <-done:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for content to be deleted")
+	}
+}
+
+func (s *workerSuite) TestDeleteContentError(c *gc.C) {
+	defer s.setupMocks(c).Finish()
+
+	uri := coresecrets.NewURI()
+	ref := coresecrets.ValueRef{BackendID: "backend-id", RevisionID: "rev-id"}
+	s.secretService.EXPECT().DeleteSecretRevisionContent(gomock.Any(), ref).Return(errors.New("boom"))
+
+	w := s.newWorker(c)
+	s.changes <- []watcher.SecretRevisionPendingDeletion{{
+		RevisionUUID: "rev-uuid",
+		URI:          uri,
+		Revision:     666,
+		ValueRef:     ref,
+	}}
+
+	// The revision is not marked deleted, so it is reported again once the
+	// worker is restarted.
+	err := workertest.CheckKilled(c, w)
+	c.Assert(err, gc.ErrorMatches, `deleting content of secret .* revision 666: boom`)
+}
+
+type revisionWatcher struct {
+	worker.Worker
+	changes chan []watcher.SecretRevisionPendingDeletion
+}
+
+func (w *revisionWatcher) Changes() <-chan []watcher.SecretRevisionPendingDeletion {
+	return w.changes
+}
@@ -454,6 +454,9 @@ func (w *upgradeDBWorker) upgradeController(ctx context.Context) error {
 	if err != nil {
 		return errors.Annotatef(err, "applying controller schema")
 	}
+	if err := schema.RecordMigrationChecksum(ctx, db); err != nil {
+		return errors.Annotatef(err, "recording controller schema checksum")
+	}
 	w.logger.Infof("applied controller schema changes from: %d to: %d", changeSet.Post, changeSet.Current)
 	return nil
 }
@@ -486,6 +489,9 @@ func (w *upgradeDBWorker) upgradeModel(ctx context.Context, modelUUID coremodel.
 	if err != nil {
 		return errors.Annotatef(err, "applying model schema %s", modelUUID)
 	}
+	if err := schema.RecordMigrationChecksum(ctx, db); err != nil {
+		return errors.Annotatef(err, "recording model schema checksum %s", modelUUID)
+	}
 	w.logger.Infof("applied model schema changes from: %d to: %d for model %s", changeSet.Post, changeSet.Current, modelUUID)
 	return nil
 }
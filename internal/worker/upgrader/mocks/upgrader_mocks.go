@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	upgrade "github.com/juju/juju/core/upgrade"
 	watcher "github.com/juju/juju/core/watcher"
 	tools "github.com/juju/juju/internal/tools"
 	version "github.com/juju/version/v2"
@@ -81,6 +82,44 @@ func (c *MockUpgraderClientDesiredVersionCall) DoAndReturn(f func(context.Contex
 	return c
 }
 
+// SetAgentUpgradeState mocks base method.
+func (m *MockUpgraderClient) SetAgentUpgradeState(arg0 context.Context, arg1 string, arg2, arg3 version.Number, arg4 upgrade.AgentState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAgentUpgradeState", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAgentUpgradeState indicates an expected call of SetAgentUpgradeState.
+func (mr *MockUpgraderClientMockRecorder) SetAgentUpgradeState(arg0, arg1, arg2, arg3, arg4 any) *MockUpgraderClientSetAgentUpgradeStateCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAgentUpgradeState", reflect.TypeOf((*MockUpgraderClient)(nil).SetAgentUpgradeState), arg0, arg1, arg2, arg3, arg4)
+	return &MockUpgraderClientSetAgentUpgradeStateCall{Call: call}
+}
+
+// MockUpgraderClientSetAgentUpgradeStateCall wrap *gomock.Call
+type MockUpgraderClientSetAgentUpgradeStateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockUpgraderClientSetAgentUpgradeStateCall) Return(arg0 error) *MockUpgraderClientSetAgentUpgradeStateCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockUpgraderClientSetAgentUpgradeStateCall) Do(f func(context.Context, string, version.Number, version.Number, upgrade.AgentState) error) *MockUpgraderClientSetAgentUpgradeStateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockUpgraderClientSetAgentUpgradeStateCall) DoAndReturn(f func(context.Context, string, version.Number, version.Number, upgrade.AgentState) error) *MockUpgraderClientSetAgentUpgradeStateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // SetVersion mocks base method.
 func (m *MockUpgraderClient) SetVersion(arg0 context.Context, arg1 string, arg2 version.Binary) error {
 	m.ctrl.T.Helper()
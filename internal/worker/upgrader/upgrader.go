@@ -22,6 +22,7 @@ import (
 	"github.com/juju/juju/core/arch"
 	"github.com/juju/juju/core/logger"
 	coreos "github.com/juju/juju/core/os"
+	"github.com/juju/juju/core/upgrade"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/core/watcher"
 	jujuhttp "github.com/juju/juju/internal/http"
@@ -48,6 +49,7 @@ const (
 type UpgraderClient interface {
 	DesiredVersion(ctx context.Context, tag string) (version.Number, error)
 	SetVersion(ctx context.Context, tag string, v version.Binary) error
+	SetAgentUpgradeState(ctx context.Context, tag string, current, target version.Number, state upgrade.AgentState) error
 	WatchAPIVersion(ctx context.Context, agentTag string) (watcher.NotifyWatcher, error)
 	Tools(ctx context.Context, tag string) (coretools.List, error)
 }
@@ -149,6 +151,12 @@ func (u *Upgrader) loop() error {
 		return nil
 	}
 
+	// The agent has restarted into a new agent binary and completed its
+	// upgrade steps.
+	if orig := u.config.OrigAgentVersion; orig != version.Zero && orig != jujuversion.Current {
+		u.setUpgradeState(ctx, orig, jujuversion.Current, upgrade.AgentUpgraded)
+	}
+
 	versionWatcher, err := u.client.WatchAPIVersion(ctx, u.tag.String())
 	if err != nil {
 		return errors.Trace(err)
@@ -198,6 +206,7 @@ func (u *Upgrader) loop() error {
 		// Check if tools have already been downloaded.
 		wantVersionBinary := toBinaryVersion(wantVersion, hostOSType)
 		if u.toolsAlreadyDownloaded(wantVersionBinary) {
+			u.setUpgradeState(ctx, haveVersion, wantVersion, upgrade.AgentRestarting)
 			return u.newUpgradeReadyError(haveVersion, wantVersionBinary, hostOSType)
 		}
 
@@ -219,8 +228,11 @@ func (u *Upgrader) loop() error {
 				delay = notEnoughSpaceDelay
 				break
 			}
+			u.setUpgradeState(ctx, haveVersion, wantVersion, upgrade.AgentDownloading)
 			err = u.ensureTools(wantTools)
 			if err == nil {
+				u.setUpgradeState(ctx, haveVersion, wantVersion, upgrade.AgentStaging)
+				u.setUpgradeState(ctx, haveVersion, wantVersion, upgrade.AgentRestarting)
 				return u.newUpgradeReadyError(haveVersion, wantTools.Version, hostOSType)
 			}
 			logger.Errorf("failed to fetch agent binaries from %q: %v", wantTools.URL, err)
@@ -229,6 +241,15 @@ func (u *Upgrader) loop() error {
 	}
 }
 
+// setUpgradeState records the progress of the agent through an upgrade. The
+// progress is informational, so failing to record it does not stop the
+// upgrade.
+func (u *Upgrader) setUpgradeState(ctx context.Context, current, target version.Number, state upgrade.AgentState) {
+	if err := u.client.SetAgentUpgradeState(ctx, u.tag.String(), current, target, state); err != nil {
+		u.config.Logger.Warningf("cannot record agent upgrade state %q: %v", state, err)
+	}
+}
+
 func toBinaryVersion(vers version.Number, osType string) version.Binary {
 	outVers := version.Binary{
 		Number:  vers,
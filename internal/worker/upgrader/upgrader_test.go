@@ -25,6 +25,7 @@ import (
 	agenterrors "github.com/juju/juju/agent/errors"
 	agenttools "github.com/juju/juju/agent/tools"
 	"github.com/juju/juju/core/arch"
+	"github.com/juju/juju/core/upgrade"
 	jujuversion "github.com/juju/juju/core/version"
 	"github.com/juju/juju/core/watcher/watchertest"
 	"github.com/juju/juju/environs/filestorage"
@@ -76,6 +77,7 @@ func (s *UpgraderSuite) SetUpTest(c *gc.C) {
 
 	s.initialCheckComplete = gate.NewLock()
 	s.clock = testclock.NewClock(time.Now())
+	s.confVersion = version.Zero
 }
 
 func (s *UpgraderSuite) patchVersion(v version.Binary) {
@@ -210,6 +212,7 @@ func (s *UpgraderSuite) TestUpgraderUpgradesImmediately(c *gc.C) {
 	client.EXPECT().SetVersion(gomock.Any(), "machine-666", vers)
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(newVersion.Number, nil)
 	client.EXPECT().WatchAPIVersion(gomock.Any(), "machine-666").Return(watch, nil)
+	s.expectUpgradeStates(client, vers.Number, newVersion.Number, upgrade.AgentRestarting)
 
 	u := s.makeUpgrader(c, client)
 	err := workertest.CheckKilled(c, u)
@@ -250,6 +253,7 @@ func (s *UpgraderSuite) TestUpgraderRetryAndChanged(c *gc.C) {
 	client.EXPECT().Tools(gomock.Any(), "machine-666").Return(coretools.List{{
 		URL: "http://invalid",
 	}}, nil).Times(retryCount + 1)
+	client.EXPECT().SetAgentUpgradeState(gomock.Any(), "machine-666", vers.Number, newVersion.Number, upgrade.AgentDownloading).Times(retryCount + 1)
 
 	u := s.makeUpgrader(c, client)
 	defer func() { _ = workertest.CheckKilled(c, u) }()
@@ -271,6 +275,8 @@ func (s *UpgraderSuite) TestUpgraderRetryAndChanged(c *gc.C) {
 
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(newerVersion.Number, nil)
 	client.EXPECT().Tools(gomock.Any(), "machine-666").Return(coretools.List{newTools}, nil)
+	s.expectUpgradeStates(client, vers.Number, newerVersion.Number,
+		upgrade.AgentDownloading, upgrade.AgentStaging, upgrade.AgentRestarting)
 	ch <- struct{}{}
 
 	done := make(chan error)
@@ -338,6 +344,7 @@ func (s *UpgraderSuite) TestUsesAlreadyDownloadedToolsIfAvailable(c *gc.C) {
 	envtesting.InstallFakeDownloadedTools(c, s.dataDir, newVersion)
 
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(newVersion.Number, nil)
+	s.expectUpgradeStates(client, vers.Number, newVersion.Number, upgrade.AgentRestarting)
 	ch <- struct{}{}
 
 	u := s.makeUpgrader(c, client)
@@ -376,6 +383,8 @@ func (s *UpgraderSuite) TestUpgraderAllowsDowngradingMinorVersions(c *gc.C) {
 	client.EXPECT().WatchAPIVersion(gomock.Any(), "machine-666").Return(watch, nil)
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(oldVersion.Number, nil)
 	client.EXPECT().Tools(gomock.Any(), "machine-666").Return(coretools.List{downgradeTools}, nil)
+	s.expectUpgradeStates(client, vers.Number, oldVersion.Number,
+		upgrade.AgentDownloading, upgrade.AgentStaging, upgrade.AgentRestarting)
 
 	u := s.makeUpgrader(c, client)
 	err := workertest.CheckKilled(c, u)
@@ -453,6 +462,8 @@ func (s *UpgraderSuite) TestUpgraderAllowsDowngradingPatchVersions(c *gc.C) {
 	client.EXPECT().WatchAPIVersion(gomock.Any(), "machine-666").Return(watch, nil)
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(oldVersion.Number, nil)
 	client.EXPECT().Tools(gomock.Any(), "machine-666").Return(coretools.List{downgradeTools}, nil)
+	s.expectUpgradeStates(client, vers.Number, oldVersion.Number,
+		upgrade.AgentDownloading, upgrade.AgentStaging, upgrade.AgentRestarting)
 
 	u := s.makeUpgrader(c, client)
 	err := workertest.CheckKilled(c, u)
@@ -497,6 +508,9 @@ func (s *UpgraderSuite) TestUpgraderAllowsDowngradeToPriorMinorVersion(c *gc.C)
 	client.EXPECT().WatchAPIVersion(gomock.Any(), "machine-666").Return(watch, nil)
 	client.EXPECT().DesiredVersion(gomock.Any(), "machine-666").Return(downgradeVersion.Number, nil)
 	client.EXPECT().Tools(gomock.Any(), "machine-666").Return(coretools.List{prevTools}, nil)
+	s.expectUpgradeStates(client, downgradeVersion.Number, origTools.Version.Number, upgrade.AgentUpgraded)
+	s.expectUpgradeStates(client, origTools.Version.Number, downgradeVersion.Number,
+		upgrade.AgentDownloading, upgrade.AgentStaging, upgrade.AgentRestarting)
 
 	u := s.makeUpgrader(c, client)
 	err := workertest.CheckKilled(c, u)
@@ -580,6 +594,14 @@ func (s *UpgraderSuite) TestChecksSpaceBeforeDownloading(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, `cannot read agent metadata in directory.*: no such file or directory`)
 }
 
+func (s *UpgraderSuite) expectUpgradeStates(client *mocks.MockUpgraderClient, current, target version.Number, states ...upgrade.AgentState) {
+	var calls []any
+	for _, state := range states {
+		calls = append(calls, client.EXPECT().SetAgentUpgradeState(gomock.Any(), "machine-666", current, target, state))
+	}
+	gomock.InOrder(calls...)
+}
+
 func (s *UpgraderSuite) waitForUpgradeCheck(c *gc.C) {
 	select {
 	case <-s.initialCheckComplete.Unlocked():
@@ -79,8 +79,8 @@ func (e *RequestError) UnmarshalInfo(to interface{}) error {
 }
 
 func (conn *Conn) send(call *Call) uint64 {
-	conn.sending.Lock()
-	defer conn.sending.Unlock()
+	conn.sending.lock(requestPriority(call.Request))
+	defer conn.sending.unlock()
 
 	// Register this call.
 	conn.mutex.Lock()
@@ -3,7 +3,10 @@
 
 package rpc
 
-const CodeNotImplemented = codeNotImplemented
+const (
+	CodeNotImplemented = codeNotImplemented
+	CodeRequestTimeout = codeRequestTimeout
+)
 
 // TODO(katco): Remove this as it is exposing internal state of Conn. Age old story: ran out of time to rewrite the tests to do this correctly.
 
@@ -12,3 +15,9 @@ const CodeNotImplemented = codeNotImplemented
 func (c *Conn) ClientRequestID() uint64 {
 	return c.reqId
 }
+
+// PendingWrites returns the number of messages waiting to be written to the
+// connection's codec.
+func (c *Conn) PendingWrites() int {
+	return c.sending.pending()
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc_test
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/internal/testing"
+	"github.com/juju/juju/rpc"
+)
+
+type fairnessSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&fairnessSuite{})
+
+type fairnessRoot struct{}
+
+func (fairnessRoot) Client(id string) (fairnessClient, error) {
+	return fairnessClient{}, nil
+}
+
+func (fairnessRoot) Pinger(id string) (fairnessPinger, error) {
+	return fairnessPinger{}, nil
+}
+
+type largeResult struct {
+	Data string
+}
+
+type fairnessClient struct{}
+
+func (fairnessClient) Large() largeResult {
+	return largeResult{Data: strings.Repeat("x", 1<<20)}
+}
+
+type fairnessPinger struct{}
+
+func (fairnessPinger) Ping() {}
+
+// scriptedCodec is a codec which reads the requests sent on its input
+// channel, and records the request IDs of the messages written to it. The
+// writes of large results block until released.
+type scriptedCodec struct {
+	input   chan rpc.Header
+	closed  chan struct{}
+	release chan struct{}
+	started chan uint64
+
+	mu      sync.Mutex
+	written []uint64
+	once    sync.Once
+}
+
+func newScriptedCodec() *scriptedCodec {
+	return &scriptedCodec{
+		input:   make(chan rpc.Header),
+		closed:  make(chan struct{}),
+		release: make(chan struct{}),
+		started: make(chan uint64, 10),
+	}
+}
+
+func (c *scriptedCodec) ReadHeader(hdr *rpc.Header) error {
+	select {
+	case h := <-c.input:
+		*hdr = h
+		return nil
+	case <-c.closed:
+		return io.EOF
+	}
+}
+
+func (c *scriptedCodec) ReadBody(body interface{}, isRequest bool) error {
+	return nil
+}
+
+func (c *scriptedCodec) WriteMessage(hdr *rpc.Header, body interface{}) error {
+	if _, ok := body.(largeResult); ok {
+		c.started <- hdr.RequestId
+		select {
+		case <-c.release:
+		case <-c.closed:
+			return io.EOF
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.written = append(c.written, hdr.RequestId)
+	return nil
+}
+
+func (c *scriptedCodec) Close() error {
+	c.once.Do(func() { close(c.closed) })
+	return nil
+}
+
+func (c *scriptedCodec) Written() []uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]uint64(nil), c.written...)
+}
+
+func (s *fairnessSuite) send(c *gc.C, codec *scriptedCodec, id uint64, typ, action string) {
+	select {
+	case codec.input <- rpc.Header{
+		RequestId: id,
+		Request:   rpc.Request{Type: typ, Action: action},
+		Version:   1,
+	}:
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out sending request %d", id)
+	}
+}
+
+func (s *fairnessSuite) waitPendingWrites(c *gc.C, conn *rpc.Conn, n int) {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if conn.PendingWrites() == n {
+			return
+		}
+	}
+	c.Fatalf("timed out waiting for %d pending writes, have %d", n, conn.PendingWrites())
+}
+
+func (s *fairnessSuite) waitWritten(c *gc.C, codec *scriptedCodec, n int) []uint64 {
+	for a := coretesting.LongAttempt.Start(); a.Next(); {
+		if written := codec.Written(); len(written) >= n {
+			return written
+		}
+	}
+	c.Fatalf("timed out waiting for %d writes, have %v", n, codec.Written())
+	return nil
+}
+
+func (s *fairnessSuite) TestPingNotBlockedBehindLargeResponses(c *gc.C) {
+	codec := newScriptedCodec()
+	conn := rpc.NewConn(codec, nil)
+	conn.Serve(fairnessRoot{}, nil, nil)
+	conn.Start(context.Background())
+	defer func() {
+		_ = codec.Close()
+		for {
+			select {
+			case codec.release <- struct{}{}:
+			default:
+				c.Check(conn.Close(), jc.ErrorIsNil)
+				return
+			}
+		}
+	}()
+
+	// The first large response is being written.
+	s.send(c, codec, 1, "Client", "Large")
+	select {
+	case id := <-codec.started:
+		c.Assert(id, gc.Equals, uint64(1))
+	case <-time.After(coretesting.LongWait):
+		c.Fatalf("timed out waiting for large response to be written")
+	}
+
+	// The second large response is queued behind it, followed by a ping.
+	s.send(c, codec, 2, "Client", "Large")
+	s.waitPendingWrites(c, conn, 1)
+	s.send(c, codec, 3, "Pinger", "Ping")
+	s.waitPendingWrites(c, conn, 2)
+
+	// Once the first large response has been written, the ping reply is
+	// written ahead of the queued large response.
+	codec.release <- struct{}{}
+	c.Check(s.waitWritten(c, codec, 2), jc.DeepEquals, []uint64{1, 3})
+
+	codec.release <- struct{}{}
+	c.Check(s.waitWritten(c, codec, 3), jc.DeepEquals, []uint64{1, 3, 2})
+}
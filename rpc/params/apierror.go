@@ -209,6 +209,7 @@ const (
 	CodeQuotaLimitExceeded         = "quota limit exceeded"
 	CodeNotLeader                  = "not leader"
 	CodeDeadlineExceeded           = "deadline exceeded"
+	CodeRequestTimeout             = "request timeout" // asserted to match rpc.codeRequestTimeout in rpc/rpc_test.go
 	CodeNotYetAvailable            = "not yet available; try again later"
 	CodeNotValid                   = "not valid"
 	CodeSecretBackendNotValid      = "secret backend not valid"
@@ -546,6 +547,10 @@ func IsCodeDeadlineExceeded(err error) bool {
 	return ErrCode(err) == CodeDeadlineExceeded
 }
 
+func IsCodeRequestTimeout(err error) bool {
+	return ErrCode(err) == CodeRequestTimeout
+}
+
 func IsCodeAppShouldNotHaveUnits(err error) bool {
 	return ErrCode(err) == CodeAppShouldNotHaveUnits
 }
@@ -644,6 +644,20 @@ type EntitiesVersion struct {
 	AgentTools []EntityVersion `json:"agent-tools"`
 }
 
+// AgentUpgradeState holds the progress of an agent through an agent
+// binary upgrade.
+type AgentUpgradeState struct {
+	Tag            string         `json:"tag"`
+	CurrentVersion version.Number `json:"current-version"`
+	TargetVersion  version.Number `json:"target-version"`
+	State          string         `json:"state"`
+}
+
+// SetAgentUpgradeStates holds the upgrade progress of multiple agents.
+type SetAgentUpgradeStates struct {
+	States []AgentUpgradeState `json:"states"`
+}
+
 // NotifyWatchResult holds a NotifyWatcher id and an error (if any).
 type NotifyWatchResult struct {
 	NotifyWatcherId string
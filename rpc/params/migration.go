@@ -88,6 +88,34 @@ type SerializedModel struct {
 	Charms    []string                  `json:"charms"`
 	Tools     []SerializedModelTools    `json:"tools"`
 	Resources []SerializedModelResource `json:"resources"`
+
+	// SecretBackends holds the secret backends the model depends on. It
+	// is carried outside the model description since it holds backend
+	// credentials. It is nil if the source controller does not export
+	// secret backends.
+	SecretBackends *SerializedModelSecretBackends `json:"secret-backends,omitempty"`
+}
+
+// SerializedModelSecretBackends holds the secret backends a serialized
+// model depends on.
+type SerializedModelSecretBackends struct {
+	// ModelBackendName is the name of the backend the model is configured
+	// to use, or "auto" for the built-in backend.
+	ModelBackendName string `json:"model-backend-name"`
+
+	// Backends are the user-defined secret backends used by the model.
+	Backends []SerializedModelSecretBackend `json:"backends,omitempty"`
+}
+
+// SerializedModelSecretBackend holds the details for a single secret
+// backend used by a serialized model.
+type SerializedModelSecretBackend struct {
+	ID                  string                 `json:"id"`
+	Name                string                 `json:"name"`
+	BackendType         string                 `json:"backend-type"`
+	TokenRotateInterval *time.Duration         `json:"token-rotate-interval,omitempty"`
+	NextRotateTime      *time.Time             `json:"next-rotate-time,omitempty"`
+	Config              map[string]interface{} `json:"config,omitempty"`
 }
 
 // SerializedModelTools holds the version and URI for a given tools
@@ -180,6 +180,9 @@ type ModelInfo struct {
 	// entries (e.g. juju version) and other features that depend on the
 	// substrate the model is deployed to.
 	SupportedFeatures []SupportedFeature `json:"supported-features,omitempty"`
+
+	// Annotations holds the annotations set on the model.
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // SupportedFeature describes a feature that is supported by a particular model.
@@ -44,6 +44,10 @@ type Subnet struct {
 	// associated with.
 	Zones []string `json:"zones"`
 
+	// ProviderAttributes holds provider-specific metadata about the
+	// subnet, such as secondary ranges or service endpoints.
+	ProviderAttributes map[string]string `json:"provider-attributes,omitempty"`
+
 	// TODO (jack-w-shaw 2022-02-22): Remove this. It is unused
 	//
 	// Status returns the status of the subnet, whether it is in use, not
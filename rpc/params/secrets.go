@@ -255,6 +255,14 @@ type ListSecretsArgs struct {
 	Filter      SecretsFilter `json:"filter"`
 }
 
+// SearchSecretsArgs holds the args for searching secrets.
+type SearchSecretsArgs struct {
+	// Query is matched, ignoring case, against secret labels and descriptions.
+	Query string `json:"query"`
+	// Scope is one of "all", "owned" or "accessible".
+	Scope string `json:"scope,omitempty"`
+}
+
 // ListSecretResults holds secret metadata results.
 type ListSecretResults struct {
 	Results []ListSecretResult `json:"results"`
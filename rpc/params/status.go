@@ -159,9 +159,10 @@ type ApplicationStatus struct {
 	EndpointBindings map[string]string          `json:"endpoint-bindings"`
 
 	// The following are for CAAS models.
-	Scale         int    `json:"int,omitempty"`
-	ProviderId    string `json:"provider-id,omitempty"`
-	PublicAddress string `json:"public-address"`
+	Scale         int        `json:"int,omitempty"`
+	ScaleChanged  *time.Time `json:"scale-changed,omitempty"`
+	ProviderId    string     `json:"provider-id,omitempty"`
+	PublicAddress string     `json:"public-address"`
 }
 
 // RemoteApplicationStatus holds status info about a remote application.
@@ -1187,6 +1187,10 @@ func (*rpcSuite) TestCodeNotImplementedMatchesAPIserverParams(c *gc.C) {
 	c.Assert(rpc.CodeNotImplemented, gc.Equals, params.CodeNotImplemented)
 }
 
+func (*rpcSuite) TestCodeRequestTimeoutMatchesAPIserverParams(c *gc.C) {
+	c.Assert(rpc.CodeRequestTimeout, gc.Equals, params.CodeRequestTimeout)
+}
+
 func (*rpcSuite) TestRequestContext(c *gc.C) {
 	root := &Root{}
 	root.contextInst = &ContextMethods{root: root}
@@ -1267,6 +1271,68 @@ func (*rpcSuite) TestConnectionContextCloseServer(c *gc.C) {
 	c.Assert(err, gc.ErrorMatches, "context canceled")
 }
 
+func (*rpcSuite) TestRequestTimeoutCancelsContext(c *gc.C) {
+	root := &Root{}
+	root.contextInst = &ContextMethods{
+		root:    root,
+		waiting: make(chan struct{}),
+	}
+
+	client, server, srvDone, _ := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+	server.SetRequestTimeouts(rpc.RequestTimeouts{Default: testing.ShortWait})
+
+	err := client.Call(context.Background(), rpc.Request{Type: "ContextMethods", Version: 0, Id: "", Action: "Wait"}, nil, nil)
+	c.Assert(err, gc.ErrorMatches, `ContextMethods.Wait timed out after .*`)
+	c.Check(errors.Cause(err).(rpc.ErrorCoder).ErrorCode(), gc.Equals, rpc.CodeRequestTimeout)
+}
+
+func (*rpcSuite) TestRequestTimeoutDiscardsResult(c *gc.C) {
+	ready := make(chan struct{}, 1)
+	done := make(chan string, 1)
+	root := &Root{
+		delayed: map[string]*DelayedMethods{
+			"1": {ready: ready, done: done},
+		},
+	}
+
+	client, server, srvDone, _ := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+	server.SetRequestTimeouts(rpc.RequestTimeouts{Default: testing.ShortWait})
+
+	// Delay ignores its context, so it is left blocked after the timeout
+	// has been reported to the client.
+	var r stringVal
+	err := client.Call(context.Background(), rpc.Request{Type: "DelayedMethods", Version: 0, Id: "1", Action: "Delay"}, nil, &r)
+	c.Assert(err, gc.ErrorMatches, `DelayedMethods.Delay timed out after .*`)
+	c.Check(r, gc.Equals, stringVal{})
+	<-ready
+
+	// Once the handler completes, the connection keeps working and the
+	// late result is never sent.
+	done <- "late"
+	server.SetRequestTimeouts(rpc.RequestTimeouts{})
+	done <- "on time"
+	err = client.Call(context.Background(), rpc.Request{Type: "DelayedMethods", Version: 0, Id: "1", Action: "Delay"}, nil, &r)
+	c.Assert(err, jc.ErrorIsNil)
+	c.Check(r, gc.Equals, stringVal{"on time"})
+}
+
+func (*rpcSuite) TestRequestTimeoutFacadeOverride(c *gc.C) {
+	root := &Root{}
+	root.contextInst = &ContextMethods{root: root}
+
+	client, server, srvDone, _ := newRPCClientServer(c, root, nil, false)
+	defer closeClient(c, client, srvDone)
+	server.SetRequestTimeouts(rpc.RequestTimeouts{
+		Default: time.Nanosecond,
+		Facades: map[string]time.Duration{"ContextMethods": 0},
+	})
+
+	err := client.Call(context.Background(), rpc.Request{Type: "ContextMethods", Version: 0, Id: "", Action: "Call0"}, nil, nil)
+	c.Assert(err, jc.ErrorIsNil)
+}
+
 func (s *rpcSuite) TestRecorderErrorPreventsRequest(c *gc.C) {
 	root := &Root{
 		simple: make(map[string]*SimpleMethods),
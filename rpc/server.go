@@ -153,9 +153,10 @@ type Conn struct {
 	srvPending sync.WaitGroup
 
 	// sending guards the write side of the codec - it ensures
-	// that codec.WriteMessage is not called concurrently.
+	// that codec.WriteMessage is not called concurrently, and that
+	// queued control messages are written ahead of other messages.
 	// It also guards shutdown.
-	sending sync.Mutex
+	sending writeQueue
 
 	// mutex guards the following values.
 	mutex sync.Mutex
@@ -199,6 +200,9 @@ type Conn struct {
 	inputLoopError error
 
 	recorderFactory RecorderFactory
+
+	// requestTimeouts holds the time limits for served requests.
+	requestTimeouts RequestTimeouts
 }
 
 // NewConn creates a new connection that uses the given codec for
@@ -406,8 +410,8 @@ type Killer interface {
 // appropriately.
 func (conn *Conn) input() {
 	err := conn.loop()
-	conn.sending.Lock()
-	defer conn.sending.Unlock()
+	conn.sending.lock(priorityControl)
+	defer conn.sending.unlock()
 	conn.mutex.Lock()
 	defer conn.mutex.Unlock()
 
@@ -528,8 +532,10 @@ func (conn *Conn) handleRequest(hdr *Header) error {
 }
 
 func (conn *Conn) writeErrorResponse(reqHdr *Header, err error, recorder Recorder) error {
-	conn.sending.Lock()
-	defer conn.sending.Unlock()
+	// Error replies have no body, so they are written ahead of any
+	// queued replies which may be large.
+	conn.sending.lock(priorityControl)
+	defer conn.sending.unlock()
 	hdr := &Header{
 		RequestId:  reqHdr.RequestId,
 		Version:    reqHdr.Version,
@@ -648,12 +654,17 @@ func (conn *Conn) callRequest(
 	version int,
 	recorder Recorder,
 ) {
-	rv, err := req.Call(ctx, req.hdr.Request.Id, arg)
+	rv, err := conn.callWithTimeout(ctx, req, arg)
 	if err != nil {
 		// Record the first error, this is the one that will be returned to
 		// the client.
 		trace.SpanFromContext(ctx).RecordError(err)
-		err = conn.writeErrorResponse(&req.hdr, req.transformErrors(err), recorder)
+		// Timeouts are reported as is, so the client always gets the
+		// timeout code.
+		if _, ok := err.(*RequestTimeoutError); !ok {
+			err = req.transformErrors(err)
+		}
+		err = conn.writeErrorResponse(&req.hdr, err, recorder)
 	} else {
 		hdr := &Header{
 			RequestId:  req.hdr.RequestId,
@@ -671,9 +682,9 @@ func (conn *Conn) callRequest(
 		if err := recorder.HandleReply(req.hdr.Request, hdr, rvi); err != nil {
 			logger.Errorf("error recording reply %+v: %T %+v", hdr, err, err)
 		}
-		conn.sending.Lock()
+		conn.sending.lock(requestPriority(req.hdr.Request))
 		err = conn.codec.WriteMessage(hdr, rvi)
-		conn.sending.Unlock()
+		conn.sending.unlock()
 	}
 	if err != nil {
 		// If the message failed due to the other end closing the socket, that
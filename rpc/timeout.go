@@ -0,0 +1,134 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/juju/errors"
+)
+
+const codeRequestTimeout = "request timeout"
+
+// RequestTimeouts holds the maximum time the server allows a request to run
+// before giving up on it. A zero duration means no limit.
+type RequestTimeouts struct {
+	// Default is the limit for requests with no more specific limit.
+	Default time.Duration
+
+	// Watcher is the limit for requests on watcher facades, such as
+	// NotifyWatcher.Next, which legitimately block until there are changes.
+	// It is not limited by Default, so watchers are exempt unless this is
+	// set.
+	Watcher time.Duration
+
+	// Facades holds limits for requests on individual facades, keyed by
+	// facade name. They take precedence over both Default and Watcher.
+	Facades map[string]time.Duration
+}
+
+// timeoutFor returns the time limit for the given request.
+func (t RequestTimeouts) timeoutFor(req Request) time.Duration {
+	if timeout, ok := t.Facades[req.Type]; ok {
+		return timeout
+	}
+	if isWatcherFacade(req.Type) {
+		return t.Watcher
+	}
+	return t.Default
+}
+
+// isWatcherFacade reports whether the named facade serves watchers. By
+// convention all such facades are named for the type of watcher they serve.
+func isWatcherFacade(name string) bool {
+	return strings.HasSuffix(name, "Watcher")
+}
+
+// RequestTimeoutError is returned to the client when a request does not
+// complete within the server's time limit for it.
+type RequestTimeoutError struct {
+	Request Request
+	Timeout time.Duration
+}
+
+// Error implements error.
+func (e *RequestTimeoutError) Error() string {
+	return fmt.Sprintf("%s.%s timed out after %v", e.Request.Type, e.Request.Action, e.Timeout)
+}
+
+// ErrorCode implements ErrorCoder.
+func (e *RequestTimeoutError) ErrorCode() string {
+	return codeRequestTimeout
+}
+
+// SetRequestTimeouts sets the time limits for requests served by the
+// connection. It has no effect on requests that are currently being
+// serviced.
+func (conn *Conn) SetRequestTimeouts(timeouts RequestTimeouts) {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	conn.requestTimeouts = timeouts
+}
+
+func (conn *Conn) requestTimeout(req Request) time.Duration {
+	conn.mutex.Lock()
+	defer conn.mutex.Unlock()
+	return conn.requestTimeouts.timeoutFor(req)
+}
+
+type callResult struct {
+	rv  reflect.Value
+	err error
+}
+
+// callWithTimeout calls the request, cancelling its context if it runs
+// beyond its time limit. A handler that ignores the cancellation is left to
+// finish in the background and its result is discarded; the connection
+// still waits for it when closing.
+func (conn *Conn) callWithTimeout(ctx context.Context, req boundRequest, arg reflect.Value) (reflect.Value, error) {
+	timeout := conn.requestTimeout(req.hdr.Request)
+	if timeout <= 0 {
+		return req.Call(ctx, req.hdr.Request.Id, arg)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan callResult, 1)
+	conn.srvPending.Add(1)
+	go func() {
+		defer conn.srvPending.Done()
+		defer func() {
+			if panicResult := recover(); panicResult != nil {
+				logger.Criticalf(
+					"panic running request %+v with arg %+v: %v\n%v", req, arg, panicResult, string(debug.Stack()))
+				done <- callResult{err: errors.Errorf("%v", panicResult)}
+			}
+		}()
+		rv, err := req.Call(ctx, req.hdr.Request.Id, arg)
+		done <- callResult{rv: rv, err: err}
+	}()
+
+	select {
+	case result := <-done:
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) && errors.Is(result.err, context.DeadlineExceeded) {
+			return reflect.Value{}, &RequestTimeoutError{Request: req.hdr.Request, Timeout: timeout}
+		}
+		return result.rv, result.err
+	case <-ctx.Done():
+	}
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// The connection is closing; the handler is expected to return
+		// promptly, as it always has been.
+		result := <-done
+		return result.rv, result.err
+	}
+	logger.Warningf("request %s.%s exceeded its time limit of %v", req.hdr.Request.Type, req.hdr.Request.Action, timeout)
+	return reflect.Value{}, &RequestTimeoutError{Request: req.hdr.Request, Timeout: timeout}
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc
+
+import (
+	"time"
+
+	"github.com/juju/testing"
+	gc "gopkg.in/check.v1"
+)
+
+type requestTimeoutsSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&requestTimeoutsSuite{})
+
+func (s *requestTimeoutsSuite) TestTimeoutFor(c *gc.C) {
+	timeouts := RequestTimeouts{
+		Default: time.Minute,
+		Watcher: time.Hour,
+		Facades: map[string]time.Duration{
+			"Backups":         10 * time.Minute,
+			"AllModelWatcher": 0,
+		},
+	}
+	c.Check(timeouts.timeoutFor(Request{Type: "Client", Action: "FullStatus"}), gc.Equals, time.Minute)
+	c.Check(timeouts.timeoutFor(Request{Type: "NotifyWatcher", Action: "Next"}), gc.Equals, time.Hour)
+	c.Check(timeouts.timeoutFor(Request{Type: "Backups", Action: "Create"}), gc.Equals, 10*time.Minute)
+	c.Check(timeouts.timeoutFor(Request{Type: "AllModelWatcher", Action: "Next"}), gc.Equals, time.Duration(0))
+}
+
+func (s *requestTimeoutsSuite) TestTimeoutForWatchersExemptByDefault(c *gc.C) {
+	timeouts := RequestTimeouts{Default: time.Minute}
+	c.Check(timeouts.timeoutFor(Request{Type: "StringsWatcher", Action: "Next"}), gc.Equals, time.Duration(0))
+	c.Check(timeouts.timeoutFor(Request{Type: "Uniter", Action: "Watch"}), gc.Equals, time.Minute)
+}
@@ -0,0 +1,130 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc
+
+import (
+	"container/heap"
+	"sync"
+)
+
+// writePriority is the priority with which a message is written to the
+// codec. Messages with a higher priority are written ahead of any queued
+// messages with a lower priority.
+type writePriority int
+
+const (
+	// priorityNormal is used for requests and replies which may have an
+	// arbitrarily large body.
+	priorityNormal writePriority = iota
+
+	// priorityControl is used for small control messages, such as pings
+	// and error replies, which must not be delayed behind large replies.
+	priorityControl
+)
+
+// pingAction is the action of the request used by clients to check that the
+// connection is alive.
+const pingAction = "Ping"
+
+// requestPriority returns the write priority for messages initiated or
+// replied to for the given request.
+func requestPriority(req Request) writePriority {
+	if req.Action == pingAction {
+		return priorityControl
+	}
+	return priorityNormal
+}
+
+// writeQueue serialises writes to the codec. It behaves as a mutex, except
+// that when the lock is released it is handed to the waiter with the highest
+// priority. Waiters with equal priority are handed the lock in the order in
+// which they asked for it, so the messages of a given priority are written
+// in order.
+//
+// A message which is already being written can not be interrupted, as the
+// codec writes whole messages; rather, control messages are written as soon
+// as the current write completes, ahead of any other queued messages.
+type writeQueue struct {
+	mu      sync.Mutex
+	locked  bool
+	seq     uint64
+	waiters writeWaiters
+}
+
+// lock blocks until the caller has exclusive access to the codec's write
+// side.
+func (q *writeQueue) lock(priority writePriority) {
+	q.mu.Lock()
+	if !q.locked {
+		q.locked = true
+		q.mu.Unlock()
+		return
+	}
+	w := &writeWaiter{
+		priority: priority,
+		seq:      q.seq,
+		ready:    make(chan struct{}),
+	}
+	q.seq++
+	heap.Push(&q.waiters, w)
+	q.mu.Unlock()
+
+	<-w.ready
+}
+
+// unlock releases exclusive access to the codec's write side, handing it to
+// the next waiter, if any.
+func (q *writeQueue) unlock() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.locked {
+		panic("rpc: unlock of unlocked write queue")
+	}
+	if q.waiters.Len() == 0 {
+		q.locked = false
+		return
+	}
+	// The lock stays held; ownership passes directly to the next waiter.
+	w := heap.Pop(&q.waiters).(*writeWaiter)
+	close(w.ready)
+}
+
+// pending returns the number of writers waiting for the lock.
+func (q *writeQueue) pending() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.waiters.Len()
+}
+
+type writeWaiter struct {
+	priority writePriority
+	seq      uint64
+	ready    chan struct{}
+}
+
+// writeWaiters implements heap.Interface, ordering waiters by descending
+// priority and then by ascending sequence number.
+type writeWaiters []*writeWaiter
+
+func (w writeWaiters) Len() int { return len(w) }
+
+func (w writeWaiters) Less(i, j int) bool {
+	if w[i].priority != w[j].priority {
+		return w[i].priority > w[j].priority
+	}
+	return w[i].seq < w[j].seq
+}
+
+func (w writeWaiters) Swap(i, j int) { w[i], w[j] = w[j], w[i] }
+
+func (w *writeWaiters) Push(x any) { *w = append(*w, x.(*writeWaiter)) }
+
+func (w *writeWaiters) Pop() any {
+	old := *w
+	n := len(old)
+	x := old[n-1]
+	old[n-1] = nil
+	*w = old[:n-1]
+	return x
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Canonical Ltd.
+// Licensed under the AGPLv3, see LICENCE file for details.
+
+package rpc
+
+import (
+	"time"
+
+	"github.com/juju/testing"
+	jc "github.com/juju/testing/checkers"
+	gc "gopkg.in/check.v1"
+
+	coretesting "github.com/juju/juju/internal/testing"
+)
+
+type writeQueueSuite struct {
+	testing.IsolationSuite
+}
+
+var _ = gc.Suite(&writeQueueSuite{})
+
+func (s *writeQueueSuite) TestRequestPriority(c *gc.C) {
+	c.Check(requestPriority(Request{Type: "Pinger", Action: "Ping"}), gc.Equals, priorityControl)
+	c.Check(requestPriority(Request{Type: "Client", Action: "FullStatus"}), gc.Equals, priorityNormal)
+}
+
+func (s *writeQueueSuite) TestOrdering(c *gc.C) {
+	var q writeQueue
+	q.lock(priorityNormal)
+
+	order := make(chan string)
+	waitFor := func(name string, priority writePriority, pending int) {
+		go func() {
+			q.lock(priority)
+			order <- name
+			q.unlock()
+		}()
+		for a := coretesting.LongAttempt.Start(); a.Next(); {
+			if q.pending() == pending {
+				return
+			}
+		}
+		c.Fatalf("timed out waiting for %q to queue", name)
+	}
+	waitFor("normal-1", priorityNormal, 1)
+	waitFor("normal-2", priorityNormal, 2)
+	waitFor("control-1", priorityControl, 3)
+	waitFor("control-2", priorityControl, 4)
+
+	q.unlock()
+
+	var got []string
+	for i := 0; i < 4; i++ {
+		select {
+		case name := <-order:
+			got = append(got, name)
+		case <-time.After(coretesting.LongWait):
+			c.Fatalf("timed out waiting for writer")
+		}
+	}
+	c.Check(got, jc.DeepEquals, []string{"control-1", "control-2", "normal-1", "normal-2"})
+	c.Check(q.pending(), gc.Equals, 0)
+}
+
+func (s *writeQueueSuite) TestUnlockUnlockedPanics(c *gc.C) {
+	var q writeQueue
+	c.Assert(func() { q.unlock() }, gc.PanicMatches, "rpc: unlock of unlocked write queue")
+}